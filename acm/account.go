@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/hyperledger/burrow/binary"
@@ -51,6 +52,62 @@ func (acc *Account) SubtractFromBalance(amount uint64) error {
 	return nil
 }
 
+func (acc *Account) AddToGasBalance(amount uint64) error {
+	if binary.IsUint64SumOverflow(acc.GasBalance, amount) {
+		return errors.Errorf(errors.Codes.IntegerOverflow,
+			"uint64 overflow: attempt to add %v to the gas balance of %s", amount, acc.Address)
+	}
+	acc.GasBalance += amount
+	return nil
+}
+
+func (acc *Account) SubtractFromGasBalance(amount uint64) error {
+	if amount > acc.GasBalance {
+		return errors.Errorf(errors.Codes.InsufficientBalance,
+			"insufficient gas: attempt to subtract %v from the gas balance of %s", amount, acc.Address)
+	}
+	acc.GasBalance -= amount
+	return nil
+}
+
+// The kind of code held by an account, which determines the engine used to execute calls to it
+type ContractKind int
+
+const (
+	// An account without code
+	NoContract ContractKind = iota
+	EVMContract
+	WASMContract
+	NativeContract
+)
+
+func (kind ContractKind) String() string {
+	switch kind {
+	case EVMContract:
+		return "EVM"
+	case WASMContract:
+		return "WASM"
+	case NativeContract:
+		return "Native"
+	default:
+		return "None"
+	}
+}
+
+// Return the kind of contract this account holds. An account holding both WASM and EVM code is a WASM contract since
+// calls to it have always run its WASM code.
+func (acc *Account) ContractKind() ContractKind {
+	switch {
+	case len(acc.WASMCode) > 0:
+		return WASMContract
+	case len(acc.EVMCode) > 0:
+		return EVMContract
+	case acc.NativeName != "":
+		return NativeContract
+	}
+	return NoContract
+}
+
 // Return bytes of any code-type value that is set. EVM, WASM, or native name
 func (acc *Account) Code() []byte {
 	switch {
@@ -94,6 +151,26 @@ func (acc *Account) Copy() *Account {
 	return &accCopy
 }
 
+// Mask returns a copy of the account holding only the named fields, with all others left zero, so that large fields
+// such as code can be omitted from query responses. Fields are named as in Account and if none are given the account is
+// returned whole.
+func (acc *Account) Mask(fields ...string) (*Account, error) {
+	if len(fields) == 0 {
+		return acc, nil
+	}
+	masked := new(Account)
+	src := reflect.ValueOf(acc).Elem()
+	dst := reflect.ValueOf(masked).Elem()
+	for _, field := range fields {
+		value := src.FieldByName(field)
+		if !value.IsValid() || strings.HasPrefix(field, "XXX_") {
+			return nil, fmt.Errorf("cannot mask account: no such field '%s'", field)
+		}
+		dst.FieldByName(field).Set(value)
+	}
+	return masked, nil
+}
+
 func (acc *Account) Equal(accOther *Account) bool {
 	buf := proto.NewBuffer(nil)
 	err := buf.Marshal(acc)
@@ -52,7 +52,7 @@ func TestMarshalJSON(t *testing.T) {
 
 	expected := fmt.Sprintf(`{"Address":"%s","PublicKey":{"CurveType":"ed25519","PublicKey":"%s"},`+
 		`"Sequence":4,"Balance":10,"EVMCode":"3C172D",`+
-		`"Permissions":{"Base":{"Perms":"root | send | call | createContract | createAccount | bond | name | proposal | input | batch | identify | hasBase | setBase | unsetBase | setGlobal | hasRole | addRole | removeRole","SetBit":""}}}`,
+		`"Permissions":{"Base":{"Perms":"root | send | call | createContract | createAccount | bond | name | proposal | input | batch | identify | hasBase | setBase | unsetBase | setGlobal | hasRole | addRole | removeRole | emit | pause | schedule","SetBit":""}}}`,
 		acc.Address, acc.PublicKey)
 	assert.Equal(t, expected, string(bs))
 	assert.NoError(t, err)
@@ -67,7 +67,7 @@ func TestAccountTags(t *testing.T) {
 	}
 	flag, _ := acc.Get("Permissions")
 	permString := permission.String(flag.(permission.PermFlag))
-	assert.Equal(t, "send | call | createContract | createAccount | bond | name | proposal | input | batch | hasBase | hasRole", permString)
+	assert.Equal(t, "send | call | createContract | createAccount | bond | name | proposal | input | batch | hasBase | hasRole | emit", permString)
 	roles, _ := acc.Get("Roles")
 	assert.Equal(t, []string{"frogs", "dogs"}, roles)
 	acc.Get("EVMCode")
@@ -75,3 +75,37 @@ func TestAccountTags(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, qry.Matches(acc))
 }
+
+func TestAccount_ContractKind(t *testing.T) {
+	acc := &Account{}
+	assert.Equal(t, NoContract, acc.ContractKind())
+	acc.EVMCode = Bytecode{0x60}
+	assert.Equal(t, EVMContract, acc.ContractKind())
+	acc = &Account{WASMCode: Bytecode{0x00, 0x61, 0x73, 0x6d}}
+	assert.Equal(t, WASMContract, acc.ContractKind())
+	acc.EVMCode = Bytecode{0x60}
+	assert.Equal(t, WASMContract, acc.ContractKind())
+	acc = &Account{NativeName: "Permissions"}
+	assert.Equal(t, NativeContract, acc.ContractKind())
+	assert.Equal(t, "WASM", WASMContract.String())
+}
+
+func TestAccount_Mask(t *testing.T) {
+	acc := NewAccountFromSecret("masked")
+	acc.Balance = 42
+	acc.EVMCode = solidity.Bytecode_StrangeLoop
+
+	masked, err := acc.Mask()
+	require.NoError(t, err)
+	assert.Equal(t, acc, masked)
+
+	masked, err = acc.Mask("Address", "Balance")
+	require.NoError(t, err)
+	assert.Equal(t, &Account{Address: acc.Address, Balance: 42}, masked)
+	assert.Equal(t, solidity.Bytecode_StrangeLoop, []byte(acc.EVMCode), "original should be unchanged")
+
+	_, err = acc.Mask("Address", "Colour")
+	require.Error(t, err)
+	_, err = acc.Mask("XXX_sizecache")
+	require.Error(t, err)
+}
@@ -54,10 +54,18 @@ type Account struct {
 	// The metadata is stored in the deployed account. When the deployed account creates new account
 	// (from Solidity/EVM), they point to the original deployed account where the metadata is stored.
 	// This original account is called the forebear.
-	Forebear             *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,10,opt,name=Forebear,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Forebear,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+	Forebear *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,10,opt,name=Forebear,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Forebear,omitempty"`
+	// The contract that authorizes transactions from this account in place of checking a signature against its public
+	// key (if set)
+	Authorizer *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,12,opt,name=Authorizer,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:",omitempty"`
+	// The account's balance of the gas token from which it pays transaction fees when the chain separates gas from
+	// the native token (see ChainParams.SeparateGasToken)
+	GasBalance uint64 `protobuf:"varint,13,opt,name=GasBalance,proto3" json:",omitempty"`
+	// Whether calls to the account's code are refused, as set by a PermsTx from an account permitted to pause it
+	Paused               bool     `protobuf:"varint,14,opt,name=Paused,proto3" json:",omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Account) Reset()      { *m = Account{} }
@@ -130,6 +138,20 @@ func (m *Account) GetContractMeta() []*ContractMeta {
 	return nil
 }
 
+func (m *Account) GetGasBalance() uint64 {
+	if m != nil {
+		return m.GasBalance
+	}
+	return 0
+}
+
+func (m *Account) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 func (*Account) XXX_MessageName() string {
 	return "acm.Account"
 }
@@ -183,50 +205,156 @@ func (m *ContractMeta) GetMetadata() string {
 func (*ContractMeta) XXX_MessageName() string {
 	return "acm.ContractMeta"
 }
+
+// The metadata registered for some code by the first deployment of a contract with that code
+type CodeMetadata struct {
+	MetadataHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=MetadataHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"MetadataHash"`
+	// The contract whose deployment registered the metadata
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *CodeMetadata) Reset()         { *m = CodeMetadata{} }
+func (m *CodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadata) ProtoMessage()    {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ed775bc0a6adf6, []int{2}
+}
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
+func (*CodeMetadata) XXX_MessageName() string {
+	return "acm.CodeMetadata"
+}
+
+// Records the transaction that deployed a contract
+type Deployment struct {
+	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	// The input account of the deploying transaction
+	Creator              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Creator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Creator"`
+	Height               uint64                                       `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *Deployment) Reset()         { *m = Deployment{} }
+func (m *Deployment) String() string { return proto.CompactTextString(m) }
+func (*Deployment) ProtoMessage()    {}
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ed775bc0a6adf6, []int{3}
+}
+func (m *Deployment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Deployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deployment.Merge(m, src)
+}
+func (m *Deployment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deployment proto.InternalMessageInfo
+
+func (m *Deployment) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*Deployment) XXX_MessageName() string {
+	return "acm.Deployment"
+}
 func init() {
 	proto.RegisterType((*Account)(nil), "acm.Account")
 	golang_proto.RegisterType((*Account)(nil), "acm.Account")
 	proto.RegisterType((*ContractMeta)(nil), "acm.ContractMeta")
 	golang_proto.RegisterType((*ContractMeta)(nil), "acm.ContractMeta")
+	proto.RegisterType((*CodeMetadata)(nil), "acm.CodeMetadata")
+	golang_proto.RegisterType((*CodeMetadata)(nil), "acm.CodeMetadata")
+	proto.RegisterType((*Deployment)(nil), "acm.Deployment")
+	golang_proto.RegisterType((*Deployment)(nil), "acm.Deployment")
 }
 
 func init() { proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
 func init() { golang_proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
 
 var fileDescriptor_49ed775bc0a6adf6 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xed, 0x35, 0x69, 0xe3, 0x5c, 0x22, 0x54, 0x6e, 0x3a, 0x65, 0x70, 0x4c, 0xa7, 0x08, 0x35,
-	0x0e, 0x02, 0xb2, 0x84, 0x29, 0xae, 0xa8, 0x2a, 0x41, 0xa3, 0xe2, 0x4a, 0x45, 0xb0, 0x9d, 0xcf,
-	0x1f, 0x89, 0xa5, 0xd8, 0x67, 0xce, 0x67, 0xc0, 0x3f, 0x81, 0x7f, 0xc0, 0xc8, 0x4f, 0x61, 0xcc,
-	0xc8, 0x58, 0x31, 0x44, 0x28, 0xdd, 0xfa, 0x2b, 0x90, 0x2f, 0x8e, 0x71, 0x3a, 0x54, 0x82, 0x6e,
-	0x79, 0x79, 0xef, 0x7b, 0xef, 0xdd, 0xdd, 0x67, 0xdc, 0x64, 0x3c, 0xb4, 0x63, 0x29, 0x94, 0x20,
-	0x35, 0xc6, 0xc3, 0x4e, 0x7f, 0x1a, 0xa8, 0x59, 0xea, 0xd9, 0x5c, 0x84, 0x83, 0xa9, 0x98, 0x8a,
-	0x81, 0xe6, 0xbc, 0xf4, 0x83, 0x46, 0x1a, 0xe8, 0x5f, 0xeb, 0x99, 0xce, 0x41, 0x0c, 0x32, 0x0c,
-	0x92, 0x24, 0x10, 0x51, 0xf1, 0x4f, 0x9b, 0xcb, 0x2c, 0x56, 0x05, 0x7f, 0xf8, 0x75, 0x0f, 0x37,
-	0xc6, 0x9c, 0x8b, 0x34, 0x52, 0x64, 0x82, 0x1b, 0x63, 0xdf, 0x97, 0x90, 0x24, 0x14, 0x59, 0xa8,
-	0xd7, 0x76, 0x9e, 0x2f, 0x96, 0xdd, 0x9d, 0x5f, 0xcb, 0xee, 0x51, 0x25, 0x73, 0x96, 0xc5, 0x20,
-	0xe7, 0xe0, 0x4f, 0x41, 0x0e, 0xbc, 0x54, 0x4a, 0xf1, 0x79, 0x50, 0x18, 0x16, 0xb3, 0xee, 0xc6,
-	0x84, 0x0c, 0x71, 0xf3, 0x3c, 0xf5, 0xe6, 0x01, 0x7f, 0x05, 0x19, 0xdd, 0xb5, 0x50, 0xaf, 0xf5,
-	0xf4, 0xa1, 0x5d, 0x88, 0x4b, 0xc2, 0xa9, 0xe7, 0x21, 0xee, 0x5f, 0x25, 0xe9, 0x60, 0xe3, 0x02,
-	0x3e, 0xa6, 0x10, 0x71, 0xa0, 0x35, 0x0b, 0xf5, 0xea, 0x6e, 0x89, 0x09, 0xc5, 0x0d, 0x87, 0xcd,
-	0x59, 0x4e, 0xd5, 0x35, 0xb5, 0x81, 0xe4, 0x31, 0x6e, 0xbc, 0xbc, 0x3c, 0x3b, 0x16, 0x3e, 0xd0,
-	0x3d, 0x5d, 0xfe, 0xa0, 0x28, 0x6f, 0x38, 0x99, 0x02, 0x2e, 0x7c, 0x70, 0x37, 0x02, 0x72, 0x82,
-	0x5b, 0xe7, 0xe5, 0xb5, 0x24, 0x74, 0x5f, 0x57, 0x33, 0xed, 0xca, 0x55, 0x15, 0x57, 0x52, 0x51,
-	0x15, 0x3d, 0xab, 0x83, 0x64, 0x84, 0x8d, 0xb7, 0xe3, 0x8b, 0x75, 0x68, 0x43, 0x87, 0x9a, 0xb7,
-	0x43, 0x6f, 0x96, 0x5d, 0x7c, 0x24, 0xc2, 0x40, 0x41, 0x18, 0xab, 0xcc, 0x2d, 0xf5, 0xc4, 0xc6,
-	0x78, 0xc2, 0x54, 0xf0, 0x09, 0x26, 0x2c, 0x04, 0xda, 0xb2, 0x50, 0xaf, 0xe9, 0x3c, 0xb8, 0xa5,
-	0xae, 0x28, 0xc8, 0x25, 0x36, 0xf2, 0xb9, 0x53, 0x96, 0xcc, 0xa8, 0xa1, 0xb3, 0x46, 0x45, 0x56,
-	0xff, 0xee, 0xd7, 0xf1, 0x82, 0x88, 0xc9, 0xcc, 0x3e, 0x85, 0x2f, 0x79, 0xa7, 0xe4, 0x66, 0xd9,
-	0x45, 0x7d, 0xb7, 0xf4, 0x22, 0x43, 0xdc, 0x3e, 0x16, 0x91, 0x92, 0x8c, 0xab, 0x33, 0x50, 0x8c,
-	0x36, 0xad, 0x9a, 0x7e, 0xa7, 0x7c, 0xed, 0xaa, 0x84, 0xbb, 0x25, 0x23, 0xaf, 0xb1, 0x71, 0x22,
-	0x24, 0x78, 0xc0, 0x24, 0xc5, 0xba, 0xce, 0x93, 0x7f, 0x5e, 0x94, 0xd2, 0x61, 0x54, 0xff, 0xf6,
-	0xbd, 0xbb, 0x73, 0x78, 0x85, 0xb6, 0xbb, 0x90, 0x37, 0x95, 0x33, 0xaf, 0x37, 0x72, 0xf8, 0x5f,
-	0x67, 0xae, 0x1c, 0xf7, 0x1d, 0x6e, 0xe7, 0xd6, 0x3e, 0x53, 0x4c, 0xdb, 0xee, 0xde, 0xc7, 0x76,
-	0xcb, 0x2a, 0xdf, 0xdb, 0x0d, 0xd6, 0x7b, 0xdb, 0x74, 0x4b, 0xec, 0xbc, 0x58, 0xac, 0x4c, 0xf4,
-	0x73, 0x65, 0xa2, 0xab, 0x95, 0x89, 0x7e, 0xaf, 0x4c, 0xf4, 0xe3, 0xda, 0x44, 0x8b, 0x6b, 0x13,
-	0xbd, 0x7f, 0x74, 0x77, 0x24, 0xe3, 0xa1, 0xb7, 0xaf, 0x3f, 0xd5, 0x67, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xa2, 0x2c, 0x48, 0xa4, 0x0b, 0x04, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0x66, 0xa0, 0xb6, 0xdb, 0x47, 0x25, 0x38, 0x07, 0x33, 0xe1, 0xb0, 0xad, 0x3d, 0x98, 0xc6,
+	0x40, 0x6b, 0x54, 0x2e, 0x98, 0x98, 0xb4, 0x28, 0x92, 0x28, 0x04, 0x17, 0x83, 0xd1, 0x83, 0xc9,
+	0xec, 0xee, 0xb3, 0xdd, 0xa4, 0xdb, 0xa9, 0xb3, 0xb3, 0xca, 0xfa, 0x4b, 0x3c, 0xfa, 0x37, 0xbc,
+	0x69, 0xbc, 0x70, 0xf4, 0x48, 0x3c, 0x34, 0xa6, 0xdc, 0xf8, 0x15, 0x66, 0x87, 0xe9, 0xb2, 0x40,
+	0x42, 0x82, 0xe5, 0xd6, 0xb7, 0xef, 0x7b, 0xdf, 0xf7, 0xf5, 0xbd, 0x37, 0x0f, 0xca, 0xdc, 0x0b,
+	0x9b, 0x43, 0x29, 0x94, 0xa0, 0x73, 0xdc, 0x0b, 0x97, 0x56, 0xba, 0x81, 0xea, 0xc5, 0x6e, 0xd3,
+	0x13, 0x61, 0xab, 0x2b, 0xba, 0xa2, 0xa5, 0x73, 0x6e, 0xfc, 0x41, 0x47, 0x3a, 0xd0, 0xbf, 0x4e,
+	0x6a, 0x96, 0x16, 0x87, 0x28, 0xc3, 0x20, 0x8a, 0x02, 0x31, 0x30, 0x5f, 0x2a, 0x9e, 0x4c, 0x86,
+	0xca, 0xe4, 0xeb, 0x3f, 0x8b, 0x50, 0x6a, 0x7b, 0x9e, 0x88, 0x07, 0x8a, 0x6e, 0x43, 0xa9, 0xed,
+	0xfb, 0x12, 0xa3, 0x88, 0x91, 0x1a, 0x69, 0x54, 0x3a, 0x8f, 0x0e, 0x46, 0xd5, 0x99, 0x3f, 0xa3,
+	0xea, 0x72, 0x4e, 0xb3, 0x97, 0x0c, 0x51, 0xf6, 0xd1, 0xef, 0xa2, 0x6c, 0xb9, 0xb1, 0x94, 0xe2,
+	0x73, 0xcb, 0x10, 0x9a, 0x5a, 0x67, 0x42, 0x42, 0x57, 0xa1, 0xbc, 0x13, 0xbb, 0xfd, 0xc0, 0x7b,
+	0x81, 0x09, 0x9b, 0xad, 0x91, 0xc6, 0xfc, 0x83, 0x5b, 0x4d, 0x03, 0xce, 0x12, 0x9d, 0x42, 0x2a,
+	0xe2, 0x9c, 0x22, 0xe9, 0x12, 0x58, 0xbb, 0xf8, 0x31, 0xc6, 0x81, 0x87, 0x6c, 0xae, 0x46, 0x1a,
+	0x05, 0x27, 0x8b, 0x29, 0x83, 0x52, 0x87, 0xf7, 0x79, 0x9a, 0x2a, 0xe8, 0xd4, 0x24, 0xa4, 0xf7,
+	0xa0, 0xf4, 0x6c, 0x6f, 0x6b, 0x5d, 0xf8, 0xc8, 0x6e, 0x68, 0xf3, 0x8b, 0xc6, 0xbc, 0xd5, 0x49,
+	0x14, 0x7a, 0xc2, 0x47, 0x67, 0x02, 0xa0, 0x1b, 0x30, 0xbf, 0x93, 0xb5, 0x25, 0x62, 0x45, 0x6d,
+	0xcd, 0x6e, 0xe6, 0x5a, 0x65, 0x5a, 0x92, 0x43, 0x19, 0x9f, 0xf9, 0x42, 0xba, 0x06, 0xd6, 0x9b,
+	0xf6, 0xee, 0x89, 0x68, 0x49, 0x8b, 0xda, 0xe7, 0x45, 0x8f, 0x47, 0x55, 0x58, 0x16, 0x61, 0xa0,
+	0x30, 0x1c, 0xaa, 0xc4, 0xc9, 0xf0, 0xb4, 0x09, 0xb0, 0xcd, 0x55, 0xf0, 0x09, 0xb7, 0x79, 0x88,
+	0x6c, 0xbe, 0x46, 0x1a, 0xe5, 0xce, 0xc2, 0x39, 0x74, 0x0e, 0x41, 0xf7, 0xc0, 0x4a, 0xeb, 0x36,
+	0x79, 0xd4, 0x63, 0x96, 0xd6, 0x5a, 0x33, 0x5a, 0x2b, 0x97, 0x4f, 0xc7, 0x0d, 0x06, 0x5c, 0x26,
+	0xcd, 0x4d, 0xdc, 0x4f, 0x3d, 0x45, 0xc7, 0xa3, 0x2a, 0x59, 0x71, 0x32, 0x2e, 0xba, 0x0a, 0x95,
+	0x75, 0x31, 0x50, 0x92, 0x7b, 0x6a, 0x0b, 0x15, 0x67, 0xe5, 0xda, 0x9c, 0x9e, 0x53, 0xba, 0x76,
+	0xf9, 0x84, 0x73, 0x06, 0x46, 0x5f, 0x82, 0xb5, 0x21, 0x24, 0xba, 0xc8, 0x25, 0x03, 0x6d, 0xe7,
+	0xfe, 0x95, 0x17, 0x25, 0x63, 0xa0, 0xef, 0x01, 0xda, 0xb1, 0xea, 0x09, 0x19, 0x7c, 0x41, 0xc9,
+	0x2a, 0x9a, 0xef, 0xc9, 0x55, 0xf9, 0xce, 0x37, 0xef, 0x94, 0x31, 0x6d, 0xf6, 0x73, 0x1e, 0x4d,
+	0x36, 0xe7, 0x66, 0xba, 0x39, 0x17, 0x9b, 0x7d, 0x8a, 0xa0, 0x77, 0xa1, 0xb8, 0xc3, 0xe3, 0x08,
+	0x7d, 0xb6, 0x50, 0x23, 0x0d, 0xeb, 0x02, 0xd6, 0x64, 0xd7, 0x0a, 0x5f, 0xbf, 0x55, 0x67, 0xea,
+	0x87, 0xe4, 0x6c, 0x0f, 0xe9, 0xab, 0xdc, 0xac, 0x4e, 0x5e, 0xd2, 0xea, 0x7f, 0xcd, 0x2a, 0x37,
+	0xa6, 0xb7, 0x50, 0x49, 0xa9, 0x7d, 0xae, 0xb8, 0xa6, 0x9d, 0x9d, 0x86, 0xf6, 0x0c, 0x55, 0xfa,
+	0xde, 0x26, 0xb1, 0x7e, 0x6f, 0x65, 0x27, 0x8b, 0xeb, 0xdf, 0xf5, 0x5f, 0xf3, 0x71, 0xf2, 0xe1,
+	0x82, 0x0f, 0x72, 0x7d, 0x3e, 0x72, 0xe7, 0x67, 0xf6, 0x1a, 0xce, 0x4f, 0xfd, 0x17, 0x01, 0x78,
+	0x8a, 0xc3, 0xbe, 0x48, 0x42, 0x1c, 0x28, 0xba, 0x05, 0xc5, 0xd7, 0xfb, 0xd3, 0x7b, 0x36, 0x24,
+	0xa9, 0xdb, 0x75, 0x89, 0x5c, 0x09, 0x39, 0x9d, 0x5b, 0x43, 0x42, 0x6f, 0x43, 0x71, 0x13, 0x83,
+	0x6e, 0x4f, 0x99, 0x9b, 0x67, 0xa2, 0xce, 0xe3, 0x83, 0xb1, 0x4d, 0x7e, 0x8f, 0x6d, 0x72, 0x38,
+	0xb6, 0xc9, 0xdf, 0xb1, 0x4d, 0x7e, 0x1c, 0xd9, 0xe4, 0xe0, 0xc8, 0x26, 0xef, 0xee, 0x5c, 0x2e,
+	0xc4, 0xbd, 0xd0, 0x2d, 0xea, 0x23, 0xff, 0xf0, 0xdf, 0x00, 0xcb, 0xbc, 0xd2, 0xe5, 0x45, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Account) Marshal() (dAtA []byte, err error) {
@@ -253,6 +381,33 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.GasBalance != 0 {
+		i = encodeVarintAcm(dAtA, i, uint64(m.GasBalance))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Authorizer != nil {
+		{
+			size := m.Authorizer.Size()
+			i -= size
+			if _, err := m.Authorizer.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAcm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.NativeName) > 0 {
 		i -= len(m.NativeName)
 		copy(dAtA[i:], m.NativeName)
@@ -413,6 +568,105 @@ func (m *ContractMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAcm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MetadataHash.Size()
+		i -= size
+		if _, err := m.MetadataHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAcm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Deployment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deployment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintAcm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Creator.Size()
+		i -= size
+		if _, err := m.Creator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAcm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAcm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAcm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAcm(v)
 	base := offset
@@ -462,6 +716,16 @@ func (m *Account) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAcm(uint64(l))
 	}
+	if m.Authorizer != nil {
+		l = m.Authorizer.Size()
+		n += 1 + l + sovAcm(uint64(l))
+	}
+	if m.GasBalance != 0 {
+		n += 1 + sovAcm(uint64(m.GasBalance))
+	}
+	if m.Paused {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -488,6 +752,41 @@ func (m *ContractMeta) Size() (n int) {
 	return n
 }
 
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetadataHash.Size()
+	n += 1 + l + sovAcm(uint64(l))
+	l = m.Address.Size()
+	n += 1 + l + sovAcm(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Deployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovAcm(uint64(l))
+	l = m.Creator.Size()
+	n += 1 + l + sovAcm(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAcm(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovAcm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -860,6 +1159,80 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			}
 			m.NativeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Authorizer = &v
+			if err := m.Authorizer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBalance", wireType)
+			}
+			m.GasBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAcm(dAtA[iNdEx:])
@@ -1037,6 +1410,265 @@ func (m *ContractMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetadataHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deployment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deployment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deployment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Creator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAcm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
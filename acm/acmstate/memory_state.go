@@ -9,9 +9,11 @@ import (
 )
 
 type MemoryState struct {
-	Accounts map[crypto.Address]*acm.Account
-	Storage  map[crypto.Address]map[binary.Word256][]byte
-	Metadata map[MetadataHash]string
+	Accounts     map[crypto.Address]*acm.Account
+	Storage      map[crypto.Address]map[binary.Word256][]byte
+	Metadata     map[MetadataHash]string
+	CodeMetadata map[CodeHash]*acm.CodeMetadata
+	Deployments  map[crypto.Address]*acm.Deployment
 }
 
 var _ IterableReaderWriter = &MemoryState{}
@@ -24,8 +26,10 @@ func NewMemoryState() *MemoryState {
 				Permissions: permission.DefaultAccountPermissions,
 			},
 		},
-		Storage:  make(map[crypto.Address]map[binary.Word256][]byte),
-		Metadata: make(map[MetadataHash]string),
+		Storage:      make(map[crypto.Address]map[binary.Word256][]byte),
+		Metadata:     make(map[MetadataHash]string),
+		CodeMetadata: make(map[CodeHash]*acm.CodeMetadata),
+		Deployments:  make(map[crypto.Address]*acm.Deployment),
 	}
 }
 
@@ -50,6 +54,24 @@ func (ms *MemoryState) SetMetadata(metahash MetadataHash, metadata string) error
 	return nil
 }
 
+func (ms *MemoryState) GetCodeMetadata(codehash CodeHash) (*acm.CodeMetadata, error) {
+	return ms.CodeMetadata[codehash], nil
+}
+
+func (ms *MemoryState) SetCodeMetadata(codehash CodeHash, codeMeta *acm.CodeMetadata) error {
+	ms.CodeMetadata[codehash] = codeMeta
+	return nil
+}
+
+func (ms *MemoryState) GetDeployment(address crypto.Address) (*acm.Deployment, error) {
+	return ms.Deployments[address], nil
+}
+
+func (ms *MemoryState) SetDeployment(address crypto.Address, deployment *acm.Deployment) error {
+	ms.Deployments[address] = deployment
+	return nil
+}
+
 func (ms *MemoryState) RemoveAccount(address crypto.Address) error {
 	delete(ms.Accounts, address)
 	return nil
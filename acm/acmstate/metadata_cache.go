@@ -2,6 +2,9 @@ package acmstate
 
 import (
 	"sync"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
 )
 
 type metadataInfo struct {
@@ -9,9 +12,21 @@ type metadataInfo struct {
 	updated  bool
 }
 
+type codeMetadataInfo struct {
+	codeMeta *acm.CodeMetadata
+	updated  bool
+}
+
+type deploymentInfo struct {
+	deployment *acm.Deployment
+	updated    bool
+}
+
 type MetadataCache struct {
-	backend MetadataReader
-	m       sync.Map
+	backend     MetadataReader
+	m           sync.Map
+	code        sync.Map
+	deployments sync.Map
 }
 
 func NewMetadataCache(backend MetadataReader) *MetadataCache {
@@ -34,6 +49,42 @@ func (cache *MetadataCache) GetMetadata(metahash MetadataHash) (string, error) {
 	return metaInfo.metadata, nil
 }
 
+func (cache *MetadataCache) SetCodeMetadata(codehash CodeHash, codeMeta *acm.CodeMetadata) error {
+	cache.code.Store(codehash, &codeMetadataInfo{updated: true, codeMeta: codeMeta})
+	return nil
+}
+
+func (cache *MetadataCache) GetCodeMetadata(codehash CodeHash) (*acm.CodeMetadata, error) {
+	value, ok := cache.code.Load(codehash)
+	if ok {
+		return value.(*codeMetadataInfo).codeMeta, nil
+	}
+	codeMeta, err := cache.backend.GetCodeMetadata(codehash)
+	if err != nil {
+		return nil, err
+	}
+	cache.code.Store(codehash, &codeMetadataInfo{codeMeta: codeMeta})
+	return codeMeta, nil
+}
+
+func (cache *MetadataCache) SetDeployment(address crypto.Address, deployment *acm.Deployment) error {
+	cache.deployments.Store(address, &deploymentInfo{updated: true, deployment: deployment})
+	return nil
+}
+
+func (cache *MetadataCache) GetDeployment(address crypto.Address) (*acm.Deployment, error) {
+	value, ok := cache.deployments.Load(address)
+	if ok {
+		return value.(*deploymentInfo).deployment, nil
+	}
+	deployment, err := cache.backend.GetDeployment(address)
+	if err != nil {
+		return nil, err
+	}
+	cache.deployments.Store(address, &deploymentInfo{deployment: deployment})
+	return deployment, nil
+}
+
 // Syncs changes to the backend in deterministic order. Sends storage updates before updating
 // the account they belong so that storage values can be taken account of in the update.
 func (cache *MetadataCache) Sync(st MetadataWriter) error {
@@ -52,12 +103,40 @@ func (cache *MetadataCache) Sync(st MetadataWriter) error {
 	if err != nil {
 		return err
 	}
+	cache.code.Range(func(key, value interface{}) bool {
+		info := value.(*codeMetadataInfo)
+		if info.updated {
+			err = st.SetCodeMetadata(key.(CodeHash), info.codeMeta)
+			if err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	cache.deployments.Range(func(key, value interface{}) bool {
+		info := value.(*deploymentInfo)
+		if info.updated {
+			err = st.SetDeployment(key.(crypto.Address), info.deployment)
+			if err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
 func (cache *MetadataCache) Reset(backend MetadataReader) {
 	cache.backend = backend
 	cache.m = sync.Map{}
+	cache.code = sync.Map{}
+	cache.deployments = sync.Map{}
 }
 
 // Get the cache accountInfo item creating it if necessary
@@ -113,11 +113,19 @@ type StorageIterable interface {
 type MetadataReader interface {
 	// Get an Metadata by its hash. This is content-addressed
 	GetMetadata(metahash MetadataHash) (string, error)
+	// Get the Metadata registered for code with codehash, or nil if none has been registered
+	GetCodeMetadata(codehash CodeHash) (*acm.CodeMetadata, error)
+	// Get the Deployment of the contract at address, or nil if it was not deployed by a transaction
+	GetDeployment(address crypto.Address) (*acm.Deployment, error)
 }
 
 type MetadataWriter interface {
 	// Set an Metadata according to it keccak-256 hash.
 	SetMetadata(metahash MetadataHash, metadata string) error
+	// Register Metadata as describing any code with codehash
+	SetCodeMetadata(codehash CodeHash, codeMeta *acm.CodeMetadata) error
+	// Record the Deployment of the contract at address
+	SetDeployment(address crypto.Address, deployment *acm.Deployment) error
 }
 
 type AccountStats struct {
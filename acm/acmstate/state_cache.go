@@ -20,14 +20,18 @@ type Cache struct {
 	backend  Reader
 	accounts map[crypto.Address]*accountInfo
 	readonly bool
+	// Counts reads loaded from the backend (if set)
+	storageLoads *StorageReads
 }
 
 type accountInfo struct {
 	sync.RWMutex
 	account *acm.Account
 	storage map[binary.Word256][]byte
-	removed bool
-	updated bool
+	// Reads the account's storage from the backend if it is a StorageReader, resolved on the first storage miss
+	storageReader func(key binary.Word256) ([]byte, error)
+	removed       bool
+	updated       bool
 }
 
 type CacheOption func(*Cache) *Cache
@@ -138,7 +142,7 @@ func (cache *Cache) GetStorage(address crypto.Address, key binary.Word256) ([]by
 		value, ok = accInfo.storage[key]
 		if !ok {
 			// Load from backend
-			value, err = cache.backend.GetStorage(address, key)
+			value, err = cache.loadStorage(address, accInfo, key)
 			if err != nil {
 				return []byte{}, err
 			}
@@ -148,6 +152,25 @@ func (cache *Cache) GetStorage(address crypto.Address, key binary.Word256) ([]by
 	return value, nil
 }
 
+// Loads storage from the backend, resolving the account's storage once for all keys if the backend allows it. Must be
+// called with accInfo locked.
+func (cache *Cache) loadStorage(address crypto.Address, accInfo *accountInfo,
+	key binary.Word256) ([]byte, error) {
+	cache.storageLoads.Load()
+	if accInfo.storageReader == nil {
+		sr, ok := cache.backend.(StorageReader)
+		if !ok {
+			return cache.backend.GetStorage(address, key)
+		}
+		storageReader, err := sr.StorageReader(address)
+		if err != nil {
+			return nil, err
+		}
+		accInfo.storageReader = storageReader
+	}
+	return accInfo.storageReader(key)
+}
+
 // NOTE: Set value to zero to remove.
 func (cache *Cache) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
 	if cache.readonly {
@@ -353,3 +353,39 @@ func combine(states ...*MemoryState) *MemoryState {
 func word(str string) binary.Word256 {
 	return binary.LeftPadWord256([]byte(str))
 }
+
+func TestStateCache_StorageReader(t *testing.T) {
+	backend := &storageReaderState{MemoryState: testAccounts()}
+	reads := new(StorageReads)
+	cache := NewCache(backend, CountStorageLoads(reads))
+	address := addressOf("acc2")
+
+	for i := 0; i < 2; i++ {
+		reads.Read()
+		value, err := cache.GetStorage(address, word("ducks"))
+		require.NoError(t, err)
+		assert.Equal(t, "have lucks", string(value))
+		reads.Read()
+		value, err = cache.GetStorage(address, word("chickens"))
+		require.NoError(t, err)
+		assert.Equal(t, "just cluck", string(value))
+	}
+
+	// Storage is resolved once for the account then both keys are loaded through it and served from the cache after
+	assert.Equal(t, 1, backend.resolved)
+	assert.Equal(t, uint64(4), reads.Reads())
+	assert.Equal(t, uint64(2), reads.Loads())
+	assert.Equal(t, uint64(2), reads.Avoided())
+}
+
+type storageReaderState struct {
+	*MemoryState
+	resolved int
+}
+
+func (srs *storageReaderState) StorageReader(address crypto.Address) (func(key binary.Word256) ([]byte, error), error) {
+	srs.resolved++
+	return func(key binary.Word256) ([]byte, error) {
+		return srs.GetStorage(address, key)
+	}, nil
+}
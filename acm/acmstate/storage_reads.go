@@ -0,0 +1,60 @@
+package acmstate
+
+import (
+	"sync/atomic"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// StorageReader is implemented by backends that can resolve the storage of an account once so that a batch of reads
+// from it need not look the storage up for each key
+type StorageReader interface {
+	StorageReader(address crypto.Address) (func(key binary.Word256) ([]byte, error), error)
+}
+
+// StorageReads counts the contract storage reads made by executing transactions and those of them that had to be
+// loaded from state storage rather than being served by the caches of their call frames, transaction, or block.
+// A nil StorageReads counts nothing.
+type StorageReads struct {
+	reads uint64
+	loads uint64
+}
+
+func (sr *StorageReads) Read() {
+	if sr != nil {
+		atomic.AddUint64(&sr.reads, 1)
+	}
+}
+
+func (sr *StorageReads) Load() {
+	if sr != nil {
+		atomic.AddUint64(&sr.loads, 1)
+	}
+}
+
+func (sr *StorageReads) Reads() uint64 {
+	return atomic.LoadUint64(&sr.reads)
+}
+
+func (sr *StorageReads) Loads() uint64 {
+	return atomic.LoadUint64(&sr.loads)
+}
+
+// Avoided returns the number of reads that were served from a cache rather than loaded from state storage
+func (sr *StorageReads) Avoided() uint64 {
+	reads, loads := sr.Reads(), sr.Loads()
+	// Loads can be made on behalf of reads from outside the EVM that are not counted
+	if loads > reads {
+		return 0
+	}
+	return reads - loads
+}
+
+// CountStorageLoads counts the storage reads that miss the cache and are loaded from its backend
+func CountStorageLoads(reads *StorageReads) CacheOption {
+	return func(cache *Cache) *Cache {
+		cache.storageLoads = reads
+		return cache
+	}
+}
@@ -55,6 +55,10 @@ func (bs Balances) Power(amount uint64) Balances {
 	return bs.Add(TypePower, amount)
 }
 
+func (bs Balances) Gas(amount uint64) Balances {
+	return bs.Add(TypeGas, amount)
+}
+
 func (bs Balances) Sum(bss ...Balances) Balances {
 	return Sum(append(bss, bs)...)
 }
@@ -88,6 +92,13 @@ func Power(power uint64) Balance {
 	}
 }
 
+func Gas(gas uint64) Balance {
+	return Balance{
+		Type:   TypeGas,
+		Amount: gas,
+	}
+}
+
 func (bs Balances) Has(ty Type) bool {
 	for _, b := range bs {
 		if b.Type == ty {
@@ -123,6 +134,10 @@ func (bs Balances) GetPower(fallback uint64) uint64 {
 	return bs.GetFallback(TypePower, fallback)
 }
 
+func (bs Balances) GetGas(fallback uint64) uint64 {
+	return bs.GetFallback(TypeGas, fallback)
+}
+
 func (bs Balances) HasNative() bool {
 	return bs.Has(TypeNative)
 }
@@ -131,6 +146,10 @@ func (bs Balances) HasPower() bool {
 	return bs.Has(TypePower)
 }
 
+func (bs Balances) HasGas() bool {
+	return bs.Has(TypeGas)
+}
+
 func NativeToWei(n uint64) *big.Int {
 	// 1 native unit to 1 ether (wei)
 	x := new(big.Int).SetUint64(n)
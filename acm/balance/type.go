@@ -5,11 +5,14 @@ type Type uint32
 const (
 	TypeNative Type = 1
 	TypePower  Type = 2
+	// The token from which fees are paid when the chain separates gas from the native token
+	TypeGas Type = 3
 )
 
 var nameFromType = map[Type]string{
 	TypeNative: "Native",
 	TypePower:  "Power",
+	TypeGas:    "Gas",
 }
 
 var typeFromName = make(map[string]Type)
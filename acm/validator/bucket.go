@@ -132,3 +132,13 @@ func checkPower(power *big.Int) error {
 	}
 	return nil
 }
+
+// Copy returns an independent copy of the bucket against which changes can be trialled without affecting the original
+func (vc *Bucket) Copy() *Bucket {
+	return &Bucket{
+		Delta:    Copy(vc.Delta),
+		Previous: CopyTrim(vc.Previous),
+		Next:     CopyTrim(vc.Next),
+		Flow:     Copy(vc.Flow),
+	}
+}
@@ -228,6 +228,8 @@ func (bc *Blockchain) AppHashAfterLastBlock() []byte {
 // Tendermint block access
 
 func (bc *Blockchain) SetBlockStore(bs *BlockStore) {
+	bc.Lock()
+	defer bc.Unlock()
 	bc.blockStore = bs
 }
 
@@ -245,7 +247,10 @@ func (bc *Blockchain) getBlockMeta(height uint64) (*types.BlockMeta, error) {
 		return nil, fmt.Errorf("%s could not get block hash because Blockchain has not been given access to "+
 			"tendermint BlockStore", errHeader)
 	}
-	return bc.blockStore.BlockMeta(int64(height))
+	bc.RLock()
+	blockStore := bc.blockStore
+	bc.RUnlock()
+	return blockStore.BlockMeta(int64(height))
 }
 
 // GetBlockHeader returns the block header at any given height
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Ceremony runs a genesis ceremony in which validators sign a proposed GenesisDoc before a new chain is started
+func Ceremony(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		cmd.Command("sign", "Sign the hash of the GenesisDoc with this node's validator key, emitting the signature as JSON",
+			func(cmd *cli.Cmd) {
+				configOpts := addConfigOptions(cmd)
+
+				cmd.Action = func() {
+					conf, err := configOpts.obtainBurrowConfig()
+					if err != nil {
+						output.Fatalf("could not set up config: %v", err)
+					}
+					if conf.GenesisDoc == nil {
+						output.Fatalf("no GenesisDoc to sign, provide one with --genesis or in the config")
+					}
+					if conf.ValidatorAddress == nil {
+						output.Fatalf("could not determine which key to sign with, provide one with --address")
+					}
+					keyClient, err := keyClientFromConfig(conf)
+					if err != nil {
+						output.Fatalf("could not create key client: %v", err)
+					}
+					signer, err := keys.AddressableSigner(keyClient, *conf.ValidatorAddress)
+					if err != nil {
+						output.Fatalf("could not get signer for %v: %v", *conf.ValidatorAddress, err)
+					}
+					signature, err := genesis.SignGenesis(conf.GenesisDoc, signer)
+					if err != nil {
+						output.Fatalf("%v", err)
+					}
+					output.Logf("Signed GenesisDoc with hash %X as %v", conf.GenesisDoc.Hash(), signer.GetAddress())
+					output.Printf("%s", source.JSONString(signature))
+				}
+			})
+
+		cmd.Command("collect", "Aggregate validators' signatures of the GenesisDoc into a ceremony manifest",
+			func(cmd *cli.Cmd) {
+				configFileOpt := cmd.String(configFileOption)
+				genesisFileOpt := cmd.String(genesisFileOption)
+				signatureFilesArg := cmd.StringsArg("SIGNATURE", nil, "JSON files containing signatures made by sign")
+				cmd.Spec = configFileSpec + " " + genesisFileSpec + " SIGNATURE..."
+
+				cmd.Action = func() {
+					conf, err := obtainDefaultConfig(*configFileOpt, *genesisFileOpt)
+					if err != nil {
+						output.Fatalf("could not obtain config: %v", err)
+					}
+					if conf.GenesisDoc == nil {
+						output.Fatalf("no GenesisDoc to collect signatures for, provide one with --genesis or in the config")
+					}
+					manifest := genesis.NewCeremonyManifest(conf.GenesisDoc)
+					for _, signatureFile := range *signatureFilesArg {
+						signature := new(genesis.CeremonySignature)
+						err = source.FromFile(signatureFile, signature)
+						if err != nil {
+							output.Fatalf("could not read signature from %s: %v", signatureFile, err)
+						}
+						err = manifest.Add(*signature)
+						if err != nil {
+							output.Fatalf("could not add signature from %s: %v", signatureFile, err)
+						}
+					}
+					output.Printf("%s", source.JSONString(manifest))
+				}
+			})
+
+		cmd.Command("verify", "Check that a ceremony manifest holds enough validators' signatures of the GenesisDoc",
+			func(cmd *cli.Cmd) {
+				configFileOpt := cmd.String(configFileOption)
+				genesisFileOpt := cmd.String(genesisFileOption)
+				thresholdOpt := cmd.IntOpt("t threshold", 0,
+					"Number of genesis validators that must have signed, all of them if zero")
+				manifestFileArg := cmd.StringArg("MANIFEST", "", "JSON file containing the manifest made by collect")
+				cmd.Spec = configFileSpec + " " + genesisFileSpec + " [--threshold=<validators>] MANIFEST"
+
+				cmd.Action = func() {
+					conf, err := obtainDefaultConfig(*configFileOpt, *genesisFileOpt)
+					if err != nil {
+						output.Fatalf("could not obtain config: %v", err)
+					}
+					if conf.GenesisDoc == nil {
+						output.Fatalf("no GenesisDoc to verify, provide one with --genesis or in the config")
+					}
+					manifest := new(genesis.CeremonyManifest)
+					err = source.FromFile(*manifestFileArg, manifest)
+					if err != nil {
+						output.Fatalf("could not read manifest from %s: %v", *manifestFileArg, err)
+					}
+					err = manifest.Verify(conf.GenesisDoc, *thresholdOpt)
+					if err != nil {
+						output.Fatalf("%v", err)
+					}
+					output.Printf("Ceremony manifest verified for GenesisDoc with hash %X", conf.GenesisDoc.Hash())
+				}
+			})
+	}
+}
+
+func keyClientFromConfig(conf *config.BurrowConfig) (keys.KeyClient, error) {
+	if conf.Keys.RemoteAddress != "" {
+		return keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, logging.NewNoopLogger())
+	}
+	keyStore := keys.NewFilesystemKeyStore(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions)
+	return keys.NewLocalKeyClient(keyStore, logging.NewNoopLogger()), nil
+}
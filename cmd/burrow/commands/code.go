@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+	"google.golang.org/grpc"
+)
+
+// Code inspects contract code deployed on a chain
+func Code(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 10, "Timeout in seconds")
+
+		query := func(address crypto.Address, disassemble bool) *rpcquery.Code {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*timeoutOpt)*time.Second)
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, *chainURLOpt, grpc.WithInsecure())
+			if err != nil {
+				output.Fatalf("failed to connect: %v", err)
+			}
+			code, err := rpcquery.NewQueryClient(conn).GetCode(ctx,
+				&rpcquery.GetCodeParam{Address: address, Disassemble: disassemble})
+			if err != nil {
+				output.Fatalf("failed to get code at %v: %v", address, err)
+			}
+			return code
+		}
+
+		cmd.Command("get", "Print the runtime code deployed at an address as hex",
+			func(cmd *cli.Cmd) {
+				addressArg := cmd.StringArg("ADDRESS", "", "Address of the contract")
+
+				cmd.Action = func() {
+					code := query(parseAddress(output, *addressArg), false)
+					output.Printf("%v", code.Code)
+				}
+			})
+
+		cmd.Command("disasm", "Print the annotated EVM assembly of the code deployed at an address",
+			func(cmd *cli.Cmd) {
+				addressArg := cmd.StringArg("ADDRESS", "", "Address of the contract")
+
+				cmd.Action = func() {
+					address := parseAddress(output, *addressArg)
+					code := query(address, true)
+					disassembly := code.Disassembly
+					if disassembly == nil {
+						output.Fatalf("no EVM code deployed at %v", address)
+					}
+					output.Printf("; code hash %v", disassembly.CodeHash)
+					for _, sel := range disassembly.Selectors {
+						line := fmt.Sprintf("; selector 0x%x -> %s", sel.Selector.Bytes(), sel.Label)
+						if sel.Signature != "" {
+							line += " " + sel.Signature
+						}
+						output.Printf(line)
+					}
+					var end uint64
+					for _, ins := range disassembly.Instructions {
+						if ins.Label != "" {
+							output.Printf("%s:", ins.Label)
+						}
+						line := fmt.Sprintf("%06x  %s", ins.PC, ins.OpCode)
+						if len(ins.Immediate) > 0 {
+							line += fmt.Sprintf(" 0x%x", ins.Immediate.Bytes())
+						}
+						if ins.Annotation != "" {
+							line += "  ; " + ins.Annotation
+						}
+						output.Printf(line)
+						end = ins.PC + 1 + uint64(len(ins.Immediate))
+					}
+					if len(disassembly.Metadata) > 0 {
+						output.Printf("%06x  ; Solidity metadata 0x%x", end, disassembly.Metadata.Bytes())
+					}
+				}
+			})
+	}
+}
+
+func parseAddress(output Output, str string) crypto.Address {
+	address, err := crypto.AddressFromHexString(str)
+	if err != nil {
+		output.Fatalf("could not parse address: %v", err)
+	}
+	return address
+}
@@ -2,11 +2,14 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/bind"
 	cli "github.com/jawher/mow.cli"
 )
 
@@ -15,21 +18,54 @@ import (
 func Compile(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		wasmOpt := cmd.BoolOpt("w wasm", false, "Use solang rather than solc")
-		sourceArg := cmd.StringsArg("SOURCE", nil, "Solidity source files to compile")
-		cmd.Spec = "[--wasm] SOURCE..."
+		standardJSONOpt := cmd.BoolOpt("standard-json", false,
+			"Each SOURCE is a solc standard JSON input file, whose settings are used, rather than Solidity source")
+		optimizeOpt := cmd.BoolOpt("optimize", false, "Enable the solc optimizer")
+		optimizeRunsOpt := cmd.IntOpt("optimize-runs", 0,
+			"Number of times the solc optimizer should assume code will be run (solc's default of 200 if not given)")
+		viaIROpt := cmd.BoolOpt("via-ir", false, "Compile via the Yul intermediate representation (solc 0.8.13 or later)")
+		evmVersionOpt := cmd.StringOpt("evm-version", "", "EVM version for solc to target (solc's default if not given)")
+		bindingsOpt := cmd.StringOpt("bindings", "",
+			"Generate typed contract bindings in the given language (go or ts) rather than bytecode fixtures")
+		sourceArg := cmd.StringsArg("SOURCE", nil, "Solidity (or Vyper .vy) source files to compile")
+		cmd.Spec = "[--wasm | --standard-json | [--optimize] [--optimize-runs=<runs>] [--via-ir] [--evm-version=<version>]] " +
+			"[--bindings=<language>] SOURCE..."
 
 		cmd.Action = func() {
+			if *bindingsOpt != "" && *bindingsOpt != "go" && *bindingsOpt != "ts" {
+				output.Fatalf("bindings can only be generated for go or ts, not %s\n", *bindingsOpt)
+			}
 			for _, solfile := range *sourceArg {
 				var resp *compile.Response
 				var err error
 
-				if *wasmOpt {
+				switch {
+				case compile.IsVyper(solfile):
+					resp, err = compile.Vyper(solfile, "", logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("failed compile vyper: %v\n", err)
+					}
+				case *wasmOpt:
 					resp, err = compile.WASM(solfile, "", logging.NewNoopLogger())
 					if err != nil {
 						output.Fatalf("failed compile solidity to wasm: %v\n", err)
 					}
-				} else {
-					resp, err = compile.EVM(solfile, false, "", nil, logging.NewNoopLogger())
+				case *standardJSONOpt:
+					input, err := ioutil.ReadFile(solfile)
+					if err != nil {
+						output.Fatalf("failed to read standard JSON input: %v\n", err)
+					}
+					resp, err = compile.EVMStandardJSON(input, path.Dir(solfile), logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("failed compile standard JSON input: %v\n", err)
+					}
+				default:
+					resp, err = compile.EVMFiles([]string{solfile}, compile.Options{
+						Optimize:     *optimizeOpt,
+						OptimizeRuns: *optimizeRunsOpt,
+						ViaIR:        *viaIROpt,
+						EVMVersion:   *evmVersionOpt,
+					}, "", nil, logging.NewNoopLogger())
 					if err != nil {
 						output.Fatalf("failed compile solidity: %v\n", err)
 					}
@@ -43,6 +79,37 @@ func Compile(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf(resp.Warning)
 				}
 
+				if *bindingsOpt != "" {
+					sources := make([]bind.ContractSource, len(resp.Objects))
+					for i, c := range resp.Objects {
+						sources[i] = bind.ContractSource{
+							Name:     c.Objectname,
+							Abi:      c.Contract.Abi,
+							Bytecode: c.Contract.Evm.Bytecode.Object,
+						}
+					}
+					var code []byte
+					if *bindingsOpt == "ts" {
+						code, err = bind.GenerateTypeScript(sources...)
+					} else {
+						// Bindings belong to the package of the directory containing the source
+						var dir string
+						dir, err = filepath.Abs(filepath.Dir(solfile))
+						if err != nil {
+							output.Fatalf("failed to resolve package directory: %v\n", err)
+						}
+						code, err = bind.Generate(filepath.Base(dir), sources...)
+					}
+					if err != nil {
+						output.Fatalf("failed to generate bindings: %v\n", err)
+					}
+					err = ioutil.WriteFile(solfile+"."+*bindingsOpt, code, 0644)
+					if err != nil {
+						output.Fatalf("failed to write bindings: %v\n", err)
+					}
+					continue
+				}
+
 				f, err := os.Create(solfile + ".go")
 				if err != nil {
 					output.Fatalf("failed to create go file: %v\n", err)
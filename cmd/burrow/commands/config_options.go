@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/crypto"
 	cli "github.com/jawher/mow.cli"
 )
@@ -18,6 +19,9 @@ type configOptions struct {
 	initPassphraseOpt  *string
 	initMonikerOpt     *string
 	externalAddressOpt *string
+	configURLOpt       *string
+	genesisURLOpt      *string
+	configKeyOpt       *string
 }
 
 const configFileSpec = "[--config=<config file>]"
@@ -43,7 +47,10 @@ func addConfigOptions(cmd *cli.Cmd) *configOptions {
 		"|--address=<address of signing key>] " +
 		"[--passphrase=<secret passphrase to unlock key>] " +
 		"[--external-address=<hostname:port>] " +
-		configFileSpec + " " + genesisFileSpec
+		configFileSpec + " " + genesisFileSpec + " " +
+		"[--config-url=<https URL of config>] " +
+		"[--genesis-url=<https URL of genesis json>] " +
+		"[--config-key=<hex public key of config signer>]"
 
 	cmd.Spec = strings.Join([]string{cmd.Spec, spec}, " ")
 	return &configOptions{
@@ -88,11 +95,39 @@ func addConfigOptions(cmd *cli.Cmd) *configOptions {
 		configFileOpt: cmd.String(configFileOption),
 
 		genesisFileOpt: cmd.String(genesisFileOption),
+
+		configURLOpt: cmd.String(cli.StringOpt{
+			Name:   "config-url",
+			Desc:   "Fetch burrow config from this HTTPS URL (in preference to --config) once its detached signature, served at the URL with .sig appended, is verified against --config-key",
+			EnvVar: "BURROW_CONFIG_URL",
+		}),
+
+		genesisURLOpt: cmd.String(cli.StringOpt{
+			Name:   "genesis-url",
+			Desc:   "Fetch the genesis JSON from this HTTPS URL if the config does not contain a GenesisDoc, verifying its detached signature as for --config-url",
+			EnvVar: "BURROW_GENESIS_URL",
+		}),
+
+		configKeyOpt: cmd.String(cli.StringOpt{
+			Name:   "config-key",
+			Desc:   "The hex-encoded ed25519 or secp256k1 public key of the operator who signs the config and genesis served at --config-url and --genesis-url",
+			EnvVar: "BURROW_CONFIG_KEY",
+		}),
 	}
 }
 
 func (opts *configOptions) obtainBurrowConfig() (*config.BurrowConfig, error) {
-	conf, err := obtainDefaultConfig(*opts.configFileOpt, *opts.genesisFileOpt)
+	verify, err := signatureVerifier(*opts.configKeyOpt)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := obtainConfig(
+		source.FirstOf(
+			source.URL(*opts.configURLOpt, verify),
+			burrowConfigProvider(*opts.configFileOpt)),
+		source.FirstOf(
+			genesisDocURLProvider(*opts.genesisURLOpt, verify),
+			genesisDocProvider(*opts.genesisFileOpt, false)))
 	if err != nil {
 		return nil, err
 	}
@@ -123,11 +158,11 @@ func (opts *configOptions) obtainBurrowConfig() (*config.BurrowConfig, error) {
 }
 
 // address is sourced in the following order:
-// 	1. explicitly from cli
-// 	2. genesis accounts (by index)
-// 	3. genesis validators (by index)
-// 	4. config
-// 	5. genesis validator (if only one)
+//  1. explicitly from cli
+//  2. genesis accounts (by index)
+//  3. genesis validators (by index)
+//  4. config
+//  5. genesis validator (if only one)
 func accountAddress(conf *config.BurrowConfig, addressIn string, accIndex, valIndex int) (*crypto.Address, error) {
 	if addressIn != "" {
 		address, err := crypto.AddressFromHexString(addressIn)
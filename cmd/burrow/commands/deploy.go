@@ -10,6 +10,7 @@ import (
 	pkgs "github.com/hyperledger/burrow/deploy"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/proposals"
+	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/loggers"
 	cli "github.com/jawher/mow.cli"
@@ -47,6 +48,9 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 		jobsOpt := cmd.IntOpt("j jobs", 1,
 			"default number of concurrent playbooks to run if multiple are specified")
 
+		parallelOpt := cmd.IntOpt("parallel", 1,
+			"number of jobs within a playbook to run concurrently once the jobs they depend on have completed")
+
 		addressOpt := cmd.StringOpt("a address", "",
 			"default address (or account name) to use; operates the same way as the [account] job, only before the deploy file is ran")
 
@@ -69,8 +73,23 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 
 		proposalCreate := cmd.BoolOpt("proposal-create", false, "Create new proposal")
 
+		gasReportOpt := cmd.BoolOpt("gas-report", false,
+			"Write a report of the gas used by each deploy and call job, aggregated by function and by opcode, to <playbook>.gas.json")
+
+		optimizeOpt := cmd.BoolOpt("optimize", false, "Enable the solc optimizer")
+
+		optimizeRunsOpt := cmd.IntOpt("optimize-runs", 0,
+			"number of times the solc optimizer should assume code will be run (solc's default of 200 if not given)")
+
+		viaIROpt := cmd.BoolOpt("via-ir", false, "Compile via the Yul intermediate representation (solc 0.8.13 or later)")
+
+		evmVersionOpt := cmd.StringOpt("evm-version", "", "EVM version for solc to target (solc's default if not given)")
+
 		timeoutSecondsOpt := cmd.IntOpt("t timeout", int(defaultChainTimeout/time.Second), "Timeout to talk to the chain in seconds")
 
+		rollbackPlanOpt := cmd.BoolOpt("rollback-plan", false,
+			"Write the jobs reverting the changes made by each playbook, where they can be determined, to <playbook>.rollback.json")
+
 		proposalList := cmd.StringOpt("list-proposals state", "", "List proposals, either all, executed, expired, or current")
 
 		playbooksArg := cmd.StringsArg("FILE", []string{},
@@ -78,10 +97,57 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 
 		cmd.Spec = "[--chain=<host:port>] [--keys=<host:port>] [--mempool-signing] [--dir=<root directory>] " +
 			"[--output=<output file>] [--wasm] [--set=<KEY=VALUE>]... [--bin-path=<path>] [--gas=<gas>] " +
-			"[--jobs=<concurrent playbooks>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] [--local-abi] " +
-			"[--verbose] [--debug] [--timeout=<timeout>] " +
+			"[--jobs=<concurrent playbooks>] [--parallel=<concurrent jobs>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] [--local-abi] " +
+			"[--verbose] [--debug] [--timeout=<timeout>] [--gas-report] [--rollback-plan] " +
+			"[--optimize] [--optimize-runs=<runs>] [--via-ir] [--evm-version=<version>] " +
 			"[--list-proposals=<state> | --proposal-create| --proposal-verify | --proposal-vote] [FILE...]"
 
+		cmd.Command("rollback", "Run the jobs of a rollback plan written by --rollback-plan to revert a deploy",
+			func(cmd *cli.Cmd) {
+				chainOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+				signerOpt := cmd.StringOpt("s keys", "",
+					"IP:PORT of Burrow GRPC service which jobs should or otherwise transaction submitted unsigned for mempool signing in Burrow")
+				mempoolSigningOpt := cmd.BoolOpt("p mempool-signing", false,
+					"Use Burrow's own keys connection to sign transactions")
+				pathOpt := cmd.StringOpt("i dir", "", "root directory of app (will use pwd by default)")
+				defaultGasOpt := cmd.StringOpt("g gas", "1111111111", "default gas to use")
+				defaultFeeOpt := cmd.StringOpt("n fee", "99", "default fee to use")
+				defaultAmountOpt := cmd.StringOpt("m amount", "99", "default amount to use")
+				timeoutSecondsOpt := cmd.IntOpt("t timeout", int(defaultChainTimeout/time.Second),
+					"Timeout to talk to the chain in seconds")
+				debugOpt := cmd.BoolOpt("d debug", false, "debug level output")
+				planArg := cmd.StringArg("PLAN", "", "path to the rollback plan (<playbook>.rollback.json) to run")
+
+				cmd.Spec = "[--chain=<host:port>] [--keys=<host:port>] [--mempool-signing] [--dir=<root directory>] " +
+					"[--gas=<gas>] [--fee=<fee>] [--amount=<amount>] [--timeout=<timeout>] [--debug] PLAN"
+
+				cmd.Action = func() {
+					args := &def.DeployArgs{
+						Chain:         *chainOpt,
+						KeysService:   *signerOpt,
+						MempoolSign:   *mempoolSigningOpt,
+						Timeout:       *timeoutSecondsOpt,
+						Path:          *pathOpt,
+						DefaultGas:    *defaultGasOpt,
+						DefaultFee:    *defaultFeeOpt,
+						DefaultAmount: *defaultAmountOpt,
+						Debug:         *debugOpt,
+						Jobs:          1,
+						Parallel:      1,
+					}
+					logger, err := deployLogger(*debugOpt)
+					if err != nil {
+						output.Fatalf("Could not make logger: %v", err)
+					}
+					handleTerm()
+
+					err = pkgs.RunRollback(args, *planArg, logger)
+					if err != nil {
+						output.Fatalf("Could not roll back %s: %v", *planArg, err)
+					}
+				}
+			})
+
 		cmd.Action = func() {
 			args := new(def.DeployArgs)
 
@@ -113,20 +179,22 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			args.Verbose = *verboseOpt
 			args.Debug = *debugOpt
 			args.Jobs = *jobsOpt
+			args.Parallel = *parallelOpt
 			args.ProposeVerify = *proposalVerify
 			args.ProposeVote = *proposalVote
 			args.ProposeCreate = *proposalCreate
-			stdoutLogger, err := loggers.NewStreamLogger(os.Stdout, loggers.TerminalFormat)
+			args.GasReport = *gasReportOpt
+			args.RollbackPlan = *rollbackPlanOpt
+			args.Optimize = *optimizeOpt
+			args.OptimizeRuns = *optimizeRunsOpt
+			args.ViaIR = *viaIROpt
+			args.EVMVersion = *evmVersionOpt
+			logger, err := deployLogger(*debugOpt)
 			if err != nil {
 				output.Fatalf("Could not make logger: %v", err)
 			}
-			logger := logging.NewLogger(stdoutLogger)
 			handleTerm()
 
-			if !*debugOpt {
-				logger.Trace = log.NewNopLogger()
-			}
-
 			if *proposalList != "" {
 				state, err := proposals.ProposalStateFromString(*proposalList)
 				if err != nil {
@@ -152,3 +220,16 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 		}
 	}
 }
+
+func deployLogger(debug bool) (*logging.Logger, error) {
+	stdoutLogger, err := loggers.NewStreamLogger(os.Stdout, loggers.TerminalFormat)
+	if err != nil {
+		return nil, err
+	}
+	// Never log the values of secrets referenced by playbooks
+	logger := logging.NewLogger(loggers.RedactLogger(stdoutLogger, util.SecretValues))
+	if !debug {
+		logger.Trace = log.NewNopLogger()
+	}
+	return logger, nil
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/storage"
 	cli "github.com/jawher/mow.cli"
 	"google.golang.org/grpc"
 )
@@ -86,10 +88,65 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("proofs", "export ICS-23 Merkle proofs of the state in a local Burrow directory", func(cmd *cli.Cmd) {
+			configFileOpt := cmd.String(configFileOption)
+			genesisFileOpt := cmd.String(genesisFileOption)
+
+			dumpOpts := addDumpOptions(cmd, configFileSpec, genesisFileSpec)
+
+			cmd.Action = func() {
+				conf, err := obtainDefaultConfig(*configFileOpt, *genesisFileOpt)
+				if err != nil {
+					output.Fatalf("could not obtain config: %v", err)
+				}
+
+				kern, err := core.NewKernel(conf.BurrowDir)
+				if err != nil {
+					output.Fatalf("could not create burrow kernel: %v", err)
+				}
+
+				err = kern.LoadState(conf.GenesisDoc)
+				if err != nil {
+					output.Fatalf("could not load burrow state: %v", err)
+				}
+
+				height := uint64(*dumpOpts.height)
+				if height == 0 {
+					height = kern.Blockchain.LastBlockHeight()
+				}
+
+				file := os.Stdout
+				if *dumpOpts.filename != "" {
+					file, err = os.OpenFile(*dumpOpts.filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+					if err != nil {
+						output.Fatalf("could not open %s: %v", *dumpOpts.filename, err)
+					}
+				}
+				encoder := json.NewEncoder(file)
+				hash, err := kern.State.ExportProofs(height, func(proof *storage.StateProof) error {
+					if *dumpOpts.useBinaryEncoding {
+						_, err := encoding.WriteMessage(file, proof)
+						return err
+					}
+					return encoder.Encode(proof)
+				})
+				if err != nil {
+					output.Fatalf("could not export proofs: %v", err)
+				}
+				err = file.Close()
+				if err != nil {
+					output.Fatalf("could not close %s: %v", *dumpOpts.filename, err)
+				}
+				output.Logf("exported proofs of state at height %d with hash %X", height, hash)
+			}
+		})
+
 		cmd.Command("remote", "pull a dump from a remote Burrow node", func(cmd *cli.Cmd) {
 			chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
 			timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds")
-			dumpOpts := addDumpOptions(cmd, "[--chain=<chain GRPC address>]", "[--timeout=<GRPC timeout seconds>]")
+			maxMsgSizeOpt := cmd.IntOpt("max-msg-size", 64<<20, "Largest message in bytes to receive from the chain")
+			dumpOpts := addDumpOptions(cmd, "[--chain=<chain GRPC address>]", "[--timeout=<GRPC timeout seconds>]",
+				"[--max-msg-size=<bytes>]")
 
 			cmd.Action = func() {
 				maybeOutput(verbose, output, "dumping from remote chain at %s", *chainURLOpt)
@@ -102,7 +159,8 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 				defer cancel()
 
 				var opts []grpc.DialOption
-				opts = append(opts, grpc.WithInsecure())
+				opts = append(opts, grpc.WithInsecure(),
+					grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(*maxMsgSizeOpt)))
 				conn, err := grpc.DialContext(ctx, *chainURLOpt, opts...)
 				if err != nil {
 					output.Fatalf("failed to connect: %v", err)
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"os/signal"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/genesis"
 	logging_config "github.com/hyperledger/burrow/logging/logconfig"
 )
@@ -21,15 +23,19 @@ type Output interface {
 }
 
 func obtainDefaultConfig(configFile, genesisDocFile string) (*config.BurrowConfig, error) {
+	return obtainConfig(burrowConfigProvider(configFile), genesisDocProvider(genesisDocFile, false))
+}
+
+func obtainConfig(configProvider, genesisProvider source.ConfigProvider) (*config.BurrowConfig, error) {
 	// We need to reflect on whether this obscures where values are coming from
 	conf := config.DefaultBurrowConfig()
 	// We treat logging a little differently in that if anything is set for logging we will not
 	// set default outputs
 	conf.Logging = nil
 	err := source.EachOf(
-		burrowConfigProvider(configFile),
+		configProvider,
 		source.FirstOf(
-			genesisDocProvider(genesisDocFile, false),
+			genesisProvider,
 			// Try working directory
 			genesisDocProvider(config.DefaultGenesisDocJSONFileName, true)),
 	).Apply(conf)
@@ -74,6 +80,60 @@ func genesisDocProvider(genesisFile string, skipNonExistent bool) source.ConfigP
 		})
 }
 
+func genesisDocURLProvider(genesisURL string, verify source.Verifier) source.ConfigProvider {
+	return source.NewConfigProvider(fmt.Sprintf("genesis at URL %s", genesisURL), genesisURL == "",
+		func(baseConfig interface{}) error {
+			conf, ok := baseConfig.(*config.BurrowConfig)
+			if !ok {
+				return fmt.Errorf("config passed was not BurrowConfig")
+			}
+			if conf.GenesisDoc != nil {
+				return nil
+			}
+			genesisDoc := new(genesis.GenesisDoc)
+			err := source.FromURL(genesisURL, verify, genesisDoc)
+			if err != nil {
+				return err
+			}
+			conf.GenesisDoc = genesisDoc
+			return nil
+		})
+}
+
+// Returns a Verifier that checks hex-encoded detached signatures against the hex-encoded ed25519 (32 byte) or
+// secp256k1 (33 byte compressed) public key, or nil if no key is given
+func signatureVerifier(publicKeyHex string) (source.Verifier, error) {
+	if publicKeyHex == "" {
+		return nil, nil
+	}
+	bs, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode config signing key: %v", err)
+	}
+	curveType := crypto.CurveTypeEd25519
+	if len(bs) != crypto.PublicKeyLength(crypto.CurveTypeEd25519) {
+		curveType = crypto.CurveTypeSecp256k1
+	}
+	publicKey, err := crypto.PublicKeyFromBytes(bs, curveType)
+	if err != nil {
+		return nil, fmt.Errorf("could not read config signing key: %v", err)
+	}
+	return func(document, signature []byte) error {
+		sigBytes, err := hex.DecodeString(strings.TrimSpace(string(signature)))
+		if err != nil {
+			return fmt.Errorf("could not decode signature: %v", err)
+		}
+		sig, err := crypto.SignatureFromBytes(sigBytes, curveType)
+		if err != nil {
+			return err
+		}
+		if publicKey.Verify(document, sig) != nil {
+			return fmt.Errorf("signature was not made by key %v", publicKey)
+		}
+		return nil
+	}, nil
+}
+
 func parseRange(rangeString string) (start int64, end int64, err error) {
 	start = 0
 	end = -1
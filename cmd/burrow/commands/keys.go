@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/config/deployment"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/keys"
 	cli "github.com/jawher/mow.cli"
 	"google.golang.org/grpc"
@@ -187,7 +188,7 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json>", func(cmd *cli.Cmd) {
 			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
 			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this key")
-			key := cmd.StringArg("KEY", "", "private key, filename, or raw json")
+			key := cmd.StringArg("KEY", "", "private key, filename, raw json, or secret reference such as ${secret:env:NAME}")
 
 			cmd.Action = func() {
 				var password string
@@ -201,6 +202,12 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 				}
 
 				var privKeyBytes []byte
+				// Keep keys off the command line by referring to them as secrets
+				resolved, err := util.ResolveSecrets(*key, "")
+				if err != nil {
+					output.Fatalf("%v", err)
+				}
+				*key = resolved
 				fileContents, err := ioutil.ReadFile(*key)
 				if err == nil {
 					*key = string(fileContents)
@@ -220,7 +227,7 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 				} else {
 					privKeyBytes, err = hex.DecodeString(*key)
 					if err != nil {
-						output.Fatalf("failed to hex decode key: %s", *key)
+						output.Fatalf("failed to hex decode key: %v", err)
 					}
 					resp, err := c.Import(ctx, &keys.ImportRequest{Passphrase: password, KeyBytes: privKeyBytes, CurveType: *curveType})
 					if err != nil {
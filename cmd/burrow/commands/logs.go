@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/logging/logquery"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/rpcadmin"
+	cli "github.com/jawher/mow.cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Logs searches the logs written by a node's file sinks
+func Logs(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		cmd.Command("query", "Print the log lines of the node's file sinks matching filters", func(cmd *cli.Cmd) {
+			configFileOpt := cmd.String(configFileOption)
+			fileOpt := cmd.StringsOpt("f file", nil,
+				"Log file to search rather than those of the file sinks in the logging config")
+			channelOpt := cmd.StringsOpt("channel", nil, "Only include lines on this log channel (Info or Trace)")
+			levelOpt := cmd.StringsOpt("level", nil, "Only include lines at this level (e.g. info or error)")
+			sinceOpt := cmd.StringOpt("since", "",
+				"Only include lines logged at or after this RFC3339 time or this duration ago (e.g. 30m)")
+			untilOpt := cmd.StringOpt("until", "",
+				"Only include lines logged before this RFC3339 time or this duration ago")
+			txHashOpt := cmd.StringOpt("tx-hash", "", "Only include lines mentioning this transaction hash")
+			limitOpt := cmd.IntOpt("limit", 0, "Print at most this many lines (0 for no limit)")
+
+			cmd.Spec = configFileSpec + " [--file=<log file>...] [--channel=<channel>...] [--level=<level>...] " +
+				"[--since=<time>] [--until=<time>] [--tx-hash=<hash>] [--limit=<lines>]"
+
+			cmd.Action = func() {
+				now := time.Now()
+				since, err := parseLogTime(*sinceOpt, now)
+				if err != nil {
+					output.Fatalf("could not parse --since: %v", err)
+				}
+				until, err := parseLogTime(*untilOpt, now)
+				if err != nil {
+					output.Fatalf("could not parse --until: %v", err)
+				}
+				query := &logquery.Query{
+					Channels: *channelOpt,
+					Levels:   *levelOpt,
+					Since:    since,
+					Until:    until,
+					TxHash:   *txHashOpt,
+				}
+
+				files := *fileOpt
+				if len(files) == 0 {
+					conf, err := obtainDefaultConfig(*configFileOpt, "")
+					if err != nil {
+						output.Fatalf("could not obtain config: %v", err)
+					}
+					files, err = logquery.Files(conf.Logging)
+					if err != nil {
+						output.Fatalf("could not find log files: %v", err)
+					}
+					if len(files) == 0 {
+						output.Fatalf("no log files found, configure a file sink or pass --file")
+					}
+				}
+
+				count := 0
+				errLimit := fmt.Errorf("limit reached")
+				err = query.Search(files, func(line *logquery.Line) error {
+					output.Printf("%s", line.Raw)
+					count++
+					if *limitOpt > 0 && count >= *limitOpt {
+						return errLimit
+					}
+					return nil
+				})
+				if err != nil && err != errLimit {
+					output.Fatalf("could not search logs: %v", err)
+				}
+				output.Logf("%d matching lines in %d files", count, len(files))
+			}
+		})
+
+		cmd.Command("level", "Print or change the logging channels and sinks enabled on a running node",
+			func(cmd *cli.Cmd) {
+				chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+				timeoutOpt := cmd.IntOpt("t timeout", 10, "Timeout in seconds")
+				tokenOpt := cmd.StringOpt("token", "", "Bearer token of an identity permitted to call the admin service")
+				enableOpt := cmd.StringsOpt("enable", nil,
+					"Enable a channel (Info or Trace) or a sink by its path (e.g. root.0)")
+				disableOpt := cmd.StringsOpt("disable", nil,
+					"Disable a channel (Trace) or a sink by its path (e.g. root.0)")
+
+				cmd.Spec = "[--chain=<host:port>] [--timeout=<seconds>] [--token=<token>] " +
+					"[--enable=<channel or sink>...] [--disable=<channel or sink>...]"
+
+				cmd.Action = func() {
+					ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*timeoutOpt)*time.Second)
+					defer cancel()
+					if *tokenOpt != "" {
+						ctx = metadata.AppendToOutgoingContext(ctx, acl.AuthorizationKey, "Bearer "+*tokenOpt)
+					}
+					conn, err := grpc.DialContext(ctx, *chainURLOpt, grpc.WithInsecure())
+					if err != nil {
+						output.Fatalf("failed to connect: %v", err)
+					}
+					client := rpcadmin.NewAdminClient(conn)
+
+					var spec *rpcadmin.LogSpec
+					if len(*enableOpt) == 0 && len(*disableOpt) == 0 {
+						spec, err = client.GetLogSpec(ctx, &rpcadmin.GetLogSpecParam{})
+					} else {
+						param := new(rpcadmin.SetLogSpecParam)
+						addLogSpecTargets(param, *enableOpt, true)
+						addLogSpecTargets(param, *disableOpt, false)
+						spec, err = client.SetLogSpec(ctx, param)
+					}
+					if err != nil {
+						output.Fatalf("failed to get or set logging levels: %v", err)
+					}
+					for _, channel := range spec.Channels {
+						output.Printf("channel %-12s %s", channel.Name, enabledString(channel.Enabled))
+					}
+					for _, sink := range spec.Sinks {
+						output.Printf("sink    %-12s %-8s %s", sink.Path, enabledString(sink.Enabled), sink.Description)
+					}
+				}
+			})
+	}
+}
+
+// Adds each target to param as a sink if it is a sink path, otherwise as a channel
+func addLogSpecTargets(param *rpcadmin.SetLogSpecParam, targets []string, enabled bool) {
+	for _, target := range targets {
+		if target == logconfig.RootSinkPath || strings.HasPrefix(target, logconfig.RootSinkPath+".") {
+			param.Sinks = append(param.Sinks, &rpcadmin.LogSink{Path: target, Enabled: enabled})
+		} else {
+			param.Channels = append(param.Channels, &rpcadmin.LogChannel{Name: target, Enabled: enabled})
+		}
+	}
+}
+
+func enabledString(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// Parses an RFC3339 time or a duration before now, returning the zero time for an empty string
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -16,6 +16,9 @@ import (
 func Natives(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		contractsOpt := cmd.StringsOpt("c contracts", nil, "Contracts to generate")
+		languageOpt := cmd.StringOpt("l language", "solidity",
+			"Generate a solidity interface, or typed event definitions for consumers as either go or proto")
+		packageOpt := cmd.StringOpt("p package", "natives", "Package name for generated go or proto event definitions")
 		cmd.Action = func() {
 			callables := native.MustDefaultNatives().Callables()
 			// Index of next contract
@@ -39,12 +42,21 @@ func Natives(output Output) func(cmd *cli.Cmd) {
 						continue
 					}
 				}
-				solidity, err := templates.NewSolidityContract(contract).Solidity()
+				var code string
+				var err error
+				switch *languageOpt {
+				case "go":
+					code, err = templates.NewEventsContract(*packageOpt, contract).Go()
+				case "proto":
+					code, err = templates.NewEventsContract(*packageOpt, contract).Proto()
+				default:
+					code, err = templates.NewSolidityContract(contract).Solidity()
+				}
 				if err != nil {
-					fmt.Printf("Error generating solidity for contract %s: %s\n",
-						contract.Name, err)
+					fmt.Printf("Error generating %s for contract %s: %s\n",
+						*languageOpt, contract.Name, err)
 				}
-				fmt.Println(solidity)
+				fmt.Println(code)
 				if i < len(callables) {
 					// Two new lines between contracts as per Solidity style guide
 					// (the template gives us 1 trailing new line)
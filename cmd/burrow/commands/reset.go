@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/hyperledger/burrow/core"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Reset deletes selected chain data from a stopped node
+func Reset(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpts := addConfigOptions(cmd)
+		consensusOpt := cmd.BoolOpt("consensus", false,
+			"Delete only the consensus write-ahead log and evidence (e.g. when a node cannot restart mid-round)")
+		blocksOpt := cmd.BoolOpt("blocks", false,
+			"Delete the Tendermint blockstore and consensus state but keep the application state")
+		allOpt := cmd.BoolOpt("all", false, "Delete all chain data including the application state")
+		yesOpt := cmd.BoolOpt("y yes", false, "Do not ask for confirmation")
+		cmd.Spec += "(--consensus | --blocks | --all) [--yes]"
+
+		cmd.Action = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+
+			mode := core.ResetAll
+			if *consensusOpt {
+				mode = core.ResetConsensus
+			} else if *blocksOpt {
+				mode = core.ResetBlocks
+			} else if !*allOpt {
+				output.Fatalf("one of --consensus, --blocks, or --all must be given")
+			}
+
+			paths, err := core.ResetPaths(conf, mode)
+			if err != nil {
+				output.Fatalf("could not determine what to delete: %v", err)
+			}
+			if len(paths) == 0 {
+				output.Logf("Nothing to delete for %s reset under %s", mode, conf.BurrowDir)
+				return
+			}
+
+			output.Printf("A %s reset will permanently delete:", mode)
+			for _, path := range paths {
+				output.Printf("  %s", path)
+			}
+			if mode == core.ResetBlocks {
+				output.Printf("The node will not start until blocks are restored to match the application state")
+			}
+
+			if !*yesOpt {
+				output.Printf("Type 'yes' to continue:")
+				answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+				if err != nil || strings.TrimSpace(answer) != "yes" {
+					output.Fatalf("reset aborted, nothing was deleted")
+				}
+			}
+
+			err = core.Reset(conf, paths)
+			if err != nil {
+				output.Fatalf("could not reset: %v", err)
+			}
+			output.Logf("Deleted %d paths", len(paths))
+		}
+	}
+}
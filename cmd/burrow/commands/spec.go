@@ -26,6 +26,18 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		blockGasLimitOpt := cmd.IntOpt("param-blockgaslimit", 0, "Maximum total gas the transactions of a block may use (0 for unlimited)")
+		maxTxInstructionsOpt := cmd.IntOpt("param-maxtxinstructions", 0, "Maximum number of instructions a transaction may execute (0 for unlimited)")
+		maxLogDataSizeOpt := cmd.IntOpt("param-maxlogdatasize", 0, "Maximum data size of a log event emitted by a contract without the emit permission (0 for unlimited)")
+		maxTxLogsOpt := cmd.IntOpt("param-maxtxlogs", 0, "Maximum number of log events a contract without the emit permission may emit per transaction (0 for unlimited)")
+		maxValidatorPowerChangeOpt := cmd.IntOpt("param-maxvalidatorpowerchange", 0, "Maximum change to validator power as a percentage of total power a GovTx may make within a block without being time-locked (0 for unlimited)")
+		minFeeOpt := cmd.IntOpt("param-minfee", 0, "Minimum fee a CallTx or NameTx must pay (0 for no minimum)")
+		separateGasTokenOpt := cmd.BoolOpt("param-separategastoken", false, "Pay fees in the gas token allocated by Gas amounts rather than the native token")
+		capCallGasOpt := cmd.BoolOpt("param-capcallgas", false, "Forward at most all but one 64th of a contract's remaining gas to its calls as on Ethereum (EIP-150)")
+		newAccountPermissionsOpt := cmd.StringsOpt("param-newaccountpermissions", nil, "Permissions granted to accounts created by a SendTx, a CallTx deploying a contract, or a contract")
+		maxNewAccountPermissionsOpt := cmd.StringsOpt("param-maxnewaccountpermissions", nil, "Most permissions an account created by a SendTx, a CallTx deploying a contract, or a contract may have (unlimited if none)")
+		gasScheduleOpt := cmd.StringOpt("param-gasschedule", "", "EVM gas schedule, one of 'burrow' (the default) or 'ethereum'")
+		validatorPowerChangeDelayOpt := cmd.IntOpt("param-validatorpowerchangedelay", 0, "Number of blocks a time-locked validator power change is delayed during which it may be vetoed")
 
 		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
 			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
@@ -60,6 +72,18 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			genesisSpec.Params.BlockGasLimit = uint64(*blockGasLimitOpt)
+			genesisSpec.Params.MaxTxInstructions = uint64(*maxTxInstructionsOpt)
+			genesisSpec.Params.MaxLogDataSize = uint64(*maxLogDataSizeOpt)
+			genesisSpec.Params.MaxTxLogs = uint64(*maxTxLogsOpt)
+			genesisSpec.Params.MaxValidatorPowerChange = uint64(*maxValidatorPowerChangeOpt)
+			genesisSpec.Params.ValidatorPowerChangeDelay = uint64(*validatorPowerChangeDelayOpt)
+			genesisSpec.Params.MinFee = uint64(*minFeeOpt)
+			genesisSpec.Params.SeparateGasToken = *separateGasTokenOpt
+			genesisSpec.Params.CapCallGas = *capCallGasOpt
+			genesisSpec.Params.NewAccountPermissions = *newAccountPermissionsOpt
+			genesisSpec.Params.MaxNewAccountPermissions = *maxNewAccountPermissionsOpt
+			genesisSpec.Params.GasSchedule = *gasScheduleOpt
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
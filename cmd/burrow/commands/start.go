@@ -28,8 +28,10 @@ func Start(output Output) func(cmd *cli.Cmd) {
 			}
 
 			if err = kern.Boot(); err != nil {
+				output.Logf("%v", kern.BootReport)
 				output.Fatalf("could not boot Burrow kernel: %v", err)
 			}
+			output.Logf("%v", kern.BootReport)
 
 			kern.WaitForShutdown()
 		}
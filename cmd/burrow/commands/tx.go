@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/jobs"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	cli "github.com/jawher/mow.cli"
 )
@@ -27,6 +28,8 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 
 		// formulate first to enable better visibility for the tx input
 		cmd.Command("formulate", "formulate a tx", func(cmd *cli.Cmd) {
+			requestOpt := cmd.BoolOpt("r request", false, "Output a signing request for external approvers rather than the payload")
+			expiryOpt := cmd.IntOpt("e expiry", 3600, "Seconds for which a signing request may be signed and submitted")
 			conf, err := configOpts.obtainBurrowConfig()
 			if err != nil {
 				output.Fatalf("could not set up config: %v", err)
@@ -40,6 +43,22 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 			logger := logging.NewNoopLogger()
 			address := conf.ValidatorAddress.String()
 
+			formulated := func(tx payload.Payload) {
+				if !*requestOpt {
+					output.Printf("%s", source.JSONString(tx.Any()))
+					return
+				}
+				txEnv, err := client.Enclose(tx, logger)
+				if err != nil {
+					output.Fatalf("could not enclose %v: %v", tx.Type(), err)
+				}
+				request, err := txs.NewSigningRequest(txEnv, time.Now().Add(time.Duration(*expiryOpt)*time.Second))
+				if err != nil {
+					output.Fatalf("could not create signing request: %v", err)
+				}
+				output.Printf("%s", source.JSONString(request))
+			}
+
 			cmd.Command("send", "send value to another account", func(cmd *cli.Cmd) {
 				sourceOpt := cmd.StringOpt("s source", "", "Address to send from, if not set config is used")
 				targetOpt := cmd.StringOpt("t target", "", "Address to receive transfer, required")
@@ -62,9 +81,7 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not formulate SendTx: %v", err)
 					}
 
-					output.Printf("%s", source.JSONString(payload.Any{
-						SendTx: tx,
-					}))
+					formulated(tx)
 				}
 			})
 
@@ -88,9 +105,7 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not formulate BondTx: %v", err)
 					}
 
-					output.Printf("%s", source.JSONString(payload.Any{
-						BondTx: tx,
-					}))
+					formulated(tx)
 				}
 			})
 
@@ -114,9 +129,7 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not formulate UnbondTx: %v", err)
 					}
 
-					output.Printf("%s", source.JSONString(payload.Any{
-						UnbondTx: tx,
-					}))
+					formulated(tx)
 				}
 			})
 
@@ -150,13 +163,43 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not formulate IdentifyTx: %v", err)
 					}
 
-					output.Printf("%s", source.JSONString(payload.Any{
-						IdentifyTx: tx,
-					}))
+					formulated(tx)
 				}
 			})
 		})
 
+		cmd.Command("attach-signature", "verify and attach an approver's signature to a signing request", func(cmd *cli.Cmd) {
+			fileOpt := cmd.StringOpt("f file", "", "Read the signing request from a file")
+			signatureOpt := cmd.StringOpt("s signature", "", "File containing the approver's Signatory as JSON")
+			cmd.Spec += "[--file=<location>] --signature=<location>"
+
+			cmd.Action = func() {
+				data, err := readInput(*fileOpt)
+				if err != nil {
+					output.Fatalf("no input: %v", err)
+				}
+				request := new(txs.SigningRequest)
+				if err = json.Unmarshal(data, request); err != nil {
+					output.Fatalf("could not unmarshal signing request: %v", err)
+				}
+				if err = request.Validate(time.Now()); err != nil {
+					output.Fatalf("invalid signing request: %v", err)
+				}
+				data, err = ioutil.ReadFile(*signatureOpt)
+				if err != nil {
+					output.Fatalf("could not read signature: %v", err)
+				}
+				sig := new(txs.Signatory)
+				if err = json.Unmarshal(data, sig); err != nil {
+					output.Fatalf("could not unmarshal Signatory: %v", err)
+				}
+				if err = request.Envelope.AttachSignatory(*sig); err != nil {
+					output.Fatalf("could not attach signature: %v", err)
+				}
+				output.Printf("%s", source.JSONString(request))
+			}
+		})
+
 		cmd.Command("commit", "read and send a tx to mempool", func(cmd *cli.Cmd) {
 			conf, err := configOpts.obtainBurrowConfig()
 			if err != nil {
@@ -182,6 +225,24 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("no input: %v", err)
 				}
 
+				// A completed signing request is submitted as is
+				request := new(txs.SigningRequest)
+				if err = json.Unmarshal(data, request); err == nil && request.Envelope != nil {
+					if err = request.Validate(time.Now()); err != nil {
+						output.Fatalf("invalid signing request: %v", err)
+					}
+					if !request.Complete() {
+						output.Fatalf("signing request has %d of %d signatures", len(request.Envelope.Signatories),
+							len(request.Envelope.Tx.GetInputs()))
+					}
+					txe, err := client.BroadcastEnvelope(request.Envelope, logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("failed to commit tx to mempool: %v", err)
+					}
+					output.Printf("%s", txe.Receipt.TxHash)
+					return
+				}
+
 				if err = json.Unmarshal(data, &rawTx); err != nil {
 					output.Fatalf("could not unmarshal Tx: %v", err)
 				}
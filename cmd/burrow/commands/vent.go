@@ -38,6 +38,7 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				dbTxOpt := cmd.BoolOpt("txs", false, "Create tx tables and persist related data")
 
 				announceEveryOpt := cmd.StringOpt("announce-every", "5s", "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
+				maxLagOpt := cmd.IntOpt("max-lag", int(cfg.MaxHealthyLag), "Fail /healthz when the projection is more than this many blocks behind the chain (0 to disable)")
 
 				cmd.Before = func() {
 					// Rather annoying boilerplate here... but there is no way to pass mow.cli a pointer for it to fill you value
@@ -49,6 +50,10 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					cfg.LogLevel = *logLevelOpt
 					cfg.AbiFileOrDirs = *abiFileOpt
 					cfg.SpecFileOrDirs = *specFileOrDirOpt
+					if *maxLagOpt < 0 {
+						output.Fatalf("max-lag must not be negative")
+					}
+					cfg.MaxHealthyLag = uint64(*maxLagOpt)
 					if *dbBlockOpt {
 						cfg.SpecOpt |= sqlsol.Block
 					}
@@ -66,7 +71,7 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				}
 
 				cmd.Spec = "--spec=<spec file or dir> [--abi=<abi file or dir>] [--db-adapter] [--db-url] [--db-schema] " +
-					"[--blocks] [--txs] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>]"
+					"[--blocks] [--txs] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>] [--max-lag]"
 
 				cmd.Action = func() {
 					log, err := logconfig.New().NewLogger()
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/rpc/rpcverify"
+	cli "github.com/jawher/mow.cli"
+	"google.golang.org/grpc"
+)
+
+// Verify submits Solidity source to a node to verify it against deployed code, and shows verification records
+func Verify(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 60, "Timeout in seconds")
+
+		verifierClient := func() (rpcverify.VerifierClient, context.Context, context.CancelFunc) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*timeoutOpt)*time.Second)
+			conn, err := grpc.DialContext(ctx, *chainURLOpt, grpc.WithInsecure())
+			if err != nil {
+				output.Fatalf("failed to connect: %v", err)
+			}
+			return rpcverify.NewVerifierClient(conn), ctx, cancel
+		}
+
+		cmd.Command("submit", "Recompile source on the node and verify it against the code deployed at an address",
+			func(cmd *cli.Cmd) {
+				addressArg := cmd.StringArg("ADDRESS", "", "Address of the deployed contract")
+				sourceArg := cmd.StringArg("SOURCE", "", "Solidity source file")
+				contractOpt := cmd.StringOpt("n contract", "", "Name of the contract within the source")
+				nameOpt := cmd.StringOpt("s source-name", "",
+					"Name of the source file as it was compiled (defaults to SOURCE)")
+				optimizeOpt := cmd.BoolOpt("o optimize", false, "Whether the contract was compiled with optimization")
+				libsOpt := cmd.StringsOpt("l lib", nil, "Address of a linked library as NAME:ADDRESS")
+				versionOpt := cmd.StringOpt("solc-version", "", "Require the node's compiler to be of this version")
+				cmd.Spec = "--contract=<name> [--source-name=<name>] [--optimize] [--lib=<name:address>]... " +
+					"[--solc-version=<version>] ADDRESS SOURCE"
+
+				cmd.Action = func() {
+					address, err := crypto.AddressFromHexString(*addressArg)
+					if err != nil {
+						output.Fatalf("could not parse address: %v", err)
+					}
+					source, err := ioutil.ReadFile(*sourceArg)
+					if err != nil {
+						output.Fatalf("could not read source: %v", err)
+					}
+					sourceName := *nameOpt
+					if sourceName == "" {
+						sourceName = *sourceArg
+					}
+					libraries := make(map[string]string, len(*libsOpt))
+					for _, lib := range *libsOpt {
+						parts := strings.SplitN(lib, ":", 2)
+						if len(parts) != 2 {
+							output.Fatalf("library should be given as NAME:ADDRESS but got '%s'", lib)
+						}
+						libraries[parts[0]] = parts[1]
+					}
+
+					client, ctx, cancel := verifierClient()
+					defer cancel()
+					verification, err := client.Verify(ctx, &rpcverify.VerifyParam{
+						Address:         address,
+						SourceFile:      sourceName,
+						Source:          string(source),
+						ContractName:    *contractOpt,
+						Optimize:        *optimizeOpt,
+						Libraries:       libraries,
+						CompilerVersion: *versionOpt,
+					})
+					if err != nil {
+						output.Fatalf("could not verify contract: %v", err)
+					}
+					printVerification(output, verification)
+				}
+			})
+
+		cmd.Command("show", "Show the verification record for a contract", func(cmd *cli.Cmd) {
+			addressArg := cmd.StringArg("ADDRESS", "", "Address of the contract")
+
+			cmd.Action = func() {
+				address, err := crypto.AddressFromHexString(*addressArg)
+				if err != nil {
+					output.Fatalf("could not parse address: %v", err)
+				}
+				client, ctx, cancel := verifierClient()
+				defer cancel()
+				verification, err := client.GetVerification(ctx, &rpcverify.GetVerificationParam{Address: &address})
+				if err != nil {
+					output.Fatalf("could not get verification: %v", err)
+				}
+				printVerification(output, verification)
+			}
+		})
+	}
+}
+
+func printVerification(output Output, verification *rpcverify.Verification) {
+	output.Printf("Address: %v", verification.Address)
+	output.Printf("  Code Hash: %v", verification.CodeHash)
+	output.Printf("  Contract Name: %s", verification.ContractName)
+	output.Printf("  Source File: %s", verification.SourceFile)
+	output.Printf("  Compiler version: %s", verification.CompilerVersion)
+	output.Printf("  Optimized: %t", verification.Optimize)
+	output.Printf("  Exact match: %t", verification.ExactMatch)
+	output.Printf("  Verified at height: %d", verification.Height)
+}
@@ -54,6 +54,9 @@ func burrow(output commands.Output) *cli.Cli {
 		"Create Burrow configuration by consuming a GenesisDoc or GenesisSpec, creating keys, and emitting the config",
 		commands.Configure(output))
 
+	app.Command("ceremony", "Sign a proposed GenesisDoc with the other validators in a genesis ceremony",
+		commands.Ceremony(output))
+
 	app.Command("keys", "A tool for doing a bunch of cool stuff with keys",
 		commands.Keys(output))
 
@@ -78,12 +81,24 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("restore", "Restore new chain from backup",
 		commands.Restore(output))
 
+	app.Command("reset", "Delete consensus state, blocks, or all chain data from a stopped node",
+		commands.Reset(output))
+
+	app.Command("verify", "Verify Solidity source against deployed contracts",
+		commands.Verify(output))
+
+	app.Command("code", "Inspect contract code deployed on a chain",
+		commands.Code(output))
+
 	app.Command("accounts", "List accounts and metadata",
 		commands.Accounts(output))
 
 	app.Command("abi", "List, decode and encode using ABI",
 		commands.Abi(output))
 
+	app.Command("logs", "Query the logs written by a node's file sinks or change the logging of a running node",
+		commands.Logs(output))
+
 	app.Command("compile", "Compile solidity files embedding the compilation results as a fixture in a Go file",
 		commands.Compile(output))
 
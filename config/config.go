@@ -6,6 +6,7 @@ import (
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
@@ -30,6 +31,9 @@ type BurrowConfig struct {
 	Keys       *keys.KeysConfig                   `json:",omitempty" toml:",omitempty"`
 	RPC        *rpc.RPCConfig                     `json:",omitempty" toml:",omitempty"`
 	Logging    *logconfig.LoggingConfig           `json:",omitempty" toml:",omitempty"`
+	Events     *event.EventsConfig                `json:",omitempty" toml:",omitempty"`
+	// Requires a new chain's GenesisDoc to have been signed by its validators in a genesis ceremony
+	GenesisCeremony *genesis.CeremonyConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowConfig() *BurrowConfig {
@@ -40,6 +44,7 @@ func DefaultBurrowConfig() *BurrowConfig {
 		RPC:        rpc.DefaultRPCConfig(),
 		Execution:  execution.DefaultExecutionConfig(),
 		Logging:    logconfig.DefaultNodeLoggingConfig(),
+		Events:     event.DefaultEventsConfig(),
 	}
 }
 
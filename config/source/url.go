@@ -0,0 +1,91 @@
+package source
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Appended to the URL of a config document to obtain the URL of its detached signature
+const SignatureSuffix = ".sig"
+
+// The largest config document or signature that will be fetched
+const MaxURLResponseSize = 16 << 20
+
+// HTTPClient fetches config from URLs
+var HTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// Verifies a config document against its detached signature
+type Verifier func(document, signature []byte) error
+
+// Source config from an HTTPS URL, detecting its format. Its detached signature is fetched from the URL with
+// SignatureSuffix appended and the config is only applied if verify accepts it. Skipped if configURL is empty.
+func URL(configURL string, verify Verifier) *configSource {
+	return &configSource{
+		skip: configURL == "",
+		from: fmt.Sprintf("Config at URL '%s'", configURL),
+		apply: func(baseConfig interface{}) error {
+			return FromURL(configURL, verify, baseConfig)
+		},
+	}
+}
+
+// FromURL reads config from an HTTPS URL once verify has accepted it along with its detached signature
+func FromURL(configURL string, verify Verifier, conf interface{}) error {
+	bs, err := ReadURL(configURL, verify)
+	if err != nil {
+		return err
+	}
+	return FromString(string(bs), conf)
+}
+
+// ReadURL fetches a document from an HTTPS URL and its detached signature from the URL with SignatureSuffix appended,
+// returning the document only if verify accepts it
+func ReadURL(documentURL string, verify Verifier) ([]byte, error) {
+	if verify == nil {
+		return nil, fmt.Errorf("a key with which to verify the signature of %s is required", documentURL)
+	}
+	u, err := url.Parse(documentURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse config URL: %v", err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("config may only be fetched over HTTPS but URL %s has scheme '%s'", documentURL,
+			u.Scheme)
+	}
+	document, err := get(documentURL)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := get(documentURL + SignatureSuffix)
+	if err != nil {
+		return nil, err
+	}
+	err = verify(document, signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify signature of %s: %v", documentURL, err)
+	}
+	return document, nil
+}
+
+func get(documentURL string) ([]byte, error) {
+	response, err := HTTPClient.Get(documentURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %v", documentURL, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch %s: %s", documentURL, response.Status)
+	}
+	bs, err := ioutil.ReadAll(io.LimitReader(response.Body, MaxURLResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %v", documentURL, err)
+	}
+	if len(bs) > MaxURLResponseSize {
+		return nil, fmt.Errorf("%s is larger than the maximum of %d bytes", documentURL, MaxURLResponseSize)
+	}
+	return bs, nil
+}
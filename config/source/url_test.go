@@ -0,0 +1,58 @@
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURL(t *testing.T) {
+	tomlString := TOMLString(newTestConfig())
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/burrow.toml":
+			fmt.Fprint(w, tomlString)
+		case "/burrow.toml" + SignatureSuffix:
+			fmt.Fprint(w, "signed")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defaultClient := HTTPClient
+	HTTPClient = server.Client()
+	defer func() { HTTPClient = defaultClient }()
+
+	verify := func(document, signature []byte) error {
+		if !bytes.Equal(signature, []byte("signed")) {
+			return fmt.Errorf("bad signature")
+		}
+		return nil
+	}
+
+	conf := new(animalConfig)
+	err := URL(server.URL+"/burrow.toml", verify).Apply(conf)
+	require.NoError(t, err)
+	assert.Equal(t, tomlString, TOMLString(conf))
+
+	err = URL(server.URL+"/burrow.toml", func(document, signature []byte) error {
+		return fmt.Errorf("bad signature")
+	}).Apply(new(animalConfig))
+	assert.Error(t, err, "should not apply config that fails verification")
+
+	err = URL(server.URL+"/missing.toml", verify).Apply(new(animalConfig))
+	assert.Error(t, err)
+
+	err = URL(server.URL+"/burrow.toml", nil).Apply(new(animalConfig))
+	assert.Error(t, err, "should require a verifier")
+
+	err = URL("http://example.com/burrow.toml", verify).Apply(new(animalConfig))
+	assert.Error(t, err, "should require HTTPS")
+
+	assert.True(t, URL("", nil).Skip(), "should skip an empty URL")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/project"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 type Validators interface {
@@ -26,6 +27,8 @@ type Validators interface {
 const (
 	TendermintValidatorDelayInBlocks = 2
 	BurrowValidatorDelayInBlocks     = 1
+	// The most transactions checked in their Dandelion stem phase that we remember between commits
+	MaxStemCheckedTxs = 10000
 )
 
 type App struct {
@@ -43,11 +46,21 @@ type App struct {
 	checker   execution.BatchExecutor
 	committer execution.BatchCommitter
 	txDecoder txs.Decoder
-	logger    *logging.Logger
+	// Notified of the app hash computed on committing each block
+	appHashListener AppHashListener
+	// Responses to the transactions checked by CheckStemTx since the last commit
+	stemChecked map[string]types.ResponseCheckTx
+	stemLock    sync.Mutex
+	logger      *logging.Logger
 }
 
 var _ types.Application = &App{}
 
+// Receives the app hash computed on committing each block
+type AppHashListener interface {
+	CommittedAppHash(height uint64, appHash []byte)
+}
+
 func NewApp(nodeInfo string, blockchain *bcm.Blockchain, validators Validators, checker execution.BatchExecutor,
 	committer execution.BatchCommitter, txDecoder txs.Decoder, authorizedPeers AuthorizedPeers,
 	panicFunc func(error), logger *logging.Logger) *App {
@@ -60,6 +73,7 @@ func NewApp(nodeInfo string, blockchain *bcm.Blockchain, validators Validators,
 		txDecoder:       txDecoder,
 		authorizedPeers: authorizedPeers,
 		panicFunc:       panicFunc,
+		stemChecked:     make(map[string]types.ResponseCheckTx),
 		logger: logger.WithScope("abci.NewApp").With(structure.ComponentKey, "ABCI_App",
 			"node_info", nodeInfo),
 	}
@@ -72,6 +86,11 @@ func (app *App) SetMempoolLocker(mempoolLocker sync.Locker) {
 	app.mempoolLocker = mempoolLocker
 }
 
+// Provide a listener to be notified of the app hash computed on committing each block
+func (app *App) SetAppHashListener(listener AppHashListener) {
+	app.appHashListener = listener
+}
+
 func (app *App) Info(info types.RequestInfo) types.ResponseInfo {
 	return types.ResponseInfo{
 		Data:             app.nodeInfo,
@@ -160,6 +179,11 @@ func (app *App) BeginBlock(block types.RequestBeginBlock) (respBeginBlock types.
 			}
 		}
 	}
+	// Recurring calls registered with the Cron native run before any of the block's transactions
+	err := app.committer.BeginBlock()
+	if err != nil {
+		panic(fmt.Errorf("could not run cron jobs at start of block: %v", err))
+	}
 	return
 }
 
@@ -187,7 +211,17 @@ func (app *App) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 		}
 	}()
 
-	checkTx := ExecuteTx(logHeader, app.checker, app.txDecoder, req.GetTx())
+	key := string(tmhash.Sum(req.GetTx()))
+	app.stemLock.Lock()
+	checkTx, ok := app.stemChecked[key]
+	delete(app.stemChecked, key)
+	app.stemLock.Unlock()
+	if ok {
+		// Already executed against the check cache while relayed in its stem phase
+		return checkTx
+	}
+
+	checkTx = ExecuteTx(logHeader, app.checker, app.txDecoder, req.GetTx())
 
 	logger := WithEvents(app.logger, checkTx.Events)
 
@@ -202,6 +236,34 @@ func (app *App) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 	return checkTx
 }
 
+// CheckStemTx checks a transaction being relayed in its Dandelion stem phase rather than added to the mempool. Since
+// the transaction is executed against the check cache, a subsequent CheckTx of it by the mempool when it is broadcast
+// returns the same response rather than executing it again (which would fail on its sequence number). Transactions are
+// forgotten at each commit when the check cache is reset.
+func (app *App) CheckStemTx(req types.RequestCheckTx) types.ResponseCheckTx {
+	key := string(tmhash.Sum(req.GetTx()))
+	app.stemLock.Lock()
+	checkTx, ok := app.stemChecked[key]
+	full := len(app.stemChecked) >= MaxStemCheckedTxs
+	app.stemLock.Unlock()
+	if ok {
+		return checkTx
+	}
+	if full {
+		return types.ResponseCheckTx{
+			Code: codes.TxExecutionErrorCode,
+			Log:  fmt.Sprintf("CheckStemTx: already holding %d transactions in their stem phase", MaxStemCheckedTxs),
+		}
+	}
+	checkTx = app.CheckTx(req)
+	if checkTx.Code == codes.TxExecutionSuccessCode {
+		app.stemLock.Lock()
+		app.stemChecked[key] = checkTx
+		app.stemLock.Unlock()
+	}
+	return checkTx
+}
+
 func (app *App) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
 	const logHeader = "DeliverTx"
 	defer func() {
@@ -301,6 +363,9 @@ func (app *App) Commit() types.ResponseCommit {
 	if err != nil {
 		panic(errors.Wrap(err, "could not reset check cache during commit"))
 	}
+	app.stemLock.Lock()
+	app.stemChecked = make(map[string]types.ResponseCheckTx)
+	app.stemLock.Unlock()
 	// Commit to our blockchain state which will checkpoint the previous app hash by saving it to the database
 	// (we know the previous app hash is safely committed because we are about to commit the next)
 	err = app.blockchain.CommitBlock(blockTime, app.block.Hash, appHash)
@@ -308,6 +373,9 @@ func (app *App) Commit() types.ResponseCommit {
 		panic(fmt.Errorf("could not commit block to blockchain state: %v", err))
 	}
 	app.logger.InfoMsg("Committed block")
+	if app.appHashListener != nil {
+		app.appHashListener.CommittedAppHash(uint64(app.block.Header.Height), appHash)
+	}
 
 	return types.ResponseCommit{
 		Data: appHash,
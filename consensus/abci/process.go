@@ -8,7 +8,7 @@ import (
 	"time"
 
 	"github.com/hyperledger/burrow/bcm"
-
+	"github.com/hyperledger/burrow/consensus/ordering"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/tendermint/tendermint/abci/types"
@@ -23,14 +23,26 @@ type Process struct {
 	done         chan struct{}
 	panic        func(error)
 	commitNeeded bool
+	// Whether the cron jobs of the current block have run
+	blockStarted bool
 	txDecoder    txs.Decoder
 	shutdownOnce sync.Once
+	policy       ordering.Policy
+	pending      []*pendingTx
+}
+
+type pendingTx struct {
+	txEnv *txs.Envelope
+	tx    tmTypes.Tx
+	cb    func(*types.Response)
 }
 
 // NewProcess returns a no-consensus ABCI process suitable for running a single node without Tendermint.
 // The CheckTx function can be used to submit transactions which are processed according
+// If a policy is provided (and commitInterval is non-zero) transactions are held until the next commit and then executed
+// in the order given by the policy, otherwise they are executed as they arrive.
 func NewProcess(committer execution.BatchCommitter, blockchain *bcm.Blockchain, txDecoder txs.Decoder,
-	commitInterval time.Duration, panicFunc func(error)) *Process {
+	commitInterval time.Duration, policy ordering.Policy, panicFunc func(error)) *Process {
 
 	p := &Process{
 		committer:  committer,
@@ -38,6 +50,7 @@ func NewProcess(committer execution.BatchCommitter, blockchain *bcm.Blockchain,
 		done:       make(chan struct{}),
 		txDecoder:  txDecoder,
 		panic:      panicFunc,
+		policy:     policy,
 	}
 
 	if commitInterval != 0 {
@@ -52,17 +65,30 @@ func (p *Process) CheckTx(tx tmTypes.Tx, cb func(*types.Response), txInfo mempoo
 	const header = "DeliverTx"
 	p.committer.Lock()
 	defer p.committer.Unlock()
+	if p.policy != nil && p.ticker != nil {
+		txEnv, err := p.txDecoder.DecodeTx(tx)
+		// Let undecodable transactions fall through to be rejected immediately
+		if err == nil {
+			p.pending = append(p.pending, &pendingTx{txEnv: txEnv, tx: tx, cb: cb})
+			p.commitNeeded = true
+			return nil
+		}
+	}
 	// Skip check - deliver immediately
 	// FIXME: [Silas] this means that any transaction that a transaction that fails CheckTx
 	// that would not normally end up stored in state (as an exceptional tx) will get stored in state.
 	// This means that the same sequence of transactions fed to no consensus mode can give rise to a state with additional
 	// invalid transactions in state. Since the state hash is non-deterministic based on when the commits happen it's not
 	// clear this is a problem. The underlying state will be compatible.
+	err := p.beginBlock()
+	if err != nil {
+		return err
+	}
 	checkTx := ExecuteTx(header, p.committer, p.txDecoder, tx)
 	cb(types.ToResponseCheckTx(checkTx))
 	p.commitNeeded = true
 	if p.ticker == nil {
-		err := p.commit()
+		err = p.commit()
 		if err != nil {
 			return err
 		}
@@ -107,6 +133,11 @@ func (p *Process) commit() error {
 	if !p.commitNeeded {
 		return nil
 	}
+	err := p.beginBlock()
+	if err != nil {
+		return fmt.Errorf("%s %v", errHeader, err)
+	}
+	p.deliverPending()
 
 	appHash, err := p.committer.Commit(nil)
 	if err != nil {
@@ -123,5 +154,40 @@ func (p *Process) commit() error {
 		return fmt.Errorf("%s could not CommitBlock %v", errHeader, err)
 	}
 	p.commitNeeded = false
+	p.blockStarted = false
+	return nil
+}
+
+// Run the cron jobs of the current block unless they have already run, this must happen before any of its transactions
+// are executed
+func (p *Process) beginBlock() error {
+	if p.blockStarted {
+		return nil
+	}
+	err := p.committer.BeginBlock()
+	if err != nil {
+		return fmt.Errorf("could not run cron jobs: %v", err)
+	}
+	p.blockStarted = true
 	return nil
 }
+
+// Execute the transactions held for this block in the order given by the policy
+func (p *Process) deliverPending() {
+	const header = "DeliverTx"
+	if len(p.pending) == 0 {
+		return
+	}
+	txEnvs := make([]*txs.Envelope, len(p.pending))
+	byEnvelope := make(map[*txs.Envelope]*pendingTx, len(p.pending))
+	for i, ptx := range p.pending {
+		txEnvs[i] = ptx.txEnv
+		byEnvelope[ptx.txEnv] = ptx
+	}
+	p.pending = nil
+	for _, txEnv := range p.policy.Order(txEnvs) {
+		ptx := byEnvelope[txEnv]
+		checkTx := ExecuteTx(header, p.committer, p.txDecoder, ptx.tx)
+		ptx.cb(types.ToResponseCheckTx(checkTx))
+	}
+}
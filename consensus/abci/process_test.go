@@ -0,0 +1,62 @@
+package abci
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/mempool"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestProcess_BeginsBlockBeforeTxs(t *testing.T) {
+	genesisDoc, _, _ := genesis.NewDeterministicGenesis(3450976).GenesisDoc(1, 1)
+	committer := new(recordingCommitter)
+	codec := txs.NewProtobufCodec()
+	p := NewProcess(committer, bcm.NewBlockchain(dbm.NewMemDB(), genesisDoc), codec, 0, nil, func(err error) {
+		require.NoError(t, err)
+	})
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		tx, err := codec.EncodeTx(txs.Enclose(genesisDoc.ChainID(), &payload.CallTx{
+			Input:   &payload.TxInput{Address: crypto.Address{1}, Sequence: sequence},
+			Address: &crypto.Address{2},
+		}))
+		require.NoError(t, err)
+		err = p.CheckTx(tx, func(*abciTypes.Response) {}, mempool.TxInfo{})
+		require.NoError(t, err)
+	}
+	// Each transaction is committed in its own block whose cron jobs run first
+	assert.Equal(t, []string{"BeginBlock", "Execute", "Commit", "BeginBlock", "Execute", "Commit"}, committer.calls)
+}
+
+type recordingCommitter struct {
+	execution.BatchCommitter
+	calls []string
+}
+
+func (rc *recordingCommitter) Lock() {}
+
+func (rc *recordingCommitter) Unlock() {}
+
+func (rc *recordingCommitter) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	rc.calls = append(rc.calls, "Execute")
+	return exec.NewTxExecution(txEnv), nil
+}
+
+func (rc *recordingCommitter) BeginBlock() error {
+	rc.calls = append(rc.calls, "BeginBlock")
+	return nil
+}
+
+func (rc *recordingCommitter) Commit(header *abciTypes.Header) ([]byte, error) {
+	rc.calls = append(rc.calls, "Commit")
+	return []byte{1}, nil
+}
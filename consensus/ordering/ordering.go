@@ -0,0 +1,138 @@
+// Package ordering provides the policies by which pending transactions are ordered when a block is assembled
+package ordering
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+const (
+	// Transactions are included in the order they arrived
+	FIFO = "fifo"
+	// Transactions offering higher fees are included first
+	FeePriority = "fee"
+	// Each sender has a transaction included in turn, so no sender can crowd out others by submitting many
+	RoundRobin = "round-robin"
+)
+
+// A Policy orders the transactions pending inclusion in a block. Implementations must be deterministic and must not
+// reorder transactions from the same sender relative to each other (so that their sequence numbers remain valid).
+type Policy interface {
+	Order(txEnvs []*txs.Envelope) []*txs.Envelope
+}
+
+type PolicyFunc func(txEnvs []*txs.Envelope) []*txs.Envelope
+
+func (pf PolicyFunc) Order(txEnvs []*txs.Envelope) []*txs.Envelope {
+	return pf(txEnvs)
+}
+
+var policies = map[string]Policy{
+	FIFO:        PolicyFunc(fifo),
+	FeePriority: PolicyFunc(feePriority),
+	RoundRobin:  PolicyFunc(roundRobin),
+}
+
+// Register makes a policy available by name for selection in config, it must be called before the node is started
+func Register(name string, policy Policy) error {
+	if _, ok := policies[name]; ok {
+		return fmt.Errorf("transaction ordering policy '%s' is already registered", name)
+	}
+	policies[name] = policy
+	return nil
+}
+
+// PolicyByName returns the named policy where the empty name is FIFO
+func PolicyByName(name string) (Policy, error) {
+	if name == "" {
+		name = FIFO
+	}
+	policy, ok := policies[name]
+	if !ok {
+		return nil, fmt.Errorf("transaction ordering policy '%s' not recognised", name)
+	}
+	return policy, nil
+}
+
+func fifo(txEnvs []*txs.Envelope) []*txs.Envelope {
+	return txEnvs
+}
+
+func feePriority(txEnvs []*txs.Envelope) []*txs.Envelope {
+	queues := bySender(txEnvs)
+	ordered := make([]*txs.Envelope, 0, len(txEnvs))
+	for len(ordered) < len(txEnvs) {
+		// Take the highest fee from the heads of the senders' queues, breaking ties by arrival
+		var next *queue
+		for _, q := range queues {
+			if len(q.pending) == 0 {
+				continue
+			}
+			if next == nil || Fee(q.pending[0].txEnv) > Fee(next.pending[0].txEnv) ||
+				Fee(q.pending[0].txEnv) == Fee(next.pending[0].txEnv) && q.pending[0].index < next.pending[0].index {
+				next = q
+			}
+		}
+		ordered = append(ordered, next.pending[0].txEnv)
+		next.pending = next.pending[1:]
+	}
+	return ordered
+}
+
+func roundRobin(txEnvs []*txs.Envelope) []*txs.Envelope {
+	queues := bySender(txEnvs)
+	ordered := make([]*txs.Envelope, 0, len(txEnvs))
+	for len(ordered) < len(txEnvs) {
+		for _, q := range queues {
+			if len(q.pending) > 0 {
+				ordered = append(ordered, q.pending[0].txEnv)
+				q.pending = q.pending[1:]
+			}
+		}
+	}
+	return ordered
+}
+
+// Fee returns the fee offered by a transaction, or zero for transactions that do not offer one
+func Fee(txEnv *txs.Envelope) uint64 {
+	switch tx := txEnv.Tx.Payload.(type) {
+	case *payload.CallTx:
+		return tx.Fee
+	case *payload.NameTx:
+		return tx.Fee
+	}
+	return 0
+}
+
+type indexed struct {
+	index int
+	txEnv *txs.Envelope
+}
+
+type queue struct {
+	pending []indexed
+}
+
+// Partitions txEnvs into a queue for each sender (the first input) in order of each sender's first transaction
+func bySender(txEnvs []*txs.Envelope) []*queue {
+	var queues []*queue
+	senders := make(map[crypto.Address]*queue)
+	for i, txEnv := range txEnvs {
+		inputs := txEnv.Tx.GetInputs()
+		if len(inputs) == 0 {
+			queues = append(queues, &queue{pending: []indexed{{i, txEnv}}})
+			continue
+		}
+		q, ok := senders[inputs[0].Address]
+		if !ok {
+			q = new(queue)
+			senders[inputs[0].Address] = q
+			queues = append(queues, q)
+		}
+		q.pending = append(q.pending, indexed{i, txEnv})
+	}
+	return queues
+}
@@ -0,0 +1,64 @@
+package ordering
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = crypto.Address{1}
+	bob   = crypto.Address{2}
+	carol = crypto.Address{3}
+)
+
+func TestFIFO(t *testing.T) {
+	pending := []*txs.Envelope{callTx(alice, 1, 0), callTx(alice, 2, 10), callTx(bob, 1, 5)}
+	policy, err := PolicyByName("")
+	require.NoError(t, err)
+	assert.Equal(t, pending, policy.Order(pending))
+}
+
+func TestFeePriority(t *testing.T) {
+	a1, a2, b1, c1 := callTx(alice, 1, 1), callTx(alice, 2, 100), callTx(bob, 1, 10), callTx(carol, 1, 10)
+	policy, err := PolicyByName(FeePriority)
+	require.NoError(t, err)
+	// Alice's high fee transaction cannot jump her own earlier transaction, and bob arrived before carol
+	assert.Equal(t, []*txs.Envelope{b1, c1, a1, a2}, policy.Order([]*txs.Envelope{a1, a2, b1, c1}))
+}
+
+func TestRoundRobin(t *testing.T) {
+	a1, a2, a3, b1, b2, c1 := callTx(alice, 1, 0), callTx(alice, 2, 0), callTx(alice, 3, 0), callTx(bob, 1, 0),
+		callTx(bob, 2, 0), callTx(carol, 1, 0)
+	policy, err := PolicyByName(RoundRobin)
+	require.NoError(t, err)
+	assert.Equal(t, []*txs.Envelope{a1, b1, c1, a2, b2, a3}, policy.Order([]*txs.Envelope{a1, a2, a3, b1, b2, c1}))
+}
+
+func TestRegister(t *testing.T) {
+	_, err := PolicyByName("reverse")
+	require.Error(t, err)
+	require.NoError(t, Register("reverse", PolicyFunc(func(txEnvs []*txs.Envelope) []*txs.Envelope {
+		reversed := make([]*txs.Envelope, len(txEnvs))
+		for i, txEnv := range txEnvs {
+			reversed[len(txEnvs)-1-i] = txEnv
+		}
+		return reversed
+	})))
+	require.Error(t, Register("reverse", PolicyFunc(fifo)))
+	policy, err := PolicyByName("reverse")
+	require.NoError(t, err)
+	a1, b1 := callTx(alice, 1, 0), callTx(bob, 1, 0)
+	assert.Equal(t, []*txs.Envelope{b1, a1}, policy.Order([]*txs.Envelope{a1, b1}))
+}
+
+func callTx(sender crypto.Address, sequence, fee uint64) *txs.Envelope {
+	return txs.Enclose("OrderingChain", &payload.CallTx{
+		Input: &payload.TxInput{Address: sender, Sequence: sequence},
+		Fee:   fee,
+	})
+}
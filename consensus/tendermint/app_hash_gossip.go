@@ -0,0 +1,175 @@
+package tendermint
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+const (
+	// The p2p channel on which app hashes are gossiped (distinct from those used by Tendermint's own reactors)
+	AppHashChannel = byte(0x70)
+	// The name under which the app hash gossip reactor is registered with the p2p switch
+	AppHashGossipReactorName = "APP_HASH_GOSSIP"
+	// The event type published when a peer's app hash diverges from ours
+	AppHashDivergenceEventType = "AppHashDivergence"
+	// The number of recent heights for which app hashes are retained for comparison
+	AppHashRetainHeights = 100
+
+	maxAppHashAnnouncementSize = 1024
+)
+
+// Queries for the events published when app hash divergence is detected
+var AppHashDivergenceQuery = query.NewBuilder().AndEquals(event.EventTypeKey, AppHashDivergenceEventType)
+
+// AppHashGossip is a p2p reactor over which nodes announce the app hash they computed on committing each block. Each
+// announcement is compared with our own app hash at the same height so that divergent execution is detected and alerted
+// as soon as the block is committed rather than only when consensus halts on the next block's app hash. It is purely
+// advisory: announcements never affect consensus or execution.
+type AppHashGossip struct {
+	p2p.BaseReactor
+	sync.Mutex
+	// App hashes we have computed at recent heights
+	local map[uint64]binary.HexBytes
+	// The last height we committed
+	height uint64
+	// App hashes announced by peers at heights we have yet to commit
+	pending map[uint64]map[p2p.ID]binary.HexBytes
+	emitter *event.Emitter
+	logger  *logging.Logger
+}
+
+func NewAppHashGossip(emitter *event.Emitter, logger *logging.Logger) *AppHashGossip {
+	ahg := &AppHashGossip{
+		local:   make(map[uint64]binary.HexBytes),
+		pending: make(map[uint64]map[p2p.ID]binary.HexBytes),
+		emitter: emitter,
+		logger:  logger.WithScope("AppHashGossip"),
+	}
+	ahg.BaseReactor = *p2p.NewBaseReactor("AppHashGossip", ahg)
+	return ahg
+}
+
+// Allows the reactor to be carried over to a rebuilt node, keeping the app hashes we have computed
+func (ahg *AppHashGossip) OnReset() error {
+	return nil
+}
+
+func (ahg *AppHashGossip) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{{
+		ID:                  AppHashChannel,
+		Priority:            1,
+		SendQueueCapacity:   10,
+		RecvMessageCapacity: maxAppHashAnnouncementSize,
+	}}
+}
+
+// Bring a newly connected peer up to date with our latest app hash
+func (ahg *AppHashGossip) AddPeer(peer p2p.Peer) {
+	ahg.Lock()
+	appHash, ok := ahg.local[ahg.height]
+	height := ahg.height
+	ahg.Unlock()
+	if !ok {
+		return
+	}
+	bs, err := encoding.Encode(&AppHashAnnouncement{Height: height, AppHash: appHash})
+	if err != nil {
+		ahg.logger.InfoMsg("Could not encode app hash announcement", structure.ErrorKey, err)
+		return
+	}
+	peer.TrySend(AppHashChannel, bs)
+}
+
+func (ahg *AppHashGossip) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
+	announcement := new(AppHashAnnouncement)
+	err := encoding.Decode(msgBytes, announcement)
+	if err != nil {
+		ahg.logger.InfoMsg("Could not decode app hash announcement from peer", "peer_id", peer.ID(),
+			structure.ErrorKey, err)
+		ahg.Switch.StopPeerForError(peer, err)
+		return
+	}
+	// We may not retain msgBytes
+	peerAppHash := append(binary.HexBytes(nil), announcement.AppHash...)
+	ahg.Lock()
+	defer ahg.Unlock()
+	if announcement.Height <= ahg.height {
+		ahg.compare(announcement.Height, peer.ID(), peerAppHash)
+		return
+	}
+	// Don't let peers make us hold onto announcements arbitrarily far ahead of us
+	if announcement.Height > ahg.height+AppHashRetainHeights {
+		return
+	}
+	peers, ok := ahg.pending[announcement.Height]
+	if !ok {
+		peers = make(map[p2p.ID]binary.HexBytes)
+		ahg.pending[announcement.Height] = peers
+	}
+	peers[peer.ID()] = peerAppHash
+}
+
+// Record the app hash we computed on committing height, compare it with any that peers have already announced, and
+// announce it to our peers
+func (ahg *AppHashGossip) CommittedAppHash(height uint64, appHash []byte) {
+	ahg.Lock()
+	ahg.height = height
+	ahg.local[height] = appHash
+	for peerID, peerAppHash := range ahg.pending[height] {
+		ahg.compare(height, peerID, peerAppHash)
+	}
+	delete(ahg.pending, height)
+	if height > AppHashRetainHeights {
+		delete(ahg.local, height-AppHashRetainHeights)
+		delete(ahg.pending, height-AppHashRetainHeights)
+	}
+	ahg.Unlock()
+
+	if ahg.Switch == nil {
+		return
+	}
+	bs, err := encoding.Encode(&AppHashAnnouncement{Height: height, AppHash: appHash})
+	if err != nil {
+		ahg.logger.InfoMsg("Could not encode app hash announcement", structure.ErrorKey, err)
+		return
+	}
+	ahg.Switch.Broadcast(AppHashChannel, bs)
+}
+
+// Must hold lock
+func (ahg *AppHashGossip) compare(height uint64, peerID p2p.ID, peerAppHash binary.HexBytes) {
+	appHash, ok := ahg.local[height]
+	if !ok || bytes.Equal(appHash, peerAppHash) {
+		return
+	}
+	ahg.logger.InfoMsg("App hash divergence detected: peer computed a different app hash for a committed block",
+		"height", height,
+		"app_hash", appHash,
+		"peer_app_hash", peerAppHash,
+		"peer_id", peerID)
+	if ahg.emitter == nil {
+		return
+	}
+	err := ahg.emitter.Publish(context.Background(), &AppHashDivergence{
+		Height:      height,
+		AppHash:     appHash,
+		PeerAppHash: peerAppHash,
+		PeerID:      string(peerID),
+	}, query.TagMap{
+		event.EventTypeKey: AppHashDivergenceEventType,
+		event.HeightKey:    height,
+	})
+	if err != nil {
+		ahg.logger.InfoMsg("Error publishing app hash divergence event", "height", height, structure.ErrorKey, err)
+	}
+}
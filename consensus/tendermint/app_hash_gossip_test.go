@@ -0,0 +1,51 @@
+package tendermint
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p/mock"
+)
+
+func TestAppHashGossip(t *testing.T) {
+	emitter := event.NewEmitter()
+	events, err := emitter.Subscribe(context.Background(), "test", AppHashDivergenceQuery, 10)
+	require.NoError(t, err)
+
+	ahg := NewAppHashGossip(emitter, logging.NewNoopLogger())
+	peer := mock.NewPeer(net.IP{127, 0, 0, 1})
+	announce := func(height uint64, appHash []byte) {
+		bs, err := encoding.Encode(&AppHashAnnouncement{Height: height, AppHash: appHash})
+		require.NoError(t, err)
+		ahg.Receive(AppHashChannel, peer, bs)
+	}
+
+	// Agreeing peer ahead of us
+	announce(1, []byte{1})
+	ahg.CommittedAppHash(1, []byte{1})
+	// Agreeing peer behind us
+	ahg.CommittedAppHash(2, []byte{2})
+	announce(2, []byte{2})
+	assert.Len(t, events, 0)
+
+	// Diverging peer behind us
+	announce(1, []byte{0xFF})
+	ev := (<-events).(*AppHashDivergence)
+	assert.Equal(t, uint64(1), ev.Height)
+	assert.Equal(t, []byte{1}, ev.AppHash.Bytes())
+	assert.Equal(t, []byte{0xFF}, ev.PeerAppHash.Bytes())
+	assert.Equal(t, string(peer.ID()), ev.PeerID)
+
+	// Diverging peer ahead of us
+	announce(3, []byte{0xFF})
+	assert.Len(t, events, 0)
+	ahg.CommittedAppHash(3, []byte{3})
+	ev = (<-events).(*AppHashDivergence)
+	assert.Equal(t, uint64(3), ev.Height)
+}
@@ -15,6 +15,8 @@ import (
 const (
 	NeverCreateEmptyBlocks  = "never"
 	AlwaysCreateEmptyBlocks = "always"
+	// The largest transaction accepted by the mempool
+	MaxTxBytes = 1024 * 1024 * 4 // 4MB
 )
 
 // Burrow's view on Tendermint's config. Since we operate as a Tendermint harness not all configuration values
@@ -44,6 +46,12 @@ type BurrowTendermintConfig struct {
 	// "", "never" (to never create unnecessary blocks)
 	// "always" (to create empty blocks each consensus round)
 	CreateEmptyBlocks string
+	// Authenticated encryption profile for peer connections and scheduled node key rotations
+	P2PEncryption *P2PEncryptionConfig `json:",omitempty" toml:",omitempty"`
+	// Gossip the app hash computed for each block with peers (that have also enabled it) and alert on divergence
+	AppHashGossip bool `json:",omitempty" toml:",omitempty"`
+	// Relay transactions submitted to this node through a Dandelion stem before they are broadcast to all peers
+	Dandelion *DandelionConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
@@ -58,6 +66,7 @@ func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
 		ListenPort:        url.Port(),
 		ExternalAddress:   tmDefaultConfig.P2P.ExternalAddress,
 		CreateEmptyBlocks: "5m",
+		P2PEncryption:     DefaultP2PEncryptionConfig(),
 	}
 }
 
@@ -73,7 +82,7 @@ func (btc *BurrowTendermintConfig) Config(rootDir string, timeoutFactor float64)
 		// This creates load on leveldb for no purpose. The default indexer is "kv" and allows retrieval the TxResult
 		// for which we use use TxReceipt (returned from ABCI DeliverTx) - we have our own much richer index
 		conf.TxIndex.Indexer = "null"
-		conf.Mempool.MaxTxBytes = 1024 * 1024 * 4 // 4MB
+		conf.Mempool.MaxTxBytes = MaxTxBytes
 
 		// Consensus
 		switch strings.ToLower(btc.CreateEmptyBlocks) {
@@ -111,6 +120,10 @@ func (btc *BurrowTendermintConfig) Config(rootDir string, timeoutFactor float64)
 		conf.P2P.AddrBookStrict = btc.AddrBookStrict
 		// We use this in tests and I am not aware of a strong reason to reject nodes on the same IP with different ports
 		conf.P2P.AllowDuplicateIP = true
+		err := btc.P2PEncryption.apply(conf)
+		if err != nil {
+			return nil, err
+		}
 
 		// Unfortunately this stops metrics from being used at all
 		conf.Instrumentation.Prometheus = false
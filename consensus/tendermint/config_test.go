@@ -1,9 +1,13 @@
 package tendermint
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -50,3 +54,46 @@ func TestDefaultBurrowTendermintConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, true, tmConf.FilterPeers)
 }
+
+func TestP2PEncryptionConfig(t *testing.T) {
+	btc := DefaultBurrowTendermintConfig()
+	btc.P2PEncryption.HandshakeTimeout = "7s"
+	tmConf, err := btc.Config(".burrow", 0.33)
+	require.NoError(t, err)
+	assert.Equal(t, 7*time.Second, tmConf.P2P.HandshakeTimeout)
+
+	btc.P2PEncryption.Cipher = "aes128-gcm"
+	_, err = btc.Config(".burrow", 0.33)
+	require.Error(t, err)
+	btc.P2PEncryption.Cipher = ChaCha20Poly1305Cipher
+
+	dir, err := ioutil.TempDir("", "node-key-rotation")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	nextKey := NewNodeKey()
+	nextKeyBytes, err := cdc.MarshalJSON(nextKey)
+	require.NoError(t, err)
+	require.NoError(t, WriteNodeKey(filepath.Join(dir, "next_node_key.json"), nextKeyBytes))
+
+	btc.P2PEncryption.NodeKeyRotations = []*NodeKeyRotation{{Height: 10, NodeKeyFile: "next_node_key.json"}}
+	current := "config/node_key.json"
+
+	nodeKeyFile, err := btc.P2PEncryption.NodeKeyFile(dir, current, 9, nil)
+	require.NoError(t, err)
+	assert.Equal(t, current, nodeKeyFile)
+
+	nodeKeyFile, err = btc.P2PEncryption.NodeKeyFile(dir, current, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "next_node_key.json"), nodeKeyFile)
+
+	// Not yet registered so we stay on the current key
+	registered := abci.NewPeerLists()
+	nodeKeyFile, err = btc.P2PEncryption.NodeKeyFile(dir, current, 10, registered)
+	require.NoError(t, err)
+	assert.Equal(t, current, nodeKeyFile)
+
+	registered.IDs[string(nextKey.ID())] = struct{}{}
+	nodeKeyFile, err = btc.P2PEncryption.NodeKeyFile(dir, current, 10, registered)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "next_node_key.json"), nodeKeyFile)
+}
@@ -0,0 +1,269 @@
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/consensus/tendermint/codes"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/mempool"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// The p2p channel over which transactions are relayed in their stem phase
+	DandelionChannel = byte(0x71)
+	// The name under which the Dandelion relay reactor is registered with the p2p switch
+	DandelionReactorName = "DANDELION"
+
+	DefaultDandelionFluffProbability = 0.1
+	DefaultDandelionEmbargo          = 30 * time.Second
+	DefaultDandelionEpoch            = 10 * time.Minute
+	// The most transactions we hold under embargo, beyond which we broadcast transactions rather than relay them
+	MaxDandelionRelayed = 10000
+)
+
+// Relays transactions submitted to this node through a stem of single peers before they are broadcast to the network
+type DandelionConfig struct {
+	Enabled bool
+	// The probability that a peer relaying a transaction in its stem phase broadcasts (fluffs) it rather than passing it
+	// on to its own stem peer (DefaultDandelionFluffProbability if zero)
+	FluffProbability float64 `json:",omitempty" toml:",omitempty"`
+	// How long to wait for a transaction we have relayed to be broadcast before broadcasting it ourselves (e.g. 30s)
+	Embargo string `json:",omitempty" toml:",omitempty"`
+	// How long we keep relaying transactions to the same stem peer before choosing another at random (e.g. 10m)
+	Epoch string `json:",omitempty" toml:",omitempty"`
+}
+
+// Dandelion is a p2p reactor implementing Dandelion-style transaction relay. Rather than adding transactions submitted
+// to this node to the mempool, from which they are gossiped to every peer, we check them and pass them to a single stem
+// peer. Each peer receiving a transaction on the stem checks it then passes it on to its own stem peer or, with
+// FluffProbability, adds it to its mempool so that it is broadcast from there. An observer of the broadcast therefore cannot tell which
+// node on the stem the transaction originated from. Should a transaction we relay not be broadcast within the embargo
+// (if a stem peer drops it) we add it to our own mempool.
+type Dandelion struct {
+	p2p.BaseReactor
+	sync.Mutex
+	checkTx          func(abciTypes.RequestCheckTx) abciTypes.ResponseCheckTx
+	mempool          mempool.Mempool
+	fluffProbability float64
+	embargo          time.Duration
+	epoch            time.Duration
+	// Connected peers that support Dandelion relay
+	peers map[p2p.ID]p2p.Peer
+	// The peer to which we relay transactions in the current epoch
+	stem       p2p.Peer
+	stemChosen time.Time
+	// Transactions we have relayed in their stem phase and are yet to be broadcast by the embargo
+	relayed map[string]*time.Timer
+	random  *rand.Rand
+	logger  *logging.Logger
+}
+
+// Returns a Dandelion relay that checks the transactions it relays with checkTx, which must leave a transaction it has
+// checked to be accepted by the mempool's own check when the transaction is broadcast (as abci.App.CheckStemTx does).
+// The mempool must be set with SetMempool before the relay is used.
+func NewDandelion(conf *DandelionConfig, checkTx func(abciTypes.RequestCheckTx) abciTypes.ResponseCheckTx,
+	logger *logging.Logger) (*Dandelion, error) {
+	dl := &Dandelion{
+		checkTx:          checkTx,
+		fluffProbability: conf.FluffProbability,
+		embargo:          DefaultDandelionEmbargo,
+		epoch:            DefaultDandelionEpoch,
+		peers:            make(map[p2p.ID]p2p.Peer),
+		relayed:          make(map[string]*time.Timer),
+		random:           rand.New(rand.NewSource(time.Now().UnixNano())),
+		logger:           logger.WithScope("Dandelion"),
+	}
+	if dl.fluffProbability == 0 {
+		dl.fluffProbability = DefaultDandelionFluffProbability
+	}
+	if dl.fluffProbability < 0 || dl.fluffProbability > 1 {
+		return nil, fmt.Errorf("dandelion FluffProbability must be between 0 and 1 but is %v", conf.FluffProbability)
+	}
+	var err error
+	if conf.Embargo != "" {
+		dl.embargo, err = time.ParseDuration(conf.Embargo)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse dandelion Embargo '%s' as duration: %v", conf.Embargo, err)
+		}
+	}
+	if conf.Epoch != "" {
+		dl.epoch, err = time.ParseDuration(conf.Epoch)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse dandelion Epoch '%s' as duration: %v", conf.Epoch, err)
+		}
+	}
+	dl.BaseReactor = *p2p.NewBaseReactor("Dandelion", dl)
+	return dl, nil
+}
+
+// Sets the mempool to which transactions are added when they are broadcast
+func (dl *Dandelion) SetMempool(mp mempool.Mempool) {
+	dl.Lock()
+	defer dl.Unlock()
+	dl.mempool = mp
+}
+
+func (dl *Dandelion) currentMempool() mempool.Mempool {
+	dl.Lock()
+	defer dl.Unlock()
+	return dl.mempool
+}
+
+// Allows the relay to be carried over to a rebuilt node, peers having been removed as the old node stopped. Embargoes
+// keep running so that the transactions we relayed are still broadcast should the stem drop them.
+func (dl *Dandelion) OnReset() error {
+	return nil
+}
+
+func (dl *Dandelion) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{{
+		ID:                  DandelionChannel,
+		Priority:            5,
+		SendQueueCapacity:   100,
+		RecvMessageCapacity: MaxTxBytes,
+	}}
+}
+
+func (dl *Dandelion) AddPeer(peer p2p.Peer) {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok || bytes.IndexByte(ni.Channels, DandelionChannel) < 0 {
+		return
+	}
+	dl.Lock()
+	defer dl.Unlock()
+	dl.peers[peer.ID()] = peer
+}
+
+func (dl *Dandelion) RemovePeer(peer p2p.Peer, reason interface{}) {
+	dl.Lock()
+	defer dl.Unlock()
+	delete(dl.peers, peer.ID())
+	if dl.stem != nil && dl.stem.ID() == peer.ID() {
+		dl.stem = nil
+	}
+}
+
+// CheckTx can be used in place of the mempool's CheckTx to submit transactions through the stem. If we have no stem
+// peer the transaction is added to the mempool directly.
+func (dl *Dandelion) CheckTx(tx tmTypes.Tx, callback func(*abciTypes.Response), txInfo mempool.TxInfo) error {
+	stem := dl.stemPeer(nil)
+	if stem == nil {
+		return dl.currentMempool().CheckTx(tx, callback, txInfo)
+	}
+	res := dl.check(tx)
+	if callback != nil {
+		callback(abciTypes.ToResponseCheckTx(res))
+	}
+	if res.Code == codes.TxExecutionSuccessCode {
+		dl.relay(tx, stem)
+	}
+	return nil
+}
+
+func (dl *Dandelion) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
+	// We may not retain msgBytes
+	tx := append(tmTypes.Tx(nil), msgBytes...)
+	dl.Lock()
+	// A transaction that comes back to us has looped around the stem so must be broadcast
+	_, looped := dl.relayed[string(tx.Hash())]
+	fluff := looped || dl.random.Float64() < dl.fluffProbability
+	dl.Unlock()
+	if !looped {
+		// Peers must not be able to have us relay transactions that would never be accepted
+		res := dl.check(tx)
+		if res.Code != codes.TxExecutionSuccessCode {
+			dl.logger.TraceMsg("Dropping invalid transaction relayed by peer", "tendermint_tx_hash", tx.Hash(),
+				"peer_id", peer.ID(), "code", res.Code, "log", res.Log)
+			return
+		}
+	}
+	if !fluff {
+		stem := dl.stemPeer(peer)
+		if stem != nil {
+			dl.relay(tx, stem)
+			return
+		}
+	}
+	dl.fluff(tx, peer.ID())
+}
+
+// Checks tx holding the mempool lock as the mempool does to serialise checks against the check state
+func (dl *Dandelion) check(tx tmTypes.Tx) abciTypes.ResponseCheckTx {
+	mp := dl.currentMempool()
+	mp.Lock()
+	defer mp.Unlock()
+	return dl.checkTx(abciTypes.RequestCheckTx{Tx: tx})
+}
+
+// Returns the stem peer to which to relay a transaction received from peer (nil if it originated with us), choosing a
+// new one at random if the epoch has ended, or nil if we have none
+func (dl *Dandelion) stemPeer(from p2p.Peer) p2p.Peer {
+	dl.Lock()
+	defer dl.Unlock()
+	if dl.stem == nil || time.Since(dl.stemChosen) >= dl.epoch {
+		dl.stem = dl.randomPeer(nil)
+		dl.stemChosen = time.Now()
+	}
+	if dl.stem != nil && from != nil && dl.stem.ID() == from.ID() {
+		// Don't pass a transaction straight back to the peer that sent it
+		return dl.randomPeer(from)
+	}
+	return dl.stem
+}
+
+// Must hold lock
+func (dl *Dandelion) randomPeer(exclude p2p.Peer) p2p.Peer {
+	candidates := make([]p2p.Peer, 0, len(dl.peers))
+	for _, peer := range dl.peers {
+		if exclude == nil || peer.ID() != exclude.ID() {
+			candidates = append(candidates, peer)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return candidates[dl.random.Intn(len(candidates))]
+}
+
+// Passes tx on to stem and starts its embargo if it is not already running, or broadcasts it if we already hold
+// MaxDandelionRelayed transactions under embargo
+func (dl *Dandelion) relay(tx tmTypes.Tx, stem p2p.Peer) {
+	key := string(tx.Hash())
+	dl.Lock()
+	if _, ok := dl.relayed[key]; !ok {
+		if len(dl.relayed) >= MaxDandelionRelayed {
+			dl.Unlock()
+			dl.fluff(tx, "")
+			return
+		}
+		dl.relayed[key] = time.AfterFunc(dl.embargo, func() {
+			dl.Lock()
+			delete(dl.relayed, key)
+			dl.Unlock()
+			// Has no effect if the transaction has already been broadcast since it is then in the mempool's cache
+			dl.fluff(tx, "")
+		})
+	}
+	dl.Unlock()
+	if !stem.Send(DandelionChannel, tx) {
+		dl.fluff(tx, "")
+	}
+}
+
+// Adds tx to the mempool from which it is broadcast to all our peers
+func (dl *Dandelion) fluff(tx tmTypes.Tx, from p2p.ID) {
+	err := dl.currentMempool().CheckTx(tx, nil, mempool.TxInfo{SenderP2PID: from})
+	if err != nil {
+		dl.logger.TraceMsg("Could not add relayed transaction to mempool", "tendermint_tx_hash", tx.Hash(),
+			structure.ErrorKey, err)
+	}
+}
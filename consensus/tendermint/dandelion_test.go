@@ -0,0 +1,252 @@
+package tendermint
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/consensus/abci"
+	"github.com/hyperledger/burrow/consensus/tendermint/codes"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/mempool"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/mock"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDandelion(t *testing.T) {
+	tx := tmTypes.Tx("tx")
+
+	t.Run("StemsLocalTransactions", func(t *testing.T) {
+		dl, mp := newTestDandelion(t, &DandelionConfig{Enabled: true})
+		peer := newDandelionPeer(true)
+		dl.AddPeer(peer)
+		dl.AddPeer(newDandelionPeer(false))
+
+		var res *abciTypes.Response
+		err := dl.CheckTx(tx, func(r *abciTypes.Response) { res = r }, mempool.TxInfo{})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, codes.TxExecutionSuccessCode, res.GetCheckTx().Code)
+		assert.Equal(t, []tmTypes.Tx{tx}, peer.sent())
+		assert.Empty(t, mp.checked())
+	})
+
+	t.Run("BroadcastsWithoutStemPeer", func(t *testing.T) {
+		dl, mp := newTestDandelion(t, &DandelionConfig{Enabled: true})
+		dl.AddPeer(newDandelionPeer(false))
+		require.NoError(t, dl.CheckTx(tx, nil, mempool.TxInfo{}))
+		assert.Equal(t, []tmTypes.Tx{tx}, mp.checked())
+	})
+
+	t.Run("RelaysOrFluffs", func(t *testing.T) {
+		// A tiny probability is treated as never fluffing
+		dl, mp := newTestDandelion(t, &DandelionConfig{Enabled: true, FluffProbability: 1e-12})
+		from, stem := newDandelionPeer(true), newDandelionPeer(true)
+		dl.AddPeer(from)
+		dl.AddPeer(stem)
+		dl.Receive(DandelionChannel, from, tx)
+		// Never relayed straight back to the sender
+		assert.Empty(t, from.sent())
+		assert.Equal(t, []tmTypes.Tx{tx}, stem.sent())
+		assert.Empty(t, mp.checked())
+
+		// Broadcast once it loops back to us
+		dl.Receive(DandelionChannel, stem, tx)
+		assert.Equal(t, []tmTypes.Tx{tx}, mp.checked())
+
+		dl, mp = newTestDandelion(t, &DandelionConfig{Enabled: true, FluffProbability: 1})
+		dl.AddPeer(from)
+		dl.AddPeer(stem)
+		dl.Receive(DandelionChannel, from, tx)
+		assert.Equal(t, []tmTypes.Tx{tx}, mp.checked())
+	})
+
+	t.Run("Embargo", func(t *testing.T) {
+		dl, mp := newTestDandelion(t, &DandelionConfig{Enabled: true, Embargo: "10ms"})
+		dl.AddPeer(newDandelionPeer(true))
+		require.NoError(t, dl.CheckTx(tx, nil, mempool.TxInfo{}))
+		assert.Empty(t, mp.checked())
+		require.Eventually(t, func() bool { return len(mp.checked()) == 1 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("BroadcastsOwnTransactionsAfterEmbargo", func(t *testing.T) {
+		// The check state rejects a transaction executed twice as it would on its sequence number
+		app := abci.NewApp("", nil, nil, newSequenceChecker(), nil, txs.NewProtobufCodec(), nil, nil,
+			logging.NewNoopLogger())
+		dl, err := NewDandelion(&DandelionConfig{Enabled: true, Embargo: "10ms"}, app.CheckStemTx,
+			logging.NewNoopLogger())
+		require.NoError(t, err)
+		mp := &dandelionMempool{checkTx: app.CheckTx}
+		dl.SetMempool(mp)
+		dl.AddPeer(newDandelionPeer(true))
+
+		tx := encodeTestTx(t)
+		var res *abciTypes.Response
+		require.NoError(t, dl.CheckTx(tx, func(r *abciTypes.Response) { res = r }, mempool.TxInfo{}))
+		assert.Equal(t, codes.TxExecutionSuccessCode, res.GetCheckTx().Code)
+		assert.Empty(t, mp.checked())
+		// The mempool accepts the transaction we checked when the embargo expires
+		require.Eventually(t, func() bool { return len(mp.checked()) == 1 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("DropsInvalidStemTransactions", func(t *testing.T) {
+		dl, err := NewDandelion(&DandelionConfig{Enabled: true, FluffProbability: 1e-12},
+			func(req abciTypes.RequestCheckTx) abciTypes.ResponseCheckTx {
+				return abciTypes.ResponseCheckTx{Code: codes.TxExecutionErrorCode}
+			}, logging.NewNoopLogger())
+		require.NoError(t, err)
+		mp := new(dandelionMempool)
+		dl.SetMempool(mp)
+		from, stem := newDandelionPeer(true), newDandelionPeer(true)
+		dl.AddPeer(from)
+		dl.AddPeer(stem)
+		dl.Receive(DandelionChannel, from, tx)
+		assert.Empty(t, stem.sent())
+		assert.Empty(t, mp.checked())
+	})
+
+	t.Run("BroadcastsBeyondMaxRelayed", func(t *testing.T) {
+		dl, mp := newTestDandelion(t, &DandelionConfig{Enabled: true})
+		stem := newDandelionPeer(true)
+		dl.AddPeer(stem)
+		for i := 0; i < MaxDandelionRelayed; i++ {
+			dl.relayed[string(rune(i))] = nil
+		}
+		require.NoError(t, dl.CheckTx(tx, nil, mempool.TxInfo{}))
+		assert.Empty(t, stem.sent())
+		assert.Equal(t, []tmTypes.Tx{tx}, mp.checked())
+	})
+
+	t.Run("Config", func(t *testing.T) {
+		_, err := NewDandelion(&DandelionConfig{FluffProbability: 2}, nil, logging.NewNoopLogger())
+		require.Error(t, err)
+		_, err = NewDandelion(&DandelionConfig{Embargo: "soon"}, nil, logging.NewNoopLogger())
+		require.Error(t, err)
+	})
+}
+
+func newTestDandelion(t *testing.T, conf *DandelionConfig) (*Dandelion, *dandelionMempool) {
+	dl, err := NewDandelion(conf, func(req abciTypes.RequestCheckTx) abciTypes.ResponseCheckTx {
+		return abciTypes.ResponseCheckTx{Code: codes.TxExecutionSuccessCode}
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	mp := new(dandelionMempool)
+	dl.SetMempool(mp)
+	return dl, mp
+}
+
+// A peer that records the transactions relayed to it
+type dandelionPeer struct {
+	*mock.Peer
+	channels []byte
+	sync.Mutex
+	txs []tmTypes.Tx
+}
+
+func newDandelionPeer(dandelion bool) *dandelionPeer {
+	peer := &dandelionPeer{Peer: mock.NewPeer(net.IP{127, 0, 0, 1})}
+	if dandelion {
+		peer.channels = []byte{DandelionChannel}
+	}
+	return peer
+}
+
+func (dp *dandelionPeer) NodeInfo() p2p.NodeInfo {
+	ni := dp.Peer.NodeInfo().(p2p.DefaultNodeInfo)
+	ni.Channels = dp.channels
+	return ni
+}
+
+func (dp *dandelionPeer) Send(chID byte, msgBytes []byte) bool {
+	dp.Lock()
+	defer dp.Unlock()
+	dp.txs = append(dp.txs, msgBytes)
+	return true
+}
+
+func (dp *dandelionPeer) sent() []tmTypes.Tx {
+	dp.Lock()
+	defer dp.Unlock()
+	return dp.txs
+}
+
+// A mempool that records the transactions added to it, which must pass checkTx if it is set
+type dandelionMempool struct {
+	mempool.Mempool
+	checkTx  func(abciTypes.RequestCheckTx) abciTypes.ResponseCheckTx
+	proxyMtx sync.Mutex
+	mtx      sync.Mutex
+	txs      []tmTypes.Tx
+}
+
+func (dm *dandelionMempool) CheckTx(tx tmTypes.Tx, callback func(*abciTypes.Response), txInfo mempool.TxInfo) error {
+	if dm.checkTx != nil {
+		dm.Lock()
+		res := dm.checkTx(abciTypes.RequestCheckTx{Tx: tx})
+		dm.Unlock()
+		if res.Code != codes.TxExecutionSuccessCode {
+			return nil
+		}
+	}
+	dm.mtx.Lock()
+	defer dm.mtx.Unlock()
+	dm.txs = append(dm.txs, tx)
+	return nil
+}
+
+func (dm *dandelionMempool) Lock() {
+	dm.proxyMtx.Lock()
+}
+
+func (dm *dandelionMempool) Unlock() {
+	dm.proxyMtx.Unlock()
+}
+
+func (dm *dandelionMempool) checked() []tmTypes.Tx {
+	dm.mtx.Lock()
+	defer dm.mtx.Unlock()
+	return dm.txs
+}
+
+// A checker that rejects transactions it has already executed
+type sequenceChecker struct {
+	sync.Mutex
+	acmstate.Reader
+	executed map[string]bool
+}
+
+func newSequenceChecker() *sequenceChecker {
+	return &sequenceChecker{executed: make(map[string]bool)}
+}
+
+func (sc *sequenceChecker) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	key := txEnv.Tx.Hash().String()
+	if sc.executed[key] {
+		return nil, errors.Errorf(errors.Codes.InvalidSequence, "transaction %s already executed", key)
+	}
+	sc.executed[key] = true
+	return exec.NewTxExecution(txEnv), nil
+}
+
+func (sc *sequenceChecker) Reset() error {
+	return nil
+}
+
+func encodeTestTx(t *testing.T) tmTypes.Tx {
+	tx := payload.NewSendTx()
+	tx.Inputs = append(tx.Inputs, &payload.TxInput{Address: crypto.Address{1}, Amount: 1, Sequence: 1})
+	bs, err := txs.NewProtobufCodec().EncodeTx(txs.Enclose("TestChain", tx))
+	require.NoError(t, err)
+	return bs
+}
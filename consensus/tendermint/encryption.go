@@ -0,0 +1,188 @@
+package tendermint
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/consensus/abci"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	tmConfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// The authenticated encryption scheme implemented by Tendermint's SecretConnection (X25519 key agreement, ChaCha20-Poly1305
+// AEAD, with ed25519 node keys authenticating the handshake)
+const ChaCha20Poly1305Cipher = "chacha20poly1305"
+
+// Parameters for the authenticated encryption of p2p connections between nodes
+type P2PEncryptionConfig struct {
+	// The AEAD cipher used for peer connections - currently only "chacha20poly1305" is supported by the underlying
+	// transport, but we make the choice explicit so that a network can pin its profile and refuse to start on mismatch
+	Cipher string `json:",omitempty" toml:",omitempty"`
+	// The time allowed for a peer to complete the authenticated handshake (e.g. 20s)
+	HandshakeTimeout string `json:",omitempty" toml:",omitempty"`
+	// The time allowed for dialling a peer (e.g. 3s)
+	DialTimeout string `json:",omitempty" toml:",omitempty"`
+	// Scheduled rotations of this node's transport key
+	NodeKeyRotations []*NodeKeyRotation `json:",omitempty" toml:",omitempty"`
+}
+
+// Schedules the replacement of the node key from a particular height. The node ID derived from the new key should be
+// registered on chain (with an IdentifyTx signed by the validator key) before the activation height so that peers
+// filtering by the node registry continue to accept us. A running node rotates its key by restarting its connection to
+// the network once the rotation is due.
+type NodeKeyRotation struct {
+	// The first height at which NodeKeyFile should be used
+	Height uint64
+	// The node key file to use from Height (relative to the burrow directory unless absolute)
+	NodeKeyFile string
+}
+
+func DefaultP2PEncryptionConfig() *P2PEncryptionConfig {
+	return &P2PEncryptionConfig{
+		Cipher: ChaCha20Poly1305Cipher,
+	}
+}
+
+// Validates the encryption profile and applies it to the Tendermint P2P config
+func (pec *P2PEncryptionConfig) apply(conf *tmConfig.Config) error {
+	if pec == nil {
+		return nil
+	}
+	switch strings.ToLower(pec.Cipher) {
+	case "", ChaCha20Poly1305Cipher:
+	default:
+		return fmt.Errorf("p2p cipher '%s' is not supported, expected '%s'", pec.Cipher, ChaCha20Poly1305Cipher)
+	}
+	var err error
+	if pec.HandshakeTimeout != "" {
+		conf.P2P.HandshakeTimeout, err = time.ParseDuration(pec.HandshakeTimeout)
+		if err != nil {
+			return fmt.Errorf("could not parse HandshakeTimeout '%s' as duration: %v", pec.HandshakeTimeout, err)
+		}
+	}
+	if pec.DialTimeout != "" {
+		conf.P2P.DialTimeout, err = time.ParseDuration(pec.DialTimeout)
+		if err != nil {
+			return fmt.Errorf("could not parse DialTimeout '%s' as duration: %v", pec.DialTimeout, err)
+		}
+	}
+	heights := make(map[uint64]struct{}, len(pec.NodeKeyRotations))
+	for _, rotation := range pec.NodeKeyRotations {
+		if rotation.NodeKeyFile == "" {
+			return fmt.Errorf("node key rotation at height %d has no NodeKeyFile", rotation.Height)
+		}
+		if _, ok := heights[rotation.Height]; ok {
+			return fmt.Errorf("more than one node key rotation scheduled at height %d", rotation.Height)
+		}
+		heights[rotation.Height] = struct{}{}
+	}
+	return nil
+}
+
+// Returns the node key file that should be in use at height given the scheduled rotations. When authorized is non-nil a
+// rotation is only applied once its node ID is authorised (i.e. registered on chain), otherwise we stay on the last
+// authorised key so that we are never cut off from peers that filter by the node registry.
+func (pec *P2PEncryptionConfig) NodeKeyFile(rootDir, defaultNodeKeyFile string, height uint64,
+	authorized abci.AuthorizedPeers) (string, error) {
+	if pec == nil || len(pec.NodeKeyRotations) == 0 {
+		return defaultNodeKeyFile, nil
+	}
+	rotations := make([]*NodeKeyRotation, len(pec.NodeKeyRotations))
+	copy(rotations, pec.NodeKeyRotations)
+	sort.Slice(rotations, func(i, j int) bool {
+		return rotations[i].Height > rotations[j].Height
+	})
+	for _, rotation := range rotations {
+		if rotation.Height > height {
+			continue
+		}
+		nodeKeyFile := rotation.NodeKeyFile
+		if !filepath.IsAbs(nodeKeyFile) {
+			nodeKeyFile = filepath.Join(rootDir, nodeKeyFile)
+		}
+		if authorized == nil {
+			return nodeKeyFile, nil
+		}
+		nodeKey, err := p2p.LoadNodeKey(nodeKeyFile)
+		if err != nil {
+			return "", fmt.Errorf("could not load node key for rotation at height %d: %v", rotation.Height, err)
+		}
+		if authorized.QueryPeerByID(string(nodeKey.ID())) {
+			return nodeKeyFile, nil
+		}
+	}
+	return defaultNodeKeyFile, nil
+}
+
+// NodeKeyRotator rotates the node key of a running node as its scheduled rotations fall due
+type NodeKeyRotator struct {
+	config             *P2PEncryptionConfig
+	rootDir            string
+	defaultNodeKeyFile string
+	// The node key file currently in use
+	nodeKeyFile string
+	blockchain  interface{ LastBlockHeight() uint64 }
+	authorized  abci.AuthorizedPeers
+	node        *Node
+	logger      *logging.Logger
+}
+
+// Returns a NodeKeyRotator for node, which is using nodeKeyFile, where authorized is passed to NodeKeyFile
+func NewNodeKeyRotator(config *P2PEncryptionConfig, rootDir, defaultNodeKeyFile, nodeKeyFile string,
+	blockchain interface{ LastBlockHeight() uint64 }, authorized abci.AuthorizedPeers, node *Node,
+	logger *logging.Logger) *NodeKeyRotator {
+	return &NodeKeyRotator{
+		config:             config,
+		rootDir:            rootDir,
+		defaultNodeKeyFile: defaultNodeKeyFile,
+		nodeKeyFile:        nodeKeyFile,
+		blockchain:         blockchain,
+		authorized:         authorized,
+		node:               node,
+		logger:             logger.WithScope("NodeKeyRotator"),
+	}
+}
+
+// Run checks whether a rotation is due every interval until ctx is done
+func (nkr *NodeKeyRotator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			err := nkr.Rotate()
+			if err != nil {
+				// We will try again on the next tick
+				nkr.logger.InfoMsg("Could not rotate node key", structure.ErrorKey, err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Rotate restarts the node with the node key that should be in use at the last block height if it is not the one in
+// use
+func (nkr *NodeKeyRotator) Rotate() error {
+	height := nkr.blockchain.LastBlockHeight()
+	nodeKeyFile, err := nkr.config.NodeKeyFile(nkr.rootDir, nkr.defaultNodeKeyFile, height, nkr.authorized)
+	if err != nil {
+		return err
+	}
+	if nodeKeyFile == nkr.nodeKeyFile {
+		return nil
+	}
+	nkr.logger.InfoMsg("Rotating node key", "height", height, "node_key_file", nodeKeyFile)
+	err = nkr.node.RotateNodeKey(nodeKeyFile)
+	if err != nil {
+		return err
+	}
+	nkr.nodeKeyFile = nodeKeyFile
+	return nil
+}
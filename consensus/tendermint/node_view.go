@@ -52,7 +52,7 @@ func (nv *NodeView) NodeInfo() *NodeInfo {
 	if nv == nil {
 		return nil
 	}
-	ni, ok := nv.tmNode.NodeInfo().(p2p.DefaultNodeInfo)
+	ni, ok := nv.tmNode.Current().NodeInfo().(p2p.DefaultNodeInfo)
 	if ok {
 		return NewNodeInfo(ni)
 	}
@@ -63,15 +63,15 @@ func (nv *NodeView) IsFastSyncing() bool {
 	if nv == nil {
 		return true
 	}
-	return nv.tmNode.ConsensusReactor().FastSync()
+	return nv.tmNode.Current().ConsensusReactor().FastSync()
 }
 
 func (nv *NodeView) Peers() p2p.IPeerSet {
-	return nv.tmNode.Switch().Peers()
+	return nv.tmNode.Current().Switch().Peers()
 }
 
 func (nv *NodeView) BlockStore() state.BlockStoreRPC {
-	return nv.tmNode.BlockStore()
+	return nv.tmNode.Current().BlockStore()
 }
 
 func (nv *NodeView) RunID() simpleuuid.UUID {
@@ -95,15 +95,15 @@ func (nv *NodeView) MempoolTransactions(maxTxs int) ([]*txs.Envelope, error) {
 }
 
 func (nv *NodeView) RoundState() *ctypes.RoundState {
-	return nv.tmNode.ConsensusState().GetRoundState()
+	return nv.tmNode.Current().ConsensusState().GetRoundState()
 }
 
 func (nv *NodeView) RoundStateJSON() ([]byte, error) {
-	return nv.tmNode.ConsensusState().GetRoundStateJSON()
+	return nv.tmNode.Current().ConsensusState().GetRoundStateJSON()
 }
 
 func (nv *NodeView) PeerRoundStates() ([]*ctypes.PeerRoundState, error) {
-	peers := nv.tmNode.Switch().Peers().List()
+	peers := nv.tmNode.Current().Switch().Peers().List()
 	peerRoundStates := make([]*ctypes.PeerRoundState, len(peers))
 	for i, peer := range peers {
 		peerState, ok := peer.Get(types.PeerStateKey).(*consensus.PeerState)
@@ -1,10 +1,13 @@
 package tendermint
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"sync"
 
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/abci"
@@ -14,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/proxy"
@@ -21,12 +25,28 @@ import (
 	dbm "github.com/tendermint/tm-db"
 )
 
-// Serves as a wrapper around the Tendermint node's closeable resources (database connections)
+// Serves as a wrapper around the Tendermint node's closeable resources (database connections) and holds what is needed
+// to rebuild the node with a new node key
 type Node struct {
 	*node.Node
-	closers []interface {
+	// Guards replacing the Tendermint node when it is rebuilt
+	sync.RWMutex
+	// Held for the whole of a rotation or shutdown so that neither runs against a half rebuilt node
+	rotation sync.Mutex
+	// Set once the node has been shut down after which it is no longer rotated
+	shutdown bool
+	closers  []interface {
 		Close() error
 	}
+	conf            *config.Config
+	privValidator   tmTypes.PrivValidator
+	genesisDoc      *tmTypes.GenesisDoc
+	app             *abci.App
+	metricsProvider node.MetricsProvider
+	reactors        map[string]p2p.Reactor
+	logger          *logging.Logger
+	// Called with each rebuilt node before it is started
+	rebuilt []func(*node.Node)
 }
 
 func DBProvider(ID string, backendType dbm.BackendType, dbDir string) dbm.DB {
@@ -44,34 +64,147 @@ func (n *Node) Close() {
 	for _, closer := range n.closers {
 		closer.Close()
 	}
+	n.closers = nil
 }
 
+// NewNode builds a Tendermint node serving app, with reactors run alongside Tendermint's own
 func NewNode(conf *config.Config, privValidator tmTypes.PrivValidator, genesisDoc *tmTypes.GenesisDoc,
-	app *abci.App, metricsProvider node.MetricsProvider, logger *logging.Logger) (*Node, error) {
+	app *abci.App, metricsProvider node.MetricsProvider, reactors map[string]p2p.Reactor,
+	logger *logging.Logger) (*Node, error) {
 
-	var err error
 	// disable Tendermint's RPC
 	conf.RPC.ListenAddress = ""
 
-	nodeKey, err := EnsureNodeKey(conf.NodeKeyFile())
+	nde := &Node{
+		conf:            conf,
+		privValidator:   privValidator,
+		genesisDoc:      genesisDoc,
+		app:             app,
+		metricsProvider: metricsProvider,
+		reactors:        reactors,
+		logger:          logger,
+	}
+	var err error
+	nde.Node, err = nde.build()
 	if err != nil {
 		return nil, err
 	}
+	return nde, nil
+}
+
+// Current returns the Tendermint node, which is replaced when the node key is rotated
+func (n *Node) Current() *node.Node {
+	n.RLock()
+	defer n.RUnlock()
+	return n.Node
+}
+
+// Mempool returns the mempool of the current Tendermint node
+func (n *Node) Mempool() mempool.Mempool {
+	return n.Current().Mempool()
+}
+
+// OnRebuild registers a function to be called with the new Tendermint node each time the node is rebuilt (before it is
+// started) so that those holding its mempool or block store can take the new ones
+func (n *Node) OnRebuild(rebuilt func(*node.Node)) {
+	n.rebuilt = append(n.rebuilt, rebuilt)
+}
 
-	nde := &Node{}
-	nde.Node, err = node.NewNode(conf, privValidator,
-		nodeKey, proxy.NewLocalClientCreator(app),
+// RotateNodeKey stops the running node and replaces it with one using the node key in nodeKeyFile. Peers see us
+// disconnect and reconnect with the new node ID. Our own reactors are reset and carried over to the new node.
+func (n *Node) RotateNodeKey(nodeKeyFile string) error {
+	n.rotation.Lock()
+	defer n.rotation.Unlock()
+	if n.shutdown {
+		return fmt.Errorf("cannot rotate node key because node has been shut down")
+	}
+	old := n.Current()
+	// The old node may already be stopped if a previous rotation failed to rebuild it
+	if old.IsRunning() {
+		if old.ConsensusReactor().FastSync() {
+			// Blocks are applied outside of the consensus state machine while fast syncing so we cannot stop cleanly
+			return fmt.Errorf("cannot rotate node key while fast syncing")
+		}
+		err := old.Stop()
+		if err != nil {
+			return fmt.Errorf("could not stop node to rotate node key: %v", err)
+		}
+		<-old.Quit()
+	}
+	n.Close()
+	for name, reactor := range n.reactors {
+		select {
+		case <-reactor.Quit():
+			err := reactor.Reset()
+			if err != nil {
+				return fmt.Errorf("could not reset reactor %s to rotate node key: %v", name, err)
+			}
+		default:
+			// Not stopped so already reset
+		}
+	}
+	conf := *n.conf
+	conf.NodeKey = nodeKeyFile
+	n.conf = &conf
+	nde, err := n.build()
+	if err != nil {
+		return fmt.Errorf("could not rebuild node to rotate node key: %v", err)
+	}
+	for _, rebuilt := range n.rebuilt {
+		rebuilt(nde)
+	}
+	n.Lock()
+	n.Node = nde
+	n.Unlock()
+	return nde.Start()
+}
+
+// Shutdown stops the current node, waiting for it to quit until ctx is done, and closes its database connections. No
+// rotation can run concurrently with or after it.
+func (n *Node) Shutdown(ctx context.Context) error {
+	n.rotation.Lock()
+	defer n.rotation.Unlock()
+	n.shutdown = true
+	nde := n.Current()
+	// Close tendermint database connections using our wrapper
+	defer n.Close()
+	if nde.IsRunning() {
+		err := nde.Stop()
+		if err != nil {
+			return err
+		}
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-nde.Quit():
+		return nil
+	}
+}
+
+func (n *Node) build() (*node.Node, error) {
+	nodeKey, err := EnsureNodeKey(n.conf.NodeKeyFile())
+	if err != nil {
+		return nil, err
+	}
+	var options []node.Option
+	if len(n.reactors) > 0 {
+		options = append(options, node.CustomReactors(n.reactors))
+	}
+	nde, err := node.NewNode(n.conf, n.privValidator,
+		nodeKey, proxy.NewLocalClientCreator(n.app),
 		func() (*tmTypes.GenesisDoc, error) {
-			return genesisDoc, nil
+			return n.genesisDoc, nil
 		},
-		nde.DBProvider,
-		metricsProvider,
-		NewLogger(logger.WithPrefix(structure.ComponentKey, structure.Tendermint).
-			With(structure.ScopeKey, "tendermint.NewNode")))
+		n.DBProvider,
+		n.metricsProvider,
+		NewLogger(n.logger.WithPrefix(structure.ComponentKey, structure.Tendermint).
+			With(structure.ScopeKey, "tendermint.NewNode")),
+		options...)
 	if err != nil {
 		return nil, err
 	}
-	app.SetMempoolLocker(nde.Mempool())
+	n.app.SetMempoolLocker(nde.Mempool())
 	return nde, nil
 }
 
@@ -110,37 +110,147 @@ func (m *NodeInfo) GetTxIndex() string {
 func (*NodeInfo) XXX_MessageName() string {
 	return "tendermint.NodeInfo"
 }
+
+// The app hash a node computed on committing the block at Height, gossiped between nodes that have enabled app hash
+// gossip so that divergent execution is detected as soon as the block is committed
+type AppHashAnnouncement struct {
+	Height               uint64                                        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	AppHash              github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=AppHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"AppHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *AppHashAnnouncement) Reset()         { *m = AppHashAnnouncement{} }
+func (m *AppHashAnnouncement) String() string { return proto.CompactTextString(m) }
+func (*AppHashAnnouncement) ProtoMessage()    {}
+func (*AppHashAnnouncement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{1}
+}
+func (m *AppHashAnnouncement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppHashAnnouncement.Unmarshal(m, b)
+}
+func (m *AppHashAnnouncement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppHashAnnouncement.Marshal(b, m, deterministic)
+}
+func (m *AppHashAnnouncement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppHashAnnouncement.Merge(m, src)
+}
+func (m *AppHashAnnouncement) XXX_Size() int {
+	return xxx_messageInfo_AppHashAnnouncement.Size(m)
+}
+func (m *AppHashAnnouncement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppHashAnnouncement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppHashAnnouncement proto.InternalMessageInfo
+
+func (m *AppHashAnnouncement) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*AppHashAnnouncement) XXX_MessageName() string {
+	return "tendermint.AppHashAnnouncement"
+}
+
+// Published when a peer announces an app hash that differs from the one we computed at the same height
+type AppHashDivergence struct {
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The app hash we computed
+	AppHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=AppHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"AppHash"`
+	// The app hash announced by the peer
+	PeerAppHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=PeerAppHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"PeerAppHash"`
+	// The node ID of the peer
+	PeerID               string   `protobuf:"bytes,4,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppHashDivergence) Reset()         { *m = AppHashDivergence{} }
+func (m *AppHashDivergence) String() string { return proto.CompactTextString(m) }
+func (*AppHashDivergence) ProtoMessage()    {}
+func (*AppHashDivergence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{2}
+}
+func (m *AppHashDivergence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppHashDivergence.Unmarshal(m, b)
+}
+func (m *AppHashDivergence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppHashDivergence.Marshal(b, m, deterministic)
+}
+func (m *AppHashDivergence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppHashDivergence.Merge(m, src)
+}
+func (m *AppHashDivergence) XXX_Size() int {
+	return xxx_messageInfo_AppHashDivergence.Size(m)
+}
+func (m *AppHashDivergence) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppHashDivergence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppHashDivergence proto.InternalMessageInfo
+
+func (m *AppHashDivergence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AppHashDivergence) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (*AppHashDivergence) XXX_MessageName() string {
+	return "tendermint.AppHashDivergence"
+}
 func init() {
 	proto.RegisterType((*NodeInfo)(nil), "tendermint.NodeInfo")
 	golang_proto.RegisterType((*NodeInfo)(nil), "tendermint.NodeInfo")
+	proto.RegisterType((*AppHashAnnouncement)(nil), "tendermint.AppHashAnnouncement")
+	golang_proto.RegisterType((*AppHashAnnouncement)(nil), "tendermint.AppHashAnnouncement")
+	proto.RegisterType((*AppHashDivergence)(nil), "tendermint.AppHashDivergence")
+	golang_proto.RegisterType((*AppHashDivergence)(nil), "tendermint.AppHashDivergence")
 }
 
 func init() { proto.RegisterFile("tendermint.proto", fileDescriptor_04f926c8da23c367) }
 func init() { golang_proto.RegisterFile("tendermint.proto", fileDescriptor_04f926c8da23c367) }
 
 var fileDescriptor_04f926c8da23c367 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x4f, 0xf2, 0x40,
-	0x10, 0xc6, 0xdf, 0xed, 0xab, 0x80, 0x1b, 0x4d, 0x4c, 0x4f, 0x1b, 0x0f, 0x85, 0x18, 0x0f, 0x1c,
-	0xa4, 0x4d, 0xfc, 0xf3, 0x01, 0x84, 0x1e, 0x6c, 0xa2, 0x44, 0x1b, 0xe3, 0xc1, 0x1b, 0xa5, 0x43,
-	0xd9, 0x00, 0x3b, 0x64, 0x77, 0x1b, 0xe8, 0xb7, 0xf3, 0xc8, 0xd5, 0x9b, 0xf1, 0x40, 0x0c, 0x7c,
-	0x11, 0xd3, 0x6d, 0x11, 0xbc, 0xe8, 0x6d, 0x7f, 0xcf, 0xb3, 0x33, 0xf3, 0xec, 0x0e, 0x3d, 0xd6,
-	0x20, 0x62, 0x90, 0x13, 0x2e, 0xb4, 0x3b, 0x95, 0xa8, 0xd1, 0xa6, 0x5b, 0xe5, 0xa4, 0x95, 0x70,
-	0x3d, 0x4c, 0x23, 0xb7, 0x8f, 0x13, 0x2f, 0xc1, 0x04, 0x3d, 0x73, 0x25, 0x4a, 0x07, 0x86, 0x0c,
-	0x98, 0x53, 0x51, 0x7a, 0xfa, 0x66, 0xd1, 0x5a, 0x17, 0x63, 0x08, 0xc4, 0x00, 0x6d, 0x9f, 0x5a,
-	0x81, 0xcf, 0x48, 0x83, 0x34, 0x0f, 0xdb, 0x57, 0x8b, 0x65, 0xfd, 0xdf, 0xc7, 0xb2, 0x7e, 0xbe,
-	0xd3, 0x6f, 0x98, 0x4d, 0x41, 0x8e, 0x21, 0x4e, 0x40, 0x7a, 0x51, 0x2a, 0x25, 0xce, 0xbc, 0xbe,
-	0xcc, 0xa6, 0x1a, 0xdd, 0x9b, 0x38, 0x96, 0xa0, 0x54, 0x68, 0x05, 0xbe, 0x7d, 0x46, 0x8f, 0xee,
-	0xb8, 0xd2, 0x20, 0x4a, 0x91, 0x59, 0x0d, 0xd2, 0x3c, 0x08, 0x7f, 0x8a, 0x36, 0xa3, 0xd5, 0x2e,
-	0xe8, 0x19, 0xca, 0x11, 0xfb, 0x6f, 0xfc, 0x0d, 0xe6, 0xce, 0x33, 0x48, 0xc5, 0x51, 0xb0, 0xbd,
-	0xc2, 0x29, 0xd1, 0x7e, 0xa4, 0xb5, 0xce, 0xb0, 0x27, 0x04, 0x8c, 0x15, 0xdb, 0x37, 0x29, 0xaf,
-	0xcb, 0x94, 0xad, 0xdf, 0x53, 0x46, 0x5c, 0xf4, 0x64, 0xe6, 0xde, 0xc2, 0xbc, 0x9d, 0x69, 0x50,
-	0xe1, 0x77, 0x9b, 0x7c, 0xd8, 0x3d, 0x0a, 0x3e, 0x02, 0xc9, 0x2a, 0xc5, 0xb0, 0x12, 0x6d, 0x87,
-	0xd2, 0xf0, 0xa1, 0xb3, 0x79, 0x43, 0xd5, 0x98, 0x3b, 0x4a, 0x5e, 0xf9, 0x34, 0x0f, 0x44, 0x0c,
-	0x73, 0x56, 0x2b, 0x2a, 0x4b, 0x6c, 0xfb, 0xef, 0x2b, 0x87, 0x7c, 0xae, 0x1c, 0xf2, 0xba, 0x76,
-	0xc8, 0x62, 0xed, 0x90, 0x97, 0x8b, 0x3f, 0x3e, 0x11, 0x85, 0x02, 0xa1, 0x52, 0xe5, 0x6d, 0x17,
-	0x19, 0x55, 0xcc, 0x82, 0x2e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xba, 0x9e, 0x0e, 0xac, 0xef,
-	0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0x71, 0x5a, 0x76, 0x17, 0x03, 0x12, 0x18, 0x09, 0x59, 0x1c, 0xd2, 0x6a, 0xc5, 0xa1,
+	0x07, 0x9a, 0x48, 0xfc, 0x79, 0x80, 0xdd, 0xe6, 0xb0, 0x91, 0xa0, 0x94, 0x08, 0x81, 0xc4, 0x6d,
+	0x93, 0x4c, 0x13, 0xab, 0xdd, 0x71, 0x64, 0x3b, 0x74, 0x73, 0xe1, 0xd9, 0x38, 0xf6, 0xca, 0x0d,
+	0x71, 0xa8, 0x50, 0xf7, 0xcc, 0x3b, 0xa0, 0x38, 0x0e, 0xbb, 0x5c, 0xe0, 0xb0, 0x52, 0x6f, 0xfe,
+	0xcd, 0xe7, 0xf9, 0x66, 0xc6, 0xf2, 0xd0, 0x07, 0x06, 0x30, 0x07, 0xb5, 0x10, 0x68, 0x82, 0x4a,
+	0x49, 0x23, 0x19, 0x5d, 0x47, 0x9e, 0x1c, 0x16, 0xc2, 0x94, 0x75, 0x1a, 0x64, 0x72, 0x11, 0x16,
+	0xb2, 0x90, 0xa1, 0xbd, 0x92, 0xd6, 0xa7, 0x96, 0x2c, 0xd8, 0x53, 0x97, 0x3a, 0xfe, 0xe6, 0xd1,
+	0xd1, 0xb1, 0xcc, 0x21, 0xc6, 0x53, 0xc9, 0x22, 0xea, 0xc5, 0x11, 0x27, 0xfb, 0xe4, 0xe0, 0xde,
+	0xf4, 0xe5, 0xe5, 0xd5, 0xde, 0xad, 0x1f, 0x57, 0x7b, 0xcf, 0x36, 0xfc, 0xca, 0xa6, 0x02, 0x75,
+	0x0e, 0x79, 0x01, 0x2a, 0x4c, 0x6b, 0xa5, 0xe4, 0x45, 0x98, 0xa9, 0xa6, 0x32, 0x32, 0x98, 0xe4,
+	0xb9, 0x02, 0xad, 0x13, 0x2f, 0x8e, 0xd8, 0x53, 0x7a, 0xff, 0xb5, 0xd0, 0x06, 0xd0, 0x05, 0xb9,
+	0xb7, 0x4f, 0x0e, 0xee, 0x24, 0x7f, 0x07, 0x19, 0xa7, 0xc3, 0x63, 0x30, 0x17, 0x52, 0x9d, 0xf1,
+	0x1d, 0xab, 0xf7, 0xd8, 0x2a, 0x1f, 0x40, 0x69, 0x21, 0x91, 0xef, 0x76, 0x8a, 0x43, 0xf6, 0x8e,
+	0x8e, 0x8e, 0xca, 0x39, 0x22, 0x9c, 0x6b, 0x7e, 0xdb, 0x76, 0xf9, 0xca, 0x75, 0x79, 0xf8, 0xef,
+	0x2e, 0x53, 0x81, 0x73, 0xd5, 0x04, 0x33, 0x58, 0x4e, 0x1b, 0x03, 0x3a, 0xf9, 0x63, 0xd3, 0x16,
+	0x7b, 0x23, 0x51, 0x9c, 0x81, 0xe2, 0x83, 0xae, 0x98, 0x43, 0xe6, 0x53, 0x9a, 0x9c, 0x1c, 0xf5,
+	0x33, 0x0c, 0xad, 0xb8, 0x11, 0x69, 0x33, 0xdf, 0x2f, 0x63, 0xcc, 0x61, 0xc9, 0x47, 0x5d, 0xa6,
+	0xc3, 0xf1, 0x17, 0xfa, 0x68, 0x52, 0x55, 0xb3, 0xb9, 0x2e, 0x27, 0x88, 0xb2, 0xc6, 0x0c, 0x16,
+	0x80, 0x86, 0x3d, 0xa6, 0x83, 0x19, 0x88, 0xa2, 0x34, 0xf6, 0x85, 0x77, 0x13, 0x47, 0xec, 0x2d,
+	0x1d, 0xba, 0xeb, 0xdc, 0xdb, 0x66, 0xa8, 0xde, 0x65, 0xfc, 0x8b, 0xd0, 0x87, 0xee, 0x1c, 0x89,
+	0xcf, 0xa0, 0x0a, 0xc0, 0x0c, 0x6e, 0xac, 0x3c, 0xfb, 0x48, 0xef, 0x9e, 0x00, 0xa8, 0xde, 0x74,
+	0x67, 0x1b, 0xd3, 0x4d, 0xa7, 0x76, 0x82, 0x16, 0xe3, 0xc8, 0xfd, 0x0b, 0x47, 0xd3, 0xe8, 0xfb,
+	0xb5, 0x4f, 0x7e, 0x5e, 0xfb, 0xe4, 0xeb, 0xca, 0x27, 0x97, 0x2b, 0x9f, 0x7c, 0x7a, 0xfe, 0x9f,
+	0x4f, 0x2b, 0x51, 0x03, 0xea, 0x5a, 0x87, 0xeb, 0xc5, 0x49, 0x07, 0x76, 0x21, 0x5e, 0xfc, 0x1e,
+	0x00, 0x29, 0xb6, 0x63, 0x06, 0x5f, 0x03, 0x00, 0x00,
 }
 
 func (m *NodeInfo) Size() (n int) {
@@ -183,6 +293,46 @@ func (m *NodeInfo) Size() (n int) {
 	return n
 }
 
+func (m *AppHashAnnouncement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTendermint(uint64(m.Height))
+	}
+	l = m.AppHash.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AppHashDivergence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTendermint(uint64(m.Height))
+	}
+	l = m.AppHash.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	l = m.PeerAppHash.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	l = len(m.PeerID)
+	if l > 0 {
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTendermint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
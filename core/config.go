@@ -1,21 +1,39 @@
 package core
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-kit/kit/log"
+	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/consensus/abci"
+	"github.com/hyperledger/burrow/consensus/ordering"
 	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/breaker"
+	"github.com/hyperledger/burrow/execution/private"
+	"github.com/hyperledger/burrow/execution/redact"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/rpc/feature"
+	"github.com/hyperledger/burrow/rpc/ratelimit"
+	"github.com/hyperledger/burrow/rpc/rpcverify"
 	tmConfig "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
 	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/credentials"
 )
 
 // LoadKeysFromConfig sets the keyClient & keyStore based on the given config
@@ -35,8 +53,30 @@ func (kern *Kernel) LoadKeysFromConfig(conf *keys.KeysConfig) (err error) {
 // LoadLoggerFromConfig adds a logging configuration to the kernel
 func (kern *Kernel) LoadLoggerFromConfig(conf *logconfig.LoggingConfig) error {
 	logger, err := conf.NewLogger()
+	if err != nil {
+		return err
+	}
 	kern.SetLogger(logger)
-	return err
+	kern.LiveLogging = logconfig.NewLive(conf, logger)
+	return nil
+}
+
+// LoadEventsFromConfig replaces the kernel's emitter with one whose resources are bounded by conf, so must be called
+// before the emitter is passed to any other component
+func (kern *Kernel) LoadEventsFromConfig(conf *event.EventsConfig) error {
+	if conf == nil {
+		return nil
+	}
+	emitter, err := event.NewEmitterFromConfig(conf, kern.Logger)
+	if err != nil {
+		return err
+	}
+	err = kern.Emitter.Shutdown(context.Background())
+	if err != nil {
+		return err
+	}
+	kern.Emitter = emitter
+	return nil
 }
 
 // LoadExecutionOptionsFromConfig builds the execution options for the kernel
@@ -47,16 +87,81 @@ func (kern *Kernel) LoadExecutionOptionsFromConfig(conf *execution.ExecutionConf
 			return err
 		}
 		kern.exeOptions = exeOptions
+		if conf.OrderingPolicy != "" && conf.OrderingPolicy != ordering.FIFO {
+			kern.orderingPolicy, err = ordering.PolicyByName(conf.OrderingPolicy)
+			if err != nil {
+				return err
+			}
+			if conf.TimeoutFactor == 0 {
+				return fmt.Errorf("transaction ordering policy '%s' requires a non-zero TimeoutFactor so that "+
+					"transactions can be held until the block is committed", conf.OrderingPolicy)
+			}
+		}
 		kern.timeoutFactor = conf.TimeoutFactor
+		kern.prefetchQueue = conf.PrefetchQueueSize
+		kern.heartbeatInterval, err = conf.Heartbeat()
+		if err != nil {
+			return err
+		}
+		if conf.CircuitBreaker != nil {
+			kern.CircuitBreaker, err = breaker.New(conf.CircuitBreaker, kern.Emitter, kern.Logger)
+			if err != nil {
+				return fmt.Errorf("could not create circuit breaker: %v", err)
+			}
+		}
+		kern.Redactor, err = redact.New(conf.Redaction)
+		if err != nil {
+			return fmt.Errorf("could not create event redactor: %v", err)
+		}
+		if conf.Private != nil {
+			key, err := private.LoadOrGenerateKey(conf.Private.KeyFile)
+			if err != nil {
+				return fmt.Errorf("could not load private transaction key: %v", err)
+			}
+			vmOptions, err := conf.EVMOptions()
+			if err != nil {
+				return err
+			}
+			tlsConfig, err := conf.Private.TLSConfig()
+			if err != nil {
+				return err
+			}
+			kern.Private, err = private.NewManager(key, dbm.NewPrefixDB(kern.database, []byte("private/")),
+				vmOptions, conf.Private.Peers, credentials.NewTLS(tlsConfig), kern.Logger)
+			if err != nil {
+				return fmt.Errorf("could not create private transaction manager: %v", err)
+			}
+			kern.privateListenAddress = conf.Private.ListenAddress()
+			kern.privateTLS = tlsConfig
+		}
 	}
 	return nil
 }
 
+// LoadGenesisCeremonyFromConfig reads the ceremony manifest that a new chain's GenesisDoc must be signed in
+func (kern *Kernel) LoadGenesisCeremonyFromConfig(conf *genesis.CeremonyConfig) error {
+	if conf == nil {
+		return nil
+	}
+	manifest := new(genesis.CeremonyManifest)
+	err := source.FromFile(conf.ManifestFile, manifest)
+	if err != nil {
+		return fmt.Errorf("could not read ceremony manifest: %v", err)
+	}
+	kern.ceremonyManifest = manifest
+	kern.ceremonyThreshold = conf.Threshold
+	return nil
+}
+
 // LoadTendermintFromConfig loads our consensus engine into the kernel
 func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal tmTypes.PrivValidator) (err error) {
 	if conf.Tendermint == nil || !conf.Tendermint.Enabled {
 		return nil
 	}
+	if kern.orderingPolicy != nil {
+		return fmt.Errorf("transaction ordering policies other than %s are only supported when Tendermint is disabled "+
+			"since Tendermint proposers include transactions in mempool order", ordering.FIFO)
+	}
 
 	authorizedPeersProvider := conf.Tendermint.DefaultAuthorizedPeersProvider()
 	if conf.Tendermint.IdentifyPeers {
@@ -87,8 +192,82 @@ func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal
 	if err != nil {
 		return fmt.Errorf("could not build Tendermint config: %v", err)
 	}
-	kern.Node, err = tendermint.NewNode(tmConf, privVal, tmGenesisDoc, app, metricsProvider, tmLogger)
-	return err
+	var registered abci.AuthorizedPeers
+	if conf.Tendermint.IdentifyPeers {
+		registered = authorizedPeersProvider
+	}
+	defaultNodeKeyFile := tmConf.NodeKey
+	tmConf.NodeKey, err = conf.Tendermint.P2PEncryption.NodeKeyFile(conf.BurrowDir, defaultNodeKeyFile,
+		kern.Blockchain.LastBlockHeight(), registered)
+	if err != nil {
+		return err
+	}
+	reactors := make(map[string]p2p.Reactor)
+	if conf.Tendermint.AppHashGossip {
+		appHashGossip := tendermint.NewAppHashGossip(kern.Emitter, kern.Logger)
+		app.SetAppHashListener(appHashGossip)
+		reactors[tendermint.AppHashGossipReactorName] = appHashGossip
+	}
+	if conf.Tendermint.Dandelion != nil && conf.Tendermint.Dandelion.Enabled {
+		kern.dandelion, err = tendermint.NewDandelion(conf.Tendermint.Dandelion, app.CheckStemTx, kern.Logger)
+		if err != nil {
+			return fmt.Errorf("could not create Dandelion relay: %v", err)
+		}
+		reactors[tendermint.DandelionReactorName] = kern.dandelion
+	}
+	kern.Node, err = tendermint.NewNode(tmConf, privVal, tmGenesisDoc, app, metricsProvider, reactors, tmLogger)
+	if err != nil {
+		return err
+	}
+	// Hand the node's mempool and block store to those that use them, again each time the node is rebuilt
+	useNode := func(nde *node.Node) {
+		if kern.dandelion != nil {
+			kern.dandelion.SetMempool(nde.Mempool())
+		}
+		if kern.CircuitBreaker != nil {
+			kern.CircuitBreaker.SetMempool(nde.Mempool())
+		}
+	}
+	useNode(kern.Node.Current())
+	kern.Node.OnRebuild(useNode)
+	kern.Node.OnRebuild(func(nde *node.Node) {
+		kern.Blockchain.SetBlockStore(bcm.NewBlockStore(nde.BlockStore()))
+	})
+	if conf.Tendermint.P2PEncryption != nil && len(conf.Tendermint.P2PEncryption.NodeKeyRotations) > 0 {
+		kern.nodeKeyRotator = tendermint.NewNodeKeyRotator(conf.Tendermint.P2PEncryption, conf.BurrowDir,
+			defaultNodeKeyFile, tmConf.NodeKey, kern.Blockchain, registered, kern.Node, kern.Logger)
+	}
+	return nil
+}
+
+// LoadRPCFromConfig configures the access controls and optional services of the RPC servers
+func (kern *Kernel) LoadRPCFromConfig(conf *rpc.RPCConfig) (err error) {
+	if conf == nil {
+		return nil
+	}
+	if conf.BroadcastACL != nil {
+		kern.BroadcastACL, err = acl.New(conf.BroadcastACL, kern.Logger)
+		if err != nil {
+			return fmt.Errorf("could not load broadcast ACL: %v", err)
+		}
+	}
+	kern.Auth, err = auth.New(conf.Auth, kern.Logger)
+	if err != nil {
+		return fmt.Errorf("could not configure RPC auth: %v", err)
+	}
+	kern.Features, err = feature.New(conf.Features, kern.Blockchain, kern.Logger)
+	if err != nil {
+		return fmt.Errorf("could not configure RPC features: %v", err)
+	}
+	kern.RateLimiter, err = ratelimit.New(conf.RateLimit, kern.Logger)
+	if err != nil {
+		return fmt.Errorf("could not configure RPC rate limits: %v", err)
+	}
+	if conf.Verify != nil && conf.Verify.Enabled {
+		kern.Verifier = rpcverify.NewVerifyServer(kern.State, kern.Blockchain,
+			dbm.NewPrefixDB(kern.database, []byte("verify/")), rpcverify.SolidityCompiler(kern.Logger), kern.Logger)
+	}
+	return nil
 }
 
 // LoadKernelFromConfig builds and returns a Kernel based solely on the supplied configuration
@@ -98,39 +277,86 @@ func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
 		return nil, fmt.Errorf("could not create initial kernel: %v", err)
 	}
 
-	if err = kern.LoadLoggerFromConfig(conf.Logging); err != nil {
+	err = kern.BootReport.Time("logging", func() error {
+		return kern.LoadLoggerFromConfig(conf.Logging)
+	})
+	if err != nil {
 		return nil, fmt.Errorf("could not configure logger: %v", err)
 	}
 
-	err = kern.LoadKeysFromConfig(conf.Keys)
+	err = kern.BootReport.Time("events", func() error {
+		return kern.LoadEventsFromConfig(conf.Events)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not configure events: %v", err)
+	}
+
+	err = kern.BootReport.Time("keys", func() error {
+		return kern.LoadKeysFromConfig(conf.Keys)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("could not configure keys: %v", err)
 	}
 
-	err = kern.LoadExecutionOptionsFromConfig(conf.Execution)
+	err = kern.BootReport.Time("execution", func() error {
+		return kern.LoadExecutionOptionsFromConfig(conf.Execution)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("could not add execution options: %v", err)
 	}
 
+	err = kern.BootReport.Time("genesisCeremony", func() error {
+		return kern.LoadGenesisCeremonyFromConfig(conf.GenesisCeremony)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not configure genesis ceremony: %v", err)
+	}
+
+	done := kern.BootReport.Start("state")
 	err = kern.LoadState(conf.GenesisDoc)
 	if err != nil {
+		done(err, "")
 		return nil, fmt.Errorf("could not load state: %v", err)
 	}
+	done(nil, fmt.Sprintf("height %d, version %d", kern.Blockchain.LastBlockHeight(), kern.State.Version()))
+
+	if conf.Execution != nil && conf.Execution.WarmupAccounts > 0 {
+		err = kern.Warmup(conf.Execution.WarmupAccounts, conf.Execution.WarmupStorageKeys)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		kern.BootReport.Disabled("warmup")
+	}
 
 	if conf.ValidatorAddress == nil {
 		return nil, fmt.Errorf("Address must be set")
 	}
 
+	kern.SetValidatorAddress(*conf.ValidatorAddress)
 	privVal, err := kern.PrivValidator(*conf.ValidatorAddress)
 	if err != nil {
 		return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
 	}
 
-	err = kern.LoadTendermintFromConfig(conf, privVal)
+	if conf.Tendermint != nil && conf.Tendermint.Enabled {
+		err = kern.BootReport.Time("tendermint", func() error {
+			return kern.LoadTendermintFromConfig(conf, privVal)
+		})
+	} else {
+		kern.BootReport.Disabled("tendermint")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not configure Tendermint: %v", err)
 	}
 
+	err = kern.BootReport.Time("rpc", func() error {
+		return kern.LoadRPCFromConfig(conf.RPC)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	kern.AddProcesses(DefaultProcessLaunchers(kern, conf.RPC, conf.Keys)...)
 	return kern, nil
 }
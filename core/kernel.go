@@ -6,6 +6,7 @@ package core
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	_ "net/http/pprof"
@@ -18,18 +19,30 @@ import (
 	"github.com/hyperledger/burrow/dump"
 
 	"github.com/go-kit/kit/log"
+	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/consensus/ordering"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/breaker"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/execution/private"
+	"github.com/hyperledger/burrow/execution/redact"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/rpc/feature"
+	"github.com/hyperledger/burrow/rpc/ratelimit"
+	"github.com/hyperledger/burrow/rpc/rpcverify"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
 	"github.com/tendermint/tendermint/store"
@@ -43,6 +56,8 @@ const (
 	LoggingCallerDepth     = 5
 	AccountsRingMutexCount = 100
 	BurrowDBName           = "burrow_state"
+	// How often we check whether a scheduled node key rotation has fallen due
+	NodeKeyRotationCheckInterval = time.Second
 )
 
 // Kernel is the root structure of Burrow
@@ -56,11 +71,25 @@ type Kernel struct {
 	Blockchain     *bcm.Blockchain
 	Node           *tendermint.Node
 	Transactor     *execution.Transactor
+	CircuitBreaker *breaker.CircuitBreaker
+	Prefetcher     *execution.Prefetcher
+	Private        *private.Manager
+	Redactor       *redact.Redactor
+	Verifier       rpcverify.VerifierServer
+	BroadcastACL   *acl.ACL
+	Auth           *auth.Auth
+	Features       *feature.Gate
+	RateLimiter    *ratelimit.Limiter
 	RunID          simpleuuid.UUID // Time-based UUID randomly generated each time Burrow is started
+	BootReport     *process.BootReport
 	Logger         *logging.Logger
+	// The logging configuration of the running node, set when the logger is loaded from config
+	LiveLogging    *logconfig.Live
 	database       dbm.DB
 	txCodec        txs.Codec
 	exeOptions     []execution.Option
+	natives        *native.Natives
+	orderingPolicy ordering.Policy
 	checker        execution.BatchExecutor
 	committer      execution.BatchCommitter
 	keyClient      keys.KeyClient
@@ -68,9 +97,27 @@ type Kernel struct {
 	info           string
 	processes      map[string]process.Process
 	listeners      map[string]net.Listener
-	timeoutFactor  float64
-	shutdownNotify chan struct{}
-	shutdownOnce   sync.Once
+	// Shares of the multiplexed listener to be served by the named processes in place of their own listeners
+	muxListeners  map[string]net.Listener
+	timeoutFactor float64
+	prefetchQueue int
+	// Relays transactions submitted to this node through a stem before they are broadcast (if enabled)
+	dandelion *tendermint.Dandelion
+	// Rotates the node key of the running node on schedule (if any rotations are scheduled)
+	nodeKeyRotator *tendermint.NodeKeyRotator
+	// Counts the storage reads of transactions executed in blocks
+	storageReads *acmstate.StorageReads
+	// Where the private transaction service is served to peers and clients presenting a certificate signed by its CA
+	privateListenAddress string
+	privateTLS           *tls.Config
+	// The validator this node signs for and how often it sends a heartbeat on its behalf (zero meaning never)
+	validatorAddress  crypto.Address
+	heartbeatInterval time.Duration
+	// A new chain may only be started with a GenesisDoc signed in the manifest by enough validators
+	ceremonyManifest  *genesis.CeremonyManifest
+	ceremonyThreshold int
+	shutdownNotify    chan struct{}
+	shutdownOnce      sync.Once
 }
 
 // NewKernel initializes an empty kernel
@@ -79,15 +126,22 @@ func NewKernel(dbDir string) (*Kernel, error) {
 		return nil, fmt.Errorf("Burrow requires a database directory")
 	}
 	runID, err := simpleuuid.NewTime(time.Now()) // Create a random ID based on start time
+	bootReport := process.NewBootReport()
+	done := bootReport.Start("database")
+	database := dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dbDir)
+	done(nil, dbDir)
 	return &Kernel{
 		Logger:         logging.NewNoopLogger(),
 		RunID:          runID,
 		Emitter:        event.NewEmitter(),
 		processes:      make(map[string]process.Process),
 		listeners:      make(map[string]net.Listener),
+		muxListeners:   make(map[string]net.Listener),
 		shutdownNotify: make(chan struct{}),
 		txCodec:        txs.NewProtobufCodec(),
-		database:       dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dbDir),
+		database:       database,
+		BootReport:     bootReport,
+		storageReads:   new(acmstate.StorageReads),
 	}, err
 }
 
@@ -108,6 +162,15 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 		return fmt.Errorf("error creating or loading blockchain state: %v", err)
 	}
 
+	if !existing && kern.ceremonyManifest != nil {
+		err = kern.ceremonyManifest.Verify(genesisDoc, kern.ceremonyThreshold)
+		if err != nil {
+			return fmt.Errorf("refusing to start new chain: %v", err)
+		}
+		kern.Logger.InfoMsg("GenesisDoc signed in genesis ceremony",
+			"signatures", len(kern.ceremonyManifest.Signatures))
+	}
+
 	if existing {
 		kern.Logger.InfoMsg("Loading application state", "height", kern.Blockchain.LastBlockHeight())
 		kern.State, err = state.LoadState(kern.database, execution.VersionAtHeight(kern.Blockchain.LastBlockHeight()))
@@ -142,18 +205,50 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 	kern.Logger.InfoMsg("State loading successful")
 
 	params := execution.ParamsFromGenesis(genesisDoc)
-	kern.checker, err = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger)
+	// Precompiles are relocated on top of any natives registered with AddNatives
+	kern.natives, err = execution.NativesFromGenesis(kern.natives, genesisDoc)
+	if err != nil {
+		return fmt.Errorf("could not relocate precompiles: %w", err)
+	}
+	checkerOptions := []execution.Option{execution.CircuitBreaker(kern.CircuitBreaker)}
+	if kern.prefetchQueue > 0 {
+		kern.Prefetcher = execution.NewPrefetcher(kern.State, kern.natives, kern.Blockchain, kern.prefetchQueue,
+			kern.Logger)
+		checkerOptions = append(checkerOptions, execution.Prefetch(kern.Prefetcher))
+	}
+	kern.checker, err = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger,
+		checkerOptions...)
 	if err != nil {
 		return fmt.Errorf("could not create BatchChecker: %w", err)
 	}
+	committerOptions := append(kern.exeOptions, execution.CircuitBreaker(kern.CircuitBreaker),
+		execution.Natives(kern.natives), execution.Redact(kern.Redactor), execution.CountStorageReads(kern.storageReads))
+	if kern.Private != nil {
+		committerOptions = append(committerOptions, execution.Private(kern.Private))
+	}
 	kern.committer, err = execution.NewBatchCommitter(kern.State, params, kern.Blockchain, kern.Emitter, kern.Logger,
-		kern.exeOptions...)
+		committerOptions...)
 	if err != nil {
 		return fmt.Errorf("could not create BatchCommitter: %w", err)
 	}
 	return nil
 }
 
+// Warmup preloads the most recently active accounts and their storage into the state caches
+func (kern *Kernel) Warmup(maxAccounts, maxStorageKeys int) error {
+	start := time.Now()
+	done := kern.BootReport.Start("warmup")
+	accounts, storageKeys, err := kern.State.Warmup(maxAccounts, maxStorageKeys)
+	if err != nil {
+		done(err, "")
+		return fmt.Errorf("could not warm up state: %w", err)
+	}
+	done(nil, fmt.Sprintf("%d accounts, %d storage keys", accounts, storageKeys))
+	kern.Logger.InfoMsg("Warmed up state caches", "accounts", accounts, "storage_keys", storageKeys,
+		"duration", time.Since(start).String())
+	return nil
+}
+
 // LoadDump restores chain state from the given dump file
 func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string, silent bool) (err error) {
 	var exists bool
@@ -216,6 +311,24 @@ func (kern *Kernel) AddExecutionOptions(opts ...execution.Option) {
 	kern.exeOptions = append(kern.exeOptions, opts...)
 }
 
+// AddNatives registers custom native contracts and functions with the EVM alongside the default natives, it must be
+// called before the state is loaded
+func (kern *Kernel) AddNatives(natives ...*native.Natives) error {
+	if kern.natives == nil {
+		defaults, err := native.DefaultNatives()
+		if err != nil {
+			return err
+		}
+		kern.natives = defaults
+	}
+	merged, err := native.Merge(append([]*native.Natives{kern.natives}, natives...)...)
+	if err != nil {
+		return fmt.Errorf("could not register natives: %w", err)
+	}
+	kern.natives = merged
+	return nil
+}
+
 // AddProcesses extends the services that we launch at boot
 func (kern *Kernel) AddProcesses(pl ...process.Launcher) {
 	kern.Launchers = append(kern.Launchers, pl...)
@@ -226,6 +339,11 @@ func (kern *Kernel) SetKeyClient(client keys.KeyClient) {
 	kern.keyClient = client
 }
 
+// SetValidatorAddress sets the validator this node signs for, on whose behalf it sends heartbeats
+func (kern *Kernel) SetValidatorAddress(address crypto.Address) {
+	kern.validatorAddress = address
+}
+
 // SetKeyStore explicitly sets the key store
 func (kern *Kernel) SetKeyStore(store *keys.FilesystemKeyStore) {
 	kern.keyStore = store
@@ -248,18 +366,34 @@ func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidat
 func (kern *Kernel) Boot() (err error) {
 	for _, launcher := range kern.Launchers {
 		if launcher.Enabled {
+			done := kern.BootReport.Start(launcher.Name)
 			srvr, err := launcher.Launch()
+			done(err, "")
 			if err != nil {
 				return fmt.Errorf("error launching %s server: %v", launcher.Name, err)
 			}
 
 			kern.processes[launcher.Name] = srvr
+		} else {
+			kern.BootReport.Disabled(launcher.Name)
 		}
 	}
+	kern.BootReport.Finish()
+	kern.logBootReport()
 	go kern.supervise()
 	return nil
 }
 
+// Logs the time taken by each stage of booting so that slow starts can be diagnosed
+func (kern *Kernel) logBootReport() {
+	report := kern.BootReport.Copy()
+	for _, step := range report.Steps {
+		kern.Logger.InfoMsg("Boot step", "step", step.Name, "status", step.Status,
+			"duration", step.Duration.String(), "detail", step.Detail)
+	}
+	kern.Logger.InfoMsg("Booted", "duration", report.Duration().String())
+}
+
 func (kern *Kernel) Panic(err error) {
 	fmt.Fprintf(os.Stderr, "%v: shutting down due to panic: %v", kern, err)
 	kern.ShutdownAndExit()
@@ -280,6 +414,22 @@ func (kern *Kernel) registerListener(name string, listener net.Listener) error {
 	return nil
 }
 
+// Returns the listener of the process name, which is its share of the multiplexed listener if there is one or else a
+// new listener on its configured address
+func (kern *Kernel) listener(name string, conf *rpc.ServerConfig) (listener net.Listener, muxed bool, err error) {
+	listener, muxed = kern.muxListeners[name]
+	if muxed {
+		return listener, true, nil
+	}
+	listener, err = process.ListenerFromAddress(conf.ListenAddress())
+	return listener, false, err
+}
+
+// Returns the events stored in state as served by RPC, that is redacted by the kernel's Redactor
+func (kern *Kernel) eventsReader() redact.EventsReader {
+	return redact.NewReader(kern.State, kern.Redactor)
+}
+
 func (kern *Kernel) GRPCListenAddress() net.Addr {
 	l, ok := kern.listeners[GRPCProcessName]
 	if !ok {
@@ -324,6 +474,10 @@ func (kern *Kernel) supervise() {
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "%v: could not reload logger: %v", kern, err)
 			}
+			err = kern.BroadcastACL.Reload()
+			if err != nil {
+				kern.Logger.InfoMsg("Could not reload broadcast ACL", structure.ErrorKey, err)
+			}
 		case <-syncCh:
 			err := kern.Logger.Sync()
 			if err != nil {
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type doubleArgs struct {
+	Value uint64
+}
+
+type doubleRets struct {
+	Value uint64
+}
+
+func double(ctx native.Context, args doubleArgs) (doubleRets, error) {
+	return doubleRets{Value: 2 * args.Value}, nil
+}
+
+func TestKernel_AddNatives(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-kernel")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	kern, err := NewKernel(dir)
+	require.NoError(t, err)
+
+	address := crypto.Address{0xBE, 0xEF}
+	doubler := native.New().MustContractAt("Doubler", "Doubles things", address,
+		native.Function{
+			Comment:  "Double the value",
+			PermFlag: permission.Call,
+			F:        double,
+		})
+	require.NoError(t, kern.AddNatives(doubler))
+	// Loading the config must not replace the natives already registered
+	require.NoError(t, kern.LoadExecutionOptionsFromConfig(execution.DefaultExecutionConfig()))
+
+	genesisDoc, _, _ := genesis.NewDeterministicGenesis(1).GenesisDoc(1, 1)
+	genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions
+	relocated := crypto.Address{0x1, 0x0, 0x0}
+	genesisDoc.Params.PrecompileAddresses = map[string]crypto.Address{"sha512_256Func": relocated}
+	require.NoError(t, kern.LoadState(genesisDoc))
+
+	assert.NotNil(t, kern.natives.GetByAddress(address))
+	assert.NotNil(t, kern.natives.GetByAddress(relocated))
+
+	// Simulated calls see the registered natives, which must be called from code since they have no account
+	caller := crypto.Address{0xCA, 0x11}
+	code := bc.MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 32, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH1, 0, PUSH20, address, GAS, CALL, POP,
+		PUSH1, 32, PUSH1, 0, RETURN)
+	funcID := doubler.GetContract("Doubler").FunctionByName("double").Abi().FunctionID
+	txe, err := execution.CallCodeSim(kern.State, kern.State, kern.natives, kern.Blockchain, caller, caller, code,
+		bc.MustSplice(funcID[:], binary.Int64ToWord256(21)), kern.Logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), txe.Result.Return)
+}
@@ -2,13 +2,19 @@ package core
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/private"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
@@ -16,16 +22,26 @@ import (
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
 	"github.com/hyperledger/burrow/rpc/metrics"
+	"github.com/hyperledger/burrow/rpc/rpcadmin"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcexplorer"
+	"github.com/hyperledger/burrow/rpc/rpcgraphql"
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/rpc/rpcverify"
 	"github.com/hyperledger/burrow/rpc/web3"
 	"github.com/hyperledger/burrow/txs"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
+	tmTypes "github.com/tendermint/tendermint/types"
 	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
 	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -38,6 +54,14 @@ const (
 	InfoProcessName        = "rpcConfig/info"
 	GRPCProcessName        = "rpcConfig/GRPC"
 	MetricsProcessName     = "rpcConfig/metrics"
+	GatewayProcessName     = "rpcConfig/gateway"
+	GraphQLProcessName     = "rpcConfig/graphql"
+	ExplorerProcessName    = "rpcConfig/explorer"
+	MuxProcessName         = "rpcConfig/mux"
+	PrefetchProcessName    = "Prefetcher"
+	HeartbeatProcessName   = "Heartbeater"
+	NodeKeyProcessName     = "NodeKeyRotator"
+	PrivateProcessName     = "PrivateTransactions"
 )
 
 func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig *keys.KeysConfig) []process.Launcher {
@@ -48,10 +72,23 @@ func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig
 		NoConsensusLauncher(kern),
 		TendermintLauncher(kern),
 		StartupLauncher(kern),
+		PrefetchLauncher(kern),
+		// Run heartbeater after consensus so it has a Transactor
+		HeartbeatLauncher(kern),
+		// Run after consensus so that we are stopped before the node we restart
+		NodeKeyRotationLauncher(kern),
 		Web3Launcher(kern, rpcConfig.Web3),
-		InfoLauncher(kern, rpcConfig.Info),
+		// Run mux before the servers it shares its listener with
+		MuxLauncher(kern, rpcConfig.Mux, rpcConfig.GRPCTLS),
+		InfoLauncher(kern, rpcConfig.Info, rpcConfig.CORS),
 		MetricsLauncher(kern, rpcConfig.Metrics),
-		GRPCLauncher(kern, rpcConfig.GRPC, keysConfig),
+		GRPCLauncher(kern, rpcConfig.GRPC, rpcConfig.GRPCWeb, rpcConfig.GRPCTLS, rpcConfig.GRPCReflection,
+			rpcConfig.GRPCMessages, keysConfig),
+		// Run gateway after GRPC so it can connect to it
+		GatewayLauncher(kern, rpcConfig.Gateway, rpcConfig.GRPCTLS, rpcConfig.GRPCMessages, rpcConfig.CORS),
+		GraphQLLauncher(kern, rpcConfig.GraphQL, rpcConfig.CORS),
+		ExplorerLauncher(kern, rpcConfig.Explorer, rpcConfig.CORS),
+		PrivateLauncher(kern),
 	}
 }
 
@@ -98,11 +135,13 @@ func NoConsensusLauncher(kern *Kernel) process.Launcher {
 			nameRegState := kern.State
 			nodeRegState := kern.State
 			validatorSet := kern.State
-			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorSet, nil, kern.Logger)
+			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorSet, nil,
+				kern.BootReport, kern.Logger)
 			// TimeoutFactor scales in units of seconds
 			blockDuration := time.Duration(kern.timeoutFactor * float64(time.Second))
 			//proc := abci.NewProcess(kern.checker, kern.committer, kern.Blockchain, kern.txCodec, blockDuration, kern.Panic)
-			proc := abci.NewProcess(kern.committer, kern.Blockchain, kern.txCodec, blockDuration, kern.Panic)
+			proc := abci.NewProcess(kern.committer, kern.Blockchain, kern.txCodec, blockDuration, kern.orderingPolicy,
+				kern.Panic)
 			// Provide execution accounts against backend state since we will commit immediately
 			accounts := execution.NewAccounts(kern.committer, kern.keyClient, AccountsRingMutexCount)
 			// Elide consensus and use a CheckTx function that immediately commits any valid transaction
@@ -132,37 +171,37 @@ func TendermintLauncher(kern *Kernel) process.Launcher {
 			kern.Blockchain.SetBlockStore(bcm.NewBlockStore(nodeView.BlockStore()))
 			// Provide execution accounts against checker state so that we can assign sequence numbers
 			accounts := execution.NewAccounts(kern.checker, kern.keyClient, AccountsRingMutexCount)
-			// Pass transactions to Tendermint's CheckTx function for broadcast and consensus
-			checkTx := kern.Node.Mempool().CheckTx
+			// Pass transactions to Tendermint's CheckTx function for broadcast and consensus (through the current
+			// mempool since the node is rebuilt when its key is rotated)
+			checkTx := func(tx tmTypes.Tx, cb func(*abciTypes.Response), txInfo mempool.TxInfo) error {
+				return kern.Node.Mempool().CheckTx(tx, cb, txInfo)
+			}
+			if kern.dandelion != nil {
+				checkTx = kern.dandelion.CheckTx
+			}
 			kern.Transactor = execution.NewTransactor(kern.Blockchain,
 				kern.Emitter, accounts, checkTx, id, kern.txCodec, kern.Logger)
 
 			accountState := kern.State
-			eventsState := kern.State
+			eventsState := kern.eventsReader()
 			nameRegState := kern.State
 			nodeRegState := kern.State
 			validatorState := kern.State
-			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorState, nodeView, kern.Logger)
-			kern.EthService = rpc.NewEthService(accountState, eventsState, kern.Blockchain, validatorState, nodeView, kern.Transactor, kern.keyStore, kern.Logger)
+			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorState, nodeView,
+				kern.BootReport, kern.Logger)
+			kern.EthService = rpc.NewEthService(accountState, kern.natives, eventsState, kern.Blockchain, validatorState, nodeView, kern.Transactor, kern.BroadcastACL, kern.keyStore, kern.Logger)
 
 			if err := kern.Node.Start(); err != nil {
 				return nil, fmt.Errorf("%s error starting Tendermint node: %v", errHeader, err)
 			}
 
 			return process.ShutdownFunc(func(ctx context.Context) error {
-				err := kern.Node.Stop()
-				// Close tendermint database connections using our wrapper
-				defer kern.Node.Close()
+				err := kern.Node.Shutdown(ctx)
 				if err != nil {
 					return err
 				}
-				select {
-				case <-ctx.Done():
-					return ctx.Err()
-				case <-kern.Node.Quit():
-					kern.Logger.InfoMsg("Tendermint Node has quit, closing DB connections...")
-					return nil
-				}
+				kern.Logger.InfoMsg("Tendermint Node has quit, closed DB connections")
+				return nil
 			}), nil
 		},
 	}
@@ -214,20 +253,53 @@ func StartupLauncher(kern *Kernel) process.Launcher {
 	}
 }
 
-func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
+// MuxLauncher listens on a single port for both the GRPC and info servers, which serve their shares of its
+// connections in place of listening on their own ports
+func MuxLauncher(kern *Kernel, conf *rpc.ServerConfig, tlsConf *rpc.TLSConfig) process.Launcher {
 	return process.Launcher{
-		Name:    InfoProcessName,
+		Name:    MuxProcessName,
 		Enabled: conf.Enabled,
 		Launch: func() (process.Process, error) {
 			listener, err := process.ListenerFromAddress(conf.ListenAddress())
 			if err != nil {
 				return nil, err
 			}
+			if tlsConf != nil && tlsConf.Enabled {
+				tlsConfig, err := tlsConf.ServerTLSConfig()
+				if err != nil {
+					return nil, fmt.Errorf("could not configure mux TLS: %v", err)
+				}
+				listener = tls.NewListener(listener, tlsConfig)
+			}
+			err = kern.registerListener(MuxProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			grpcListener, httpListener := rpc.SplitHTTP2(listener)
+			kern.muxListeners[GRPCProcessName] = grpcListener
+			kern.muxListeners[InfoProcessName] = httpListener
+
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				return grpcListener.Close()
+			}), nil
+		},
+	}
+}
+
+func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig, corsConf *rpc.CORSConfig) process.Launcher {
+	return process.Launcher{
+		Name:    InfoProcessName,
+		Enabled: conf.Enabled,
+		Launch: func() (process.Process, error) {
+			listener, _, err := kern.listener(InfoProcessName, conf)
+			if err != nil {
+				return nil, err
+			}
 			err = kern.registerListener(InfoProcessName, listener)
 			if err != nil {
 				return nil, err
 			}
-			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, kern.Logger)
+			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, corsConf, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -236,6 +308,66 @@ func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	}
 }
 
+func PrefetchLauncher(kern *Kernel) process.Launcher {
+	return process.Launcher{
+		Name:    PrefetchProcessName,
+		Enabled: kern.Prefetcher != nil,
+		Launch: func() (process.Process, error) {
+			ctx, cancel := context.WithCancel(context.Background())
+			go kern.Prefetcher.Run(ctx)
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				cancel()
+				return nil
+			}), nil
+		},
+	}
+}
+
+func HeartbeatLauncher(kern *Kernel) process.Launcher {
+	return process.Launcher{
+		Name:    HeartbeatProcessName,
+		Enabled: kern.heartbeatInterval > 0,
+		Launch: func() (process.Process, error) {
+			if kern.Transactor == nil {
+				return nil, fmt.Errorf("cannot send heartbeats without a Transactor")
+			}
+			heartbeater := execution.NewHeartbeater(kern.validatorAddress, kern.heartbeatInterval, kern.Blockchain,
+				kern.Transactor, kern.Logger)
+			ctx, cancel := context.WithCancel(context.Background())
+			go heartbeater.Run(ctx)
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				cancel()
+				return nil
+			}), nil
+		},
+	}
+}
+
+func NodeKeyRotationLauncher(kern *Kernel) process.Launcher {
+	return process.Launcher{
+		Name:    NodeKeyProcessName,
+		Enabled: kern.nodeKeyRotator != nil,
+		Launch: func() (process.Process, error) {
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				kern.nodeKeyRotator.Run(ctx, NodeKeyRotationCheckInterval)
+				close(done)
+			}()
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				cancel()
+				// Wait for any rotation in progress so that the node is not stopped under it
+				select {
+				case <-done:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}), nil
+		},
+	}
+}
+
 func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	return process.Launcher{
 		Name:    Web3ProcessName,
@@ -260,6 +392,146 @@ func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	}
 }
 
+func GatewayLauncher(kern *Kernel, conf *rpc.ServerConfig, grpcTLSConf *rpc.TLSConfig,
+	messagesConf *rpc.GRPCMessagesConfig, corsConf *rpc.CORSConfig) process.Launcher {
+	return process.Launcher{
+		Name:    GatewayProcessName,
+		Enabled: conf != nil && conf.Enabled,
+		Launch: func() (process.Process, error) {
+			grpcAddress, ok := kern.GRPCListenAddress().(*net.TCPAddr)
+			if !ok {
+				return nil, fmt.Errorf("the gateway requires the GRPC server to be enabled")
+			}
+			transport := grpc.WithInsecure()
+			if grpcTLSConf != nil && grpcTLSConf.Enabled {
+				if grpcTLSConf.ClientCAFile != "" {
+					return nil, fmt.Errorf("the gateway cannot connect to a GRPC server that requires client certificates")
+				}
+				// We are dialling our own listener over loopback so the certificate (issued for the node's external
+				// name) cannot match and there is nothing to verify
+				transport = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true}))
+			}
+			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(GatewayProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+
+			// GRPC may listen on any interface but we only need to reach it locally
+			dialAddress := net.JoinHostPort(rpc.LocalHost, strconv.Itoa(grpcAddress.Port))
+			conn, err := grpc.Dial(dialAddress, append(messagesConf.DialOptions(), transport)...)
+			if err != nil {
+				return nil, err
+			}
+			gateway, err := rpc.NewGateway(conn, rpc.GatewayProtoFiles...)
+			if err != nil {
+				return nil, err
+			}
+			srv, err := server.StartHTTPServer(listener, corsConf.Handler(kern.Auth.Handler(gateway)), kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				err := srv.Shutdown(ctx)
+				conn.Close()
+				return err
+			}), nil
+		},
+	}
+}
+
+func GraphQLLauncher(kern *Kernel, conf *rpc.ServerConfig, corsConf *rpc.CORSConfig) process.Launcher {
+	return process.Launcher{
+		Name:    GraphQLProcessName,
+		Enabled: conf != nil && conf.Enabled,
+		Launch: func() (process.Process, error) {
+			schema, err := rpcgraphql.NewSchema(struct {
+				acmstate.IterableStatsReader
+				names.IterableReader
+				rpcevents.Provider
+			}{kern.State, kern.State, kern.eventsReader()}, kern.Blockchain)
+			if err != nil {
+				return nil, err
+			}
+			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(GraphQLProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			handler := corsConf.Handler(kern.Auth.Handler(kern.RateLimiter.Handler(rpcgraphql.NewHandler(schema))))
+			srv, err := server.StartHTTPServer(listener, handler, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+
+			return srv, nil
+		},
+	}
+}
+
+func ExplorerLauncher(kern *Kernel, conf *rpc.ServerConfig, corsConf *rpc.CORSConfig) process.Launcher {
+	return process.Launcher{
+		Name:    ExplorerProcessName,
+		Enabled: conf.Enabled,
+		Launch: func() (process.Process, error) {
+			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(ExplorerProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			explorer := rpcexplorer.NewRESTHandler(struct {
+				rpcexplorer.ExplorerState
+				rpcevents.Provider
+			}{kern.State, kern.eventsReader()}, kern.Blockchain, kern.Logger)
+			handler := corsConf.Handler(kern.Auth.Handler(kern.RateLimiter.Handler(explorer)))
+			srv, err := server.StartHTTPServer(listener, handler, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+
+			return srv, nil
+		},
+	}
+}
+
+// Serves the private transaction service to peers and clients on its own mutually authenticated TLS listener since it
+// exposes the node's private state
+func PrivateLauncher(kern *Kernel) process.Launcher {
+	return process.Launcher{
+		Name:    PrivateProcessName,
+		Enabled: kern.Private != nil,
+		Launch: func() (process.Process, error) {
+			listener, err := process.ListenerFromAddress(kern.privateListenAddress)
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(PrivateProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(kern.privateTLS)))
+			private.RegisterPrivateTransactionsServer(grpcServer, private.NewPrivateServer(kern.Private))
+			go grpcServer.Serve(listener)
+
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				grpcServer.Stop()
+				// listener is closed for us
+				return nil
+			}), nil
+		},
+	}
+}
+
 func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 	return process.Launcher{
 		Name:    MetricsProcessName,
@@ -273,8 +545,8 @@ func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 			if err != nil {
 				return nil, err
 			}
-			server, err := metrics.StartServer(kern.Service, conf.MetricsPath, listener, conf.BlockSampleSize,
-				kern.Logger)
+			server, err := metrics.StartServer(kern.Service, kern.Emitter, kern.storageReads, conf.MetricsPath,
+				listener, conf.BlockSampleSize, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -283,7 +555,9 @@ func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 	}
 }
 
-func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConfig) process.Launcher {
+func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, webConf *rpc.GRPCWebConfig, tlsConf *rpc.TLSConfig,
+	reflectionConf *rpc.GRPCReflectionConfig, messagesConf *rpc.GRPCMessagesConfig,
+	keyConfig *keys.KeysConfig) process.Launcher {
 	return process.Launcher{
 		Name:    GRPCProcessName,
 		Enabled: conf.Enabled,
@@ -293,16 +567,29 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 				return nil, err
 			}
 
-			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			listener, muxed, err := kern.listener(GRPCProcessName, conf)
 			if err != nil {
 				return nil, err
 			}
+			// A multiplexed listener has already terminated any TLS
+			if tlsConf != nil && tlsConf.Enabled && !muxed {
+				tlsConfig, err := tlsConf.ServerTLSConfig()
+				if err != nil {
+					return nil, fmt.Errorf("could not configure GRPC TLS: %v", err)
+				}
+				// Terminate TLS before gRPC-web is split off so both are served securely
+				listener = tls.NewListener(listener, tlsConfig)
+			}
 			err = kern.registerListener(GRPCProcessName, listener)
 			if err != nil {
 				return nil, err
 			}
 
-			grpcServer := rpc.NewGRPCServer(kern.Logger)
+			serverOpts, err := messagesConf.ServerOptions()
+			if err != nil {
+				return nil, fmt.Errorf("could not configure GRPC messages: %v", err)
+			}
+			grpcServer := rpc.NewGRPCServer(kern.Logger, kern.Auth, kern.Features, kern.RateLimiter, serverOpts...)
 			var ks *keys.FilesystemKeyStore
 			if kern.keyStore != nil {
 				ks = kern.keyStore
@@ -316,24 +603,58 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 				keys.RegisterKeysServer(grpcServer, ks)
 			}
 			rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, kern.Blockchain, nodeView,
-				kern.Logger))
+				grpcServer, kern.committer.PayloadTypes(), kern.Logger))
 
 			txCodec := txs.NewProtobufCodec()
 			rpctransact.RegisterTransactServer(grpcServer,
-				rpctransact.NewTransactServer(kern.State, kern.Blockchain, kern.Transactor, txCodec, kern.Logger))
+				rpctransact.NewTransactServer(kern.State, kern.natives, kern.Blockchain, kern.Transactor, kern.BroadcastACL, txCodec,
+					kern.Logger))
 
-			rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
-				kern.Emitter, kern.Blockchain, kern.Logger))
+			rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(
+				kern.eventsReader(), kern.State, kern.Emitter, kern.Blockchain,
+				rpcevents.NewSubscriptions(dbm.NewPrefixDB(kern.database, []byte("subscriptions/")),
+					kern.Auth == nil), kern.Logger))
 
 			rpcdump.RegisterDumpServer(grpcServer, rpcdump.NewDumpServer(kern.State, kern.Blockchain, kern.Logger))
 
+			rpcadmin.RegisterAdminServer(grpcServer, rpcadmin.NewAdminServer(kern.CircuitBreaker, kern.Emitter,
+				kern.Blockchain, kern.LiveLogging, kern.Logger))
+
+			rpcexplorer.RegisterExplorerServer(grpcServer, rpcexplorer.NewExplorerServer(kern.State, kern.Blockchain,
+				kern.Logger))
+
+			if kern.Verifier != nil {
+				rpcverify.RegisterVerifierServer(grpcServer, kern.Verifier)
+			}
+
 			// Provides metadata about services registered
-			// reflection.Register(grpcServer)
+			if reflectionConf != nil && reflectionConf.Enabled {
+				err = rpc.RegisterReflection(grpcServer)
+				if err != nil {
+					return nil, err
+				}
+			}
 
-			go grpcServer.Serve(listener)
+			// Only HTTP/2 connections reach a multiplexed listener so gRPC-web cannot be served on it
+			if webConf == nil || !webConf.Enabled || muxed {
+				go grpcServer.Serve(listener)
+
+				return process.ShutdownFunc(func(ctx context.Context) error {
+					grpcServer.Stop()
+					// listener is closed for us
+					return nil
+				}), nil
+			}
+
+			// Browsers speak HTTP/1 so serve them gRPC-web on the same port
+			grpcListener, webListener := rpc.SplitHTTP2(listener)
+			webServer := &http.Server{Handler: rpc.NewGRPCWebHandler(grpcServer, webConf.AllowedOrigins)}
+			go grpcServer.Serve(grpcListener)
+			go webServer.Serve(webListener)
 
 			return process.ShutdownFunc(func(ctx context.Context) error {
 				grpcServer.Stop()
+				webServer.Close()
 				// listener is closed for us
 				return nil
 			}), nil
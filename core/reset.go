@@ -0,0 +1,85 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/burrow/config"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// What to delete when resetting a node
+type ResetMode string
+
+const (
+	// Delete the consensus write-ahead log and evidence so a node stuck in consensus can restart from its last block
+	ResetConsensus ResetMode = "consensus"
+	// Delete Tendermint's blockstore and consensus state but keep Burrow's application state
+	ResetBlocks ResetMode = "blocks"
+	// Delete all chain data, keys and configuration are always kept
+	ResetAll ResetMode = "all"
+)
+
+// The databases Tendermint keeps in its data directory
+var tendermintDBs = []string{"blockstore.db", "state.db", "evidence.db", "tx_index.db"}
+
+// Returns the paths that a reset in mode would delete, only paths that exist are returned
+func ResetPaths(conf *config.BurrowConfig, mode ResetMode) ([]string, error) {
+	tmConf, err := conf.TendermintConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not build Tendermint config: %v", err)
+	}
+	// Tendermint is rooted in the Burrow directory even when disabled
+	tmConf.SetRoot(conf.BurrowDir)
+	walDir := filepath.Dir(tmConf.Consensus.WalFile())
+
+	var paths []string
+	switch mode {
+	case ResetConsensus:
+		paths = []string{walDir, filepath.Join(tmConf.DBDir(), "evidence.db")}
+	case ResetBlocks, ResetAll:
+		paths = []string{walDir}
+		for _, db := range tendermintDBs {
+			paths = append(paths, filepath.Join(tmConf.DBDir(), db))
+		}
+		if mode == ResetAll {
+			paths = append(paths, filepath.Join(conf.BurrowDir, BurrowDBName+".db"))
+		}
+	default:
+		return nil, fmt.Errorf("unknown reset mode '%s', should be one of '%s', '%s', or '%s'", mode,
+			ResetConsensus, ResetBlocks, ResetAll)
+	}
+
+	existing := paths[:0]
+	for _, path := range paths {
+		_, err := os.Stat(path)
+		if err == nil {
+			existing = append(existing, path)
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return existing, nil
+}
+
+// Deletes paths (as returned by ResetPaths) refusing to do so if the node appears to be running
+func Reset(conf *config.BurrowConfig, paths []string) error {
+	stateDB := filepath.Join(conf.BurrowDir, BurrowDBName+".db")
+	if _, err := os.Stat(stateDB); err == nil {
+		// Fails if the database is locked by a running node
+		db, err := leveldb.OpenFile(stateDB, &opt.Options{ErrorIfMissing: true})
+		if err != nil {
+			return fmt.Errorf("could not open %s, is the node still running? %v", stateDB, err)
+		}
+		db.Close()
+	}
+	for _, path := range paths {
+		err := os.RemoveAll(path)
+		if err != nil {
+			return fmt.Errorf("could not delete %s: %v", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestReset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-reset")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	conf := config.DefaultBurrowConfig()
+	conf.BurrowDir = dir
+
+	walDir := filepath.Join(dir, "data", "cs.wal")
+	blockstore := filepath.Join(dir, "data", "blockstore.db")
+	stateDB := filepath.Join(dir, BurrowDBName+".db")
+	for _, path := range []string{walDir, blockstore} {
+		require.NoError(t, os.MkdirAll(path, 0700))
+	}
+	db := dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dir)
+
+	paths, err := ResetPaths(conf, ResetConsensus)
+	require.NoError(t, err)
+	assert.Equal(t, []string{walDir}, paths)
+
+	paths, err = ResetPaths(conf, ResetBlocks)
+	require.NoError(t, err)
+	assert.Equal(t, []string{walDir, blockstore}, paths)
+
+	paths, err = ResetPaths(conf, ResetAll)
+	require.NoError(t, err)
+	assert.Equal(t, []string{walDir, blockstore, stateDB}, paths)
+
+	// Refuse while the state database is in use
+	require.Error(t, Reset(conf, paths))
+	db.Close()
+
+	require.NoError(t, Reset(conf, paths))
+	for _, path := range paths {
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	_, err = ResetPaths(conf, "everything")
+	require.Error(t, err)
+}
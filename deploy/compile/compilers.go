@@ -28,7 +28,10 @@ type SolidityInput struct {
 		Libraries map[string]map[string]string `json:"libraries"`
 		Optimizer struct {
 			Enabled bool `json:"enabled"`
+			Runs    int  `json:"runs,omitempty"`
 		} `json:"optimizer"`
+		EVMVersion      string `json:"evmVersion,omitempty"`
+		ViaIR           bool   `json:"viaIR,omitempty"`
 		OutputSelection struct {
 			File struct {
 				OutputType []string `json:"*"`
@@ -37,6 +40,24 @@ type SolidityInput struct {
 	} `json:"settings"`
 }
 
+// The outputs we select from solc for every contract
+var solidityOutputTypes = []string{"abi", "evm.bytecode.object", "evm.bytecode.linkReferences",
+	"evm.deployedBytecode.object", "evm.deployedBytecode.linkReferences", "metadata", "devdoc"}
+
+// Solc (from 0.6.8) warns about sources without an SPDX license identifier comment, which we do not count as a warning
+const missingSPDXErrorCode = "1878"
+
+// Options control how solc compiles Solidity
+type Options struct {
+	Optimize bool
+	// The number of times the optimizer assumes code will be run, solc's default of 200 if zero
+	OptimizeRuns int
+	// Compile via the Yul intermediate representation (requires solc 0.8.13 or later)
+	ViaIR bool
+	// The EVM version to target, solc's default if empty
+	EVMVersion string
+}
+
 // SolidityInputSource should be set for each solidity input source file in SolidityInput
 type SolidityInputSource struct {
 	Content string   `json:"content,omitempty"`
@@ -46,13 +67,17 @@ type SolidityInputSource struct {
 // SolidityOutput is a structure for the output of the solidity json output form
 type SolidityOutput struct {
 	Contracts map[string]map[string]SolidityContract
-	Errors    []struct {
-		Component        string
-		FormattedMessage string
-		Message          string
-		Severity         string
-		Type             string
-	}
+	Errors    []SolidityError
+}
+
+// SolidityError is an error, warning, or (from solc 0.8) informational message reported by the compiler
+type SolidityError struct {
+	Component        string
+	ErrorCode        string
+	FormattedMessage string
+	Message          string
+	Severity         string
+	Type             string
 }
 
 // SolidityContract is defined for each contract defined in the solidity source code
@@ -93,6 +118,17 @@ type SolidityMetadata struct {
 		Keccak256 string
 		Content   string
 		Urls      []string
+		License   string
+	}
+	Settings struct {
+		// Maps the source file to the name of the contract the metadata describes
+		CompilationTarget map[string]string
+		EVMVersion        string
+		ViaIR             bool
+		Optimizer         struct {
+			Enabled bool
+			Runs    int
+		}
 	}
 	// Other fields elided, see https://solidity.readthedocs.io/en/v0.5.10/metadata.html
 }
@@ -101,7 +137,9 @@ type Metadata struct {
 	ContractName    string
 	SourceFile      string
 	CompilerVersion string
-	Abi             json.RawMessage
+	// The keccak256 hash of the source file as reported by the compiler
+	SourceHash string `json:",omitempty"`
+	Abi        json.RawMessage
 }
 
 type MetadataMap struct {
@@ -233,18 +271,63 @@ func (contract *SolidityContract) Code() (code string) {
 }
 
 func EVM(file string, optimize bool, workDir string, libraries map[string]string, logger *logging.Logger) (*Response, error) {
-	input := SolidityInput{Language: "Solidity", Sources: make(map[string]SolidityInputSource)}
+	return EVMFiles([]string{file}, Options{Optimize: optimize}, workDir, libraries, logger)
+}
+
+// EVMSource compiles Solidity source passed directly rather than read from file, which names the source for the
+// purposes of the compiler output and metadata
+func EVMSource(file, source string, optimize bool, libraries map[string]string, logger *logging.Logger) (*Response, error) {
+	input := solidityInput(Options{Optimize: optimize}, libraries)
+	input.Sources[file] = SolidityInputSource{Content: source}
+	return compileEVM(input, "", logger)
+}
+
+// EVMFiles compiles several Solidity source files together, returning the contracts of all of them
+func EVMFiles(files []string, options Options, workDir string, libraries map[string]string,
+	logger *logging.Logger) (*Response, error) {
+	input := solidityInput(options, libraries)
+	for _, file := range files {
+		input.Sources[file] = SolidityInputSource{Urls: []string{file}}
+	}
+	return compileEVM(input, workDir, logger)
+}
+
+// EVMStandardJSON compiles a standard JSON input such as those produced by other build tools. The sources, remappings,
+// optimiser, and other settings of the input are used as they are but we select the outputs we need for every contract.
+func EVMStandardJSON(input []byte, workDir string, logger *logging.Logger) (*Response, error) {
+	var standard map[string]interface{}
+	err := json.Unmarshal(input, &standard)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode standard JSON input: %v", err)
+	}
+	settings, ok := standard["settings"].(map[string]interface{})
+	if !ok {
+		settings = make(map[string]interface{})
+		standard["settings"] = settings
+	}
+	settings["outputSelection"] = map[string]interface{}{
+		"*": map[string]interface{}{"*": solidityOutputTypes},
+	}
+	return compileEVM(standard, workDir, logger)
+}
 
-	input.Sources[file] = SolidityInputSource{Urls: []string{file}}
-	input.Settings.Optimizer.Enabled = optimize
-	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.deployedBytecode.object", "evm.bytecode.linkReferences", "metadata", "bin", "devdoc"}
+func solidityInput(options Options, libraries map[string]string) *SolidityInput {
+	input := &SolidityInput{Language: "Solidity", Sources: make(map[string]SolidityInputSource)}
+	input.Settings.Optimizer.Enabled = options.Optimize
+	input.Settings.Optimizer.Runs = options.OptimizeRuns
+	input.Settings.EVMVersion = options.EVMVersion
+	input.Settings.ViaIR = options.ViaIR
+	input.Settings.OutputSelection.File.OutputType = solidityOutputTypes
 	input.Settings.Libraries = make(map[string]map[string]string)
 	input.Settings.Libraries[""] = make(map[string]string)
 
 	for l, a := range libraries {
 		input.Settings.Libraries[""][l] = "0x" + a
 	}
+	return input
+}
 
+func compileEVM(input interface{}, workDir string, logger *logging.Logger) (*Response, error) {
 	command, err := json.Marshal(input)
 	if err != nil {
 		return nil, err
@@ -276,6 +359,7 @@ func EVM(file string, optimize bool, workDir string, libraries map[string]string
 						ContractName:    contractname,
 						SourceFile:      filename,
 						CompilerVersion: meta.Compiler.Version,
+						SourceHash:      meta.Sources[filename].Keccak256,
 						Abi:             item.Abi,
 					},
 				})
@@ -297,15 +381,7 @@ func EVM(file string, optimize bool, workDir string, libraries map[string]string
 		}
 	}
 
-	warnings := ""
-	errors := ""
-	for _, msg := range output.Errors {
-		if msg.Type == "Warning" {
-			warnings += msg.FormattedMessage
-		} else {
-			errors += msg.FormattedMessage
-		}
-	}
+	warnings, errors := output.messages(logger)
 
 	for _, re := range respItemArray {
 		logger.TraceMsg("Response formulated",
@@ -323,6 +399,27 @@ func EVM(file string, optimize bool, workDir string, libraries map[string]string
 	return &resp, nil
 }
 
+// Returns the formatted warnings and errors reported by the compiler. Informational messages and the warning about a
+// missing SPDX license identifier are logged rather than returned.
+func (output *SolidityOutput) messages(logger *logging.Logger) (warnings, errors string) {
+	for _, msg := range output.Errors {
+		severity := strings.ToLower(msg.Severity)
+		if severity == "" {
+			// Older compilers only give the type
+			severity = strings.ToLower(msg.Type)
+		}
+		switch {
+		case severity == "info" || msg.ErrorCode == missingSPDXErrorCode:
+			logger.TraceMsg("Compiler message", "message", msg.FormattedMessage)
+		case severity == "warning":
+			warnings += msg.FormattedMessage
+		default:
+			errors += msg.FormattedMessage
+		}
+	}
+	return
+}
+
 func WASM(file string, workDir string, logger *logging.Logger) (*Response, error) {
 	shellCmd := exec.Command("solang", "--target", "ewasm", "--standard-json", file)
 	if workDir != "" {
@@ -354,15 +451,7 @@ func WASM(file string, workDir string, logger *logging.Logger) (*Response, error
 		}
 	}
 
-	warnings := ""
-	errors := ""
-	for _, msg := range wasmoutput.Errors {
-		if msg.Type == "Warning" {
-			warnings += msg.FormattedMessage
-		} else {
-			errors += msg.FormattedMessage
-		}
-	}
+	warnings, errors := wasmoutput.messages(logger)
 
 	for _, re := range respItemArray {
 		logger.TraceMsg("Response formulated",
@@ -139,6 +139,43 @@ func TestFaultyContract(t *testing.T) {
 	fmt.Println(output)
 }
 
+func TestSolidityMessages(t *testing.T) {
+	output := SolidityOutput{
+		Errors: []SolidityError{
+			{Severity: "warning", ErrorCode: missingSPDXErrorCode, FormattedMessage: "no SPDX\n"},
+			{Severity: "info", FormattedMessage: "info\n"},
+			{Severity: "warning", ErrorCode: "2072", FormattedMessage: "unused variable\n"},
+			{Severity: "error", ErrorCode: "7576", FormattedMessage: "undeclared identifier\n"},
+			// Compilers before 0.5 only give the type
+			{Type: "Warning", FormattedMessage: "old warning\n"},
+		},
+	}
+	warnings, errors := output.messages(logging.NewNoopLogger())
+	assert.Equal(t, "unused variable\nold warning\n", warnings)
+	assert.Equal(t, "undeclared identifier\n", errors)
+}
+
+func TestSolidityInput(t *testing.T) {
+	input := solidityInput(Options{Optimize: true, OptimizeRuns: 1000, ViaIR: true, EVMVersion: "london"},
+		map[string]string{"Lib": "0000000000000000000000000000000000000001"})
+	bs, err := json.Marshal(input)
+	require.NoError(t, err)
+	var settings struct {
+		Settings map[string]json.RawMessage
+	}
+	require.NoError(t, json.Unmarshal(bs, &settings))
+	assert.JSONEq(t, `{"enabled":true,"runs":1000}`, string(settings.Settings["optimizer"]))
+	assert.Equal(t, `true`, string(settings.Settings["viaIR"]))
+	assert.Equal(t, `"london"`, string(settings.Settings["evmVersion"]))
+	assert.JSONEq(t, `{"":{"Lib":"0x0000000000000000000000000000000000000001"}}`, string(settings.Settings["libraries"]))
+
+	// Settings solc would reject before 0.8 are omitted unless given
+	bs, err = json.Marshal(solidityInput(Options{}, nil))
+	require.NoError(t, err)
+	assert.NotContains(t, string(bs), "viaIR")
+	assert.NotContains(t, string(bs), "evmVersion")
+}
+
 func testContractPath() string {
 	baseDir, _ := os.Getwd()
 	return filepath.Join(baseDir, "..", "..", "tests", "compilers_fixtures")
@@ -0,0 +1,122 @@
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+	"golang.org/x/crypto/sha3"
+)
+
+// VyperExtension is the file extension of Vyper sources, which are compiled with vyper rather than solc
+const VyperExtension = ".vy"
+
+// VyperContract is an entry of the vyper compiler's combined_json output
+type VyperContract struct {
+	Abi             json.RawMessage `json:"abi"`
+	Bytecode        string          `json:"bytecode"`
+	BytecodeRuntime string          `json:"bytecode_runtime"`
+}
+
+// IsVyper reports whether file is a Vyper source
+func IsVyper(file string) bool {
+	return filepath.Ext(file) == VyperExtension
+}
+
+// Vyper compiles a Vyper source file with the vyper compiler. Vyper has one contract per source file which is named
+// after the file.
+func Vyper(file string, workDir string, logger *logging.Logger) (*Response, error) {
+	shellCmd := exec.Command("vyper", "-f", "combined_json", file)
+	if workDir != "" {
+		shellCmd.Dir = workDir
+	}
+	output, err := shellCmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			// Compilation errors are reported on stderr
+			logger.InfoMsg("vyper failed", "output", string(exitErr.Stderr))
+			return &Response{Error: string(exitErr.Stderr)}, nil
+		}
+		return nil, err
+	}
+	logger.TraceMsg("Command Output", "result", string(output))
+
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workDir, path)
+	}
+	source, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := vyperResponse(output, source)
+	if err != nil {
+		return nil, err
+	}
+	for _, re := range resp.Objects {
+		logger.TraceMsg("Response formulated",
+			"name", re.Objectname,
+			"bin", re.Contract.Code(),
+			"abi", string(re.Contract.Abi))
+	}
+	return resp, nil
+}
+
+// Converts the combined_json output of vyper into a Response as produced for Solidity
+func vyperResponse(output, source []byte) (*Response, error) {
+	combined := make(map[string]json.RawMessage)
+	err := json.Unmarshal(output, &combined)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode vyper output: %v", err)
+	}
+	var version string
+	if bs, ok := combined["version"]; ok {
+		err = json.Unmarshal(bs, &version)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode vyper version: %v", err)
+		}
+		delete(combined, "version")
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(source)
+	sourceHash := "0x" + hex.EncodeToString(hash.Sum(nil))
+
+	resp := &Response{
+		Objects: make([]ResponseItem, 0, len(combined)),
+		Version: version,
+	}
+	for filename, bs := range combined {
+		vyc := new(VyperContract)
+		err = json.Unmarshal(bs, vyc)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode vyper output for %s: %v", filename, err)
+		}
+		name := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+		var contract SolidityContract
+		contract.Abi = vyc.Abi
+		contract.Evm.Bytecode.Object = strings.TrimPrefix(vyc.Bytecode, "0x")
+		contract.Evm.DeployedBytecode.Object = strings.TrimPrefix(vyc.BytecodeRuntime, "0x")
+		contract.MetadataMap = []MetadataMap{{
+			DeployedBytecode: contract.Evm.DeployedBytecode,
+			Metadata: Metadata{
+				ContractName:    name,
+				SourceFile:      filename,
+				CompilerVersion: version,
+				SourceHash:      sourceHash,
+				Abi:             vyc.Abi,
+			},
+		}}
+		resp.Objects = append(resp.Objects, ResponseItem{
+			Filename:   filename,
+			Objectname: name,
+			Contract:   contract,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,39 @@
+package compile
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVyperResponse(t *testing.T) {
+	output := []byte(`{
+  "storage.vy": {
+    "abi": [{"name": "get", "inputs": [], "outputs": [{"name": "", "type": "uint256"}], "stateMutability": "view", "type": "function"}],
+    "bytecode": "0x6100286100116100003961002861000ff3",
+    "bytecode_runtime": "0x600436101561000d57610022565b"
+  },
+  "version": "0.3.10+commit.91361694"
+}`)
+	resp, err := vyperResponse(output, []byte("# @version ^0.3.0\n"))
+	require.NoError(t, err)
+	require.Equal(t, "0.3.10+commit.91361694", resp.Version)
+	require.Len(t, resp.Objects, 1)
+
+	item := resp.Objects[0]
+	require.Equal(t, "storage", item.Objectname)
+	require.Equal(t, "storage.vy", item.Filename)
+	require.Equal(t, "6100286100116100003961002861000ff3", item.Contract.Evm.Bytecode.Object)
+	require.Equal(t, "600436101561000d57610022565b", item.Contract.Evm.DeployedBytecode.Object)
+
+	var abi []map[string]interface{}
+	require.NoError(t, json.Unmarshal(item.Contract.Abi, &abi))
+	require.Equal(t, "get", abi[0]["name"])
+
+	require.Len(t, item.Contract.MetadataMap, 1)
+	metadata := item.Contract.MetadataMap[0].Metadata
+	require.Equal(t, "storage", metadata.ContractName)
+	require.Equal(t, "0.3.10+commit.91361694", metadata.CompilerVersion)
+	require.Len(t, metadata.SourceHash, 66)
+}
@@ -1,11 +1,14 @@
 package def
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
@@ -43,6 +46,11 @@ type Client struct {
 	executionEventsClient rpcevents.ExecutionEventsClient
 	keyClient             keys.KeyClient
 	AllSpecs              *abi.Spec
+	// Guards dialling so the client can be shared by jobs running in parallel
+	dialMtx sync.Mutex
+	// Serialise locally signed transactions from each input account so that their sequence numbers do not collide
+	inputMtx   sync.Mutex
+	inputLocks map[crypto.Address]*sync.Mutex
 }
 
 func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout time.Duration) *Client {
@@ -57,8 +65,12 @@ func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout tim
 
 // Connect GRPC clients using ChainURL
 func (c *Client) dial(logger *logging.Logger) error {
+	c.dialMtx.Lock()
+	defer c.dialMtx.Unlock()
 	if c.transactClient == nil {
-		conn, err := grpc.Dial(c.ChainAddress, grpc.WithInsecure())
+		// Bound and retry calls so that jobs fail rather than hang if the chain becomes unresponsive
+		policy := rpc.DefaultCallPolicy()
+		conn, err := grpc.Dial(c.ChainAddress, append(policy.DialOptions(), grpc.WithInsecure())...)
 		if err != nil {
 			return err
 		}
@@ -226,6 +238,14 @@ func (c *Client) SignAndBroadcast(tx payload.Payload, logger *logging.Logger) (*
 	if err != nil {
 		return nil, err
 	}
+	if !c.MempoolSigning {
+		unlock := c.lockInputs(tx.GetInputs())
+		defer unlock()
+		err = c.refreshSequences(tx.GetInputs(), logger)
+		if err != nil {
+			return nil, err
+		}
+	}
 	txEnv, err := c.SignTx(tx, logger)
 	if err != nil {
 		return nil, err
@@ -233,6 +253,59 @@ func (c *Client) SignAndBroadcast(tx payload.Payload, logger *logging.Logger) (*
 	return unifyErrors(c.BroadcastEnvelope(txEnv, logger))
 }
 
+// Locks each input account (in address order to avoid deadlock) returning a function to unlock them
+func (c *Client) lockInputs(inputs []*payload.TxInput) func() {
+	addresses := make([]crypto.Address, len(inputs))
+	for i, input := range inputs {
+		addresses[i] = input.Address
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+	c.inputMtx.Lock()
+	if c.inputLocks == nil {
+		c.inputLocks = make(map[crypto.Address]*sync.Mutex)
+	}
+	locks := make([]*sync.Mutex, 0, len(addresses))
+	for i, address := range addresses {
+		if i > 0 && address == addresses[i-1] {
+			continue
+		}
+		lock, ok := c.inputLocks[address]
+		if !ok {
+			lock = new(sync.Mutex)
+			c.inputLocks[address] = lock
+		}
+		locks = append(locks, lock)
+	}
+	c.inputMtx.Unlock()
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for _, lock := range locks {
+			lock.Unlock()
+		}
+	}
+}
+
+// Advances any input sequence numbers that have been used since the transaction was formulated (as happens when jobs
+// that send from the same account run in parallel)
+func (c *Client) refreshSequences(inputs []*payload.TxInput, logger *logging.Logger) error {
+	for _, input := range inputs {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		acc, err := c.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: input.Address})
+		cancel()
+		if err != nil {
+			return err
+		}
+		if input.Sequence <= acc.GetSequence() {
+			logger.TraceMsg("Advancing stale input sequence", "address", input.Address,
+				"sequence", input.Sequence, "next_sequence", acc.GetSequence()+1)
+			input.Sequence = acc.GetSequence() + 1
+		}
+	}
+	return nil
+}
+
 func (c *Client) SignTx(tx payload.Payload, logger *logging.Logger) (*txs.Envelope, error) {
 	err := c.dial(logger)
 	if err != nil {
@@ -258,6 +331,15 @@ func (c *Client) SignTx(tx payload.Payload, logger *logging.Logger) (*txs.Envelo
 	return txEnv, nil
 }
 
+// Encloses tx in an unsigned Envelope for the connected chain
+func (c *Client) Enclose(tx payload.Payload, logger *logging.Logger) (*txs.Envelope, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	return txs.Enclose(c.chainID, tx), nil
+}
+
 // Creates a keypair using attached keys service
 func (c *Client) CreateKey(keyName, curveTypeString string, logger *logging.Logger) (crypto.PublicKey, error) {
 	err := c.dial(logger)
@@ -334,6 +416,24 @@ func (c *Client) QueryContract(arg *QueryArg, logger *logging.Logger) (*exec.TxE
 	return unifyErrors(c.transactClient.CallTxSim(ctx, tx))
 }
 
+// Simulate tx against current state without broadcasting it. A tx that creates a contract is simulated by running its
+// init code as a call from its input.
+func (c *Client) SimulateCallTx(tx *payload.CallTx, logger *logging.Logger) (*exec.TxExecution, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	if tx.Address == nil {
+		return unifyErrors(c.transactClient.CallCodeSim(ctx, &rpctransact.CallCodeParam{
+			FromAddress: tx.Input.Address,
+			Code:        tx.Data,
+		}))
+	}
+	return unifyErrors(c.transactClient.CallTxSim(ctx, tx))
+}
+
 // Transaction types
 
 type GovArg struct {
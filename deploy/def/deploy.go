@@ -26,9 +26,16 @@ type DeployArgs struct {
 	Path          string   `mapstructure:"," json:"," yaml:"," toml:","`
 	Verbose       bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	Jobs          int      `mapstructure:"," json:"," yaml:"," toml:","`
+	Parallel      int      `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeVerify bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeVote   bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeCreate bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	GasReport     bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	RollbackPlan  bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	Optimize      bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	OptimizeRuns  int      `mapstructure:"," json:"," yaml:"," toml:","`
+	ViaIR         bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	EVMVersion    string   `mapstructure:"," json:"," yaml:"," toml:","`
 }
 
 func (args *DeployArgs) Validate() error {
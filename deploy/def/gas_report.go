@@ -0,0 +1,105 @@
+package def
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+const GasReportSuffix = ".gas.json"
+
+// GasReport collects the gas used by the transactions issued by the deploy and call jobs of a playbook so that gas
+// regressions can be tracked across contract versions
+type GasReport struct {
+	sync.Mutex
+	// Gas used by each transaction in the order it was issued
+	Transactions []*TxGas
+}
+
+// The gas used by a single deploy or call transaction
+type TxGas struct {
+	Job      string
+	Contract string
+	// The function called, or 'constructor' for a deploy
+	Function string
+	GasUsed  uint64
+	// Gas used per opcode as measured by simulating the transaction before it was broadcast
+	Opcodes []*exec.OpcodeGas `json:",omitempty"`
+}
+
+// The gas used by all calls to a contract function
+type FunctionGas struct {
+	Contract   string
+	Function   string
+	Calls      uint64
+	GasUsed    uint64
+	MinGasUsed uint64
+	MaxGasUsed uint64
+	AvgGasUsed uint64
+}
+
+// The aggregated report as written to the gas report artifact
+type GasReportOutput struct {
+	Functions    []*FunctionGas
+	Opcodes      []*exec.OpcodeGas
+	Transactions []*TxGas
+}
+
+func (gr *GasReport) Add(txGas *TxGas) {
+	gr.Lock()
+	defer gr.Unlock()
+	gr.Transactions = append(gr.Transactions, txGas)
+}
+
+// Aggregate gas used by function and by opcode across all transactions
+func (gr *GasReport) Output() *GasReportOutput {
+	gr.Lock()
+	defer gr.Unlock()
+	functions := make(map[[2]string]*FunctionGas)
+	opcodes := make(map[string]*exec.OpcodeGas)
+	out := &GasReportOutput{
+		Transactions: gr.Transactions,
+	}
+	for _, tx := range gr.Transactions {
+		key := [2]string{tx.Contract, tx.Function}
+		fn, ok := functions[key]
+		if !ok {
+			fn = &FunctionGas{Contract: tx.Contract, Function: tx.Function, MinGasUsed: tx.GasUsed}
+			functions[key] = fn
+			out.Functions = append(out.Functions, fn)
+		}
+		fn.Calls++
+		fn.GasUsed += tx.GasUsed
+		if tx.GasUsed < fn.MinGasUsed {
+			fn.MinGasUsed = tx.GasUsed
+		}
+		if tx.GasUsed > fn.MaxGasUsed {
+			fn.MaxGasUsed = tx.GasUsed
+		}
+		fn.AvgGasUsed = fn.GasUsed / fn.Calls
+		for _, op := range tx.Opcodes {
+			agg, ok := opcodes[op.Opcode]
+			if !ok {
+				agg = &exec.OpcodeGas{Opcode: op.Opcode}
+				opcodes[op.Opcode] = agg
+				out.Opcodes = append(out.Opcodes, agg)
+			}
+			agg.Count += op.Count
+			agg.Gas += op.Gas
+		}
+	}
+	sort.SliceStable(out.Functions, func(i, j int) bool {
+		if out.Functions[i].Contract != out.Functions[j].Contract {
+			return out.Functions[i].Contract < out.Functions[j].Contract
+		}
+		return out.Functions[i].Function < out.Functions[j].Function
+	})
+	sort.SliceStable(out.Opcodes, func(i, j int) bool {
+		if out.Opcodes[i].Gas != out.Opcodes[j].Gas {
+			return out.Opcodes[i].Gas > out.Opcodes[j].Gas
+		}
+		return out.Opcodes[i].Opcode < out.Opcodes[j].Opcode
+	})
+	return out
+}
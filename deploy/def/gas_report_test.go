@@ -0,0 +1,28 @@
+package def
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasReport_Output(t *testing.T) {
+	report := new(GasReport)
+	report.Add(&TxGas{Job: "deploy", Contract: "Store", Function: "constructor", GasUsed: 500,
+		Opcodes: []*exec.OpcodeGas{{Opcode: "SSTORE", Count: 1, Gas: 200}}})
+	report.Add(&TxGas{Job: "set1", Contract: "Store", Function: "set", GasUsed: 100,
+		Opcodes: []*exec.OpcodeGas{{Opcode: "SSTORE", Count: 1, Gas: 50}, {Opcode: "PUSH1", Count: 4, Gas: 12}}})
+	report.Add(&TxGas{Job: "set2", Contract: "Store", Function: "set", GasUsed: 300})
+
+	out := report.Output()
+	require.Len(t, out.Transactions, 3)
+	require.Equal(t, []*FunctionGas{
+		{Contract: "Store", Function: "constructor", Calls: 1, GasUsed: 500, MinGasUsed: 500, MaxGasUsed: 500, AvgGasUsed: 500},
+		{Contract: "Store", Function: "set", Calls: 2, GasUsed: 400, MinGasUsed: 100, MaxGasUsed: 300, AvgGasUsed: 200},
+	}, out.Functions)
+	require.Equal(t, []*exec.OpcodeGas{
+		{Opcode: "SSTORE", Count: 2, Gas: 250},
+		{Opcode: "PUSH1", Count: 4, Gas: 12},
+	}, out.Opcodes)
+}
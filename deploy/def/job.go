@@ -31,6 +31,8 @@ type Job struct {
 	Set *Set `mapstructure:"set,omitempty" json:"set,omitempty" yaml:"set,omitempty" toml:"set"`
 	// Run a sequence of other deploy.yamls
 	Meta *Meta `mapstructure:"meta,omitempty" json:"meta,omitempty" yaml:"meta,omitempty" toml:"meta"`
+	// Run the jobs of another playbook in their own scope, passing parameters to them and exposing their results
+	Include *Include `mapstructure:"include,omitempty" json:"include,omitempty" yaml:"include,omitempty" toml:"include"`
 	// Issue a governance transaction
 	UpdateAccount *UpdateAccount `mapstructure:"update-account,omitempty" json:"update-account,omitempty" yaml:"update-account,omitempty" toml:"update-account"`
 	// Contract compile and send to the chain functions
@@ -66,6 +68,16 @@ type Job struct {
 	QueryVals *QueryVals `mapstructure:"query-vals,omitempty" json:"query-vals,omitempty" yaml:"query-vals,omitempty" toml:"query-vals"`
 	// Makes and assertion (useful for testing purposes)
 	Assert *Assert `mapstructure:"assert,omitempty" json:"assert,omitempty" yaml:"assert,omitempty" toml:"assert"`
+	// Sends a transaction to a contract that is expected to revert, optionally with a given reason (useful for testing
+	// failure paths)
+	AssertRevert *AssertRevert `mapstructure:"assert-revert,omitempty" json:"assert-revert,omitempty" yaml:"assert-revert,omitempty" toml:"assert-revert"`
+	// (Optional) only run the job when this condition holds, otherwise skip it
+	If *Condition `mapstructure:"if,omitempty" json:"if,omitempty" yaml:"if,omitempty" toml:"if"`
+	// (Optional) run the job once for each element of a list
+	ForEach *Loop `mapstructure:"for-each,omitempty" json:"for-each,omitempty" yaml:"for-each,omitempty" toml:"for-each"`
+	// (Optional) names of earlier jobs that must complete before this one when jobs are run in parallel, in addition to
+	// any jobs whose results this one refers to
+	DependsOn []string `mapstructure:"depends-on,omitempty" json:"depends-on,omitempty" yaml:"depends-on,omitempty" toml:"depends-on"`
 }
 
 type Payload interface {
@@ -82,6 +94,8 @@ func (job *Job) Validate() error {
 			Error("must contain word characters; alphanumeric plus underscores/hyphens")),
 		validation.Field(&job.Result, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
 		validation.Field(&job.Variables, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
+		validation.Field(&job.If),
+		validation.Field(&job.ForEach),
 		validation.Field(payloadField.Addr().Interface()),
 	)
 }
@@ -104,7 +118,8 @@ func (job *Job) PayloadField() (reflect.Value, error) {
 
 	payloadIndex := -1
 	for i := 0; i < rt.NumField(); i++ {
-		if rt.Field(i).Type.Implements(payloadType) && !rv.Field(i).IsNil() {
+		ft := rt.Field(i).Type
+		if ft.Implements(payloadType) && !ft.Implements(controlType) && !rv.Field(i).IsNil() {
 			if payloadIndex >= 0 {
 				return reflect.Value{}, fmt.Errorf("only one Job payload field should be set, but both '%v' and '%v' are set",
 					rt.Field(payloadIndex).Name, rt.Field(i).Name)
@@ -41,3 +41,39 @@ func TestJob_Validate(t *testing.T) {
 	err = job.Validate()
 	require.NoError(t, err)
 }
+
+func TestJob_ValidateControl(t *testing.T) {
+	job := &Job{
+		Name: "guarded",
+		If: &Condition{
+			Key:      "$foo",
+			Relation: "eq",
+			Value:    "bar",
+		},
+		ForEach: &Loop{
+			Items: "$list",
+		},
+		Set: &Set{Value: "$item"},
+	}
+	// Control fields are not payloads
+	payload, err := job.Payload()
+	require.NoError(t, err)
+	assert.Equal(t, job.Set, payload)
+	require.NoError(t, job.Validate())
+
+	job.If.Relation = "sort of"
+	require.Error(t, job.Validate())
+	job.If.Relation = "=="
+
+	job.ForEach.As = "not a word"
+	require.Error(t, job.Validate())
+}
+
+func TestLoop_Elements(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, (&Loop{Items: "[a, b,c]"}).Elements())
+	assert.Equal(t, []string{"a", "b"}, (&Loop{Items: `"a","b"`}).Elements())
+	assert.Equal(t, []string{"a"}, (&Loop{Items: "a"}).Elements())
+	assert.Nil(t, (&Loop{Items: "[]"}).Elements())
+	assert.Equal(t, "item", (&Loop{}).Variable())
+	assert.Equal(t, "token", (&Loop{As: "token"}).Variable())
+}
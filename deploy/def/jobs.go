@@ -2,7 +2,9 @@ package def
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
@@ -75,6 +77,29 @@ func (job *Meta) Validate() error {
 	)
 }
 
+type Include struct {
+	// (Required) the file path of the playbook to include
+	File string `mapstructure:"file" json:"file" yaml:"file" toml:"file"`
+	// (Optional) parameters made available to the jobs of the included playbook as $<name>, which is all they can
+	// refer to outside of their own playbook
+	With     map[string]string `mapstructure:"with" json:"with,omitempty" yaml:"with,omitempty" toml:"with"`
+	Playbook *Playbook         `json:"-" yaml:"-" toml:"-"`
+}
+
+var parameterNameRegex = regexp.MustCompile(`^[[:word:]]+$`)
+
+func (job *Include) Validate() error {
+	for name := range job.With {
+		if !parameterNameRegex.MatchString(name) {
+			return fmt.Errorf("include parameter name '%s' must contain only alphanumeric characters and "+
+				"underscores", name)
+		}
+	}
+	return validation.ValidateStruct(job,
+		validation.Field(&job.File, validation.Required),
+	)
+}
+
 // ------------------------------------------------------------------------
 // Governance Jobs
 // ------------------------------------------------------------------------
@@ -243,7 +268,7 @@ type Permission struct {
 	// (Optional, if account job or global account set) address of the account from which to send (the
 	// public key for the account must be available to burrow keys)
 	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
-	// (Required) actions must be in the set ["set_base", "unset_base", "set_global", "add_role" "rm_role"]
+	// (Required) actions must be in the set ["set_base", "unset_base", "set_global", "add_role" "rm_role", "pause"]
 	Action string `mapstructure:"action" json:"action" yaml:"action" toml:"action"`
 	// (Required, unless add_role or rm_role action selected) the name of the permission flag which is to
 	// be updated
@@ -544,3 +569,93 @@ func (job *Assert) Validate() error {
 		validation.Field(&job.Relation, validation.Required, rule.Relation),
 	)
 }
+
+type AssertRevert struct {
+	// (Required) the call that is expected to revert, given by the same fields as a call job
+	Call `mapstructure:",squash" yaml:",inline"`
+	// (Optional) the revert reason the call is expected to give, if omitted any revert passes the assertion
+	Reason string `mapstructure:"reason" json:"reason" yaml:"reason" toml:"reason"`
+}
+
+func (job *AssertRevert) Validate() error {
+	return job.Call.Validate()
+}
+
+// ------------------------------------------------------------------------
+// Control Flow
+// ------------------------------------------------------------------------
+
+// The maximum number of iterations of a for-each loop when none is given
+const DefaultMaxIterations = 100
+
+// Marks the fields of a Job that control how its payload is run rather than being a payload themselves
+type control interface {
+	isControl()
+}
+
+var controlType = reflect.TypeOf((*control)(nil)).Elem()
+
+// Condition guards a job so that it only runs when the relation between Key and Value holds, otherwise the job is
+// skipped. Key and Value are usually variable expansions from earlier query or assert jobs.
+type Condition struct {
+	// (Required) the key to compare (as for an assert job)
+	Key string `mapstructure:"key" json:"key" yaml:"key" toml:"key"`
+	// (Required) must be of the set ["eq", "ne", "ge", "gt", "le", "lt", "==", "!=", ">=", ">", "<=", "<"]
+	Relation string `mapstructure:"relation" json:"relation" yaml:"relation" toml:"relation"`
+	// (Required) the value to compare against the key
+	Value string `mapstructure:"val" json:"val" yaml:"val" toml:"val"`
+}
+
+func (cond *Condition) isControl() {}
+
+func (cond *Condition) Validate() error {
+	return validation.ValidateStruct(cond,
+		validation.Field(&cond.Relation, validation.Required, rule.Relation),
+	)
+}
+
+// Loop repeats a job once for each element of a list, which is available to the job as a variable named by As
+type Loop struct {
+	// (Required) the list to iterate over, either comma separated or of the form [a,b,c] - usually a variable
+	// expansion from an earlier set or query job
+	Items string `mapstructure:"items" json:"items" yaml:"items" toml:"items"`
+	// (Optional) the name of the variable holding the current element, defaults to 'item'
+	As string `mapstructure:"as" json:"as" yaml:"as" toml:"as"`
+	// (Optional) the maximum number of elements the list may have, defaults to DefaultMaxIterations
+	MaxIterations string `mapstructure:"max-iterations" json:"max-iterations" yaml:"max-iterations" toml:"max-iterations"`
+}
+
+func (loop *Loop) isControl() {}
+
+func (loop *Loop) Validate() error {
+	return validation.ValidateStruct(loop,
+		validation.Field(&loop.Items, validation.Required),
+		validation.Field(&loop.As, validation.Match(regexp.MustCompile(`^[[:word:]]+$`)).
+			Error("must contain only word characters; alphanumeric plus underscores")),
+		validation.Field(&loop.MaxIterations, rule.Uint64OrPlaceholder),
+	)
+}
+
+// The name of the variable holding the current element
+func (loop *Loop) Variable() string {
+	if loop.As == "" {
+		return "item"
+	}
+	return loop.As
+}
+
+// Splits the (pre-processed) items into the list of elements to iterate over
+func (loop *Loop) Elements() []string {
+	items := strings.TrimSpace(loop.Items)
+	if strings.HasPrefix(items, "[") && strings.HasSuffix(items, "]") {
+		items = items[1 : len(items)-1]
+	}
+	if strings.TrimSpace(items) == "" {
+		return nil
+	}
+	elements := strings.Split(items, ",")
+	for i, element := range elements {
+		elements[i] = strings.Trim(strings.TrimSpace(element), `"`)
+	}
+	return elements
+}
@@ -12,6 +12,26 @@ type Playbook struct {
 	BinPath    string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
 	// If we're in a proposal or meta job, reference our parent script
 	Parent *Playbook `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// Collects gas used by deploy and call jobs if a gas report was requested (only set on the outermost playbook)
+	GasReport *GasReport `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// Collects jobs reverting the changes made if a rollback plan was requested (only set on the outermost playbook)
+	RollbackPlan *RollbackPlan `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+}
+
+// Returns the gas report of the outermost playbook, or nil if no gas report was requested
+func (pkg *Playbook) GetGasReport() *GasReport {
+	for pkg.Parent != nil {
+		pkg = pkg.Parent
+	}
+	return pkg.GasReport
+}
+
+// Returns the rollback plan of the outermost playbook, or nil if no rollback plan was requested
+func (pkg *Playbook) GetRollbackPlan() *RollbackPlan {
+	for pkg.Parent != nil {
+		pkg = pkg.Parent
+	}
+	return pkg.RollbackPlan
 }
 
 func (pkg *Playbook) Validate() error {
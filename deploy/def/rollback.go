@@ -0,0 +1,54 @@
+package def
+
+import (
+	"fmt"
+	"sync"
+)
+
+const RollbackPlanSuffix = ".rollback.json"
+
+// RollbackPlan collects jobs that revert the changes made by the jobs of a playbook, where the prior state could be
+// determined, so that a failed release can be reverted with 'burrow deploy rollback'
+type RollbackPlan struct {
+	sync.Mutex `json:"-"`
+	// The playbook whose changes are reverted
+	Playbook string
+	// Jobs reverting the changes made, in the order they should be run (the reverse of that in which the changes were
+	// made)
+	Jobs []*Job
+	// Changes that cannot be reverted automatically and must be reviewed by hand
+	Irreversible []*IrreversibleChange `json:",omitempty"`
+	// The number of reverting jobs recorded
+	reverts int
+}
+
+// A change made by a job that the rollback plan does not revert
+type IrreversibleChange struct {
+	Job  string
+	Type string
+}
+
+// Revert records the jobs reverting a change made by the named job ahead of those reverting earlier changes. Each is
+// named after job and numbered in the order in which the changes were recorded so that names stay unique when the
+// same job runs more than once.
+func (rp *RollbackPlan) Revert(job string, reverts ...*Job) {
+	if len(reverts) == 0 {
+		return
+	}
+	rp.Lock()
+	defer rp.Unlock()
+	jobs := make([]*Job, 0, len(reverts)+len(rp.Jobs))
+	for _, revert := range reverts {
+		rp.reverts++
+		revert.Name = fmt.Sprintf("rollback_%d_%s", rp.reverts, job)
+		jobs = append(jobs, revert)
+	}
+	rp.Jobs = append(jobs, rp.Jobs...)
+}
+
+// AddIrreversible records that the named job of type typ made a change that the plan cannot revert
+func (rp *RollbackPlan) AddIrreversible(job, typ string) {
+	rp.Lock()
+	defer rp.Unlock()
+	rp.Irreversible = append(rp.Irreversible, &IrreversibleChange{Job: job, Type: typ})
+}
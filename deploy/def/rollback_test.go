@@ -0,0 +1,25 @@
+package def
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackPlan_Revert(t *testing.T) {
+	plan := new(RollbackPlan)
+	plan.Revert("perms", &Job{Permission: &Permission{Action: "unset_base"}},
+		&Job{Permission: &Permission{Action: "rm_role"}})
+	plan.Revert("empty")
+	plan.Revert("name", &Job{RegisterName: &RegisterName{Name: "foo"}})
+	plan.AddIrreversible("send", "Send")
+
+	names := make([]string, len(plan.Jobs))
+	for i, job := range plan.Jobs {
+		names[i] = job.Name
+	}
+	// Later changes are reverted first but the jobs reverting a single change keep their order
+	require.Equal(t, []string{"rollback_3_name", "rollback_1_perms", "rollback_2_perms"}, names)
+	require.Equal(t, "unset_base", plan.Jobs[1].Permission.Action)
+	require.Equal(t, []*IrreversibleChange{{Job: "send", Type: "Send"}}, plan.Irreversible)
+}
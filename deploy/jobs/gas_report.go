@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+const constructorFunction = "constructor"
+
+// Records the gas used by the transactions issued by a job in the gas report of its playbook. A nil gasRecorder
+// records nothing so jobs can use it unconditionally.
+type gasRecorder struct {
+	job    string
+	report *def.GasReport
+	client *def.Client
+	logger *logging.Logger
+}
+
+func newGasRecorder(job *def.Job, playbook *def.Playbook, client *def.Client, logger *logging.Logger) *gasRecorder {
+	report := playbook.GetGasReport()
+	if report == nil {
+		return nil
+	}
+	return &gasRecorder{
+		job:    job.Name,
+		report: report,
+		client: client,
+		logger: logger,
+	}
+}
+
+// Simulate tx before it is broadcast to measure the gas used by each opcode. Failure to simulate is logged rather
+// than returned since the gas report should not prevent the transaction from being attempted.
+func (gr *gasRecorder) profile(tx *payload.CallTx) []*exec.OpcodeGas {
+	if gr == nil || len(tx.WASM) > 0 {
+		return nil
+	}
+	txe, err := gr.client.SimulateCallTx(tx, gr.logger)
+	if err != nil {
+		gr.logger.InfoMsg("Could not simulate transaction for gas report", "job", gr.job, "error", err)
+		return nil
+	}
+	return txe.GetResult().GetGasProfile()
+}
+
+func (gr *gasRecorder) record(contract, function string, txe *exec.TxExecution, opcodes []*exec.OpcodeGas) {
+	if gr == nil {
+		return
+	}
+	gr.report.Add(&def.TxGas{
+		Job:      gr.job,
+		Contract: contract,
+		Function: function,
+		GasUsed:  txe.GetResult().GetGasUsed(),
+		Opcodes:  opcodes,
+	})
+}
+
+func WriteGasReport(report *def.GasReport, file string) error {
+	bs, err := json.MarshalIndent(report.Output(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, bs, 0644)
+}
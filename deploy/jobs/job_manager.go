@@ -1,14 +1,17 @@
 package jobs
 
 import (
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
 
+	"github.com/hyperledger/burrow/acm"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/loggers"
 	pbpayload "github.com/hyperledger/burrow/txs/payload"
 )
 
@@ -31,13 +34,19 @@ type compilerJob struct {
 	done         chan struct{}
 }
 
-func solcRunner(jobs chan *compilerJob, logger *logging.Logger) {
+func solcRunner(jobs chan *compilerJob, options compilers.Options, logger *logging.Logger) {
 	for {
 		job, ok := <-jobs
 		if !ok {
 			break
 		}
-		resp, err := compilers.EVM(job.work.contractName, false, job.work.workDir, nil, logger)
+		var resp *compilers.Response
+		var err error
+		if compilers.IsVyper(job.work.contractName) {
+			resp, err = compilers.Vyper(job.work.contractName, job.work.workDir, logger)
+		} else {
+			resp, err = compilers.EVMFiles([]string{job.work.contractName}, options, job.work.workDir, nil, logger)
+		}
 		(*job).compilerResp = resp
 		(*job).err = err
 		close(job.done)
@@ -50,7 +59,14 @@ func solangRunner(jobs chan *compilerJob, logger *logging.Logger) {
 		if !ok {
 			return
 		}
-		resp, err := compilers.WASM(job.work.contractName, job.work.workDir, logger)
+		var resp *compilers.Response
+		var err error
+		if compilers.IsVyper(job.work.contractName) {
+			// Vyper only targets the EVM
+			resp, err = compilers.Vyper(job.work.contractName, job.work.workDir, logger)
+		} else {
+			resp, err = compilers.WASM(job.work.contractName, job.work.workDir, logger)
+		}
 		(*job).compilerResp = resp
 		(*job).err = err
 		close(job.done)
@@ -76,7 +92,7 @@ func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *compiler
 		job.Intermediate = &intermediate
 		jobs <- &intermediate
 	case *def.Deploy:
-		if filepath.Ext(job.Deploy.Contract) == ".sol" {
+		if filepath.Ext(job.Deploy.Contract) == ".sol" || compilers.IsVyper(job.Deploy.Contract) {
 			intermediate := compilerJob{
 				done: make(chan struct{}),
 				work: solidityCompilerWork{
@@ -101,6 +117,13 @@ func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *compiler
 				return err
 			}
 		}
+	case *def.Include:
+		for _, job := range job.Include.Playbook.Jobs {
+			err = queueCompilerWork(job, playbook, jobs)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -117,184 +140,242 @@ func getCompilerWork(intermediate interface{}) (*compilers.Response, error) {
 }
 
 func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	if args.Parallel > 1 {
+		return doJobsParallel(playbook, args, client, logger)
+	}
 	for _, job := range playbook.Jobs {
-		payload, err := job.Payload()
+		err := doPlaybookJob(job, playbook, args, client, logger)
 		if err != nil {
-			return fmt.Errorf("could not get Job payload: %v", payload)
+			return err
 		}
+	}
+
+	return nil
+}
+
+func doPlaybookJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client,
+	logger *logging.Logger) error {
+	if job.ForEach != nil {
+		return doLoop(job, playbook, args, client, logger)
+	}
+	return doGuardedJob(job, playbook, args, client, logger)
+}
 
-		err = util.PreProcessFields(payload, args, playbook, client, logger)
+func doJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	payload, err := job.Payload()
+	if err != nil {
+		return fmt.Errorf("could not get Job payload: %v", payload)
+	}
+
+	err = util.PreProcessFields(payload, args, playbook, client, logger)
+	if err != nil {
+		return err
+	}
+	// Revalidate with possible replacements
+	err = payload.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+	}
+
+	switch payload.(type) {
+	case *def.Proposal:
+		announce(job.Name, "Proposal", logger)
+		job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
+		if err == nil {
+			newRollbackRecorder(job, playbook, client, logger).irreversible("Proposal")
+		}
+
+	// Meta Job
+	case *def.Meta:
+		announce(job.Name, "Meta", logger)
+		metaPlaybook := job.Meta.Playbook
+		if metaPlaybook.Account == "" {
+			metaPlaybook.Account = playbook.Account
+		}
+		err = doJobs(metaPlaybook, args, client, logger)
+	case *def.Include:
+		announce(job.Name, "Include", logger)
+		job.Result, job.Variables, err = IncludeJob(job.Include, args, playbook, client, logger)
+
+	// Governance
+	case *def.UpdateAccount:
+		announce(job.Name, "UpdateAccount", logger)
+		var tx *pbpayload.GovTx
+		tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
 		if err != nil {
 			return err
 		}
-		// Revalidate with possible replacements
-		err = payload.Validate()
-		if err != nil {
-			return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+		rollback := newRollbackRecorder(job, playbook, client, logger)
+		before := rollback.account(*tx.AccountUpdates[0].Address)
+		err = UpdateAccountJob(tx, client, logger)
+		if err == nil {
+			rollback.revertAccount("UpdateAccount", job.UpdateAccount.Source, before, true)
 		}
 
-		switch payload.(type) {
-		case *def.Proposal:
-			announce(job.Name, "Proposal", logger)
-			job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
-
-		// Meta Job
-		case *def.Meta:
-			announce(job.Name, "Meta", logger)
-			metaPlaybook := job.Meta.Playbook
-			if metaPlaybook.Account == "" {
-				metaPlaybook.Account = playbook.Account
-			}
-			err = doJobs(metaPlaybook, args, client, logger)
-
-		// Governance
-		case *def.UpdateAccount:
-			announce(job.Name, "UpdateAccount", logger)
-			var tx *pbpayload.GovTx
-			tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			err = UpdateAccountJob(tx, client, logger)
-
-		// Util jobs
-		case *def.Account:
-			announce(job.Name, "Account", logger)
-			job.Result, err = SetAccountJob(job.Account, playbook, logger)
-		case *def.Set:
-			announce(job.Name, "Set", logger)
-			job.Result, err = SetValJob(job.Set, args, logger)
-
-		// Transaction jobs
-		case *def.Send:
-			announce(job.Name, "Send", logger)
-			tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = SendJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Bond:
-			announce(job.Name, "Bond", logger)
-			tx, err := FormulateBondJob(job.Bond, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BondJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Unbond:
-			announce(job.Name, "Unbond", logger)
-			tx, err := FormulateUnbondJob(job.Unbond, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = UnbondJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.RegisterName:
-			announce(job.Name, "RegisterName", logger)
-			txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = RegisterNameJob(txs, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Permission:
-			announce(job.Name, "Permission", logger)
-			tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = PermissionJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Identify:
-			announce(job.Name, "Identify", logger)
-			tx, err := FormulateIdentifyJob(job.Identify, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = IdentifyJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-
-		// Contracts jobs
-		case *def.Deploy:
-			announce(job.Name, "Deploy", logger)
-			txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, err = DeployJob(job.Deploy, playbook, client, txs, contracts, logger)
+	// Util jobs
+	case *def.Account:
+		announce(job.Name, "Account", logger)
+		job.Result, err = SetAccountJob(job.Account, playbook, logger)
+	case *def.Set:
+		announce(job.Name, "Set", logger)
+		job.Result, err = SetValJob(job.Set, args, logger)
+
+	// Transaction jobs
+	case *def.Send:
+		announce(job.Name, "Send", logger)
+		tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = SendJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+		newRollbackRecorder(job, playbook, client, logger).irreversible("Send")
+	case *def.Bond:
+		announce(job.Name, "Bond", logger)
+		tx, err := FormulateBondJob(job.Bond, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = BondJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+		newRollbackRecorder(job, playbook, client, logger).irreversible("Bond")
+	case *def.Unbond:
+		announce(job.Name, "Unbond", logger)
+		tx, err := FormulateUnbondJob(job.Unbond, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = UnbondJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+		newRollbackRecorder(job, playbook, client, logger).irreversible("Unbond")
+	case *def.RegisterName:
+		announce(job.Name, "RegisterName", logger)
+		txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
+		if err != nil {
+			return err
+		}
+		rollback := newRollbackRecorder(job, playbook, client, logger)
+		before := rollback.names(txs)
+		job.Result, err = RegisterNameJob(txs, client, logger)
+		if err != nil {
+			return err
+		}
+		rollback.revertNames(job.RegisterName.Source, txs, before)
+	case *def.Permission:
+		announce(job.Name, "Permission", logger)
+		tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		target := acm.GlobalPermissionsAddress
+		if tx.PermArgs.Target != nil {
+			target = *tx.PermArgs.Target
+		}
+		rollback := newRollbackRecorder(job, playbook, client, logger)
+		before := rollback.account(target)
+		job.Result, err = PermissionJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+		rollback.revertAccount("Permission", job.Permission.Source, before, false)
+	case *def.Identify:
+		announce(job.Name, "Identify", logger)
+		tx, err := FormulateIdentifyJob(job.Identify, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = IdentifyJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+		newRollbackRecorder(job, playbook, client, logger).irreversible("Identify")
 
-		case *def.Call:
-			announce(job.Name, "Call", logger)
-			CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, job.Variables, err = CallJob(job.Call, CallTx, playbook, client, logger)
-		case *def.Build:
-			announce(job.Name, "Build", logger)
-			var resp *compilers.Response
-			resp, err = getCompilerWork(job.Intermediate)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BuildJob(job.Build, playbook, resp, logger)
-
-		// State jobs
-		case *def.RestoreState:
-			announce(job.Name, "RestoreState", logger)
-			job.Result, err = RestoreStateJob(job.RestoreState)
-		case *def.DumpState:
-			announce(job.Name, "DumpState", logger)
-			job.Result, err = DumpStateJob(job.DumpState)
-
-		// Test jobs
-		case *def.QueryAccount:
-			announce(job.Name, "QueryAccount", logger)
-			job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
-		case *def.QueryContract:
-			announce(job.Name, "QueryContract", logger)
-			job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
-		case *def.QueryName:
-			announce(job.Name, "QueryName", logger)
-			job.Result, err = QueryNameJob(job.QueryName, client, logger)
-		case *def.QueryVals:
-			announce(job.Name, "QueryVals", logger)
-			job.Result, err = QueryValsJob(job.QueryVals, client, logger)
-		case *def.Assert:
-			announce(job.Name, "Assert", logger)
-			job.Result, err = AssertJob(job.Assert, logger)
-
-		default:
-			logger.InfoMsg("Error")
-			return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
-				job)
-		}
-
-		if len(job.Variables) != 0 {
-			for _, theJob := range job.Variables {
-				logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
-			}
+	// Contracts jobs
+	case *def.Deploy:
+		announce(job.Name, "Deploy", logger)
+		txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
+		if ferr != nil {
+			return ferr
+		}
+		job.Result, err = DeployJob(job.Deploy, playbook, client, txs, contracts,
+			newGasRecorder(job, playbook, client, logger), logger)
+		if err == nil {
+			newRollbackRecorder(job, playbook, client, logger).irreversible("Deploy")
 		}
 
+	case *def.Call:
+		announce(job.Name, "Call", logger)
+		CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
+		if ferr != nil {
+			return ferr
+		}
+		rollback := newRollbackRecorder(job, playbook, client, logger)
+		before := rollback.proxy(*CallTx.Address)
+		job.Result, job.Variables, err = CallJob(job.Call, CallTx, playbook, client,
+			newGasRecorder(job, playbook, client, logger), logger)
+		if err == nil {
+			rollback.revertCall(job.Call, *CallTx.Address, before)
+		}
+	case *def.AssertRevert:
+		announce(job.Name, "AssertRevert", logger)
+		CallTx, ferr := FormulateCallJob(&job.AssertRevert.Call, args, playbook, client, logger)
+		if ferr != nil {
+			return ferr
+		}
+		job.Result, err = AssertRevertJob(job.AssertRevert, CallTx, client, logger)
+	case *def.Build:
+		announce(job.Name, "Build", logger)
+		var resp *compilers.Response
+		resp, err = getCompilerWork(job.Intermediate)
 		if err != nil {
 			return err
 		}
+		job.Result, err = BuildJob(job.Build, playbook, resp, logger)
+
+	// State jobs
+	case *def.RestoreState:
+		announce(job.Name, "RestoreState", logger)
+		job.Result, err = RestoreStateJob(job.RestoreState)
+	case *def.DumpState:
+		announce(job.Name, "DumpState", logger)
+		job.Result, err = DumpStateJob(job.DumpState)
+
+	// Test jobs
+	case *def.QueryAccount:
+		announce(job.Name, "QueryAccount", logger)
+		job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
+	case *def.QueryContract:
+		announce(job.Name, "QueryContract", logger)
+		job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
+	case *def.QueryName:
+		announce(job.Name, "QueryName", logger)
+		job.Result, err = QueryNameJob(job.QueryName, client, logger)
+	case *def.QueryVals:
+		announce(job.Name, "QueryVals", logger)
+		job.Result, err = QueryValsJob(job.QueryVals, client, logger)
+	case *def.Assert:
+		announce(job.Name, "Assert", logger)
+		job.Result, err = AssertJob(job.Assert, logger)
+
+	default:
+		logger.InfoMsg("Error")
+		return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
+			job)
 	}
 
-	return nil
+	if len(job.Variables) != 0 {
+		for _, theJob := range job.Variables {
+			logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
+		}
+	}
+
+	return err
 }
 
 func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) error {
@@ -321,7 +402,12 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 		if args.Wasm {
 			go solangRunner(jobs, logger)
 		} else {
-			go solcRunner(jobs, logger)
+			go solcRunner(jobs, compilers.Options{
+				Optimize:     args.Optimize,
+				OptimizeRuns: args.OptimizeRuns,
+				ViaIR:        args.ViaIR,
+				EVMVersion:   args.EVMVersion,
+			}, logger)
 		}
 	}
 
@@ -329,7 +415,25 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 		queueCompilerWork(job, playbook, jobs)
 	}
 
+	if args.GasReport {
+		playbook.GasReport = new(def.GasReport)
+	}
+
+	if args.RollbackPlan {
+		playbook.RollbackPlan = &def.RollbackPlan{Playbook: playbook.Filename}
+	}
+
 	err = doJobs(playbook, args, client, logger)
+	// Write the rollback plan even if a job failed so that the changes made by the jobs before it can be reverted
+	if playbook.RollbackPlan != nil {
+		rollbackPlanFile := strings.TrimSuffix(playbook.Filename, filepath.Ext(playbook.Filename)) +
+			def.RollbackPlanSuffix
+		logger.InfoMsg("Writing rollback plan", "output", rollbackPlanFile)
+		werr := WriteRollbackPlan(playbook.RollbackPlan, rollbackPlanFile)
+		if werr != nil && err == nil {
+			err = werr
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -338,6 +442,31 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 	return nil
 }
 
+// ExecuteRollback runs the jobs of a rollback plan in order, after warning of the changes it cannot revert
+func ExecuteRollback(args *def.DeployArgs, plan *def.RollbackPlan, client *def.Client, logger *logging.Logger) error {
+	for _, change := range plan.Irreversible {
+		logger.InfoMsg("Change cannot be rolled back and must be reviewed by hand", "Job Name", change.Job,
+			"Type", change.Type)
+	}
+	playbook := &def.Playbook{
+		Filename: plan.Playbook,
+		Jobs:     plan.Jobs,
+		Path:     args.Path,
+		BinPath:  args.BinPath,
+	}
+	err := playbook.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating rollback plan for %s: %v", plan.Playbook, err)
+	}
+	for _, job := range playbook.Jobs {
+		err = doPlaybookJob(job, playbook, args, client, logger)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func announce(job, typ string, logger *logging.Logger) {
 	logger.InfoMsg("*****Executing Job*****", "Job Name", job, "Type", typ)
 }
@@ -383,7 +512,7 @@ func postProcess(args *def.DeployArgs, playbook *def.Playbook, logger *logging.L
 	// Formulate the results map
 	results := make(map[string]interface{})
 	for _, job := range playbook.Jobs {
-		results[job.Name] = job.Result
+		results[job.Name] = redactResult(job.Result)
 	}
 
 	// check do.YAMLPath and do.DefaultOutput
@@ -395,6 +524,15 @@ func postProcess(args *def.DeployArgs, playbook *def.Playbook, logger *logging.L
 		return fmt.Errorf("invalid jobs file path (%s)", playbook.Filename)
 	}
 
+	if playbook.GasReport != nil {
+		gasReportFile := yaml + def.GasReportSuffix
+		logger.InfoMsg("Writing gas report", "output", gasReportFile)
+		err := WriteGasReport(playbook.GasReport, gasReportFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	// if do.YAMLPath is not default and do.DefaultOutput is default, over-ride do.DefaultOutput
 	if yaml != "deploy" && args.DefaultOutput == def.DefaultOutputFile {
 		args.DefaultOutput = fmt.Sprintf("%s.output.json", yaml)
@@ -410,3 +548,18 @@ func postProcess(args *def.DeployArgs, playbook *def.Playbook, logger *logging.L
 	logger.InfoMsg("Writing to current directory", "output", args.DefaultOutput)
 	return WriteJobResultJSON(results, args.DefaultOutput)
 }
+
+// Keeps the values of secrets out of the output file
+func redactResult(result interface{}) interface{} {
+	if str, ok := result.(string); ok {
+		return util.RedactSecrets(str)
+	}
+	bs, err := json.Marshal(result)
+	if err != nil {
+		return result
+	}
+	if redacted := util.RedactSecrets(string(bs)); redacted != string(bs) {
+		return loggers.Redacted
+	}
+	return result
+}
@@ -131,7 +131,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	contracts = make([]*compilers.ResponseItem, 0)
 
 	// compile
-	if filepath.Ext(deploy.Contract) != ".sol" {
+	if filepath.Ext(deploy.Contract) != ".sol" && !compilers.IsVyper(deploy.Contract) {
 		logger.InfoMsg("Binary file detected. Using binary deploy sequence.", "Binary path", contractPath)
 
 		contract, err := compilers.LoadSolidityContract(contractPath)
@@ -271,18 +271,25 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	return
 }
 
-func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs []*payload.CallTx, contracts []*compilers.ResponseItem, logger *logging.Logger) (result string, err error) {
+func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs []*payload.CallTx, contracts []*compilers.ResponseItem,
+	gas *gasRecorder, logger *logging.Logger) (result string, err error) {
 	// saving contract
 	// additional data may be sent along with the contract
 	// these are naively added to the end of the contract code using standard
 	// mint packing
 
 	for i, tx := range txs {
+		opcodes := gas.profile(tx)
 		// Sign, broadcast, display
-		contractAddress, err := deployFinalize(client, tx, logger)
+		contractAddress, txe, err := deployFinalize(client, tx, logger)
 		if err != nil {
 			return "", fmt.Errorf("error finalizing contract deploy %s: %w", deploy.Contract, err)
 		}
+		contractName := deploy.Contract
+		if contracts != nil {
+			contractName = contracts[i].Objectname
+		}
+		gas.record(contractName, constructorFunction, txe, opcodes)
 
 		// saving contract/library abi at abi/address
 		if contracts != nil && contractAddress != nil {
@@ -490,8 +497,10 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 	}, logger)
 }
 
-func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client *def.Client, logger *logging.Logger) (string, []*abi.Variable, error) {
+func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client *def.Client, gas *gasRecorder,
+	logger *logging.Logger) (string, []*abi.Variable, error) {
 
+	opcodes := gas.profile(tx)
 	// Sign, broadcast, display
 	txe, err := client.SignAndBroadcast(tx, logger)
 	if err != nil {
@@ -501,7 +510,7 @@ func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client
 	if txe.Exception != nil {
 		switch txe.Exception.ErrorCode() {
 		case errors.Codes.ExecutionReverted:
-			message, err := abi.UnpackRevert(txe.Result.Return)
+			message, err := abi.UnpackRevertWithSpec(client.AllSpecs, txe.Result.Return)
 			if err != nil {
 				return "", nil, err
 			}
@@ -520,6 +529,7 @@ func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client
 	}
 
 	logEvents(txe, client, logger)
+	gas.record(FirstOf(call.Bin, call.Destination), call.Function, txe, opcodes)
 
 	var result string
 
@@ -555,10 +565,10 @@ func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client
 	return result, call.Variables, nil
 }
 
-func deployFinalize(client *def.Client, tx payload.Payload, logger *logging.Logger) (*crypto.Address, error) {
+func deployFinalize(client *def.Client, tx payload.Payload, logger *logging.Logger) (*crypto.Address, *exec.TxExecution, error) {
 	txe, err := client.SignAndBroadcast(tx, logger)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	LogTxExecution(txe, logger)
@@ -568,9 +578,9 @@ func deployFinalize(client *def.Client, tx payload.Payload, logger *logging.Logg
 
 	if !txe.Receipt.CreatesContract || txe.Receipt.ContractAddress == crypto.ZeroAddress {
 		// Shouldn't get ZeroAddress when CreatesContract is true, but still
-		return nil, fmt.Errorf("result from SignAndBroadcast does not contain address for the deployed contract")
+		return nil, nil, fmt.Errorf("result from SignAndBroadcast does not contain address for the deployed contract")
 	}
-	return &txe.Receipt.ContractAddress, nil
+	return &txe.Receipt.ContractAddress, txe, nil
 }
 
 func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger) {
@@ -0,0 +1,161 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Runs each iteration of a for-each job in turn, each as a sub-playbook in which the current element is available as
+// the loop variable. The results of the iterations are collected as the result of the job.
+func doLoop(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	loop := *job.ForEach
+	err := util.PreProcessFields(&loop, args, playbook, client, logger)
+	if err != nil {
+		return err
+	}
+	err = loop.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating for-each of job %s after pre-processing variables: %v", job.Name, err)
+	}
+	maxIterations := uint64(def.DefaultMaxIterations)
+	if loop.MaxIterations != "" {
+		maxIterations, err = strconv.ParseUint(loop.MaxIterations, 10, 64)
+		if err != nil {
+			return fmt.Errorf("could not parse max-iterations of job %s: %v", job.Name, err)
+		}
+	}
+	elements := loop.Elements()
+	if uint64(len(elements)) > maxIterations {
+		return fmt.Errorf("for-each of job %s has %d elements, more than the maximum of %d iterations",
+			job.Name, len(elements), maxIterations)
+	}
+
+	results := make([]interface{}, 0, len(elements))
+	for i, element := range elements {
+		logger.InfoMsg("*****Executing Loop Iteration*****", "Job Name", job.Name, "iteration", i,
+			loop.Variable(), element)
+		iteration, err := copyJob(job)
+		if err != nil {
+			return err
+		}
+		// The element is exposed as the result of a job named for the loop variable in the iteration's playbook
+		iterationPlaybook := *playbook
+		iterationPlaybook.Parent = playbook
+		iterationPlaybook.Jobs = []*def.Job{{Name: loop.Variable(), Result: element}, iteration}
+		err = doGuardedJob(iteration, &iterationPlaybook, args, client, logger)
+		if err != nil {
+			return err
+		}
+		results = append(results, iteration.Result)
+	}
+	job.Result = results
+	return nil
+}
+
+// Runs job if it has no condition or its condition holds, otherwise skips it
+func doGuardedJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	if job.If != nil {
+		cond := *job.If
+		err := util.PreProcessFields(&cond, args, playbook, client, logger)
+		if err != nil {
+			return err
+		}
+		holds, err := Compare(cond.Key, cond.Relation, cond.Value)
+		if err != nil {
+			return fmt.Errorf("error evaluating condition of job %s: %v", job.Name, err)
+		}
+		if !holds {
+			logger.InfoMsg("*****Skipping Job*****", "Job Name", job.Name,
+				"key", cond.Key, "relation", cond.Relation, "value", cond.Value)
+			return nil
+		}
+	}
+	return doJob(job, playbook, args, client, logger)
+}
+
+// Returns a copy of job with a copy of its payload so that pre-processing variables in one iteration of a loop does
+// not affect the next
+func copyJob(job *def.Job) (*def.Job, error) {
+	cp := *job
+	cp.ForEach = nil
+	cp.Result = nil
+	cp.Variables = nil
+	field, err := cp.PayloadField()
+	if err != nil {
+		return nil, err
+	}
+	payload := reflect.New(field.Type().Elem())
+	payload.Elem().Set(field.Elem())
+	field.Set(payload)
+	return &cp, nil
+}
+
+// Runs the jobs of an included playbook in a scope of their own in which only its parameters (evaluated in the
+// including playbook) are visible. The results of the included jobs are exposed as variables of the include job, so
+// can be referred to as $<include job>.<included job>.
+func IncludeJob(include *def.Include, args *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) (interface{}, []*abi.Variable, error) {
+	scope := &def.Playbook{
+		Filename:     playbook.Filename,
+		Path:         playbook.Path,
+		BinPath:      playbook.BinPath,
+		GasReport:    playbook.GetGasReport(),
+		RollbackPlan: playbook.GetRollbackPlan(),
+	}
+	names := make([]string, 0, len(include.With))
+	for name := range include.With {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, err := util.PreProcess(include.With[name], args, playbook, client, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		scope.Jobs = append(scope.Jobs, &def.Job{Name: name, Result: value})
+	}
+
+	// Copy the included jobs so that the same playbook may be included more than once
+	included := *include.Playbook
+	included.Parent = scope
+	if included.Account == "" {
+		included.Account = playbook.Account
+	}
+	included.Jobs = make([]*def.Job, len(include.Playbook.Jobs))
+	for i, job := range include.Playbook.Jobs {
+		cp, err := copyJob(job)
+		if err != nil {
+			return nil, nil, err
+		}
+		cp.ForEach = job.ForEach
+		included.Jobs[i] = cp
+	}
+	err := doJobs(&included, args, client, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make(map[string]interface{}, len(included.Jobs))
+	variables := make([]*abi.Variable, 0, len(included.Jobs))
+	for _, job := range included.Jobs {
+		results[job.Name] = job.Result
+		value, ok := job.Result.(string)
+		if !ok {
+			bs, err := json.Marshal(job.Result)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not marshal result of included job %s: %v", job.Name, err)
+			}
+			value = string(bs)
+		}
+		variables = append(variables, &abi.Variable{Name: job.Name, Value: value})
+	}
+	return results, variables, nil
+}
@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoJobsControl(t *testing.T) {
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{
+				Name: "tokens",
+				Set:  &def.Set{Value: "[gold,silver,bronze]"},
+			},
+			{
+				Name: "labels",
+				ForEach: &def.Loop{
+					Items: "$tokens",
+					As:    "token",
+				},
+				If: &def.Condition{
+					Key:      "$token",
+					Relation: "ne",
+					Value:    "silver",
+				},
+				Set: &def.Set{Value: "label-$token"},
+			},
+			{
+				Name: "skipped",
+				If: &def.Condition{
+					Key:      "$tokens",
+					Relation: "==",
+					Value:    "[]",
+				},
+				Set: &def.Set{Value: "never"},
+			},
+			{
+				Name: "run",
+				If: &def.Condition{
+					Key:      "3",
+					Relation: "gt",
+					Value:    "2",
+				},
+				Set: &def.Set{Value: "always"},
+			},
+		},
+	}
+	err := doJobs(playbook, &def.DeployArgs{}, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	jobs := playbook.Jobs
+	assert.Equal(t, []interface{}{"label-gold", nil, "label-bronze"}, jobs[1].Result)
+	// Each iteration pre-processes its own copy of the payload
+	assert.Equal(t, "label-$token", jobs[1].Set.Value)
+	assert.Nil(t, jobs[2].Result)
+	assert.Equal(t, "always", jobs[3].Result)
+}
+
+func TestDoJobsLoopBound(t *testing.T) {
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{
+				Name: "bounded",
+				ForEach: &def.Loop{
+					Items:         "a,b,c",
+					MaxIterations: "2",
+				},
+				Set: &def.Set{Value: "$item"},
+			},
+		},
+	}
+	err := doJobs(playbook, &def.DeployArgs{}, nil, logging.NewNoopLogger())
+	require.Error(t, err)
+}
+
+func TestIncludeJob(t *testing.T) {
+	greeter := &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "greeting", Set: &def.Set{Value: "hello-$name"}},
+			{Name: "shout", Set: &def.Set{Value: "$greeting!"}},
+			// Jobs of the including playbook are out of scope
+			{Name: "leak", Set: &def.Set{Value: "$secret"}},
+		},
+	}
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "who", Set: &def.Set{Value: "world"}},
+			{Name: "secret", Set: &def.Set{Value: "s3cr3t"}},
+			{Name: "greetWorld", Include: &def.Include{File: "greeter.yaml", With: map[string]string{"name": "$who"},
+				Playbook: greeter}},
+			{Name: "greetMars", Include: &def.Include{File: "greeter.yaml", With: map[string]string{"name": "mars"},
+				Playbook: greeter}},
+			{Name: "both", Set: &def.Set{Value: "$greetWorld.shout $greetMars.shout"}},
+		},
+	}
+	err := doJobs(playbook, &def.DeployArgs{}, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	jobs := playbook.Jobs
+	assert.Equal(t, map[string]interface{}{
+		"greeting": "hello-world",
+		"shout":    "hello-world!",
+		"leak":     "$secret",
+	}, jobs[2].Result)
+	assert.Equal(t, "hello-world! hello-mars!", jobs[4].Result)
+	// The included playbook itself is untouched
+	assert.Nil(t, greeter.Jobs[0].Result)
+	assert.Equal(t, "hello-$name", greeter.Jobs[0].Set.Value)
+}
@@ -0,0 +1,183 @@
+package jobs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/def/rule"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Returned for jobs that were not run because a job they depend on failed or the playbook was aborted
+type errNotRun struct {
+	job string
+}
+
+func (err errNotRun) Error() string {
+	return fmt.Sprintf("job %s was not run because an earlier job failed", err.job)
+}
+
+// Runs the jobs of playbook concurrently, up to args.Parallel at a time, starting each once the jobs it depends on have
+// completed. Once any job fails no further jobs are started and the first failure (in playbook order) is returned.
+func doJobsParallel(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	dependencies, err := jobDependencies(playbook.Jobs)
+	if err != nil {
+		return err
+	}
+	logger.InfoMsg("Running jobs in parallel", "jobs", len(playbook.Jobs), "parallel", args.Parallel)
+
+	done := make([]chan struct{}, len(playbook.Jobs))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	errs := make([]error, len(playbook.Jobs))
+	slots := make(chan struct{}, args.Parallel)
+	abort := make(chan struct{})
+	var abortOnce sync.Once
+
+	for i, job := range playbook.Jobs {
+		go func(i int, job *def.Job) {
+			defer close(done[i])
+			// Dependencies are always earlier jobs so we cannot deadlock
+			for _, d := range dependencies[i] {
+				<-done[d]
+				if errs[d] != nil {
+					errs[i] = errNotRun{job: job.Name}
+					return
+				}
+			}
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-abort:
+				errs[i] = errNotRun{job: job.Name}
+				return
+			}
+			select {
+			case <-abort:
+				errs[i] = errNotRun{job: job.Name}
+				return
+			default:
+			}
+			errs[i] = doPlaybookJob(job, playbook, args, client, logger)
+			if errs[i] != nil {
+				abortOnce.Do(func() { close(abort) })
+			}
+		}(i, job)
+	}
+
+	for _, d := range done {
+		<-d
+	}
+	for _, err := range errs {
+		if _, ok := err.(errNotRun); err != nil && !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the indices of the earlier jobs on which each job depends. A job depends on the jobs it names in depends-on and
+// on those whose results it refers to. Jobs that change the state of the playbook as a whole (such as setting the
+// default account) or that run other jobs depend on all earlier jobs and all later jobs depend on them.
+func jobDependencies(jobs []*def.Job) ([][]int, error) {
+	dependencies := make([][]int, len(jobs))
+	// Indices of the jobs seen so far by name (names need not be unique)
+	byName := make(map[string][]int)
+	barrier := -1
+	for i, job := range jobs {
+		deps := make(map[int]struct{})
+		addDeps := func(indices ...int) {
+			for _, d := range indices {
+				deps[d] = struct{}{}
+			}
+		}
+		if isBarrierJob(job) {
+			for d := barrier + 1; d < i; d++ {
+				addDeps(d)
+			}
+		} else {
+			for _, name := range job.DependsOn {
+				indices, ok := byName[name]
+				if !ok {
+					return nil, fmt.Errorf("job %s depends on %s, which is not the name of an earlier job", job.Name,
+						name)
+				}
+				addDeps(indices...)
+			}
+			for _, name := range referencedJobs(job) {
+				addDeps(byName[name]...)
+			}
+		}
+		if barrier >= 0 {
+			addDeps(barrier)
+		}
+		if isBarrierJob(job) {
+			barrier = i
+		}
+		for d := range deps {
+			dependencies[i] = append(dependencies[i], d)
+		}
+		sort.Ints(dependencies[i])
+		byName[job.Name] = append(byName[job.Name], i)
+	}
+	return dependencies, nil
+}
+
+func isBarrierJob(job *def.Job) bool {
+	return job.Account != nil || job.Meta != nil || job.Proposal != nil || job.RestoreState != nil ||
+		job.DumpState != nil
+}
+
+// Returns the names of the jobs referred to by placeholders in job
+func referencedJobs(job *def.Job) []string {
+	var names []string
+	collect := func(str string) {
+		for _, pm := range rule.MatchPlaceholders(str) {
+			names = append(names, pm.JobName)
+		}
+	}
+	visitStrings(reflect.ValueOf(job.If), collect)
+	visitStrings(reflect.ValueOf(job.ForEach), collect)
+	if job.Include != nil {
+		// The jobs of the included playbook have a scope of their own
+		visitStrings(reflect.ValueOf(job.Include.With), collect)
+		return names
+	}
+	payload, err := job.Payload()
+	if err == nil {
+		visitStrings(reflect.ValueOf(payload), collect)
+	}
+	return names
+}
+
+func visitStrings(rv reflect.Value, visit func(str string)) {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !rv.IsNil() {
+			visitStrings(rv.Elem(), visit)
+		}
+	case reflect.Struct:
+		rt := rv.Type()
+		for i := 0; i < rv.NumField(); i++ {
+			if rt.Field(i).PkgPath == "" {
+				visitStrings(rv.Field(i), visit)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			visitStrings(rv.Index(i), visit)
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			visitStrings(iter.Key(), visit)
+			visitStrings(iter.Value(), visit)
+		}
+	case reflect.String:
+		visit(rv.String())
+	}
+}
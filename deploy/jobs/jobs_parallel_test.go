@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobDependencies(t *testing.T) {
+	jobs := []*def.Job{
+		{Name: "a", Set: &def.Set{Value: "a"}},
+		{Name: "b", Set: &def.Set{Value: "b"}},
+		{Name: "c", Call: &def.Call{Destination: "$a", Data: []interface{}{"${b.x}"}}},
+		{Name: "d", DependsOn: []string{"c"}, Set: &def.Set{Value: "d"}},
+		{Name: "e", Account: &def.Account{Address: "$b"}},
+		{Name: "f", Set: &def.Set{Value: "$block"}},
+		{Name: "g", If: &def.Condition{Key: "$f", Relation: "eq", Value: "1"}, Set: &def.Set{Value: "g"}},
+	}
+	dependencies, err := jobDependencies(jobs)
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{nil, nil, {0, 1}, {2}, {0, 1, 2, 3}, {4}, {4, 5}}, dependencies)
+
+	// Dependencies must be earlier jobs
+	jobs[0].DependsOn = []string{"d"}
+	_, err = jobDependencies(jobs)
+	require.Error(t, err)
+}
+
+func TestDoJobsParallel(t *testing.T) {
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "a", Set: &def.Set{Value: "a"}},
+			{Name: "b", Set: &def.Set{Value: "b"}},
+			{Name: "c", Set: &def.Set{Value: "$a-$b"}},
+			{Name: "d", DependsOn: []string{"c"}, Set: &def.Set{Value: "$c-d"}},
+			{Name: "e", Set: &def.Set{Value: "e"}},
+		},
+	}
+	err := doJobs(playbook, &def.DeployArgs{Parallel: 3}, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+	results := make([]interface{}, len(playbook.Jobs))
+	for i, job := range playbook.Jobs {
+		results[i] = job.Result
+	}
+	assert.Equal(t, []interface{}{"a", "b", "a-b", "a-b-d", "e"}, results)
+
+	// Jobs depending on a failed job are not run
+	playbook = &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "a", Assert: &def.Assert{Key: "1", Relation: "eq", Value: "2"}},
+			{Name: "b", Set: &def.Set{Value: "$a"}},
+		},
+	}
+	err = doJobs(playbook, &def.DeployArgs{Parallel: 2}, nil, logging.NewNoopLogger())
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "was not run")
+	assert.Nil(t, playbook.Jobs[1].Result)
+}
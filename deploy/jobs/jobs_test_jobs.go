@@ -8,8 +8,10 @@ import (
 
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
 )
 
 func QueryContractJob(query *def.QueryContract, do *def.DeployArgs, script *def.Playbook, client *def.Client, logger *logging.Logger) (string, []*abi.Variable, error) {
@@ -138,66 +140,53 @@ func AssertJob(assertion *def.Assert, logger *logging.Logger) (string, error) {
 		"relation", assertion.Relation,
 		"value", assertion.Value)
 
-	switch assertion.Relation {
-	case "==", "eq":
-		/*log.Debug("Compare", strings.Compare(assertion.Key, assertion.Value))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Value))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Value))*/
-		if assertion.Key == assertion.Value {
-			return assertPass("==", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("==", assertion.Key, assertion.Value, logger)
-		}
-	case "!=", "ne":
-		if assertion.Key != assertion.Value {
-			return assertPass("!=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("!=", assertion.Key, assertion.Value, logger)
-		}
-	case ">", "gt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k > v {
-			return assertPass(">", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail(">", assertion.Key, assertion.Value, logger)
-		}
-	case ">=", "ge":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k >= v {
-			return assertPass(">=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail(">=", assertion.Key, assertion.Value, logger)
-		}
-	case "<", "lt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k < v {
-			return assertPass("<", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("<", assertion.Key, assertion.Value, logger)
-		}
-	case "<=", "le":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k <= v {
-			return assertPass("<=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("<=", assertion.Key, assertion.Value, logger)
-		}
+	holds, err := Compare(assertion.Key, assertion.Relation, assertion.Value)
+	if err != nil {
+		return "", err
+	}
+	if holds {
+		return assertPass(relationOperators[assertion.Relation], assertion.Key, assertion.Value, logger)
+	}
+	return assertFail(relationOperators[assertion.Relation], assertion.Key, assertion.Value, logger)
+}
+
+// Maps each relation accepted by assert and conditional jobs to its operator
+var relationOperators = map[string]string{
+	"==": "==", "eq": "==",
+	"!=": "!=", "ne": "!=",
+	">": ">", "gt": ">",
+	">=": ">=", "ge": ">=",
+	"<": "<", "lt": "<",
+	"<=": "<=", "le": "<=",
+}
+
+// Compare returns whether relation holds between key and value. Only the equality relations may be used with
+// values that are not integers.
+func Compare(key, relation, value string) (bool, error) {
+	operator, ok := relationOperators[relation]
+	if !ok {
+		return false, fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", relation)
+	}
+	switch operator {
+	case "==":
+		return key == value, nil
+	case "!=":
+		return key != value, nil
+	}
+	k, v, err := bulkConvert(key, value)
+	if err != nil {
+		_, err = convFail()
+		return false, err
+	}
+	switch operator {
+	case ">":
+		return k > v, nil
+	case ">=":
+		return k >= v, nil
+	case "<":
+		return k < v, nil
 	default:
-		return "", fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", assertion.Relation)
+		return k <= v, nil
 	}
 }
 
@@ -232,3 +221,43 @@ func assertFail(typ, key, val string, logger *logging.Logger) (string, error) {
 func convFail() (string, error) {
 	return "", fmt.Errorf("The Key of your assertion cannot be converted into an integer.\nFor string conversions please use the equal or not equal relations.")
 }
+
+// AssertRevertJob broadcasts tx and asserts that it reverts with the expected reason, if one is given
+func AssertRevertJob(assertion *def.AssertRevert, tx *payload.CallTx, client *def.Client, logger *logging.Logger) (string, error) {
+	logger.InfoMsg("Assertion",
+		"destination", assertion.Destination,
+		"function", assertion.Function,
+		"reason", assertion.Reason)
+
+	txe, err := client.SignAndBroadcast(tx, logger)
+	if txe == nil {
+		return "", fmt.Errorf("error in AssertRevertJob with %v: %w", assertion, err)
+	}
+	if txe.Exception == nil {
+		logger.InfoMsg("Assertion Failed: transaction did not revert")
+		return "failed", fmt.Errorf("assertion failed: expected call to %s to revert but it succeeded",
+			assertion.Function)
+	}
+	if txe.Exception.ErrorCode() != errors.Codes.ExecutionReverted {
+		logger.InfoMsg("Assertion Failed: transaction failed without reverting", "exception", txe.Exception)
+		return "failed", fmt.Errorf("assertion failed: expected call to %s to revert but got exception: %v",
+			assertion.Function, txe.Exception)
+	}
+	message, err := abi.UnpackRevertWithSpec(client.AllSpecs, txe.Result.Return)
+	if err != nil {
+		return "", err
+	}
+	reason := ""
+	if message != nil {
+		reason = *message
+	}
+	if assertion.Reason != "" && assertion.Reason != reason {
+		logger.InfoMsg("Assertion Failed: transaction reverted with unexpected reason",
+			"expected", assertion.Reason,
+			"reason", reason)
+		return "failed", fmt.Errorf("assertion failed: expected call to %s to revert with reason '%s' but got '%s'",
+			assertion.Function, assertion.Reason, reason)
+	}
+	logger.InfoMsg("Assertion Succeeded: transaction reverted", "reason", reason)
+	return "passed", nil
+}
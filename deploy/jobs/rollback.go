@@ -0,0 +1,313 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// The EIP-1967 storage slot in which a proxy holds the address of its implementation
+var proxyImplementationSlot = binary.BigIntToWord256(new(big.Int).Sub(
+	new(big.Int).SetBytes(crypto.Keccak256([]byte("eip1967.proxy.implementation"))), big.NewInt(1)))
+
+// Records in the rollback plan of its playbook the jobs reverting the changes made by a job, for which it captures the
+// state the job changes before it runs. A nil rollbackRecorder records nothing so jobs can use it unconditionally.
+// Where the prior state cannot be read the change is logged and recorded as irreversible rather than failing the job.
+type rollbackRecorder struct {
+	job    string
+	plan   *def.RollbackPlan
+	client *def.Client
+	logger *logging.Logger
+}
+
+// The state of an account that UpdateAccount and Permission jobs change
+type accountState struct {
+	account *acm.Account
+	power   uint64
+}
+
+func newRollbackRecorder(job *def.Job, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) *rollbackRecorder {
+	plan := playbook.GetRollbackPlan()
+	if plan == nil {
+		return nil
+	}
+	return &rollbackRecorder{
+		job:    job.Name,
+		plan:   plan,
+		client: client,
+		logger: logger,
+	}
+}
+
+// Records that the job made a change of type typ that cannot be reverted
+func (rr *rollbackRecorder) irreversible(typ string) {
+	if rr == nil {
+		return
+	}
+	rr.plan.AddIrreversible(rr.job, typ)
+}
+
+// Reads the state of the account at address before the job changes it
+func (rr *rollbackRecorder) account(address crypto.Address) *accountState {
+	if rr == nil {
+		return nil
+	}
+	state, err := rr.readAccount(address)
+	if err != nil {
+		rr.logger.InfoMsg("Could not read account for rollback plan", "job", rr.job, "address", address,
+			"error", err)
+		return nil
+	}
+	return state
+}
+
+// Records the jobs reverting the changes made to the account since before was read, restoring its permissions and
+// roles as well as its balance and power if amounts is set
+func (rr *rollbackRecorder) revertAccount(typ, source string, before *accountState, amounts bool) {
+	if rr == nil {
+		return
+	}
+	if before == nil {
+		rr.irreversible(typ)
+		return
+	}
+	after, err := rr.readAccount(before.account.Address)
+	if err != nil {
+		rr.logger.InfoMsg("Could not read account for rollback plan", "job", rr.job,
+			"address", before.account.Address, "error", err)
+		rr.irreversible(typ)
+		return
+	}
+	rr.plan.Revert(rr.job, accountReverts(source, before, after, amounts)...)
+}
+
+func (rr *rollbackRecorder) readAccount(address crypto.Address) (*accountState, error) {
+	account, err := rr.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	// The query service returns an empty account for one that does not exist
+	account.Address = address
+	state := &accountState{account: account}
+	validators, err := rr.client.GetValidatorSet(rr.logger)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range validators.Set {
+		if v.GetAddress() == address {
+			state.power = v.Power
+		}
+	}
+	return state, nil
+}
+
+// Reads the names the NameTxs will register before the job registers them
+func (rr *rollbackRecorder) names(txs []*payload.NameTx) map[string]*names.Entry {
+	if rr == nil {
+		return nil
+	}
+	entries := make(map[string]*names.Entry, len(txs))
+	for _, tx := range txs {
+		// Missing names are not an error but come back as one so we treat any failure as the name being absent
+		entry, err := rr.client.GetName(tx.Name, rr.logger)
+		if err == nil {
+			entries[tx.Name] = entry
+		}
+	}
+	return entries
+}
+
+// Records the jobs restoring the names registered by the NameTxs to the entries read before they were sent
+func (rr *rollbackRecorder) revertNames(source string, txs []*payload.NameTx, before map[string]*names.Entry) {
+	if rr == nil {
+		return
+	}
+	height, err := rr.lastBlockHeight()
+	if err != nil {
+		rr.logger.InfoMsg("Could not read chain status for rollback plan", "job", rr.job, "error", err)
+		rr.irreversible("RegisterName")
+		return
+	}
+	reverts := make([]*def.Job, len(txs))
+	for i, tx := range txs {
+		reverts[i] = nameRevert(source, tx.Name, before[tx.Name], height)
+	}
+	rr.plan.Revert(rr.job, reverts...)
+}
+
+func (rr *rollbackRecorder) lastBlockHeight() (uint64, error) {
+	status, err := rr.client.Status(rr.logger)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.GetLatestBlockHeight(), nil
+}
+
+// Reads the implementation of the contract at address, should it be an EIP-1967 proxy, before the job calls it
+func (rr *rollbackRecorder) proxy(address crypto.Address) binary.Word256 {
+	if rr == nil {
+		return binary.Zero256
+	}
+	implementation, err := rr.client.GetStorage(address, proxyImplementationSlot)
+	if err != nil {
+		rr.logger.InfoMsg("Could not read proxy implementation for rollback plan", "job", rr.job,
+			"address", address, "error", err)
+		return binary.Zero256
+	}
+	return binary.LeftPadWord256(implementation)
+}
+
+// Records a call pointing the proxy at address back to its prior implementation if the job changed it, otherwise the
+// effects of the call cannot be determined so it is recorded as irreversible
+func (rr *rollbackRecorder) revertCall(call *def.Call, address crypto.Address, before binary.Word256) {
+	if rr == nil {
+		return
+	}
+	if !before.IsZero() && rr.proxy(address) != before {
+		rr.plan.Revert(rr.job, &def.Job{
+			Call: &def.Call{
+				Source:      call.Source,
+				Destination: address.String(),
+				Function:    "upgradeTo",
+				Data:        []interface{}{crypto.AddressFromWord256(before).String()},
+				Bin:         call.Bin,
+			},
+		})
+		return
+	}
+	rr.irreversible("Call")
+}
+
+// Returns the jobs issued by source that revert the account from after to before, including its balance and power if
+// amounts is set
+func accountReverts(source string, before, after *accountState, amounts bool) []*def.Job {
+	var reverts []*def.Job
+	address := before.account.Address
+	target := address.String()
+	if amounts && (before.account.Balance != after.account.Balance || before.power != after.power) {
+		update := &def.UpdateAccount{
+			Source: source,
+			Target: target,
+		}
+		if before.account.Balance != after.account.Balance {
+			update.Native = fmt.Sprintf("%d", before.account.Balance)
+		}
+		if before.power != after.power {
+			update.Power = fmt.Sprintf("%d", before.power)
+		}
+		reverts = append(reverts, &def.Job{UpdateAccount: update})
+	}
+	action := "set_base"
+	if address == acm.GlobalPermissionsAddress {
+		action = "set_global"
+	}
+	beforeBase, afterBase := before.account.Permissions.Base, after.account.Permissions.Base
+	for i := uint(0); i < permission.NumPermissions; i++ {
+		flag := permission.PermFlag(1) << i
+		if beforeBase.IsSet(flag) == afterBase.IsSet(flag) && beforeBase.Perms&flag == afterBase.Perms&flag {
+			continue
+		}
+		perm := &def.Permission{
+			Source:     source,
+			Action:     "unset_base",
+			Permission: flag.String(),
+			Target:     target,
+		}
+		if beforeBase.IsSet(flag) {
+			perm.Action = action
+			perm.Value = fmt.Sprintf("%t", beforeBase.Perms&flag != 0)
+		}
+		reverts = append(reverts, &def.Job{Permission: perm})
+	}
+	beforeRoles, afterRoles := roleSet(before.account.Permissions), roleSet(after.account.Permissions)
+	for _, role := range sortedRoles(afterRoles) {
+		if !beforeRoles[role] {
+			reverts = append(reverts, &def.Job{Permission: &def.Permission{
+				Source: source,
+				Action: "rm_role",
+				Target: target,
+				Role:   role,
+			}})
+		}
+	}
+	for _, role := range sortedRoles(beforeRoles) {
+		if !afterRoles[role] {
+			reverts = append(reverts, &def.Job{Permission: &def.Permission{
+				Source: source,
+				Action: "add_role",
+				Target: target,
+				Role:   role,
+			}})
+		}
+	}
+	return reverts
+}
+
+// Roles are stored padded to a word so are trimmed back to the names PermsTxs take
+func roleSet(perms permission.AccountPermissions) map[string]bool {
+	roles := make(map[string]bool, len(perms.Roles))
+	for _, role := range perms.Roles {
+		roles[strings.TrimRight(role, "\x00")] = true
+	}
+	return roles
+}
+
+func sortedRoles(roles map[string]bool) []string {
+	sorted := make([]string, 0, len(roles))
+	for role := range roles {
+		sorted = append(sorted, role)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// Returns the job issued by source that restores name to the entry it had before (as of height), removing it if it had
+// none. Sending no value leaves the expiry of an entry as it is.
+func nameRevert(source, name string, before *names.Entry, height uint64) *def.Job {
+	revert := &def.RegisterName{
+		Source: source,
+		Name:   name,
+		Amount: "0",
+		Fee:    "0",
+	}
+	if before != nil && before.Expires > height {
+		revert.Data = before.Data
+	}
+	return &def.Job{RegisterName: revert}
+}
+
+func WriteRollbackPlan(plan *def.RollbackPlan, file string) error {
+	plan.Lock()
+	defer plan.Unlock()
+	bs, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, bs, 0644)
+}
+
+func ReadRollbackPlan(file string) (*def.RollbackPlan, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	plan := new(def.RollbackPlan)
+	err = json.Unmarshal(bs, plan)
+	if err != nil {
+		return nil, fmt.Errorf("could not read rollback plan %s: %v", file, err)
+	}
+	return plan, nil
+}
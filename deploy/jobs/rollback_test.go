@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountReverts(t *testing.T) {
+	address := crypto.Address{1, 2, 3}
+	target := address.String()
+	before := &accountState{
+		account: &acm.Account{
+			Address: address,
+			Balance: 100,
+			Permissions: permission.AccountPermissions{
+				Base: permission.BasePermissions{
+					Perms:  permission.Send | permission.Call,
+					SetBit: permission.Send | permission.Call | permission.Bond,
+				},
+				Roles: []string{"old"},
+			},
+		},
+		power: 5,
+	}
+	after := &accountState{
+		account: &acm.Account{
+			Address: address,
+			Balance: 100,
+			Permissions: permission.AccountPermissions{
+				Base: permission.BasePermissions{
+					Perms:  permission.Send | permission.Bond | permission.Name,
+					SetBit: permission.Send | permission.Call | permission.Bond | permission.Name,
+				},
+				Roles: []string{"new\x00\x00"},
+			},
+		},
+		power: 10,
+	}
+
+	require.Equal(t, []*def.Job{
+		{UpdateAccount: &def.UpdateAccount{Source: "root", Target: target, Power: "5"}},
+		{Permission: &def.Permission{Source: "root", Action: "set_base", Permission: "call", Value: "true",
+			Target: target}},
+		{Permission: &def.Permission{Source: "root", Action: "set_base", Permission: "bond", Value: "false",
+			Target: target}},
+		{Permission: &def.Permission{Source: "root", Action: "unset_base", Permission: "name", Target: target}},
+		{Permission: &def.Permission{Source: "root", Action: "rm_role", Target: target, Role: "new"}},
+		{Permission: &def.Permission{Source: "root", Action: "add_role", Target: target, Role: "old"}},
+	}, accountReverts("root", before, after, true))
+
+	// Permission jobs leave balances and power alone
+	require.Len(t, accountReverts("root", before, after, false), 5)
+	require.Empty(t, accountReverts("root", before, before, true))
+
+	global := &accountState{account: &acm.Account{Address: acm.GlobalPermissionsAddress,
+		Permissions: permission.AccountPermissions{Base: permission.BasePermissions{
+			Perms: permission.Send, SetBit: permission.AllPermFlags}}}}
+	changed := &accountState{account: &acm.Account{Address: acm.GlobalPermissionsAddress,
+		Permissions: permission.AccountPermissions{Base: permission.BasePermissions{
+			Perms: 0, SetBit: permission.AllPermFlags}}}}
+	require.Equal(t, []*def.Job{
+		{Permission: &def.Permission{Source: "root", Action: "set_global", Permission: "send", Value: "true",
+			Target: acm.GlobalPermissionsAddress.String()}},
+	}, accountReverts("root", global, changed, false))
+}
+
+func TestNameRevert(t *testing.T) {
+	// No prior entry so remove the name
+	require.Equal(t, &def.RegisterName{Source: "root", Name: "foo", Amount: "0", Fee: "0"},
+		nameRevert("root", "foo", nil, 10).RegisterName)
+	// An expired entry is as good as none
+	require.Equal(t, "", nameRevert("root", "foo", &names.Entry{Name: "foo", Data: "old", Expires: 10}, 10).
+		RegisterName.Data)
+	require.Equal(t, "old", nameRevert("root", "foo", &names.Entry{Name: "foo", Data: "old", Expires: 11}, 10).
+		RegisterName.Data)
+}
@@ -11,10 +11,12 @@ import (
 )
 
 func LoadPlaybook(fileName string, args *def.DeployArgs, logger *logging.Logger) (*def.Playbook, error) {
-	return loadPlaybook(fileName, args, nil, logger)
+	return loadPlaybook(fileName, args, nil, nil, logger)
 }
 
-func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, logger *logging.Logger) (*def.Playbook, error) {
+// includedFrom holds the absolute paths of the playbooks that (transitively) include this one so that cycles are caught
+func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, includedFrom []string,
+	logger *logging.Logger) (*def.Playbook, error) {
 	logger.InfoMsg("Loading Playbook File.")
 	playbook := new(def.Playbook)
 	deployJobs := viper.New()
@@ -46,6 +48,12 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 		return nil, fmt.Errorf("sorry, the marmots were unable to find the absolute path to the playbook file")
 	}
 
+	for _, includer := range includedFrom {
+		if includer == abs {
+			return nil, fmt.Errorf("playbook %s includes itself", fileName)
+		}
+	}
+
 	base := filepath.Base(abs)
 	extName := filepath.Ext(base)
 	bName := base[:len(base)-len(extName)]
@@ -80,38 +88,33 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 
 	for _, job := range playbook.Jobs {
 		if job.Meta != nil {
-			metaPlaybook, err := loadPlaybook(job.Meta.File, args, playbook, logger)
+			metaPlaybook, err := loadSubPlaybook(job.Meta.File, args, playbook, includedFrom, logger)
 			if err != nil {
 				return nil, err
 			}
-
-			// set the deploy contract jobs relative to the newDo's root directory
-			for _, job := range metaPlaybook.Jobs {
-				if job.Deploy != nil {
-					job.Deploy.Contract = filepath.Join(metaPlaybook.Path, job.Deploy.Contract)
-				}
-			}
-
 			// We do not set the parent for this playbook; the parent is used for
 			// backreferencing variables
 			job.Meta.Playbook = metaPlaybook
 		}
 
+		if job.Include != nil {
+			includedPlaybook, err := loadSubPlaybook(job.Include.File, args, playbook, append(includedFrom, abs),
+				logger)
+			if err != nil {
+				return nil, err
+			}
+			// Nor for included playbooks, which are given a scope holding their parameters each time they are run
+			job.Include.Playbook = includedPlaybook
+		}
+
 		if job.Proposal != nil {
 			for _, job := range job.Proposal.Jobs {
 				if job.Meta != nil {
-					metaPlaybook, err := loadPlaybook(job.Meta.File, args, playbook, logger)
+					metaPlaybook, err := loadSubPlaybook(job.Meta.File, args, playbook, includedFrom, logger)
 					if err != nil {
 						return nil, err
 					}
 
-					// set the deploy contract jobs relative to the newDo's root directory
-					for _, job := range metaPlaybook.Jobs {
-						if job.Deploy != nil {
-							job.Deploy.Contract = filepath.Join(metaPlaybook.Path, job.Deploy.Contract)
-						}
-					}
-
 					// Set the parent for the playbook so that the proposal can backreference e.g.
 					// deployed contracts addresses
 					metaPlaybook.Parent = playbook
@@ -123,3 +126,18 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 
 	return playbook, nil
 }
+
+func loadSubPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, includedFrom []string,
+	logger *logging.Logger) (*def.Playbook, error) {
+	subPlaybook, err := loadPlaybook(fileName, args, parent, includedFrom, logger)
+	if err != nil {
+		return nil, err
+	}
+	// set the deploy contract jobs relative to the newDo's root directory
+	for _, job := range subPlaybook.Jobs {
+		if job.Deploy != nil {
+			job.Deploy.Contract = filepath.Join(subPlaybook.Path, job.Deploy.Contract)
+		}
+	}
+	return subPlaybook, nil
+}
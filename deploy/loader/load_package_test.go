@@ -2,9 +2,13 @@ package loader
 
 import (
 	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,6 +42,32 @@ func TestUnmarshal(t *testing.T) {
 `)
 }
 
+func TestUnmarshalAssertRevert(t *testing.T) {
+	pkgs := viper.New()
+	pkgs.SetConfigType("yaml")
+	err := pkgs.ReadConfig(bytes.NewBufferString(`jobs:
+
+- name: revertTest
+  assert-revert:
+    destination: $deployRevert
+    function: RevertIf0
+    data:
+      - 0
+    reason: not today
+`))
+	require.NoError(t, err)
+	do := new(def.Playbook)
+	err = pkgs.UnmarshalExact(do)
+	require.NoError(t, err)
+	require.Len(t, do.Jobs, 1)
+	job := do.Jobs[0]
+	require.NoError(t, job.Validate())
+	require.NotNil(t, job.AssertRevert)
+	assert.Equal(t, "$deployRevert", job.AssertRevert.Destination)
+	assert.Equal(t, "RevertIf0", job.AssertRevert.Function)
+	assert.Equal(t, "not today", job.AssertRevert.Reason)
+}
+
 func testUnmarshal(t *testing.T, testPackageYAML string) {
 	pkgs := viper.New()
 	pkgs.SetConfigType("yaml")
@@ -56,3 +86,41 @@ func testUnmarshal(t *testing.T, testPackageYAML string) {
 	require.NoError(t, err)
 	assert.Equal(t, do, doOut)
 }
+
+func TestLoadInclude(t *testing.T) {
+	dir, err := ioutil.TempDir("", "include")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	write := func(name, contents string) string {
+		file := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(file, []byte(contents), 0600))
+		return file
+	}
+	write("token.yaml", `jobs:
+- name: deployToken
+  deploy:
+    contract: Token.sol
+    data: [$symbol]
+`)
+	playbook, err := LoadPlaybook(write("deploy.yaml", `jobs:
+- name: gold
+  include:
+    file: token.yaml
+    with:
+      symbol: GLD
+`), &def.DeployArgs{BinPath: filepath.Join(dir, "bin")}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	include := playbook.Jobs[0].Include
+	assert.Equal(t, map[string]string{"symbol": "GLD"}, include.With)
+	require.Len(t, include.Playbook.Jobs, 1)
+	assert.Equal(t, filepath.Join(dir, "Token.sol"), include.Playbook.Jobs[0].Deploy.Contract)
+	assert.Nil(t, include.Playbook.Parent)
+
+	_, err = LoadPlaybook(write("cycle.yaml", `jobs:
+- name: again
+  include:
+    file: cycle.yaml
+`), &def.DeployArgs{BinPath: filepath.Join(dir, "bin")}, logging.NewNoopLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "includes itself")
+}
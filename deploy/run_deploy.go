@@ -12,8 +12,10 @@ import (
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/jobs"
 	"github.com/hyperledger/burrow/deploy/loader"
+	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/loggers"
 )
 
 type playbookWork struct {
@@ -46,7 +48,7 @@ func worker(mtx *sync.RWMutex, playbooks <-chan playbookWork, results chan<- pla
 			}
 
 			if args.Jobs != 1 {
-				logger = logging.NewLogger(log.NewLogfmtLogger(&logBuf))
+				logger = logging.NewLogger(loggers.RedactLogger(log.NewLogfmtLogger(&logBuf), util.SecretValues))
 				if !args.Debug {
 					logger.Trace = log.NewNopLogger()
 				}
@@ -176,3 +178,32 @@ func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logg
 
 	return failures, nil
 }
+
+// RunRollback runs the jobs of the rollback plan written to planFile by a deploy with args.RollbackPlan set
+func RunRollback(args *def.DeployArgs, planFile string, logger *logging.Logger) error {
+	if args.Path == "" {
+		var err error
+		args.Path, err = os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory %v", err)
+		}
+	}
+	if args.BinPath == "" {
+		args.BinPath = filepath.Join(args.Path, "bin")
+	}
+
+	plan, err := jobs.ReadRollbackPlan(filepath.Join(args.Path, planFile))
+	if err != nil {
+		return err
+	}
+	logger.InfoMsg("Rolling back", "Chain", args.Chain, "Signer", args.KeysService, "Playbook", plan.Playbook,
+		"Jobs", len(plan.Jobs))
+
+	client := def.NewClient(args.Chain, args.KeysService, args.MempoolSign, time.Duration(args.Timeout)*time.Second)
+	var abiError error
+	client.AllSpecs, abiError = abi.LoadPath(args.BinPath)
+	if abiError != nil {
+		logger.InfoMsg("failed to load ABIs for Event parsing", "path", args.BinPath, "error", abiError)
+	}
+	return jobs.ExecuteRollback(args, plan, client, logger)
+}
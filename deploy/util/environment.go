@@ -0,0 +1,36 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// Matches environment references of the form ${env:NAME} or ${env:NAME:-default}
+var EnvRefRegex = regexp.MustCompile(`\$\{env:([[:word:]]+)(:-([^}]*))?\}`)
+
+// Replaces each environment reference in str with the value of the environment variable it names. A reference to a
+// variable that is not set is an error unless the reference gives a default. Unlike secrets, environment values are not
+// redacted so ${secret:env:NAME} should be used for sensitive values.
+func ResolveEnvironment(str string) (string, error) {
+	var err error
+	resolved := EnvRefRegex.ReplaceAllStringFunc(str, func(ref string) string {
+		if err != nil {
+			return ref
+		}
+		match := EnvRefRegex.FindStringSubmatch(ref)
+		value, ok := os.LookupEnv(match[1])
+		if ok {
+			return value
+		}
+		if match[2] != "" {
+			return match[3]
+		}
+		err = fmt.Errorf("environment variable %s referred to by %s is not set", match[1], ref)
+		return ref
+	})
+	if err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
@@ -0,0 +1,31 @@
+package util
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEnvironment(t *testing.T) {
+	require.NoError(t, os.Setenv("BURROW_TEST_OWNER", "CF8F9480252B70D59CF5B5F3CAAA75FEAF6A4B33"))
+	defer os.Unsetenv("BURROW_TEST_OWNER")
+
+	resolved, err := ResolveEnvironment("owner=${env:BURROW_TEST_OWNER};supply=${env:BURROW_TEST_SUPPLY:-100}")
+	require.NoError(t, err)
+	assert.Equal(t, "owner=CF8F9480252B70D59CF5B5F3CAAA75FEAF6A4B33;supply=100", resolved)
+
+	// An empty default is allowed
+	resolved, err = ResolveEnvironment("${env:BURROW_TEST_SUPPLY:-}")
+	require.NoError(t, err)
+	assert.Equal(t, "", resolved)
+
+	_, err = ResolveEnvironment("${env:BURROW_TEST_SUPPLY}")
+	require.Error(t, err)
+
+	// Secret references are left for ResolveSecrets
+	resolved, err = ResolveEnvironment("${secret:env:BURROW_TEST_OWNER}")
+	require.NoError(t, err)
+	assert.Equal(t, "${secret:env:BURROW_TEST_OWNER}", resolved)
+}
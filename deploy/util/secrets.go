@@ -0,0 +1,173 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging/loggers"
+)
+
+const (
+	// The environment variables from which the vault provider takes the address of the vault and the token with which to
+	// authenticate
+	VaultAddressEnv = "VAULT_ADDR"
+	VaultTokenEnv   = "VAULT_TOKEN"
+	// The key read from a vault secret when none is given
+	DefaultVaultKey = "value"
+
+	vaultTimeout = 10 * time.Second
+)
+
+// Matches secret references of the form ${secret:<provider>:<reference>}
+var SecretRefRegex = regexp.MustCompile(`\$\{secret:(env|file|vault):([^}]+)\}`)
+
+// The values of the secrets resolved so far so they can be redacted from logs and output
+var resolvedSecrets = &secretSet{values: make(map[string]struct{})}
+
+type secretSet struct {
+	sync.RWMutex
+	values map[string]struct{}
+}
+
+func (ss *secretSet) add(value string) {
+	if value == "" {
+		return
+	}
+	ss.Lock()
+	defer ss.Unlock()
+	ss.values[value] = struct{}{}
+}
+
+// Returns the values of all secrets resolved so far, for use with loggers.RedactLogger
+func SecretValues() []string {
+	resolvedSecrets.RLock()
+	defer resolvedSecrets.RUnlock()
+	values := make([]string, 0, len(resolvedSecrets.values))
+	for value := range resolvedSecrets.values {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Replaces any resolved secrets in str
+func RedactSecrets(str string) string {
+	for _, secret := range SecretValues() {
+		str = strings.ReplaceAll(str, secret, loggers.Redacted)
+	}
+	return str
+}
+
+// Replaces each secret reference in str with the value of the secret it refers to. References have the form:
+//
+//	${secret:env:NAME}        the environment variable NAME
+//	${secret:file:PATH}       the contents of the file at PATH (relative to baseDir) without trailing whitespace
+//	${secret:vault:PATH#KEY}  the key KEY (or 'value' if omitted) of the secret at PATH in the vault at $VAULT_ADDR
+//
+// Resolved values are recorded so that they can be redacted wherever they would otherwise be logged. Errors refer
+// only to the reference and never include the value of a secret.
+func ResolveSecrets(str, baseDir string) (string, error) {
+	var err error
+	resolved := SecretRefRegex.ReplaceAllStringFunc(str, func(ref string) string {
+		if err != nil {
+			return ref
+		}
+		match := SecretRefRegex.FindStringSubmatch(ref)
+		var value string
+		value, err = resolveSecret(match[1], match[2], baseDir)
+		if err != nil {
+			err = fmt.Errorf("could not resolve secret reference %s: %v", ref, err)
+			return ref
+		}
+		resolvedSecrets.add(value)
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
+
+func resolveSecret(provider, reference, baseDir string) (string, error) {
+	switch provider {
+	case "env":
+		value, ok := os.LookupEnv(reference)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", reference)
+		}
+		return value, nil
+	case "file":
+		path := reference
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		bs, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(bs), " \t\r\n"), nil
+	case "vault":
+		return readVaultSecret(reference)
+	default:
+		return "", fmt.Errorf("unknown secret provider %s", provider)
+	}
+}
+
+// Reads a key from a secret in a HashiCorp Vault key-value store (either version 1 or 2) over its HTTP API
+func readVaultSecret(reference string) (string, error) {
+	address := os.Getenv(VaultAddressEnv)
+	if address == "" {
+		return "", fmt.Errorf("%s must be set to resolve vault secrets", VaultAddressEnv)
+	}
+	path, key := reference, DefaultVaultKey
+	if i := strings.LastIndexByte(reference, '#'); i >= 0 {
+		path, key = reference[:i], reference[i+1:]
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), vaultTimeout)
+	defer cancel()
+	url := strings.TrimRight(address, "/") + "/v1/" + strings.TrimLeft(path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv(VaultTokenEnv))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned status %s for %s", resp.Status, path)
+	}
+	secret := new(struct {
+		Data map[string]interface{} `json:"data"`
+	})
+	err = json.NewDecoder(resp.Body).Decode(secret)
+	if err != nil {
+		return "", fmt.Errorf("could not decode vault response for %s: %v", path, err)
+	}
+	data := secret.Data
+	// Version 2 of the key-value store nests the secret's data alongside its metadata
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = inner
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("vault secret %s has no key %s", path, key)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("key %s of vault secret %s is not a string", key, path)
+	}
+	return str, nil
+}
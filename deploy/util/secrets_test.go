@@ -0,0 +1,69 @@
+package util
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "key"), []byte("0123abcd\n"), 0600))
+	require.NoError(t, os.Setenv("BURROW_TEST_SECRET", "hunter2"))
+	defer os.Unsetenv("BURROW_TEST_SECRET")
+
+	resolved, err := ResolveSecrets("token=${secret:env:BURROW_TEST_SECRET};key=${secret:file:key}", dir)
+	require.NoError(t, err)
+	assert.Equal(t, "token=hunter2;key=0123abcd", resolved)
+	assert.Equal(t, "token="+loggers.Redacted, RedactSecrets("token=hunter2"))
+
+	// Strings without references are untouched
+	resolved, err = ResolveSecrets("$deploy.address", dir)
+	require.NoError(t, err)
+	assert.Equal(t, "$deploy.address", resolved)
+
+	_, err = ResolveSecrets("${secret:env:BURROW_TEST_SECRET_UNSET}", dir)
+	require.Error(t, err)
+}
+
+func TestResolveVaultSecret(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/deploy":
+			w.Write([]byte(`{"data":{"data":{"token":"v2-token"},"metadata":{"version":1}}}`))
+		case "/v1/kv/deploy":
+			w.Write([]byte(`{"data":{"value":"v1-token"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer vault.Close()
+	require.NoError(t, os.Setenv(VaultAddressEnv, vault.URL))
+	defer os.Unsetenv(VaultAddressEnv)
+	require.NoError(t, os.Setenv(VaultTokenEnv, "root"))
+	defer os.Unsetenv(VaultTokenEnv)
+
+	resolved, err := ResolveSecrets("${secret:vault:secret/data/deploy#token}", "")
+	require.NoError(t, err)
+	assert.Equal(t, "v2-token", resolved)
+
+	resolved, err = ResolveSecrets("${secret:vault:kv/deploy}", "")
+	require.NoError(t, err)
+	assert.Equal(t, "v1-token", resolved)
+
+	_, err = ResolveSecrets("${secret:vault:kv/missing}", "")
+	require.Error(t, err)
+}
@@ -26,6 +26,11 @@ func Variables(value interface{}) []*abi.Variable {
 	var variables []*abi.Variable
 	for i := 0; i < rv.NumField(); i++ {
 		field := rv.Field(i)
+		// Include the fields of embedded structs as though they were our own
+		if field.Kind() == reflect.Struct && rt.Field(i).Anonymous {
+			variables = append(variables, Variables(field.Interface())...)
+			continue
+		}
 		if field.Kind() == reflect.String {
 			variables = append(variables, &abi.Variable{Name: lowerFirstCharacter(rt.Field(i).Name), Value: field.String()})
 		}
@@ -50,6 +55,14 @@ func PreProcessFields(value interface{}, do *def.DeployArgs, script *def.Playboo
 	}
 	for i := 0; i < rv.NumField(); i++ {
 		field := rv.Field(i)
+		// Process the fields of embedded structs as though they were our own
+		if field.Kind() == reflect.Struct && rv.Type().Field(i).Anonymous {
+			err = PreProcessFields(field.Addr().Interface(), do, script, client, logger)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		if field.Kind() == reflect.String {
 			str, err := PreProcess(field.String(), do, script, client, logger)
 			if err != nil {
@@ -62,6 +75,20 @@ func PreProcessFields(value interface{}, do *def.DeployArgs, script *def.Playboo
 }
 
 func PreProcess(toProcess string, do *def.DeployArgs, script *def.Playbook, client *def.Client, logger *logging.Logger) (string, error) {
+	// Secrets and environment variables are resolved first so that they cannot be mistaken for job placeholders
+	var baseDir string
+	if script != nil {
+		baseDir = script.Path
+	}
+	toProcess, err := ResolveSecrets(toProcess, baseDir)
+	if err != nil {
+		return "", err
+	}
+	toProcess, err = ResolveEnvironment(toProcess)
+	if err != nil {
+		return "", err
+	}
+
 	// Run through the replacement process for any placeholder matches
 	for _, pm := range rule.MatchPlaceholders(toProcess) {
 		logger.TraceMsg("Replacement Match Found",
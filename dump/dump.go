@@ -174,7 +174,10 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 				case ev.BeginTx != nil:
 					origin = ev.BeginTx.TxHeader.Origin
 				case ev.Event != nil && ev.Event.Log != nil:
-					row := &Dump{EVMEvent: &EVMEvent{Event: ev.Event.Log}}
+					// The sequence of each log is assigned afresh by the state the dump is loaded into
+					log := *ev.Event.Log
+					log.Sequence = 0
+					row := &Dump{EVMEvent: &EVMEvent{Event: &log}}
 					if origin != nil {
 						// this event was already restored
 						row.EVMEvent.ChainID = origin.ChainID
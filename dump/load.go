@@ -17,6 +17,7 @@ import (
 func Load(source Source, st *state.State) error {
 	_, _, err := st.Update(func(s state.Updatable) error {
 		txs := make([]*exec.TxExecution, 0)
+		registered := make(map[acmstate.CodeHash]bool)
 
 		var tx *exec.TxExecution
 
@@ -37,6 +38,21 @@ func Load(source Source, st *state.State) error {
 						if err != nil {
 							return err
 						}
+						// Re-register code hashes with the first metadata seen for them
+						var codehash acmstate.CodeHash
+						if len(m.CodeHash) == len(codehash) {
+							copy(codehash[:], m.CodeHash)
+							if !registered[codehash] {
+								err = s.SetCodeMetadata(codehash, &acm.CodeMetadata{
+									MetadataHash: metahash.Bytes(),
+									Address:      row.Account.Address,
+								})
+								if err != nil {
+									return err
+								}
+								registered[codehash] = true
+							}
+						}
 						m.MetadataHash = metahash.Bytes()
 						m.Metadata = ""
 					}
@@ -0,0 +1,46 @@
+package event
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/event/pubsub"
+)
+
+const DefaultEventWorkers = 4
+
+// EventsConfig bounds the resources used to deliver events to subscribers so that a burst of subscribers or a slow
+// subscriber cannot delay block commit
+type EventsConfig struct {
+	// The number of events that may be queued for delivery
+	BufferCapacity int
+	// How long to wait for space in a full queue before dropping an event (e.g. '100ms'), wait indefinitely if empty
+	PublishTimeout string `json:",omitempty" toml:",omitempty"`
+	// The number of workers across which subscriptions are partitioned, each matching and delivering events to its own
+	Workers int
+	// The number of events that may be queued for each worker
+	WorkerBufferCapacity int
+}
+
+func DefaultEventsConfig() *EventsConfig {
+	return &EventsConfig{
+		BufferCapacity:       DefaultEventBufferCapacity,
+		Workers:              DefaultEventWorkers,
+		WorkerBufferCapacity: DefaultEventBufferCapacity,
+	}
+}
+
+func (conf *EventsConfig) PubsubOptions() ([]pubsub.Option, error) {
+	options := []pubsub.Option{
+		pubsub.BufferCapacity(conf.BufferCapacity),
+		pubsub.Workers(conf.Workers, conf.WorkerBufferCapacity),
+	}
+	if conf.PublishTimeout != "" {
+		timeout, err := time.ParseDuration(conf.PublishTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse PublishTimeout '%s': %v", conf.PublishTimeout, err)
+		}
+		options = append(options, pubsub.PublishTimeout(timeout))
+	}
+	return options, nil
+}
@@ -28,8 +28,14 @@ type Emitter struct {
 }
 
 // NewEmitter initializes an emitter struct with a pubsubServer
-func NewEmitter() *Emitter {
-	pubsubServer := pubsub.NewServer(pubsub.BufferCapacity(DefaultEventBufferCapacity))
+func NewEmitter(options ...pubsub.Option) *Emitter {
+	if len(options) == 0 {
+		options = []pubsub.Option{
+			pubsub.BufferCapacity(DefaultEventBufferCapacity),
+			pubsub.Workers(DefaultEventWorkers, DefaultEventBufferCapacity),
+		}
+	}
+	pubsubServer := pubsub.NewServer(options...)
 	pubsubServer.BaseService = *service.NewBaseService(nil, "Emitter", pubsubServer)
 	pubsubServer.Start()
 	return &Emitter{
@@ -37,11 +43,30 @@ func NewEmitter() *Emitter {
 	}
 }
 
+// NewEmitterFromConfig initializes an emitter whose resources are bounded by conf
+func NewEmitterFromConfig(conf *EventsConfig, logger *logging.Logger) (*Emitter, error) {
+	options, err := conf.PubsubOptions()
+	if err != nil {
+		return nil, err
+	}
+	em := NewEmitter(append(options, pubsub.WithLogger(logger))...)
+	em.SetLogger(logger)
+	return em, nil
+}
+
 // SetLogger attaches a log handler to this emitter
 func (em *Emitter) SetLogger(logger *logging.Logger) {
 	em.logger = logger.With(structure.ComponentKey, "Events")
 }
 
+// Stats returns the current load on the emitter
+func (em *Emitter) Stats() pubsub.Stats {
+	if em == nil || em.pubsubServer == nil {
+		return pubsub.Stats{}
+	}
+	return em.pubsubServer.Stats()
+}
+
 // Shutdown stops the pubsubServer
 func (em *Emitter) Shutdown(ctx context.Context) error {
 	return em.pubsubServer.Stop()
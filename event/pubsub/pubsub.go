@@ -16,7 +16,10 @@ package pubsub
 import (
 	"context"
 	"errors"
+	"hash/fnv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/logging"
@@ -54,15 +57,45 @@ type cmd struct {
 
 // Server allows clients to subscribe/unsubscribe for messages, publishing
 // messages with or without tags, and manages internal state.
+//
+// Commands are queued for a listener which partitions subscriptions by client
+// across a pool of workers. Each worker matches published messages against
+// the queries of its own clients and delivers them, so that the cost of
+// fan-out is spread across workers and is not borne by publishers.
 type Server struct {
 	service.BaseService
 
 	cmds    chan cmd
 	cmdsCap int
 
+	workers        []chan cmd
+	workersCap     int
+	publishTimeout time.Duration
+
 	mtx           sync.RWMutex
 	subscriptions map[string]map[string]query.Query // subscriber -> query (string) -> query.Query
 	logger        *logging.Logger
+
+	published   uint64
+	dropped     uint64
+	undelivered uint64
+}
+
+// Stats reports the load on a server
+type Stats struct {
+	// Messages accepted for delivery
+	Published uint64
+	// Messages dropped because the queue remained full for longer than the publish timeout
+	Dropped uint64
+	// Deliveries skipped because a subscriber's buffer was full
+	Undelivered uint64
+	// Commands waiting in the server's queue and its capacity
+	Queued   int
+	Capacity int
+	// Commands waiting in the queues of the server's workers and their total capacity
+	WorkersQueued   int
+	WorkersCapacity int
+	Subscriptions   int
 }
 
 // Option sets a parameter for the server.
@@ -84,6 +117,12 @@ func NewServer(options ...Option) *Server {
 
 	// if BufferCapacity option was not set, the channel is unbuffered
 	s.cmds = make(chan cmd, s.cmdsCap)
+	if len(s.workers) == 0 {
+		s.workers = make([]chan cmd, 1)
+	}
+	for i := range s.workers {
+		s.workers[i] = make(chan cmd, s.workersCap)
+	}
 
 	return s
 }
@@ -100,6 +139,30 @@ func BufferCapacity(cap int) Option {
 	}
 }
 
+// Workers sets the number of workers across which subscriptions are
+// partitioned (one if not set) and the capacity of the queue of each. Each
+// worker matches and delivers published messages to the subscriptions of its
+// clients concurrently with the others.
+func Workers(workers, cap int) Option {
+	return func(s *Server) {
+		if workers > 0 {
+			s.workers = make([]chan cmd, workers)
+		}
+		if cap > 0 {
+			s.workersCap = cap
+		}
+	}
+}
+
+// PublishTimeout sets how long a publisher waits for space in a full queue
+// before the message is dropped. Publishers wait until their context is done
+// if it is not set.
+func PublishTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.publishTimeout = timeout
+	}
+}
+
 func WithLogger(logger *logging.Logger) Option {
 	return func(s *Server) {
 		s.logger = logger.WithScope("PubSub")
@@ -111,6 +174,27 @@ func (s *Server) BufferCapacity() int {
 	return s.cmdsCap
 }
 
+// Stats returns the current load on the server
+func (s *Server) Stats() Stats {
+	stats := Stats{
+		Published:       atomic.LoadUint64(&s.published),
+		Dropped:         atomic.LoadUint64(&s.dropped),
+		Undelivered:     atomic.LoadUint64(&s.undelivered),
+		Queued:          len(s.cmds),
+		Capacity:        cap(s.cmds),
+		WorkersCapacity: len(s.workers) * s.workersCap,
+	}
+	for _, worker := range s.workers {
+		stats.WorkersQueued += len(worker)
+	}
+	s.mtx.RLock()
+	for _, clientSubscriptions := range s.subscriptions {
+		stats.Subscriptions += len(clientSubscriptions)
+	}
+	s.mtx.RUnlock()
+	return stats
+}
+
 // Subscribe creates a subscription for the given client. It accepts a channel
 // on which messages matching the given query can be received. An error will be
 // returned to the caller if the context is canceled or if subscription already
@@ -199,10 +283,24 @@ func (s *Server) Publish(ctx context.Context, msg interface{}) error {
 
 // PublishWithTags publishes the given message with the set of tags. The set is
 // matched with clients queries. If there is a match, the message is sent to
-// the client.
+// the client. If the server has a publish timeout and its queue stays full
+// for longer the message is dropped.
 func (s *Server) PublishWithTags(ctx context.Context, msg interface{}, tags query.Tagged) error {
+	c := cmd{op: pub, msg: msg, tags: tags}
+	var timeout <-chan time.Time
+	if s.publishTimeout > 0 {
+		timer := time.NewTimer(s.publishTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
 	select {
-	case s.cmds <- cmd{op: pub, msg: msg, tags: tags}:
+	case s.cmds <- c:
+		atomic.AddUint64(&s.published, 1)
+		return nil
+	case <-timeout:
+		atomic.AddUint64(&s.dropped, 1)
+		s.logger.InfoMsg("pubsub Server dropped message because its queue is full",
+			"timeout", s.publishTimeout.String())
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -221,15 +319,21 @@ type state struct {
 	// client -> query -> struct{}
 	clients map[string]map[query.Query]struct{}
 	logger  *logging.Logger
+	// Counts deliveries skipped because a subscriber's buffer was full
+	undelivered *uint64
 }
 
 // OnStart implements Service.OnStart by starting the server.
 func (s *Server) OnStart() error {
-	go s.loop(state{
-		queries: make(map[query.Query]map[string]chan interface{}),
-		clients: make(map[string]map[query.Query]struct{}),
-		logger:  s.logger,
-	})
+	for _, worker := range s.workers {
+		go s.work(worker, state{
+			queries:     make(map[query.Query]map[string]chan interface{}),
+			clients:     make(map[string]map[query.Query]struct{}),
+			logger:      s.logger,
+			undelivered: &s.undelivered,
+		})
+	}
+	go s.loop()
 	return nil
 }
 
@@ -238,9 +342,36 @@ func (s *Server) OnReset() error {
 	return nil
 }
 
-func (s *Server) loop(state state) {
-loop:
+// Dispatches commands to workers, those concerning a client always to the same worker so that the client receives
+// messages in the order they were published
+func (s *Server) loop() {
 	for cmd := range s.cmds {
+		switch cmd.op {
+		case pub, shutdown:
+			for _, worker := range s.workers {
+				worker <- cmd
+			}
+			if cmd.op == shutdown {
+				return
+			}
+		default:
+			s.workers[s.worker(cmd.clientID)] <- cmd
+		}
+	}
+}
+
+func (s *Server) worker(clientID string) int {
+	if len(s.workers) == 1 {
+		return 0
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(clientID))
+	return int(hash.Sum32() % uint32(len(s.workers)))
+}
+
+func (s *Server) work(cmds chan cmd, state state) {
+loop:
+	for cmd := range cmds {
 		switch cmd.op {
 		case unsub:
 			if cmd.query != nil {
@@ -332,6 +463,7 @@ func (state *state) send(msg interface{}, tags query.Tagged) {
 				select {
 				case ch <- msg:
 				default:
+					atomic.AddUint64(state.undelivered, 1)
 					// It's difficult to do anything sensible here with retries/times outs since we may reorder a client's
 					// view of events by sending a later message before an earlier message we retry. If per-client order
 					// matters then we need a queue per client. Possible for us it does not...
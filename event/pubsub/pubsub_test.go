@@ -168,6 +168,65 @@ func TestBufferCapacity(t *testing.T) {
 	}
 }
 
+func TestPublishTimeout(t *testing.T) {
+	s := pubsub.NewServer(pubsub.BufferCapacity(1), pubsub.PublishTimeout(10*time.Millisecond))
+
+	ctx := context.Background()
+	err := s.Publish(ctx, "Nighthawk")
+	require.NoError(t, err)
+	// The server is not started so its queue stays full and the message is dropped rather than blocking
+	err = s.Publish(ctx, "Sage")
+	require.NoError(t, err)
+
+	stats := s.Stats()
+	assert.Equal(t, uint64(1), stats.Published)
+	assert.Equal(t, uint64(1), stats.Dropped)
+	assert.Equal(t, 1, stats.Queued)
+	assert.Equal(t, 1, stats.Capacity)
+}
+
+func TestWorkers(t *testing.T) {
+	s := pubsub.NewServer(pubsub.Workers(4, 10))
+	s.Start()
+	defer s.Stop()
+
+	ctx := context.Background()
+	chs := make([]<-chan interface{}, 20)
+	for i := range chs {
+		var err error
+		chs[i], err = s.Subscribe(ctx, fmt.Sprintf("client-%d", i), query.Empty{}, 3)
+		require.NoError(t, err)
+	}
+	for _, msg := range []string{"Wolverine", "Storm", "Cyclops"} {
+		require.NoError(t, s.Publish(ctx, msg))
+	}
+	// Every client receives every message in order whichever worker it is assigned to
+	for _, ch := range chs {
+		assertReceive(t, "Wolverine", ch)
+		assertReceive(t, "Storm", ch)
+		assertReceive(t, "Cyclops", ch)
+	}
+
+	// A client that does not keep up misses messages but does not hold up others
+	require.NoError(t, s.Publish(ctx, "Rogue"))
+	for _, ch := range chs[1:] {
+		assertReceive(t, "Rogue", ch)
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.Publish(ctx, "Gambit"))
+	}
+	for _, ch := range chs[1:] {
+		for i := 0; i < 3; i++ {
+			assertReceive(t, "Gambit", ch)
+		}
+	}
+	// The first client's worker may not yet have tried to deliver the last message
+	assert.Eventually(t, func() bool { return s.Stats().Undelivered == 1 }, receiveTimeout, time.Millisecond)
+	stats := s.Stats()
+	assert.Equal(t, 20, stats.Subscriptions)
+	assert.Equal(t, 40, stats.WorkersCapacity)
+}
+
 func Benchmark10Clients(b *testing.B)   { benchmarkNClients(10, b) }
 func Benchmark100Clients(b *testing.B)  { benchmarkNClients(100, b) }
 func Benchmark1000Clients(b *testing.B) { benchmarkNClients(1000, b) }
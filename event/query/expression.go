@@ -3,6 +3,8 @@ package query
 import (
 	"fmt"
 	"math/big"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +32,8 @@ const (
 	OpGreater
 	OpEqual
 	OpContains
+	OpMatches
+	OpIn
 )
 
 var opNames = map[Operator]string{
@@ -41,6 +45,8 @@ var opNames = map[Operator]string{
 	OpGreater:      ">",
 	OpEqual:        "=",
 	OpContains:     "CONTAINS",
+	OpMatches:      "MATCHES",
+	OpIn:           "IN",
 }
 
 func (op Operator) String() string {
@@ -54,7 +60,11 @@ type instruction struct {
 	string *string
 	time   *time.Time
 	number *big.Float
-	match  bool
+	// The regular expression of a MATCHES operand
+	regexp *regexp.Regexp
+	// The operands of an IN operand
+	list  []*instruction
+	match bool
 }
 
 func (in *instruction) String() string {
@@ -69,6 +79,12 @@ func (in *instruction) String() string {
 		return in.time.String()
 	case in.number != nil:
 		return in.number.String()
+	case in.list != nil:
+		strs := make([]string, len(in.list))
+		for i, operand := range in.list {
+			strs[i] = operand.String()
+		}
+		return "(" + strings.Join(strs, ", ") + ")"
 	default:
 		if in.match {
 			return "true"
@@ -80,9 +96,12 @@ func (in *instruction) String() string {
 // A Boolean expression for the query grammar
 type Expression struct {
 	// This is our 'bytecode'
-	code      []*instruction
-	errors    errors.MultipleErrors
-	explainer func(format string, args ...interface{})
+	code []*instruction
+	// Where the operands of the list being parsed begin in code
+	listStart  int
+	directives Directives
+	errors     errors.MultipleErrors
+	explainer  func(format string, args ...interface{})
 }
 
 // Evaluate expects an Execute() to have filled the code of the Expression so it can be run in the little stack machine
@@ -91,6 +110,10 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 	if len(e.errors) > 0 {
 		return false, e.errors
 	}
+	if len(e.code) == 0 {
+		// A query consisting only of directives matches everything
+		return true, nil
+	}
 	var left, right *instruction
 	stack := make([]*instruction, 0, len(e.code))
 	for _, in := range e.code {
@@ -116,14 +139,7 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 			tagValue, ok := getTagValue(*left.tag)
 			// No match if we can't get tag value
 			if ok {
-				switch {
-				case right.string != nil:
-					ins.match = compareString(in.op, tagValue, *right.string)
-				case right.number != nil:
-					ins.match = compareNumber(in.op, tagValue, right.number)
-				case right.time != nil:
-					ins.match = compareTime(in.op, tagValue, *right.time)
-				}
+				ins.match = compare(in.op, tagValue, right)
 			}
 			// Uncomment this for a little bit of debug:
 			//e.explainf("%v := %v\n", left, tagValue)
@@ -141,6 +157,50 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 	return stack[0].match, nil
 }
 
+// Returns the values that tags must equal for the expression to be true, which are those given by conditions of the
+// form Tag = 'value' that are not within an OR
+func (e *Expression) equalities() map[string]string {
+	if len(e.errors) > 0 {
+		return nil
+	}
+	// A subexpression and the equalities it implies
+	type term struct {
+		in         *instruction
+		equalities map[string]string
+	}
+	stack := make([]term, 0, len(e.code))
+	for _, in := range e.code {
+		if in.op == OpTerminal {
+			stack = append(stack, term{in: in})
+			continue
+		}
+		if len(stack) < 2 {
+			return nil
+		}
+		left, right := stack[len(stack)-2], stack[len(stack)-1]
+		stack = stack[:len(stack)-2]
+		equalities := make(map[string]string)
+		switch in.op {
+		case OpAnd:
+			for tag, value := range left.equalities {
+				equalities[tag] = value
+			}
+			for tag, value := range right.equalities {
+				equalities[tag] = value
+			}
+		case OpEqual:
+			if left.in.tag != nil && right.in.string != nil {
+				equalities[*left.in.tag] = *right.in.string
+			}
+		}
+		stack = append(stack, term{in: in, equalities: equalities})
+	}
+	if len(stack) != 1 {
+		return nil
+	}
+	return stack[0].equalities
+}
+
 func (e *Expression) explainf(fmt string, args ...interface{}) {
 	if e.explainer != nil {
 		e.explainer(fmt, args...)
@@ -151,6 +211,26 @@ func pop(stack []*instruction) ([]*instruction, *instruction, *instruction) {
 	return stack[:len(stack)-2], stack[len(stack)-2], stack[len(stack)-1]
 }
 
+func compare(op Operator, tagValue interface{}, operand *instruction) bool {
+	switch {
+	case operand.list != nil:
+		for _, member := range operand.list {
+			if compare(OpEqual, tagValue, member) {
+				return true
+			}
+		}
+	case operand.regexp != nil:
+		return operand.regexp.MatchString(StringFromValue(tagValue))
+	case operand.string != nil:
+		return compareString(op, tagValue, *operand.string)
+	case operand.number != nil:
+		return compareNumber(op, tagValue, operand.number)
+	case operand.time != nil:
+		return compareTime(op, tagValue, *operand.time)
+	}
+	return false
+}
+
 func compareString(op Operator, tagValue interface{}, value string) bool {
 	tagString := StringFromValue(tagValue)
 	switch op {
@@ -257,11 +337,49 @@ func (e *Expression) String() string {
 }
 
 func (e *Expression) Operator(operator Operator) {
+	if operator == OpMatches {
+		// Compile the pattern once rather than on every evaluation
+		operand := e.code[len(e.code)-1]
+		var err error
+		operand.regexp, err = regexp.Compile(*operand.string)
+		if err != nil {
+			e.pushErr(fmt.Errorf("could not compile MATCHES pattern '%s': %v", *operand.string, err))
+		}
+	}
 	e.code = append(e.code, &instruction{
 		op: operator,
 	})
 }
 
+func (e *Expression) ListStart() {
+	e.listStart = len(e.code)
+}
+
+// Replaces the operands pushed since ListStart with a single list operand
+func (e *Expression) ListEnd() {
+	list := make([]*instruction, len(e.code)-e.listStart)
+	copy(list, e.code[e.listStart:])
+	e.code = append(e.code[:e.listStart], &instruction{
+		list: list,
+	})
+}
+
+// Directives...
+
+func (e *Expression) OrderBy(tag string) {
+	e.directives.OrderBy = tag
+}
+
+func (e *Expression) Descending() {
+	e.directives.Descending = true
+}
+
+func (e *Expression) Limit(value string) {
+	limit, err := strconv.ParseUint(value, 10, 64)
+	e.pushErr(err)
+	e.directives.Limit = limit
+}
+
 // Terminals...
 
 func (e *Expression) Tag(value string) {
@@ -30,4 +30,10 @@ func TestExpression(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, matches)
 	})
+
+	t.Run("IN list", func(t *testing.T) {
+		qry, err := New("something IN ('awful', 3) AND another_thing = 'OKAY'")
+		require.NoError(t, err)
+		require.Equal(t, "something, ('awful', 3), IN, another_thing, 'OKAY', =, AND", qry.parser.String())
+	})
 }
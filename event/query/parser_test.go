@@ -77,6 +77,29 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"EventType IN ('LogEvent', 'CallEvent')", true},
+		{"Height IN (1, 2,3)", true},
+		{"Height IN (1)", true},
+		{"Height IN ()", false},
+		{"Height IN 1, 2", false},
+		{"Height IN (1, 2", false},
+		{"Height IN (1,)", false},
+		{"Address MATCHES '^AB.*'", true},
+		{"Address MATCHES '(AB'", false},
+		{"Address MATCHES AB", false},
+
+		{"Height > 3 ORDER BY Height", true},
+		{"Height > 3 ORDER BY Height ASC", true},
+		{"Height > 3 order by Height desc LIMIT 10", true},
+		{"Height > 3 LIMIT 10", true},
+		{"ORDER BY Height DESC", true},
+		{"LIMIT 10", true},
+		{"Height > 3 LIMIT", false},
+		{"Height > 3 LIMIT -1", false},
+		{"Height > 3 LIMIT 10 ORDER BY Height", false},
+		{"Height > 3 ORDER BY", false},
+		{"Height > 3 ORDER Height", false},
 	}
 
 	for _, c := range cases {
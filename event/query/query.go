@@ -45,6 +45,41 @@ type Condition struct {
 	Operand interface{}
 }
 
+// Directives on the results matching a query that are given by the ORDER BY and LIMIT clauses that may follow its
+// conditions, e.g.:
+//
+//		EventType = 'LogEvent' ORDER BY Height DESC LIMIT 10
+//
+// They do not affect which tags a query matches but are left for the consumer of the query to apply.
+type Directives struct {
+	// The tag by which results should be ordered, if any
+	OrderBy string
+	// Whether results should be in descending rather than ascending order
+	Descending bool
+	// The most results that should be returned or zero for no limit
+	Limit uint64
+}
+
+// Returns the directives of query, which are empty if it has none
+func DirectivesOf(query Query) Directives {
+	if dq, ok := query.(interface{ Directives() Directives }); ok {
+		return dq.Directives()
+	}
+	return Directives{}
+}
+
+// Returns the values that tags must equal for query to match, e.g. {"EventType": "LogEvent"} for:
+//
+//		EventType = 'LogEvent' AND (Height < 10 OR Height > 20)
+//
+// Consumers of a query may use them to narrow down the tags they need to match it against.
+func EqualitiesOf(query Query) map[string]string {
+	if eq, ok := query.(interface{ Equalities() map[string]string }); ok {
+		return eq.Equalities()
+	}
+	return nil
+}
+
 // New parses the given string and returns a query or error if the string is
 // invalid.
 func New(s string) (*PegQuery, error) {
@@ -63,6 +98,9 @@ func New(s string) (*PegQuery, error) {
 		return nil, err
 	}
 	p.Execute()
+	if len(p.errors) > 0 {
+		return nil, p.errors
+	}
 	return &PegQuery{str: s, parser: p}, nil
 }
 
@@ -81,6 +119,16 @@ func (q *PegQuery) String() string {
 	return q.str
 }
 
+// Directives returns the directives on the results of the query
+func (q *PegQuery) Directives() Directives {
+	return q.parser.directives
+}
+
+// Equalities returns the values that tags must equal for the query to match
+func (q *PegQuery) Equalities() map[string]string {
+	return q.parser.equalities()
+}
+
 func (q *PegQuery) Query() (Query, error) {
 	return q, nil
 }
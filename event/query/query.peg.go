@@ -21,7 +21,11 @@ const (
 	ruleeor
 	ruleeand
 	rulecondition
+	rulelist
+	ruleoperand
+	ruledirectives
 	ruletag
+	rulefield
 	ruleqvalue
 	rulevalue
 	rulenumber
@@ -35,10 +39,18 @@ const (
 	ruleor
 	ruleequal
 	rulecontains
+	rulematches
+	rulein
 	rulele
 	rulege
 	rulel
 	ruleg
+	ruleorder
+	ruleby
+	ruleasc
+	ruledesc
+	rulelimit
+	rulecomma
 	ruleopen
 	ruleclose
 	rulesp
@@ -50,12 +62,19 @@ const (
 	ruleAction5
 	ruleAction6
 	ruleAction7
-	rulePegText
 	ruleAction8
 	ruleAction9
 	ruleAction10
 	ruleAction11
 	ruleAction12
+	rulePegText
+	ruleAction13
+	ruleAction14
+	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
 )
 
 var rul3s = [...]string{
@@ -64,7 +83,11 @@ var rul3s = [...]string{
 	"eor",
 	"eand",
 	"condition",
+	"list",
+	"operand",
+	"directives",
 	"tag",
+	"field",
 	"qvalue",
 	"value",
 	"number",
@@ -78,10 +101,18 @@ var rul3s = [...]string{
 	"or",
 	"equal",
 	"contains",
+	"matches",
+	"in",
 	"le",
 	"ge",
 	"l",
 	"g",
+	"order",
+	"by",
+	"asc",
+	"desc",
+	"limit",
+	"comma",
 	"open",
 	"close",
 	"sp",
@@ -93,12 +124,19 @@ var rul3s = [...]string{
 	"Action5",
 	"Action6",
 	"Action7",
-	"PegText",
 	"Action8",
 	"Action9",
 	"Action10",
 	"Action11",
 	"Action12",
+	"PegText",
+	"Action13",
+	"Action14",
+	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
 }
 
 type token32 struct {
@@ -215,7 +253,7 @@ type QueryParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [40]func() bool
+	rules  [59]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -328,14 +366,28 @@ func (p *QueryParser) Execute() {
 		case ruleAction7:
 			p.Operator(OpContains)
 		case ruleAction8:
-			p.Tag(buffer[begin:end])
+			p.Operator(OpMatches)
 		case ruleAction9:
-			p.Value(buffer[begin:end])
+			p.Operator(OpIn)
 		case ruleAction10:
-			p.Number(buffer[begin:end])
+			p.ListStart()
 		case ruleAction11:
-			p.Time(buffer[begin:end])
+			p.ListEnd()
 		case ruleAction12:
+			p.Descending()
+		case ruleAction13:
+			p.Limit(buffer[begin:end])
+		case ruleAction14:
+			p.Tag(buffer[begin:end])
+		case ruleAction15:
+			p.OrderBy(buffer[begin:end])
+		case ruleAction16:
+			p.Value(buffer[begin:end])
+		case ruleAction17:
+			p.Number(buffer[begin:end])
+		case ruleAction18:
+			p.Time(buffer[begin:end])
+		case ruleAction19:
 			p.Date(buffer[begin:end])
 
 		}
@@ -430,14 +482,44 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <(eor !.)> */
+		/* 0 e <- <(((eor directives) / (&(order / limit) directives)) !.)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
-				if !_rules[ruleeor]() {
-					goto l0
+				{
+					position183, tokenIndex183 := position, tokenIndex
+					if !_rules[ruleeor]() {
+						goto l184
+					}
+					if !_rules[ruledirectives]() {
+						goto l184
+					}
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
+					{
+						position185, tokenIndex185 := position, tokenIndex
+						{
+							position186, tokenIndex186 := position, tokenIndex
+							if !_rules[ruleorder]() {
+								goto l187
+							}
+							goto l186
+						l187:
+							position, tokenIndex = position186, tokenIndex186
+							if !_rules[rulelimit]() {
+								goto l0
+							}
+						}
+					l186:
+						position, tokenIndex = position185, tokenIndex185
+					}
+					if !_rules[ruledirectives]() {
+						goto l0
+					}
 				}
+			l183:
 				{
 					position2, tokenIndex2 := position, tokenIndex
 					if !matchDot() {
@@ -516,7 +598,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position7, tokenIndex7
 			return false
 		},
-		/* 3 condition <- <((tag sp ((le (number / time / date) Action2) / (ge (number / time / date) Action3) / (l (number / time / date) Action4) / (g (number / time / date) Action5) / (equal (number / time / date / qvalue) Action6) / (contains qvalue Action7)) sp) / (open eor close))> */
+		/* 3 condition <- <((tag sp ((le (number / time / date) Action2) / (ge (number / time / date) Action3) / (l (number / time / date) Action4) / (g (number / time / date) Action5) / (equal (number / time / date / qvalue) Action6) / (contains qvalue Action7) / (matches qvalue Action8) / (in list Action9)) sp) / (open eor close))> */
 		func() bool {
 			position11, tokenIndex11 := position, tokenIndex
 			{
@@ -678,12 +760,36 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 					l32:
 						position, tokenIndex = position15, tokenIndex15
 						if !_rules[rulecontains]() {
-							goto l14
+							goto l188
 						}
 						if !_rules[ruleqvalue]() {
-							goto l14
+							goto l188
 						}
 						if !_rules[ruleAction7]() {
+							goto l188
+						}
+						goto l15
+					l188:
+						position, tokenIndex = position15, tokenIndex15
+						if !_rules[rulematches]() {
+							goto l189
+						}
+						if !_rules[ruleqvalue]() {
+							goto l189
+						}
+						if !_rules[ruleAction8]() {
+							goto l189
+						}
+						goto l15
+					l189:
+						position, tokenIndex = position15, tokenIndex15
+						if !_rules[rulein]() {
+							goto l14
+						}
+						if !_rules[rulelist]() {
+							goto l14
+						}
+						if !_rules[ruleAction9]() {
 							goto l14
 						}
 					}
@@ -712,7 +818,167 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position11, tokenIndex11
 			return false
 		},
-		/* 4 tag <- <(<(!(' ' / '\t' / '\n' / '\r' / '\\' / '(' / ')' / '"' / '\'' / '=' / '>' / '<') .)+> sp Action8)> */
+		/* 4 list <- <(open Action10 operand (comma operand)* close Action11)> */
+		func() bool {
+			position190, tokenIndex190 := position, tokenIndex
+			{
+				position191 := position
+				if !_rules[ruleopen]() {
+					goto l190
+				}
+				if !_rules[ruleAction10]() {
+					goto l190
+				}
+				if !_rules[ruleoperand]() {
+					goto l190
+				}
+			l192:
+				{
+					position193, tokenIndex193 := position, tokenIndex
+					if !_rules[rulecomma]() {
+						goto l193
+					}
+					if !_rules[ruleoperand]() {
+						goto l193
+					}
+					goto l192
+				l193:
+					position, tokenIndex = position193, tokenIndex193
+				}
+				if !_rules[ruleclose]() {
+					goto l190
+				}
+				if !_rules[ruleAction11]() {
+					goto l190
+				}
+				add(rulelist, position191)
+			}
+			return true
+		l190:
+			position, tokenIndex = position190, tokenIndex190
+			return false
+		},
+		/* 5 operand <- <((number / time / date / qvalue) sp)> */
+		func() bool {
+			position194, tokenIndex194 := position, tokenIndex
+			{
+				position195 := position
+				{
+					position196, tokenIndex196 := position, tokenIndex
+					if !_rules[rulenumber]() {
+						goto l197
+					}
+					goto l196
+				l197:
+					position, tokenIndex = position196, tokenIndex196
+					if !_rules[ruletime]() {
+						goto l198
+					}
+					goto l196
+				l198:
+					position, tokenIndex = position196, tokenIndex196
+					if !_rules[ruledate]() {
+						goto l199
+					}
+					goto l196
+				l199:
+					position, tokenIndex = position196, tokenIndex196
+					if !_rules[ruleqvalue]() {
+						goto l194
+					}
+				}
+			l196:
+				if !_rules[rulesp]() {
+					goto l194
+				}
+				add(ruleoperand, position195)
+			}
+			return true
+		l194:
+			position, tokenIndex = position194, tokenIndex194
+			return false
+		},
+		/* 6 directives <- <((order by field (asc / (desc Action12))?)? (limit <digit+> sp Action13)?)> */
+		func() bool {
+			{
+				position200 := position
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					if !_rules[ruleorder]() {
+						goto l201
+					}
+					if !_rules[ruleby]() {
+						goto l201
+					}
+					if !_rules[rulefield]() {
+						goto l201
+					}
+					{
+						position203, tokenIndex203 := position, tokenIndex
+						{
+							position205, tokenIndex205 := position, tokenIndex
+							if !_rules[ruleasc]() {
+								goto l206
+							}
+							goto l205
+						l206:
+							position, tokenIndex = position205, tokenIndex205
+							if !_rules[ruledesc]() {
+								goto l203
+							}
+							if !_rules[ruleAction12]() {
+								goto l203
+							}
+						}
+					l205:
+						goto l204
+					l203:
+						position, tokenIndex = position203, tokenIndex203
+					}
+				l204:
+					goto l202
+				l201:
+					position, tokenIndex = position201, tokenIndex201
+				}
+			l202:
+				{
+					position207, tokenIndex207 := position, tokenIndex
+					if !_rules[rulelimit]() {
+						goto l207
+					}
+					{
+						position209 := position
+						if !_rules[ruledigit]() {
+							goto l207
+						}
+					l210:
+						{
+							position211, tokenIndex211 := position, tokenIndex
+							if !_rules[ruledigit]() {
+								goto l211
+							}
+							goto l210
+						l211:
+							position, tokenIndex = position211, tokenIndex211
+						}
+						add(rulePegText, position209)
+					}
+					if !_rules[rulesp]() {
+						goto l207
+					}
+					if !_rules[ruleAction13]() {
+						goto l207
+					}
+					goto l208
+				l207:
+					position, tokenIndex = position207, tokenIndex207
+				}
+			l208:
+				add(ruledirectives, position200)
+			}
+			return true
+		},
+		/* 7 tag <- <(<(!(' ' / '\t' / '\n' / '\r' / '\\' / '(' / ')' / '"' / '\'' / '=' / '>' / '<') .)+> sp Action14)> */
 		func() bool {
 			position37, tokenIndex37 := position, tokenIndex
 			{
@@ -919,7 +1185,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 				if !_rules[rulesp]() {
 					goto l37
 				}
-				if !_rules[ruleAction8]() {
+				if !_rules[ruleAction14]() {
 					goto l37
 				}
 				add(ruletag, position38)
@@ -929,7 +1195,224 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position37, tokenIndex37
 			return false
 		},
-		/* 5 qvalue <- <('\'' value '\'' sp)> */
+		/* 8 field <- <(<(!(' ' / '\t' / '\n' / '\r' / '\\' / '(' / ')' / '"' / '\'' / '=' / '>' / '<') .)+> sp Action15)> */
+		func() bool {
+			position212, tokenIndex212 := position, tokenIndex
+			{
+				position213 := position
+				{
+					position214 := position
+					{
+						position217, tokenIndex217 := position, tokenIndex
+						{
+							position218, tokenIndex218 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l219
+							}
+							position++
+							goto l218
+						l219:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('\t') {
+								goto l220
+							}
+							position++
+							goto l218
+						l220:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('\n') {
+								goto l221
+							}
+							position++
+							goto l218
+						l221:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('\r') {
+								goto l222
+							}
+							position++
+							goto l218
+						l222:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('\\') {
+								goto l223
+							}
+							position++
+							goto l218
+						l223:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('(') {
+								goto l224
+							}
+							position++
+							goto l218
+						l224:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune(')') {
+								goto l225
+							}
+							position++
+							goto l218
+						l225:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('"') {
+								goto l226
+							}
+							position++
+							goto l218
+						l226:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('\'') {
+								goto l227
+							}
+							position++
+							goto l218
+						l227:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('=') {
+								goto l228
+							}
+							position++
+							goto l218
+						l228:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('>') {
+								goto l229
+							}
+							position++
+							goto l218
+						l229:
+							position, tokenIndex = position218, tokenIndex218
+							if buffer[position] != rune('<') {
+								goto l217
+							}
+							position++
+						}
+					l218:
+						goto l212
+					l217:
+						position, tokenIndex = position217, tokenIndex217
+					}
+					if !matchDot() {
+						goto l212
+					}
+				l215:
+					{
+						position216, tokenIndex216 := position, tokenIndex
+						{
+							position230, tokenIndex230 := position, tokenIndex
+							{
+								position231, tokenIndex231 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l232
+								}
+								position++
+								goto l231
+							l232:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('\t') {
+									goto l233
+								}
+								position++
+								goto l231
+							l233:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('\n') {
+									goto l234
+								}
+								position++
+								goto l231
+							l234:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('\r') {
+									goto l235
+								}
+								position++
+								goto l231
+							l235:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('\\') {
+									goto l236
+								}
+								position++
+								goto l231
+							l236:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('(') {
+									goto l237
+								}
+								position++
+								goto l231
+							l237:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune(')') {
+									goto l238
+								}
+								position++
+								goto l231
+							l238:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('"') {
+									goto l239
+								}
+								position++
+								goto l231
+							l239:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('\'') {
+									goto l240
+								}
+								position++
+								goto l231
+							l240:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('=') {
+									goto l241
+								}
+								position++
+								goto l231
+							l241:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('>') {
+									goto l242
+								}
+								position++
+								goto l231
+							l242:
+								position, tokenIndex = position231, tokenIndex231
+								if buffer[position] != rune('<') {
+									goto l230
+								}
+								position++
+							}
+						l231:
+							goto l216
+						l230:
+							position, tokenIndex = position230, tokenIndex230
+						}
+						if !matchDot() {
+							goto l216
+						}
+						goto l215
+					l216:
+						position, tokenIndex = position216, tokenIndex216
+					}
+					add(rulePegText, position214)
+				}
+				if !_rules[rulesp]() {
+					goto l212
+				}
+				if !_rules[ruleAction15]() {
+					goto l212
+				}
+				add(rulefield, position213)
+			}
+			return true
+		l212:
+			position, tokenIndex = position212, tokenIndex212
+			return false
+		},
+		/* 9 qvalue <- <('\'' value '\'' sp)> */
 		func() bool {
 			position68, tokenIndex68 := position, tokenIndex
 			{
@@ -955,7 +1438,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position68, tokenIndex68
 			return false
 		},
-		/* 6 value <- <(<(!('"' / '\'') .)*> Action9)> */
+		/* 10 value <- <(<(!('"' / '\'') .)*> Action16)> */
 		func() bool {
 			position70, tokenIndex70 := position, tokenIndex
 			{
@@ -995,7 +1478,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 					}
 					add(rulePegText, position72)
 				}
-				if !_rules[ruleAction9]() {
+				if !_rules[ruleAction16]() {
 					goto l70
 				}
 				add(rulevalue, position71)
@@ -1005,7 +1488,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position70, tokenIndex70
 			return false
 		},
-		/* 7 number <- <(<('0' / ([1-9] digit* ('.' digit*)?))> Action10)> */
+		/* 11 number <- <(<('0' / ([1-9] digit* ('.' digit*)?))> Action17)> */
 		func() bool {
 			position78, tokenIndex78 := position, tokenIndex
 			{
@@ -1060,7 +1543,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 				l81:
 					add(rulePegText, position80)
 				}
-				if !_rules[ruleAction10]() {
+				if !_rules[ruleAction17]() {
 					goto l78
 				}
 				add(rulenumber, position79)
@@ -1070,7 +1553,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position78, tokenIndex78
 			return false
 		},
-		/* 8 digit <- <[0-9]> */
+		/* 12 digit <- <[0-9]> */
 		func() bool {
 			position89, tokenIndex89 := position, tokenIndex
 			{
@@ -1086,7 +1569,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position89, tokenIndex89
 			return false
 		},
-		/* 9 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))> Action11)> */
+		/* 13 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))> Action18)> */
 		func() bool {
 			position91, tokenIndex91 := position, tokenIndex
 			{
@@ -1248,7 +1731,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 				l102:
 					add(rulePegText, position101)
 				}
-				if !_rules[ruleAction11]() {
+				if !_rules[ruleAction18]() {
 					goto l91
 				}
 				add(ruletime, position92)
@@ -1258,7 +1741,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position91, tokenIndex91
 			return false
 		},
-		/* 10 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)> Action12)> */
+		/* 14 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)> Action19)> */
 		func() bool {
 			position106, tokenIndex106 := position, tokenIndex
 			{
@@ -1348,7 +1831,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 					}
 					add(rulePegText, position116)
 				}
-				if !_rules[ruleAction12]() {
+				if !_rules[ruleAction19]() {
 					goto l106
 				}
 				add(ruledate, position107)
@@ -1358,7 +1841,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position106, tokenIndex106
 			return false
 		},
-		/* 11 year <- <(('1' / '2') digit digit digit)> */
+		/* 15 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
 			position117, tokenIndex117 := position, tokenIndex
 			{
@@ -1394,7 +1877,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position117, tokenIndex117
 			return false
 		},
-		/* 12 month <- <(('0' / '1') digit)> */
+		/* 16 month <- <(('0' / '1') digit)> */
 		func() bool {
 			position121, tokenIndex121 := position, tokenIndex
 			{
@@ -1424,7 +1907,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position121, tokenIndex121
 			return false
 		},
-		/* 13 day <- <(('0' / '1' / '2' / '3') digit)> */
+		/* 17 day <- <(('0' / '1' / '2' / '3') digit)> */
 		func() bool {
 			position125, tokenIndex125 := position, tokenIndex
 			{
@@ -1468,7 +1951,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position125, tokenIndex125
 			return false
 		},
-		/* 14 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') sp)> */
+		/* 18 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') sp)> */
 		func() bool {
 			position131, tokenIndex131 := position, tokenIndex
 			{
@@ -1528,7 +2011,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position131, tokenIndex131
 			return false
 		},
-		/* 15 or <- <(('o' / 'O') ('r' / 'R') sp)> */
+		/* 19 or <- <(('o' / 'O') ('r' / 'R') sp)> */
 		func() bool {
 			position139, tokenIndex139 := position, tokenIndex
 			{
@@ -1573,7 +2056,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position139, tokenIndex139
 			return false
 		},
-		/* 16 equal <- <('=' sp)> */
+		/* 20 equal <- <('=' sp)> */
 		func() bool {
 			position145, tokenIndex145 := position, tokenIndex
 			{
@@ -1592,7 +2075,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position145, tokenIndex145
 			return false
 		},
-		/* 17 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') sp)> */
+		/* 21 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') sp)> */
 		func() bool {
 			position147, tokenIndex147 := position, tokenIndex
 			{
@@ -1727,43 +2210,208 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position147, tokenIndex147
 			return false
 		},
-		/* 18 le <- <('<' '=' sp)> */
+		/* 22 matches <- <(('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S') sp)> */
 		func() bool {
-			position165, tokenIndex165 := position, tokenIndex
+			position243, tokenIndex243 := position, tokenIndex
 			{
-				position166 := position
-				if buffer[position] != rune('<') {
-					goto l165
+				position244 := position
+				{
+					position245, tokenIndex245 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l246
+					}
+					position++
+					goto l245
+				l246:
+					position, tokenIndex = position245, tokenIndex245
+					if buffer[position] != rune('M') {
+						goto l243
+					}
+					position++
 				}
-				position++
-				if buffer[position] != rune('=') {
-					goto l165
+			l245:
+				{
+					position247, tokenIndex247 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l248
+					}
+					position++
+					goto l247
+				l248:
+					position, tokenIndex = position247, tokenIndex247
+					if buffer[position] != rune('A') {
+						goto l243
+					}
+					position++
 				}
-				position++
-				if !_rules[rulesp]() {
-					goto l165
+			l247:
+				{
+					position249, tokenIndex249 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l250
+					}
+					position++
+					goto l249
+				l250:
+					position, tokenIndex = position249, tokenIndex249
+					if buffer[position] != rune('T') {
+						goto l243
+					}
+					position++
 				}
-				add(rulele, position166)
-			}
-			return true
-		l165:
-			position, tokenIndex = position165, tokenIndex165
-			return false
-		},
-		/* 19 ge <- <('>' '=' sp)> */
-		func() bool {
-			position167, tokenIndex167 := position, tokenIndex
-			{
-				position168 := position
-				if buffer[position] != rune('>') {
-					goto l167
+			l249:
+				{
+					position251, tokenIndex251 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l252
+					}
+					position++
+					goto l251
+				l252:
+					position, tokenIndex = position251, tokenIndex251
+					if buffer[position] != rune('C') {
+						goto l243
+					}
+					position++
 				}
-				position++
-				if buffer[position] != rune('=') {
-					goto l167
+			l251:
+				{
+					position253, tokenIndex253 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l254
+					}
+					position++
+					goto l253
+				l254:
+					position, tokenIndex = position253, tokenIndex253
+					if buffer[position] != rune('H') {
+						goto l243
+					}
+					position++
 				}
-				position++
-				if !_rules[rulesp]() {
+			l253:
+				{
+					position255, tokenIndex255 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l256
+					}
+					position++
+					goto l255
+				l256:
+					position, tokenIndex = position255, tokenIndex255
+					if buffer[position] != rune('E') {
+						goto l243
+					}
+					position++
+				}
+			l255:
+				{
+					position257, tokenIndex257 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l258
+					}
+					position++
+					goto l257
+				l258:
+					position, tokenIndex = position257, tokenIndex257
+					if buffer[position] != rune('S') {
+						goto l243
+					}
+					position++
+				}
+			l257:
+				if !_rules[rulesp]() {
+					goto l243
+				}
+				add(rulematches, position244)
+			}
+			return true
+		l243:
+			position, tokenIndex = position243, tokenIndex243
+			return false
+		},
+		/* 23 in <- <(('i' / 'I') ('n' / 'N') sp)> */
+		func() bool {
+			position259, tokenIndex259 := position, tokenIndex
+			{
+				position260 := position
+				{
+					position261, tokenIndex261 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l262
+					}
+					position++
+					goto l261
+				l262:
+					position, tokenIndex = position261, tokenIndex261
+					if buffer[position] != rune('I') {
+						goto l259
+					}
+					position++
+				}
+			l261:
+				{
+					position263, tokenIndex263 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l264
+					}
+					position++
+					goto l263
+				l264:
+					position, tokenIndex = position263, tokenIndex263
+					if buffer[position] != rune('N') {
+						goto l259
+					}
+					position++
+				}
+			l263:
+				if !_rules[rulesp]() {
+					goto l259
+				}
+				add(rulein, position260)
+			}
+			return true
+		l259:
+			position, tokenIndex = position259, tokenIndex259
+			return false
+		},
+		/* 24 le <- <('<' '=' sp)> */
+		func() bool {
+			position165, tokenIndex165 := position, tokenIndex
+			{
+				position166 := position
+				if buffer[position] != rune('<') {
+					goto l165
+				}
+				position++
+				if buffer[position] != rune('=') {
+					goto l165
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l165
+				}
+				add(rulele, position166)
+			}
+			return true
+		l165:
+			position, tokenIndex = position165, tokenIndex165
+			return false
+		},
+		/* 25 ge <- <('>' '=' sp)> */
+		func() bool {
+			position167, tokenIndex167 := position, tokenIndex
+			{
+				position168 := position
+				if buffer[position] != rune('>') {
+					goto l167
+				}
+				position++
+				if buffer[position] != rune('=') {
+					goto l167
+				}
+				position++
+				if !_rules[rulesp]() {
 					goto l167
 				}
 				add(rulege, position168)
@@ -1773,7 +2421,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position167, tokenIndex167
 			return false
 		},
-		/* 20 l <- <('<' sp)> */
+		/* 26 l <- <('<' sp)> */
 		func() bool {
 			position169, tokenIndex169 := position, tokenIndex
 			{
@@ -1792,7 +2440,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position169, tokenIndex169
 			return false
 		},
-		/* 21 g <- <('>' sp)> */
+		/* 27 g <- <('>' sp)> */
 		func() bool {
 			position171, tokenIndex171 := position, tokenIndex
 			{
@@ -1811,7 +2459,386 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position171, tokenIndex171
 			return false
 		},
-		/* 22 open <- <('(' sp)> */
+		/* 28 order <- <(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp)> */
+		func() bool {
+			position265, tokenIndex265 := position, tokenIndex
+			{
+				position266 := position
+				{
+					position267, tokenIndex267 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l268
+					}
+					position++
+					goto l267
+				l268:
+					position, tokenIndex = position267, tokenIndex267
+					if buffer[position] != rune('O') {
+						goto l265
+					}
+					position++
+				}
+			l267:
+				{
+					position269, tokenIndex269 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l270
+					}
+					position++
+					goto l269
+				l270:
+					position, tokenIndex = position269, tokenIndex269
+					if buffer[position] != rune('R') {
+						goto l265
+					}
+					position++
+				}
+			l269:
+				{
+					position271, tokenIndex271 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l272
+					}
+					position++
+					goto l271
+				l272:
+					position, tokenIndex = position271, tokenIndex271
+					if buffer[position] != rune('D') {
+						goto l265
+					}
+					position++
+				}
+			l271:
+				{
+					position273, tokenIndex273 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l274
+					}
+					position++
+					goto l273
+				l274:
+					position, tokenIndex = position273, tokenIndex273
+					if buffer[position] != rune('E') {
+						goto l265
+					}
+					position++
+				}
+			l273:
+				{
+					position275, tokenIndex275 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l276
+					}
+					position++
+					goto l275
+				l276:
+					position, tokenIndex = position275, tokenIndex275
+					if buffer[position] != rune('R') {
+						goto l265
+					}
+					position++
+				}
+			l275:
+				if !_rules[rulesp]() {
+					goto l265
+				}
+				add(ruleorder, position266)
+			}
+			return true
+		l265:
+			position, tokenIndex = position265, tokenIndex265
+			return false
+		},
+		/* 29 by <- <(('b' / 'B') ('y' / 'Y') sp)> */
+		func() bool {
+			position277, tokenIndex277 := position, tokenIndex
+			{
+				position278 := position
+				{
+					position279, tokenIndex279 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l280
+					}
+					position++
+					goto l279
+				l280:
+					position, tokenIndex = position279, tokenIndex279
+					if buffer[position] != rune('B') {
+						goto l277
+					}
+					position++
+				}
+			l279:
+				{
+					position281, tokenIndex281 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l282
+					}
+					position++
+					goto l281
+				l282:
+					position, tokenIndex = position281, tokenIndex281
+					if buffer[position] != rune('Y') {
+						goto l277
+					}
+					position++
+				}
+			l281:
+				if !_rules[rulesp]() {
+					goto l277
+				}
+				add(ruleby, position278)
+			}
+			return true
+		l277:
+			position, tokenIndex = position277, tokenIndex277
+			return false
+		},
+		/* 30 asc <- <(('a' / 'A') ('s' / 'S') ('c' / 'C') sp)> */
+		func() bool {
+			position283, tokenIndex283 := position, tokenIndex
+			{
+				position284 := position
+				{
+					position285, tokenIndex285 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l286
+					}
+					position++
+					goto l285
+				l286:
+					position, tokenIndex = position285, tokenIndex285
+					if buffer[position] != rune('A') {
+						goto l283
+					}
+					position++
+				}
+			l285:
+				{
+					position287, tokenIndex287 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l288
+					}
+					position++
+					goto l287
+				l288:
+					position, tokenIndex = position287, tokenIndex287
+					if buffer[position] != rune('S') {
+						goto l283
+					}
+					position++
+				}
+			l287:
+				{
+					position289, tokenIndex289 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l290
+					}
+					position++
+					goto l289
+				l290:
+					position, tokenIndex = position289, tokenIndex289
+					if buffer[position] != rune('C') {
+						goto l283
+					}
+					position++
+				}
+			l289:
+				if !_rules[rulesp]() {
+					goto l283
+				}
+				add(ruleasc, position284)
+			}
+			return true
+		l283:
+			position, tokenIndex = position283, tokenIndex283
+			return false
+		},
+		/* 31 desc <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') sp)> */
+		func() bool {
+			position291, tokenIndex291 := position, tokenIndex
+			{
+				position292 := position
+				{
+					position293, tokenIndex293 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l294
+					}
+					position++
+					goto l293
+				l294:
+					position, tokenIndex = position293, tokenIndex293
+					if buffer[position] != rune('D') {
+						goto l291
+					}
+					position++
+				}
+			l293:
+				{
+					position295, tokenIndex295 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l296
+					}
+					position++
+					goto l295
+				l296:
+					position, tokenIndex = position295, tokenIndex295
+					if buffer[position] != rune('E') {
+						goto l291
+					}
+					position++
+				}
+			l295:
+				{
+					position297, tokenIndex297 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l298
+					}
+					position++
+					goto l297
+				l298:
+					position, tokenIndex = position297, tokenIndex297
+					if buffer[position] != rune('S') {
+						goto l291
+					}
+					position++
+				}
+			l297:
+				{
+					position299, tokenIndex299 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l300
+					}
+					position++
+					goto l299
+				l300:
+					position, tokenIndex = position299, tokenIndex299
+					if buffer[position] != rune('C') {
+						goto l291
+					}
+					position++
+				}
+			l299:
+				if !_rules[rulesp]() {
+					goto l291
+				}
+				add(ruledesc, position292)
+			}
+			return true
+		l291:
+			position, tokenIndex = position291, tokenIndex291
+			return false
+		},
+		/* 32 limit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp)> */
+		func() bool {
+			position301, tokenIndex301 := position, tokenIndex
+			{
+				position302 := position
+				{
+					position303, tokenIndex303 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l304
+					}
+					position++
+					goto l303
+				l304:
+					position, tokenIndex = position303, tokenIndex303
+					if buffer[position] != rune('L') {
+						goto l301
+					}
+					position++
+				}
+			l303:
+				{
+					position305, tokenIndex305 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l306
+					}
+					position++
+					goto l305
+				l306:
+					position, tokenIndex = position305, tokenIndex305
+					if buffer[position] != rune('I') {
+						goto l301
+					}
+					position++
+				}
+			l305:
+				{
+					position307, tokenIndex307 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l308
+					}
+					position++
+					goto l307
+				l308:
+					position, tokenIndex = position307, tokenIndex307
+					if buffer[position] != rune('M') {
+						goto l301
+					}
+					position++
+				}
+			l307:
+				{
+					position309, tokenIndex309 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l310
+					}
+					position++
+					goto l309
+				l310:
+					position, tokenIndex = position309, tokenIndex309
+					if buffer[position] != rune('I') {
+						goto l301
+					}
+					position++
+				}
+			l309:
+				{
+					position311, tokenIndex311 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l312
+					}
+					position++
+					goto l311
+				l312:
+					position, tokenIndex = position311, tokenIndex311
+					if buffer[position] != rune('T') {
+						goto l301
+					}
+					position++
+				}
+			l311:
+				if !_rules[rulesp]() {
+					goto l301
+				}
+				add(rulelimit, position302)
+			}
+			return true
+		l301:
+			position, tokenIndex = position301, tokenIndex301
+			return false
+		},
+		/* 33 comma <- <(',' sp)> */
+		func() bool {
+			position313, tokenIndex313 := position, tokenIndex
+			{
+				position314 := position
+				if buffer[position] != rune(',') {
+					goto l313
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l313
+				}
+				add(rulecomma, position314)
+			}
+			return true
+		l313:
+			position, tokenIndex = position313, tokenIndex313
+			return false
+		},
+		/* 34 open <- <('(' sp)> */
 		func() bool {
 			position173, tokenIndex173 := position, tokenIndex
 			{
@@ -1830,7 +2857,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position173, tokenIndex173
 			return false
 		},
-		/* 23 close <- <(')' sp)> */
+		/* 35 close <- <(')' sp)> */
 		func() bool {
 			position175, tokenIndex175 := position, tokenIndex
 			{
@@ -1849,7 +2876,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position175, tokenIndex175
 			return false
 		},
-		/* 24 sp <- <(' ' / '\t')*> */
+		/* 36 sp <- <(' ' / '\t')*> */
 		func() bool {
 			{
 				position178 := position
@@ -1879,98 +2906,147 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			}
 			return true
 		},
-		/* 26 Action0 <- <{ p.Operator(OpOr) }> */
+		/* 37 Action0 <- <{ p.Operator(OpOr) }> */
 		func() bool {
 			{
 				add(ruleAction0, position)
 			}
 			return true
 		},
-		/* 27 Action1 <- <{ p.Operator(OpAnd) }> */
+		/* 38 Action1 <- <{ p.Operator(OpAnd) }> */
 		func() bool {
 			{
 				add(ruleAction1, position)
 			}
 			return true
 		},
-		/* 28 Action2 <- <{ p.Operator(OpLessEqual) }> */
+		/* 39 Action2 <- <{ p.Operator(OpLessEqual) }> */
 		func() bool {
 			{
 				add(ruleAction2, position)
 			}
 			return true
 		},
-		/* 29 Action3 <- <{ p.Operator(OpGreaterEqual) }> */
+		/* 40 Action3 <- <{ p.Operator(OpGreaterEqual) }> */
 		func() bool {
 			{
 				add(ruleAction3, position)
 			}
 			return true
 		},
-		/* 30 Action4 <- <{ p.Operator(OpLess) }> */
+		/* 41 Action4 <- <{ p.Operator(OpLess) }> */
 		func() bool {
 			{
 				add(ruleAction4, position)
 			}
 			return true
 		},
-		/* 31 Action5 <- <{ p.Operator(OpGreater) }> */
+		/* 42 Action5 <- <{ p.Operator(OpGreater) }> */
 		func() bool {
 			{
 				add(ruleAction5, position)
 			}
 			return true
 		},
-		/* 32 Action6 <- <{ p.Operator(OpEqual) }> */
+		/* 43 Action6 <- <{ p.Operator(OpEqual) }> */
 		func() bool {
 			{
 				add(ruleAction6, position)
 			}
 			return true
 		},
-		/* 33 Action7 <- <{ p.Operator(OpContains) }> */
+		/* 44 Action7 <- <{ p.Operator(OpContains) }> */
 		func() bool {
 			{
 				add(ruleAction7, position)
 			}
 			return true
 		},
-		nil,
-		/* 35 Action8 <- <{ p.Tag(buffer[begin:end]) }> */
+		/* 45 Action8 <- <{ p.Operator(OpMatches) }> */
 		func() bool {
 			{
 				add(ruleAction8, position)
 			}
 			return true
 		},
-		/* 36 Action9 <- <{ p.Value(buffer[begin:end]) }> */
+		/* 46 Action9 <- <{ p.Operator(OpIn) }> */
 		func() bool {
 			{
 				add(ruleAction9, position)
 			}
 			return true
 		},
-		/* 37 Action10 <- <{ p.Number(buffer[begin:end]) }> */
+		/* 47 Action10 <- <{ p.ListStart() }> */
 		func() bool {
 			{
 				add(ruleAction10, position)
 			}
 			return true
 		},
-		/* 38 Action11 <- <{ p.Time(buffer[begin:end]) }> */
+		/* 48 Action11 <- <{ p.ListEnd() }> */
 		func() bool {
 			{
 				add(ruleAction11, position)
 			}
 			return true
 		},
-		/* 39 Action12 <- <{ p.Date(buffer[begin:end]) }> */
+		/* 49 Action12 <- <{ p.Descending() }> */
 		func() bool {
 			{
 				add(ruleAction12, position)
 			}
 			return true
 		},
+		nil,
+		/* 51 Action13 <- <{ p.Limit(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 52 Action14 <- <{ p.Tag(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 53 Action15 <- <{ p.OrderBy(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 54 Action16 <- <{ p.Value(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 55 Action17 <- <{ p.Number(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 56 Action18 <- <{ p.Time(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 57 Action19 <- <{ p.Date(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
 	}
 	p.rules = _rules
 	return nil
@@ -58,6 +58,16 @@ func TestMatches(t *testing.T) {
 
 		{"abci.owner.name CONTAINS 'Igor'", map[string]interface{}{"abci.owner.name": "Igor,Ivan"}, false, true},
 		{"abci.owner.name CONTAINS 'Igor'", map[string]interface{}{"abci.owner.name": "Pavel,Ivan"}, false, false},
+
+		{"EventType IN ('LogEvent', 'CallEvent')", map[string]interface{}{"EventType": "CallEvent"}, false, true},
+		{"EventType IN ('LogEvent', 'CallEvent')", map[string]interface{}{"EventType": "GovernAccount"}, false, false},
+		{"Height IN (3, 5) AND Index IN (0)", map[string]interface{}{"Height": uint64(5), "Index": 0}, false, true},
+		{"Height IN (3, 5)", map[string]interface{}{"Height": uint64(4)}, false, false},
+		{"tx.date IN (DATE 2016-01-01, DATE 2017-01-01)", map[string]interface{}{"tx.date": txDate}, false, true},
+		{"Address MATCHES '^AB[0-9]+$'", map[string]interface{}{"Address": "AB123"}, false, true},
+		{"Address MATCHES '^AB[0-9]+$'", map[string]interface{}{"Address": "CAB123"}, false, false},
+		{"Height > 3 ORDER BY Height DESC LIMIT 2", map[string]interface{}{"Height": uint64(4)}, false, true},
+		{"LIMIT 2", map[string]interface{}{"Height": uint64(4)}, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -82,6 +92,26 @@ func TestMatches(t *testing.T) {
 	}
 }
 
+func TestDirectives(t *testing.T) {
+	assert.Equal(t, Directives{}, DirectivesOf(MustParse("Height > 3")))
+	assert.Equal(t, Directives{}, DirectivesOf(Empty{}))
+	assert.Equal(t, Directives{OrderBy: "Height"}, DirectivesOf(MustParse("Height > 3 ORDER BY Height")))
+	assert.Equal(t, Directives{OrderBy: "Height", Descending: true, Limit: 10},
+		DirectivesOf(MustParse("Height > 3 ORDER BY Height DESC LIMIT 10")))
+	assert.Equal(t, Directives{Limit: 5}, DirectivesOf(MustParse("LIMIT 5")))
+}
+
+func TestEqualities(t *testing.T) {
+	assert.Nil(t, EqualitiesOf(Empty{}))
+	assert.Empty(t, EqualitiesOf(MustParse("LIMIT 5")))
+	assert.Equal(t, map[string]string{"EventType": "LogEvent"},
+		EqualitiesOf(MustParse("EventType = 'LogEvent' AND (Height < 10 OR Height > 20)")))
+	assert.Equal(t, map[string]string{"Address": "AB", "Log0": "CD", "Log1": "EF"},
+		EqualitiesOf(MustParse("Address = 'AB' AND (Log0 = 'CD' AND Log1 = 'EF') AND Height > 3")))
+	assert.Empty(t, EqualitiesOf(MustParse("Address = 'AB' OR Log0 = 'CD'")))
+	assert.Empty(t, EqualitiesOf(MustParse("Height = 3 AND Log0 CONTAINS 'CD' AND Log1 IN ('EF')")))
+}
+
 func TestMustParse(t *testing.T) {
 	assert.Panics(t, func() { MustParse("=") })
 	assert.NotPanics(t, func() { MustParse("tm.events.type='NewBlock'") })
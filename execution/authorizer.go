@@ -0,0 +1,119 @@
+package execution
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/chainparams"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// The gas available to an authorizer contract to validate a transaction input. Authorizers are run when a transaction
+// is checked for admission to the mempool, which the sender does not pay for, so this is enough for a signature
+// recovery (ecrecover) and little else.
+const AuthorizerGasLimit uint64 = 10000
+
+// The number of authorizations a checker remembers so that transactions rechecked while they wait in the mempool do not
+// run their authorizer contracts again
+const authorizationsCacheSize = 4096
+
+// authorize(address _account, bytes32 _digest, uint64 _amount, bytes _signature) returns (bool)
+type authorizeArgs struct {
+	Account   crypto.Address
+	Digest    [32]byte
+	Amount    uint64
+	Signature []byte
+}
+
+type authorizeRets struct {
+	Result bool
+}
+
+var authorizeSpec = abi.SpecFromStructReflect("authorize", reflect.TypeOf(authorizeArgs{}),
+	reflect.TypeOf(authorizeRets{}))
+
+// Delegates verification of the signatory for input to the authorizer contract designated by the input account, if it
+// has one. The authorizer runs against a discarded cache so it cannot modify state. A checker (when it has an
+// authorizations cache) remembers approvals by transaction digest, signature, and authorizer so that rechecks skip the
+// authorizer; an approval may therefore outlive a change to the authorizer's storage for mempool admission, but
+// delivery always runs the authorizer.
+func (exe *executor) authorize(input *payload.TxInput, sig *txs.Signatory, signBytes []byte) (bool, error) {
+	acc, err := exe.stateCache.GetAccount(input.Address)
+	if err != nil {
+		return false, err
+	}
+	if acc == nil || acc.Authorizer == nil {
+		return false, nil
+	}
+	authorizer, err := exe.stateCache.GetAccount(*acc.Authorizer)
+	if err != nil {
+		return false, err
+	}
+	if authorizer == nil || authorizer.ContractKind() != acm.EVMContract {
+		return false, errors.Errorf(errors.Codes.InvalidAddress, "authorizer %v of account %v is not an EVM contract",
+			*acc.Authorizer, input.Address)
+	}
+	args := authorizeArgs{
+		Account:   input.Address,
+		Amount:    input.Amount,
+		Signature: sig.Signature.Signature,
+	}
+	copy(args.Digest[:], crypto.Keccak256(signBytes))
+	var approval string
+	if exe.authorizations != nil {
+		approval = string(args.Digest[:]) + string(input.Address.Bytes()) + string(acc.Authorizer.Bytes()) +
+			string(args.Signature)
+		if exe.authorizations.Contains(approval) {
+			return true, nil
+		}
+	}
+	packed, err := abi.Pack(authorizeSpec.Inputs, args)
+	if err != nil {
+		return false, err
+	}
+	gas := AuthorizerGasLimit
+	params := engine.CallParams{
+		Origin: input.Address,
+		Caller: input.Address,
+		Callee: *acc.Authorizer,
+		Input:  append(authorizeSpec.FunctionID[:], packed...),
+		Gas:    &gas,
+	}
+	gasScheduleName, err := chainparams.GasSchedule(exe.paramsCache)
+	if err != nil {
+		return false, err
+	}
+	gasSchedule, err := evm.GasScheduleByName(gasScheduleName)
+	if err != nil {
+		return false, err
+	}
+	vm := evm.New(exe.vmOptions)
+	vm.SetGasSchedule(gasSchedule)
+	ret, err := vm.Execute(acmstate.NewCache(exe.stateCache), exe.blockchain,
+		exec.NewNoopEventSink(), params, authorizer.EVMCode)
+	if err != nil {
+		return false, fmt.Errorf("authorizer %v failed: %v", *acc.Authorizer, err)
+	}
+	rets := new(authorizeRets)
+	err = abi.Unpack(authorizeSpec.Outputs, ret, &rets.Result)
+	if err != nil {
+		return false, fmt.Errorf("could not decode return from authorizer %v: %v", *acc.Authorizer, err)
+	}
+	if !rets.Result {
+		return false, errors.Errorf(errors.Codes.PermissionDenied, "authorizer %v rejected transaction",
+			*acc.Authorizer)
+	}
+	if exe.authorizations != nil {
+		exe.authorizations.Add(approval, struct{}{})
+	}
+	return true, nil
+}
@@ -0,0 +1,211 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package breaker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+const EventType = "CircuitBreaker"
+
+// Queries for the events published when the circuit breaker trips or resets
+var Query = query.NewBuilder().AndEquals(event.EventTypeKey, EventType)
+
+// Provides the number of transactions currently in the mempool
+type Mempool interface {
+	Size() int
+}
+
+// CircuitBreaker watches for signs of anomalous load on the executor and, when tripped, restricts the transactions
+// admitted to the mempool. It only ever acts on CheckTx so that the restrictions, which depend on local observations,
+// can never make block execution non-deterministic across nodes.
+type CircuitBreaker struct {
+	sync.Mutex
+	config           *Config
+	maxBlockDuration time.Duration
+	rejectPayloads   map[payload.Type]struct{}
+	mempool          Mempool
+	lastMempoolSize  int
+	status           Status
+	emitter          *event.Emitter
+	logger           *logging.Logger
+}
+
+func New(config *Config, emitter *event.Emitter, logger *logging.Logger) (*CircuitBreaker, error) {
+	maxBlockDuration, err := config.maxBlockDuration()
+	if err != nil {
+		return nil, err
+	}
+	rejectPayloads, err := config.rejectPayloads()
+	if err != nil {
+		return nil, err
+	}
+	return &CircuitBreaker{
+		config:           config,
+		maxBlockDuration: maxBlockDuration,
+		rejectPayloads:   rejectPayloads,
+		emitter:          emitter,
+		logger:           logger.WithScope("CircuitBreaker"),
+	}, nil
+}
+
+// Provide the mempool whose growth is used as a signal (this is only available once the consensus engine is loaded)
+func (cb *CircuitBreaker) SetMempool(mempool Mempool) {
+	cb.Lock()
+	defer cb.Unlock()
+	cb.mempool = mempool
+	cb.lastMempoolSize = mempool.Size()
+}
+
+// Returns an error if a transaction with this payload should not currently be admitted to the mempool, a fee exempt
+// transaction (one in the FeeExemptCalls chain parameter) is not subject to the minimum fee
+func (cb *CircuitBreaker) Admit(p payload.Payload, feeExempt bool) error {
+	cb.Lock()
+	defer cb.Unlock()
+	if !cb.status.Tripped {
+		return nil
+	}
+	if _, ok := cb.rejectPayloads[p.Type()]; ok {
+		return errors.Errorf(errors.Codes.CircuitBreakerTripped, "%v payloads are not being accepted (%s)",
+			p.Type(), cb.status.Reason)
+	}
+	if fp, ok := p.(interface{ GetFee() uint64 }); ok && !feeExempt && fp.GetFee() < cb.config.MinFee {
+		return errors.Errorf(errors.Codes.CircuitBreakerTripped, "fee of %d is below the minimum fee of %d (%s)",
+			fp.GetFee(), cb.config.MinFee, cb.status.Reason)
+	}
+	return nil
+}
+
+// Record the execution of a block, which took duration to execute and commit, tripping or resetting the breaker as
+// indicated by the signals
+func (cb *CircuitBreaker) RecordBlock(be *exec.BlockExecution, duration time.Duration) {
+	cb.Lock()
+	defer cb.Unlock()
+	reason := cb.anomaly(be, duration)
+	if cb.status.Override != Override_AUTO {
+		return
+	}
+	if reason != "" {
+		cb.status.HealthyBlocks = 0
+		if !cb.status.Tripped {
+			cb.trip(be.Height, reason)
+		}
+		return
+	}
+	if cb.status.Tripped {
+		cb.status.HealthyBlocks++
+		if cb.status.HealthyBlocks >= cb.config.CooldownBlocks {
+			cb.reset(be.Height)
+		}
+	}
+}
+
+// Override the automatic behaviour of the breaker - Override_AUTO hands control back to the signals
+func (cb *CircuitBreaker) Override(override Override, height uint64) *Status {
+	cb.Lock()
+	defer cb.Unlock()
+	cb.status.Override = override
+	switch override {
+	case Override_FORCE_TRIPPED:
+		if !cb.status.Tripped {
+			cb.trip(height, "tripped by operator")
+		} else {
+			cb.notify(height)
+		}
+	case Override_FORCE_RESET:
+		if cb.status.Tripped {
+			cb.reset(height)
+		} else {
+			cb.notify(height)
+		}
+	default:
+		cb.notify(height)
+	}
+	status := cb.status
+	return &status
+}
+
+func (cb *CircuitBreaker) Status() *Status {
+	cb.Lock()
+	defer cb.Unlock()
+	status := cb.status
+	return &status
+}
+
+// Returns a description of the first anomalous signal observed for this block or the empty string if none
+func (cb *CircuitBreaker) anomaly(be *exec.BlockExecution, duration time.Duration) string {
+	if cb.mempool != nil {
+		size := cb.mempool.Size()
+		growth := size - cb.lastMempoolSize
+		cb.lastMempoolSize = size
+		if cb.config.MaxMempoolGrowth > 0 && growth > 0 && uint64(growth) > cb.config.MaxMempoolGrowth {
+			return fmt.Sprintf("mempool grew by %d transactions in block %d, more than the maximum of %d",
+				growth, be.Height, cb.config.MaxMempoolGrowth)
+		}
+	}
+	if cb.maxBlockDuration > 0 && duration > cb.maxBlockDuration {
+		return fmt.Sprintf("block %d took %v to execute, more than the maximum of %v",
+			be.Height, duration, cb.maxBlockDuration)
+	}
+	txs := uint64(len(be.TxExecutions))
+	if cb.config.MaxErrorRate > 0 && txs > 0 && txs >= cb.config.MinTxsForErrorRate {
+		var failed uint64
+		for _, txe := range be.TxExecutions {
+			if txe.Exception != nil {
+				failed++
+			}
+		}
+		errorRate := float64(failed) / float64(txs)
+		if errorRate > cb.config.MaxErrorRate {
+			return fmt.Sprintf("%d of %d transactions in block %d failed, more than the maximum error rate of %v",
+				failed, txs, be.Height, cb.config.MaxErrorRate)
+		}
+	}
+	return ""
+}
+
+func (cb *CircuitBreaker) trip(height uint64, reason string) {
+	cb.status.Tripped = true
+	cb.status.Reason = reason
+	cb.status.TrippedAtHeight = height
+	cb.status.HealthyBlocks = 0
+	cb.logger.InfoMsg("Circuit breaker tripped", "height", height, "reason", reason)
+	cb.notify(height)
+}
+
+func (cb *CircuitBreaker) reset(height uint64) {
+	cb.status = Status{Override: cb.status.Override}
+	cb.logger.InfoMsg("Circuit breaker reset", "height", height)
+	cb.notify(height)
+}
+
+func (cb *CircuitBreaker) notify(height uint64) {
+	if cb.emitter == nil {
+		return
+	}
+	ev := &Event{
+		Height:   height,
+		Tripped:  cb.status.Tripped,
+		Reason:   cb.status.Reason,
+		Override: cb.status.Override,
+	}
+	err := cb.emitter.Publish(context.Background(), ev, query.TagMap{
+		event.EventTypeKey: EventType,
+		event.HeightKey:    height,
+	})
+	if err != nil {
+		cb.logger.InfoMsg("Error publishing circuit breaker event", "height", height, structure.ErrorKey, err)
+	}
+}
@@ -0,0 +1,826 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: breaker.proto
+
+package breaker
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An operator override of the automatic behaviour of the circuit breaker
+type Override int32
+
+const (
+	// Trip and reset according to the configured signals
+	Override_AUTO Override = 0
+	// Hold the breaker tripped regardless of the signals
+	Override_FORCE_TRIPPED Override = 1
+	// Hold the breaker reset regardless of the signals
+	Override_FORCE_RESET Override = 2
+)
+
+var Override_name = map[int32]string{
+	0: "AUTO",
+	1: "FORCE_TRIPPED",
+	2: "FORCE_RESET",
+}
+
+var Override_value = map[string]int32{
+	"AUTO":          0,
+	"FORCE_TRIPPED": 1,
+	"FORCE_RESET":   2,
+}
+
+func (x Override) String() string {
+	return proto.EnumName(Override_name, int32(x))
+}
+
+func (Override) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a2f2304e00e2aaf, []int{0}
+}
+
+type Status struct {
+	// Whether mempool admission restrictions are in force
+	Tripped bool `protobuf:"varint,1,opt,name=Tripped,proto3" json:"Tripped,omitempty"`
+	// The signal that caused the breaker to trip
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// The height of the block after which the breaker tripped
+	TrippedAtHeight uint64 `protobuf:"varint,3,opt,name=TrippedAtHeight,proto3" json:"TrippedAtHeight,omitempty"`
+	// The number of consecutive healthy blocks observed since tripping
+	HealthyBlocks        uint64   `protobuf:"varint,4,opt,name=HealthyBlocks,proto3" json:"HealthyBlocks,omitempty"`
+	Override             Override `protobuf:"varint,5,opt,name=Override,proto3,enum=breaker.Override" json:"Override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Status) Reset()         { *m = Status{} }
+func (m *Status) String() string { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()    {}
+func (*Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a2f2304e00e2aaf, []int{0}
+}
+func (m *Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status.Merge(m, src)
+}
+func (m *Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Status proto.InternalMessageInfo
+
+func (m *Status) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func (m *Status) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Status) GetTrippedAtHeight() uint64 {
+	if m != nil {
+		return m.TrippedAtHeight
+	}
+	return 0
+}
+
+func (m *Status) GetHealthyBlocks() uint64 {
+	if m != nil {
+		return m.HealthyBlocks
+	}
+	return 0
+}
+
+func (m *Status) GetOverride() Override {
+	if m != nil {
+		return m.Override
+	}
+	return Override_AUTO
+}
+
+func (*Status) XXX_MessageName() string {
+	return "breaker.Status"
+}
+
+// Published whenever the circuit breaker trips or resets
+type Event struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Tripped              bool     `protobuf:"varint,2,opt,name=Tripped,proto3" json:"Tripped,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Override             Override `protobuf:"varint,4,opt,name=Override,proto3,enum=breaker.Override" json:"Override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a2f2304e00e2aaf, []int{1}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Event) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func (m *Event) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Event) GetOverride() Override {
+	if m != nil {
+		return m.Override
+	}
+	return Override_AUTO
+}
+
+func (*Event) XXX_MessageName() string {
+	return "breaker.Event"
+}
+func init() {
+	proto.RegisterEnum("breaker.Override", Override_name, Override_value)
+	golang_proto.RegisterEnum("breaker.Override", Override_name, Override_value)
+	proto.RegisterType((*Status)(nil), "breaker.Status")
+	golang_proto.RegisterType((*Status)(nil), "breaker.Status")
+	proto.RegisterType((*Event)(nil), "breaker.Event")
+	golang_proto.RegisterType((*Event)(nil), "breaker.Event")
+}
+
+func init() { proto.RegisterFile("breaker.proto", fileDescriptor_5a2f2304e00e2aaf) }
+func init() { golang_proto.RegisterFile("breaker.proto", fileDescriptor_5a2f2304e00e2aaf) }
+
+var fileDescriptor_5a2f2304e00e2aaf = []byte{
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x4f, 0xea, 0x40,
+	0x14, 0xc5, 0xdf, 0x85, 0xf2, 0xe7, 0xcd, 0x0b, 0x0f, 0x98, 0x85, 0x69, 0x5c, 0x34, 0x0d, 0x71,
+	0xd1, 0x98, 0x40, 0x13, 0xdd, 0xb8, 0x05, 0xad, 0x81, 0x15, 0x64, 0xa8, 0x1b, 0x37, 0xa6, 0x85,
+	0x6b, 0xdb, 0x80, 0x4c, 0x33, 0x4c, 0x51, 0x76, 0x7e, 0x2d, 0x77, 0x2e, 0x59, 0xba, 0x74, 0x69,
+	0xca, 0x17, 0x31, 0x0e, 0x45, 0xc1, 0xc4, 0xb8, 0x9b, 0xdf, 0xb9, 0x67, 0x66, 0xce, 0xc9, 0x25,
+	0x15, 0x5f, 0xa0, 0x37, 0x41, 0xd1, 0x8a, 0x05, 0x97, 0x9c, 0x96, 0x32, 0x3c, 0x6c, 0x06, 0x91,
+	0x0c, 0x13, 0xbf, 0x35, 0xe2, 0x77, 0x76, 0xc0, 0x03, 0x6e, 0xab, 0xb9, 0x9f, 0xdc, 0x2a, 0x52,
+	0xa0, 0x4e, 0x9b, 0x7b, 0x8d, 0x27, 0x20, 0xc5, 0xa1, 0xf4, 0x64, 0x32, 0xa7, 0x3a, 0x29, 0xb9,
+	0x22, 0x8a, 0x63, 0x1c, 0xeb, 0x60, 0x82, 0x55, 0x66, 0x5b, 0xa4, 0x07, 0xa4, 0xc8, 0xd0, 0x9b,
+	0xf3, 0x99, 0x9e, 0x33, 0xc1, 0xfa, 0xcb, 0x32, 0xa2, 0x16, 0xa9, 0x66, 0x96, 0xb6, 0xec, 0x62,
+	0x14, 0x84, 0x52, 0xcf, 0x9b, 0x60, 0x69, 0xec, 0xbb, 0x4c, 0x8f, 0x48, 0xa5, 0x8b, 0xde, 0x54,
+	0x86, 0xcb, 0xce, 0x94, 0x8f, 0x26, 0x73, 0x5d, 0x53, 0xbe, 0x7d, 0x91, 0x36, 0x49, 0xb9, 0xbf,
+	0x40, 0x21, 0xa2, 0x31, 0xea, 0x05, 0x13, 0xac, 0xff, 0x27, 0xf5, 0xd6, 0xb6, 0xe6, 0x76, 0xc0,
+	0x3e, 0x2d, 0x8d, 0x47, 0x20, 0x05, 0x67, 0x81, 0x33, 0xf9, 0x11, 0x30, 0xfb, 0x1f, 0xd4, 0xbb,
+	0x19, 0xed, 0x56, 0xca, 0xfd, 0x54, 0x29, 0xbf, 0x57, 0x69, 0x37, 0x82, 0xf6, 0x6b, 0x84, 0xe3,
+	0xb3, 0x2f, 0x3b, 0x2d, 0x13, 0xad, 0x7d, 0xe5, 0xf6, 0x6b, 0x7f, 0x68, 0x9d, 0x54, 0x2e, 0xfb,
+	0xec, 0xdc, 0xb9, 0x71, 0x59, 0x6f, 0x30, 0x70, 0x2e, 0x6a, 0x40, 0xab, 0xe4, 0xdf, 0x46, 0x62,
+	0xce, 0xd0, 0x71, 0x6b, 0xb9, 0x4e, 0x6f, 0x95, 0x1a, 0xf0, 0x92, 0x1a, 0xf0, 0x9a, 0x1a, 0xf0,
+	0x96, 0x1a, 0xf0, 0xbc, 0x36, 0x60, 0xb5, 0x36, 0xe0, 0xda, 0xde, 0xd9, 0x60, 0xb8, 0x8c, 0x51,
+	0x4c, 0x71, 0x1c, 0xa0, 0xb0, 0xfd, 0x44, 0x08, 0x7e, 0x6f, 0xe3, 0x03, 0x8e, 0x12, 0x19, 0xf1,
+	0x99, 0x9d, 0xe5, 0xf2, 0x8b, 0x6a, 0x95, 0xa7, 0xef, 0x03, 0x00, 0x8f, 0x78, 0x09, 0xe6, 0x13,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Override != 0 {
+		i = encodeVarintBreaker(dAtA, i, uint64(m.Override))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HealthyBlocks != 0 {
+		i = encodeVarintBreaker(dAtA, i, uint64(m.HealthyBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TrippedAtHeight != 0 {
+		i = encodeVarintBreaker(dAtA, i, uint64(m.TrippedAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBreaker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Override != 0 {
+		i = encodeVarintBreaker(dAtA, i, uint64(m.Override))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBreaker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintBreaker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tripped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBreaker(uint64(l))
+	}
+	if m.TrippedAtHeight != 0 {
+		n += 1 + sovBreaker(uint64(m.TrippedAtHeight))
+	}
+	if m.HealthyBlocks != 0 {
+		n += 1 + sovBreaker(uint64(m.HealthyBlocks))
+	}
+	if m.Override != 0 {
+		n += 1 + sovBreaker(uint64(m.Override))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBreaker(uint64(m.Height))
+	}
+	if m.Tripped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBreaker(uint64(l))
+	}
+	if m.Override != 0 {
+		n += 1 + sovBreaker(uint64(m.Override))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBreaker(x uint64) (n int) {
+	return sovBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedAtHeight", wireType)
+			}
+			m.TrippedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyBlocks", wireType)
+			}
+			m.HealthyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			m.Override = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Override |= Override(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			m.Override = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Override |= Override(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBreaker = fmt.Errorf("proto: unexpected end of group")
+)
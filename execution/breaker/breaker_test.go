@@ -0,0 +1,104 @@
+package breaker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mempoolSize int
+
+func (ms *mempoolSize) Size() int {
+	return int(*ms)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	emitter := event.NewEmitter()
+	events, err := emitter.Subscribe(context.Background(), "test", Query, 10)
+	require.NoError(t, err)
+
+	cb, err := New(&Config{
+		MaxBlockDuration:   "1s",
+		MaxMempoolGrowth:   100,
+		MaxErrorRate:       0.5,
+		MinTxsForErrorRate: 2,
+		MinFee:             10,
+		RejectPayloads:     []string{"NameTx"},
+		CooldownBlocks:     2,
+	}, emitter, logging.NewNoopLogger())
+	require.NoError(t, err)
+	mempool := mempoolSize(0)
+	cb.SetMempool(&mempool)
+
+	callTx := &payload.CallTx{Fee: 1}
+	nameTx := &payload.NameTx{Fee: 100}
+	require.NoError(t, cb.Admit(callTx, false))
+	require.NoError(t, cb.Admit(nameTx, false))
+
+	// Healthy
+	mempool = 50
+	cb.RecordBlock(&exec.BlockExecution{Height: 1}, time.Millisecond)
+	assert.False(t, cb.Status().Tripped)
+
+	// Mempool growth
+	mempool = 200
+	cb.RecordBlock(&exec.BlockExecution{Height: 2}, time.Millisecond)
+	status := cb.Status()
+	assert.True(t, status.Tripped)
+	assert.Equal(t, uint64(2), status.TrippedAtHeight)
+	ev := (<-events).(*Event)
+	assert.True(t, ev.Tripped)
+	assert.Equal(t, uint64(2), ev.Height)
+
+	assert.Equal(t, errors.Codes.CircuitBreakerTripped, errors.GetCode(cb.Admit(callTx, false)))
+	assert.Equal(t, errors.Codes.CircuitBreakerTripped, errors.GetCode(cb.Admit(nameTx, false)))
+	require.NoError(t, cb.Admit(&payload.CallTx{Fee: 10}, false))
+	// Fee exempt calls are not subject to the minimum fee
+	require.NoError(t, cb.Admit(callTx, true))
+
+	// An error rate signal keeps us tripped
+	cb.RecordBlock(&exec.BlockExecution{Height: 3}, time.Millisecond)
+	cb.RecordBlock(&exec.BlockExecution{Height: 4, TxExecutions: []*exec.TxExecution{
+		{Exception: errors.Errorf(errors.Codes.Generic, "failed")},
+		{Exception: errors.Errorf(errors.Codes.Generic, "failed")},
+	}}, time.Millisecond)
+	assert.True(t, cb.Status().Tripped)
+	assert.Equal(t, uint64(0), cb.Status().HealthyBlocks)
+
+	// Cooldown
+	cb.RecordBlock(&exec.BlockExecution{Height: 5}, time.Millisecond)
+	assert.True(t, cb.Status().Tripped)
+	cb.RecordBlock(&exec.BlockExecution{Height: 6}, time.Millisecond)
+	assert.False(t, cb.Status().Tripped)
+	ev = (<-events).(*Event)
+	assert.False(t, ev.Tripped)
+	assert.Equal(t, uint64(6), ev.Height)
+
+	// Operator override holds the breaker regardless of signals
+	status = cb.Override(Override_FORCE_TRIPPED, 6)
+	assert.True(t, status.Tripped)
+	<-events
+	cb.RecordBlock(&exec.BlockExecution{Height: 7}, time.Millisecond)
+	cb.RecordBlock(&exec.BlockExecution{Height: 8}, time.Millisecond)
+	assert.True(t, cb.Status().Tripped)
+
+	status = cb.Override(Override_FORCE_RESET, 8)
+	assert.False(t, status.Tripped)
+	<-events
+	cb.RecordBlock(&exec.BlockExecution{Height: 9}, 2*time.Second)
+	assert.False(t, cb.Status().Tripped)
+
+	// Back to automatic
+	cb.Override(Override_AUTO, 9)
+	<-events
+	cb.RecordBlock(&exec.BlockExecution{Height: 10}, 2*time.Second)
+	assert.True(t, cb.Status().Tripped)
+}
@@ -0,0 +1,59 @@
+package breaker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Configures the signals on which the circuit breaker trips and the mempool admission restrictions in force while it is
+// tripped. Zero values disable the corresponding signal or restriction.
+type Config struct {
+	// Trip when executing and committing a block takes longer than this duration (e.g. 2s)
+	MaxBlockDuration string `json:",omitempty" toml:",omitempty"`
+	// Trip when the mempool grows by more than this many transactions between consecutive blocks
+	MaxMempoolGrowth uint64 `json:",omitempty" toml:",omitempty"`
+	// Trip when the fraction of transactions in a block that fail exceeds this (between 0 and 1)
+	MaxErrorRate float64 `json:",omitempty" toml:",omitempty"`
+	// The minimum number of transactions a block must contain for its error rate to be considered
+	MinTxsForErrorRate uint64 `json:",omitempty" toml:",omitempty"`
+	// While tripped reject fee-bearing transactions (CallTx, NameTx) offering a fee less than this
+	MinFee uint64 `json:",omitempty" toml:",omitempty"`
+	// While tripped reject these payload types (e.g. CallTx) from the mempool
+	RejectPayloads []string `json:",omitempty" toml:",omitempty"`
+	// Reset a tripped breaker after this many consecutive healthy blocks (zero means a single healthy block)
+	CooldownBlocks uint64 `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		MaxBlockDuration:   "5s",
+		MaxErrorRate:       0.5,
+		MinTxsForErrorRate: 10,
+		CooldownBlocks:     10,
+	}
+}
+
+func (conf *Config) maxBlockDuration() (time.Duration, error) {
+	if conf.MaxBlockDuration == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(conf.MaxBlockDuration)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse MaxBlockDuration '%s' as duration: %v", conf.MaxBlockDuration, err)
+	}
+	return duration, nil
+}
+
+func (conf *Config) rejectPayloads() (map[payload.Type]struct{}, error) {
+	rejected := make(map[payload.Type]struct{}, len(conf.RejectPayloads))
+	for _, name := range conf.RejectPayloads {
+		ty := payload.TxTypeFromString(name)
+		if ty == payload.TypeUnknown {
+			return nil, fmt.Errorf("payload type '%s' in RejectPayloads not recognised", name)
+		}
+		rejected[ty] = struct{}{}
+	}
+	return rejected, nil
+}
@@ -0,0 +1,58 @@
+package chainparams
+
+import (
+	"sync"
+
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Cache holds any update to the chain parameters made during a block so that it can be written to state on commit
+type Cache struct {
+	sync.RWMutex
+	backend     Reader
+	chainParams *payload.ChainParams
+}
+
+var _ ReaderWriter = &Cache{}
+
+// Returns a Cache that can write to an output Writer via Sync
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend: backend,
+	}
+}
+
+func (cache *Cache) GetChainParams() (*payload.ChainParams, error) {
+	cache.RLock()
+	defer cache.RUnlock()
+	if cache.chainParams != nil {
+		return cache.chainParams, nil
+	}
+	return cache.backend.GetChainParams()
+}
+
+func (cache *Cache) UpdateChainParams(chainParams *payload.ChainParams) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.chainParams = chainParams
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.chainParams == nil {
+		return nil
+	}
+	return state.UpdateChainParams(cache.chainParams)
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.chainParams = nil
+}
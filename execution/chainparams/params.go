@@ -0,0 +1,134 @@
+package chainparams
+
+import (
+	"bytes"
+
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type Reader interface {
+	// Returns the current chain parameters or nil if none have been set
+	GetChainParams() (*payload.ChainParams, error)
+}
+
+type Writer interface {
+	// Replaces the chain parameters
+	UpdateChainParams(chainParams *payload.ChainParams) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+// Returns the block gas limit or zero when unlimited
+func BlockGasLimit(reader Reader) (uint64, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return 0, err
+	}
+	return chainParams.BlockGasLimit, nil
+}
+
+// Returns the maximum number of instructions a transaction may execute or zero when unlimited
+func MaxTxInstructions(reader Reader) (uint64, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return 0, err
+	}
+	return chainParams.MaxTxInstructions, nil
+}
+
+// Returns the maximum data size of a log event and the maximum number of log events per transaction that apply to
+// contracts without the Emit permission, where zero means unlimited
+func LogLimits(reader Reader) (maxDataSize uint64, maxTxLogs uint64, err error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return 0, 0, err
+	}
+	return chainParams.MaxLogDataSize, chainParams.MaxTxLogs, nil
+}
+
+// Returns the largest change to validator power, as a percentage of total power, that may be made within a block
+// without delay (zero means unlimited) and the number of blocks by which larger changes are delayed
+func ValidatorPowerChangeLimits(reader Reader) (maxChange uint64, delay uint64, err error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return 0, 0, err
+	}
+	return chainParams.MaxValidatorPowerChange, chainParams.ValidatorPowerChangeDelay, nil
+}
+
+// Returns the minimum fee a transaction must pay unless it is fee exempt (zero when there is no minimum)
+func MinFee(reader Reader) (uint64, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return 0, err
+	}
+	return chainParams.MinFee, nil
+}
+
+// Returns the name of the EVM gas schedule, empty for the default
+func GasSchedule(reader Reader) (string, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return "", err
+	}
+	return chainParams.GasSchedule, nil
+}
+
+// Returns true if fees are paid from accounts' gas token balances rather than from the native token
+func SeparateGasToken(reader Reader) (bool, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return false, err
+	}
+	return chainParams.SeparateGasToken, nil
+}
+
+// Returns true if contracts' calls and creates forward at most all but one 64th of their remaining gas (EIP-150)
+func CapCallGas(reader Reader) (bool, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return false, err
+	}
+	return chainParams.CapCallGas, nil
+}
+
+// Returns the base permissions to give an account created by a SendTx, by a CallTx deploying a contract, or by a
+// contract, which grant NewAccountPermissions and explicitly unset any permission outside MaxNewAccountPermissions
+func NewAccountPermissions(reader Reader) (permission.BasePermissions, error) {
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return permission.ZeroBasePermissions, err
+	}
+	perms := permission.BasePermissions{
+		Perms:  chainParams.NewAccountPermissions,
+		SetBit: chainParams.NewAccountPermissions,
+	}
+	if max := chainParams.MaxNewAccountPermissions; max != 0 {
+		perms.Perms &= max
+		perms.SetBit |= permission.AllPermFlags &^ max
+	}
+	return perms, nil
+}
+
+// Returns true if the payload is a call that matches one of the FeeExemptCalls and so need not pay minimum fees
+func FeeExempt(reader Reader, p payload.Payload) (bool, error) {
+	tx, ok := p.(*payload.CallTx)
+	if !ok || tx.Address == nil || tx.Input == nil {
+		return false, nil
+	}
+	chainParams, err := reader.GetChainParams()
+	if err != nil || chainParams == nil {
+		return false, err
+	}
+	for _, exempt := range chainParams.FeeExemptCalls {
+		if exempt.Caller == tx.Input.Address && exempt.Callee == *tx.Address &&
+			bytes.HasPrefix(tx.Data, exempt.Selector) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
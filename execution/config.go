@@ -2,8 +2,16 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/breaker"
+	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/execution/private"
+	"github.com/hyperledger/burrow/execution/redact"
+	"github.com/hyperledger/burrow/genesis"
 )
 
 type VMOption string
@@ -23,6 +31,26 @@ type ExecutionConfig struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	VMOptions                []VMOption `json:",omitempty" toml:",omitempty"`
+	// Restricts mempool admission under anomalous load, disabled when absent
+	CircuitBreaker *breaker.Config `json:",omitempty" toml:",omitempty"`
+	// Enables private transactions whose encrypted payloads are executed against a per-node private state
+	Private *private.Config `json:",omitempty" toml:",omitempty"`
+	// The number of most recently active accounts (with their code) to preload into caches on startup, zero disables
+	WarmupAccounts int `json:",omitempty" toml:",omitempty"`
+	// The maximum number of storage entries to preload for each warmed up account
+	WarmupStorageKeys int `json:",omitempty" toml:",omitempty"`
+	// The number of transactions admitted to the mempool that may wait to have the state they touch read into caches
+	// ahead of block execution, zero disables prefetching
+	PrefetchQueueSize int `json:",omitempty" toml:",omitempty"`
+	// The policy by which transactions are ordered when Burrow assembles blocks, one of 'fifo' (the default), 'fee'
+	// (highest fee first), or 'round-robin' (senders in turn). Only supported in no-consensus mode since Tendermint
+	// proposers include transactions in the order they entered the mempool.
+	OrderingPolicy string `json:",omitempty" toml:",omitempty"`
+	// Hashes or drops fields of the events served by this node (over RPC or to subscribers), disabled when absent
+	Redaction *redact.Config `json:",omitempty" toml:",omitempty"`
+	// How often (e.g. 1m) this node broadcasts a HeartbeatTx signed by its validator key attesting that it is live,
+	// disabled when empty
+	HeartbeatInterval string `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -31,6 +59,9 @@ func DefaultExecutionConfig() *ExecutionConfig {
 		DataStackInitialCapacity: evm.DataStackInitialCapacity,
 		DataStackMaxDepth:        0, // Unlimited by default
 		TimeoutFactor:            0.33,
+		WarmupAccounts:           256,
+		WarmupStorageKeys:        64,
+		PrefetchQueueSize:        256,
 	}
 }
 
@@ -42,8 +73,61 @@ func VMOptions(vmOptions evm.Options) func(*executor) {
 	}
 }
 
+// Checks transactions admitted to the mempool against, and reports executed blocks to, the circuit breaker
+func CircuitBreaker(circuitBreaker *breaker.CircuitBreaker) func(*executor) {
+	return func(exe *executor) {
+		exe.circuitBreaker = circuitBreaker
+	}
+}
+
+// Queues transactions admitted to the mempool to have the state they touch prefetched (only applies to a checker)
+func Prefetch(prefetcher *Prefetcher) func(*executor) {
+	return func(exe *executor) {
+		exe.prefetcher = prefetcher
+	}
+}
+
+// Executes the private payloads of delivered PrivateTxs with private
+func Private(private contexts.PrivateExecutor) func(*executor) {
+	return func(exe *executor) {
+		exe.private = private
+	}
+}
+
+// Redacts the events published to subscribers
+func Redact(redactor *redact.Redactor) func(*executor) {
+	return func(exe *executor) {
+		exe.redactor = redactor
+	}
+}
+
+// Counts the contract storage reads made by executed transactions and those loaded from state storage (must follow any
+// VMOptions)
+func CountStorageReads(reads *acmstate.StorageReads) func(*executor) {
+	return func(exe *executor) {
+		exe.vmOptions.StorageReads = reads
+		acmstate.CountStorageLoads(reads)(exe.stateCache)
+	}
+}
+
+// Use natives in place of the default native contracts and precompiles (must follow any VMOptions)
+func Natives(natives *native.Natives) func(*executor) {
+	return func(exe *executor) {
+		if natives != nil {
+			exe.vmOptions.Natives = natives
+		}
+	}
+}
+
 func (ec *ExecutionConfig) ExecutionOptions() ([]Option, error) {
-	var exeOptions []Option
+	vmOptions, err := ec.EVMOptions()
+	if err != nil {
+		return nil, err
+	}
+	return []Option{VMOptions(vmOptions)}, nil
+}
+
+func (ec *ExecutionConfig) EVMOptions() (evm.Options, error) {
 	vmOptions := evm.Options{
 		MemoryProvider:           evm.DefaultDynamicMemoryProvider,
 		CallStackMaxDepth:        ec.CallStackMaxDepth,
@@ -57,9 +141,39 @@ func (ec *ExecutionConfig) ExecutionOptions() ([]Option, error) {
 		case DumpTokens:
 			vmOptions.DumpTokens = true
 		default:
-			return nil, fmt.Errorf("VM option '%s' not recognised", option)
+			return evm.Options{}, fmt.Errorf("VM option '%s' not recognised", option)
 		}
 	}
-	exeOptions = append(exeOptions, VMOptions(vmOptions))
-	return exeOptions, nil
+	return vmOptions, nil
+}
+
+// Returns natives (or the default natives if nil) with any precompiles relocated according to the PrecompileAddresses
+// of genesisDoc
+func NativesFromGenesis(natives *native.Natives, genesisDoc *genesis.GenesisDoc) (*native.Natives, error) {
+	if len(genesisDoc.Params.PrecompileAddresses) == 0 {
+		return natives, nil
+	}
+	if natives == nil {
+		var err error
+		natives, err = native.DefaultNatives()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return natives.Relocate(genesisDoc.Params.PrecompileAddresses)
+}
+
+// Returns the interval at which to send heartbeats, zero meaning never
+func (ec *ExecutionConfig) Heartbeat() (time.Duration, error) {
+	if ec.HeartbeatInterval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(ec.HeartbeatInterval)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse HeartbeatInterval '%s' as duration: %v", ec.HeartbeatInterval, err)
+	}
+	if interval < 0 {
+		return 0, fmt.Errorf("HeartbeatInterval must not be negative but is %v", interval)
+	}
+	return interval, nil
 }
@@ -6,24 +6,36 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/chainparams"
+	"github.com/hyperledger/burrow/execution/cron"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/escrow"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/wasm"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
 // TODO: make configurable
 const GasLimit = uint64(1000000)
 
+// Warn when execution uses at least this percentage of the gas limit
+const NearGasLimitPercent = 90
+
 type CallContext struct {
 	EVM           *evm.EVM
 	State         acmstate.ReaderWriter
 	MetadataState acmstate.MetadataReaderWriter
+	NameReg       names.ReaderWriter
+	Cron          cron.ReaderWriter
+	Escrows       escrow.ReaderWriter
+	Params        chainparams.Reader
 	Blockchain    engine.Blockchain
 	RunCall       bool
 	Logger        *logging.Logger
@@ -42,8 +54,10 @@ func (ctx *CallContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	if err != nil {
 		return err
 	}
-	// That the fee less than the input amount is checked by Precheck to be greater than or equal to fee
-	value := ctx.tx.Input.Amount - ctx.tx.Fee
+	value, err := valueAfterFee(ctx.Params, ctx.tx.Input, ctx.tx.Fee)
+	if err != nil {
+		return err
+	}
 
 	if ctx.RunCall {
 		return ctx.Deliver(inAcc, outAcc, value)
@@ -63,17 +77,10 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 			"Cannot find input account: %v", ctx.tx.Input)
 	}
 
-	if ctx.tx.Input.Amount < ctx.tx.Fee {
-		return nil, nil, errors.Errorf(errors.Codes.InsufficientFunds,
-			"Send did not send enough to cover the fee: %v", ctx.tx.Input)
-	}
-
 	// Fees are handle by the CallContext, values transfers (i.e. balances) are handled in the VM (or in Check())
-	err = inAcc.SubtractFromBalance(ctx.tx.Fee)
+	err = payFee(ctx.Params, inAcc, ctx.tx.Input, ctx.tx.Fee)
 	if err != nil {
-		return nil, nil, errors.Errorf(errors.Codes.InsufficientFunds,
-			"Input account %v (balance: %d) does not have sufficient balance to cover input amount: %v",
-			inAcc.Address, inAcc.Balance, ctx.tx.Input)
+		return nil, nil, err
 	}
 
 	// Calling a nil destination is defined as requesting contract creation
@@ -127,20 +134,32 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 
 	var callee crypto.Address
 	var code []byte
-	var wcode []byte
+	kind := acm.EVMContract
 
 	// get or create callee
 	if createContract {
 		// We already checked for permission
 		callee = crypto.NewContractAddress(caller, ctx.txe.TxHash)
 		code = ctx.tx.Data
-		wcode = ctx.tx.WASM
-		err := native.CreateAccount(txCache, callee)
+		if len(ctx.tx.WASM) > 0 {
+			kind = acm.WASMContract
+			code = ctx.tx.WASM
+		}
+		perms := permission.ZeroBasePermissions
+		if ctx.Params != nil {
+			var err error
+			perms, err = chainparams.NewAccountPermissions(ctx.Params)
+			if err != nil {
+				return err
+			}
+		}
+		err := native.CreateAccountWithPermissions(txCache, callee, perms)
 		if err != nil {
 			return err
 		}
 		ctx.Logger.TraceMsg("Creating new contract",
 			"contract_address", callee,
+			"contract_kind", kind,
 			"init_code", code)
 
 		// store abis
@@ -148,6 +167,14 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		if err != nil {
 			return err
 		}
+		err = metaCache.SetDeployment(callee, &acm.Deployment{
+			TxHash:  ctx.txe.TxHash,
+			Creator: caller,
+			Height:  ctx.txe.Height,
+		})
+		if err != nil {
+			return err
+		}
 	} else {
 		if outAcc == nil {
 			// if you call an account that doesn't exist
@@ -171,88 +198,154 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		if err != nil {
 			return err
 		}
+		if acc.Paused {
+			exception := errors.Errorf(errors.Codes.ContractPaused, "CallTx to paused contract %v", callee)
+			ctx.Logger.Info.Log(structure.ErrorKey, exception,
+				"caller_address", inAcc.GetAddress(),
+				"callee_address", callee)
+			ctx.txe.PushError(exception)
+			ctx.CallEvents(exception)
+			return nil
+		}
 		code = acc.EVMCode
-		wcode = acc.WASMCode
+		if acc.ContractKind() == acm.WASMContract {
+			kind = acm.WASMContract
+			code = acc.WASMCode
+		}
 		ctx.Logger.TraceMsg("Calling existing contract",
 			"contract_address", callee,
+			"contract_kind", kind,
 			"input", ctx.tx.Data,
-			"evm_code", code,
-			"wasm_code", wcode)
+			"code", code)
 	}
 	ctx.Logger.Trace.Log("callee", callee)
 
 	var ret []byte
 	var err error
-	txHash := ctx.txe.Envelope.Tx.Hash()
 	gas := ctx.tx.GasLimit
-	if len(wcode) != 0 {
-		ret, err = wasm.RunWASM(txCache, callee, createContract, wcode, ctx.tx.Data)
-		if err != nil {
-			// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
-			ctx.Logger.InfoMsg("Error on WASM execution",
-				structure.ErrorKey, err)
+	// Dispatch to the engine for the kind of contract - accounts without code and natives are handled by the EVM
+	switch kind {
+	case acm.WASMContract:
+		ret, err = ctx.deliverWASM(txCache, metaCache, callee, createContract, code)
+	default:
+		ret, err = ctx.deliverEVM(txCache, metaCache, caller, callee, createContract, code, value, &gas)
+	}
+	if err != nil {
+		return err
+	}
+	gasUsed := ctx.tx.GasLimit - gas
+	if gasUsed > 0 && gasUsed >= ctx.tx.GasLimit/100*NearGasLimitPercent {
+		ctx.txe.Warn(exec.WarningNearGasLimit, "execution used %d of a gas limit of %d", gasUsed, ctx.tx.GasLimit)
+	}
+	ctx.txe.Return(ret, gasUsed)
+	// Create a receipt from the ret and whether it erred.
+	ctx.Logger.TraceMsg("VM Call complete",
+		"caller", caller,
+		"callee", callee,
+		"return", ret,
+		structure.ErrorKey, ctx.txe.Exception)
 
-			ctx.txe.PushError(errors.Wrap(err, "call error"))
-		} else {
-			ctx.Logger.TraceMsg("Successful execution")
-			if createContract {
-				err := native.InitWASMCode(txCache, callee, ret)
-				if err != nil {
-					return err
-				}
-			}
-			err = ctx.Sync(txCache, metaCache)
-			if err != nil {
-				return err
-			}
+	return nil
+}
+
+// Runs WASM code, returning an error only if the state could not be synced - execution errors are recorded on the
+// TxExecution
+func (ctx *CallContext) deliverWASM(txCache *acmstate.Cache, metaCache *acmstate.MetadataCache, callee crypto.Address,
+	createContract bool, code []byte) ([]byte, error) {
+	ret, err := wasm.RunWASM(txCache, callee, createContract, code, ctx.tx.Data)
+	if err != nil {
+		// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
+		ctx.Logger.InfoMsg("Error on WASM execution",
+			structure.ErrorKey, err)
+
+		ctx.txe.PushError(errors.Wrap(err, "call error"))
+		return ret, nil
+	}
+	ctx.Logger.TraceMsg("Successful execution")
+	if createContract {
+		err := native.InitWASMCode(txCache, callee, ret)
+		if err != nil {
+			return nil, err
 		}
-	} else {
-		// EVM
-		ctx.EVM.SetNonce(txHash)
-		ctx.EVM.SetLogger(ctx.Logger.With(structure.TxHashKey, txHash))
+	}
+	return ret, ctx.Sync(txCache, metaCache)
+}
 
-		params := engine.CallParams{
-			Origin: caller,
-			Caller: caller,
-			Callee: callee,
-			Input:  ctx.tx.Data,
-			Value:  value,
-			Gas:    &gas,
+// Runs EVM code, returning an error only if the state could not be synced - execution errors are recorded on the
+// TxExecution
+func (ctx *CallContext) deliverEVM(txCache *acmstate.Cache, metaCache *acmstate.MetadataCache, caller,
+	callee crypto.Address, createContract bool, code []byte, value uint64, gas *uint64) ([]byte, error) {
+	txHash := ctx.txe.Envelope.Tx.Hash()
+	ctx.EVM.SetNonce(txHash)
+	ctx.EVM.SetLogger(ctx.Logger.With(structure.TxHashKey, txHash))
+	ctx.EVM.SetNames(ctx.NameReg)
+	ctx.EVM.SetCron(ctx.Cron)
+	ctx.EVM.SetEscrows(ctx.Escrows)
+	if ctx.Params != nil {
+		maxInstructions, err := chainparams.MaxTxInstructions(ctx.Params)
+		if err != nil {
+			return nil, err
+		}
+		ctx.EVM.SetMaxInstructions(maxInstructions)
+		maxLogDataSize, maxTxLogs, err := chainparams.LogLimits(ctx.Params)
+		if err != nil {
+			return nil, err
+		}
+		ctx.EVM.SetLogLimits(engine.LogLimits{MaxDataSize: maxLogDataSize, MaxTxLogs: maxTxLogs})
+		capCallGas, err := chainparams.CapCallGas(ctx.Params)
+		if err != nil {
+			return nil, err
+		}
+		ctx.EVM.SetCapCallGas(capCallGas)
+		newAccountPermissions, err := chainparams.NewAccountPermissions(ctx.Params)
+		if err != nil {
+			return nil, err
+		}
+		ctx.EVM.SetNewAccountPermissions(newAccountPermissions)
+		gasScheduleName, err := chainparams.GasSchedule(ctx.Params)
+		if err != nil {
+			return nil, err
 		}
+		gasSchedule, err := evm.GasScheduleByName(gasScheduleName)
+		if err != nil {
+			return nil, err
+		}
+		ctx.EVM.SetGasSchedule(gasSchedule)
+	}
 
-		ret, err = ctx.EVM.Execute(txCache, ctx.Blockchain, ctx.txe, params, code)
+	params := engine.CallParams{
+		Origin: caller,
+		Caller: caller,
+		Callee: callee,
+		Input:  ctx.tx.Data,
+		Value:  value,
+		Gas:    gas,
+	}
 
-		if err != nil {
-			// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
-			ctx.Logger.InfoMsg("Error on EVM execution",
-				structure.ErrorKey, err)
+	ret, err := ctx.EVM.Execute(txCache, ctx.Blockchain, ctx.txe, params, code)
 
-			ctx.txe.PushError(errors.Wrapf(err, "call error: %v\nEVM call trace: %s",
-				err, ctx.txe.CallTrace()))
-		} else {
-			ctx.Logger.TraceMsg("Successful execution")
-			if createContract {
-				err := native.InitEVMCode(txCache, callee, ret)
-				if err != nil {
-					return err
-				}
-			}
-			err = ctx.Sync(txCache, metaCache)
+	if err != nil {
+		// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
+		ctx.Logger.InfoMsg("Error on EVM execution",
+			structure.ErrorKey, err)
+
+		ctx.txe.PushError(errors.Wrapf(err, "call error: %v\nEVM call trace: %s",
+			err, ctx.txe.CallTrace()))
+	} else {
+		ctx.Logger.TraceMsg("Successful execution")
+		if createContract {
+			err := native.InitEVMCode(txCache, callee, ret)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
-		ctx.CallEvents(err)
+		err = ctx.Sync(txCache, metaCache)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx.txe.Return(ret, ctx.tx.GasLimit-gas)
-	// Create a receipt from the ret and whether it erred.
-	ctx.Logger.TraceMsg("VM Call complete",
-		"caller", caller,
-		"callee", callee,
-		"return", ret,
-		structure.ErrorKey, err)
-
-	return nil
+	ctx.CallEvents(err)
+	return ret, nil
 }
 
 func (ctx *CallContext) CallEvents(err error) {
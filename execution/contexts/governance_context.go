@@ -1,15 +1,21 @@
 package contexts
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/chainparams"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/schedule"
 	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -19,6 +25,9 @@ import (
 type GovernanceContext struct {
 	State        acmstate.ReaderWriter
 	ValidatorSet validator.ReaderWriter
+	Schedule     schedule.ReaderWriter
+	Params       chainparams.ReaderWriter
+	Blockchain   engine.Blockchain
 	Logger       *logging.Logger
 	tx           *payload.GovTx
 	txe          *exec.TxExecution
@@ -31,7 +40,7 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 	ctx.txe = txe
 	ctx.tx, ok = p.(*payload.GovTx)
 	if !ok {
-		return fmt.Errorf("payload must be NameTx, but is: %v", txe.Envelope.Tx.Payload)
+		return fmt.Errorf("payload must be GovTx, but is: %v", txe.Envelope.Tx.Payload)
 	}
 	// Nothing down with any incoming funds at this point
 	accounts, _, err := getInputs(ctx.State, ctx.tx.Inputs)
@@ -49,25 +58,286 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 		txe.Input(i.Address, nil)
 	}
 
-	for _, update := range ctx.tx.AccountUpdates {
-		err := VerifyIdentity(ctx.State, update)
+	// Check vetoes up front but only cancel the vetoed GovTxs once the rest of this GovTx has succeeded
+	for _, veto := range ctx.tx.Vetoes {
+		err = ctx.checkVeto(veto)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.tx.Params != nil {
+		// Reject unknown parameters or gas schedules now rather than failing every subsequent EVM call
+		chainParams, err := ctx.mergeChainParams(ctx.tx)
 		if err != nil {
 			return fmt.Errorf("GovTx: %v", err)
 		}
-		account, err := getOrMakeOutput(ctx.State, accounts, *update.Address, ctx.Logger)
+		_, err = evm.GasScheduleByName(chainParams.GasSchedule)
 		if err != nil {
-			return err
+			return fmt.Errorf("GovTx: %v", err)
 		}
-		governAccountEvent, err := ctx.UpdateAccount(account, update)
+	}
+
+	lockedUntil, err := ctx.powerChangeActivationHeight(ctx.tx.AccountUpdates)
+	if err != nil {
+		return err
+	}
+	paramsLockedUntil, err := ctx.paramsActivationHeight(ctx.tx)
+	if err != nil {
+		return err
+	}
+	if paramsLockedUntil > lockedUntil {
+		lockedUntil = paramsLockedUntil
+	}
+
+	switch {
+	case lockedUntil > 0:
+		if ctx.tx.ActivationHeight > 0 && ctx.tx.ActivationHeight < lockedUntil {
+			return errors.Errorf(errors.Codes.InvalidBlockNumber,
+				"GovTx changes validator power by more than is permitted within a block, or relaxes that limit, so "+
+					"its activation height %d must be at least %d", ctx.tx.ActivationHeight, lockedUntil)
+		}
+		if ctx.tx.ActivationHeight > lockedUntil {
+			lockedUntil = ctx.tx.ActivationHeight
+		}
+		err = ctx.scheduleGovTx(ctx.tx, txe.TxHash, lockedUntil, true)
+	case ctx.tx.ActivationHeight > 0:
+		err = ctx.ScheduleGovTx(ctx.tx, txe.TxHash)
+	default:
+		var events []*exec.GovernAccountEvent
+		events, err = ctx.applyGovTx(accounts, ctx.tx)
+		for _, ev := range events {
+			txe.GovernAccount(ev, nil)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, veto := range ctx.tx.Vetoes {
+		ctx.Logger.InfoMsg("Vetoing scheduled GovTx", "activation_height", veto.Height, "vetoed_tx_hash", veto.TxHash)
+		err = ctx.Schedule.RemoveScheduledGovTx(veto.Height, veto.TxHash)
 		if err != nil {
-			txe.GovernAccount(governAccountEvent, errors.AsException(err))
 			return err
 		}
-		txe.GovernAccount(governAccountEvent, nil)
 	}
 	return nil
 }
 
+// Schedule the GovTx's account updates for application at the end of the block at its ActivationHeight
+func (ctx *GovernanceContext) ScheduleGovTx(tx *payload.GovTx, txHash binary.HexBytes) error {
+	return ctx.scheduleGovTx(tx, txHash, tx.ActivationHeight, false)
+}
+
+func (ctx *GovernanceContext) scheduleGovTx(tx *payload.GovTx, txHash binary.HexBytes, activationHeight uint64,
+	timeLocked bool) error {
+	height := ctx.Blockchain.LastBlockHeight() + 1
+	if activationHeight <= height {
+		return errors.Errorf(errors.Codes.InvalidBlockNumber,
+			"GovTx activation height %d must be greater than the current block height %d", activationHeight, height)
+	}
+	for _, update := range tx.AccountUpdates {
+		err := VerifyIdentity(ctx.State, update)
+		if err != nil {
+			return fmt.Errorf("GovTx: %v", err)
+		}
+	}
+	ctx.Logger.InfoMsg("Scheduling GovTx", "activation_height", activationHeight,
+		"account_updates", len(tx.AccountUpdates), "time_locked", timeLocked)
+	return ctx.Schedule.ScheduleGovTx(&payload.ScheduledGovTx{
+		Height:     activationHeight,
+		TxHash:     txHash,
+		GovTx:      tx,
+		TimeLocked: timeLocked,
+	})
+}
+
+// Returns the earliest height at which the validator power changes made by updates may be applied if, taken
+// together with any changes already made in the current block, they exceed the chain's MaxValidatorPowerChange.
+// Otherwise returns zero and the updates may be applied immediately.
+func (ctx *GovernanceContext) powerChangeActivationHeight(updates []*spec.TemplateAccount) (uint64, error) {
+	if ctx.Params == nil {
+		return 0, nil
+	}
+	maxChange, delay, err := chainparams.ValidatorPowerChangeLimits(ctx.Params)
+	if err != nil || maxChange == 0 {
+		return 0, err
+	}
+	change, total, err := ctx.powerChange(updates)
+	if err != nil {
+		return 0, err
+	}
+	// Allow any change while bootstrapping the validator set and otherwise any change up to maxChange percent
+	if total.Sign() == 0 ||
+		new(big.Int).Mul(change, big.NewInt(100)).Cmp(new(big.Int).Mul(total, new(big.Int).SetUint64(maxChange))) <= 0 {
+		return 0, nil
+	}
+	if delay == 0 {
+		delay = 1
+	}
+	height := ctx.Blockchain.LastBlockHeight() + 1
+	ctx.Logger.InfoMsg("GovTx validator power change exceeds permitted change within a block",
+		"power_change", change, "total_power", total, "max_power_change_percent", maxChange,
+		"locked_until", height+delay)
+	return height + delay, nil
+}
+
+// Returns the earliest height at which the chain params of tx may be applied if they relax MaxValidatorPowerChange or
+// ValidatorPowerChangeDelay, otherwise returns zero. Relaxing the limit is itself time-locked so that it cannot be
+// lifted in one block to make an unlimited power change in the next.
+func (ctx *GovernanceContext) paramsActivationHeight(tx *payload.GovTx) (uint64, error) {
+	if ctx.Params == nil || tx.Params == nil {
+		return 0, nil
+	}
+	maxChange, delay, err := chainparams.ValidatorPowerChangeLimits(ctx.Params)
+	if err != nil || maxChange == 0 {
+		return 0, err
+	}
+	chainParams, err := ctx.mergeChainParams(tx)
+	if err != nil {
+		return 0, err
+	}
+	if chainParams.MaxValidatorPowerChange != 0 && chainParams.MaxValidatorPowerChange <= maxChange &&
+		chainParams.ValidatorPowerChangeDelay >= delay {
+		return 0, nil
+	}
+	if delay == 0 {
+		delay = 1
+	}
+	height := ctx.Blockchain.LastBlockHeight() + 1
+	ctx.Logger.InfoMsg("GovTx relaxes the permitted validator power change within a block",
+		"max_power_change_percent", chainParams.MaxValidatorPowerChange,
+		"power_change_delay", chainParams.ValidatorPowerChangeDelay, "locked_until", height+delay)
+	return height + delay, nil
+}
+
+// Returns the absolute change in validator power the updates would make within the current block, including changes
+// already made within the block, and the total power of the validator set at the start of the block
+func (ctx *GovernanceContext) powerChange(updates []*spec.TemplateAccount) (change, total *big.Int, err error) {
+	var bucket *validator.Bucket
+	switch v := ctx.ValidatorSet.(type) {
+	case *validator.Cache:
+		bucket = v.Copy()
+	case *validator.Bucket:
+		bucket = v.Copy()
+	default:
+		return nil, nil, fmt.Errorf("cannot measure validator power changes made to validator set of type %T", v)
+	}
+	for _, update := range updates {
+		if !update.Balances().HasPower() {
+			continue
+		}
+		err = VerifyIdentity(ctx.State, update)
+		if err != nil {
+			return nil, nil, fmt.Errorf("GovTx: %v", err)
+		}
+		err = updatePower(bucket, update)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return bucket.Flow.TotalPower(), bucket.Previous.TotalPower(), nil
+}
+
+func (ctx *GovernanceContext) checkVeto(veto *payload.ScheduledGovTxID) error {
+	scheduled, err := ctx.Schedule.GetScheduledGovTxs(veto.Height)
+	if err != nil {
+		return err
+	}
+	for _, sgt := range scheduled {
+		if bytes.Equal(sgt.TxHash, veto.TxHash) {
+			return nil
+		}
+	}
+	return fmt.Errorf("GovTx: cannot veto GovTx %v scheduled at height %d since no such GovTx is pending",
+		veto.TxHash, veto.Height)
+}
+
+// Apply the account updates of a GovTx previously scheduled for the current height
+func (ctx *GovernanceContext) ApplyScheduledGovTx(scheduled *payload.ScheduledGovTx) ([]*exec.GovernAccountEvent, error) {
+	accounts, _, err := getInputs(ctx.State, scheduled.GovTx.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.applyGovTx(accounts, scheduled.GovTx)
+}
+
+// Applies the account updates and chain params of tx, either all of them or, on error, none of them
+func (ctx *GovernanceContext) applyGovTx(accounts map[crypto.Address]*acm.Account,
+	tx *payload.GovTx) ([]*exec.GovernAccountEvent, error) {
+	// Merge the chain params first since that may fail and the account updates are written once they succeed
+	var chainParams *payload.ChainParams
+	var err error
+	if tx.Params != nil {
+		chainParams, err = ctx.mergeChainParams(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	events, err := ctx.UpdateAccounts(accounts, tx.AccountUpdates)
+	if err != nil {
+		return nil, err
+	}
+	if chainParams != nil {
+		ctx.Logger.InfoMsg("Updating chain parameters", "chain_params", tx.Params, "params_mask", tx.ParamsMask)
+		err = ctx.Params.UpdateChainParams(chainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// Returns the current chain params updated by the params of tx that its ParamsMask selects
+func (ctx *GovernanceContext) mergeChainParams(tx *payload.GovTx) (*payload.ChainParams, error) {
+	chainParams, err := ctx.Params.GetChainParams()
+	if err != nil {
+		return nil, err
+	}
+	return chainParams.Merge(tx.Params, tx.ParamsMask)
+}
+
+// UpdateAccounts applies the updates atomically - either all of them are applied or, on error, none of them are
+func (ctx *GovernanceContext) UpdateAccounts(accounts map[crypto.Address]*acm.Account,
+	updates []*spec.TemplateAccount) ([]*exec.GovernAccountEvent, error) {
+	// Trial the updates against caches so nothing is written if any one of them fails
+	stateCache := acmstate.NewCache(ctx.State, acmstate.Named("GovTxCache"))
+	trial := &GovernanceContext{
+		State:        stateCache,
+		ValidatorSet: trialValidatorSet(ctx.ValidatorSet),
+		Logger:       ctx.Logger,
+	}
+	events := make([]*exec.GovernAccountEvent, 0, len(updates))
+	for _, update := range updates {
+		err := VerifyIdentity(stateCache, update)
+		if err != nil {
+			return nil, fmt.Errorf("GovTx: %v", err)
+		}
+		account, err := getOrMakeOutput(stateCache, accounts, *update.Address, permission.ZeroBasePermissions,
+			ctx.Logger)
+		if err != nil {
+			return nil, err
+		}
+		governAccountEvent, err := trial.UpdateAccount(account, update)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, governAccountEvent)
+	}
+	// Replay the validator changes against the real validator set which will succeed as they did on its copy
+	for _, update := range updates {
+		err := updatePower(ctx.ValidatorSet, update)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := stateCache.Sync(ctx.State)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
 func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.TemplateAccount) (ev *exec.GovernAccountEvent, err error) {
 	ev = &exec.GovernAccountEvent{
 		AccountUpdate: update,
@@ -75,23 +345,15 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 	if update.Balances().HasNative() {
 		account.Balance = update.Balances().GetNative(0)
 	}
-	if update.Balances().HasPower() {
-		if update.PublicKey == nil {
-			err = fmt.Errorf("updateAccount should have PublicKey by this point but appears not to for "+
-				"template account: %v", update)
-			return
-		}
-		power := new(big.Int).SetUint64(update.Balances().GetPower(0))
-		_, err := ctx.ValidatorSet.SetPower(*update.PublicKey, power)
-		if err != nil {
-			return ev, err
-		}
+	if update.Balances().HasGas() {
+		account.GasBalance = update.Balances().GetGas(0)
+	}
+	err = updatePower(ctx.ValidatorSet, update)
+	if err != nil {
+		return ev, err
 	}
 	if update.Code != nil {
 		account.EVMCode = *update.Code
-		if err != nil {
-			return ev, err
-		}
 	}
 	perms := account.Permissions
 	if len(update.Permissions) > 0 {
@@ -104,13 +366,38 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 		perms.Roles = update.Roles
 	}
 	account.Permissions = perms
-	if err != nil {
-		return
-	}
 	err = ctx.State.UpdateAccount(account)
 	return
 }
 
+func updatePower(vs validator.Writer, update *spec.TemplateAccount) error {
+	if !update.Balances().HasPower() {
+		return nil
+	}
+	if update.PublicKey == nil {
+		return fmt.Errorf("updateAccount should have PublicKey by this point but appears not to for "+
+			"template account: %v", update)
+	}
+	power := new(big.Int).SetUint64(update.Balances().GetPower(0))
+	_, err := vs.SetPower(*update.PublicKey, power)
+	return err
+}
+
+// Returns a copy of the validator set against which power changes can be trialled. Changes to validator sets that
+// cannot be copied are only checked by the real validator set.
+func trialValidatorSet(vs validator.ReaderWriter) validator.ReaderWriter {
+	switch v := vs.(type) {
+	case *validator.Cache:
+		return v.Copy()
+	case *validator.Bucket:
+		return v.Copy()
+	case *validator.Set:
+		return validator.Copy(v)
+	default:
+		return validator.NewSet()
+	}
+}
+
 func VerifyIdentity(sw acmstate.ReaderWriter, account *spec.TemplateAccount) (err error) {
 	if account.Address == nil && account.PublicKey == nil {
 		// We do not want to generate a key
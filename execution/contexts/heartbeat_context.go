@@ -0,0 +1,78 @@
+package contexts
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/heartbeat"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type HeartbeatContext struct {
+	ValidatorSet validator.Reader
+	Heartbeats   heartbeat.ReaderWriter
+	Blockchain   engine.Blockchain
+	Logger       *logging.Logger
+	tx           *payload.HeartbeatTx
+}
+
+// Execute a HeartbeatTx to record that a validator is live and has seen the block it attests to
+func (ctx *HeartbeatContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.HeartbeatTx)
+	if !ok {
+		return fmt.Errorf("payload must be HeartbeatTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+
+	address := ctx.tx.Input.Address
+	if ctx.tx.Input.Amount != 0 {
+		return errors.Errorf(errors.Codes.Overpayment, "HeartbeatTx may not carry an amount but has %d",
+			ctx.tx.Input.Amount)
+	}
+
+	// Only current validators may attest to their liveness
+	power, err := ctx.ValidatorSet.Power(address)
+	if err != nil {
+		return err
+	}
+	if power == nil || power.Sign() == 0 {
+		return errors.Errorf(errors.Codes.PermissionDenied, "HeartbeatTx must be signed by a validator but %v is not one",
+			address)
+	}
+
+	// The validator must have seen a block we have actually committed
+	lastHeight := ctx.Blockchain.LastBlockHeight()
+	if ctx.tx.Height == 0 || ctx.tx.Height > lastHeight {
+		return errors.Errorf(errors.Codes.BlockNumberOutOfRange, "HeartbeatTx attests to block %d but the last block is %d",
+			ctx.tx.Height, lastHeight)
+	}
+	blockHash, err := ctx.Blockchain.BlockHash(ctx.tx.Height)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blockHash, ctx.tx.BlockHash) {
+		return fmt.Errorf("HeartbeatTx attests to block hash %v at height %d but the block has hash %X",
+			ctx.tx.BlockHash, ctx.tx.Height, blockHash)
+	}
+
+	hb, err := ctx.Heartbeats.GetHeartbeat(address)
+	if err != nil {
+		return err
+	}
+	var count uint64
+	if hb != nil {
+		count = hb.Count
+	}
+	return ctx.Heartbeats.UpdateHeartbeat(&heartbeat.Heartbeat{
+		Address:        address,
+		Height:         txe.GetHeight(),
+		ObservedHeight: ctx.tx.Height,
+		BlockHash:      ctx.tx.BlockHash,
+		Count:          count + 1,
+	})
+}
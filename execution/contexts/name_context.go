@@ -3,9 +3,8 @@ package contexts
 import (
 	"fmt"
 
-	"regexp"
-
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -14,15 +13,11 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
-// Name should be file system like
-// Data should be anything permitted in JSON
-var regexpAlphaNum = regexp.MustCompile("^[a-zA-Z0-9._/-@]*$")
-var regexpJSON = regexp.MustCompile(`^[a-zA-Z0-9_/ \-+"':,\n\t.{}()\[\]]*$`)
-
 type NameContext struct {
 	Blockchain engine.Blockchain
 	State      acmstate.ReaderWriter
 	NameReg    names.ReaderWriter
+	Params     chainparams.Reader
 	Logger     *logging.Logger
 	tx         *payload.NameTx
 }
@@ -47,116 +42,42 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	if !hasNamePermission(ctx.State, inAcc, ctx.Logger) {
 		return fmt.Errorf("account %s does not have Name permission", ctx.tx.Input.Address)
 	}
-	if ctx.tx.Input.Amount < ctx.tx.Fee {
-		ctx.Logger.InfoMsg("Sender did not send enough to cover the fee",
-			"tx_input", ctx.tx.Input)
-		return errors.Codes.InsufficientFunds
-	}
-
 	// validate the input strings
 	if err := validateStrings(ctx.tx); err != nil {
 		return err
 	}
 
-	value := ctx.tx.Input.Amount - ctx.tx.Fee
-
-	// let's say cost of a name for one block is len(data) + 32
-	costPerBlock := names.NameCostPerBlock(names.NameBaseCost(ctx.tx.Name, ctx.tx.Data))
-	expiresIn := value / uint64(costPerBlock)
-	lastBlockHeight := ctx.Blockchain.LastBlockHeight()
-
-	ctx.Logger.TraceMsg("New NameTx",
-		"value", value,
-		"cost_per_block", costPerBlock,
-		"expires_in", expiresIn,
-		"last_block_height", lastBlockHeight)
-
-	// check if the name exists
-	entry, err := ctx.NameReg.GetName(ctx.tx.Name)
+	value, err := valueAfterFee(ctx.Params, ctx.tx.Input, ctx.tx.Fee)
 	if err != nil {
+		ctx.Logger.InfoMsg("Sender did not send enough to cover the fee",
+			"tx_input", ctx.tx.Input)
 		return err
 	}
-
-	if entry != nil {
-		var expired bool
-
-		// if the entry already exists, and hasn't expired, we must be owner
-		if entry.Expires > lastBlockHeight {
-			// ensure we are owner
-			if entry.Owner != ctx.tx.Input.Address {
-				return fmt.Errorf("permission denied: sender %s is trying to update a name (%s) for "+
-					"which they are not an owner", ctx.tx.Input.Address, ctx.tx.Name)
-			}
-		} else {
-			expired = true
+	// A fee paid in the gas token is collected from the gas balance, whereas a native fee is simply kept back from value
+	if ctx.tx.Fee > 0 {
+		separate, err := separateGasToken(ctx.Params)
+		if err != nil {
+			return err
 		}
-
-		// no value and empty data means delete the entry
-		if value == 0 && len(ctx.tx.Data) == 0 {
-			// maybe we reward you for telling us we can delete this crap
-			// (owners if not expired, anyone if expired)
-			ctx.Logger.TraceMsg("Removing NameReg entry (no value and empty data in tx requests this)",
-				"name", entry.Name)
-			err := ctx.NameReg.RemoveName(entry.Name)
-			if err != nil {
-				return err
-			}
-		} else {
-			// update the entry by bumping the expiry
-			// and changing the data
-			if expired {
-				if expiresIn < names.MinNameRegistrationPeriod {
-					return fmt.Errorf("names must be registered for at least %d blocks", names.MinNameRegistrationPeriod)
-				}
-				entry.Expires = lastBlockHeight + expiresIn
-				entry.Owner = ctx.tx.Input.Address
-				ctx.Logger.TraceMsg("An old NameReg entry has expired and been reclaimed",
-					"name", entry.Name,
-					"expires_in", expiresIn,
-					"owner", entry.Owner)
-			} else {
-				// since the size of the data may have changed
-				// we use the total amount of "credit"
-				oldCredit := (entry.Expires - lastBlockHeight) * names.NameBaseCost(entry.Name, entry.Data)
-				credit := oldCredit + value
-				expiresIn = uint64(credit / costPerBlock)
-				if expiresIn < names.MinNameRegistrationPeriod {
-					return fmt.Errorf("names must be registered for at least %d blocks", names.MinNameRegistrationPeriod)
-				}
-				entry.Expires = lastBlockHeight + expiresIn
-				ctx.Logger.TraceMsg("Updated NameReg entry",
-					"name", entry.Name,
-					"expires_in", expiresIn,
-					"old_credit", oldCredit,
-					"value", value,
-					"credit", credit)
-			}
-			entry.Data = ctx.tx.Data
-			err := ctx.NameReg.UpdateName(entry)
+		if separate {
+			err = payFee(ctx.Params, inAcc, ctx.tx.Input, ctx.tx.Fee)
 			if err != nil {
 				return err
 			}
 		}
-	} else {
-		if expiresIn < names.MinNameRegistrationPeriod {
-			return fmt.Errorf("names must be registered for at least %d blocks", names.MinNameRegistrationPeriod)
-		}
-		// entry does not exist, so create it
-		entry = &names.Entry{
-			Name:    ctx.tx.Name,
-			Owner:   ctx.tx.Input.Address,
-			Data:    ctx.tx.Data,
-			Expires: lastBlockHeight + expiresIn,
-		}
-		ctx.Logger.TraceMsg("Creating NameReg entry",
-			"name", entry.Name,
-			"expires_in", expiresIn)
-		err := ctx.NameReg.UpdateName(entry)
-		if err != nil {
-			return err
-		}
 	}
 
+	lastBlockHeight := ctx.Blockchain.LastBlockHeight()
+	entry, err := names.Register(ctx.NameReg, ctx.tx.Input.Address, ctx.tx.Name, ctx.tx.Data, value, lastBlockHeight)
+	if err != nil {
+		return err
+	}
+	ctx.Logger.TraceMsg("Registered NameTx",
+		"name", entry.Name,
+		"value", value,
+		"expires", entry.Expires,
+		"last_block_height", lastBlockHeight)
+
 	// TODO: something with the value sent?
 
 	// Good!
@@ -184,34 +105,5 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 }
 
 func validateStrings(tx *payload.NameTx) error {
-	if len(tx.Name) == 0 {
-		return errors.Errorf(errors.Codes.InvalidString, "name must not be empty")
-	}
-	if len(tx.Name) > names.MaxNameLength {
-		return errors.Errorf(errors.Codes.InvalidString, "Name is too long. Max %d bytes", names.MaxNameLength)
-	}
-	if len(tx.Data) > names.MaxDataLength {
-		return errors.Errorf(errors.Codes.InvalidString, "Data is too long. Max %d bytes", names.MaxDataLength)
-	}
-
-	if !validateNameRegEntryName(tx.Name) {
-		return errors.Errorf(errors.Codes.InvalidString,
-			"Invalid characters found in NameTx.Name (%s). Only alphanumeric, underscores, dashes, forward slashes, and @ are allowed", tx.Name)
-	}
-
-	if !validateNameRegEntryData(tx.Data) {
-		return errors.Errorf(errors.Codes.InvalidString,
-			"Invalid characters found in NameTx.Data (%s). Only the kind of things found in a JSON file are allowed", tx.Data)
-	}
-
-	return nil
-}
-
-// filter strings
-func validateNameRegEntryName(name string) bool {
-	return regexpAlphaNum.Match([]byte(name))
-}
-
-func validateNameRegEntryData(data string) bool {
-	return regexpJSON.Match([]byte(data))
+	return names.ValidateEntry(tx.Name, tx.Data)
 }
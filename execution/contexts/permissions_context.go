@@ -42,8 +42,9 @@ func (ctx *PermissionsContext) Execute(txe *exec.TxExecution, p payload.Payload)
 	}
 
 	permFlag := ctx.tx.PermArgs.Action
-	// check permission
-	if !HasPermission(ctx.State, inAcc, permFlag, ctx.Logger) {
+	// check permission - a contract may also be paused by the holder of its pause role
+	if !HasPermission(ctx.State, inAcc, permFlag, ctx.Logger) &&
+		!(permFlag == permission.Pause && inAcc.Permissions.HasRole(permission.PauseRole(*ctx.tx.PermArgs.Target))) {
 		return fmt.Errorf("account %s does not have moderator permission %s (%b)", ctx.tx.Input.Address,
 			permFlag.String(), permFlag)
 	}
@@ -93,6 +94,8 @@ func (ctx *PermissionsContext) Execute(txe *exec.TxExecution, p payload.Payload)
 				}
 				return nil
 			})
+	case permission.Pause:
+		permAcc, err = mutatePaused(ctx.State, *ctx.tx.PermArgs.Target, *ctx.tx.PermArgs.Value)
 	default:
 		return fmt.Errorf("invalid permission function: %v", permFlag)
 	}
@@ -137,3 +140,19 @@ func mutatePermissions(stateReader acmstate.Reader, address crypto.Address,
 	}
 	return account, mutator(&account.Permissions)
 }
+
+func mutatePaused(stateReader acmstate.Reader, address crypto.Address, paused bool) (*acm.Account, error) {
+	account, err := stateReader.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("could not get account at address %s in order to pause it", address)
+	}
+	kind := account.ContractKind()
+	if kind != acm.EVMContract && kind != acm.WASMContract {
+		return nil, fmt.Errorf("account %s is not an EVM or WASM contract so cannot be paused", address)
+	}
+	account.Paused = paused
+	return account, nil
+}
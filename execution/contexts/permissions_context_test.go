@@ -3,7 +3,9 @@ package contexts
 import (
 	"testing"
 
+	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -124,6 +126,41 @@ func TestPermissionsContext(t *testing.T) {
 	}
 }
 
+func TestPermissionsContextPause(t *testing.T) {
+	accountState := acmstate.NewMemoryState()
+	ctx := &PermissionsContext{
+		State:  accountState,
+		Logger: logging.NewNoopLogger(),
+	}
+
+	pauserAccount := newAccountFromPrivKey(newPrivKey(t))
+	contractAccount := newAccountFromPrivKey(newPrivKey(t))
+	contractAccount.EVMCode = acm.Bytecode{0x60, 0x00}
+	otherAccount := newAccountFromPrivKey(newPrivKey(t))
+	accountState.Accounts[pauserAccount.Address] = pauserAccount
+	accountState.Accounts[contractAccount.Address] = contractAccount
+	accountState.Accounts[otherAccount.Address] = otherAccount
+
+	pause := func(target crypto.Address, paused bool) error {
+		permsTx := &payload.PermsTx{
+			Input:    &payload.TxInput{Address: pauserAccount.Address},
+			PermArgs: permission.PauseArgs(target, paused),
+		}
+		return ctx.Execute(execFromTx(permsTx), permsTx)
+	}
+
+	require.Error(t, pause(contractAccount.Address, true), "should need pause permission or role")
+
+	pauserAccount.Permissions.AddRole(permission.PauseRole(contractAccount.Address))
+	require.NoError(t, pause(contractAccount.Address, true))
+	require.True(t, accountState.Accounts[contractAccount.Address].Paused)
+	require.NoError(t, pause(contractAccount.Address, false))
+	require.False(t, accountState.Accounts[contractAccount.Address].Paused)
+
+	pauserAccount.Permissions.Base = permission.AllAccountPermissions.GetBase()
+	require.Error(t, pause(otherAccount.Address, true), "should not pause an account without code")
+}
+
 func ptrPermFlag(flag permission.PermFlag) *permission.PermFlag {
 	return &flag
 }
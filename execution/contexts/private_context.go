@@ -0,0 +1,62 @@
+package contexts
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Executes the encrypted payload committed to by a PrivateTx against a node's private state, which is only possible
+// on the nodes of the payload's participant group
+type PrivateExecutor interface {
+	ExecutePrivate(blockchain engine.Blockchain, txHash []byte, tx *payload.PrivateTx) error
+}
+
+type PrivateContext struct {
+	State      acmstate.ReaderWriter
+	Private    PrivateExecutor
+	Blockchain engine.Blockchain
+	RunCall    bool
+	Logger     *logging.Logger
+	tx         *payload.PrivateTx
+}
+
+func (ctx *PrivateContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.PrivateTx)
+	if !ok {
+		return fmt.Errorf("payload must be PrivateTx, but is: %v", p)
+	}
+	inAcc, err := ctx.State.GetAccount(ctx.tx.Input.Address)
+	if err != nil {
+		return err
+	}
+	if inAcc == nil {
+		return errors.Errorf(errors.Codes.InvalidAddress, "Cannot find input account: %v", ctx.tx.Input)
+	}
+	if !hasCallPermission(ctx.State, inAcc, ctx.Logger) {
+		return errors.Errorf(errors.Codes.PermissionDenied, "account %v does not have Call permission",
+			ctx.tx.Input.Address)
+	}
+	if len(ctx.tx.PayloadHash) != sha256.Size {
+		return errors.Errorf(errors.Codes.InvalidString, "PrivateTx PayloadHash should be %d bytes but is %d",
+			sha256.Size, len(ctx.tx.PayloadHash))
+	}
+	txe.Input(ctx.tx.Input.Address, nil)
+
+	if ctx.RunCall && ctx.Private != nil {
+		// Only the hash is public so other nodes cannot check the outcome, and it must not affect consensus
+		err = ctx.Private.ExecutePrivate(ctx.Blockchain, txe.TxHash, ctx.tx)
+		if err != nil {
+			ctx.Logger.InfoMsg("Could not execute private payload", "tx_hash", txe.TxHash,
+				"payload_hash", ctx.tx.PayloadHash, "error", err)
+		}
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -248,7 +249,7 @@ func validateProposalStrings(proposal *payload.Proposal) error {
 		return errors.Errorf(errors.Codes.InvalidString, "name must not be empty")
 	}
 
-	if !validateNameRegEntryName(proposal.Name) {
+	if !names.ValidName(proposal.Name) {
 		return errors.Errorf(errors.Codes.InvalidString,
 			"Invalid characters found in Proposal.Name (%s). Only alphanumeric, underscores, dashes, forward slashes, and @ are allowed", proposal.Name)
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
@@ -13,6 +14,7 @@ import (
 
 type SendContext struct {
 	State  acmstate.ReaderWriter
+	Params chainparams.Reader
 	Logger *logging.Logger
 	tx     *payload.SendTx
 }
@@ -36,7 +38,14 @@ func (ctx *SendContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 
 	// add outputs to accounts map
 	// if any outputs don't exist, all inputs must have CreateAccount perm
-	accounts, err = getOrMakeOutputs(ctx.State, accounts, ctx.tx.Outputs, ctx.Logger)
+	newPerms := permission.ZeroBasePermissions
+	if ctx.Params != nil {
+		newPerms, err = chainparams.NewAccountPermissions(ctx.Params)
+		if err != nil {
+			return err
+		}
+	}
+	accounts, err = getOrMakeOutputs(ctx.State, accounts, ctx.tx.Outputs, newPerms, ctx.Logger)
 	if err != nil {
 		return err
 	}
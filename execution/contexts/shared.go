@@ -6,6 +6,7 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
@@ -43,15 +44,17 @@ func getInputs(accountGetter acmstate.AccountGetter, ins []*payload.TxInput) (ma
 	return accounts, total, nil
 }
 
+// Gets the accounts of outs, making those that do not exist with the base permissions newPerms
 func getOrMakeOutputs(accountGetter acmstate.AccountGetter, accs map[crypto.Address]*acm.Account,
-	outs []*payload.TxOutput, logger *logging.Logger) (map[crypto.Address]*acm.Account, error) {
+	outs []*payload.TxOutput, newPerms permission.BasePermissions,
+	logger *logging.Logger) (map[crypto.Address]*acm.Account, error) {
 	if accs == nil {
 		accs = make(map[crypto.Address]*acm.Account)
 	}
 	// we should err if an account is being created but the inputs don't have permission
 	var err error
 	for _, out := range outs {
-		accs[out.Address], err = getOrMakeOutput(accountGetter, accs, out.Address, logger)
+		accs[out.Address], err = getOrMakeOutput(accountGetter, accs, out.Address, newPerms, logger)
 		if err != nil {
 			return nil, err
 		}
@@ -60,7 +63,7 @@ func getOrMakeOutputs(accountGetter acmstate.AccountGetter, accs map[crypto.Addr
 }
 
 func getOrMakeOutput(accountGetter acmstate.AccountGetter, accs map[crypto.Address]*acm.Account,
-	outputAddress crypto.Address, logger *logging.Logger) (*acm.Account, error) {
+	outputAddress crypto.Address, newPerms permission.BasePermissions, logger *logging.Logger) (*acm.Account, error) {
 
 	// Account shouldn't be duplicated
 	if _, ok := accs[outputAddress]; ok {
@@ -80,7 +83,7 @@ func getOrMakeOutput(accountGetter acmstate.AccountGetter, accs map[crypto.Addre
 			Address:     outputAddress,
 			Sequence:    0,
 			Balance:     0,
-			Permissions: permission.ZeroAccountPermissions,
+			Permissions: permission.AccountPermissions{Base: newPerms},
 		}
 	}
 
@@ -250,3 +253,56 @@ func hasBondOrSendPermission(accountGetter acmstate.AccountGetter, accs map[cryp
 	}
 	return true
 }
+
+// Debits fee from the gas token balance of the input account if the chain separates gas from the native token and
+// otherwise from its native balance, in which case the input amount must cover the fee
+func payFee(params chainparams.Reader, inAcc *acm.Account, input *payload.TxInput, fee uint64) error {
+	separate, err := separateGasToken(params)
+	if err != nil {
+		return err
+	}
+	if separate {
+		err = inAcc.SubtractFromGasBalance(fee)
+		if err != nil {
+			return errors.Errorf(errors.Codes.InsufficientFunds,
+				"Input account %v (gas balance: %d) does not have sufficient gas to cover fee %d",
+				inAcc.Address, inAcc.GasBalance, fee)
+		}
+		return nil
+	}
+	if input.Amount < fee {
+		return errors.Errorf(errors.Codes.InsufficientFunds,
+			"Send did not send enough to cover the fee: %v", input)
+	}
+	err = inAcc.SubtractFromBalance(fee)
+	if err != nil {
+		return errors.Errorf(errors.Codes.InsufficientFunds,
+			"Input account %v (balance: %d) does not have sufficient balance to cover input amount: %v",
+			inAcc.Address, inAcc.Balance, input)
+	}
+	return nil
+}
+
+// Returns the value of the native token carried by input once fee is paid, which is the whole input amount when fees
+// are paid in the gas token
+func valueAfterFee(params chainparams.Reader, input *payload.TxInput, fee uint64) (uint64, error) {
+	separate, err := separateGasToken(params)
+	if err != nil {
+		return 0, err
+	}
+	if separate {
+		return input.Amount, nil
+	}
+	if input.Amount < fee {
+		return 0, errors.Errorf(errors.Codes.InsufficientFunds,
+			"Send did not send enough to cover the fee: %v", input)
+	}
+	return input.Amount - fee, nil
+}
+
+func separateGasToken(params chainparams.Reader) (bool, error) {
+	if params == nil {
+		return false, nil
+	}
+	return chainparams.SeparateGasToken(params)
+}
@@ -0,0 +1,129 @@
+package execution
+
+import (
+	"fmt"
+	"math"
+	"runtime/debug"
+
+	"github.com/hyperledger/burrow/execution/cron"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Runs the cron jobs due at the current height. Each call is recorded as a TxExecution of an unsigned CallTx from the
+// job's payer to its target so that its events are delivered like those of any other transaction, along with a
+// CronExecuted event from the Cron native. Jobs are deregistered once their budget is exhausted or if their call cannot
+// be made at all. Jobs that would take the gas used by cron jobs in the block beyond cron.MaxBlockGas are deferred to
+// the next block.
+func (exe *executor) BeginBlock() (err error) {
+	// Cron jobs only run on delivery
+	if !exe.runCall {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic in executor.BeginBlock(): %v\n%s", r, debug.Stack())
+		}
+	}()
+	height := exe.block.Height
+	jobs, err := exe.cronCache.GetDueCronJobs(height)
+	if err != nil {
+		return err
+	}
+	var gasUsed uint64
+	for _, job := range jobs {
+		if gasUsed+job.Gas() > cron.MaxBlockGas {
+			job.NextHeight = height + 1
+			err = exe.cronCache.UpdateCronJob(job)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		used, err := exe.runCronJob(height, job)
+		if err != nil {
+			return err
+		}
+		gasUsed += used
+	}
+	return nil
+}
+
+// Runs the job returning the gas it used
+func (exe *executor) runCronJob(height uint64, job *cron.Job) (uint64, error) {
+	logger := exe.logger.With("cron_job_id", job.ID, "height", height)
+	txExecutor, ok := exe.contexts[payload.TypeCall]
+	if !ok {
+		return 0, fmt.Errorf("no context registered for CallTx so cannot run cron jobs")
+	}
+	target := job.Target
+	// There is no fee since gas is paid for from the budget held in escrow when the job was scheduled
+	tx := &payload.CallTx{
+		Input:    &payload.TxInput{Address: job.Payer, Sequence: cronSequence(height, job.ID)},
+		Address:  &target,
+		GasLimit: job.Gas(),
+		Data:     job.Input,
+	}
+	txe := exe.block.Tx(txs.Enclose(exe.params.ChainID, tx))
+
+	var exhausted bool
+	var gasUsed uint64
+	err := txExecutor.Execute(txe, tx)
+	if err != nil {
+		// The call could not be made at all (for instance the payer has lost its Call permission) so it never will be
+		logger.InfoMsg("Cron job call could not be made, deregistering", structure.ErrorKey, err)
+		txe.PushError(err)
+		exhausted = true
+	} else {
+		gasUsed = txe.GetResult().GetGasUsed()
+		if txe.Exception != nil && gasUsed == 0 {
+			// Failed calls must still cost something or a job could run indefinitely
+			gasUsed = tx.GasLimit
+		}
+		exe.blockGasUsed += gasUsed
+		exhausted = job.Spend(gasUsed)
+		err = logCronEvent(txe, native.CronExecuted{
+			ID:      job.ID,
+			Success: txe.Exception == nil,
+			GasUsed: gasUsed,
+			Budget:  job.Budget,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if exhausted {
+		err = exe.cronCache.RemoveCronJob(job.ID)
+		if err != nil {
+			return 0, err
+		}
+		// A job whose call cannot be made keeps what remains of its budget
+		err = native.RefundCronJob(exe.stateCache, job)
+		if err != nil {
+			return 0, err
+		}
+		logger.TraceMsg("Cron job deregistered", "job", job)
+		return gasUsed, logCronEvent(txe, native.CronDeregistered{ID: job.ID, Refund: job.Budget})
+	}
+	job.NextHeight = height + job.Interval
+	return gasUsed, exe.cronCache.UpdateCronJob(job)
+}
+
+func logCronEvent(txe *exec.TxExecution, value interface{}) error {
+	log, err := native.NewLogEvent(native.CronAddress, value)
+	if err != nil {
+		return err
+	}
+	return txe.Log(log)
+}
+
+// The sequence of the call a job makes at height, which folds in the job's ID so that jobs with the same payer, target,
+// and input running in the same block still have distinct transaction hashes. Calls are distinct so long as heights and
+// job IDs are below 2^32.
+func cronSequence(height, id uint64) uint64 {
+	return height<<32 | id&math.MaxUint32
+}
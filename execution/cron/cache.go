@@ -0,0 +1,180 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Cache accumulates changes to cron jobs so that they can be written to a backend Writer via Sync or discarded
+type Cache struct {
+	sync.RWMutex
+	backend Reader
+	jobs    map[uint64]*jobInfo
+	// The highest ID assigned by this cache (or zero if it has assigned none)
+	lastID uint64
+}
+
+type jobInfo struct {
+	job     *Job
+	removed bool
+	updated bool
+}
+
+var _ ReaderWriter = &Cache{}
+
+// Returns a Cache that wraps backend for reads and can write to an output Writer via Sync
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend: backend,
+		jobs:    make(map[uint64]*jobInfo),
+	}
+}
+
+func (cache *Cache) GetCronJob(id uint64) (*Job, error) {
+	info, err := cache.get(id)
+	if err != nil {
+		return nil, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if info.removed {
+		return nil, nil
+	}
+	return info.job, nil
+}
+
+func (cache *Cache) GetDueCronJobs(height uint64) ([]*Job, error) {
+	due, err := cache.backend.GetDueCronJobs(height)
+	if err != nil {
+		return nil, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	// Our changes take precedence over the backend's
+	jobs := make([]*Job, 0, len(due))
+	for _, job := range due {
+		if _, ok := cache.jobs[job.ID]; !ok {
+			jobs = append(jobs, job)
+		}
+	}
+	for _, info := range cache.jobs {
+		if !info.removed && info.job != nil && info.job.NextHeight == height {
+			jobs = append(jobs, info.job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return jobs, nil
+}
+
+func (cache *Cache) LastCronJobID() (uint64, error) {
+	id, err := cache.backend.LastCronJobID()
+	if err != nil {
+		return 0, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if cache.lastID > id {
+		return cache.lastID, nil
+	}
+	return id, nil
+}
+
+func (cache *Cache) UpdateCronJob(job *Job) error {
+	if job.ID == 0 {
+		return fmt.Errorf("UpdateCronJob passed job without an ID")
+	}
+	info, err := cache.get(job.ID)
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if info.removed {
+		return fmt.Errorf("UpdateCronJob on a removed job: %d", job.ID)
+	}
+	info.job = job
+	info.updated = true
+	if job.ID > cache.lastID {
+		cache.lastID = job.ID
+	}
+	return nil
+}
+
+func (cache *Cache) RemoveCronJob(id uint64) error {
+	info, err := cache.get(id)
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if info.removed {
+		return fmt.Errorf("RemoveCronJob on a removed job: %d", id)
+	}
+	info.removed = true
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	ids := make([]uint64, 0, len(cache.jobs))
+	for id := range cache.jobs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		info := cache.jobs[id]
+		if info.removed {
+			if info.job == nil {
+				// Never existed beyond this cache
+				continue
+			}
+			err := state.RemoveCronJob(id)
+			if err != nil {
+				return err
+			}
+		} else if info.updated {
+			err := state.UpdateCronJob(info.job)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.jobs = make(map[uint64]*jobInfo)
+	cache.lastID = 0
+}
+
+// Get the cache jobInfo item creating it if necessary
+func (cache *Cache) get(id uint64) (*jobInfo, error) {
+	cache.RLock()
+	info := cache.jobs[id]
+	cache.RUnlock()
+	if info == nil {
+		cache.Lock()
+		defer cache.Unlock()
+		info = cache.jobs[id]
+		if info == nil {
+			job, err := cache.backend.GetCronJob(id)
+			if err != nil {
+				return nil, err
+			}
+			info = &jobInfo{
+				job: job,
+			}
+			cache.jobs[id] = info
+		}
+	}
+	return info, nil
+}
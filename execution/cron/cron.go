@@ -0,0 +1,76 @@
+package cron
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/event/query"
+)
+
+const (
+	// The longest interval between calls a job may have
+	MaxInterval uint64 = 1 << 32
+	// The most gas a job may allow any one call to use
+	MaxGasLimit uint64 = 1000000
+	// The most gas the jobs run at the start of a block may use between them, jobs that would exceed it are deferred
+	// to the next block
+	MaxBlockGas uint64 = 10 * MaxGasLimit
+)
+
+func (job *Job) String() string {
+	return fmt.Sprintf("CronJob{ID: %d; Payer: %v; Target: %v; Interval: %d; GasLimit: %d; Budget: %d; NextHeight: %d}",
+		job.ID, job.Payer, job.Target, job.Interval, job.GasLimit, job.Budget, job.NextHeight)
+}
+
+func (job *Job) Get(key string) (value interface{}, ok bool) {
+	return query.GetReflect(reflect.ValueOf(job), key)
+}
+
+// The gas available to the next call, which is the lesser of the gas limit and the remaining budget
+func (job *Job) Gas() uint64 {
+	if job.Budget < job.GasLimit {
+		return job.Budget
+	}
+	return job.GasLimit
+}
+
+// Spend gas from the budget, returning whether the budget is exhausted
+func (job *Job) Spend(gas uint64) bool {
+	if gas >= job.Budget {
+		job.Budget = 0
+		return true
+	}
+	job.Budget -= gas
+	return false
+}
+
+type Reader interface {
+	// Returns the job with the given ID or nil if there is none
+	GetCronJob(id uint64) (*Job, error)
+	// Returns the jobs due to run at the start of the block at height in order of ID
+	GetDueCronJobs(height uint64) ([]*Job, error)
+	// Returns the highest ID assigned to a job so far
+	LastCronJobID() (uint64, error)
+}
+
+type Writer interface {
+	// Updates the job creating it if it does not exist
+	UpdateCronJob(job *Job) error
+	// Remove the job with the given ID
+	RemoveCronJob(id uint64) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	// Iterate over all registered jobs in order of ID
+	IterateCronJobs(consumer func(job *Job) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
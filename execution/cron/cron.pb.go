@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cron.proto
+
+package cron
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A recurring call registered with the Cron native contract that is made at the start of every Interval blocks until
+// its gas budget is exhausted
+type Job struct {
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// The account from which each call is made and which registered the job (or on whose behalf it was registered)
+	Payer github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Payer,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Payer"`
+	// The contract to call
+	Target github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,3,opt,name=Target,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Target"`
+	// The call data
+	Input github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Input,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Input"`
+	// The number of blocks between calls
+	Interval uint64 `protobuf:"varint,5,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	// The most gas any one call may use
+	GasLimit uint64 `protobuf:"varint,6,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	// The gas remaining to be spent on future calls, the job is deregistered once it is exhausted
+	Budget uint64 `protobuf:"varint,7,opt,name=Budget,proto3" json:"Budget,omitempty"`
+	// The height of the block at the start of which the job next runs
+	NextHeight           uint64   `protobuf:"varint,8,opt,name=NextHeight,proto3" json:"NextHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Job) Reset()      { *m = Job{} }
+func (*Job) ProtoMessage() {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21379dc2cd81a5b8, []int{0}
+}
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return m.Size()
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Job) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Job) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Job) GetBudget() uint64 {
+	if m != nil {
+		return m.Budget
+	}
+	return 0
+}
+
+func (m *Job) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (*Job) XXX_MessageName() string {
+	return "cron.Job"
+}
+func init() {
+	proto.RegisterType((*Job)(nil), "cron.Job")
+	golang_proto.RegisterType((*Job)(nil), "cron.Job")
+}
+
+func init() { proto.RegisterFile("cron.proto", fileDescriptor_21379dc2cd81a5b8) }
+func init() { golang_proto.RegisterFile("cron.proto", fileDescriptor_21379dc2cd81a5b8) }
+
+var fileDescriptor_21379dc2cd81a5b8 = []byte{
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x40, 0xeb, 0x34, 0xcd, 0x57, 0x59, 0x9f, 0x18, 0x3c, 0x20, 0xab, 0x83, 0x5b, 0x31, 0x75,
+	0xa0, 0xc9, 0x00, 0x2c, 0x6c, 0x44, 0x15, 0x34, 0xa5, 0x42, 0xa8, 0x62, 0x62, 0xcb, 0xcf, 0x25,
+	0x8d, 0xd4, 0xc6, 0x91, 0x63, 0x43, 0xf3, 0x26, 0x8c, 0x3c, 0x0a, 0x63, 0x47, 0x46, 0xc4, 0x50,
+	0xa1, 0xf4, 0x0d, 0x78, 0x02, 0x14, 0xa7, 0xa0, 0x4e, 0x30, 0xb0, 0xdd, 0x93, 0xa3, 0x1c, 0xe9,
+	0xfa, 0x62, 0x1c, 0x0a, 0x9e, 0xda, 0x99, 0xe0, 0x92, 0x13, 0xb3, 0x9a, 0x3b, 0x83, 0x38, 0x91,
+	0x33, 0x15, 0xd8, 0x21, 0x5f, 0x38, 0x31, 0x8f, 0xb9, 0xa3, 0x65, 0xa0, 0xee, 0x34, 0x69, 0xd0,
+	0x53, 0xfd, 0xd3, 0xc1, 0x87, 0x81, 0x9b, 0x63, 0x1e, 0x90, 0x3d, 0x6c, 0x78, 0x43, 0x8a, 0x7a,
+	0xa8, 0x6f, 0x4e, 0x0d, 0x6f, 0x48, 0xc6, 0xb8, 0x75, 0xed, 0x17, 0x20, 0xa8, 0xd1, 0x43, 0xfd,
+	0xff, 0xee, 0xf1, 0x6a, 0xdd, 0x6d, 0xbc, 0xad, 0xbb, 0x87, 0x3b, 0xf5, 0x59, 0x91, 0x81, 0x98,
+	0x43, 0x14, 0x83, 0x70, 0x02, 0x25, 0x04, 0x7f, 0x70, 0x42, 0x51, 0x64, 0x92, 0xdb, 0x67, 0x51,
+	0x24, 0x20, 0xcf, 0xa7, 0x75, 0x82, 0x4c, 0xb0, 0x75, 0xe3, 0x8b, 0x18, 0x24, 0x6d, 0xfe, 0x21,
+	0xb6, 0x6d, 0x90, 0x4b, 0xdc, 0xf2, 0xd2, 0x4c, 0x49, 0x6a, 0xea, 0xd8, 0xc9, 0x36, 0x36, 0xf8,
+	0x39, 0x16, 0x24, 0xa9, 0x2f, 0x0a, 0x7b, 0x04, 0x4b, 0xb7, 0x90, 0x90, 0x4f, 0xeb, 0x06, 0xe9,
+	0xe0, 0xb6, 0x97, 0x4a, 0x10, 0xf7, 0xfe, 0x9c, 0xb6, 0xf4, 0xf2, 0xdf, 0x5c, 0xb9, 0x0b, 0x3f,
+	0x9f, 0x24, 0x8b, 0x44, 0x52, 0xab, 0x76, 0x5f, 0x4c, 0xf6, 0xb1, 0xe5, 0xaa, 0xa8, 0x5a, 0xe9,
+	0x9f, 0x36, 0x5b, 0x22, 0x0c, 0xe3, 0x2b, 0x58, 0xca, 0x11, 0x24, 0xf1, 0x4c, 0xd2, 0xb6, 0x76,
+	0x3b, 0x5f, 0x4e, 0xcd, 0xc7, 0xa7, 0x6e, 0xc3, 0x3d, 0x5f, 0x95, 0x0c, 0xbd, 0x94, 0x0c, 0xbd,
+	0x96, 0x0c, 0xbd, 0x97, 0x0c, 0x3d, 0x6f, 0x18, 0x5a, 0x6d, 0x18, 0xba, 0xfd, 0xe5, 0x49, 0x60,
+	0x09, 0xa1, 0x92, 0x09, 0x4f, 0x9d, 0xea, 0xd6, 0x81, 0xa5, 0x6f, 0x78, 0xf4, 0x39, 0x00, 0x68,
+	0xd1, 0xa0, 0x29, 0x06, 0x02, 0x00, 0x00,
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Budget != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Budget))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Input.Size()
+		i -= size
+		if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCron(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Target.Size()
+		i -= size
+		if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCron(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Payer.Size()
+		i -= size
+		if _, err := m.Payer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCron(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ID != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCron(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCron(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovCron(uint64(m.ID))
+	}
+	l = m.Payer.Size()
+	n += 1 + l + sovCron(uint64(l))
+	l = m.Target.Size()
+	n += 1 + l + sovCron(uint64(l))
+	l = m.Input.Size()
+	n += 1 + l + sovCron(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovCron(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCron(uint64(m.GasLimit))
+	}
+	if m.Budget != 0 {
+		n += 1 + sovCron(uint64(m.Budget))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovCron(uint64(m.NextHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCron(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCron(x uint64) (n int) {
+	return sovCron(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			m.Budget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Budget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCron(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCron
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCron
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCron
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCron        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCron          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCron = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,7 +2,12 @@ package engine
 
 import (
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/cron"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/escrow"
+	"github.com/hyperledger/burrow/execution/names"
 )
 
 type CallFrame struct {
@@ -16,6 +21,34 @@ type CallFrame struct {
 	callStackDepth uint64
 	// Max call stack depth
 	maxCallStackDepth uint64
+	// Frame that created this one (if any) into which our gas refund is merged on Sync
+	parent *CallFrame
+	// Gas refund accumulated by this frame and any frames it has synced
+	refund uint64
+	// Name registry updates made in this frame (if a registry is available) and where we sync them
+	names        *names.Cache
+	namesBackend names.ReaderWriter
+	// Cron job updates made in this frame (if cron jobs are available) and where we sync them
+	cron        *cron.Cache
+	cronBackend cron.ReaderWriter
+	// Escrow updates made in this frame (if escrows are available) and where we sync them
+	escrows        *escrow.Cache
+	escrowsBackend escrow.ReaderWriter
+	readOnly       bool
+	// Instructions executed by this frame and all others in the same call stack, and the limit on them (if any)
+	instructions    *uint64
+	maxInstructions uint64
+	// Log events emitted by each contract in the call stack and the limits beyond which the Emit permission is needed
+	logs      map[crypto.Address]uint64
+	logLimits LogLimits
+	// Counts the storage reads made in this frame and all others in the same call stack (if set)
+	storageReads *acmstate.StorageReads
+}
+
+// Thresholds on log events beyond which a contract requires the Emit permission (zero means unlimited)
+type LogLimits struct {
+	MaxDataSize uint64
+	MaxTxLogs   uint64
 }
 
 // Create a new CallFrame to hold state updates at a particular level in the call stack
@@ -36,20 +69,160 @@ func newCallFrame(st acmstate.ReaderWriter, stackDepth uint64, maxCallStackDepth
 // Put this CallFrame in permanent read-only mode
 func (st *CallFrame) ReadOnly() *CallFrame {
 	acmstate.ReadOnly(st.Cache)
+	st.readOnly = true
+	return st
+}
+
+// Make the name registry available to this frame and any frames created from it, with updates held and synced or
+// discarded along with account state
+func (st *CallFrame) WithNames(reg names.ReaderWriter) *CallFrame {
+	if reg != nil {
+		st.names = names.NewCache(reg)
+		st.namesBackend = reg
+	}
+	return st
+}
+
+// Returns the name registry as seen from this frame or nil if none is available
+func (st *CallFrame) Names() names.ReaderWriter {
+	if st.names == nil {
+		return nil
+	}
+	if st.readOnly {
+		return readOnlyNames{st.names}
+	}
+	return st.names
+}
+
+// Make cron jobs available to this frame and any frames created from it, with updates held and synced or discarded
+// along with account state
+func (st *CallFrame) WithCron(jobs cron.ReaderWriter) *CallFrame {
+	if jobs != nil {
+		st.cron = cron.NewCache(jobs)
+		st.cronBackend = jobs
+	}
 	return st
 }
 
+// Returns cron jobs as seen from this frame or nil if none are available
+func (st *CallFrame) Cron() cron.ReaderWriter {
+	if st.cron == nil {
+		return nil
+	}
+	if st.readOnly {
+		return readOnlyCron{st.cron}
+	}
+	return st.cron
+}
+
+// Make escrows available to this frame and any frames created from it, with updates held and synced or discarded
+// along with account state
+func (st *CallFrame) WithEscrows(escrows escrow.ReaderWriter) *CallFrame {
+	if escrows != nil {
+		st.escrows = escrow.NewCache(escrows)
+		st.escrowsBackend = escrows
+	}
+	return st
+}
+
+// Returns escrows as seen from this frame or nil if none are available
+func (st *CallFrame) Escrows() escrow.ReaderWriter {
+	if st.escrows == nil {
+		return nil
+	}
+	if st.readOnly {
+		return readOnlyEscrows{st.escrows}
+	}
+	return st.escrows
+}
+
 func (st *CallFrame) WithMaxCallStackDepth(max uint64) *CallFrame {
 	st.maxCallStackDepth = max
 	return st
 }
 
+// Limit the total number of instructions that may be executed by this frame and any frames created from it (zero
+// means unlimited)
+func (st *CallFrame) WithMaxInstructions(max uint64) *CallFrame {
+	st.instructions = new(uint64)
+	st.maxInstructions = max
+	return st
+}
+
+// Set the thresholds on log events emitted in this frame and any frames created from it beyond which a contract
+// requires the Emit permission
+func (st *CallFrame) WithLogLimits(limits LogLimits) *CallFrame {
+	st.logs = make(map[crypto.Address]uint64)
+	st.logLimits = limits
+	return st
+}
+
+// Count the storage reads made in this frame and any frames created from it
+func (st *CallFrame) WithStorageReads(reads *acmstate.StorageReads) *CallFrame {
+	st.storageReads = reads
+	return st
+}
+
+func (st *CallFrame) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	st.storageReads.Read()
+	return st.Cache.GetStorage(address, key)
+}
+
+// Count a log event emitted by address with dataSize bytes of data, returning whether it exceeds the limits on log
+// events (in which case the emitting contract requires the Emit permission)
+func (st *CallFrame) UseLog(address crypto.Address, dataSize uint64) bool {
+	if st.logs == nil {
+		return false
+	}
+	st.logs[address]++
+	return st.logLimits.MaxDataSize > 0 && dataSize > st.logLimits.MaxDataSize ||
+		st.logLimits.MaxTxLogs > 0 && st.logs[address] > st.logLimits.MaxTxLogs
+}
+
+// Count an instruction against the limit shared by all frames in the call stack, returning an error if the limit has
+// been exceeded
+func (st *CallFrame) UseInstruction() error {
+	if st.instructions == nil {
+		return nil
+	}
+	*st.instructions++
+	if st.maxInstructions > 0 && *st.instructions > st.maxInstructions {
+		return errors.Errorf(errors.Codes.InstructionLimit, "exceeded limit of %d instructions", st.maxInstructions)
+	}
+	return nil
+}
+
+// The number of instructions executed so far in this call stack
+func (st *CallFrame) Instructions() uint64 {
+	if st.instructions == nil {
+		return 0
+	}
+	return *st.instructions
+}
+
 func (st *CallFrame) NewFrame(cacheOptions ...acmstate.CacheOption) (*CallFrame, error) {
 	if st.maxCallStackDepth > 0 && st.maxCallStackDepth == st.callStackDepth {
 		return nil, errors.Codes.CallStackOverflow
 	}
-	return newCallFrame(st.Cache, st.callStackDepth+1, st.maxCallStackDepth,
-		append(st.cacheOptions, cacheOptions...)...), nil
+	frame := newCallFrame(st.Cache, st.callStackDepth+1, st.maxCallStackDepth,
+		append(st.cacheOptions, cacheOptions...)...)
+	frame.parent = st
+	frame.readOnly = st.readOnly
+	frame.instructions = st.instructions
+	frame.maxInstructions = st.maxInstructions
+	frame.logs = st.logs
+	frame.logLimits = st.logLimits
+	frame.storageReads = st.storageReads
+	if st.names != nil {
+		frame.WithNames(st.names)
+	}
+	if st.cron != nil {
+		frame.WithCron(st.cron)
+	}
+	if st.escrows != nil {
+		frame.WithEscrows(st.escrows)
+	}
+	return frame, nil
 }
 
 func (st *CallFrame) Sync() error {
@@ -57,9 +230,78 @@ func (st *CallFrame) Sync() error {
 	if err != nil {
 		return errors.AsException(err)
 	}
+	if st.names != nil {
+		err = st.names.Sync(st.namesBackend)
+		if err != nil {
+			return errors.AsException(err)
+		}
+	}
+	if st.cron != nil {
+		err = st.cron.Sync(st.cronBackend)
+		if err != nil {
+			return errors.AsException(err)
+		}
+	}
+	if st.escrows != nil {
+		err = st.escrows.Sync(st.escrowsBackend)
+		if err != nil {
+			return errors.AsException(err)
+		}
+	}
+	// Refunds only survive if the frame's state changes do
+	if st.parent != nil {
+		st.parent.refund += st.refund
+		st.refund = 0
+	}
 	return nil
 }
 
+// Add to the gas refund counter for this frame
+func (st *CallFrame) AddRefund(gas uint64) {
+	st.refund += gas
+}
+
+// The gas refund accumulated by this frame
+func (st *CallFrame) Refund() uint64 {
+	return st.refund
+}
+
 func (st *CallFrame) CallStackDepth() uint64 {
 	return st.callStackDepth
 }
+
+type readOnlyNames struct {
+	names.Reader
+}
+
+func (rn readOnlyNames) UpdateName(entry *names.Entry) error {
+	return errors.Errorf(errors.Codes.IllegalWrite, "UpdateName called in a read-only context on name %s", entry.Name)
+}
+
+func (rn readOnlyNames) RemoveName(name string) error {
+	return errors.Errorf(errors.Codes.IllegalWrite, "RemoveName called in a read-only context on name %s", name)
+}
+
+type readOnlyCron struct {
+	cron.Reader
+}
+
+func (rc readOnlyCron) UpdateCronJob(job *cron.Job) error {
+	return errors.Errorf(errors.Codes.IllegalWrite, "UpdateCronJob called in a read-only context on job %d", job.ID)
+}
+
+func (rc readOnlyCron) RemoveCronJob(id uint64) error {
+	return errors.Errorf(errors.Codes.IllegalWrite, "RemoveCronJob called in a read-only context on job %d", id)
+}
+
+type readOnlyEscrows struct {
+	escrow.Reader
+}
+
+func (re readOnlyEscrows) UpdateEscrow(esc *escrow.Escrow) error {
+	return errors.Errorf(errors.Codes.IllegalWrite, "UpdateEscrow called in a read-only context on escrow %d", esc.ID)
+}
+
+func (re readOnlyEscrows) RemoveEscrow(id uint64) error {
+	return errors.Errorf(errors.Codes.IllegalWrite, "RemoveEscrow called in a read-only context on escrow %d", id)
+}
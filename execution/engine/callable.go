@@ -3,6 +3,7 @@ package engine
 import (
 	"time"
 
+	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
 )
@@ -34,3 +35,9 @@ type CallableFunc func(st State, params CallParams) (output []byte, err error)
 func (c CallableFunc) Call(state State, params CallParams) (output []byte, err error) {
 	return c(state, params)
 }
+
+// A Blockchain that can also provide the validator set in force for the block being executed
+type ValidatorsBlockchain interface {
+	Blockchain
+	Validators() validator.IterableReader
+}
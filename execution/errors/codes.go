@@ -49,6 +49,11 @@ type codes struct {
 	UnresolvedSymbols      *Code
 	InvalidContractCode    *Code
 	NonExistentAccount     *Code
+	BlockGasLimitExceeded  *Code
+	CircuitBreakerTripped  *Code
+	InstructionLimit       *Code
+	InsufficientFee        *Code
+	ContractPaused         *Code
 
 	// For lookup
 	codes []*Code
@@ -64,6 +64,11 @@ var Codes = codes{
 	UnresolvedSymbols:      code("code has unresolved symbols"),
 	InvalidContractCode:    code("contract being created with unexpected code"),
 	NonExistentAccount:     code("account does not exist"),
+	BlockGasLimitExceeded:  code("transaction gas limit exceeds the gas remaining in the block"),
+	CircuitBreakerTripped:  code("transaction rejected while the execution circuit breaker is tripped"),
+	InstructionLimit:       code("transaction exceeded the maximum number of instructions it may execute"),
+	InsufficientFee:        code("transaction fee is below the minimum fee"),
+	ContractPaused:         code("contract is paused"),
 }
 
 func init() {
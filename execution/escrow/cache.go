@@ -0,0 +1,157 @@
+package escrow
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Cache accumulates changes to escrows so that they can be written to a backend Writer via Sync or discarded
+type Cache struct {
+	sync.RWMutex
+	backend Reader
+	escrows map[uint64]*escrowInfo
+	// The highest ID assigned by this cache (or zero if it has assigned none)
+	lastID uint64
+}
+
+type escrowInfo struct {
+	escrow  *Escrow
+	removed bool
+	updated bool
+}
+
+var _ ReaderWriter = &Cache{}
+
+// Returns a Cache that wraps backend for reads and can write to an output Writer via Sync
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend: backend,
+		escrows: make(map[uint64]*escrowInfo),
+	}
+}
+
+func (cache *Cache) GetEscrow(id uint64) (*Escrow, error) {
+	info, err := cache.get(id)
+	if err != nil {
+		return nil, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if info.removed {
+		return nil, nil
+	}
+	return info.escrow, nil
+}
+
+func (cache *Cache) LastEscrowID() (uint64, error) {
+	id, err := cache.backend.LastEscrowID()
+	if err != nil {
+		return 0, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if cache.lastID > id {
+		return cache.lastID, nil
+	}
+	return id, nil
+}
+
+func (cache *Cache) UpdateEscrow(esc *Escrow) error {
+	if esc.ID == 0 {
+		return fmt.Errorf("UpdateEscrow passed escrow without an ID")
+	}
+	info, err := cache.get(esc.ID)
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if info.removed {
+		return fmt.Errorf("UpdateEscrow on a removed escrow: %d", esc.ID)
+	}
+	info.escrow = esc
+	info.updated = true
+	if esc.ID > cache.lastID {
+		cache.lastID = esc.ID
+	}
+	return nil
+}
+
+func (cache *Cache) RemoveEscrow(id uint64) error {
+	info, err := cache.get(id)
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if info.removed {
+		return fmt.Errorf("RemoveEscrow on a removed escrow: %d", id)
+	}
+	info.removed = true
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	ids := make([]uint64, 0, len(cache.escrows))
+	for id := range cache.escrows {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		info := cache.escrows[id]
+		if info.removed {
+			if info.escrow == nil {
+				// Never existed beyond this cache
+				continue
+			}
+			err := state.RemoveEscrow(id)
+			if err != nil {
+				return err
+			}
+		} else if info.updated {
+			err := state.UpdateEscrow(info.escrow)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.escrows = make(map[uint64]*escrowInfo)
+	cache.lastID = 0
+}
+
+// Get the cache escrowInfo item creating it if necessary
+func (cache *Cache) get(id uint64) (*escrowInfo, error) {
+	cache.RLock()
+	info := cache.escrows[id]
+	cache.RUnlock()
+	if info == nil {
+		cache.Lock()
+		defer cache.Unlock()
+		info = cache.escrows[id]
+		if info == nil {
+			esc, err := cache.backend.GetEscrow(id)
+			if err != nil {
+				return nil, err
+			}
+			info = &escrowInfo{
+				escrow: esc,
+			}
+			cache.escrows[id] = info
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,49 @@
+package escrow
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/event/query"
+)
+
+// The longest dispute window an escrow may have
+const MaxDisputeWindow uint64 = 1 << 32
+
+func (esc *Escrow) String() string {
+	return fmt.Sprintf("Escrow{ID: %d; Depositor: %v; Payee: %v; Arbiter: %v; Amount: %d; ReleaseHeight: %d; Disputed: %t}",
+		esc.ID, esc.Depositor, esc.Payee, esc.Arbiter, esc.Amount, esc.ReleaseHeight, esc.Disputed)
+}
+
+func (esc *Escrow) Get(key string) (value interface{}, ok bool) {
+	return query.GetReflect(reflect.ValueOf(esc), key)
+}
+
+type Reader interface {
+	// Returns the escrow with the given ID or nil if there is none
+	GetEscrow(id uint64) (*Escrow, error)
+	// Returns the highest ID assigned to an escrow so far
+	LastEscrowID() (uint64, error)
+}
+
+type Writer interface {
+	// Updates the escrow creating it if it does not exist
+	UpdateEscrow(esc *Escrow) error
+	// Remove the escrow with the given ID
+	RemoveEscrow(id uint64) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	// Iterate over all open escrows in order of ID
+	IterateEscrows(consumer func(esc *Escrow) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
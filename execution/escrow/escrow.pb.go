@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: escrow.proto
+
+package escrow
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Value held by the Escrow native contract on behalf of a depositor until it is released to the payee or refunded
+type Escrow struct {
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// The account that deposited the value and to which it is refunded
+	Depositor github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Depositor,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Depositor"`
+	// The account to which the value is released
+	Payee github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,3,opt,name=Payee,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Payee"`
+	// The account that may release or refund the value at any time, including once it is disputed
+	Arbiter github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,opt,name=Arbiter,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Arbiter"`
+	// The value held
+	Amount uint64 `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// The height from which the payee may claim the value if the depositor has not disputed it
+	ReleaseHeight uint64 `protobuf:"varint,6,opt,name=ReleaseHeight,proto3" json:"ReleaseHeight,omitempty"`
+	// Whether the depositor has disputed the escrow, after which the payee may no longer claim it
+	Disputed             bool     `protobuf:"varint,7,opt,name=Disputed,proto3" json:"Disputed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Escrow) Reset()      { *m = Escrow{} }
+func (*Escrow) ProtoMessage() {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{0}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Escrow) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Escrow) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *Escrow) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
+func (*Escrow) XXX_MessageName() string {
+	return "escrow.Escrow"
+}
+func init() {
+	proto.RegisterType((*Escrow)(nil), "escrow.Escrow")
+	golang_proto.RegisterType((*Escrow)(nil), "escrow.Escrow")
+}
+
+func init() { proto.RegisterFile("escrow.proto", fileDescriptor_89c81597814471f3) }
+func init() { golang_proto.RegisterFile("escrow.proto", fileDescriptor_89c81597814471f3) }
+
+var fileDescriptor_89c81597814471f3 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xbd, 0x4e, 0x32, 0x41,
+	0x14, 0x86, 0x99, 0xfd, 0x60, 0xe1, 0x9b, 0xa0, 0xc5, 0x14, 0x66, 0x42, 0x31, 0x10, 0x63, 0x41,
+	0xa1, 0xbb, 0x85, 0x56, 0x76, 0x90, 0x35, 0x01, 0x0b, 0x63, 0xb6, 0xb4, 0x63, 0x77, 0x8f, 0xcb,
+	0x24, 0xc0, 0xd9, 0xcc, 0x4f, 0x90, 0x3b, 0xb1, 0xf4, 0x52, 0x2c, 0x29, 0x2d, 0x8d, 0x05, 0x31,
+	0x4b, 0xe3, 0x65, 0x98, 0xcc, 0xfa, 0xdb, 0xd8, 0xd0, 0xcd, 0x33, 0x27, 0xef, 0x33, 0x93, 0xf3,
+	0xd2, 0x36, 0xe8, 0x54, 0xe1, 0x32, 0x28, 0x14, 0x1a, 0x64, 0x7e, 0x45, 0x9d, 0x93, 0x5c, 0x9a,
+	0xa9, 0x4d, 0x82, 0x14, 0xe7, 0x61, 0x8e, 0x39, 0x86, 0x6e, 0x9c, 0xd8, 0x5b, 0x47, 0x0e, 0xdc,
+	0xa9, 0x8a, 0x1d, 0xbe, 0x79, 0xd4, 0xbf, 0x70, 0x49, 0xb6, 0x4f, 0xbd, 0x71, 0xc4, 0x49, 0x8f,
+	0xf4, 0xeb, 0xb1, 0x37, 0x8e, 0x58, 0x4c, 0xff, 0x47, 0x50, 0xa0, 0x96, 0x06, 0x15, 0xf7, 0x7a,
+	0xa4, 0xdf, 0x1e, 0x9e, 0xad, 0x37, 0xdd, 0xda, 0xcb, 0xa6, 0x7b, 0xfc, 0xe3, 0x91, 0xe9, 0xaa,
+	0x00, 0x35, 0x83, 0x2c, 0x07, 0x15, 0x26, 0x56, 0x29, 0x5c, 0x86, 0xa9, 0x5a, 0x15, 0x06, 0x83,
+	0x41, 0x96, 0x29, 0xd0, 0x3a, 0xfe, 0xd6, 0xb0, 0x4b, 0xda, 0xb8, 0x9e, 0xac, 0x00, 0xf8, 0xbf,
+	0x1d, 0x7c, 0x95, 0x82, 0x5d, 0xd1, 0xe6, 0x40, 0x25, 0xd2, 0x80, 0xe2, 0xf5, 0x1d, 0x6c, 0x9f,
+	0x12, 0x76, 0x40, 0xfd, 0xc1, 0x1c, 0xed, 0xc2, 0xf0, 0x86, 0xdb, 0xc1, 0x07, 0xb1, 0x23, 0xba,
+	0x17, 0xc3, 0x0c, 0x26, 0x1a, 0x46, 0x20, 0xf3, 0xa9, 0xe1, 0xbe, 0x1b, 0xff, 0xbe, 0x64, 0x1d,
+	0xda, 0x8a, 0xa4, 0x2e, 0xac, 0x81, 0x8c, 0x37, 0x7b, 0xa4, 0xdf, 0x8a, 0xbf, 0xf8, 0xbc, 0x7e,
+	0xff, 0xd0, 0xad, 0x0d, 0x47, 0xeb, 0x52, 0x90, 0xa7, 0x52, 0x90, 0xe7, 0x52, 0x90, 0xd7, 0x52,
+	0x90, 0xc7, 0xad, 0x20, 0xeb, 0xad, 0x20, 0x37, 0xc1, 0xdf, 0x1f, 0x86, 0x3b, 0x48, 0xad, 0x91,
+	0xb8, 0x08, 0xab, 0x8e, 0x13, 0xdf, 0x75, 0x77, 0xfa, 0x3e, 0x00, 0x35, 0xb6, 0x01, 0x2c, 0x02,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Disputed {
+		i--
+		if m.Disputed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Arbiter.Size()
+		i -= size
+		if _, err := m.Arbiter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Payee.Size()
+		i -= size
+		if _, err := m.Payee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Depositor.Size()
+		i -= size
+		if _, err := m.Depositor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ID != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovEscrow(uint64(m.ID))
+	}
+	l = m.Depositor.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.Payee.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.Arbiter.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovEscrow(uint64(m.Amount))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovEscrow(uint64(m.ReleaseHeight))
+	}
+	if m.Disputed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Depositor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arbiter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disputed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrow = fmt.Errorf("proto: unexpected end of group")
+)
@@ -203,6 +203,24 @@ func SpecFromStructReflect(fname string, args reflect.Type, rets reflect.Type) *
 	return NewFunctionSpec(fname, inputs, outputs)
 }
 
+// EventSpecFromStructReflect generates an EventSpec named name whose fields are described by the struct type fields. A
+// field is emitted as a topic if it is tagged with `abi:"indexed"`
+func EventSpecFromStructReflect(name string, fields reflect.Type) *EventSpec {
+	inputs := make([]Argument, fields.NumField())
+	for i := range inputs {
+		f := fields.Field(i)
+		a := typeFromReflect(f.Type)
+		a.Name = f.Name
+		a.Indexed = f.Tag.Get("abi") == "indexed"
+		inputs[i] = a
+	}
+	return &EventSpec{
+		ID:     GetEventID(Signature(name, inputs)),
+		Inputs: inputs,
+		Name:   name,
+	}
+}
+
 func SpecFromFunctionReflect(fname string, v reflect.Value, skipIn, skipOut int) *FunctionSpec {
 	t := v.Type()
 
@@ -242,11 +260,17 @@ func GetPackingTypes(args []Argument) []interface{} {
 func typeFromReflect(v reflect.Type) Argument {
 	arg := Argument{Name: v.Name()}
 
-	if v == reflect.TypeOf(crypto.Address{}) {
-		arg.EVM = EVMAddress{}
-	} else if v == reflect.TypeOf(big.Int{}) {
-		arg.EVM = EVMInt{M: 256}
-	} else {
+	if v.Kind() == reflect.Array && v.Elem().Kind() == reflect.Uint8 && v.Len() <= ElementSize &&
+		v != reflect.TypeOf(crypto.Address{}) {
+		arg.EVM = EVMBytes{M: uint64(v.Len())}
+		return arg
+	}
+	if v.Kind() == reflect.Slice && v.Elem().Kind() == reflect.Uint8 {
+		arg.EVM = EVMBytes{}
+		return arg
+	}
+
+	if v != reflect.TypeOf(crypto.Address{}) {
 		if v.Kind() == reflect.Array {
 			arg.IsArray = true
 			arg.ArrayLength = uint64(v.Len())
@@ -255,7 +279,13 @@ func typeFromReflect(v reflect.Type) Argument {
 			arg.IsArray = true
 			v = v.Elem()
 		}
+	}
 
+	if v == reflect.TypeOf(crypto.Address{}) {
+		arg.EVM = EVMAddress{}
+	} else if v == reflect.TypeOf(big.Int{}) {
+		arg.EVM = EVMInt{M: 256}
+	} else {
 		switch v.Kind() {
 		case reflect.Bool:
 			arg.EVM = EVMBool{}
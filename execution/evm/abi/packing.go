@@ -2,6 +2,7 @@ package abi
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 
@@ -52,11 +53,53 @@ func UnpackEvent(eventSpec *EventSpec, topics []binary.Word256, data []byte, arg
 // UnpackRevert decodes the revert reason if a contract called revert. If no
 // reason was given, message will be nil else it will point to the string
 func UnpackRevert(data []byte) (message *string, err error) {
-	if len(data) > 0 {
-		var msg string
-		err = revertAbi.UnpackWithID(data, &msg)
-		message = &msg
+	return UnpackRevertWithSpec(nil, data)
+}
+
+// UnpackRevertWithSpec decodes the revert reason like UnpackRevert but also describes the panics raised by failed
+// asserts and arithmetic errors from solc 0.8 and any custom errors declared in spec, which may be nil, in the form
+// Name(arg, ...)
+func UnpackRevertWithSpec(spec *Spec, data []byte) (message *string, err error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var id FunctionID
+	copy(id[:], data)
+	if id == panicSpec.FunctionID {
+		var code uint64
+		err = Unpack(panicSpec.Inputs, data[FunctionIDSize:], &code)
+		if err != nil {
+			return nil, err
+		}
+		msg := fmt.Sprintf("Panic(0x%x)", code)
+		if reason, ok := panicReasons[code]; ok {
+			msg += ": " + reason
+		}
+		return &msg, nil
+	}
+	if spec != nil {
+		if errorSpec, ok := spec.ErrorsByID[id]; ok {
+			vals := make([]interface{}, len(errorSpec.Inputs))
+			for i := range vals {
+				vals[i] = new(string)
+			}
+			if len(vals) > 0 {
+				err = Unpack(errorSpec.Inputs, data[FunctionIDSize:], vals...)
+				if err != nil {
+					return nil, err
+				}
+			}
+			args := make([]string, len(vals))
+			for i, val := range vals {
+				args[i] = *(val.(*string))
+			}
+			msg := errorSpec.Name + "(" + strings.Join(args, ", ") + ")"
+			return &msg, nil
+		}
 	}
+	var msg string
+	err = revertAbi.UnpackWithID(data, &msg)
+	message = &msg
 	return
 }
 
@@ -64,6 +107,22 @@ func UnpackRevert(data []byte) (message *string, err error) {
 // If a function exits this way, the this hardcoded ABI will be used.
 var revertAbi *Spec
 
+// The error raised by solc 0.8 for failed asserts and arithmetic errors, see
+// https://docs.soliditylang.org/en/v0.8.0/control-structures.html#panic-via-assert-and-error-via-require
+var panicSpec = NewFunctionSpec("Panic", []Argument{{EVM: EVMUint{M: 256}}}, nil)
+
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialised internal function",
+}
+
 func init() {
 	var err error
 	revertAbi, err = ReadSpec([]byte(`[{"name":"Error","type":"function","outputs":[{"type":"string"}],"inputs":[{"type":"string"}]}]`))
@@ -72,6 +131,8 @@ func init() {
 	}
 }
 
+var bigIntType = reflect.TypeOf(big.Int{})
+
 func argGetter(argSpec []Argument, args []interface{}, ptr bool) (func(int) interface{}, error) {
 	if len(args) == 1 {
 		rv := reflect.ValueOf(args[0])
@@ -80,7 +141,8 @@ func argGetter(argSpec []Argument, args []interface{}, ptr bool) (func(int) inte
 		} else if ptr {
 			return nil, fmt.Errorf("struct pointer required in order to set values, but got %v", rv.Kind())
 		}
-		if rv.Kind() != reflect.Struct {
+		// A big.Int is a struct but is a single value rather than a struct of values
+		if rv.Kind() != reflect.Struct || rv.Type() == bigIntType {
 			if len(args) == 1 {
 				// Treat s single arg
 				return func(i int) interface{} { return args[i] }, nil
@@ -223,18 +285,20 @@ func pack(argSpec []Argument, getArg func(int) interface{}) ([]byte, error) {
 				offset := EVMUint{M: 256}
 				b, _ := offset.pack(fixedSize)
 				packed = append(packed, b...)
-				fixedSize += len(b)
 
 				// store length
 				b, _ = offset.pack(val.Len())
-				packedDynamic = append(packedDynamic, b...)
+				d := b
 				for n := 0; n < val.Len(); n++ {
-					d, err := as.EVM.pack(val.Index(n).Interface())
+					e, err := as.EVM.pack(val.Index(n).Interface())
 					if err != nil {
 						return nil, err
 					}
-					packedDynamic = append(packedDynamic, d...)
+					d = append(d, e...)
 				}
+				// Subsequent dynamic data follows this array
+				fixedSize += len(d)
+				packedDynamic = append(packedDynamic, d...)
 			}
 		} else {
 			err := addArg(a, as)
@@ -347,6 +411,9 @@ func unpack(argSpec []Argument, data []byte, getArg func(int) interface{}) error
 					o += int64(l)
 				}
 
+				if target, ok := arg.(*[]interface{}); ok {
+					*target = intermediate
+				}
 				array = &intermediate
 			}
 
@@ -97,3 +97,41 @@ func splat(v interface{}) []interface{} {
 
 	return vals
 }
+
+func TestUnpackRevertWithSpec(t *testing.T) {
+	spec, err := ReadSpec([]byte(`[{"inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"},{"inputs":[],"name":"Unauthorized","type":"error"}]`))
+	require.NoError(t, err)
+
+	insufficientBalance := spec.ErrorsByID[GetFunctionID("InsufficientBalance(uint256,uint256)")]
+	require.NotNil(t, insufficientBalance)
+	data, err := Pack(insufficientBalance.Inputs, 100, 200)
+	require.NoError(t, err)
+	message, err := UnpackRevertWithSpec(spec, append(insufficientBalance.FunctionID[:], data...))
+	require.NoError(t, err)
+	assert.Equal(t, "InsufficientBalance(100, 200)", *message)
+
+	id := GetFunctionID("Unauthorized()")
+	message, err = UnpackRevertWithSpec(spec, id[:])
+	require.NoError(t, err)
+	assert.Equal(t, "Unauthorized()", *message)
+
+	// Without the spec a custom error cannot be decoded
+	_, err = UnpackRevert(id[:])
+	require.Error(t, err)
+
+	data, err = Pack(panicSpec.Inputs, uint64(0x11))
+	require.NoError(t, err)
+	message, err = UnpackRevert(append(panicSpec.FunctionID[:], data...))
+	require.NoError(t, err)
+	assert.Equal(t, "Panic(0x11): arithmetic overflow or underflow", *message)
+
+	data, _, err = revertAbi.Pack("Error", "not allowed")
+	require.NoError(t, err)
+	message, err = UnpackRevertWithSpec(spec, data)
+	require.NoError(t, err)
+	assert.Equal(t, "not allowed", *message)
+
+	message, err = UnpackRevertWithSpec(spec, nil)
+	require.NoError(t, err)
+	assert.Nil(t, message)
+}
@@ -145,6 +145,15 @@ func (e EVMUint) pack(v interface{}) ([]byte, error) {
 
 	arg := reflect.ValueOf(v)
 	switch arg.Kind() {
+	case reflect.Ptr:
+		b, ok := v.(*big.Int)
+		if !ok {
+			return nil, fmt.Errorf("cannot convert type %T to uint%d", v, e.M)
+		}
+		if b.Sign() < 0 {
+			return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
+		}
+		n.Set(b)
 	case reflect.String:
 		_, ok := n.SetString(arg.String(), 0)
 		if !ok {
@@ -589,6 +598,9 @@ func (e EVMBytes) pack(v interface{}) ([]byte, error) {
 		s, ok := v.(string)
 		if ok {
 			b = []byte(s)
+		} else if rv := reflect.ValueOf(v); rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+			b = make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
 		} else {
 			return nil, fmt.Errorf("cannot map from %s to EVM bytes", reflect.ValueOf(v).Kind().String())
 		}
@@ -638,7 +650,7 @@ func (e EVMBytes) unpack(data []byte, offset int, v interface{}) (int, error) {
 		}
 		v2.SetString(string(data[offset+start : offset+end]))
 	case reflect.Array:
-		fallthrough
+		reflect.Copy(v2, reflect.ValueOf(data[offset:offset+int(e.M)]))
 	case reflect.Slice:
 		v2.SetBytes(data[offset : offset+int(e.M)])
 	default:
@@ -20,6 +20,8 @@ type Spec struct {
 	Functions    map[string]*FunctionSpec
 	EventsByName map[string]*EventSpec
 	EventsByID   map[EventID]*EventSpec
+	// Custom errors (from solc 0.8.4) by their selector, the inputs of each being the error's parameters
+	ErrorsByID map[FunctionID]*FunctionSpec
 }
 
 type specJSON struct {
@@ -41,6 +43,7 @@ func NewSpec() *Spec {
 		EventsByName: make(map[string]*EventSpec),
 		EventsByID:   make(map[EventID]*EventSpec),
 		Functions:    make(map[string]*FunctionSpec),
+		ErrorsByID:   make(map[FunctionID]*FunctionSpec),
 	}
 }
 
@@ -92,6 +95,13 @@ func ReadSpec(specBytes []byte) (*Spec, error) {
 				return nil, err
 			}
 			abiSpec.Functions[s.Name] = NewFunctionSpec(s.Name, inputs, outputs).SetConstant()
+		case "error":
+			inputs, err := readArgSpec(s.Inputs)
+			if err != nil {
+				return nil, err
+			}
+			errorSpec := NewFunctionSpec(s.Name, inputs, nil)
+			abiSpec.ErrorsByID[errorSpec.FunctionID] = errorSpec
 		}
 	}
 
@@ -115,6 +125,10 @@ func MergeSpec(abiSpec []*Spec) *Spec {
 			newSpec.EventsByName[e.Name] = e
 			newSpec.EventsByID[e.ID] = e
 		}
+
+		for id, e := range s.ErrorsByID {
+			newSpec.ErrorsByID[id] = e
+		}
 	}
 
 	return newSpec
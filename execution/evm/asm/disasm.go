@@ -0,0 +1,162 @@
+package asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Instruction is a single disassembled EVM instruction
+type Instruction struct {
+	// Offset of the instruction in the code
+	PC     int
+	OpCode OpCode
+	// The bytes pushed by a PUSH<N>
+	Immediate []byte
+	// Notes on the instruction's role, e.g. a jump's destination or a dispatched function selector
+	Annotation string
+	// The label of a JUMPDEST by which jumps to it are annotated
+	Label string
+}
+
+// FunctionSelector is an entry in the table by which a Solidity contract dispatches calls to its public functions
+type FunctionSelector struct {
+	Selector []byte
+	// Offset of the JUMPDEST to which calls with the selector are dispatched
+	Destination int
+	// The label of that JUMPDEST
+	Label string
+}
+
+func (ins Instruction) String() string {
+	str := fmt.Sprintf("%06x  %s", ins.PC, ins.OpCode.Name())
+	if len(ins.Immediate) > 0 {
+		str += fmt.Sprintf(" 0x%x", ins.Immediate)
+	}
+	if ins.Annotation != "" {
+		str += "  ; " + ins.Annotation
+	}
+	return str
+}
+
+// Disassemble returns the instructions of EVM code and any Solidity metadata appended to it (which is data rather
+// than code so is not disassembled). Instructions are annotated where their role can be determined from their
+// immediate context.
+func Disassemble(code []byte) ([]Instruction, []byte) {
+	var metadata []byte
+	if start := SolidityMetadataStart(code); start < len(code) {
+		code, metadata = code[:start], code[start:]
+	}
+	var instructions []Instruction
+	jumpdests := make(map[int]bool)
+	for pc := 0; pc < len(code); pc++ {
+		op := OpCode(code[pc])
+		ins := Instruction{PC: pc, OpCode: op}
+		if n := op.Pushes(); n > 0 {
+			end := pc + 1 + n
+			if end > len(code) {
+				end = len(code)
+				ins.Annotation = fmt.Sprintf("truncated, pushes %d of %d bytes", end-pc-1, n)
+			}
+			ins.Immediate = code[pc+1 : end]
+			pc = end - 1
+		} else if _, ok := GetOpCode(code[pc]); !ok {
+			ins.Annotation = "invalid opcode"
+		} else if op == JUMPDEST {
+			jumpdests[pc] = true
+		}
+		instructions = append(instructions, ins)
+	}
+	labels := make(map[int]string)
+	for i := range instructions {
+		if ins := &instructions[i]; jumpdests[ins.PC] {
+			ins.Label = fmt.Sprintf("tag_%d", len(labels)+1)
+			labels[ins.PC] = ins.Label
+		}
+	}
+	for i := 1; i < len(instructions); i++ {
+		ins, prev := &instructions[i], instructions[i-1]
+		switch {
+		case (ins.OpCode == JUMP || ins.OpCode == JUMPI) && prev.OpCode.Pushes() > 0:
+			dest := immediateInt(prev.Immediate)
+			if label, ok := labels[dest]; ok {
+				ins.Annotation = "to " + label
+			} else {
+				ins.Annotation = fmt.Sprintf("to %06x which is not a JUMPDEST", dest)
+			}
+		case ins.OpCode == EQ && prev.OpCode == PUSH4 && prev.Annotation == "":
+			instructions[i-1].Annotation = "function selector"
+		}
+	}
+	return instructions, metadata
+}
+
+// FunctionSelectors returns the dispatch table of a Solidity contract from its disassembled code, found from the
+// sequences PUSH4 <selector> [DUP<N>] EQ PUSH<N> <destination> JUMPI by which it compares a call's selector against those
+// of its functions. Entries are in the order in which they are compared.
+func FunctionSelectors(instructions []Instruction) []FunctionSelector {
+	var selectors []FunctionSelector
+	for i := 0; i < len(instructions); i++ {
+		if instructions[i].OpCode != PUSH4 || len(instructions[i].Immediate) != 4 {
+			continue
+		}
+		j := i + 1
+		if j < len(instructions) && instructions[j].OpCode >= DUP1 && instructions[j].OpCode <= DUP16 {
+			j++
+		}
+		if j+2 >= len(instructions) || instructions[j].OpCode != EQ || instructions[j+1].OpCode.Pushes() == 0 ||
+			instructions[j+2].OpCode != JUMPI {
+			continue
+		}
+		dest := immediateInt(instructions[j+1].Immediate)
+		for _, ins := range instructions {
+			if ins.PC == dest && ins.Label != "" {
+				selectors = append(selectors, FunctionSelector{
+					Selector:    instructions[i].Immediate,
+					Destination: dest,
+					Label:       ins.Label,
+				})
+				break
+			}
+		}
+	}
+	return selectors
+}
+
+// Returns the annotated assembly of code, one instruction per line, with each JUMPDEST preceded by its label
+func DisassembleString(code []byte) string {
+	instructions, metadata := Disassemble(code)
+	lines := make([]string, 0, len(instructions)+1)
+	for _, ins := range instructions {
+		if ins.Label != "" {
+			lines = append(lines, ins.Label+":")
+		}
+		lines = append(lines, ins.String())
+	}
+	if len(metadata) > 0 {
+		lines = append(lines, fmt.Sprintf("%06x  ; Solidity metadata 0x%x", len(code)-len(metadata), metadata))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func immediateInt(immediate []byte) int {
+	n := 0
+	for _, b := range immediate {
+		n = n<<8 | int(b)
+	}
+	return n
+}
+
+// Solidity appends a CBOR encoded map containing the hash of the contract's metadata to runtime code, followed by the
+// two byte big-endian length of that map. Returns the offset at which this begins, or len(code) if it is absent.
+func SolidityMetadataStart(code []byte) int {
+	if len(code) < 2 {
+		return len(code)
+	}
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - length
+	// CBOR maps with fewer than 24 entries have major type 5 (0xa0 - 0xb7) in their first byte
+	if length == 0 || start < 0 || code[start] < 0xa0 || code[start] > 0xb7 {
+		return len(code)
+	}
+	return start
+}
@@ -0,0 +1,54 @@
+package asm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisassemble(t *testing.T) {
+	code := []byte{
+		byte(PUSH1), 0x80, byte(PUSH1), 0x40, byte(MSTORE),
+		byte(DUP1), byte(PUSH4), 0xa9, 0x05, 0x9c, 0xbb, byte(EQ), byte(PUSH1), 0x13, byte(JUMPI),
+		byte(PUSH1), 0x02, byte(JUMP), 0x0c,
+		byte(JUMPDEST), byte(STOP),
+		byte(PUSH2), 0x01,
+		// Metadata
+		0xa1, 0x00, 0x01,
+	}
+	assert.Equal(t, `000000  PUSH1 0x80
+000002  PUSH1 0x40
+000004  MSTORE
+000005  DUP1
+000006  PUSH4 0xa9059cbb  ; function selector
+00000b  EQ
+00000c  PUSH1 0x13
+00000e  JUMPI  ; to tag_1
+00000f  PUSH1 0x02
+000011  JUMP  ; to 000002 which is not a JUMPDEST
+000012  Non-opcode 0xc  ; invalid opcode
+tag_1:
+000013  JUMPDEST
+000014  STOP
+000015  PUSH2 0x01  ; truncated, pushes 1 of 2 bytes
+000017  ; Solidity metadata 0xa10001`, DisassembleString(code))
+
+	instructions, metadata := Disassemble(code[:len(code)-3])
+	assert.Len(t, instructions, 14)
+	assert.Nil(t, metadata)
+	assert.Equal(t, []FunctionSelector{{Selector: []byte{0xa9, 0x05, 0x9c, 0xbb}, Destination: 0x13, Label: "tag_1"}},
+		FunctionSelectors(instructions))
+}
+
+func TestFunctionSelectors(t *testing.T) {
+	// Later versions of solc compare against a duplicate of the selector pushed first
+	code := []byte{
+		byte(PUSH4), 0x18, 0x16, 0x0d, 0xdd, byte(DUP2), byte(EQ), byte(PUSH1), 0x13, byte(JUMPI),
+		byte(PUSH4), 0x70, 0xa0, 0x82, 0x31, byte(EQ), byte(PUSH1), 0x0b, byte(JUMPI),
+		byte(JUMPDEST), byte(STOP),
+	}
+	instructions, _ := Disassemble(code)
+	// The second comparison does not jump to a JUMPDEST
+	assert.Equal(t, []FunctionSelector{{Selector: []byte{0x18, 0x16, 0x0d, 0xdd}, Destination: 0x13, Label: "tag_1"}},
+		FunctionSelectors(instructions))
+}
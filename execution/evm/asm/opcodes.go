@@ -5,6 +5,7 @@ package asm
 
 import (
 	"fmt"
+	"sort"
 )
 
 type OpCode byte
@@ -344,6 +345,16 @@ var opCodeNames = map[OpCode]string{
 	SELFDESTRUCT: "SELFDESTRUCT",
 }
 
+// Returns all defined opcodes in ascending order
+func OpCodes() []OpCode {
+	ops := make([]OpCode, 0, len(opCodeNames))
+	for op := range opCodeNames {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i] < ops[j] })
+	return ops
+}
+
 func GetOpCode(b byte) (OpCode, bool) {
 	op := OpCode(b)
 	_, isOpcode := opCodeNames[op]
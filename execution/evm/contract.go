@@ -54,10 +54,16 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 	// particular for 1, 3. acts a shared error sink for stack, memory, and the main execute loop
 	maybe := new(errors.Maybe)
 
+	gas := c.options.GasSchedule
+
 	// Provide stack and memory storage - passing in the callState as an error provider
 	stack := NewStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas)
 	memory := c.options.MemoryProvider(maybe)
 
+	// Attributes gas to opcodes when profiling
+	profiler := c.gasProfile.frame(params.Gas)
+	defer profiler.end()
+
 	for {
 		// Check for any error in this frame.
 		if maybe.Error() != nil {
@@ -65,9 +71,11 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 		}
 
 		var op = c.GetSymbol(pc)
+		profiler.begin(op)
 		c.debugf("(pc) %-3d (op) %-14s (st) %-4d (gas) %d", pc, op.String(), stack.Len(), *params.Gas)
 		// Use BaseOp gas.
-		maybe.PushError(useGasNegative(params.Gas, native.GasBaseOp))
+		maybe.PushError(useGasNegative(params.Gas, gas.BaseOp))
+		maybe.PushError(st.CallFrame.UseInstruction())
 
 		switch op {
 
@@ -321,8 +329,9 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			}
 
 		case SHA3: // 0x20
-			maybe.PushError(useGasNegative(params.Gas, native.GasSha3))
+			maybe.PushError(useGasNegative(params.Gas, gas.Sha3))
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			maybe.PushError(useGasNegative(params.Gas, gas.Sha3Word*wordCount(size)))
 			data := memory.Read(offset, size)
 			data = crypto.Keccak256(data)
 			stack.PushBytes(data)
@@ -334,7 +343,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case BALANCE: // 0x31
 			address := stack.PopAddress()
-			maybe.PushError(useGasNegative(params.Gas, native.GasGetAccount))
+			maybe.PushError(useGasNegative(params.Gas, gas.GetAccount))
 			balance := mustGetAccount(st.CallFrame, maybe, address).Balance
 			stack.Push64(balance)
 			c.debugf(" => %v (%v)\n", balance, address)
@@ -386,10 +395,12 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 		case GASPRICE_DEPRECATED: // 0x3A
 			stack.Push(Zero256)
 			c.debugf(" => %v (GASPRICE IS DEPRECATED)\n", Zero256)
+			exec.Warn(st.EventSink, exec.WarningDeprecatedOpcode,
+				"contract %v used deprecated opcode GASPRICE which always returns zero", params.Callee)
 
 		case EXTCODESIZE: // 0x3B
 			address := stack.PopAddress()
-			maybe.PushError(useGasNegative(params.Gas, native.GasGetAccount))
+			maybe.PushError(useGasNegative(params.Gas, gas.GetAccount))
 			acc := mustGetAccount(st.CallFrame, maybe, address)
 			if acc == nil {
 				stack.Push(Zero256)
@@ -401,7 +412,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			}
 		case EXTCODECOPY: // 0x3C
 			address := stack.PopAddress()
-			maybe.PushError(useGasNegative(params.Gas, native.GasGetAccount))
+			maybe.PushError(useGasNegative(params.Gas, gas.GetAccount))
 			acc := mustGetAccount(st.CallFrame, maybe, address)
 			if acc == nil {
 				maybe.PushError(errors.Codes.UnknownAddress)
@@ -509,13 +520,16 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case SLOAD: // 0x54
 			loc := stack.Pop()
+			maybe.PushError(useGasNegative(params.Gas, gas.StorageRead))
 			data := LeftPadWord256(maybe.Bytes(st.CallFrame.GetStorage(params.Callee, loc)))
 			stack.Push(data)
 			c.debugf("%v {0x%v = 0x%v}\n", params.Callee, loc, data)
 
 		case SSTORE: // 0x55
 			loc, data := stack.Pop(), stack.Pop()
-			maybe.PushError(useGasNegative(params.Gas, native.GasStorageUpdate))
+			cost, refund := gas.StorageUpdate(maybe.Bytes(st.CallFrame.GetStorage(params.Callee, loc)), data.Bytes())
+			maybe.PushError(useGasNegative(params.Gas, cost))
+			st.CallFrame.AddRefund(refund)
 			maybe.PushError(st.CallFrame.SetStorage(params.Callee, loc, data.Bytes()))
 			c.debugf("%v {%v := %v}\n", params.Callee, loc, data)
 
@@ -578,7 +592,13 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			for i := 0; i < n; i++ {
 				topics[i] = stack.Pop()
 			}
+			maybe.PushError(useGasNegative(params.Gas, gas.Log+gas.LogTopic*uint64(n)+gas.LogData*size.Uint64()))
 			data := memory.Read(offset, size)
+			// Contracts may only emit large or numerous log events with the Emit permission
+			if st.CallFrame.UseLog(params.Callee, uint64(len(data))) &&
+				maybe.PushError(ensurePermission(st.CallFrame, params.Callee, permission.Emit)) {
+				continue
+			}
 			maybe.PushError(st.EventSink.Log(&exec.LogEvent{
 				Address: params.Callee,
 				Topics:  topics,
@@ -593,7 +613,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			input := memory.Read(offset, size)
 
 			// TODO charge for gas to create account _ the code length * GasCreateByte
-			maybe.PushError(useGasNegative(params.Gas, native.GasCreateAccount))
+			maybe.PushError(useGasNegative(params.Gas, gas.CreateAccount))
 
 			var newAccountAddress crypto.Address
 			if op == CREATE {
@@ -616,7 +636,16 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			// Establish a frame in which the putative account exists
 			childCallFrame, err := st.CallFrame.NewFrame()
 			maybe.PushError(err)
-			maybe.PushError(native.CreateAccount(childCallFrame, newAccountAddress))
+			maybe.PushError(native.CreateAccountWithPermissions(childCallFrame, newAccountAddress,
+				c.newAccountPermissions))
+
+			// Share the caller's gas with the create unless we must retain a 64th of it as per EIP-150
+			createGas := params.Gas
+			if c.capCallGas {
+				forwarded := allButOne64th(*params.Gas)
+				*params.Gas -= forwarded
+				createGas = &forwarded
+			}
 
 			// Run the input to get the contract code.
 			// NOTE: no need to copy 'input' as per Call contract.
@@ -632,8 +661,12 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 					Callee: newAccountAddress,
 					Input:  input,
 					Value:  contractValue,
-					Gas:    params.Gas,
+					Gas:    createGas,
 				})
+			if createGas != params.Gas {
+				// Return the gas the create did not use
+				*params.Gas += *createGas
+			}
 			if callErr != nil {
 				stack.Push(Zero256)
 				// Note we both set the return buffer and return the result normally in order to service the error to
@@ -673,7 +706,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 			// Get the arguments from the memory
 			// EVM contract
-			maybe.PushError(useGasNegative(params.Gas, native.GasGetAccount))
+			maybe.PushError(useGasNegative(params.Gas, gas.GetAccount))
 			// since CALL is used also for sending funds,
 			// acc may not exist yet. This is an errors.CodedError for
 			// CALLCODE, but not for CALL, though I don't think
@@ -685,7 +718,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 					continue
 				}
 				// We're sending funds to a new account so we must create it first
-				if maybe.PushError(createAccount(st.CallFrame, params.Callee, target)) {
+				if maybe.PushError(createAccount(st.CallFrame, params.Callee, target, c.newAccountPermissions)) {
 					continue
 				}
 				acc = mustGetAccount(st.CallFrame, maybe, target)
@@ -702,9 +735,12 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 				EventSink:  st.EventSink,
 			}
 			// Ensure that gasLimit is reasonable
-			if *params.Gas < gasLimit {
-				// EIP150 - the 63/64 rule - rather than errors.CodedError we pass this specified fraction of the total available gas
-				gasLimit = *params.Gas - *params.Gas/64
+			if maxGas := allButOne64th(*params.Gas); c.capCallGas && gasLimit > maxGas {
+				// EIP150 - the 63/64 rule - the caller always retains a 64th of its gas
+				gasLimit = maxGas
+			} else if *params.Gas < gasLimit {
+				// Rather than errors.CodedError we pass this specified fraction of the total available gas
+				gasLimit = maxGas
 			}
 			// NOTE: we will return any used gas later.
 			*params.Gas -= gasLimit
@@ -756,6 +792,9 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 				calleeParams.Caller = params.Callee
 				calleeParams.Callee = params.Callee
 
+				exec.Warn(st.EventSink, exec.WarningDeprecatedOpcode,
+					"contract %v used deprecated opcode CALLCODE, use DELEGATECALL instead", params.Callee)
+
 			case DELEGATECALL:
 				// Calling this contract from the original caller as if it had the code at target
 				// Value: not transferred
@@ -821,11 +860,11 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case SELFDESTRUCT: // 0xFF
 			receiver := stack.PopAddress()
-			maybe.PushError(useGasNegative(params.Gas, native.GasGetAccount))
+			maybe.PushError(useGasNegative(params.Gas, gas.GetAccount))
 			if getAccount(st.CallFrame, maybe, receiver) == nil {
 				// If receiver address doesn't exist, try to create it
-				maybe.PushError(useGasNegative(params.Gas, native.GasCreateAccount))
-				if maybe.PushError(createAccount(st.CallFrame, params.Callee, receiver)) {
+				maybe.PushError(useGasNegative(params.Gas, gas.CreateAccount))
+				if maybe.PushError(createAccount(st.CallFrame, params.Callee, receiver, c.newAccountPermissions)) {
 					continue
 				}
 			}
@@ -834,6 +873,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 				return account.AddToBalance(balance)
 			}))
 			maybe.PushError(native.RemoveAccount(st.CallFrame, params.Callee))
+			st.CallFrame.AddRefund(gas.SelfDestructRefund)
 			c.debugf(" => (%X) %v\n", receiver[:4], balance)
 			return nil, maybe.Error()
 
@@ -862,12 +902,13 @@ func (c *Contract) jump(to uint64, pc *uint64) error {
 	return nil
 }
 
-func createAccount(callFrame *engine.CallFrame, creator, address crypto.Address) error {
+func createAccount(callFrame *engine.CallFrame, creator, address crypto.Address,
+	perms permission.BasePermissions) error {
 	err := ensurePermission(callFrame, creator, permission.CreateAccount)
 	if err != nil {
 		return err
 	}
-	return native.CreateAccount(callFrame, address)
+	return native.CreateAccountWithPermissions(callFrame, address, perms)
 }
 
 func getAccount(st acmstate.Reader, m *errors.Maybe, address crypto.Address) *acm.Account {
@@ -903,6 +944,11 @@ func ensurePermission(callFrame *engine.CallFrame, address crypto.Address, perm
 	return nil
 }
 
+// All but one 64th of gas, the most that may be forwarded to a call under EIP-150
+func allButOne64th(gas uint64) uint64 {
+	return gas - gas/64
+}
+
 // Try to deduct gasToUse from gasLeft.  If ok return false, otherwise
 // set err and return true.
 func useGasNegative(gasLeft *uint64, gasToUse uint64) error {
@@ -934,6 +980,11 @@ func subslice(data []byte, offset, length uint64) ([]byte, error) {
 	return data[offset : offset+length], nil
 }
 
+// Number of 32-byte words needed to hold size bytes
+func wordCount(size *big.Int) uint64 {
+	return (size.Uint64() + Word256Bytes - 1) / Word256Bytes
+}
+
 func codeGetOp(code []byte, n uint64) OpCode {
 	if uint64(len(code)) <= n {
 		return OpCode(0) // stop
@@ -8,11 +8,15 @@ import (
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/cron"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/escrow"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
 )
 
 const (
@@ -21,6 +25,9 @@ const (
 	uint64Length                = 8
 )
 
+// The Ethereum hard forks whose EVM semantics (opcodes and precompiles) are implemented, in order of activation
+var HardForks = []string{"Frontier", "Homestead", "Byzantium", "Constantinople", "Petersburg"}
+
 type EVM struct {
 	options  Options
 	sequence uint64
@@ -28,6 +35,22 @@ type EVM struct {
 	externals engine.Dispatcher
 	// User dispatcher.CallableProvider to get access to other VMs
 	logger *logging.Logger
+	// Name registry made available to natives (if any)
+	names names.ReaderWriter
+	// Cron jobs made available to natives (if any)
+	cron cron.ReaderWriter
+	// Escrows made available to natives (if any)
+	escrows escrow.ReaderWriter
+	// Maximum number of instructions a single execution may perform (zero means unlimited)
+	maxInstructions uint64
+	// Thresholds on log events beyond which a contract requires the Emit permission
+	logLimits engine.LogLimits
+	// Whether calls and creates forward at most all but one 64th of the remaining gas (EIP-150)
+	capCallGas bool
+	// The base permissions given to the accounts that contracts create
+	newAccountPermissions permission.BasePermissions
+	// Accumulates gas used per opcode when set
+	gasProfile *GasProfile
 }
 
 // Options are parameters that are generally stable across a burrow configuration.
@@ -35,13 +58,16 @@ type EVM struct {
 type Options struct {
 	MemoryProvider           func(errors.Sink) Memory
 	Natives                  *native.Natives
+	GasSchedule              *GasSchedule
 	Nonce                    []byte
 	DebugOpcodes             bool
 	DumpTokens               bool
 	CallStackMaxDepth        uint64
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
-	Logger                   *logging.Logger
+	// Counts the contract storage reads made by executions (if set)
+	StorageReads *acmstate.StorageReads
+	Logger       *logging.Logger
 }
 
 func New(options Options) *EVM {
@@ -55,6 +81,9 @@ func New(options Options) *EVM {
 	if options.Natives == nil {
 		options.Natives = native.MustDefaultNatives()
 	}
+	if options.GasSchedule == nil {
+		options.GasSchedule = BurrowGasSchedule()
+	}
 	vm := &EVM{
 		options: options,
 	}
@@ -78,17 +107,28 @@ func (vm *EVM) Execute(st acmstate.ReaderWriter, blockchain engine.Blockchain, e
 	// Make it appear as if natives are stored in state
 	st = native.NewState(vm.options.Natives, st)
 
+	callFrame := engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth).WithNames(vm.names).
+		WithCron(vm.cron).WithEscrows(vm.escrows).WithStorageReads(vm.options.StorageReads)
 	state := engine.State{
-		CallFrame:  engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
+		CallFrame:  callFrame.WithMaxInstructions(vm.maxInstructions).WithLogLimits(vm.logLimits),
 		Blockchain: blockchain,
 		EventSink:  eventSink,
 	}
 
+	gasStart := *params.Gas
 	output, err := vm.Contract(code).Call(state, params)
 	if err == nil {
 		// Only sync back when there was no exception
 		err = state.CallFrame.Sync()
 	}
+	if err == nil {
+		// Return any refund earned by freeing state (capped relative to the gas used)
+		refund := vm.options.GasSchedule.CappedRefund(state.CallFrame.Refund(), gasStart-*params.Gas)
+		*params.Gas += refund
+		if rs, ok := eventSink.(exec.RefundSink); ok && refund > 0 {
+			rs.Refund(refund)
+		}
+	}
 	// Always return output - we may have a reverted exception for which the return is meaningful
 	return output, err
 }
@@ -104,7 +144,69 @@ func (vm *EVM) SetLogger(logger *logging.Logger) {
 	vm.logger = logger
 }
 
+// Provide the name registry to natives called during subsequent executions
+func (vm *EVM) SetNames(reg names.ReaderWriter) {
+	vm.names = reg
+}
+
+// Limit the number of instructions subsequent executions may perform across all of their calls (zero means unlimited).
+// Unlike gas this bounds the running time of code whose instructions are cheap but slow.
+func (vm *EVM) SetMaxInstructions(max uint64) {
+	vm.maxInstructions = max
+}
+
+// Limit the data size of log events and the number of log events per contract that subsequent executions may emit
+// from contracts lacking the Emit permission (zero means unlimited)
+func (vm *EVM) SetLogLimits(limits engine.LogLimits) {
+	vm.logLimits = limits
+}
+
+// Forward at most all but one 64th of a contract's remaining gas to the calls and creates it makes during subsequent
+// executions as per EIP-150, rather than the gas requested whenever it is available
+func (vm *EVM) SetCapCallGas(capCallGas bool) {
+	vm.capCallGas = capCallGas
+}
+
+// Give the accounts that contracts create by CALL, CREATE, CREATE2, or SELFDESTRUCT during subsequent executions the
+// base permissions perms
+func (vm *EVM) SetNewAccountPermissions(perms permission.BasePermissions) {
+	vm.newAccountPermissions = perms
+}
+
+// Charge subsequent executions according to schedule (or the Burrow schedule if nil)
+func (vm *EVM) SetGasSchedule(schedule *GasSchedule) {
+	if schedule == nil {
+		schedule = BurrowGasSchedule()
+	}
+	vm.options.GasSchedule = schedule
+}
+
+// Record the gas used by each opcode during subsequent executions in profile (or stop profiling if nil)
+func (vm *EVM) SetGasProfile(profile *GasProfile) {
+	vm.gasProfile = profile
+}
+
+// Provide cron jobs to natives called during subsequent executions
+func (vm *EVM) SetCron(jobs cron.ReaderWriter) {
+	vm.cron = jobs
+}
+
+// Provide escrows to natives called during subsequent executions
+func (vm *EVM) SetEscrows(escrows escrow.ReaderWriter) {
+	vm.escrows = escrows
+}
+
 func (vm *EVM) Dispatch(acc *acm.Account) engine.Callable {
+	// Paused contracts refuse calls before any of their code is run or value is transferred to them
+	if acc.Paused {
+		return engine.CallableFunc(func(st engine.State, params engine.CallParams) ([]byte, error) {
+			err := errors.Errorf(errors.Codes.ContractPaused, "contract %v is paused", acc.Address)
+			if eventErr := native.FireCallEvent(st.CallFrame, err, st.EventSink, nil, params, *params.Gas); eventErr != nil {
+				return nil, eventErr
+			}
+			return nil, err
+		})
+	}
 	// Try external calls then fallback to EVM
 	callable := vm.externals.Dispatch(acc)
 	if callable != nil {
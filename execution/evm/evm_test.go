@@ -659,6 +659,30 @@ func TestEVM(t *testing.T) {
 		assert.NotNil(t, txe.Exception, "Expected insufficient gas error")
 	})
 
+	// Test that a paused contract refuses calls from other contracts
+	t.Run("PausedCall", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "2")
+		account3 := newAccount(t, st, "3")
+		addToBalance(t, st, account2, 100000)
+
+		contractCode := callContractCode(account3)
+		txe := runVM(st, account1, account2, contractCode, 1000)
+		require.Nil(t, txe.Exception)
+
+		acc, err := st.GetAccount(account3)
+		require.NoError(t, err)
+		acc.Paused = true
+		require.NoError(t, st.UpdateAccount(acc))
+
+		txe = runVM(st, account1, account2, contractCode, 1000)
+		exCalls := txe.ExceptionalCalls()
+		require.Len(t, exCalls, 1)
+		require.Equal(t, errors.Codes.ContractPaused, errors.GetCode(exCalls[0].Header.Exception))
+	})
+
 	// Test to ensure that contracts called with STATICCALL cannot modify state
 	// as per https://github.com/ethereum/EIPs/blob/master/EIPS/eip-214.md
 	t.Run("StaticCallReadOnly", func(t *testing.T) {
@@ -843,6 +867,37 @@ func TestEVM(t *testing.T) {
 		assert.NotNil(t, txe.Exception, "Should have insufficient gas for call")
 	})
 
+	t.Run("CapCallGas", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		callee := makeAccountWithCode(t, st, "gasCallee", MustSplice(STOP))
+		// Request 9000 gas for the call, which is available but more than all but one 64th of what remains
+		callerCode := MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, callee, PUSH2, 0x23, 0x28,
+			CALL, STOP)
+		caller := makeAccountWithCode(t, st, "gasCaller", callerCode)
+		gasForwarded := func(capCallGas bool) uint64 {
+			vm := New(Options{})
+			vm.SetCapCallGas(capCallGas)
+			gas := uint64(9100)
+			txe := new(exec.TxExecution)
+			_, err := vm.Execute(st, new(blockchain), txe, engine.CallParams{
+				Caller: caller,
+				Callee: caller,
+				Gas:    &gas,
+			}, callerCode)
+			require.NoError(t, err)
+			for _, ev := range txe.Events {
+				if ev.Call != nil && ev.Call.CallData.Callee == callee {
+					return ev.Call.GasForwarded
+				}
+			}
+			t.Fatalf("no call to %v", callee)
+			return 0
+		}
+		assert.Equal(t, uint64(9000), gasForwarded(false))
+		forwarded := gasForwarded(true)
+		assert.True(t, forwarded < 9100-9100/64, "should retain a 64th of remaining gas but forwarded %d", forwarded)
+	})
+
 	t.Run("MemoryBounds", func(t *testing.T) {
 		st := acmstate.NewMemoryState()
 		blockchain := new(blockchain)
@@ -1682,6 +1737,75 @@ func TestEVM(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("StorageRefund", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		vm := New(Options{
+			GasSchedule: EthereumGasSchedule(),
+		})
+
+		// Set and then clear a storage slot
+		bytecode := MustSplice(PUSH1, 0x01, PUSH1, 0x00, SSTORE, PUSH1, 0x00, PUSH1, 0x00, SSTORE, STOP)
+		var gas uint64 = 100000
+		txe := new(exec.TxExecution)
+		_, err := vm.Execute(st, new(blockchain), txe, engine.CallParams{
+			Caller: account1,
+			Callee: account2,
+			Gas:    &gas,
+		}, bytecode)
+		require.NoError(t, err)
+
+		// 7 ops, 8 stack pushes and pops, one storage set, and one storage reset
+		gasUsed := uint64(7*3 + 8*native.GasStackOp + 20000 + 5000)
+		// Refund is capped at half the gas used
+		refund := gasUsed / 2
+		require.Equal(t, refund, txe.Result.GetGasRefunded())
+		require.Equal(t, 100000-gasUsed+refund, gas)
+	})
+
+	t.Run("GasProfile", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		// Callee stores a value so its SSTORE should be attributed to SSTORE rather than to the CALL
+		account3 := makeAccountWithCode(t, st, "3", MustSplice(PUSH1, 0x01, PUSH1, 0x00, SSTORE, STOP))
+
+		profile := NewGasProfile()
+		vm := New(Options{
+			GasSchedule: EthereumGasSchedule(),
+		})
+		vm.SetGasProfile(profile)
+		var gas uint64 = 100000
+		_, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: account1,
+			Callee: account2,
+			Gas:    &gas,
+		}, MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, account3, PUSH2, 0xFF, 0xFF,
+			CALL, STOP))
+		require.NoError(t, err)
+
+		opcodes := make(map[string]*exec.OpcodeGas)
+		for _, op := range profile.Opcodes() {
+			opcodes[op.Opcode] = op
+		}
+		require.Equal(t, uint64(1), opcodes["SSTORE"].Count)
+		require.Equal(t, uint64(1), opcodes["CALL"].Count)
+		require.True(t, opcodes["CALL"].Gas < opcodes["SSTORE"].Gas)
+		require.Equal(t, uint64(100000)-gas, profile.Total())
+	})
+
+	t.Run("DeprecatedOpcodeWarning", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+
+		txe := runVM(st, account1, account2, MustSplice(GASPRICE_DEPRECATED, return1()), 100000)
+		require.Nil(t, txe.Exception)
+		require.Len(t, txe.Warnings, 1)
+		require.Equal(t, exec.WarningDeprecatedOpcode, txe.Warnings[0].Code)
+	})
 }
 
 type blockchain struct {
@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/execution/native"
+)
+
+const (
+	BurrowGasScheduleName   = "burrow"
+	EthereumGasScheduleName = "ethereum"
+)
+
+// GasSchedule sets out the cost of the metered EVM operations along with the refunds granted for freeing state
+type GasSchedule struct {
+	// Charged for every operation executed
+	BaseOp uint64
+	// Charged for SHA3 (KECCAK256)
+	Sha3 uint64
+	// Charged per word hashed by SHA3
+	Sha3Word uint64
+	// Charged for operations that load an account (BALANCE, EXTCODESIZE, EXTCODECOPY, CALL, etc.)
+	GetAccount uint64
+	// Charged for SLOAD
+	StorageRead uint64
+	// Charged for an SSTORE that sets a zero storage slot to a non-zero value
+	StorageSet uint64
+	// Charged for any other SSTORE
+	StorageReset uint64
+	// Refunded for an SSTORE that sets a non-zero storage slot to zero
+	StorageClearRefund uint64
+	// Charged for creating an account (CREATE, CREATE2, or implicitly via CALL or SELFDESTRUCT)
+	CreateAccount uint64
+	// Refunded for SELFDESTRUCT
+	SelfDestructRefund uint64
+	// Charged for LOG0-4
+	Log uint64
+	// Charged per topic emitted by LOG0-4
+	LogTopic uint64
+	// Charged per byte of data emitted by LOG0-4
+	LogData uint64
+	// The refund granted at the end of execution is capped at gas used divided by this quotient (zero disables refunds)
+	MaxRefundQuotient uint64
+}
+
+// The historical Burrow schedule under which most operations are nominally priced and nothing is refunded
+func BurrowGasSchedule() *GasSchedule {
+	return &GasSchedule{
+		BaseOp:        native.GasBaseOp,
+		Sha3:          native.GasSha3,
+		GetAccount:    native.GasGetAccount,
+		StorageSet:    native.GasStorageUpdate,
+		StorageReset:  native.GasStorageUpdate,
+		CreateAccount: native.GasCreateAccount,
+	}
+}
+
+// The Ethereum (Petersburg) cost table for the operations that we meter
+func EthereumGasSchedule() *GasSchedule {
+	return &GasSchedule{
+		BaseOp:             3,
+		Sha3:               30,
+		Sha3Word:           6,
+		GetAccount:         700,
+		StorageRead:        800,
+		StorageSet:         20000,
+		StorageReset:       5000,
+		StorageClearRefund: 15000,
+		CreateAccount:      32000,
+		SelfDestructRefund: 24000,
+		Log:                375,
+		LogTopic:           375,
+		LogData:            8,
+		MaxRefundQuotient:  2,
+	}
+}
+
+func GasScheduleByName(name string) (*GasSchedule, error) {
+	switch strings.ToLower(name) {
+	case "", BurrowGasScheduleName:
+		return BurrowGasSchedule(), nil
+	case EthereumGasScheduleName:
+		return EthereumGasSchedule(), nil
+	default:
+		return nil, fmt.Errorf("gas schedule '%s' not recognised, expected one of: %s, %s", name,
+			BurrowGasScheduleName, EthereumGasScheduleName)
+	}
+}
+
+// Returns the cost of an SSTORE overwriting the current value with the new value along with any refund due
+func (gs *GasSchedule) StorageUpdate(current, value []byte) (cost, refund uint64) {
+	currentZero, valueZero := isZero(current), isZero(value)
+	if currentZero && !valueZero {
+		return gs.StorageSet, 0
+	}
+	if !currentZero && valueZero {
+		return gs.StorageReset, gs.StorageClearRefund
+	}
+	return gs.StorageReset, 0
+}
+
+// Returns the refund actually granted given the accumulated refund counter and the gas used by the execution
+func (gs *GasSchedule) CappedRefund(refund, gasUsed uint64) uint64 {
+	if gs.MaxRefundQuotient == 0 {
+		return 0
+	}
+	limit := gasUsed / gs.MaxRefundQuotient
+	if refund > limit {
+		return limit
+	}
+	return refund
+}
+
+func isZero(bs []byte) bool {
+	for _, b := range bs {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
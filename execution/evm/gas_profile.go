@@ -0,0 +1,96 @@
+package evm
+
+import (
+	"sort"
+
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// GasProfile accumulates the gas used by each opcode over one or more executions. Gas used by the code run by a call
+// (or create) is attributed to the opcodes executed by the callee rather than to the CALL itself so that it is not
+// counted twice.
+type GasProfile struct {
+	count [256]uint64
+	gas   [256]uint64
+	// Gas attributed so far, used to separate a CALL's own cost from that of the code it calls
+	total uint64
+}
+
+func NewGasProfile() *GasProfile {
+	return new(GasProfile)
+}
+
+// The opcodes that were executed ordered by gas used (descending) then opcode
+func (gp *GasProfile) Opcodes() []*exec.OpcodeGas {
+	var ops []*exec.OpcodeGas
+	for op, count := range gp.count {
+		if count > 0 {
+			ops = append(ops, &exec.OpcodeGas{
+				Opcode: OpCode(op).String(),
+				Count:  count,
+				Gas:    gp.gas[op],
+			})
+		}
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].Gas > ops[j].Gas
+	})
+	return ops
+}
+
+// Total gas attributed to opcodes
+func (gp *GasProfile) Total() uint64 {
+	return gp.total
+}
+
+// Tracks the opcode being executed in a single frame so the gas it uses can be attributed once it completes
+type opProfiler struct {
+	profile *GasProfile
+	gas     *uint64
+	op      OpCode
+	// Gas remaining and gas attributed across the profile when op began
+	gasStart   uint64
+	totalStart uint64
+	running    bool
+}
+
+func (gp *GasProfile) frame(gas *uint64) *opProfiler {
+	if gp == nil {
+		return nil
+	}
+	return &opProfiler{profile: gp, gas: gas}
+}
+
+// Attribute the gas used by any previous opcode and begin tracking op
+func (opp *opProfiler) begin(op OpCode) {
+	if opp == nil {
+		return
+	}
+	opp.end()
+	opp.op = op
+	opp.gasStart = *opp.gas
+	opp.totalStart = opp.profile.total
+	opp.running = true
+}
+
+// Attribute the gas used by the current opcode less that attributed to any code it called
+func (opp *opProfiler) end() {
+	if opp == nil || !opp.running {
+		return
+	}
+	opp.running = false
+	var used uint64
+	if opp.gasStart > *opp.gas {
+		used = opp.gasStart - *opp.gas
+	}
+	called := opp.profile.total - opp.totalStart
+	if used > called {
+		used -= called
+	} else {
+		used = 0
+	}
+	opp.profile.count[opp.op]++
+	opp.profile.gas[opp.op] += used
+	opp.profile.total += used
+}
@@ -5,6 +5,10 @@ import (
 	"reflect"
 )
 
+// EventSchemaVersion is incremented whenever the structure of the execution events streamed to clients changes in a way
+// they need to know about
+const EventSchemaVersion = 1
+
 var eventMessageType = reflect.TypeOf(&Event{}).String()
 
 type EventType uint32
@@ -9,6 +9,11 @@ type EventSink interface {
 	Log(log *LogEvent) error
 }
 
+// RefundSink may optionally be implemented by an EventSink that wishes to record the gas refunded by an execution
+type RefundSink interface {
+	Refund(gasRefunded uint64)
+}
+
 type noopEventSink struct {
 }
 
@@ -38,3 +43,7 @@ func (esc *logFreeEventSink) Log(log *LogEvent) error {
 	return errors.Errorf(errors.Codes.IllegalWrite,
 		"Log emitted from contract %v, but current call should be log-free", log.Address)
 }
+
+func (esc *logFreeEventSink) Warn(code WarningCode, format string, a ...interface{}) {
+	Warn(esc.EventSink, code, format, a...)
+}
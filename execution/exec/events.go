@@ -52,8 +52,9 @@ func (evs Events) CallTrace() string {
 			if ev.Header.Exception != nil {
 				ex = fmt.Sprintf(" [%v]", ev.Header.Exception)
 			}
-			calls = append(calls, fmt.Sprintf("%v: %v -> %v: %v%s",
-				ev.Call.CallType, ev.Call.CallData.Caller, ev.Call.CallData.Callee, ev.Call.Return, ex))
+			calls = append(calls, fmt.Sprintf("%v: %v -> %v (gas forwarded: %d, remaining: %d): %v%s",
+				ev.Call.CallType, ev.Call.CallData.Caller, ev.Call.CallData.Callee, ev.Call.GasForwarded,
+				ev.Call.CallData.Gas, ev.Call.Return, ex))
 		}
 	}
 	return strings.Join(calls, "\n")
@@ -296,10 +296,12 @@ type BeginTx struct {
 	// Result of tx execution
 	Result *Result `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
 	// If tx execution was an exception
-	Exception            *errors.Exception `protobuf:"bytes,4,opt,name=Exception,proto3" json:"Exception,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Exception *errors.Exception `protobuf:"bytes,4,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	// Non-fatal issues detected during execution
+	Warnings             []*Warning `protobuf:"bytes,6,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *BeginTx) Reset()         { *m = BeginTx{} }
@@ -359,6 +361,13 @@ func (m *BeginTx) GetException() *errors.Exception {
 	return nil
 }
 
+func (m *BeginTx) GetWarnings() []*Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 func (*BeginTx) XXX_MessageName() string {
 	return "exec.BeginTx"
 }
@@ -624,10 +633,12 @@ type TxExecution struct {
 	// If execution was an exception
 	Exception *errors.Exception `protobuf:"bytes,10,opt,name=Exception,proto3" json:"Exception,omitempty"`
 	// A proposal may contain other transactions
-	TxExecutions         []*TxExecution `protobuf:"bytes,11,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	TxExecutions []*TxExecution `protobuf:"bytes,11,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// Non-fatal issues detected during execution
+	Warnings             []*Warning `protobuf:"bytes,12,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *TxExecution) Reset()         { *m = TxExecution{} }
@@ -694,10 +705,150 @@ func (m *TxExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *TxExecution) GetWarnings() []*Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 func (*TxExecution) XXX_MessageName() string {
 	return "exec.TxExecution"
 }
 
+// A compact record of the outcome of a transaction, stored by hash so that it can be read without its TxExecution
+type TxReceipt struct {
+	*TxHeader `protobuf:"bytes,1,opt,name=Header,proto3,embedded=Header" json:"Header,omitempty"`
+	// Set if the transaction failed, in which case it emitted no logs
+	Exception *errors.Exception `protobuf:"bytes,2,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	GasUsed   uint64            `protobuf:"varint,3,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// The logs emitted by the transaction in the order they were emitted
+	Logs []*LogEvent `protobuf:"bytes,4,rep,name=Logs,proto3" json:"Logs,omitempty"`
+	// Whether the transaction created a contract
+	CreatesContract bool `protobuf:"varint,5,opt,name=CreatesContract,proto3" json:"CreatesContract,omitempty"`
+	// The address of the contract created or called
+	ContractAddress      github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,6,opt,name=ContractAddress,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"ContractAddress"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *TxReceipt) Reset()         { *m = TxReceipt{} }
+func (m *TxReceipt) String() string { return proto.CompactTextString(m) }
+func (*TxReceipt) ProtoMessage()    {}
+func (*TxReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{10}
+}
+func (m *TxReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReceipt.Merge(m, src)
+}
+func (m *TxReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReceipt proto.InternalMessageInfo
+
+func (m *TxReceipt) GetException() *errors.Exception {
+	if m != nil {
+		return m.Exception
+	}
+	return nil
+}
+
+func (m *TxReceipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxReceipt) GetLogs() []*LogEvent {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *TxReceipt) GetCreatesContract() bool {
+	if m != nil {
+		return m.CreatesContract
+	}
+	return false
+}
+
+func (*TxReceipt) XXX_MessageName() string {
+	return "exec.TxReceipt"
+}
+
+// A non-fatal issue detected during execution that may become a hard failure in future
+type Warning struct {
+	Code                 WarningCode `protobuf:"varint,1,opt,name=Code,proto3,casttype=WarningCode" json:"Code,omitempty"`
+	Message              string      `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Warning) Reset()      { *m = Warning{} }
+func (*Warning) ProtoMessage() {}
+func (*Warning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{11}
+}
+func (m *Warning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Warning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Warning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Warning.Merge(m, src)
+}
+func (m *Warning) XXX_Size() int {
+	return m.Size()
+}
+func (m *Warning) XXX_DiscardUnknown() {
+	xxx_messageInfo_Warning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Warning proto.InternalMessageInfo
+
+func (m *Warning) GetCode() WarningCode {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *Warning) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (*Warning) XXX_MessageName() string {
+	return "exec.Warning"
+}
+
 type Origin struct {
 	// The original ChainID from for this transaction
 	ChainID string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
@@ -716,7 +867,7 @@ func (m *Origin) Reset()         { *m = Origin{} }
 func (m *Origin) String() string { return proto.CompactTextString(m) }
 func (*Origin) ProtoMessage()    {}
 func (*Origin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{10}
+	return fileDescriptor_4d737c7315c25422, []int{12}
 }
 func (m *Origin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +947,7 @@ type Header struct {
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{11}
+	return fileDescriptor_4d737c7315c25422, []int{13}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +1033,7 @@ type Event struct {
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{12}
+	return fileDescriptor_4d737c7315c25422, []int{14}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,17 +1113,21 @@ type Result struct {
 	// Name entry created
 	NameEntry *names.Entry `protobuf:"bytes,3,opt,name=NameEntry,proto3" json:"NameEntry,omitempty"`
 	// Permission update performed
-	PermArgs             *permission.PermArgs `protobuf:"bytes,4,opt,name=PermArgs,proto3" json:"PermArgs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	PermArgs *permission.PermArgs `protobuf:"bytes,4,opt,name=PermArgs,proto3" json:"PermArgs,omitempty"`
+	// Gas refunded for freeing state (already deducted from GasUsed)
+	GasRefunded uint64 `protobuf:"varint,5,opt,name=GasRefunded,proto3" json:"GasRefunded,omitempty"`
+	// Gas used by each EVM opcode executed (only populated for simulated calls)
+	GasProfile           []*OpcodeGas `protobuf:"bytes,6,rep,name=GasProfile,proto3" json:"GasProfile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{13}
+	return fileDescriptor_4d737c7315c25422, []int{15}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,29 +1180,45 @@ func (m *Result) GetPermArgs() *permission.PermArgs {
 	return nil
 }
 
+func (m *Result) GetGasRefunded() uint64 {
+	if m != nil {
+		return m.GasRefunded
+	}
+	return 0
+}
+
+func (m *Result) GetGasProfile() []*OpcodeGas {
+	if m != nil {
+		return m.GasProfile
+	}
+	return nil
+}
+
 func (*Result) XXX_MessageName() string {
 	return "exec.Result"
 }
 
-type LogEvent struct {
-	Address              github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Data                 github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
-	Topics               []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+// Gas attributed to an EVM opcode over an execution, excluding gas used by any calls it makes
+type OpcodeGas struct {
+	Opcode string `protobuf:"bytes,1,opt,name=Opcode,proto3" json:"Opcode,omitempty"`
+	// Number of times the opcode was executed
+	Count                uint64   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Gas                  uint64   `protobuf:"varint,3,opt,name=Gas,proto3" json:"Gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LogEvent) Reset()         { *m = LogEvent{} }
-func (m *LogEvent) String() string { return proto.CompactTextString(m) }
-func (*LogEvent) ProtoMessage()    {}
-func (*LogEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{14}
+func (m *OpcodeGas) Reset()         { *m = OpcodeGas{} }
+func (m *OpcodeGas) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGas) ProtoMessage()    {}
+func (*OpcodeGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{16}
 }
-func (m *LogEvent) XXX_Unmarshal(b []byte) error {
+func (m *OpcodeGas) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OpcodeGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1055,43 +1226,71 @@ func (m *LogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *LogEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogEvent.Merge(m, src)
+func (m *OpcodeGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGas.Merge(m, src)
 }
-func (m *LogEvent) XXX_Size() int {
+func (m *OpcodeGas) XXX_Size() int {
 	return m.Size()
 }
-func (m *LogEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogEvent.DiscardUnknown(m)
+func (m *OpcodeGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGas.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogEvent proto.InternalMessageInfo
+var xxx_messageInfo_OpcodeGas proto.InternalMessageInfo
 
-func (*LogEvent) XXX_MessageName() string {
-	return "exec.LogEvent"
+func (m *OpcodeGas) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
 }
 
-type CallEvent struct {
-	CallType             CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
-	CallData             *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
-	Origin               github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
-	StackDepth           uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
-	Return               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+func (m *OpcodeGas) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *CallEvent) Reset()         { *m = CallEvent{} }
-func (m *CallEvent) String() string { return proto.CompactTextString(m) }
-func (*CallEvent) ProtoMessage()    {}
-func (*CallEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{15}
+func (m *OpcodeGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
 }
-func (m *CallEvent) XXX_Unmarshal(b []byte) error {
+
+func (*OpcodeGas) XXX_MessageName() string {
+	return "exec.OpcodeGas"
+}
+
+type LogEvent struct {
+	Address github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Data    github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
+	Topics  []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
+	// The log decoded according to the ABI of the emitting contract, only set when decoding is requested of rpcevents
+	// and an ABI is available
+	Decoded *DecodedLog `protobuf:"bytes,4,opt,name=Decoded,proto3" json:"Decoded,omitempty"`
+	// The position of this log among those emitted by Address (starting at 1), assigned when the block containing it
+	// is committed so consumers can detect gaps and duplicates. Zero for logs of transactions that failed with an
+	// exception.
+	Sequence uint64 `protobuf:"varint,5,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	// The fields of this log (Data, Log1, Log2, or Log3) hashed or dropped by the serving node's redaction policy
+	Redacted             []string `protobuf:"bytes,6,rep,name=Redacted,proto3" json:"Redacted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogEvent) Reset()         { *m = LogEvent{} }
+func (m *LogEvent) String() string { return proto.CompactTextString(m) }
+func (*LogEvent) ProtoMessage()    {}
+func (*LogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{17}
+}
+func (m *LogEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CallEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1099,60 +1298,63 @@ func (m *CallEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *CallEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallEvent.Merge(m, src)
+func (m *LogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEvent.Merge(m, src)
 }
-func (m *CallEvent) XXX_Size() int {
+func (m *LogEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *CallEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_CallEvent.DiscardUnknown(m)
+func (m *LogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CallEvent proto.InternalMessageInfo
+var xxx_messageInfo_LogEvent proto.InternalMessageInfo
 
-func (m *CallEvent) GetCallType() CallType {
+func (m *LogEvent) GetDecoded() *DecodedLog {
 	if m != nil {
-		return m.CallType
+		return m.Decoded
 	}
-	return 0
+	return nil
 }
 
-func (m *CallEvent) GetCallData() *CallData {
+func (m *LogEvent) GetSequence() uint64 {
 	if m != nil {
-		return m.CallData
+		return m.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (m *CallEvent) GetStackDepth() uint64 {
+func (m *LogEvent) GetRedacted() []string {
 	if m != nil {
-		return m.StackDepth
+		return m.Redacted
 	}
-	return 0
+	return nil
 }
 
-func (*CallEvent) XXX_MessageName() string {
-	return "exec.CallEvent"
+func (*LogEvent) XXX_MessageName() string {
+	return "exec.LogEvent"
 }
 
-type GovernAccountEvent struct {
-	AccountUpdate        *spec.TemplateAccount `protobuf:"bytes,1,opt,name=AccountUpdate,proto3" json:"AccountUpdate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type DecodedLog struct {
+	EventName string `protobuf:"bytes,1,opt,name=EventName,proto3" json:"EventName,omitempty"`
+	// The canonical signature of the event, e.g. Transfer(address,address,uint256)
+	Signature            string        `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Args                 []*DecodedArg `protobuf:"bytes,3,rep,name=Args,proto3" json:"Args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GovernAccountEvent) Reset()         { *m = GovernAccountEvent{} }
-func (m *GovernAccountEvent) String() string { return proto.CompactTextString(m) }
-func (*GovernAccountEvent) ProtoMessage()    {}
-func (*GovernAccountEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{16}
+func (m *DecodedLog) Reset()         { *m = DecodedLog{} }
+func (m *DecodedLog) String() string { return proto.CompactTextString(m) }
+func (*DecodedLog) ProtoMessage()    {}
+func (*DecodedLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{18}
 }
-func (m *GovernAccountEvent) XXX_Unmarshal(b []byte) error {
+func (m *DecodedLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GovernAccountEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecodedLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1160,46 +1362,65 @@ func (m *GovernAccountEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 	return b[:n], nil
 }
-func (m *GovernAccountEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernAccountEvent.Merge(m, src)
+func (m *DecodedLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedLog.Merge(m, src)
 }
-func (m *GovernAccountEvent) XXX_Size() int {
+func (m *DecodedLog) XXX_Size() int {
 	return m.Size()
 }
-func (m *GovernAccountEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernAccountEvent.DiscardUnknown(m)
+func (m *DecodedLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedLog.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GovernAccountEvent proto.InternalMessageInfo
+var xxx_messageInfo_DecodedLog proto.InternalMessageInfo
 
-func (m *GovernAccountEvent) GetAccountUpdate() *spec.TemplateAccount {
+func (m *DecodedLog) GetEventName() string {
 	if m != nil {
-		return m.AccountUpdate
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *DecodedLog) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *DecodedLog) GetArgs() []*DecodedArg {
+	if m != nil {
+		return m.Args
 	}
 	return nil
 }
 
-func (*GovernAccountEvent) XXX_MessageName() string {
-	return "exec.GovernAccountEvent"
+func (*DecodedLog) XXX_MessageName() string {
+	return "exec.DecodedLog"
 }
 
-type InputEvent struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+type DecodedArg struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The Solidity type of the argument
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// The decoded value as a string
+	Value                string   `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	Indexed              bool     `protobuf:"varint,4,opt,name=Indexed,proto3" json:"Indexed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InputEvent) Reset()         { *m = InputEvent{} }
-func (m *InputEvent) String() string { return proto.CompactTextString(m) }
-func (*InputEvent) ProtoMessage()    {}
-func (*InputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{17}
+func (m *DecodedArg) Reset()         { *m = DecodedArg{} }
+func (m *DecodedArg) String() string { return proto.CompactTextString(m) }
+func (*DecodedArg) ProtoMessage()    {}
+func (*DecodedArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{19}
 }
-func (m *InputEvent) XXX_Unmarshal(b []byte) error {
+func (m *DecodedArg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InputEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecodedArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1207,19 +1428,208 @@ func (m *InputEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *InputEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputEvent.Merge(m, src)
+func (m *DecodedArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedArg.Merge(m, src)
 }
-func (m *InputEvent) XXX_Size() int {
+func (m *DecodedArg) XXX_Size() int {
 	return m.Size()
 }
-func (m *InputEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_InputEvent.DiscardUnknown(m)
+func (m *DecodedArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedArg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InputEvent proto.InternalMessageInfo
+var xxx_messageInfo_DecodedArg proto.InternalMessageInfo
 
-func (*InputEvent) XXX_MessageName() string {
+func (m *DecodedArg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecodedArg) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodedArg) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *DecodedArg) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
+	}
+	return false
+}
+
+func (*DecodedArg) XXX_MessageName() string {
+	return "exec.DecodedArg"
+}
+
+type CallEvent struct {
+	CallType   CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
+	CallData   *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
+	Origin     github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
+	StackDepth uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
+	Return     github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
+	// The gas made available to the callee, of which CallData.Gas remained when the call returned
+	GasForwarded         uint64   `protobuf:"varint,6,opt,name=GasForwarded,proto3" json:"GasForwarded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallEvent) Reset()         { *m = CallEvent{} }
+func (m *CallEvent) String() string { return proto.CompactTextString(m) }
+func (*CallEvent) ProtoMessage()    {}
+func (*CallEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{20}
+}
+func (m *CallEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CallEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallEvent.Merge(m, src)
+}
+func (m *CallEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallEvent proto.InternalMessageInfo
+
+func (m *CallEvent) GetCallType() CallType {
+	if m != nil {
+		return m.CallType
+	}
+	return 0
+}
+
+func (m *CallEvent) GetCallData() *CallData {
+	if m != nil {
+		return m.CallData
+	}
+	return nil
+}
+
+func (m *CallEvent) GetStackDepth() uint64 {
+	if m != nil {
+		return m.StackDepth
+	}
+	return 0
+}
+
+func (m *CallEvent) GetGasForwarded() uint64 {
+	if m != nil {
+		return m.GasForwarded
+	}
+	return 0
+}
+
+func (*CallEvent) XXX_MessageName() string {
+	return "exec.CallEvent"
+}
+
+type GovernAccountEvent struct {
+	AccountUpdate        *spec.TemplateAccount `protobuf:"bytes,1,opt,name=AccountUpdate,proto3" json:"AccountUpdate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GovernAccountEvent) Reset()         { *m = GovernAccountEvent{} }
+func (m *GovernAccountEvent) String() string { return proto.CompactTextString(m) }
+func (*GovernAccountEvent) ProtoMessage()    {}
+func (*GovernAccountEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{21}
+}
+func (m *GovernAccountEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernAccountEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GovernAccountEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernAccountEvent.Merge(m, src)
+}
+func (m *GovernAccountEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernAccountEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernAccountEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernAccountEvent proto.InternalMessageInfo
+
+func (m *GovernAccountEvent) GetAccountUpdate() *spec.TemplateAccount {
+	if m != nil {
+		return m.AccountUpdate
+	}
+	return nil
+}
+
+func (*GovernAccountEvent) XXX_MessageName() string {
+	return "exec.GovernAccountEvent"
+}
+
+type InputEvent struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *InputEvent) Reset()         { *m = InputEvent{} }
+func (m *InputEvent) String() string { return proto.CompactTextString(m) }
+func (*InputEvent) ProtoMessage()    {}
+func (*InputEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{22}
+}
+func (m *InputEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InputEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InputEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputEvent.Merge(m, src)
+}
+func (m *InputEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InputEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InputEvent proto.InternalMessageInfo
+
+func (*InputEvent) XXX_MessageName() string {
 	return "exec.InputEvent"
 }
 
@@ -1234,7 +1644,7 @@ func (m *OutputEvent) Reset()         { *m = OutputEvent{} }
 func (m *OutputEvent) String() string { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()    {}
 func (*OutputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{18}
+	return fileDescriptor_4d737c7315c25422, []int{23}
 }
 func (m *OutputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1688,7 @@ func (m *CallData) Reset()         { *m = CallData{} }
 func (m *CallData) String() string { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()    {}
 func (*CallData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{19}
+	return fileDescriptor_4d737c7315c25422, []int{24}
 }
 func (m *CallData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,6 +1751,10 @@ func init() {
 	golang_proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
 	proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
 	golang_proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
+	proto.RegisterType((*TxReceipt)(nil), "exec.TxReceipt")
+	golang_proto.RegisterType((*TxReceipt)(nil), "exec.TxReceipt")
+	proto.RegisterType((*Warning)(nil), "exec.Warning")
+	golang_proto.RegisterType((*Warning)(nil), "exec.Warning")
 	proto.RegisterType((*Origin)(nil), "exec.Origin")
 	golang_proto.RegisterType((*Origin)(nil), "exec.Origin")
 	proto.RegisterType((*Header)(nil), "exec.Header")
@@ -1349,8 +1763,14 @@ func init() {
 	golang_proto.RegisterType((*Event)(nil), "exec.Event")
 	proto.RegisterType((*Result)(nil), "exec.Result")
 	golang_proto.RegisterType((*Result)(nil), "exec.Result")
+	proto.RegisterType((*OpcodeGas)(nil), "exec.OpcodeGas")
+	golang_proto.RegisterType((*OpcodeGas)(nil), "exec.OpcodeGas")
 	proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
 	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
+	proto.RegisterType((*DecodedLog)(nil), "exec.DecodedLog")
+	golang_proto.RegisterType((*DecodedLog)(nil), "exec.DecodedLog")
+	proto.RegisterType((*DecodedArg)(nil), "exec.DecodedArg")
+	golang_proto.RegisterType((*DecodedArg)(nil), "exec.DecodedArg")
 	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
 	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
 	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
@@ -1367,90 +1787,111 @@ func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422)
 func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
 
 var fileDescriptor_4d737c7315c25422 = []byte{
-	// 1318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xef, 0xda, 0x6b, 0xc7, 0x7e, 0x76, 0xfa, 0x6d, 0x47, 0xfd, 0x22, 0xab, 0x42, 0x76, 0xd9,
-	0x42, 0x29, 0xa5, 0x5d, 0x57, 0x81, 0x02, 0x2a, 0x12, 0xa2, 0x6e, 0x42, 0x1b, 0x28, 0x69, 0x99,
-	0xba, 0x45, 0x20, 0x38, 0x6c, 0x76, 0xa7, 0xce, 0xaa, 0xf6, 0xee, 0x6a, 0x77, 0xb6, 0xac, 0xff,
-	0x05, 0x4e, 0xf4, 0x56, 0x2e, 0xa8, 0x67, 0xce, 0xdc, 0xb8, 0x70, 0xcc, 0x8d, 0x1e, 0x51, 0x0f,
-	0x06, 0xa5, 0x7f, 0x01, 0xe2, 0x44, 0x4f, 0x68, 0x66, 0xde, 0xac, 0x77, 0x49, 0x9a, 0x54, 0x24,
-	0x48, 0x5c, 0xa2, 0x79, 0xef, 0x7d, 0xf6, 0xf9, 0xfd, 0xf8, 0xbc, 0x37, 0x13, 0x00, 0x96, 0x31,
-	0xd7, 0x8e, 0xe2, 0x90, 0x87, 0xc4, 0x14, 0xe7, 0xe3, 0xe7, 0x46, 0x3e, 0xdf, 0x48, 0xd7, 0x6d,
-	0x37, 0x9c, 0xf4, 0x47, 0xe1, 0x28, 0xec, 0x4b, 0xe3, 0x7a, 0x7a, 0x47, 0x4a, 0x52, 0x90, 0x27,
-	0xf5, 0xd1, 0xf1, 0xb7, 0x0b, 0x70, 0xce, 0x02, 0x8f, 0xc5, 0x13, 0x3f, 0xe0, 0xc5, 0xa3, 0xb3,
-	0xee, 0xfa, 0x7d, 0x3e, 0x8d, 0x58, 0xa2, 0xfe, 0xe2, 0x87, 0xbd, 0x51, 0x18, 0x8e, 0xc6, 0x6c,
-	0xee, 0x9e, 0xfb, 0x13, 0x96, 0x70, 0x67, 0x12, 0x21, 0xa0, 0xcd, 0xe2, 0x38, 0x8c, 0x35, 0xbc,
-	0x15, 0x38, 0x93, 0xfc, 0xdb, 0x26, 0xcf, 0xf4, 0xf1, 0x48, 0x24, 0x7e, 0x26, 0x49, 0xfc, 0x30,
-	0x40, 0x0d, 0x24, 0x91, 0x4e, 0xc9, 0x5a, 0x81, 0xf6, 0x4d, 0x1e, 0x33, 0x67, 0xb2, 0x72, 0x8f,
-	0x05, 0x3c, 0x21, 0x17, 0xca, 0x72, 0xc7, 0x38, 0x51, 0x3d, 0xdd, 0x5a, 0x3a, 0x6a, 0xcb, 0x2a,
-	0x14, 0x2c, 0xb4, 0x04, 0xb3, 0x7e, 0xac, 0x40, 0xab, 0xa0, 0x20, 0xe7, 0x01, 0x06, 0x6c, 0xe4,
-	0x07, 0x83, 0x71, 0xe8, 0xde, 0xed, 0x18, 0x27, 0x8c, 0xd3, 0xad, 0xa5, 0x23, 0xca, 0xc9, 0x5c,
-	0x4f, 0x0b, 0x18, 0xf2, 0x2a, 0x2c, 0x48, 0x69, 0x98, 0x75, 0x2a, 0x12, 0xbe, 0x58, 0x80, 0x0f,
-	0x33, 0xaa, 0xad, 0xe4, 0x33, 0x68, 0xac, 0x04, 0xf7, 0xd8, 0x38, 0x8c, 0x58, 0xa7, 0x8a, 0x48,
-	0x91, 0xad, 0x56, 0x0e, 0xec, 0xc7, 0xb3, 0xde, 0x99, 0x42, 0xd1, 0x37, 0xa6, 0x11, 0x8b, 0xc7,
-	0xcc, 0x1b, 0xb1, 0xb8, 0xbf, 0x9e, 0xc6, 0x71, 0xf8, 0x55, 0xbf, 0x88, 0xa7, 0xb9, 0x3b, 0xf2,
-	0x12, 0xd4, 0x64, 0xf8, 0x1d, 0x53, 0xfa, 0x6d, 0xa9, 0x08, 0x54, 0xbe, 0xca, 0x22, 0x21, 0x81,
-	0x37, 0xcc, 0x3a, 0xb5, 0x12, 0x44, 0xa8, 0xa8, 0xb2, 0x90, 0x33, 0x22, 0x40, 0x4f, 0x65, 0x5e,
-	0x97, 0xa8, 0xc3, 0x39, 0x4a, 0xe5, 0x9d, 0xdb, 0x2f, 0x9a, 0x9b, 0x0f, 0x7b, 0x86, 0x75, 0xdf,
-	0x28, 0x96, 0x8b, 0xbc, 0x00, 0xf5, 0xab, 0xcc, 0x1f, 0x6d, 0x70, 0x59, 0x38, 0x93, 0xa2, 0x24,
-	0xf4, 0x6b, 0xe9, 0x64, 0x98, 0x25, 0x32, 0x6f, 0x93, 0xa2, 0x44, 0xce, 0xc2, 0xd1, 0x1b, 0x31,
-	0xf3, 0x98, 0xcb, 0x92, 0x24, 0x8c, 0xf1, 0x53, 0x53, 0x42, 0xb6, 0x1b, 0xc8, 0x2b, 0xc2, 0xbb,
-	0xe3, 0xb1, 0x38, 0xaf, 0xb3, 0x22, 0x9d, 0x52, 0x52, 0x34, 0x5a, 0xd6, 0x3c, 0x8b, 0x67, 0x05,
-	0x64, 0x7d, 0x6f, 0xe4, 0x4d, 0x13, 0x59, 0x0f, 0x33, 0x74, 0x6c, 0x14, 0xb3, 0xd6, 0x5a, 0x9a,
-	0xdb, 0xc9, 0x8b, 0xd0, 0x5c, 0x4b, 0x35, 0xc3, 0x6a, 0xd2, 0xe5, 0x5c, 0x41, 0x5e, 0x86, 0x3a,
-	0x65, 0x49, 0x3a, 0xe6, 0x18, 0x60, 0x5b, 0xf9, 0x51, 0x3a, 0x8a, 0x36, 0xd2, 0x87, 0xe6, 0x4a,
-	0xe6, 0xb2, 0x88, 0xfb, 0x61, 0x80, 0xfd, 0x3a, 0x6a, 0xe3, 0x40, 0xe4, 0x06, 0x3a, 0xc7, 0x58,
-	0xb7, 0xb1, 0x73, 0xe4, 0x63, 0xa8, 0x0f, 0xb3, 0xab, 0x4e, 0xb2, 0x21, 0xcb, 0xd8, 0x1e, 0x5c,
-	0xd8, 0x9c, 0xf5, 0x0e, 0x3d, 0x9e, 0xf5, 0xce, 0xed, 0xce, 0x99, 0x75, 0x3f, 0x70, 0xe2, 0xa9,
-	0x7d, 0x95, 0x65, 0x83, 0x29, 0x67, 0x09, 0x45, 0x27, 0xd6, 0x9f, 0xc6, 0x3c, 0x73, 0xf2, 0xa1,
-	0xf0, 0x3d, 0x9c, 0x46, 0x4c, 0xd6, 0x60, 0x71, 0xb0, 0xf4, 0x74, 0xd6, 0xb3, 0xf7, 0xe4, 0x62,
-	0x3f, 0x72, 0xa6, 0xe3, 0xd0, 0xf1, 0x6c, 0xf1, 0x25, 0x45, 0x0f, 0x85, 0x38, 0x2b, 0x07, 0x10,
-	0x67, 0xa1, 0x89, 0xd5, 0x12, 0xab, 0x8e, 0x41, 0x6d, 0x35, 0xf0, 0x58, 0x86, 0x8c, 0x51, 0x82,
-	0x68, 0xc2, 0xf5, 0xd8, 0x1f, 0xf9, 0x01, 0x12, 0x1d, 0x9b, 0xa0, 0x74, 0x14, 0x6d, 0xd6, 0x0f,
-	0x06, 0x1c, 0x96, 0x14, 0x59, 0xc9, 0x98, 0x9b, 0x8a, 0x32, 0x3f, 0x93, 0xbc, 0xff, 0x06, 0x49,
-	0xc5, 0xb6, 0x1a, 0x66, 0xf9, 0x6f, 0x8b, 0xb9, 0x28, 0x6c, 0xab, 0x82, 0x85, 0x96, 0x60, 0xd6,
-	0xfb, 0x70, 0xb8, 0x20, 0x7f, 0xc4, 0xa6, 0xbb, 0x8d, 0xdc, 0xf5, 0x3b, 0x77, 0x12, 0xa6, 0xb8,
-	0x68, 0x52, 0x94, 0xac, 0xdf, 0x2b, 0xd0, 0x2a, 0xb8, 0x20, 0x67, 0xf3, 0x78, 0x77, 0xe4, 0xfe,
-	0xc0, 0x7c, 0x34, 0xeb, 0x19, 0x79, 0xd8, 0xc5, 0x15, 0x56, 0x3f, 0xd8, 0x15, 0x76, 0x12, 0xea,
-	0x38, 0x57, 0x0b, 0xb2, 0x16, 0xa5, 0x1d, 0x56, 0xdf, 0x36, 0x61, 0x8d, 0x5d, 0x26, 0xec, 0x14,
-	0x2c, 0x50, 0xe6, 0x32, 0x3f, 0xe2, 0x9d, 0x26, 0xc2, 0xc4, 0x8f, 0xa2, 0x8e, 0x6a, 0x63, 0x79,
-	0x12, 0x61, 0xef, 0x49, 0xdc, 0xd6, 0xb5, 0xd6, 0xf3, 0x75, 0xed, 0x6b, 0x43, 0x73, 0x92, 0x74,
-	0x60, 0xe1, 0xf2, 0x86, 0xe3, 0x07, 0xab, 0xcb, 0xb2, 0xde, 0x4d, 0xaa, 0xc5, 0x42, 0x23, 0x2b,
-	0x3b, 0xb3, 0xbc, 0x5a, 0x64, 0xf9, 0x3b, 0x60, 0x0e, 0xfd, 0x09, 0xc3, 0xfd, 0x71, 0xdc, 0x56,
-	0x37, 0xae, 0xad, 0x6f, 0x5c, 0x7b, 0xa8, 0x6f, 0xdc, 0x41, 0x43, 0x0c, 0xdf, 0x37, 0xbf, 0xf6,
-	0x0c, 0x2a, 0xbf, 0xb0, 0x7e, 0xae, 0xe8, 0x8e, 0xff, 0x97, 0x67, 0xfe, 0x75, 0x68, 0xca, 0x96,
-	0xcb, 0xe8, 0xaa, 0x32, 0xba, 0xc5, 0xa7, 0xb3, 0xde, 0x5c, 0x49, 0xe7, 0x47, 0x51, 0x54, 0x29,
-	0xac, 0x2e, 0xcb, 0x7a, 0x34, 0xa9, 0x16, 0x0b, 0x45, 0xad, 0xed, 0x5c, 0xd4, 0x7a, 0xb1, 0xa8,
-	0x25, 0x3e, 0x2c, 0xec, 0xcd, 0x87, 0x8b, 0xe6, 0x83, 0x87, 0xbd, 0x43, 0xd6, 0xfd, 0x0a, 0xde,
-	0xbe, 0x82, 0x9e, 0xa5, 0x61, 0x42, 0x7a, 0xfe, 0x6d, 0xf6, 0x4f, 0x89, 0x1f, 0x8f, 0x52, 0x7d,
-	0x4b, 0xe0, 0xeb, 0x42, 0xaa, 0xf0, 0xc6, 0x96, 0x67, 0xf2, 0x1a, 0xd4, 0xaf, 0xa7, 0x5c, 0x00,
-	0xab, 0x3a, 0x16, 0xb9, 0xc9, 0xa4, 0x0e, 0xe7, 0x42, 0x09, 0xe4, 0x24, 0x98, 0x97, 0x9d, 0xf1,
-	0x18, 0xe9, 0xf0, 0x3f, 0x05, 0x14, 0x1a, 0x05, 0x93, 0x46, 0x72, 0x02, 0xaa, 0xd7, 0xc2, 0x11,
-	0xae, 0x45, 0x9c, 0xf3, 0x6b, 0xe1, 0x48, 0x41, 0x84, 0x89, 0xbc, 0x07, 0x8b, 0x57, 0xc2, 0x7b,
-	0x2c, 0x0e, 0x2e, 0xb9, 0x6e, 0x98, 0x06, 0x1c, 0x67, 0xbc, 0xa3, 0xb0, 0x25, 0x93, 0xfa, 0xaa,
-	0x0c, 0xbf, 0xd8, 0x10, 0xf5, 0x90, 0x0f, 0x83, 0x07, 0x86, 0x9e, 0x54, 0xd1, 0x03, 0xca, 0x78,
-	0x1a, 0x07, 0xb2, 0x28, 0x6d, 0x8a, 0x92, 0xe8, 0xda, 0x15, 0x27, 0xb9, 0x95, 0x30, 0x0f, 0x19,
-	0xaf, 0x45, 0x72, 0x06, 0x9a, 0x6b, 0xce, 0x84, 0xad, 0x04, 0x3c, 0x9e, 0x62, 0xee, 0x6d, 0x5b,
-	0x3d, 0x12, 0xa5, 0x8e, 0xce, 0xcd, 0xe4, 0x3c, 0x34, 0x6e, 0xb0, 0x78, 0x72, 0x29, 0x1e, 0x25,
-	0x98, 0xfd, 0x31, 0xbb, 0xf0, 0x6e, 0xd4, 0x36, 0x9a, 0xa3, 0xac, 0x3f, 0x0c, 0x68, 0xe8, 0xb4,
-	0xc9, 0x1a, 0x2c, 0x5c, 0xf2, 0xbc, 0x98, 0x25, 0x89, 0x8a, 0x6e, 0xf0, 0x26, 0xf2, 0xf6, 0xec,
-	0xee, 0xbc, 0x75, 0xe3, 0x69, 0xc4, 0x43, 0x1b, 0xbf, 0xa5, 0xda, 0x09, 0x59, 0x05, 0x73, 0xd9,
-	0xe1, 0xce, 0xfe, 0x86, 0x40, 0xba, 0x20, 0xd7, 0xa0, 0x3e, 0x0c, 0x23, 0xdf, 0x55, 0x97, 0xc3,
-	0x73, 0x47, 0x86, 0xce, 0x3e, 0x0d, 0x63, 0x6f, 0xe9, 0xc2, 0x5b, 0x14, 0x7d, 0x58, 0xdf, 0x55,
-	0xa0, 0x99, 0x13, 0x82, 0x9c, 0x86, 0x86, 0x10, 0xe4, 0x74, 0xd5, 0xe4, 0x74, 0xb5, 0x9f, 0xce,
-	0x7a, 0xb9, 0x8e, 0xe6, 0x27, 0xf1, 0x3a, 0x12, 0x67, 0x99, 0x54, 0xe9, 0x86, 0xd0, 0x5a, 0x9a,
-	0xdb, 0x45, 0xc4, 0x78, 0xf5, 0x56, 0xf6, 0x51, 0x4b, 0xbd, 0x2a, 0xbb, 0x00, 0x37, 0xb9, 0xe3,
-	0xde, 0x5d, 0x66, 0x11, 0xdf, 0xc0, 0xed, 0x57, 0xd0, 0x88, 0x8d, 0x83, 0xbc, 0x32, 0xf7, 0xb5,
-	0x71, 0x94, 0x13, 0xeb, 0x13, 0x20, 0xdb, 0x09, 0x4e, 0xde, 0x85, 0x45, 0x94, 0x6f, 0x45, 0x9e,
-	0xc3, 0x19, 0xd6, 0xe0, 0xff, 0xb6, 0xfc, 0x4f, 0x64, 0xc8, 0x26, 0xd1, 0xd8, 0xe1, 0x0c, 0x21,
-	0xb4, 0x8c, 0xb5, 0xbe, 0x00, 0x98, 0x4f, 0xf5, 0x41, 0x53, 0xcd, 0xfa, 0x12, 0x5a, 0x85, 0x55,
-	0x70, 0xe0, 0xee, 0xbf, 0xad, 0x40, 0xa9, 0xb3, 0xe2, 0x8c, 0x8b, 0xed, 0x1f, 0x77, 0x56, 0xf9,
-	0xc8, 0xbd, 0xb1, 0xfd, 0xf1, 0x44, 0xf9, 0xc8, 0x47, 0xae, 0xba, 0xff, 0x91, 0x3b, 0x06, 0xb5,
-	0xdb, 0xce, 0x38, 0x65, 0xfa, 0x45, 0x29, 0x05, 0x72, 0x04, 0xaa, 0x57, 0x1c, 0xfd, 0xdc, 0x17,
-	0xc7, 0xc1, 0x07, 0x9b, 0x5b, 0x5d, 0xe3, 0xd1, 0x56, 0xd7, 0xf8, 0x65, 0xab, 0x6b, 0xfc, 0xb6,
-	0xd5, 0x35, 0x7e, 0x7a, 0xd2, 0x35, 0x36, 0x9f, 0x74, 0x8d, 0xcf, 0xf7, 0x48, 0x81, 0xe9, 0x47,
-	0x81, 0x3c, 0xad, 0xd7, 0xe5, 0x7d, 0xfd, 0xc6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xf0,
-	0x3b, 0xe7, 0xab, 0x0f, 0x00, 0x00,
+	// 1659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0xcb, 0x7f, 0x8f, 0x94, 0x65, 0x0f, 0xdc, 0x82, 0x10, 0x0a, 0x51, 0x5d, 0xbb,
+	0xae, 0xac, 0xda, 0xa4, 0xa1, 0xd6, 0x6d, 0xe1, 0x02, 0x45, 0x45, 0x49, 0x96, 0x55, 0xcb, 0x92,
+	0x3b, 0xa2, 0x6d, 0xb4, 0x68, 0x0b, 0xac, 0x76, 0x47, 0xab, 0x85, 0xc9, 0xdd, 0xed, 0xec, 0xac,
+	0x4d, 0x7e, 0x85, 0x9e, 0xea, 0x5b, 0x0b, 0xf4, 0xe0, 0xcf, 0x10, 0xe4, 0x96, 0x4b, 0x90, 0x93,
+	0x6e, 0xf1, 0x25, 0x48, 0xe0, 0x03, 0x13, 0xc8, 0xc7, 0x7c, 0x82, 0xe8, 0x14, 0xcc, 0xec, 0xcc,
+	0x72, 0x56, 0xb6, 0x65, 0x25, 0x52, 0x80, 0x5c, 0x84, 0x79, 0xef, 0xfd, 0xf6, 0xcd, 0xcc, 0xfb,
+	0xf3, 0x9b, 0x47, 0x01, 0x90, 0x21, 0x71, 0xda, 0x11, 0x0d, 0x59, 0x88, 0x4c, 0xbe, 0x9e, 0xb9,
+	0xe1, 0xf9, 0x6c, 0x2f, 0xd9, 0x69, 0x3b, 0xe1, 0xa0, 0xe3, 0x85, 0x5e, 0xd8, 0x11, 0xc6, 0x9d,
+	0x64, 0x57, 0x48, 0x42, 0x10, 0xab, 0xf4, 0xa3, 0x99, 0xdf, 0x69, 0x70, 0x46, 0x02, 0x97, 0xd0,
+	0x81, 0x1f, 0x30, 0x7d, 0x69, 0xef, 0x38, 0x7e, 0x87, 0x8d, 0x22, 0x12, 0xa7, 0x7f, 0xe5, 0x87,
+	0x2d, 0x2f, 0x0c, 0xbd, 0x3e, 0x99, 0xb8, 0x67, 0xfe, 0x80, 0xc4, 0xcc, 0x1e, 0x44, 0x12, 0xd0,
+	0x20, 0x94, 0x86, 0x54, 0xc1, 0xeb, 0x81, 0x3d, 0xc8, 0xbe, 0xad, 0xb1, 0xa1, 0x5a, 0x5e, 0x88,
+	0xf8, 0x36, 0x71, 0xec, 0x87, 0x81, 0xd4, 0x40, 0x1c, 0xa9, 0x2b, 0x59, 0xab, 0xd0, 0xd8, 0x66,
+	0x94, 0xd8, 0x83, 0xd5, 0xa7, 0x24, 0x60, 0x31, 0xba, 0x95, 0x97, 0x9b, 0xc6, 0x5c, 0x71, 0xbe,
+	0xbe, 0x78, 0xb1, 0x2d, 0xa2, 0xa0, 0x59, 0x70, 0x0e, 0x66, 0x7d, 0x54, 0x80, 0xba, 0xa6, 0x40,
+	0x37, 0x01, 0xba, 0xc4, 0xf3, 0x83, 0x6e, 0x3f, 0x74, 0x9e, 0x34, 0x8d, 0x39, 0x63, 0xbe, 0xbe,
+	0x78, 0x21, 0x75, 0x32, 0xd1, 0x63, 0x0d, 0x83, 0x7e, 0x09, 0x15, 0x21, 0xf5, 0x86, 0xcd, 0x82,
+	0x80, 0x4f, 0x69, 0xf0, 0xde, 0x10, 0x2b, 0x2b, 0xfa, 0x2b, 0x54, 0x57, 0x83, 0xa7, 0xa4, 0x1f,
+	0x46, 0xa4, 0x59, 0x94, 0x48, 0x7e, 0x5b, 0xa5, 0xec, 0xb6, 0x5f, 0x8d, 0x5b, 0x0b, 0x5a, 0xd0,
+	0xf7, 0x46, 0x11, 0xa1, 0x7d, 0xe2, 0x7a, 0x84, 0x76, 0x76, 0x12, 0x4a, 0xc3, 0x67, 0x1d, 0x1d,
+	0x8f, 0x33, 0x77, 0xe8, 0xe7, 0x50, 0x12, 0xc7, 0x6f, 0x9a, 0xc2, 0x6f, 0x3d, 0x3d, 0x41, 0x7a,
+	0xdf, 0xd4, 0x22, 0x20, 0x81, 0xdb, 0x1b, 0x36, 0x4b, 0x39, 0x08, 0x57, 0xe1, 0xd4, 0x82, 0x16,
+	0xf8, 0x01, 0xdd, 0xf4, 0xe6, 0x65, 0x81, 0x3a, 0x9f, 0xa1, 0xd2, 0x7b, 0x67, 0xf6, 0xdb, 0xe6,
+	0xfe, 0x8b, 0x96, 0x61, 0x3d, 0x37, 0xf4, 0x70, 0xa1, 0x9f, 0x42, 0xf9, 0x2e, 0xf1, 0xbd, 0x3d,
+	0x26, 0x02, 0x67, 0x62, 0x29, 0x71, 0xfd, 0x66, 0x32, 0xe8, 0x0d, 0x63, 0x71, 0x6f, 0x13, 0x4b,
+	0x09, 0x5d, 0x87, 0x8b, 0x0f, 0x28, 0x71, 0x89, 0x43, 0xe2, 0x38, 0xa4, 0xf2, 0x53, 0x53, 0x40,
+	0xde, 0x34, 0xa0, 0x5f, 0x70, 0xef, 0xb6, 0x4b, 0x68, 0x16, 0xe7, 0xb4, 0xe8, 0x52, 0x25, 0x96,
+	0x46, 0xcb, 0x9a, 0xdc, 0xe2, 0x5d, 0x07, 0xb2, 0x3e, 0x33, 0xb2, 0xa4, 0xf1, 0x5b, 0xf7, 0x86,
+	0xd2, 0xb1, 0xa1, 0xdf, 0x5a, 0x69, 0x71, 0x66, 0x47, 0x3f, 0x83, 0xda, 0x66, 0xa2, 0x2a, 0xac,
+	0x24, 0x5c, 0x4e, 0x14, 0xe8, 0x0a, 0x94, 0x31, 0x89, 0x93, 0x3e, 0x93, 0x07, 0x6c, 0xa4, 0x7e,
+	0x52, 0x1d, 0x96, 0x36, 0xd4, 0x81, 0xda, 0xea, 0xd0, 0x21, 0x11, 0xf3, 0xc3, 0x40, 0xe6, 0xeb,
+	0x62, 0x5b, 0x36, 0x44, 0x66, 0xc0, 0x13, 0x0c, 0xba, 0x06, 0xd5, 0xc7, 0x36, 0x0d, 0xfc, 0xc0,
+	0x8b, 0x9b, 0xe5, 0xb9, 0xe2, 0xa4, 0xc2, 0xa4, 0x16, 0x67, 0x66, 0xeb, 0x91, 0x4c, 0x32, 0xba,
+	0x0f, 0xe5, 0xde, 0xf0, 0xae, 0x1d, 0xef, 0x89, 0x88, 0x37, 0xba, 0xb7, 0xf6, 0xc7, 0xad, 0x73,
+	0xaf, 0xc6, 0xad, 0x1b, 0xc7, 0x97, 0xd7, 0x8e, 0x1f, 0xd8, 0x74, 0xd4, 0xbe, 0x4b, 0x86, 0xdd,
+	0x11, 0x23, 0x31, 0x96, 0x4e, 0xac, 0x6f, 0x8c, 0x49, 0x90, 0xd0, 0x9f, 0xb9, 0xef, 0xde, 0x28,
+	0x22, 0x22, 0x5c, 0x53, 0xdd, 0xc5, 0xc3, 0x71, 0xab, 0xfd, 0xde, 0xb2, 0xed, 0x44, 0xf6, 0xa8,
+	0x1f, 0xda, 0x6e, 0x9b, 0x7f, 0x89, 0xa5, 0x07, 0xed, 0x9c, 0x85, 0x33, 0x38, 0xa7, 0x96, 0xef,
+	0x62, 0xae, 0x00, 0x2f, 0x41, 0x69, 0x3d, 0x70, 0xc9, 0x50, 0x16, 0x57, 0x2a, 0xf0, 0x7c, 0x6d,
+	0x51, 0xdf, 0xf3, 0x83, 0x66, 0x49, 0xcf, 0x57, 0xaa, 0xc3, 0xd2, 0x66, 0x7d, 0x68, 0xc0, 0x79,
+	0x51, 0x4d, 0xab, 0x43, 0xe2, 0x24, 0x22, 0x23, 0xef, 0xaa, 0xf3, 0x1f, 0xa2, 0x9e, 0x39, 0xb1,
+	0xf5, 0x86, 0xd9, 0xde, 0xbc, 0x85, 0x34, 0x62, 0xd3, 0x2c, 0x38, 0x07, 0xb3, 0xfe, 0x04, 0xe7,
+	0x35, 0xf9, 0x1e, 0x19, 0x1d, 0xd7, 0x9d, 0x5b, 0xbb, 0xbb, 0x31, 0x49, 0xcb, 0xd6, 0xc4, 0x52,
+	0xb2, 0xfe, 0x5f, 0x84, 0xba, 0xe6, 0x02, 0x5d, 0xcf, 0xce, 0xfb, 0xd6, 0x36, 0xe9, 0x9a, 0x2f,
+	0xc7, 0x2d, 0x23, 0x3b, 0xb6, 0xce, 0x76, 0xe5, 0xb3, 0x65, 0xbb, 0xcb, 0x50, 0x96, 0x2d, 0x58,
+	0x99, 0x2b, 0x6a, 0x5c, 0xc6, 0x75, 0xb8, 0xfc, 0x46, 0x33, 0x56, 0x8f, 0x69, 0xc6, 0xab, 0x50,
+	0xc1, 0xc4, 0x21, 0x7e, 0xc4, 0x9a, 0x35, 0x09, 0xe3, 0x9b, 0x4a, 0x1d, 0x56, 0xc6, 0x7c, 0xd3,
+	0xc2, 0x09, 0x9a, 0xf6, 0x68, 0xd6, 0xea, 0x27, 0xca, 0x5a, 0xae, 0xd7, 0x1b, 0xc7, 0xf7, 0xfa,
+	0x07, 0x05, 0xa8, 0xf5, 0x86, 0xea, 0x80, 0xdf, 0x2d, 0x39, 0xb9, 0xeb, 0x14, 0x4e, 0x70, 0x9d,
+	0x26, 0x54, 0xd6, 0xec, 0xf8, 0x61, 0x4c, 0x5c, 0xd9, 0x59, 0x4a, 0x44, 0x16, 0x98, 0x1b, 0xa1,
+	0x17, 0x37, 0xcd, 0xb9, 0xe2, 0x64, 0xdb, 0x8d, 0xd0, 0x4b, 0xb3, 0x21, 0x6c, 0x68, 0x1e, 0xa6,
+	0x97, 0x29, 0xb1, 0x19, 0x89, 0x97, 0xc3, 0x80, 0x51, 0xdb, 0x61, 0xa2, 0xe3, 0xaa, 0xf8, 0xa8,
+	0x1a, 0xfd, 0x13, 0xa6, 0xd5, 0x7a, 0xc9, 0x75, 0x29, 0x89, 0x63, 0x51, 0x3c, 0x8d, 0xee, 0x6f,
+	0x24, 0x31, 0x5c, 0x3f, 0xbe, 0x62, 0x1c, 0x3a, 0x8a, 0x58, 0xd8, 0x96, 0xdf, 0xe2, 0xa3, 0xce,
+	0xac, 0x4d, 0xa8, 0xc8, 0x00, 0xa2, 0xcb, 0x60, 0x2e, 0x87, 0xae, 0x22, 0xb1, 0xe9, 0xc3, 0x71,
+	0xab, 0x2e, 0x4d, 0x5c, 0x8d, 0x85, 0x91, 0xdf, 0xfb, 0x3e, 0x89, 0x63, 0xdb, 0x23, 0x22, 0x4c,
+	0x35, 0xac, 0xc4, 0xdb, 0xe6, 0x7f, 0x5f, 0xb4, 0xce, 0x59, 0xff, 0x36, 0x14, 0x87, 0x70, 0xe8,
+	0xf2, 0x9e, 0xed, 0x07, 0xeb, 0x2b, 0xc2, 0x65, 0x0d, 0x2b, 0x51, 0x6b, 0xbc, 0xc2, 0xdb, 0x59,
+	0xa9, 0xa8, 0xb3, 0xd2, 0xef, 0xc1, 0xec, 0xf9, 0x03, 0x22, 0x9f, 0x86, 0x99, 0x76, 0x3a, 0x4c,
+	0xb5, 0xd5, 0x30, 0xd5, 0xee, 0xa9, 0x61, 0xaa, 0x5b, 0xe5, 0x31, 0xf9, 0xcf, 0x97, 0x2d, 0x03,
+	0x8b, 0x2f, 0xac, 0x4f, 0x0b, 0x50, 0xfe, 0xf1, 0x73, 0xf4, 0xaf, 0xa0, 0x26, 0x6a, 0x43, 0x9c,
+	0xae, 0x28, 0x4e, 0x37, 0x75, 0x38, 0x6e, 0x4d, 0x94, 0x78, 0xb2, 0xe4, 0x41, 0x15, 0xc2, 0xfa,
+	0x8a, 0x88, 0x47, 0x0d, 0x2b, 0x51, 0x0b, 0x6a, 0xe9, 0xed, 0x41, 0x2d, 0xeb, 0x41, 0xcd, 0x15,
+	0x7c, 0xe5, 0xfd, 0x05, 0x2f, 0xd3, 0xfb, 0xbc, 0x20, 0x07, 0x2b, 0x74, 0x45, 0x85, 0xb6, 0x69,
+	0xe8, 0x74, 0x72, 0x84, 0xab, 0xaf, 0xf2, 0xcd, 0xa3, 0x44, 0x0d, 0x00, 0x72, 0x70, 0x14, 0x2a,
+	0x39, 0x8c, 0x89, 0x35, 0xba, 0x06, 0xe5, 0xad, 0x84, 0x71, 0x60, 0x51, 0x9d, 0x45, 0xbc, 0x3c,
+	0x09, 0xcb, 0x90, 0x12, 0x20, 0xca, 0xd4, 0xee, 0xf7, 0x65, 0x39, 0x4c, 0xa7, 0x40, 0xae, 0x91,
+	0x0d, 0xc6, 0x97, 0x68, 0x0e, 0x8a, 0x1b, 0xa1, 0x27, 0x9f, 0xb1, 0xa3, 0x3d, 0xc8, 0x4d, 0xe8,
+	0x8f, 0x30, 0xb5, 0x16, 0x3e, 0x25, 0x34, 0x58, 0x72, 0x9c, 0x30, 0x09, 0x98, 0xe4, 0xe4, 0x66,
+	0x8a, 0xcd, 0x99, 0xd2, 0xaf, 0xf2, 0xf0, 0xdb, 0x55, 0x1e, 0x0f, 0x31, 0xf3, 0x7d, 0x6d, 0x28,
+	0x66, 0xe5, 0x39, 0xc0, 0x84, 0x25, 0x34, 0x10, 0x41, 0x69, 0x60, 0x29, 0xe9, 0x6c, 0x51, 0xc8,
+	0xb3, 0xc5, 0x02, 0xd4, 0x36, 0xed, 0x01, 0x59, 0x0d, 0x18, 0x1d, 0xc9, 0xbb, 0x37, 0xda, 0xe9,
+	0xfc, 0x2f, 0x74, 0x78, 0x62, 0x46, 0x37, 0xa1, 0xfa, 0x80, 0xd0, 0xc1, 0x12, 0x15, 0xec, 0xc2,
+	0xa1, 0x97, 0xda, 0xda, 0x4f, 0x02, 0x65, 0xc3, 0x19, 0x0a, 0xcd, 0x41, 0x7d, 0xcd, 0x8e, 0x31,
+	0xd9, 0x4d, 0x02, 0x97, 0xb8, 0xb2, 0x30, 0x74, 0x15, 0xea, 0x00, 0xac, 0xd9, 0xf1, 0x03, 0x1a,
+	0xee, 0xfa, 0x7d, 0x22, 0xa7, 0x29, 0x19, 0xd3, 0xad, 0xc8, 0x09, 0x5d, 0xc2, 0xc1, 0x1a, 0xc4,
+	0xba, 0x07, 0xb5, 0xcc, 0x20, 0x5e, 0x4a, 0x21, 0xc8, 0x0e, 0x97, 0x12, 0xaf, 0xb9, 0x65, 0x11,
+	0xd4, 0xf4, 0xb6, 0xa9, 0x80, 0x2e, 0x40, 0x71, 0xcd, 0x56, 0x23, 0x2f, 0x5f, 0x5a, 0x9f, 0x17,
+	0xa0, 0xaa, 0xd2, 0x82, 0x36, 0xa1, 0xa2, 0x28, 0xce, 0x38, 0x05, 0xc5, 0x29, 0x27, 0x68, 0x1d,
+	0xcc, 0x15, 0x9b, 0xd9, 0xa7, 0x6b, 0x52, 0xe1, 0x02, 0x6d, 0x40, 0xb9, 0x17, 0x46, 0xbe, 0x93,
+	0x0e, 0x1b, 0x27, 0x3e, 0x99, 0x74, 0xf6, 0x38, 0xa4, 0xee, 0xe2, 0xad, 0xdf, 0x62, 0xe9, 0x03,
+	0x2d, 0x40, 0x65, 0x85, 0xf0, 0x38, 0xb9, 0x4d, 0x53, 0x6f, 0x0b, 0xa9, 0xdc, 0x08, 0x3d, 0xac,
+	0x00, 0x68, 0x06, 0xaa, 0xdb, 0xe4, 0x5f, 0x09, 0x09, 0x1c, 0x22, 0xd3, 0x97, 0xc9, 0xdc, 0x86,
+	0x89, 0x6b, 0x3b, 0x8c, 0xb8, 0x22, 0x73, 0x35, 0x9c, 0xc9, 0x56, 0x00, 0x30, 0x71, 0xc7, 0xc7,
+	0x74, 0x11, 0x63, 0x5e, 0x4b, 0x32, 0x55, 0x13, 0x05, 0xb7, 0x6e, 0xfb, 0x5e, 0x60, 0xb3, 0x84,
+	0x2a, 0x56, 0x9f, 0x28, 0xd0, 0x15, 0x30, 0x45, 0xc5, 0xa5, 0x63, 0x56, 0xfe, 0xa8, 0x4b, 0xd4,
+	0xc3, 0xc2, 0x6a, 0xb9, 0xd9, 0x7e, 0x4b, 0xd4, 0x43, 0x08, 0x4c, 0x6d, 0x2b, 0xb1, 0xe6, 0x3a,
+	0xc1, 0x70, 0xe9, 0x06, 0x62, 0xcd, 0xeb, 0xe4, 0x91, 0xdd, 0x4f, 0x52, 0xda, 0xab, 0xe1, 0x54,
+	0xe0, 0xdd, 0x22, 0x48, 0x4a, 0xc6, 0xa7, 0x8a, 0x95, 0x68, 0x7d, 0x52, 0x80, 0x5a, 0xd6, 0xea,
+	0x68, 0x1e, 0xaa, 0x5c, 0x10, 0x5e, 0x4b, 0x82, 0x37, 0x1b, 0x87, 0xe3, 0x56, 0xa6, 0xc3, 0xd9,
+	0x8a, 0xff, 0xa4, 0xe1, 0x6b, 0x51, 0x0e, 0xb9, 0x71, 0x40, 0x69, 0x71, 0x66, 0x47, 0x1b, 0xea,
+	0x01, 0x93, 0x85, 0xf3, 0xfd, 0xaa, 0x50, 0x3d, 0x82, 0xb3, 0x00, 0xdb, 0xcc, 0x76, 0x9e, 0xac,
+	0x90, 0x88, 0xed, 0xc9, 0xd2, 0xd7, 0x34, 0xfc, 0x2d, 0x91, 0x8c, 0x61, 0x9e, 0xea, 0x2d, 0x91,
+	0x44, 0x63, 0x41, 0x63, 0xcd, 0x8e, 0xef, 0x84, 0xf4, 0x99, 0x4d, 0x5d, 0x51, 0x16, 0x7c, 0xc3,
+	0x9c, 0xce, 0xfa, 0x0b, 0xa0, 0x37, 0xe9, 0x0d, 0xfd, 0x01, 0xa6, 0xa4, 0xfc, 0x30, 0x72, 0x6d,
+	0x46, 0x64, 0x9c, 0x7e, 0xd2, 0x16, 0xff, 0x62, 0xe8, 0x91, 0x41, 0xd4, 0xb7, 0x19, 0x91, 0x10,
+	0x9c, 0xc7, 0x5a, 0x7f, 0x07, 0x98, 0x70, 0xfa, 0x59, 0x37, 0xb2, 0xf5, 0x0f, 0xa8, 0x6b, 0x0f,
+	0xc1, 0x99, 0xbb, 0xff, 0x5f, 0x01, 0x72, 0xd9, 0xe7, 0x6b, 0x42, 0x4f, 0xe5, 0x5b, 0xfa, 0xc8,
+	0xbc, 0x91, 0xd3, 0xd5, 0x52, 0xea, 0x23, 0x23, 0xb4, 0xe2, 0xe9, 0x09, 0x2d, 0x6b, 0x3c, 0xf9,
+	0xfb, 0x4f, 0x08, 0x8a, 0xa0, 0x4b, 0x19, 0x41, 0x77, 0xef, 0xec, 0x1f, 0xcc, 0x1a, 0x2f, 0x0f,
+	0x66, 0x8d, 0x2f, 0x0e, 0x66, 0x8d, 0xaf, 0x0e, 0x66, 0x8d, 0x8f, 0x5f, 0xcf, 0x1a, 0xfb, 0xaf,
+	0x67, 0x8d, 0xbf, 0xbd, 0xe7, 0x0a, 0x44, 0x8d, 0xf0, 0x62, 0xb5, 0x53, 0x16, 0xd3, 0xda, 0xaf,
+	0xbf, 0x1d, 0x00, 0x49, 0xcd, 0x4f, 0x33, 0x84, 0x13, 0x00, 0x00,
 }
 
 func (m *StreamEvents) Marshal() (dAtA []byte, err error) {
@@ -1703,6 +2144,20 @@ func (m *BeginTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Warnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NumEvents != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.NumEvents))
 		i--
@@ -1972,6 +2427,20 @@ func (m *TxExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Warnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.TxExecutions) > 0 {
 		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2063,7 +2532,7 @@ func (m *TxExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Origin) Marshal() (dAtA []byte, err error) {
+func (m *TxReceipt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2073,12 +2542,12 @@ func (m *Origin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Origin) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxReceipt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Origin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2087,35 +2556,73 @@ func (m *Origin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err18 != nil {
-		return 0, err18
+	{
+		size := m.ContractAddress.Size()
+		i -= size
+		if _, err := m.ContractAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
 	}
-	i -= n18
-	i = encodeVarintExec(dAtA, i, uint64(n18))
 	i--
-	dAtA[i] = 0x22
-	if m.Index != 0 {
-		i = encodeVarintExec(dAtA, i, uint64(m.Index))
+	dAtA[i] = 0x32
+	if m.CreatesContract {
+		i--
+		if m.CreatesContract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.GasUsed))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Height != 0 {
-		i = encodeVarintExec(dAtA, i, uint64(m.Height))
+	if m.Exception != nil {
+		{
+			size, err := m.Exception.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintExec(dAtA, i, uint64(len(m.ChainID)))
+	if m.TxHeader != nil {
+		{
+			size, err := m.TxHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Header) Marshal() (dAtA []byte, err error) {
+func (m *Warning) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2125,12 +2632,12 @@ func (m *Header) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+func (m *Warning) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Warning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2139,15 +2646,106 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Exception != nil {
-		{
-			size, err := m.Exception.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintExec(dAtA, i, uint64(size))
-		}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Origin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Origin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Origin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintExec(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if m.Index != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Exception != nil {
+		{
+			size, err := m.Exception.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -2314,6 +2912,25 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.GasProfile) > 0 {
+		for iNdEx := len(m.GasProfile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasRefunded != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PermArgs != nil {
 		{
 			size, err := m.PermArgs.MarshalToSizedBuffer(dAtA[:i])
@@ -2353,6 +2970,50 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpcodeGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Gas != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LogEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2377,6 +3038,32 @@ func (m *LogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Redacted) > 0 {
+		for iNdEx := len(m.Redacted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Redacted[iNdEx])
+			copy(dAtA[i:], m.Redacted[iNdEx])
+			i = encodeVarintExec(dAtA, i, uint64(len(m.Redacted[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decoded != nil {
+		{
+			size, err := m.Decoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Topics) > 0 {
 		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2414,6 +3101,119 @@ func (m *LogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DecodedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EventName) > 0 {
+		i -= len(m.EventName)
+		copy(dAtA[i:], m.EventName)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.EventName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedArg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedArg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedArg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Indexed {
+		i--
+		if m.Indexed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CallEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2438,6 +3238,11 @@ func (m *CallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GasForwarded != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.GasForwarded))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.CallType != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.CallType))
 		i--
@@ -2789,6 +3594,12 @@ func (m *BeginTx) Size() (n int) {
 	if m.NumEvents != 0 {
 		n += 1 + sovExec(uint64(m.NumEvents))
 	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2920,27 +3731,86 @@ func (m *TxExecution) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Origin) Size() (n int) {
+func (m *TxReceipt) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
+	if m.TxHeader != nil {
+		l = m.TxHeader.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
-	}
-	if m.Index != 0 {
-		n += 1 + sovExec(uint64(m.Index))
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExec(uint64(m.GasUsed))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.CreatesContract {
+		n += 2
+	}
+	l = m.ContractAddress.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Warning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovExec(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Origin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovExec(uint64(m.Index))
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
 	n += 1 + l + sovExec(uint64(l))
@@ -3041,6 +3911,37 @@ func (m *Result) Size() (n int) {
 		l = m.PermArgs.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovExec(uint64(m.GasRefunded))
+	}
+	if len(m.GasProfile) > 0 {
+		for _, e := range m.GasProfile {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OpcodeGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovExec(uint64(m.Count))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovExec(uint64(m.Gas))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3063,6 +3964,72 @@ func (m *LogEvent) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovExec(uint64(m.Sequence))
+	}
+	if len(m.Redacted) > 0 {
+		for _, s := range m.Redacted {
+			l = len(s)
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecodedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventName)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecodedArg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Indexed {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3089,6 +4056,9 @@ func (m *CallEvent) Size() (n int) {
 	if m.CallType != 0 {
 		n += 1 + sovExec(uint64(m.CallType))
 	}
+	if m.GasForwarded != 0 {
+		n += 1 + sovExec(uint64(m.GasForwarded))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3990,6 +4960,40 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &Warning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4813,6 +5817,40 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &Warning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4838,7 +5876,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Origin) Unmarshal(dAtA []byte) error {
+func (m *TxReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4861,17 +5899,17 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Origin: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Origin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4881,29 +5919,33 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if m.TxHeader == nil {
+				m.TxHeader = &TxHeader{}
+			}
+			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4913,16 +5955,33 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.Index = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4932,14 +5991,14 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4966,21 +6025,75 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &LogEvent{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatesContract", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthExec
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthExec
+			m.CreatesContract = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -4995,7 +6108,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *Warning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5018,17 +6131,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Warning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Warning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.TxType = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5038,16 +6151,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				m.Code |= WarningCode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5057,30 +6170,134 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Origin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Origin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Origin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.EventType = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5090,16 +6307,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventType |= EventType(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5109,29 +6326,84 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventID = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			m.Height = 0
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5141,16 +6413,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			m.Index = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5160,16 +6432,762 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &InputEvent{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &OutputEvent{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Call == nil {
+				m.Call = &CallEvent{}
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &LogEvent{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GovernAccount == nil {
+				m.GovernAccount = &GovernAccountEvent{}
+			}
+			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
+			if m.Return == nil {
+				m.Return = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NameEntry == nil {
+				m.NameEntry = &names.Entry{}
+			}
+			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PermArgs == nil {
+				m.PermArgs = &permission.PermArgs{}
+			}
+			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasProfile = append(m.GasProfile, &OpcodeGas{})
+			if err := m.GasProfile[len(m.GasProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpcodeGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5179,28 +7197,11 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
-			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5226,7 +7227,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5249,17 +7250,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5269,33 +7270,30 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5305,33 +7303,30 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &InputEvent{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5341,31 +7336,30 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &OutputEvent{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.Topics = append(m.Topics, v)
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5392,18 +7386,18 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Call == nil {
-				m.Call = &CallEvent{}
+			if m.Decoded == nil {
+				m.Decoded = &DecodedLog{}
 			}
-			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5413,33 +7407,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Log == nil {
-				m.Log = &LogEvent{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redacted", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5449,27 +7426,23 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GovernAccount == nil {
-				m.GovernAccount = &GovernAccountEvent{}
-			}
-			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Redacted = append(m.Redacted, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5496,7 +7469,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *DecodedLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5519,17 +7492,17 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5539,50 +7512,29 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
-			if m.Return == nil {
-				m.Return = []byte{}
-			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5592,31 +7544,27 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NameEntry == nil {
-				m.NameEntry = &names.Entry{}
-			}
-			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5643,10 +7591,8 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PermArgs == nil {
-				m.PermArgs = &permission.PermArgs{}
-			}
-			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, &DecodedArg{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5675,7 +7621,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEvent) Unmarshal(dAtA []byte) error {
+func (m *DecodedArg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,17 +7644,17 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedArg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedArg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5718,30 +7664,29 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5751,30 +7696,29 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5784,27 +7728,44 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_hyperledger_burrow_binary.Word256
-			m.Topics = append(m.Topics, v)
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Indexed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5999,6 +7960,25 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasForwarded", wireType)
+			}
+			m.GasForwarded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasForwarded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
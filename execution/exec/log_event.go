@@ -16,6 +16,9 @@ import (
 const logNTextTopicCutset = "\x00"
 const LogNKeyPrefix = "Log"
 
+// Queries may select logs by their per-address sequence, e.g. "Address = '...' AND Sequence > 42"
+const LogSequenceKey = "Sequence"
+
 func LogNKey(topic int) string {
 	return fmt.Sprintf("%s%d", LogNKeyPrefix, topic)
 }
@@ -37,7 +40,7 @@ func init() {
 		logNTopicIndex[logN] = i
 		logNTextTopicIndex[logNText] = i
 	}
-	logTagKeys = append(logTagKeys, event.AddressKey)
+	logTagKeys = append(logTagKeys, event.AddressKey, LogSequenceKey)
 }
 
 func (log *LogEvent) Get(key string) (interface{}, bool) {
@@ -48,6 +51,8 @@ func (log *LogEvent) Get(key string) (interface{}, bool) {
 	switch key {
 	case event.AddressKey:
 		value = log.Address
+	case LogSequenceKey:
+		value = log.Sequence
 	default:
 		if i, ok := logNTopicIndex[key]; ok {
 			return hex.EncodeUpperToString(log.GetTopic(i).Bytes()), true
@@ -72,3 +77,37 @@ func (log *LogEvent) SolidityEventID() abi.EventID {
 	copy(eventID[:], log.Topics[0].Bytes())
 	return eventID
 }
+
+// Decode the log according to eventSpec, which should be that identified by SolidityEventID
+func (log *LogEvent) Decode(eventSpec *abi.EventSpec) (*DecodedLog, error) {
+	vals := make([]interface{}, len(eventSpec.Inputs))
+	for i := range vals {
+		vals[i] = new(string)
+	}
+	err := abi.UnpackEvent(eventSpec, log.Topics, log.Data, vals...)
+	if err != nil {
+		return nil, err
+	}
+	decoded := &DecodedLog{
+		EventName: eventSpec.Name,
+		Signature: abi.Signature(eventSpec.Name, eventSpec.Inputs),
+		Args:      make([]*DecodedArg, len(eventSpec.Inputs)),
+	}
+	for i, arg := range eventSpec.Inputs {
+		typ := arg.EVM.GetSignature()
+		if arg.IsArray {
+			if arg.ArrayLength > 0 {
+				typ += fmt.Sprintf("[%d]", arg.ArrayLength)
+			} else {
+				typ += "[]"
+			}
+		}
+		decoded.Args[i] = &DecodedArg{
+			Name:    arg.Name,
+			Type:    typ,
+			Value:   *vals[i].(*string),
+			Indexed: arg.Indexed,
+		}
+	}
+	return decoded, nil
+}
@@ -179,6 +179,7 @@ func (stack *TxStack) Push(beginTx *BeginTx) {
 		Result:    beginTx.Result,
 		Events:    make([]*Event, 0, beginTx.NumEvents),
 		Exception: beginTx.Exception,
+		Warnings:  beginTx.Warnings,
 	})
 	stack.numEvents = append(stack.numEvents, beginTx.NumEvents)
 }
@@ -18,6 +18,7 @@ var genesisDoc, accounts, _ = genesis.NewDeterministicGenesis(345234523).Genesis
 
 func TestTxExecution(t *testing.T) {
 	txe := NewTxExecution(txs.Enclose(genesisDoc.ChainID(), newCallTx(0, 1)))
+	txe.Warn(WarningNearGasLimit, "execution used %d of a gas limit of %d", 95, 100)
 
 	stack := new(TxStack)
 	var txeOut *TxExecution
@@ -43,6 +43,7 @@ func (txe *TxExecution) StreamEvents() []*StreamEvent {
 				NumEvents: uint64(len(txe.Events)),
 				Exception: txe.Exception,
 				Result:    txe.Result,
+				Warnings:  txe.Warnings,
 			},
 		},
 		&StreamEvent{
@@ -106,6 +107,10 @@ func (txe *TxExecution) Output(address crypto.Address, exception *errors.Excepti
 }
 
 func (txe *TxExecution) Log(log *LogEvent) error {
+	if len(log.Data) >= LargeEventDataSize {
+		txe.Warn(WarningLargeEvent, "log event from %v has %d bytes of data (warning threshold is %d bytes)",
+			log.Address, len(log.Data), LargeEventDataSize)
+	}
 	txe.Append(&Event{
 		Header: txe.Header(TypeLog, EventStringLogEvent(log.Address), nil),
 		Log:    log,
@@ -145,6 +150,11 @@ func (txe *TxExecution) PushError(err error) bool {
 	return true
 }
 
+// Warnings record non-fatal issues and so, unlike errors, do not affect the outcome of execution
+func (txe *TxExecution) Warn(code WarningCode, format string, a ...interface{}) {
+	txe.Warnings = append(txe.Warnings, NewWarning(code, format, a...))
+}
+
 func (txe *TxExecution) CallTrace() string {
 	return Events(txe.Events).CallTrace()
 }
@@ -163,6 +173,29 @@ func (txe *TxExecution) CallError() *errors.CallError {
 	}
 }
 
+// TxReceipt returns the compact receipt of the transaction, which holds its logs only if it succeeded
+func (txe *TxExecution) TxReceipt() *TxReceipt {
+	receipt := &TxReceipt{
+		TxHeader:  txe.TxHeader,
+		Exception: txe.Exception,
+	}
+	if txe.Result != nil {
+		receipt.GasUsed = txe.Result.GasUsed
+	}
+	if txe.Receipt != nil {
+		receipt.CreatesContract = txe.Receipt.CreatesContract
+		receipt.ContractAddress = txe.Receipt.ContractAddress
+	}
+	if txe.Exception == nil {
+		for _, ev := range txe.Events {
+			if ev.Log != nil {
+				receipt.Logs = append(receipt.Logs, ev.Log)
+			}
+		}
+	}
+	return receipt
+}
+
 func (txe *TxExecution) TaggedEvents() Events {
 	return txe.Events
 }
@@ -176,6 +209,13 @@ func (txe *TxExecution) Return(returnValue []byte, gasUsed uint64) {
 	txe.Result.GasUsed = gasUsed
 }
 
+func (txe *TxExecution) Refund(gasRefunded uint64) {
+	if txe.Result == nil {
+		txe.Result = &Result{}
+	}
+	txe.Result.GasRefunded = gasRefunded
+}
+
 func (txe *TxExecution) Name(entry *names.Entry) {
 	if txe.Result == nil {
 		txe.Result = &Result{}
@@ -0,0 +1,78 @@
+package exec
+
+import (
+	"fmt"
+)
+
+type WarningCode uint32
+
+// Execution warning codes
+const (
+	WarningUnknown WarningCode = iota
+	WarningDeprecatedOpcode
+	WarningNearGasLimit
+	WarningLargeEvent
+)
+
+// Log data at or above this size will be flagged since large events are expensive to store and consume
+const LargeEventDataSize = 1 << 14
+
+var nameFromWarningCode = map[WarningCode]string{
+	WarningUnknown:          "UnknownWarning",
+	WarningDeprecatedOpcode: "DeprecatedOpcode",
+	WarningNearGasLimit:     "NearGasLimit",
+	WarningLargeEvent:       "LargeEvent",
+}
+
+var warningCodeFromName = make(map[string]WarningCode)
+
+func init() {
+	for c, n := range nameFromWarningCode {
+		warningCodeFromName[n] = c
+	}
+}
+
+// WarningSink may optionally be implemented by an EventSink that wishes to collect warnings about execution
+type WarningSink interface {
+	Warn(code WarningCode, format string, a ...interface{})
+}
+
+func WarningCodeFromString(name string) WarningCode {
+	return warningCodeFromName[name]
+}
+
+func (wc WarningCode) String() string {
+	name, ok := nameFromWarningCode[wc]
+	if ok {
+		return name
+	}
+	return "UnknownWarning"
+}
+
+func (wc WarningCode) MarshalText() ([]byte, error) {
+	return []byte(wc.String()), nil
+}
+
+func (wc *WarningCode) UnmarshalText(data []byte) error {
+	*wc = WarningCodeFromString(string(data))
+	return nil
+}
+
+func NewWarning(code WarningCode, format string, a ...interface{}) *Warning {
+	return &Warning{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// Push a warning to eventSink if it accepts warnings
+func Warn(eventSink EventSink, code WarningCode, format string, a ...interface{}) {
+	ws, ok := eventSink.(WarningSink)
+	if ok {
+		ws.Warn(code, format, a...)
+	}
+}
+
+func (w *Warning) String() string {
+	return fmt.Sprintf("%v: %s", w.Code, w.Message)
+}
@@ -5,24 +5,35 @@ package execution
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"runtime/debug"
+	"sort"
 	"sync"
+	"time"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/breaker"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/cron"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/escrow"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/heartbeat"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/execution/redact"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/execution/schedule"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
@@ -51,6 +62,11 @@ type ExecutorState interface {
 	names.Reader
 	registry.Reader
 	proposal.Reader
+	schedule.Reader
+	cron.Reader
+	escrow.Reader
+	heartbeat.Reader
+	chainparams.Reader
 	validator.IterableReader
 }
 type BatchExecutor interface {
@@ -67,8 +83,12 @@ type BatchExecutor interface {
 // Executes transactions
 type BatchCommitter interface {
 	BatchExecutor
+	// Run the cron jobs due at the start of the current block, before any of its transactions are executed
+	BeginBlock() error
 	// Commit execution results to underlying State and provide opportunity to mutate state before it is saved
 	Commit(header *abciTypes.Header) (stateHash []byte, err error)
+	// The payload types that can be executed, in ascending order
+	PayloadTypes() []payload.Type
 }
 
 type executor struct {
@@ -81,9 +101,22 @@ type executor struct {
 	nameRegCache     *names.Cache
 	nodeRegCache     *registry.Cache
 	proposalRegCache *proposal.Cache
+	scheduleCache    *schedule.Cache
+	cronCache        *cron.Cache
+	escrowCache      *escrow.Cache
+	heartbeatCache   *heartbeat.Cache
+	paramsCache      *chainparams.Cache
 	validatorCache   *validator.Cache
 	emitter          *event.Emitter
+	blockchain       engine.Blockchain
 	block            *exec.BlockExecution
+	blockGasUsed     uint64
+	blockStarted     time.Time
+	circuitBreaker   *breaker.CircuitBreaker
+	prefetcher       *Prefetcher
+	authorizations   *lru.Cache
+	private          contexts.PrivateExecutor
+	redactor         *redact.Redactor
 	logger           *logging.Logger
 	vmOptions        evm.Options
 	contexts         map[payload.Type]contexts.Context
@@ -107,8 +140,16 @@ var _ BatchExecutor = (*executor)(nil)
 func NewBatchChecker(backend ExecutorState, params Params, blockchain engine.Blockchain, logger *logging.Logger,
 	options ...Option) (BatchExecutor, error) {
 
-	return newExecutor("CheckCache", false, params, backend, blockchain, nil,
+	exe, err := newExecutor("CheckCache", false, params, backend, blockchain, nil,
 		logger.WithScope("NewBatchExecutor"), options...)
+	if err != nil {
+		return nil, err
+	}
+	exe.authorizations, err = lru.New(authorizationsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return exe, nil
 }
 
 func NewBatchCommitter(backend ExecutorState, params Params, blockchain engine.Blockchain, emitter *event.Emitter,
@@ -135,6 +176,11 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		nameRegCache:     names.NewCache(backend),
 		nodeRegCache:     registry.NewCache(backend),
 		proposalRegCache: proposal.NewCache(backend),
+		scheduleCache:    schedule.NewCache(backend),
+		cronCache:        cron.NewCache(backend),
+		escrowCache:      escrow.NewCache(backend),
+		heartbeatCache:   heartbeat.NewCache(backend),
+		paramsCache:      chainparams.NewCache(backend),
 		validatorCache:   validator.NewCache(backend),
 		emitter:          emitter,
 		block: &exec.BlockExecution{
@@ -146,24 +192,38 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 	for _, option := range options {
 		option(exe)
 	}
+	exe.blockchain = validatorsBlockchain{Blockchain: blockchain, validators: exe.validatorCache}
 
 	baseContexts := map[payload.Type]contexts.Context{
 		payload.TypeCall: &contexts.CallContext{
 			EVM:           evm.New(exe.vmOptions),
-			Blockchain:    blockchain,
+			Blockchain:    exe.blockchain,
 			State:         exe.stateCache,
 			MetadataState: exe.metadataCache,
+			NameReg:       exe.nameRegCache,
+			Cron:          exe.cronCache,
+			Escrows:       exe.escrowCache,
+			Params:        exe.paramsCache,
 			RunCall:       runCall,
 			Logger:        exe.logger,
 		},
 		payload.TypeSend: &contexts.SendContext{
 			State:  exe.stateCache,
+			Params: exe.paramsCache,
 			Logger: exe.logger,
 		},
+		payload.TypePrivate: &contexts.PrivateContext{
+			State:      exe.stateCache,
+			Private:    exe.private,
+			Blockchain: exe.blockchain,
+			RunCall:    runCall,
+			Logger:     exe.logger,
+		},
 		payload.TypeName: &contexts.NameContext{
 			Blockchain: blockchain,
 			State:      exe.stateCache,
 			NameReg:    exe.nameRegCache,
+			Params:     exe.paramsCache,
 			Logger:     exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
@@ -173,6 +233,9 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		payload.TypeGovernance: &contexts.GovernanceContext{
 			ValidatorSet: exe.validatorCache,
 			State:        exe.stateCache,
+			Schedule:     exe.scheduleCache,
+			Params:       exe.paramsCache,
+			Blockchain:   blockchain,
 			Logger:       exe.logger,
 		},
 		payload.TypeBond: &contexts.BondContext{
@@ -185,6 +248,12 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			State:        exe.stateCache,
 			Logger:       exe.logger,
 		},
+		payload.TypeHeartbeat: &contexts.HeartbeatContext{
+			ValidatorSet: exe.validatorCache,
+			Heartbeats:   exe.heartbeatCache,
+			Blockchain:   exe.blockchain,
+			Logger:       exe.logger,
+		},
 		payload.TypeIdentify: &contexts.IdentifyContext{
 			NodeWriter:  exe.nodeRegCache,
 			StateReader: exe.stateCache,
@@ -211,11 +280,32 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 	return exe, nil
 }
 
+// Exposes the validator set in force at the start of the current block to natives
+type validatorsBlockchain struct {
+	engine.Blockchain
+	validators *validator.Cache
+}
+
+func (vb validatorsBlockchain) Validators() validator.IterableReader {
+	return vb.validators.Previous
+}
+
 func (exe *executor) AddContext(ty payload.Type, ctx contexts.Context) *executor {
 	exe.contexts[ty] = ctx
 	return exe
 }
 
+func (exe *executor) PayloadTypes() []payload.Type {
+	payloadTypes := make([]payload.Type, 0, len(exe.contexts))
+	for ty := range exe.contexts {
+		payloadTypes = append(payloadTypes, ty)
+	}
+	sort.Slice(payloadTypes, func(i, j int) bool {
+		return payloadTypes[i] < payloadTypes[j]
+	})
+	return payloadTypes
+}
+
 // If the tx is invalid, an error will be returned.
 // Unlike ExecBlock(), state will not be altered.
 func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err error) {
@@ -233,13 +323,32 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 
 	logger.InfoMsg("Executing transaction", "tx", txEnv.String())
 
-	// Verify transaction signature against inputs
-	err = txEnv.Verify(exe.params.ChainID)
+	// Verify transaction signature against inputs (or have their authorizer contracts do so)
+	err = txEnv.VerifyWith(exe.params.ChainID, exe.authorize)
 	if err != nil {
 		logger.InfoMsg("Transaction Verify failed", structure.ErrorKey, err)
 		return nil, err
 	}
 
+	if exe.circuitBreaker != nil {
+		if exe.runCall {
+			if exe.blockStarted.IsZero() {
+				exe.blockStarted = time.Now()
+			}
+		} else {
+			// Restrictions are only ever applied to mempool admission so that delivery remains deterministic
+			feeExempt, err := chainparams.FeeExempt(exe.paramsCache, txEnv.Tx.Payload)
+			if err != nil {
+				return nil, err
+			}
+			err = exe.circuitBreaker.Admit(txEnv.Tx.Payload, feeExempt)
+			if err != nil {
+				logger.InfoMsg("Transaction rejected by circuit breaker", structure.ErrorKey, err)
+				return nil, err
+			}
+		}
+	}
+
 	if txExecutor, ok := exe.contexts[txEnv.Tx.Type()]; ok {
 		// Establish new TxExecution
 		txe := exe.block.Tx(txEnv)
@@ -250,19 +359,52 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			}
 		}()
 
-		err = exe.validateInputsAndStorePublicKeys(txEnv)
+		err = exe.checkMinFee(txEnv.Tx.Payload)
+		if err != nil {
+			logger.InfoMsg("Transaction fee is below the minimum fee", structure.ErrorKey, err)
+			txe.PushError(err)
+			return nil, err
+		}
+
+		err = exe.checkBlockGas(txEnv.Tx.Payload)
+		if err != nil {
+			logger.InfoMsg("Transaction exceeds block gas limit", structure.ErrorKey, err)
+			txe.PushError(err)
+			return nil, err
+		}
+
+		// No balance may be moved until the transaction is known to be valid since a rejected transaction leaves
+		// no trace in state
+		fp, err := exe.feePayment(txEnv)
+		if err != nil {
+			logger.InfoMsg("FeePayer could not pay transaction fee", structure.ErrorKey, err)
+			txe.PushError(err)
+			return nil, err
+		}
+
+		err = exe.validateInputsAndStorePublicKeys(txEnv, fp.credit())
 		if err != nil {
 			logger.InfoMsg("Transaction validate failed", structure.ErrorKey, err)
 			txe.PushError(err)
 			return nil, err
 		}
 
+		err = exe.payFee(txEnv, fp)
+		if err != nil {
+			logger.InfoMsg("FeePayer could not pay transaction fee", structure.ErrorKey, err)
+			txe.PushError(err)
+			return nil, err
+		}
+
 		err = txExecutor.Execute(txe, txe.Envelope.Tx.Payload)
 		if err != nil {
 			logger.InfoMsg("Transaction execution failed", structure.ErrorKey, err)
 			txe.PushError(err)
 			return nil, err
 		}
+		if exe.runCall {
+			exe.blockGasUsed += txe.GetResult().GetGasUsed()
+		}
 
 		// Increment sequence numbers for Tx inputs
 		err = exe.updateSequenceNumbers(txEnv)
@@ -271,14 +413,62 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			txe.PushError(err)
 			return nil, err
 		}
+		if !exe.runCall && exe.prefetcher != nil {
+			exe.prefetcher.Prefetch(txEnv)
+		}
 		// Return execution for this tx
 		return txe, nil
 	}
 	return nil, fmt.Errorf("unknown transaction type: %v", txEnv.Tx.Type())
 }
 
-// Validate inputs, check sequence numbers and capture public keys
-func (exe *executor) validateInputsAndStorePublicKeys(txEnv *txs.Envelope) error {
+// Checks that a CallTx or NameTx pays at least the minimum fee unless it is a fee exempt call
+func (exe *executor) checkMinFee(p payload.Payload) error {
+	var fee uint64
+	switch tx := p.(type) {
+	case *payload.CallTx:
+		fee = tx.Fee
+	case *payload.NameTx:
+		fee = tx.Fee
+	default:
+		return nil
+	}
+	minFee, err := chainparams.MinFee(exe.paramsCache)
+	if err != nil || fee >= minFee {
+		return err
+	}
+	feeExempt, err := chainparams.FeeExempt(exe.paramsCache, p)
+	if err != nil || feeExempt {
+		return err
+	}
+	return errors.Errorf(errors.Codes.InsufficientFee, "fee of %d is below the minimum fee of %d", fee, minFee)
+}
+
+// Checks that the gas limit of a CallTx fits within the gas remaining under the block gas limit
+func (exe *executor) checkBlockGas(p payload.Payload) error {
+	tx, ok := p.(*payload.CallTx)
+	if !ok {
+		return nil
+	}
+	blockGasLimit, err := chainparams.BlockGasLimit(exe.paramsCache)
+	if err != nil || blockGasLimit == 0 {
+		return err
+	}
+	var gasRemaining uint64
+	if exe.blockGasUsed < blockGasLimit {
+		gasRemaining = blockGasLimit - exe.blockGasUsed
+	}
+	if tx.GasLimit > gasRemaining {
+		return errors.Errorf(errors.Codes.BlockGasLimitExceeded,
+			"CallTx gas limit %d exceeds the %d gas remaining in block with gas limit %d",
+			tx.GasLimit, gasRemaining, blockGasLimit)
+	}
+	return nil
+}
+
+// Validate inputs, check sequence numbers and capture public keys, counting credit (from a FeePayer) towards the
+// balance of the first input
+func (exe *executor) validateInputsAndStorePublicKeys(txEnv *txs.Envelope, credit uint64) error {
 	for s, in := range txEnv.Tx.GetInputs() {
 		err := exe.updateSignatory(txEnv.Signatories[s])
 		if err != nil {
@@ -302,26 +492,133 @@ func (exe *executor) validateInputsAndStorePublicKeys(txEnv *txs.Envelope) error
 				"so expected input to have sequence %d", in, in.Sequence, acc.Sequence, acc.Sequence+1)
 		}
 		// Check amount
-		if txEnv.Tx.Type() != payload.TypeUnbond && acc.Balance < in.Amount {
+		balance := acc.Balance
+		if s == 0 {
+			balance += credit
+		}
+		if txEnv.Tx.Type() != payload.TypeUnbond && balance < in.Amount {
 			return errors.Codes.InsufficientFunds
 		}
 		// Check for Input permission
-		globalPerms, err := acmstate.GlobalAccountPermissions(exe.stateCache)
+		err = exe.checkInputPermission(acc)
 		if err != nil {
 			return err
 		}
-		v, err := acc.Permissions.Base.Compose(globalPerms.Base).Get(permission.Input)
-		if err != nil {
-			return err
+	}
+	return nil
+}
+
+func (exe *executor) checkInputPermission(acc *acm.Account) error {
+	globalPerms, err := acmstate.GlobalAccountPermissions(exe.stateCache)
+	if err != nil {
+		return err
+	}
+	v, err := acc.Permissions.Base.Compose(globalPerms.Base).Get(permission.Input)
+	if err != nil {
+		return err
+	}
+	if !v {
+		return errors.Codes.NoInputPermission
+	}
+	return nil
+}
+
+// The fee a FeePayer pays on behalf of the first input of a transaction
+type feePayment struct {
+	payer       crypto.Address
+	fee         uint64
+	separateGas bool
+}
+
+// The amount the payment adds to the balance of the first input towards the amounts it spends, none when the fee is
+// paid in the separate gas token
+func (fp *feePayment) credit() uint64 {
+	if fp == nil || fp.separateGas {
+		return 0
+	}
+	return fp.fee
+}
+
+// Checks that the FeePayer (if there is one) has Input permission and can cover the transaction fee without moving
+// any funds, which is left to payFee once the transaction has been validated
+func (exe *executor) feePayment(txEnv *txs.Envelope) (*feePayment, error) {
+	if txEnv.FeePayer == nil {
+		return nil, nil
+	}
+	err := exe.updateSignatory(*txEnv.FeePayer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update public key for FeePayer %v: %v", *txEnv.FeePayer.Address, err)
+	}
+	payer, err := exe.stateCache.GetAccount(*txEnv.FeePayer.Address)
+	if err != nil {
+		return nil, err
+	}
+	if payer == nil {
+		return nil, errors.Errorf(errors.Codes.InvalidAddress, "FeePayer %v does not exist", *txEnv.FeePayer.Address)
+	}
+	err = exe.checkInputPermission(payer)
+	if err != nil {
+		return nil, err
+	}
+	fp := &feePayment{payer: payer.Address}
+	switch tx := txEnv.Tx.Payload.(type) {
+	case *payload.CallTx:
+		fp.fee = tx.Fee
+	case *payload.NameTx:
+		fp.fee = tx.Fee
+	}
+	fp.separateGas, err = chainparams.SeparateGasToken(exe.paramsCache)
+	if err != nil {
+		return nil, err
+	}
+	if fp.separateGas && payer.GasBalance < fp.fee || !fp.separateGas && payer.Balance < fp.fee {
+		return nil, errors.Errorf(errors.Codes.InsufficientFunds,
+			"FeePayer %v (balance: %d, gas balance: %d) cannot cover fee of %d", payer.Address, payer.Balance,
+			payer.GasBalance, fp.fee)
+	}
+	return fp, nil
+}
+
+// Transfers the transaction fee from the FeePayer (if there is one) to the first input, which then pays it as usual
+func (exe *executor) payFee(txEnv *txs.Envelope, fp *feePayment) error {
+	// Both CallTx and NameTx have a single input
+	if fp == nil || fp.fee == 0 || fp.payer == txEnv.Tx.GetInputs()[0].Address {
+		return nil
+	}
+	payer, err := exe.stateCache.GetAccount(fp.payer)
+	if err != nil {
+		return err
+	}
+	acc, err := exe.stateCache.GetAccount(txEnv.Tx.GetInputs()[0].Address)
+	if err != nil {
+		return err
+	}
+	if fp.separateGas {
+		err = payer.SubtractFromGasBalance(fp.fee)
+		if err == nil {
+			err = acc.AddToGasBalance(fp.fee)
 		}
-		if !v {
-			return errors.Codes.NoInputPermission
+	} else {
+		err = payer.SubtractFromBalance(fp.fee)
+		if err == nil {
+			err = acc.AddToBalance(fp.fee)
 		}
 	}
-	return nil
+	if err != nil {
+		return err
+	}
+	err = exe.stateCache.UpdateAccount(payer)
+	if err != nil {
+		return err
+	}
+	return exe.stateCache.UpdateAccount(acc)
 }
 
 func (exe *executor) updateSignatory(sig txs.Signatory) error {
+	if sig.PublicKey == nil {
+		// Signatory was verified by an authorizer contract so there is no public key to store
+		return nil
+	}
 	// pointer dereferences are safe since txEnv.Validate() is run by
 	// txEnv.Verify() above which checks they are non-nil
 	acc, err := exe.stateCache.GetAccount(*sig.Address)
@@ -351,7 +648,16 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 	}()
 	// Capture height
 	height := exe.block.Height
+	blockStarted := exe.blockStarted
+	if blockStarted.IsZero() {
+		blockStarted = time.Now()
+	}
 	exe.logger.InfoMsg("Executor committing", "height", exe.block.Height)
+	// Apply any governance scheduled for this height before the block's changes are written
+	err = exe.applyScheduledGovTxs(height)
+	if err != nil {
+		return nil, err
+	}
 	// Form BlockExecution for this block from TxExecutions and Tendermint block header
 	blockExecution, err := exe.finaliseBlockExecution(header)
 	if err != nil {
@@ -381,6 +687,26 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 		if err != nil {
 			return err
 		}
+		err = exe.scheduleCache.Sync(ws)
+		if err != nil {
+			return err
+		}
+		err = exe.cronCache.Sync(ws)
+		if err != nil {
+			return err
+		}
+		err = exe.escrowCache.Sync(ws)
+		if err != nil {
+			return err
+		}
+		err = exe.heartbeatCache.Sync(ws)
+		if err != nil {
+			return err
+		}
+		err = exe.paramsCache.Sync(ws)
+		if err != nil {
+			return err
+		}
 		err = exe.validatorCache.Sync(ws)
 		if err != nil {
 			return err
@@ -405,11 +731,60 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 		return nil, fmt.Errorf("expected height at state tree version %d is %d but actual height is %d",
 			version, expectedHeight, height)
 	}
+	if exe.circuitBreaker != nil {
+		exe.circuitBreaker.RecordBlock(blockExecution, time.Since(blockStarted))
+	}
 	// Now state is fully committed publish events (this should be the last thing we do)
 	exe.publishBlock(blockExecution)
 	return hash, nil
 }
 
+// Applies the GovTxs scheduled for height in their own right, a failing GovTx is logged and discarded without
+// affecting the others
+func (exe *executor) applyScheduledGovTxs(height uint64) error {
+	scheduled, err := exe.scheduleCache.GetScheduledGovTxs(height)
+	if err != nil {
+		return err
+	}
+	if len(scheduled) == 0 {
+		return nil
+	}
+	ctx := &contexts.GovernanceContext{
+		State:        exe.stateCache,
+		ValidatorSet: exe.validatorCache,
+		Params:       exe.paramsCache,
+		Logger:       exe.logger,
+	}
+	for _, sgt := range scheduled {
+		// Each activation is recorded as a TxExecution of the GovTx so that its GovernAccount events are delivered
+		txe := exe.block.Tx(txs.Enclose(exe.params.ChainID, sgt.GovTx))
+		txe.TxHash = scheduledGovTxHash(sgt)
+		txe.Receipt.TxHash = txe.TxHash
+		events, err := ctx.ApplyScheduledGovTx(sgt)
+		if err != nil {
+			exe.logger.InfoMsg("Could not apply scheduled GovTx", structure.TxHashKey, sgt.TxHash,
+				"height", height, structure.ErrorKey, err)
+			txe.PushError(err)
+			continue
+		}
+		for _, ev := range events {
+			txe.GovernAccount(ev, nil)
+		}
+		exe.logger.InfoMsg("Applied scheduled GovTx", structure.TxHashKey, sgt.TxHash, "height", height)
+	}
+	return exe.scheduleCache.RemoveScheduledGovTxs(height)
+}
+
+// The hash under which the activation of a scheduled GovTx is recorded. It differs from the hash of the GovTx that
+// scheduled it (which is already indexed) by mixing in the activation height.
+func scheduledGovTxHash(sgt *payload.ScheduledGovTx) binary.HexBytes {
+	hasher := sha256.New()
+	hasher.Write(sgt.TxHash)
+	height := binary.Uint64ToWord256(sgt.Height)
+	hasher.Write(height[:])
+	return hasher.Sum(nil)
+}
+
 func (exe *executor) Reset() error {
 	// As with Commit() we do not take the write lock here
 	exe.stateCache.Reset(exe.state)
@@ -417,6 +792,13 @@ func (exe *executor) Reset() error {
 	exe.nameRegCache.Reset(exe.state)
 	exe.nodeRegCache.Reset(exe.state)
 	exe.proposalRegCache.Reset(exe.state)
+	exe.scheduleCache.Reset(exe.state)
+	exe.cronCache.Reset(exe.state)
+	exe.escrowCache.Reset(exe.state)
+	exe.heartbeatCache.Reset(exe.state)
+	exe.paramsCache.Reset(exe.state)
+	exe.blockGasUsed = 0
+	exe.blockStarted = time.Time{}
 	exe.validatorCache.Reset(exe.state)
 	return nil
 }
@@ -494,6 +876,8 @@ func (exe *executor) updateSequenceNumbers(txEnv *txs.Envelope) error {
 }
 
 func (exe *executor) publishBlock(blockExecution *exec.BlockExecution) {
+	// Subscribers receive redacted copies of the block as stored
+	blockExecution = exe.redactor.BlockExecution(blockExecution)
 	for _, txe := range blockExecution.TxExecutions {
 		publishErr := exe.emitter.Publish(context.Background(), txe, txe)
 		if publishErr != nil {
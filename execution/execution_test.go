@@ -13,11 +13,15 @@ import (
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/bcm"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/cron"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
@@ -27,6 +31,7 @@ import (
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
@@ -53,6 +58,23 @@ var testGenesisDoc, testPrivAccounts, _ = deterministicGenesis.
 	GenesisDoc(3, 1)
 var testChainID = testGenesisDoc.ChainID()
 
+func TestPayloadTypes(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), testGenesisDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+	payloadTypes := exe.PayloadTypes()
+	assert.Len(t, payloadTypes, len(exe.contexts))
+	for i, ty := range payloadTypes {
+		assert.Contains(t, exe.contexts, ty)
+		if i > 0 {
+			assert.True(t, payloadTypes[i-1] < ty, "payload types should be in ascending order")
+		}
+	}
+	assert.Contains(t, payloadTypes, payload.TypeCall)
+}
+
 func TestSendFails(t *testing.T) {
 	stateDB := dbm.NewDB("state", dbBackend, dbDir)
 	defer stateDB.Close()
@@ -1187,6 +1209,67 @@ func TestContractSend(t *testing.T) {
 	assert.Equal(t, sendAmt, accNonExistent.Balance, "value should have been transferred")
 }
 
+func TestAuthorizer(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, 1)
+
+	acc0 := getAccount(t, st, privAccounts[0].GetAddress())
+	acc1 := getAccount(t, st, privAccounts[1].GetAddress())
+	acc2 := getAccount(t, st, privAccounts[2].GetAddress())
+
+	// An authorizer that ignores the signature but only permits spending less than 100 per input:
+	// return _amount < 100
+	acc1.EVMCode = bc.MustSplice(PUSH1, 100, PUSH1, 68, CALLDATALOAD, LT, PUSH1, 0, MSTORE,
+		PUSH1, 32, PUSH1, 0, RETURN)
+	acc0.Authorizer = addressPtr(acc1)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(acc0)
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+
+	exe := makeExecutor(st)
+	send := func(amount, sequence uint64) error {
+		tx := payload.NewSendTx()
+		tx.AddInputWithSequence(privAccounts[0].GetPublicKey(), amount, sequence)
+		tx.AddOutput(acc2.Address, amount)
+		txEnv := txs.Enclose(testChainID, tx)
+		// No public key or valid signature - the authorizer takes over
+		txEnv.Signatories = []txs.Signatory{{
+			Address:   &acc0.Address,
+			Signature: &crypto.Signature{CurveType: crypto.CurveTypeEd25519, Signature: []byte("not a signature")},
+		}}
+		_, err := exe.Execute(txEnv)
+		if err != nil {
+			return err
+		}
+		_, err = exe.Commit(nil)
+		return err
+	}
+
+	require.NoError(t, send(10, acc0.Sequence+1))
+	assert.Equal(t, acc2.Balance+10, getAccount(t, st, acc2.Address).Balance)
+
+	err = send(200, acc0.Sequence+2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rejected")
+	assert.Equal(t, acc2.Balance+10, getAccount(t, st, acc2.Address).Balance)
+
+	// Accounts without an authorizer still require a valid signature
+	tx := payload.NewSendTx()
+	tx.AddInputWithSequence(privAccounts[2].GetPublicKey(), 10, acc2.Sequence+1)
+	tx.AddOutput(acc0.Address, 10)
+	txEnv := txs.Enclose(testChainID, tx)
+	txEnv.Signatories = []txs.Signatory{{
+		Address:   &acc2.Address,
+		Signature: &crypto.Signature{CurveType: crypto.CurveTypeEd25519, Signature: []byte("not a signature")},
+	}}
+	_, err = exe.Execute(txEnv)
+	require.Error(t, err)
+}
+
 func TestMerklePanic(t *testing.T) {
 	st, privAccounts := makeGenesisState(3, 1)
 
@@ -1493,6 +1576,893 @@ func TestPredecessorTracking(t *testing.T) {
 	require.Equal(t, uint64(5), exe.block.Height)
 }
 
+func TestScheduledGovTx(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	mkTx := func(activationHeight uint64, updates ...*spec.TemplateAccount) *payload.GovTx {
+		tx := payload.UpdateAccountTx(users[0].GetAddress(), updates...)
+		tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+		tx.ActivationHeight = activationHeight
+		return tx
+	}
+	address := users[1].GetAddress()
+	otherAddress := users[2].GetAddress()
+	balanceOf := func() uint64 {
+		return exe.getAccount(t, address).Balance
+	}
+	startBalance := balanceOf()
+
+	// Cannot schedule for the current block
+	err = exe.signExecuteCommit(mkTx(exe.block.Height, &spec.TemplateAccount{
+		Address: &address,
+		Amounts: balance.New().Native(1337),
+	}), users[0])
+	require.Error(t, err)
+
+	// Updates are atomic so the valid balance update is not applied alongside the invalid permission update
+	err = exe.signExecuteCommit(mkTx(0, &spec.TemplateAccount{
+		Address: &address,
+		Amounts: balance.New().Native(1337),
+	}, &spec.TemplateAccount{
+		Address:     &otherAddress,
+		Permissions: []string{"notAPermission"},
+	}), users[0])
+	require.Error(t, err)
+	require.Equal(t, startBalance, balanceOf())
+
+	activationHeight := exe.block.Height + 2
+	err = exe.signExecuteCommit(mkTx(activationHeight, &spec.TemplateAccount{
+		Address: &address,
+		Amounts: balance.New().Native(1337),
+	}), users[0])
+	require.NoError(t, err)
+	require.Equal(t, startBalance, balanceOf())
+
+	scheduled, err := st.GetScheduledGovTxs(activationHeight)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+
+	// Empty block before activation
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, startBalance, balanceOf())
+
+	// Activation
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1337), balanceOf())
+
+	// The activation is recorded with its events under its own hash alongside the GovTx that scheduled it
+	activation, err := st.TxByHash(scheduledGovTxHash(scheduled[0]))
+	require.NoError(t, err)
+	require.NotNil(t, activation)
+	assert.Equal(t, activationHeight, activation.Height)
+	require.Len(t, activation.Events, 1)
+	require.NotNil(t, activation.Events[0].GovernAccount)
+	assert.Equal(t, address, *activation.Events[0].GovernAccount.AccountUpdate.Address)
+	scheduling, err := st.TxByHash(scheduled[0].TxHash)
+	require.NoError(t, err)
+	require.NotNil(t, scheduling)
+	assert.Equal(t, activationHeight-2, scheduling.Height)
+
+	scheduled, err = st.GetScheduledGovTxs(activationHeight)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 0)
+
+	// Account updates are not applied without the chain params scheduled alongside them
+	tx := mkTx(exe.block.Height+1, &spec.TemplateAccount{
+		Address: &address,
+		Amounts: balance.New().Native(42),
+	})
+	tx.Params = &payload.ChainParams{MinFee: 1}
+	tx.ParamsMask = []string{"notAChainParam"}
+	sgt := &payload.ScheduledGovTx{Height: tx.ActivationHeight, TxHash: txs.Enclose(testChainID, tx).Tx.Hash(), GovTx: tx}
+	require.NoError(t, exe.scheduleCache.ScheduleGovTx(sgt))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1337), balanceOf())
+	activation, err = st.TxByHash(scheduledGovTxHash(sgt))
+	require.NoError(t, err)
+	require.NotNil(t, activation)
+	assert.NotNil(t, activation.Exception)
+	assert.Empty(t, activation.Events)
+}
+
+func TestTimeLockedValidatorPowerChange(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Validators[0].Amount = 100
+	genDoc.Params.MaxValidatorPowerChange = 10
+	genDoc.Params.ValidatorPowerChangeDelay = 2
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	sequence := func(tx *payload.GovTx) *payload.GovTx {
+		tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+		return tx
+	}
+	mkTx := func(activationHeight uint64, user acm.AddressableSigner, power uint64) *payload.GovTx {
+		publicKey := user.GetPublicKey()
+		tx := sequence(payload.UpdateAccountTx(users[0].GetAddress(), &spec.TemplateAccount{
+			PublicKey: &publicKey,
+			Amounts:   balance.New().Power(power),
+		}))
+		tx.ActivationHeight = activationHeight
+		return tx
+	}
+	powerOf := func(user acm.AddressableSigner) uint64 {
+		power, err := exe.validatorCache.Power(user.GetAddress())
+		require.NoError(t, err)
+		return power.Uint64()
+	}
+
+	// Within the permitted change so applied immediately
+	err = exe.signExecuteCommit(mkTx(0, users[1], 10), users[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), powerOf(users[1]))
+
+	// Too large a change cannot be scheduled within the delay window
+	err = exe.signExecuteCommit(mkTx(exe.block.Height+1, users[2], 20), users[0])
+	require.Error(t, err)
+	require.Equal(t, errors.Codes.InvalidBlockNumber, errors.GetCode(err))
+
+	// Too large a change is time-locked
+	activationHeight := exe.block.Height + 2
+	err = exe.signExecuteCommit(mkTx(0, users[2], 20), users[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), powerOf(users[2]))
+
+	// As is another scheduled in the next block
+	err = exe.signExecuteCommit(mkTx(0, users[3], 20), users[0])
+	require.NoError(t, err)
+
+	scheduled, err := st.GetScheduledGovTxs(activationHeight)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+	require.True(t, scheduled[0].TimeLocked)
+
+	vetoHeight := activationHeight + 1
+	scheduled, err = st.GetScheduledGovTxs(vetoHeight)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+
+	// Cannot veto a GovTx that is not pending
+	err = exe.signExecuteCommit(sequence(payload.VetoTx(users[0].GetAddress(), &payload.ScheduledGovTxID{
+		Height: vetoHeight + 1,
+		TxHash: scheduled[0].TxHash,
+	})), users[0])
+	require.Error(t, err)
+
+	err = exe.signExecuteCommit(sequence(payload.VetoTx(users[0].GetAddress(), &payload.ScheduledGovTxID{
+		Height: vetoHeight,
+		TxHash: scheduled[0].TxHash,
+	})), users[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), powerOf(users[2]))
+
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), powerOf(users[3]))
+
+	scheduled, err = st.GetScheduledGovTxs(vetoHeight)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 0)
+
+	getChainParams := func() *payload.ChainParams {
+		chainParams, err := st.GetChainParams()
+		require.NoError(t, err)
+		return chainParams
+	}
+
+	// Tightening the limit applies immediately
+	err = exe.signExecuteCommit(sequence(payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{
+		MaxValidatorPowerChange: 5,
+	})), users[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), getChainParams().MaxValidatorPowerChange)
+
+	// Lifting the limit or shortening the delay is itself time-locked
+	relaxHeight := exe.block.Height + 2
+	err = exe.signExecuteCommit(sequence(payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{},
+		"MaxValidatorPowerChange")), users[0])
+	require.NoError(t, err)
+	err = exe.signExecuteCommit(sequence(payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{
+		ValidatorPowerChangeDelay: 1,
+	})), users[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), getChainParams().MaxValidatorPowerChange)
+	require.Equal(t, uint64(2), getChainParams().ValidatorPowerChangeDelay)
+
+	scheduled, err = st.GetScheduledGovTxs(relaxHeight)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+	require.True(t, scheduled[0].TimeLocked)
+}
+
+func TestBlockGasLimit(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.BlockGasLimit = 150
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	address := users[2].GetAddress()
+	mkCallTx := func(gasLimit uint64) *payload.CallTx {
+		tx, err := payload.NewCallTx(exe.stateCache, users[1].GetPublicKey(), &address, nil, 10, gasLimit, 1)
+		require.NoError(t, err)
+		return tx
+	}
+
+	err = exe.signExecuteCommit(mkCallTx(100), users[1])
+	require.NoError(t, err)
+
+	err = exe.signExecuteCommit(mkCallTx(200), users[1])
+	require.Error(t, err)
+	require.Equal(t, errors.Codes.BlockGasLimitExceeded, errors.GetCode(err))
+
+	tx := payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{BlockGasLimit: 1000})
+	tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+	err = exe.signExecuteCommit(tx, users[0])
+	require.NoError(t, err)
+
+	chainParams, err := st.GetChainParams()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), chainParams.BlockGasLimit)
+
+	err = exe.signExecuteCommit(mkCallTx(200), users[1])
+	require.NoError(t, err)
+}
+
+func TestUpdateChainParams(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.BlockGasLimit = 150
+	genDoc.Params.MaxTxInstructions = 500
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	update := func(chainParams *payload.ChainParams, mask ...string) error {
+		tx := payload.UpdateChainParamsTx(users[0].GetAddress(), chainParams, mask...)
+		tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+		return exe.signExecuteCommit(tx, users[0])
+	}
+	getChainParams := func() *payload.ChainParams {
+		chainParams, err := st.GetChainParams()
+		require.NoError(t, err)
+		return chainParams
+	}
+
+	// Without a mask only the non-zero params are updated
+	require.NoError(t, update(&payload.ChainParams{MaxTxLogs: 3}))
+	chainParams := getChainParams()
+	assert.Equal(t, uint64(150), chainParams.BlockGasLimit)
+	assert.Equal(t, uint64(500), chainParams.MaxTxInstructions)
+	assert.Equal(t, uint64(3), chainParams.MaxTxLogs)
+
+	// With a mask only the named params are updated, which may be reset to zero
+	require.NoError(t, update(&payload.ChainParams{MaxTxInstructions: 1000}, "BlockGasLimit"))
+	chainParams = getChainParams()
+	assert.Equal(t, uint64(0), chainParams.BlockGasLimit)
+	assert.Equal(t, uint64(500), chainParams.MaxTxInstructions)
+	assert.Equal(t, uint64(3), chainParams.MaxTxLogs)
+
+	require.Error(t, update(&payload.ChainParams{}, "NoSuchParam"))
+}
+
+func TestNewAccountPermissions(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.NewAccountPermissions = permission.Call | permission.Send
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Send, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.CreateAccount, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.CreateContract, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	send := func(address crypto.Address) {
+		tx := payload.NewSendTx()
+		require.NoError(t, tx.AddInput(exe.stateCache, users[1].GetPublicKey(), 5))
+		require.NoError(t, tx.AddOutput(address, 5))
+		require.NoError(t, exe.signExecuteCommit(tx, users[1]))
+	}
+
+	// Accounts created by a SendTx get the new account permissions
+	address := crypto.Address{0xa, 0xb, 0xc}
+	send(address)
+	assert.Equal(t, permission.BasePermissions{
+		Perms:  permission.Call | permission.Send,
+		SetBit: permission.Call | permission.Send,
+	}, exe.getAccount(t, address).Permissions.Base)
+
+	// Permissions beyond the maximum are explicitly unset so are not inherited from the global permissions
+	tx := payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{
+		NewAccountPermissions:    permission.Call | permission.Send,
+		MaxNewAccountPermissions: permission.Call,
+	})
+	tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+	err = exe.signExecuteCommit(tx, users[0])
+	require.NoError(t, err)
+
+	expected := permission.BasePermissions{
+		Perms:  permission.Call,
+		SetBit: permission.AllPermFlags,
+	}
+	address = crypto.Address{0xc, 0xb, 0xa}
+	send(address)
+	assert.Equal(t, expected, exe.getAccount(t, address).Permissions.Base)
+
+	// As do contracts created by a CallTx
+	callTx, err := payload.NewCallTx(exe.stateCache, users[1].GetPublicKey(), nil,
+		wrapContractForCreate([]byte{0x60}), 1, 100, 1)
+	require.NoError(t, err)
+	err = exe.signExecuteCommit(callTx, users[1])
+	require.NoError(t, err)
+	contractAddress := crypto.NewContractAddress(callTx.Input.Address, getTxHash(callTx))
+	assert.Equal(t, expected, exe.getAccount(t, contractAddress).Permissions.Base)
+}
+
+func TestGasSchedule(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.GasSchedule = "ethereum"
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	// Sets and then clears a storage slot, which the Ethereum schedule refunds
+	contract := &acm.Account{
+		Address: crypto.Address{0xe, 0x7, 0x4},
+		EVMCode: bc.MustSplice(PUSH1, 0x01, PUSH1, 0x00, SSTORE, PUSH1, 0x00, PUSH1, 0x00, SSTORE, STOP),
+	}
+	exe.updateAccounts(t, contract)
+
+	// Simulated calls run under the chain's gas schedule
+	txe, err := CallSim(st, st, nil, exe.Blockchain, users[0].GetAddress(), contract.Address, nil, logger)
+	require.NoError(t, err)
+	assert.NotZero(t, txe.Result.GetGasRefunded())
+
+	txe, err = CallSim(st, nil, nil, exe.Blockchain, users[0].GetAddress(), contract.Address, nil, logger)
+	require.NoError(t, err)
+	assert.Zero(t, txe.Result.GetGasRefunded())
+
+	// An unknown gas schedule is rejected
+	tx := payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{GasSchedule: "shanghai"})
+	tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+	err = exe.signExecuteCommit(tx, users[0])
+	require.Error(t, err)
+}
+
+func TestCronJobs(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Call, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	// Increments the word at storage key zero
+	counter := &acm.Account{
+		Address: crypto.Address{0xc, 0x0, 0x0, 0x1},
+		EVMCode: bc.MustSplice(PUSH1, 0x00, SLOAD, PUSH1, 0x01, ADD, PUSH1, 0x00, SSTORE, STOP),
+	}
+	exe.updateAccounts(t, counter)
+	count := func() uint64 {
+		value, err := exe.GetStorage(counter.Address, Zero256)
+		require.NoError(t, err)
+		return Uint64FromWord256(LeftPadWord256(value))
+	}
+
+	scheduledAt := exe.block.Height
+	require.NoError(t, exe.cronCache.UpdateCronJob(&cron.Job{
+		ID:       1,
+		Payer:    users[1].GetAddress(),
+		Target:   counter.Address,
+		Interval: 2,
+		GasLimit: 100,
+		// Each call uses 11 gas
+		Budget:     44,
+		NextHeight: scheduledAt + 1,
+	}))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	var runs uint64
+	var deregistered bool
+	for !deregistered && exe.block.Height < scheduledAt+100 {
+		height := exe.block.Height
+		require.NoError(t, exe.BeginBlock())
+		for _, txe := range exe.block.TxExecutions {
+			require.Nil(t, txe.Exception)
+			// Every other block
+			assert.Equal(t, uint64(1), (height-scheduledAt)%2)
+			runs++
+			for _, ev := range txe.Events {
+				if ev.Log != nil && ev.Log.Address == native.CronAddress &&
+					len(ev.Log.Topics) > 0 && ev.Log.Topics[0] == cronDeregisteredTopic(t) {
+					deregistered = true
+				}
+			}
+		}
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		assert.Equal(t, runs, count())
+	}
+	require.True(t, deregistered, "job should be deregistered on exhausting its budget")
+	assert.Equal(t, uint64(4), runs)
+
+	job, err := st.GetCronJob(1)
+	require.NoError(t, err)
+	require.Nil(t, job)
+
+	// The call is made with the payer's permissions so losing them deregisters the job, refunding its budget
+	balance := exe.getAccount(t, users[2].GetAddress()).Balance
+	require.NoError(t, exe.cronCache.UpdateCronJob(&cron.Job{
+		ID:         2,
+		Payer:      users[2].GetAddress(),
+		Target:     counter.Address,
+		Interval:   1,
+		GasLimit:   100000,
+		Budget:     100000,
+		NextHeight: exe.block.Height + 1,
+	}))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.NoError(t, exe.BeginBlock())
+	require.Len(t, exe.block.TxExecutions, 1)
+	require.NotNil(t, exe.block.TxExecutions[0].Exception)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	job, err = st.GetCronJob(2)
+	require.NoError(t, err)
+	require.Nil(t, job)
+	assert.Equal(t, balance+100000, exe.getAccount(t, users[2].GetAddress()).Balance)
+
+	// Jobs beyond the gas cron jobs may use in a block are deferred to the next
+	spinner := &acm.Account{
+		Address: crypto.Address{0xc, 0x0, 0x0, 0x2},
+		EVMCode: bc.MustSplice(JUMPDEST, PUSH1, 0x00, JUMP),
+	}
+	exe.updateAccounts(t, spinner)
+	jobs := cron.MaxBlockGas/cron.MaxGasLimit + 1
+	for id := uint64(3); id < 3+jobs; id++ {
+		require.NoError(t, exe.cronCache.UpdateCronJob(&cron.Job{
+			ID:         id,
+			Payer:      users[1].GetAddress(),
+			Target:     spinner.Address,
+			Interval:   100,
+			GasLimit:   cron.MaxGasLimit,
+			Budget:     10 * cron.MaxGasLimit,
+			NextHeight: exe.block.Height + 1,
+		}))
+	}
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.NoError(t, exe.BeginBlock())
+	assert.Len(t, exe.block.TxExecutions, int(jobs-1))
+	// Identical calls made by different jobs in the same block are still distinct transactions
+	txHashes := make(map[string]bool)
+	for _, txe := range exe.block.TxExecutions {
+		txHashes[txe.TxHash.String()] = true
+	}
+	assert.Len(t, txHashes, int(jobs-1))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.NoError(t, exe.BeginBlock())
+	require.Len(t, exe.block.TxExecutions, 1)
+	// The deferred job is the last
+	job, err = exe.cronCache.GetCronJob(3 + jobs - 1)
+	require.NoError(t, err)
+	assert.Equal(t, exe.block.Height+100, job.NextHeight)
+}
+
+func cronDeregisteredTopic(t *testing.T) Word256 {
+	spec, err := native.EventSpec(native.CronDeregistered{})
+	require.NoError(t, err)
+	return Word256(spec.ID)
+}
+
+func TestSeparateGasToken(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.SeparateGasToken = true
+	genDoc.Accounts[1].GasAmount = 3
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	payer := users[1].GetAddress()
+	address := users[2].GetAddress()
+	balance := exe.getAccount(t, payer).Balance
+	assert.Equal(t, uint64(3), exe.getAccount(t, payer).GasBalance)
+
+	mkCallTx := func(fee uint64) *payload.CallTx {
+		tx, err := payload.NewCallTx(exe.stateCache, users[1].GetPublicKey(), &address, nil, 10, 100, fee)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// The fee comes out of the gas balance and the whole amount is sent
+	err = exe.signExecuteCommit(mkCallTx(2), users[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), exe.getAccount(t, payer).GasBalance)
+	assert.Equal(t, balance-10, exe.getAccount(t, payer).Balance)
+
+	err = exe.signExecuteCommit(mkCallTx(2), users[1])
+	require.Error(t, err)
+	require.Equal(t, errors.Codes.InsufficientFunds, errors.GetCode(err))
+	assert.Equal(t, balance-10, exe.getAccount(t, payer).Balance)
+}
+
+func TestFeeExemptCalls(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	exemptAddress := users[2].GetAddress()
+	otherAddress := users[3].GetAddress()
+	genDoc.Params.MinFee = 5
+	genDoc.Params.FeeExemptCalls = []genesis.FeeExemptCall{{
+		Caller: users[1].GetAddress(),
+		Callee: exemptAddress,
+	}}
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	mkCallTx := func(address crypto.Address, fee uint64) *payload.CallTx {
+		tx, err := payload.NewCallTx(exe.stateCache, users[1].GetPublicKey(), &address, nil, 10, 100, fee)
+		require.NoError(t, err)
+		return tx
+	}
+
+	err = exe.signExecuteCommit(mkCallTx(otherAddress, 1), users[1])
+	require.Error(t, err)
+	require.Equal(t, errors.Codes.InsufficientFee, errors.GetCode(err))
+
+	err = exe.signExecuteCommit(mkCallTx(otherAddress, 5), users[1])
+	require.NoError(t, err)
+
+	err = exe.signExecuteCommit(mkCallTx(exemptAddress, 0), users[1])
+	require.NoError(t, err)
+}
+
+func TestMaxTxInstructions(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.MaxTxInstructions = 100
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Root, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	// Cheap in gas but runs for more instructions than permitted
+	contract := exe.getAccount(t, users[2].GetAddress())
+	contract.EVMCode = append(bytes.Repeat([]byte{byte(JUMPDEST)}, 150), byte(STOP))
+	exe.updateAccounts(t, contract)
+
+	mkCallTx := func() *payload.CallTx {
+		tx, err := payload.NewCallTx(exe.stateCache, users[1].GetPublicKey(), &contract.Address, nil, 10, 1000, 1)
+		require.NoError(t, err)
+		return tx
+	}
+
+	err = exe.signExecuteCommit(mkCallTx(), users[1])
+	require.Error(t, err)
+	require.Equal(t, errors.Codes.InstructionLimit, errors.GetCode(err))
+
+	tx := payload.UpdateChainParamsTx(users[0].GetAddress(), &payload.ChainParams{MaxTxInstructions: 1000})
+	tx.Inputs[0].Sequence = exe.getAccount(t, users[0].GetAddress()).Sequence + 1
+	err = exe.signExecuteCommit(tx, users[0])
+	require.NoError(t, err)
+
+	err = exe.signExecuteCommit(mkCallTx(), users[1])
+	require.NoError(t, err)
+}
+
+func TestLogLimits(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Params.MaxLogDataSize = 32
+	genDoc.Params.MaxTxLogs = 2
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	contract := exe.getAccount(t, users[2].GetAddress())
+	mkCallTx := func(code ...byte) *payload.CallTx {
+		contract.EVMCode = append(code, byte(STOP))
+		exe.updateAccounts(t, contract)
+		tx, err := payload.NewCallTx(exe.stateCache, users[1].GetPublicKey(), &contract.Address, nil, 10, 1000, 1)
+		require.NoError(t, err)
+		return tx
+	}
+	logs := func(n int, size byte) []byte {
+		return bytes.Repeat([]byte{byte(PUSH1), size, byte(PUSH1), 0, byte(LOG0)}, n)
+	}
+
+	err = exe.signExecuteCommit(mkCallTx(logs(2, 32)...), users[1])
+	require.NoError(t, err)
+
+	denied := errors.PermissionDenied{Address: contract.Address, Perm: permission.Emit}.Error()
+	err = exe.signExecuteCommit(mkCallTx(logs(1, 33)...), users[1])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), denied)
+
+	err = exe.signExecuteCommit(mkCallTx(logs(3, 1)...), users[1])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), denied)
+
+	// Once permitted the contract may emit freely
+	contract.Permissions.Base.Set(permission.Emit, true)
+	err = exe.signExecuteCommit(mkCallTx(logs(3, 64)...), users[1])
+	require.NoError(t, err)
+}
+
+func TestFeePayer(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	perms := permission.NewAccountPermissions(permission.Input, permission.Call)
+	genDoc := newBaseGenDoc(perms, perms)
+	// The user holds nothing with which to pay fees
+	genDoc.Accounts[1].Amount = 0
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	user, relayer := users[1], users[2]
+	address := users[3].GetAddress()
+	fee := uint64(10)
+	mkCallTx := func() *payload.CallTx {
+		tx, err := payload.NewCallTx(exe.stateCache, user.GetPublicKey(), &address, nil, fee, 100, fee)
+		require.NoError(t, err)
+		return tx
+	}
+
+	err = exe.signExecuteCommit(mkCallTx(), user)
+	require.Error(t, err)
+
+	// The FeePayer is not charged for a transaction that is rejected
+	badTx := mkCallTx()
+	badTx.Input.Sequence += 5
+	txEnv := txs.Enclose(testChainID, badTx)
+	require.NoError(t, txEnv.Sign(user))
+	require.NoError(t, txEnv.SignFeePayer(relayer))
+	_, err = exe.Execute(txEnv)
+	require.Error(t, err)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000000), exe.getAccount(t, relayer.GetAddress()).Balance)
+
+	txEnv = txs.Enclose(testChainID, mkCallTx())
+	require.NoError(t, txEnv.Sign(user))
+	require.NoError(t, txEnv.SignFeePayer(relayer))
+	_, err = exe.Execute(txEnv)
+	require.NoError(t, err)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(0), exe.getAccount(t, user.GetAddress()).Balance)
+	assert.Equal(t, uint64(1000000)-fee, exe.getAccount(t, relayer.GetAddress()).Balance)
+	assert.Equal(t, uint64(1), exe.getAccount(t, user.GetAddress()).Sequence)
+}
+
+func TestPrivateTx(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	perms := permission.NewAccountPermissions(permission.Input, permission.Call)
+	genDoc := newBaseGenDoc(perms, perms)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+	private := new(privateExecutor)
+	exe.private = private
+	exe.contexts[payload.TypePrivate].(*contexts.PrivateContext).Private = private
+
+	user := users[1]
+	payloadHash := bytes.Repeat([]byte{1}, 32)
+	tx := payload.NewPrivateTx(user.GetAddress(), payloadHash)
+	tx.Input.Sequence = 1
+	err = exe.signExecuteCommit(tx, user)
+	require.NoError(t, err)
+	require.Len(t, private.executed, 1)
+	assert.Equal(t, HexBytes(payloadHash), private.executed[0].PayloadHash)
+
+	// Only the hash is committed on-chain
+	err = exe.signExecuteCommit(payload.NewPrivateTx(user.GetAddress(), []byte{1, 2, 3}), user)
+	require.Error(t, err)
+}
+
+func TestAuthorizerApprovals(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	account := crypto.Address{0xa, 0x1}
+	authorizer := crypto.Address{0xa, 0x2}
+	// Returns whether the word it stores in memory is non-zero
+	authorizerCode := func(result byte) acm.Bytecode {
+		return bc.MustSplice(PUSH1, result, PUSH1, 0x00, MSTORE, PUSH1, 0x20, PUSH1, 0x00, RETURN)
+	}
+	setAuthorizerCode := func(code acm.Bytecode) {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			err := ws.UpdateAccount(&acm.Account{Address: account, Authorizer: &authorizer})
+			if err != nil {
+				return err
+			}
+			return ws.UpdateAccount(&acm.Account{Address: authorizer, EVMCode: code})
+		})
+		require.NoError(t, err)
+	}
+	setAuthorizerCode(authorizerCode(1))
+
+	checker, err := NewBatchChecker(st, ParamsFromGenesis(testGenesisDoc), newBlockchain(testGenesisDoc), logger)
+	require.NoError(t, err)
+	committer := makeExecutor(st)
+	input := &payload.TxInput{Address: account, Amount: 1}
+	signBytes := []byte("sign bytes")
+	sig := &txs.Signatory{Address: &account, Signature: &crypto.Signature{Signature: []byte{1}}}
+	authorize := func(exe *executor) error {
+		_, err := exe.authorize(input, sig, signBytes)
+		return err
+	}
+	require.NoError(t, authorize(checker.(*executor)))
+	require.NoError(t, authorize(committer.executor))
+
+	// Rechecks use the checker's approval whereas delivery runs the authorizer again
+	setAuthorizerCode(authorizerCode(0))
+	require.NoError(t, checker.Reset())
+	require.NoError(t, committer.Reset())
+	require.NoError(t, authorize(checker.(*executor)))
+	require.Error(t, authorize(committer.executor))
+
+	// Approvals are only remembered for the signature approved
+	sig.Signature.Signature = []byte{2}
+	require.Error(t, authorize(checker.(*executor)))
+}
+
+func TestHeartbeatTx(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	perms := permission.NewAccountPermissions(permission.Input)
+	genDoc := newBaseGenDoc(perms, perms)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+	exe.contexts[payload.TypeHeartbeat].(*contexts.HeartbeatContext).Blockchain = hashedBlockchain{exe.Blockchain}
+
+	mkTx := func(user acm.AddressableSigner, height uint64, blockHash []byte) *payload.HeartbeatTx {
+		tx := payload.NewHeartbeatTx(user.GetAddress(), height, blockHash)
+		tx.Input.Sequence = exe.getAccount(t, user.GetAddress()).Sequence + 1
+		return tx
+	}
+
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	validator := users[0]
+	height := exe.LastBlockHeight()
+	err = exe.signExecuteCommit(mkTx(validator, height, hashAt(height)), validator)
+	require.NoError(t, err)
+	err = exe.signExecuteCommit(mkTx(validator, height+1, hashAt(height+1)), validator)
+	require.NoError(t, err)
+
+	hb, err := st.GetHeartbeat(validator.GetAddress())
+	require.NoError(t, err)
+	require.NotNil(t, hb)
+	assert.Equal(t, uint64(2), hb.Count)
+	assert.Equal(t, height+1, hb.ObservedHeight)
+	assert.Equal(t, HexBytes(hashAt(height+1)), hb.BlockHash)
+	assert.Equal(t, height+2, hb.Height)
+
+	// Only validators may send heartbeats
+	err = exe.signExecuteCommit(mkTx(users[1], height, hashAt(height)), users[1])
+	require.Error(t, err)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+
+	// Heartbeats must attest to a block we have
+	err = exe.signExecuteCommit(mkTx(validator, height+10, hashAt(height+10)), validator)
+	require.Error(t, err)
+	assert.Equal(t, errors.Codes.BlockNumberOutOfRange, errors.GetCode(err))
+
+	err = exe.signExecuteCommit(mkTx(validator, height, hashAt(height+1)), validator)
+	require.Error(t, err)
+
+	hb, err = st.GetHeartbeat(users[1].GetAddress())
+	require.NoError(t, err)
+	assert.Nil(t, hb)
+}
+
+// Returns hashAt(height) as the hash of each block
+type hashedBlockchain struct {
+	*bcm.Blockchain
+}
+
+func (bc hashedBlockchain) BlockHash(height uint64) ([]byte, error) {
+	return hashAt(height), nil
+}
+
+func hashAt(height uint64) []byte {
+	return crypto.Keccak256([]byte(strconv.FormatUint(height, 10)))
+}
+
+type privateExecutor struct {
+	executed []*payload.PrivateTx
+}
+
+func (pe *privateExecutor) ExecutePrivate(blockchain engine.Blockchain, txHash []byte,
+	tx *payload.PrivateTx) error {
+	pe.executed = append(pe.executed, tx)
+	return nil
+}
+
 // Helpers
 
 func makeUsers(n int) []acm.AddressableSigner {
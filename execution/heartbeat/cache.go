@@ -0,0 +1,71 @@
+package heartbeat
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Cache accumulates heartbeats so that they can be written to a backend Writer via Sync or discarded
+type Cache struct {
+	sync.RWMutex
+	backend    Reader
+	heartbeats map[crypto.Address]*Heartbeat
+}
+
+var _ ReaderWriter = &Cache{}
+
+// Returns a Cache that wraps backend for reads and can write to an output Writer via Sync
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend:    backend,
+		heartbeats: make(map[crypto.Address]*Heartbeat),
+	}
+}
+
+func (cache *Cache) GetHeartbeat(address crypto.Address) (*Heartbeat, error) {
+	cache.RLock()
+	hb, ok := cache.heartbeats[address]
+	cache.RUnlock()
+	if ok {
+		return hb, nil
+	}
+	return cache.backend.GetHeartbeat(address)
+}
+
+func (cache *Cache) UpdateHeartbeat(hb *Heartbeat) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.heartbeats[hb.Address] = hb
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	addresses := make([]crypto.Address, 0, len(cache.heartbeats))
+	for address := range cache.heartbeats {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+
+	for _, address := range addresses {
+		err := state.UpdateHeartbeat(cache.heartbeats[address])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.heartbeats = make(map[crypto.Address]*Heartbeat)
+}
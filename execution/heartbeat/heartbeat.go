@@ -0,0 +1,43 @@
+package heartbeat
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+)
+
+func (hb *Heartbeat) String() string {
+	return fmt.Sprintf("Heartbeat{Address: %v; Height: %d; ObservedHeight: %d; BlockHash: %v; Count: %d}",
+		hb.Address, hb.Height, hb.ObservedHeight, hb.BlockHash, hb.Count)
+}
+
+func (hb *Heartbeat) Get(key string) (value interface{}, ok bool) {
+	return query.GetReflect(reflect.ValueOf(hb), key)
+}
+
+type Reader interface {
+	// Returns the latest heartbeat of the validator at address or nil if it has sent none
+	GetHeartbeat(address crypto.Address) (*Heartbeat, error)
+}
+
+type Writer interface {
+	// Records the latest heartbeat of a validator
+	UpdateHeartbeat(hb *Heartbeat) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	// Iterate over the latest heartbeat of each validator in order of address
+	IterateHeartbeats(consumer func(hb *Heartbeat) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
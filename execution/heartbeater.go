@@ -0,0 +1,71 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Heartbeater periodically broadcasts a HeartbeatTx signed by a validator attesting to the latest block it has seen so
+// that its liveness is recorded in state independently of its participation in consensus
+type Heartbeater struct {
+	address    crypto.Address
+	interval   time.Duration
+	blockchain bcm.BlockchainInfo
+	transactor *Transactor
+	logger     *logging.Logger
+}
+
+// NewHeartbeater returns a Heartbeater that sends a heartbeat from the validator at address every interval using
+// transactor, which must be able to sign for address
+func NewHeartbeater(address crypto.Address, interval time.Duration, blockchain bcm.BlockchainInfo,
+	transactor *Transactor, logger *logging.Logger) *Heartbeater {
+	return &Heartbeater{
+		address:    address,
+		interval:   interval,
+		blockchain: blockchain,
+		transactor: transactor,
+		logger:     logger.WithScope("Heartbeater").With("validator_address", address),
+	}
+}
+
+// Run sends heartbeats until ctx is done
+func (hb *Heartbeater) Run(ctx context.Context) {
+	ticker := time.NewTicker(hb.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			hb.beat(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Broadcasts a heartbeat attesting to the last block. Failures (for instance because we are not currently a validator)
+// are only logged since we will try again on the next beat.
+func (hb *Heartbeater) beat(ctx context.Context) {
+	height := hb.blockchain.LastBlockHeight()
+	if height == 0 {
+		return
+	}
+	blockHash, err := hb.blockchain.BlockHash(height)
+	if err != nil {
+		hb.logger.InfoMsg("Could not get block hash for heartbeat", "height", height, structure.ErrorKey, err)
+		return
+	}
+	txEnv := txs.Enclose(hb.blockchain.ChainID(), payload.NewHeartbeatTx(hb.address, height, blockHash))
+	receipt, err := hb.transactor.BroadcastTxAsync(ctx, txEnv)
+	if err != nil {
+		hb.logger.InfoMsg("Could not broadcast heartbeat", "height", height, structure.ErrorKey, err)
+		return
+	}
+	hb.logger.TraceMsg("Broadcast heartbeat", "height", height, structure.TxHashKey, receipt.TxHash)
+}
@@ -0,0 +1,117 @@
+package names
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+// Name should be file system like
+// Data should be anything permitted in JSON
+var regexpAlphaNum = regexp.MustCompile("^[a-zA-Z0-9._/-@]*$")
+var regexpJSON = regexp.MustCompile(`^[a-zA-Z0-9_/ \-+"':,\n\t.{}()\[\]]*$`)
+
+// Whether name contains only characters permitted in a name
+func ValidName(name string) bool {
+	return regexpAlphaNum.Match([]byte(name))
+}
+
+// Whether data contains only characters permitted in name data
+func ValidData(data string) bool {
+	return regexpJSON.Match([]byte(data))
+}
+
+// Returns an error if name and data do not form a valid entry
+func ValidateEntry(name, data string) error {
+	if len(name) == 0 {
+		return errors.Errorf(errors.Codes.InvalidString, "name must not be empty")
+	}
+	if len(name) > MaxNameLength {
+		return errors.Errorf(errors.Codes.InvalidString, "Name is too long. Max %d bytes", MaxNameLength)
+	}
+	if len(data) > MaxDataLength {
+		return errors.Errorf(errors.Codes.InvalidString, "Data is too long. Max %d bytes", MaxDataLength)
+	}
+	if !ValidName(name) {
+		return errors.Errorf(errors.Codes.InvalidString,
+			"Invalid characters found in Name (%s). Only alphanumeric, underscores, dashes, forward slashes, and @ are allowed", name)
+	}
+	if !ValidData(data) {
+		return errors.Errorf(errors.Codes.InvalidString,
+			"Invalid characters found in Data (%s). Only the kind of things found in a JSON file are allowed", data)
+	}
+	return nil
+}
+
+// Register sets the data for name on behalf of owner, buying registration blocks with value at the current name cost.
+// An unexpired entry may only be updated by its owner, in which case any remaining credit is carried over. No value
+// and empty data removes an existing entry. Returns the entry as registered (or as removed).
+func Register(reg ReaderWriter, owner crypto.Address, name, data string, value, lastBlockHeight uint64) (*Entry, error) {
+	// let's say cost of a name for one block is len(data) + 32
+	costPerBlock := NameCostPerBlock(NameBaseCost(name, data))
+	expiresIn := value / costPerBlock
+
+	// check if the name exists
+	entry, err := reg.GetName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry == nil {
+		if expiresIn < MinNameRegistrationPeriod {
+			return nil, fmt.Errorf("names must be registered for at least %d blocks", MinNameRegistrationPeriod)
+		}
+		// entry does not exist, so create it
+		entry = &Entry{
+			Name:    name,
+			Owner:   owner,
+			Data:    data,
+			Expires: lastBlockHeight + expiresIn,
+		}
+		return entry, reg.UpdateName(entry)
+	}
+
+	// Copy so as not to mutate an entry held by the registry
+	updated := *entry
+	entry = &updated
+
+	var expired bool
+	// if the entry already exists, and hasn't expired, we must be owner
+	if entry.Expires > lastBlockHeight {
+		// ensure we are owner
+		if entry.Owner != owner {
+			return nil, fmt.Errorf("permission denied: sender %s is trying to update a name (%s) for "+
+				"which they are not an owner", owner, name)
+		}
+	} else {
+		expired = true
+	}
+
+	// no value and empty data means delete the entry
+	if value == 0 && len(data) == 0 {
+		// (owners if not expired, anyone if expired)
+		return entry, reg.RemoveName(entry.Name)
+	}
+
+	// update the entry by bumping the expiry and changing the data
+	if expired {
+		if expiresIn < MinNameRegistrationPeriod {
+			return nil, fmt.Errorf("names must be registered for at least %d blocks", MinNameRegistrationPeriod)
+		}
+		entry.Expires = lastBlockHeight + expiresIn
+		entry.Owner = owner
+	} else {
+		// since the size of the data may have changed we use the total amount of "credit"
+		oldCredit := (entry.Expires - lastBlockHeight) * NameBaseCost(entry.Name, entry.Data)
+		credit := oldCredit + value
+		expiresIn = credit / costPerBlock
+		if expiresIn < MinNameRegistrationPeriod {
+			return nil, fmt.Errorf("names must be registered for at least %d blocks", MinNameRegistrationPeriod)
+		}
+		entry.Expires = lastBlockHeight + expiresIn
+	}
+	entry.Data = data
+	return entry, reg.UpdateName(entry)
+}
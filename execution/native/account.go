@@ -8,11 +8,19 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs/payload"
 	"golang.org/x/crypto/sha3"
 )
 
 func CreateAccount(st acmstate.ReaderWriter, address crypto.Address) error {
+	return CreateAccountWithPermissions(st, address, permission.ZeroBasePermissions)
+}
+
+// Creates an account at address with the base permissions perms, any permission they leave unset falls back to the
+// global permissions
+func CreateAccountWithPermissions(st acmstate.ReaderWriter, address crypto.Address,
+	perms permission.BasePermissions) error {
 	acc, err := st.GetAccount(address)
 	if err != nil {
 		return err
@@ -26,7 +34,10 @@ func CreateAccount(st acmstate.ReaderWriter, address crypto.Address) error {
 		return errors.Errorf(errors.Codes.DuplicateAddress,
 			"tried to create an account at an address that already exists: %v", address)
 	}
-	return st.UpdateAccount(&acm.Account{Address: address})
+	return st.UpdateAccount(&acm.Account{
+		Address:     address,
+		Permissions: permission.AccountPermissions{Base: perms},
+	})
 }
 
 func InitEVMCode(st acmstate.ReaderWriter, address crypto.Address, code []byte) error {
@@ -155,7 +166,9 @@ func Transfer(st acmstate.ReaderWriter, from, to crypto.Address, amount uint64)
 	})
 }
 
-func UpdateContractMeta(st acmstate.ReaderWriter, metaSt acmstate.MetadataWriter, address crypto.Address, payloadMeta []*payload.ContractMeta) error {
+// Attaches payloadMeta to the account at address and registers each metadata against its code hash (unless some
+// metadata has already been registered for that code hash) so it can be found for any account with the same code
+func UpdateContractMeta(st acmstate.ReaderWriter, metaSt acmstate.MetadataReaderWriter, address crypto.Address, payloadMeta []*payload.ContractMeta) error {
 	if len(payloadMeta) == 0 {
 		return nil
 	}
@@ -176,6 +189,25 @@ func UpdateContractMeta(st acmstate.ReaderWriter, metaSt acmstate.MetadataWriter
 			return errors.Errorf(errors.Codes.IllegalWrite,
 				"cannot update metadata for %v: %v", address, err)
 		}
+		if len(abi.CodeHash) != len(acmstate.CodeHash{}) {
+			continue
+		}
+		var codehash acmstate.CodeHash
+		copy(codehash[:], abi.CodeHash)
+		registered, err := metaSt.GetCodeMetadata(codehash)
+		if err != nil {
+			return err
+		}
+		if registered == nil {
+			err = metaSt.SetCodeMetadata(codehash, &acm.CodeMetadata{
+				MetadataHash: metahash.Bytes(),
+				Address:      address,
+			})
+			if err != nil {
+				return errors.Errorf(errors.Codes.IllegalWrite,
+					"cannot register metadata for code hash %v: %v", codehash, err)
+			}
+		}
 	}
 	acc.ContractMeta = contractMeta
 	return st.UpdateAccount(acc)
@@ -8,6 +8,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +80,39 @@ func TestState_NewCache(t *testing.T) {
 	require.Equal(t, errors.Codes.IllegalWrite, errors.GetCode(err))
 }
 
+func TestUpdateContractMeta(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	metaCache := acmstate.NewMetadataCache(st)
+	codehash := acmstate.CodeHash{1, 2, 3}
+	first, second := AddressFromName("first"), AddressFromName("second")
+	for _, address := range []crypto.Address{first, second} {
+		require.NoError(t, CreateAccount(st, address))
+	}
+
+	err := UpdateContractMeta(st, metaCache, first, []*payload.ContractMeta{{CodeHash: codehash.Bytes(), Meta: "first"}})
+	require.NoError(t, err)
+	err = UpdateContractMeta(st, metaCache, second, []*payload.ContractMeta{{CodeHash: codehash.Bytes(), Meta: "second"}})
+	require.NoError(t, err)
+	require.NoError(t, metaCache.Sync(st))
+
+	// Metadata is registered against the code hash by the first deployment only
+	codeMeta, err := st.GetCodeMetadata(codehash)
+	require.NoError(t, err)
+	require.NotNil(t, codeMeta)
+	assert.Equal(t, first, codeMeta.Address)
+	var metahash acmstate.MetadataHash
+	copy(metahash[:], codeMeta.MetadataHash)
+	metadata, err := st.GetMetadata(metahash)
+	require.NoError(t, err)
+	assert.Equal(t, "first", metadata)
+
+	acc, err := st.GetAccount(second)
+	require.NoError(t, err)
+	require.Len(t, acc.ContractMeta, 1)
+	secondHash := acmstate.GetMetadataHash("second")
+	assert.Equal(t, secondHash.Bytes(), acc.ContractMeta[0].MetadataHash.Bytes())
+}
+
 func addToBalance(t testing.TB, st acmstate.ReaderWriter, address crypto.Address, amt uint64) {
 	err := UpdateAccount(st, address, func(account *acm.Account) error {
 		return account.AddToBalance(amt)
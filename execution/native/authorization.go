@@ -0,0 +1,98 @@
+package native
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+var Authorization = New().MustContract("Authorization",
+	`* Interface for contract-authorized transactions.
+		* @dev This interface describes the functions exposed by the native authorization layer in burrow.
+		* @dev An account may designate an authorizer contract that validates the transactions it sends in place of
+		* @dev checking their signatures against the account's public key. Before executing a transaction the authorizer is
+		* @dev called (without the ability to modify state) with:
+		* @dev function authorize(address _account, bytes32 _digest, uint64 _amount, bytes calldata _signature) external returns (bool);
+		* @dev where _digest is the keccak256 hash of the transaction's sign bytes, _amount is the amount being spent by the
+		* @dev input, and _signature is the signature provided for the input. The transaction is rejected unless it returns true.
+		* @dev Beware that an authorizer that never returns true leaves the account unable to send any transaction.
+		`,
+	Function{
+		Comment: `
+			* @notice Designates the contract that authorizes transactions from the calling account
+			* @param _authorizer the address of the authorizer contract, or the zero address to revert to signature checks
+			* @return _result whether the authorizer was changed
+			`,
+		Gas: GasStorageUpdate,
+		F:   setAuthorizer,
+	},
+	Function{
+		Comment: `
+			* @notice Gets the contract that authorizes transactions from an account
+			* @param _account account address
+			* @return _result the address of the authorizer contract, or the zero address if there is none
+			`,
+		Gas: GasGetAccount,
+		F:   authorizer,
+	},
+)
+
+type setAuthorizerArgs struct {
+	Authorizer crypto.Address
+}
+
+type setAuthorizerRets struct {
+	Result bool
+}
+
+func setAuthorizer(ctx Context, args setAuthorizerArgs) (setAuthorizerRets, error) {
+	var authorizer *crypto.Address
+	if args.Authorizer != crypto.ZeroAddress {
+		acc, err := mustAccount(ctx.State.CallFrame, args.Authorizer)
+		if err != nil {
+			return setAuthorizerRets{}, err
+		}
+		if acc.ContractKind() != acm.EVMContract {
+			return setAuthorizerRets{}, errors.Errorf(errors.Codes.InvalidAddress,
+				"authorizer %v is not an EVM contract", args.Authorizer)
+		}
+		authorizer = &args.Authorizer
+	}
+	var changed bool
+	err := UpdateAccount(ctx.State.CallFrame, ctx.Caller, func(acc *acm.Account) error {
+		changed = !addressPtrEqual(acc.Authorizer, authorizer)
+		acc.Authorizer = authorizer
+		return nil
+	})
+	if err != nil {
+		return setAuthorizerRets{}, err
+	}
+	ctx.Logger.TraceMsg("setAuthorizer", "account", ctx.Caller, "authorizer", args.Authorizer)
+	return setAuthorizerRets{Result: changed}, nil
+}
+
+type authorizerArgs struct {
+	Account crypto.Address
+}
+
+type authorizerRets struct {
+	Result crypto.Address
+}
+
+func authorizer(ctx Context, args authorizerArgs) (authorizerRets, error) {
+	acc, err := mustAccount(ctx.State.CallFrame, args.Account)
+	if err != nil {
+		return authorizerRets{}, err
+	}
+	if acc.Authorizer == nil {
+		return authorizerRets{}, nil
+	}
+	return authorizerRets{Result: *acc.Authorizer}, nil
+}
+
+func addressPtrEqual(a, b *crypto.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
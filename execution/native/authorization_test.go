@@ -0,0 +1,65 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorization(t *testing.T) {
+	contract := Authorization.GetByName("Authorization").(*Contract)
+	st := acmstate.NewMemoryState()
+	account := &acm.Account{Address: crypto.Address{1, 2, 3}}
+	authorizer := &acm.Account{Address: crypto.Address{4, 5, 6}, EVMCode: acm.Bytecode{0x00}}
+	require.NoError(t, st.UpdateAccount(account))
+	require.NoError(t, st.UpdateAccount(authorizer))
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st),
+		Blockchain: &validatorSetBlockchain{},
+		EventSink:  exec.NewNoopEventSink(),
+	}
+
+	call := func(name string, args ...interface{}) ([]byte, error) {
+		function := contract.FunctionByName(name)
+		packed, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		input := bc.MustSplice(function.Abi().FunctionID[:], packed)
+		gas := uint64(1000)
+		return contract.Call(state, engine.CallParams{Caller: account.Address, Input: input, Gas: &gas})
+	}
+
+	getAuthorizer := func() crypto.Address {
+		ret, err := call("authorizer", account.Address)
+		require.NoError(t, err)
+		var address crypto.Address
+		require.NoError(t, abi.Unpack(contract.FunctionByName("authorizer").Abi().Outputs, ret, &address))
+		return address
+	}
+
+	assert.Equal(t, crypto.ZeroAddress, getAuthorizer())
+
+	_, err := call("setAuthorizer", authorizer.Address)
+	require.NoError(t, err)
+	assert.Equal(t, authorizer.Address, getAuthorizer())
+
+	// Authorizer must be a contract
+	_, err = call("setAuthorizer", account.Address)
+	require.Error(t, err)
+	assert.Equal(t, authorizer.Address, getAuthorizer())
+
+	// Zero address clears the authorizer
+	_, err = call("setAuthorizer", crypto.ZeroAddress)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.ZeroAddress, getAuthorizer())
+	acc, err := state.CallFrame.GetAccount(account.Address)
+	require.NoError(t, err)
+	assert.Nil(t, acc.Authorizer)
+}
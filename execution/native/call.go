@@ -9,6 +9,7 @@ import (
 // Call provides a standard wrapper for implementing Callable.Call with appropriate error handling and event firing.
 func Call(state engine.State, params engine.CallParams, execute func(engine.State, engine.CallParams) ([]byte, error)) ([]byte, error) {
 	maybe := new(errors.Maybe)
+	gasForwarded := *params.Gas
 	if params.CallType == exec.CallTypeCall || params.CallType == exec.CallTypeCode {
 		// NOTE: Delegate and Static CallTypes do not transfer the value to the callee.
 		maybe.PushError(Transfer(state.CallFrame, params.Caller, params.Callee, params.Value))
@@ -16,12 +17,12 @@ func Call(state engine.State, params engine.CallParams, execute func(engine.Stat
 
 	output := maybe.Bytes(execute(state, params))
 	// fire the post call event (including exception if applicable) and make sure we return the accumulated call error
-	maybe.PushError(FireCallEvent(state.CallFrame, maybe.Error(), state.EventSink, output, params))
+	maybe.PushError(FireCallEvent(state.CallFrame, maybe.Error(), state.EventSink, output, params, gasForwarded))
 	return output, maybe.Error()
 }
 
 func FireCallEvent(callFrame *engine.CallFrame, callErr error, eventSink exec.EventSink, output []byte,
-	params engine.CallParams) error {
+	params engine.CallParams, gasForwarded uint64) error {
 	// fire the post call event (including exception if applicable)
 	return eventSink.Call(&exec.CallEvent{
 		CallType: params.CallType,
@@ -32,8 +33,9 @@ func FireCallEvent(callFrame *engine.CallFrame, callErr error, eventSink exec.Ev
 			Value:  params.Value,
 			Gas:    *params.Gas,
 		},
-		Origin:     params.Origin,
-		StackDepth: callFrame.CallStackDepth(),
-		Return:     output,
+		Origin:       params.Origin,
+		StackDepth:   callFrame.CallStackDepth(),
+		Return:       output,
+		GasForwarded: gasForwarded,
 	}, errors.AsException(callErr))
 }
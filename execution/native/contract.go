@@ -49,6 +49,7 @@ type Contract struct {
 	Name          string
 	functionsByID map[abi.FunctionID]*Function
 	functions     []*Function
+	events        []*Event
 	address       crypto.Address
 	logger        *logging.Logger
 }
@@ -58,7 +59,12 @@ var _ Native = &Contract{}
 // Create a new native contract description object by passing a comment, name
 // and a list of member functions descriptions
 func NewContract(name string, comment string, logger *logging.Logger, fs ...Function) (*Contract, error) {
-	address := AddressFromName(name)
+	return NewContractAt(name, comment, AddressFromName(name), logger, fs...)
+}
+
+// Create a new native contract mounted at a chosen address rather than the address derived from its name
+func NewContractAt(name string, comment string, address crypto.Address, logger *logging.Logger,
+	fs ...Function) (*Contract, error) {
 	functionsByID := make(map[abi.FunctionID]*Function, len(fs))
 	functions := make([]*Function, len(fs))
 	logger = logger.WithScope("NativeContract")
@@ -156,6 +162,38 @@ func (c *Contract) Functions() []*Function {
 	return functions
 }
 
+// Declare events that may be emitted by the contract's functions
+func (c *Contract) AddEvents(events ...Event) error {
+	for _, e := range events {
+		ev, err := NewEvent(e.Comment, e.Value)
+		if err != nil {
+			return err
+		}
+		if c.EventByName(ev.Name()) != nil {
+			return fmt.Errorf("event %s already declared on contract %s", ev.Name(), c.Name)
+		}
+		c.events = append(c.events, ev)
+	}
+	return nil
+}
+
+// Get event by name
+func (c *Contract) EventByName(name string) *Event {
+	for _, e := range c.events {
+		if e.Name() == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// Get events in order of declaration
+func (c *Contract) Events() []*Event {
+	events := make([]*Event, len(c.events))
+	copy(events, c.events)
+	return events
+}
+
 func (c *Contract) ContractMeta() []*acm.ContractMeta {
 	// FIXME: make this return actual ABI metadata
 	metadata := "{}"
@@ -0,0 +1,318 @@
+package native
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/cron"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/permission"
+)
+
+// Cron registers recurring calls that the executor makes at the start of a block, before any transactions, every
+// Interval blocks until their gas budget is exhausted
+var Cron = New().MustContract("Cron",
+	`* Interface for recurring scheduled calls.
+		* @dev This interface describes the functions exposed by the native cron layer in burrow.
+		* @dev A job calls its target with its input from its payer account at the start of every _interval blocks, with
+		* @dev at most _gasLimit gas per call. Its _budget is taken from the payer's balance when it is registered and
+		* @dev the gas used by each call (whether or not it succeeds) is spent from it, the job being deregistered once
+		* @dev its budget is exhausted. Any budget left when a job is deregistered otherwise is refunded to the payer.
+		* @dev The payer must hold the call permission when each call is made or the job is deregistered. Jobs that
+		* @dev would take the gas used by all jobs at the start of a block beyond a fixed limit are deferred to the next.
+		`,
+	Function{
+		Comment: `
+			* @notice Registers a recurring call from the calling account, which must have the schedule permission
+			* @param _target the contract to call
+			* @param _input the call data
+			* @param _interval the number of blocks between calls
+			* @param _gasLimit the most gas any one call may use
+			* @param _budget the total gas that may be used across all calls, which is taken from the caller's balance
+			* @return _id the ID of the job
+			`,
+		PermFlag: permission.Schedule,
+		Gas:      GasStorageUpdate,
+		F:        schedule,
+	},
+	Function{
+		Comment: `
+			* @notice Registers a recurring call from another account (for governance)
+			* @param _payer the account from which calls are made
+			* @param _target the contract to call
+			* @param _input the call data
+			* @param _interval the number of blocks between calls
+			* @param _gasLimit the most gas any one call may use
+			* @param _budget the total gas that may be used across all calls, which is taken from the payer's balance
+			* @return _id the ID of the job
+			`,
+		PermFlag: permission.Root,
+		Gas:      GasStorageUpdate,
+		F:        scheduleFor,
+	},
+	Function{
+		Comment: `
+			* @notice Deregisters a job, refunding its remaining budget to its payer, which may only be done by its payer or an account with root permission
+			* @param _id the ID of the job
+			* @return _result whether the job was deregistered
+			`,
+		Gas: GasStorageUpdate,
+		F:   cancel,
+	},
+	Function{
+		Comment: `
+			* @notice Gets a job
+			* @param _id the ID of the job
+			* @return _payer the account from which calls are made (the zero address if there is no such job)
+			* @return _target the contract to call
+			* @return _interval the number of blocks between calls
+			* @return _gasLimit the most gas any one call may use
+			* @return _budget the gas remaining to be used by future calls
+			* @return _nextHeight the height at which the job next runs
+			`,
+		Gas: GasGetAccount,
+		F:   getJob,
+	},
+).MustContractEvents("Cron",
+	Event{
+		Comment: "Emitted when a job is registered",
+		Value:   CronScheduled{},
+	},
+	Event{
+		Comment: "Emitted after each call made by a job",
+		Value:   CronExecuted{},
+	},
+	Event{
+		Comment: "Emitted when a job is deregistered, either by cancellation or on exhausting its budget",
+		Value:   CronDeregistered{},
+	},
+)
+
+// The address of the Cron native contract, from which the events of jobs are emitted
+var CronAddress = Cron.GetContract("Cron").Address()
+
+type CronScheduled struct {
+	ID         uint64         `abi:"indexed"`
+	Payer      crypto.Address `abi:"indexed"`
+	Target     crypto.Address
+	Interval   uint64
+	NextHeight uint64
+}
+
+type CronExecuted struct {
+	ID uint64 `abi:"indexed"`
+	// Whether the call succeeded
+	Success bool
+	GasUsed uint64
+	// The gas remaining to be used by future calls
+	Budget uint64
+}
+
+type CronDeregistered struct {
+	ID uint64 `abi:"indexed"`
+	// Whether the job was cancelled rather than exhausting its budget
+	Cancelled bool
+	// The remaining budget refunded to the payer
+	Refund uint64
+}
+
+type scheduleArgs struct {
+	Target   crypto.Address
+	Input    []byte
+	Interval uint64
+	GasLimit uint64
+	Budget   uint64
+}
+
+type scheduleRets struct {
+	ID uint64
+}
+
+func schedule(ctx Context, args scheduleArgs) (scheduleRets, error) {
+	return scheduleJob(ctx, ctx.Caller, args)
+}
+
+type scheduleForArgs struct {
+	Payer    crypto.Address
+	Target   crypto.Address
+	Input    []byte
+	Interval uint64
+	GasLimit uint64
+	Budget   uint64
+}
+
+func scheduleFor(ctx Context, args scheduleForArgs) (scheduleRets, error) {
+	return scheduleJob(ctx, args.Payer, scheduleArgs{
+		Target:   args.Target,
+		Input:    args.Input,
+		Interval: args.Interval,
+		GasLimit: args.GasLimit,
+		Budget:   args.Budget,
+	})
+}
+
+func scheduleJob(ctx Context, payer crypto.Address, args scheduleArgs) (scheduleRets, error) {
+	jobs, err := cronJobs(ctx)
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	if ctx.State.Blockchain == nil {
+		return scheduleRets{}, errors.Errorf(errors.Codes.NativeFunction, "blockchain is not available in this context")
+	}
+	if args.Interval == 0 || args.GasLimit == 0 || args.Budget == 0 {
+		return scheduleRets{}, errors.Errorf(errors.Codes.NativeFunction,
+			"cron job must have a non-zero interval, gas limit, and budget")
+	}
+	if args.Interval > cron.MaxInterval || args.GasLimit > cron.MaxGasLimit {
+		return scheduleRets{}, errors.Errorf(errors.Codes.NativeFunction,
+			"cron job may have an interval of at most %d and a gas limit of at most %d", cron.MaxInterval,
+			cron.MaxGasLimit)
+	}
+	_, err = mustAccount(ctx.State.CallFrame, args.Target)
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	// The budget is held in escrow by the job until it is spent or refunded
+	err = UpdateAccount(ctx.State.CallFrame, payer, func(acc *acm.Account) error {
+		if acc.Balance < args.Budget {
+			return errors.Codes.InsufficientBalance
+		}
+		return acc.SubtractFromBalance(args.Budget)
+	})
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	id, err := jobs.LastCronJobID()
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	job := &cron.Job{
+		ID:       id + 1,
+		Payer:    payer,
+		Target:   args.Target,
+		Input:    args.Input,
+		Interval: args.Interval,
+		GasLimit: args.GasLimit,
+		Budget:   args.Budget,
+		// The block currently executing is the one after the last
+		NextHeight: ctx.State.Blockchain.LastBlockHeight() + 1 + args.Interval,
+	}
+	err = jobs.UpdateCronJob(job)
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	err = EmitEvent(ctx, CronScheduled{
+		ID:         job.ID,
+		Payer:      job.Payer,
+		Target:     job.Target,
+		Interval:   job.Interval,
+		NextHeight: job.NextHeight,
+	})
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	ctx.Logger.TraceMsg("schedule", "job", job)
+	return scheduleRets{ID: job.ID}, nil
+}
+
+type cancelArgs struct {
+	ID uint64
+}
+
+type cancelRets struct {
+	Result bool
+}
+
+func cancel(ctx Context, args cancelArgs) (cancelRets, error) {
+	jobs, err := cronJobs(ctx)
+	if err != nil {
+		return cancelRets{}, err
+	}
+	job, err := jobs.GetCronJob(args.ID)
+	if err != nil {
+		return cancelRets{}, err
+	}
+	if job == nil {
+		return cancelRets{}, nil
+	}
+	if job.Payer != ctx.Caller {
+		root, err := HasPermission(ctx.State.CallFrame, ctx.Caller, permission.Root)
+		if err != nil {
+			return cancelRets{}, err
+		}
+		if !root {
+			return cancelRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+				"only the payer of cron job %d or an account with root permission may cancel it", job.ID)
+		}
+	}
+	err = jobs.RemoveCronJob(job.ID)
+	if err != nil {
+		return cancelRets{}, err
+	}
+	err = RefundCronJob(ctx.State.CallFrame, job)
+	if err != nil {
+		return cancelRets{}, err
+	}
+	err = EmitEvent(ctx, CronDeregistered{ID: job.ID, Cancelled: true, Refund: job.Budget})
+	if err != nil {
+		return cancelRets{}, err
+	}
+	ctx.Logger.TraceMsg("cancel", "job", job)
+	return cancelRets{Result: true}, nil
+}
+
+type getJobArgs struct {
+	ID uint64
+}
+
+type getJobRets struct {
+	Payer      crypto.Address
+	Target     crypto.Address
+	Interval   uint64
+	GasLimit   uint64
+	Budget     uint64
+	NextHeight uint64
+}
+
+func getJob(ctx Context, args getJobArgs) (getJobRets, error) {
+	jobs, err := cronJobs(ctx)
+	if err != nil {
+		return getJobRets{}, err
+	}
+	job, err := jobs.GetCronJob(args.ID)
+	if err != nil {
+		return getJobRets{}, err
+	}
+	if job == nil {
+		return getJobRets{}, nil
+	}
+	return getJobRets{
+		Payer:      job.Payer,
+		Target:     job.Target,
+		Interval:   job.Interval,
+		GasLimit:   job.GasLimit,
+		Budget:     job.Budget,
+		NextHeight: job.NextHeight,
+	}, nil
+}
+
+// Returns the remaining budget of a job being deregistered to its payer, it is burnt if the payer no longer exists
+func RefundCronJob(st acmstate.ReaderWriter, job *cron.Job) error {
+	acc, err := st.GetAccount(job.Payer)
+	if err != nil || acc == nil || job.Budget == 0 {
+		return err
+	}
+	err = acc.AddToBalance(job.Budget)
+	if err != nil {
+		return err
+	}
+	return st.UpdateAccount(acc)
+}
+
+func cronJobs(ctx Context) (cron.ReaderWriter, error) {
+	jobs := ctx.State.CallFrame.Cron()
+	if jobs == nil {
+		return nil, errors.Errorf(errors.Codes.NativeFunction, "cron jobs are not available in this context")
+	}
+	return jobs, nil
+}
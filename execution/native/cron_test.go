@@ -0,0 +1,161 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/cron"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type emptyCron struct{}
+
+func (emptyCron) GetCronJob(id uint64) (*cron.Job, error)           { return nil, nil }
+func (emptyCron) GetDueCronJobs(height uint64) ([]*cron.Job, error) { return nil, nil }
+func (emptyCron) LastCronJobID() (uint64, error)                    { return 0, nil }
+
+func TestCron(t *testing.T) {
+	contract := Cron.GetByName("Cron").(*Contract)
+	st := acmstate.NewMemoryState()
+	payer := &acm.Account{
+		Address:     crypto.Address{1, 2, 3},
+		Balance:     2000,
+		Permissions: permission.NewAccountPermissions(permission.Call, permission.Schedule),
+	}
+	other := &acm.Account{
+		Address:     crypto.Address{4, 5, 6},
+		Permissions: permission.NewAccountPermissions(permission.Call),
+	}
+	root := &acm.Account{
+		Address:     crypto.Address{7, 8, 9},
+		Permissions: permission.NewAccountPermissions(permission.Root, permission.Schedule),
+	}
+	target := &acm.Account{
+		Address: crypto.Address{10, 11, 12},
+	}
+	for _, acc := range []*acm.Account{payer, other, root, target} {
+		require.NoError(t, st.UpdateAccount(acc))
+	}
+	jobs := cron.NewCache(emptyCron{})
+	sink := exec.NewNoopEventSink()
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st).WithCron(jobs),
+		Blockchain: &validatorSetBlockchain{},
+		EventSink:  sink,
+	}
+
+	call := func(caller crypto.Address, name string, args ...interface{}) ([]byte, error) {
+		function := contract.FunctionByName(name)
+		packed, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		input := bc.MustSplice(function.Abi().FunctionID[:], packed)
+		gas := uint64(1000)
+		return contract.Call(state, engine.CallParams{Caller: caller, Input: input, Gas: &gas})
+	}
+
+	schedule := func(caller crypto.Address, interval, gasLimit, budget uint64) (uint64, error) {
+		ret, err := call(caller, "schedule", target.Address, []byte{1, 2, 3}, interval, gasLimit, budget)
+		if err != nil {
+			return 0, err
+		}
+		var id uint64
+		require.NoError(t, abi.Unpack(contract.FunctionByName("schedule").Abi().Outputs, ret, &id))
+		return id, nil
+	}
+
+	balance := func(address crypto.Address) uint64 {
+		acc, err := state.CallFrame.GetAccount(address)
+		require.NoError(t, err)
+		return acc.Balance
+	}
+
+	id, err := schedule(payer.Address, 5, 100, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+	// The budget is held in escrow
+	assert.Equal(t, uint64(1000), balance(payer.Address))
+
+	frameJobs := state.CallFrame.Cron()
+	job, err := frameJobs.GetCronJob(id)
+	require.NoError(t, err)
+	assert.Equal(t, payer.Address, job.Payer)
+	assert.Equal(t, target.Address, job.Target)
+	// The validatorSetBlockchain is at height 1 so we are executing block 2
+	assert.Equal(t, uint64(7), job.NextHeight)
+
+	due, err := frameJobs.GetDueCronJobs(7)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, id, due[0].ID)
+
+	ret, err := call(other.Address, "getJob", id)
+	require.NoError(t, err)
+	var gotPayer, gotTarget crypto.Address
+	var interval, gasLimit, budget, nextHeight uint64
+	require.NoError(t, abi.Unpack(contract.FunctionByName("getJob").Abi().Outputs, ret,
+		&gotPayer, &gotTarget, &interval, &gasLimit, &budget, &nextHeight))
+	assert.Equal(t, payer.Address, gotPayer)
+	assert.Equal(t, uint64(1000), budget)
+
+	// Jobs must be able to run
+	_, err = schedule(payer.Address, 0, 100, 1000)
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+	// Within bounds
+	_, err = schedule(payer.Address, cron.MaxInterval+1, 100, 1000)
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+	_, err = schedule(payer.Address, 5, cron.MaxGasLimit+1, 1000)
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+	// And must be paid for
+	_, err = schedule(payer.Address, 5, 100, 1001)
+	assert.Equal(t, errors.Codes.InsufficientBalance, errors.GetCode(err))
+	// By accounts with the schedule permission
+	_, err = schedule(other.Address, 5, 100, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not have native function call permission")
+
+	// Only root may schedule on behalf of another account
+	_, err = call(other.Address, "scheduleFor", payer.Address, target.Address, []byte{}, uint64(1), uint64(1),
+		uint64(1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not have native function call permission")
+	_, err = call(root.Address, "scheduleFor", payer.Address, target.Address, []byte{}, uint64(1), uint64(1),
+		uint64(1))
+	require.NoError(t, err)
+
+	// Only the payer or root may cancel
+	_, err = call(other.Address, "cancel", id)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+	_, err = call(payer.Address, "cancel", id)
+	require.NoError(t, err)
+	job, err = frameJobs.GetCronJob(id)
+	require.NoError(t, err)
+	assert.Nil(t, job)
+	// The remaining budget is refunded (the job scheduled by root holds 1)
+	assert.Equal(t, uint64(1999), balance(payer.Address))
+	_, err = call(root.Address, "cancel", uint64(2))
+	require.NoError(t, err)
+
+	// IDs are not reused
+	id, err = schedule(payer.Address, 1, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), id)
+
+	// Jobs are visible to the backing cache once synced
+	require.NoError(t, state.CallFrame.Sync())
+	job, err = jobs.GetCronJob(id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), job.Budget)
+	due, err = jobs.GetDueCronJobs(3)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, id, due[0].ID)
+}
@@ -0,0 +1,367 @@
+package native
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/escrow"
+)
+
+// Escrow holds value deposited by one account for another until it is released to the payee or refunded to the
+// depositor, with an optional arbiter to settle disputes
+var Escrow = New().MustContract("Escrow",
+	`* Interface for escrowed payments.
+		* @dev This interface describes the functions exposed by the native escrow layer in burrow.
+		* @dev A deposit moves _amount from the depositor's balance into escrow. The depositor or the arbiter may release
+		* @dev it to the payee, and the payee or the arbiter may refund it to the depositor, at any time. Once the
+		* @dev dispute window of _disputeWindow blocks has passed the payee may claim it themselves unless the depositor
+		* @dev disputed it within the window, in which case only the depositor, the payee, or the arbiter can settle it.
+		* @dev The zero address may be given as the arbiter for escrows with no arbiter.
+		`,
+	Function{
+		Comment: `
+			* @notice Deposits value from the calling account into escrow
+			* @param _payee the account to which the value may be released
+			* @param _arbiter the account that may release or refund the value at any time
+			* @param _amount the value to hold in escrow
+			* @param _disputeWindow the number of blocks (at most 2^32) during which the depositor may dispute the escrow
+			* @return _id the ID of the escrow
+			`,
+		Gas: GasStorageUpdate,
+		F:   deposit,
+	},
+	Function{
+		Comment: `
+			* @notice Releases escrowed value to its payee, which may be done by the depositor or arbiter, or by the payee once the dispute window has passed without a dispute
+			* @param _id the ID of the escrow
+			* @return _amount the value released
+			`,
+		Gas: GasStorageUpdate,
+		F:   release,
+	},
+	Function{
+		Comment: `
+			* @notice Refunds escrowed value to its depositor, which may be done by the payee or arbiter
+			* @param _id the ID of the escrow
+			* @return _amount the value refunded
+			`,
+		Gas: GasStorageUpdate,
+		F:   refund,
+	},
+	Function{
+		Comment: `
+			* @notice Disputes an escrow so that its payee cannot claim it, which may only be done by its depositor within the dispute window
+			* @param _id the ID of the escrow
+			* @return _result whether the escrow was disputed
+			`,
+		Gas: GasStorageUpdate,
+		F:   dispute,
+	},
+	Function{
+		Comment: `
+			* @notice Gets an escrow
+			* @param _id the ID of the escrow
+			* @return _depositor the account that deposited the value (the zero address if there is no such escrow)
+			* @return _payee the account to which the value may be released
+			* @return _arbiter the account that may release or refund the value at any time
+			* @return _amount the value held in escrow
+			* @return _releaseHeight the height from which the payee may claim the value
+			* @return _disputed whether the depositor has disputed the escrow
+			`,
+		Gas: GasGetAccount,
+		F:   getEscrow,
+	},
+).MustContractEvents("Escrow",
+	Event{
+		Comment: "Emitted when value is deposited into escrow",
+		Value:   EscrowDeposited{},
+	},
+	Event{
+		Comment: "Emitted when the depositor disputes an escrow",
+		Value:   EscrowDisputed{},
+	},
+	Event{
+		Comment: "Emitted when escrowed value is released to its payee",
+		Value:   EscrowReleased{},
+	},
+	Event{
+		Comment: "Emitted when escrowed value is refunded to its depositor",
+		Value:   EscrowRefunded{},
+	},
+)
+
+// The address of the Escrow native contract, from which the events of escrows are emitted
+var EscrowAddress = Escrow.GetContract("Escrow").Address()
+
+type EscrowDeposited struct {
+	ID            uint64         `abi:"indexed"`
+	Depositor     crypto.Address `abi:"indexed"`
+	Payee         crypto.Address `abi:"indexed"`
+	Arbiter       crypto.Address
+	Amount        uint64
+	ReleaseHeight uint64
+}
+
+type EscrowDisputed struct {
+	ID uint64 `abi:"indexed"`
+}
+
+type EscrowReleased struct {
+	ID     uint64         `abi:"indexed"`
+	Payee  crypto.Address `abi:"indexed"`
+	Amount uint64
+	// The account that released the escrow
+	By crypto.Address
+}
+
+type EscrowRefunded struct {
+	ID        uint64         `abi:"indexed"`
+	Depositor crypto.Address `abi:"indexed"`
+	Amount    uint64
+	// The account that refunded the escrow
+	By crypto.Address
+}
+
+type depositArgs struct {
+	Payee         crypto.Address
+	Arbiter       crypto.Address
+	Amount        uint64
+	DisputeWindow uint64
+}
+
+type depositRets struct {
+	ID uint64
+}
+
+func deposit(ctx Context, args depositArgs) (depositRets, error) {
+	escrows, err := escrowStore(ctx)
+	if err != nil {
+		return depositRets{}, err
+	}
+	if ctx.State.Blockchain == nil {
+		return depositRets{}, errors.Errorf(errors.Codes.NativeFunction, "blockchain is not available in this context")
+	}
+	if args.Amount == 0 {
+		return depositRets{}, errors.Errorf(errors.Codes.NativeFunction, "escrow must have a non-zero amount")
+	}
+	if args.DisputeWindow > escrow.MaxDisputeWindow {
+		return depositRets{}, errors.Errorf(errors.Codes.NativeFunction,
+			"escrow may have a dispute window of at most %d blocks", escrow.MaxDisputeWindow)
+	}
+	if args.Payee == ctx.Caller {
+		return depositRets{}, errors.Errorf(errors.Codes.NativeFunction, "escrow payee must not be the depositor")
+	}
+	_, err = mustAccount(ctx.State.CallFrame, args.Payee)
+	if err != nil {
+		return depositRets{}, err
+	}
+	err = UpdateAccount(ctx.State.CallFrame, ctx.Caller, func(acc *acm.Account) error {
+		if acc.Balance < args.Amount {
+			return errors.Codes.InsufficientBalance
+		}
+		return acc.SubtractFromBalance(args.Amount)
+	})
+	if err != nil {
+		return depositRets{}, err
+	}
+	id, err := escrows.LastEscrowID()
+	if err != nil {
+		return depositRets{}, err
+	}
+	esc := &escrow.Escrow{
+		ID:        id + 1,
+		Depositor: ctx.Caller,
+		Payee:     args.Payee,
+		Arbiter:   args.Arbiter,
+		Amount:    args.Amount,
+		// The block currently executing is the one after the last
+		ReleaseHeight: ctx.State.Blockchain.LastBlockHeight() + 1 + args.DisputeWindow,
+	}
+	err = escrows.UpdateEscrow(esc)
+	if err != nil {
+		return depositRets{}, err
+	}
+	err = EmitEvent(ctx, EscrowDeposited{
+		ID:            esc.ID,
+		Depositor:     esc.Depositor,
+		Payee:         esc.Payee,
+		Arbiter:       esc.Arbiter,
+		Amount:        esc.Amount,
+		ReleaseHeight: esc.ReleaseHeight,
+	})
+	if err != nil {
+		return depositRets{}, err
+	}
+	ctx.Logger.TraceMsg("deposit", "escrow", esc)
+	return depositRets{ID: esc.ID}, nil
+}
+
+type escrowIDArgs struct {
+	ID uint64
+}
+
+type settleRets struct {
+	Amount uint64
+}
+
+func release(ctx Context, args escrowIDArgs) (settleRets, error) {
+	escrows, esc, err := mustEscrow(ctx, args.ID)
+	if err != nil {
+		return settleRets{}, err
+	}
+	switch {
+	case ctx.Caller == esc.Depositor || isArbiter(ctx.Caller, esc):
+	case ctx.Caller == esc.Payee:
+		if esc.Disputed {
+			return settleRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+				"escrow %d has been disputed so may only be released by its depositor or arbiter", esc.ID)
+		}
+		if ctx.State.Blockchain == nil {
+			return settleRets{}, errors.Errorf(errors.Codes.NativeFunction,
+				"blockchain is not available in this context")
+		}
+		if height := ctx.State.Blockchain.LastBlockHeight() + 1; height < esc.ReleaseHeight {
+			return settleRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+				"escrow %d may not be claimed by its payee until height %d", esc.ID, esc.ReleaseHeight)
+		}
+	default:
+		return settleRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+			"escrow %d may only be released by its depositor, payee, or arbiter", esc.ID)
+	}
+	err = settle(ctx, escrows, esc, esc.Payee)
+	if err != nil {
+		return settleRets{}, err
+	}
+	err = EmitEvent(ctx, EscrowReleased{ID: esc.ID, Payee: esc.Payee, Amount: esc.Amount, By: ctx.Caller})
+	if err != nil {
+		return settleRets{}, err
+	}
+	ctx.Logger.TraceMsg("release", "escrow", esc)
+	return settleRets{Amount: esc.Amount}, nil
+}
+
+func refund(ctx Context, args escrowIDArgs) (settleRets, error) {
+	escrows, esc, err := mustEscrow(ctx, args.ID)
+	if err != nil {
+		return settleRets{}, err
+	}
+	if ctx.Caller != esc.Payee && !isArbiter(ctx.Caller, esc) {
+		return settleRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+			"escrow %d may only be refunded by its payee or arbiter", esc.ID)
+	}
+	err = settle(ctx, escrows, esc, esc.Depositor)
+	if err != nil {
+		return settleRets{}, err
+	}
+	err = EmitEvent(ctx, EscrowRefunded{ID: esc.ID, Depositor: esc.Depositor, Amount: esc.Amount, By: ctx.Caller})
+	if err != nil {
+		return settleRets{}, err
+	}
+	ctx.Logger.TraceMsg("refund", "escrow", esc)
+	return settleRets{Amount: esc.Amount}, nil
+}
+
+type disputeRets struct {
+	Result bool
+}
+
+func dispute(ctx Context, args escrowIDArgs) (disputeRets, error) {
+	escrows, esc, err := mustEscrow(ctx, args.ID)
+	if err != nil {
+		return disputeRets{}, err
+	}
+	if ctx.Caller != esc.Depositor {
+		return disputeRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+			"escrow %d may only be disputed by its depositor", esc.ID)
+	}
+	if esc.Disputed {
+		return disputeRets{}, nil
+	}
+	if ctx.State.Blockchain == nil {
+		return disputeRets{}, errors.Errorf(errors.Codes.NativeFunction, "blockchain is not available in this context")
+	}
+	if height := ctx.State.Blockchain.LastBlockHeight() + 1; height >= esc.ReleaseHeight {
+		return disputeRets{}, errors.Errorf(errors.Codes.PermissionDenied,
+			"the dispute window of escrow %d closed at height %d", esc.ID, esc.ReleaseHeight)
+	}
+	esc.Disputed = true
+	err = escrows.UpdateEscrow(esc)
+	if err != nil {
+		return disputeRets{}, err
+	}
+	err = EmitEvent(ctx, EscrowDisputed{ID: esc.ID})
+	if err != nil {
+		return disputeRets{}, err
+	}
+	ctx.Logger.TraceMsg("dispute", "escrow", esc)
+	return disputeRets{Result: true}, nil
+}
+
+type getEscrowRets struct {
+	Depositor     crypto.Address
+	Payee         crypto.Address
+	Arbiter       crypto.Address
+	Amount        uint64
+	ReleaseHeight uint64
+	Disputed      bool
+}
+
+func getEscrow(ctx Context, args escrowIDArgs) (getEscrowRets, error) {
+	escrows, err := escrowStore(ctx)
+	if err != nil {
+		return getEscrowRets{}, err
+	}
+	esc, err := escrows.GetEscrow(args.ID)
+	if err != nil {
+		return getEscrowRets{}, err
+	}
+	if esc == nil {
+		return getEscrowRets{}, nil
+	}
+	return getEscrowRets{
+		Depositor:     esc.Depositor,
+		Payee:         esc.Payee,
+		Arbiter:       esc.Arbiter,
+		Amount:        esc.Amount,
+		ReleaseHeight: esc.ReleaseHeight,
+		Disputed:      esc.Disputed,
+	}, nil
+}
+
+// Pay out the escrowed value to account and close the escrow
+func settle(ctx Context, escrows escrow.ReaderWriter, esc *escrow.Escrow, to crypto.Address) error {
+	err := UpdateAccount(ctx.State.CallFrame, to, func(acc *acm.Account) error {
+		return acc.AddToBalance(esc.Amount)
+	})
+	if err != nil {
+		return err
+	}
+	return escrows.RemoveEscrow(esc.ID)
+}
+
+func isArbiter(address crypto.Address, esc *escrow.Escrow) bool {
+	return esc.Arbiter != crypto.ZeroAddress && address == esc.Arbiter
+}
+
+func mustEscrow(ctx Context, id uint64) (escrow.ReaderWriter, *escrow.Escrow, error) {
+	escrows, err := escrowStore(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	esc, err := escrows.GetEscrow(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if esc == nil {
+		return nil, nil, errors.Errorf(errors.Codes.NativeFunction, "escrow %d does not exist", id)
+	}
+	return escrows, esc, nil
+}
+
+func escrowStore(ctx Context) (escrow.ReaderWriter, error) {
+	escrows := ctx.State.CallFrame.Escrows()
+	if escrows == nil {
+		return nil, errors.Errorf(errors.Codes.NativeFunction, "escrows are not available in this context")
+	}
+	return escrows, nil
+}
@@ -0,0 +1,135 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/escrow"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type emptyEscrows struct{}
+
+func (emptyEscrows) GetEscrow(id uint64) (*escrow.Escrow, error) { return nil, nil }
+func (emptyEscrows) LastEscrowID() (uint64, error)               { return 0, nil }
+
+func TestEscrow(t *testing.T) {
+	contract := Escrow.GetByName("Escrow").(*Contract)
+	st := acmstate.NewMemoryState()
+	depositor := &acm.Account{Address: crypto.Address{1, 2, 3}, Balance: 1000}
+	payee := &acm.Account{Address: crypto.Address{4, 5, 6}}
+	arbiter := &acm.Account{Address: crypto.Address{7, 8, 9}}
+	other := &acm.Account{Address: crypto.Address{10, 11, 12}}
+	for _, acc := range []*acm.Account{depositor, payee, arbiter, other} {
+		require.NoError(t, st.UpdateAccount(acc))
+	}
+	escrows := escrow.NewCache(emptyEscrows{})
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st).WithEscrows(escrows),
+		Blockchain: &validatorSetBlockchain{},
+		EventSink:  exec.NewNoopEventSink(),
+	}
+
+	call := func(caller crypto.Address, name string, args ...interface{}) ([]byte, error) {
+		function := contract.FunctionByName(name)
+		packed, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		input := bc.MustSplice(function.Abi().FunctionID[:], packed)
+		gas := uint64(1000)
+		return contract.Call(state, engine.CallParams{Caller: caller, Input: input, Gas: &gas})
+	}
+
+	deposit := func(amount, disputeWindow uint64) (uint64, error) {
+		ret, err := call(depositor.Address, "deposit", payee.Address, arbiter.Address, amount, disputeWindow)
+		if err != nil {
+			return 0, err
+		}
+		var id uint64
+		require.NoError(t, abi.Unpack(contract.FunctionByName("deposit").Abi().Outputs, ret, &id))
+		return id, nil
+	}
+
+	balance := func(address crypto.Address) uint64 {
+		acc, err := state.CallFrame.GetAccount(address)
+		require.NoError(t, err)
+		return acc.Balance
+	}
+
+	// Cannot escrow more than the depositor holds
+	_, err := deposit(2000, 5)
+	assert.Equal(t, errors.Codes.InsufficientBalance, errors.GetCode(err))
+	// Nor for longer than the maximum dispute window
+	_, err = deposit(100, escrow.MaxDisputeWindow+1)
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+
+	id, err := deposit(100, 5)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+	assert.Equal(t, uint64(900), balance(depositor.Address))
+
+	ret, err := call(other.Address, "getEscrow", id)
+	require.NoError(t, err)
+	var gotDepositor, gotPayee, gotArbiter crypto.Address
+	var amount, releaseHeight uint64
+	var disputed bool
+	require.NoError(t, abi.Unpack(contract.FunctionByName("getEscrow").Abi().Outputs, ret,
+		&gotDepositor, &gotPayee, &gotArbiter, &amount, &releaseHeight, &disputed))
+	assert.Equal(t, depositor.Address, gotDepositor)
+	assert.Equal(t, payee.Address, gotPayee)
+	assert.Equal(t, uint64(100), amount)
+	// The validatorSetBlockchain is at height 1 so we are executing block 2
+	assert.Equal(t, uint64(7), releaseHeight)
+	assert.False(t, disputed)
+
+	// The payee must wait out the dispute window
+	_, err = call(payee.Address, "release", id)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+	// Only the depositor may dispute
+	_, err = call(payee.Address, "dispute", id)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+	_, err = call(depositor.Address, "dispute", id)
+	require.NoError(t, err)
+
+	// Strangers may not settle
+	_, err = call(other.Address, "release", id)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+	_, err = call(depositor.Address, "refund", id)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+
+	// The arbiter settles the dispute
+	_, err = call(arbiter.Address, "refund", id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), balance(depositor.Address))
+	esc, err := state.CallFrame.Escrows().GetEscrow(id)
+	require.NoError(t, err)
+	assert.Nil(t, esc)
+	_, err = call(arbiter.Address, "release", id)
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+
+	// With no dispute window the payee can claim immediately, and the depositor can no longer dispute
+	id, err = deposit(300, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+	_, err = call(depositor.Address, "dispute", id)
+	assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+	_, err = call(payee.Address, "release", id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(700), balance(depositor.Address))
+	assert.Equal(t, uint64(300), balance(payee.Address))
+
+	// Escrows are visible to the backing cache once synced
+	id, err = deposit(10, 1)
+	require.NoError(t, err)
+	require.NoError(t, state.CallFrame.Sync())
+	esc, err = escrows.GetEscrow(id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), esc.Amount)
+}
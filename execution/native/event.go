@@ -0,0 +1,114 @@
+package native
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Event is metadata for an event that native functions may emit. The event is named after the struct type of Value and
+// its fields are the exported fields of that struct, which are emitted as topics when tagged with `abi:"indexed"`.
+// Can be used to generate bindings for consumers of the event.
+type Event struct {
+	// Comment describing when the event is emitted
+	Comment string
+	// A value of the struct type defining the event
+	Value interface{}
+	spec  *abi.EventSpec
+}
+
+// Create a new event description from a value of the struct type defining the event
+func NewEvent(comment string, value interface{}) (*Event, error) {
+	spec, err := EventSpec(value)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		Comment: comment,
+		Value:   value,
+		spec:    spec,
+	}, nil
+}
+
+func (e *Event) Name() string {
+	return e.spec.Name
+}
+
+// Abi returns the EventSpec for this event
+func (e *Event) Abi() *abi.EventSpec {
+	return e.spec
+}
+
+// Returns the ABI EventSpec for an event struct (or pointer to struct)
+func EventSpec(value interface{}) (*abi.EventSpec, error) {
+	rt := reflect.TypeOf(value)
+	if rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("native event must be defined by a struct but got %v", rt)
+	}
+	spec := abi.EventSpecFromStructReflect(rt.Name(), rt)
+	for _, arg := range spec.Inputs {
+		if arg.Indexed && (arg.IsArray || arg.EVM.Dynamic()) {
+			return nil, fmt.Errorf("indexed field %s of native event %s must be of a static type", arg.Name, spec.Name)
+		}
+	}
+	return spec, nil
+}
+
+// EmitEvent emits the event value as an EVM log from the native contract being called
+func EmitEvent(ctx Context, value interface{}) error {
+	log, err := NewLogEvent(ctx.Callee, value)
+	if err != nil {
+		return err
+	}
+	return ctx.State.EventSink.Log(log)
+}
+
+// NewLogEvent packs the event value as an EVM log from the native contract at address, for when a native event is
+// emitted outside of a call to the contract
+func NewLogEvent(address crypto.Address, value interface{}) (*exec.LogEvent, error) {
+	spec, err := EventSpec(value)
+	if err != nil {
+		return nil, err
+	}
+	topics, data, err := abi.PackEvent(spec, value)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack native event %s: %v", spec.Name, err)
+	}
+	return &exec.LogEvent{
+		Address: address,
+		Topics:  topics,
+		Data:    data,
+	}, nil
+}
+
+// DecodeEvent decodes the log into the event struct pointed to by value returning an error if the log is not of that
+// event type
+func DecodeEvent(log *exec.LogEvent, value interface{}) error {
+	spec, err := EventSpec(value)
+	if err != nil {
+		return err
+	}
+	if len(log.Topics) == 0 || log.SolidityEventID() != spec.ID {
+		return fmt.Errorf("log is not a %s event", spec.Name)
+	}
+	return abi.UnpackEvent(spec, log.Topics, log.Data, value)
+}
+
+// QueryForEvent returns a query matching events of the type of value emitted by the native contract at address
+func QueryForEvent(address crypto.Address, value interface{}) (*query.Builder, error) {
+	spec, err := EventSpec(value)
+	if err != nil {
+		return nil, err
+	}
+	return query.NewBuilder().
+		AndEquals(exec.LogNKey(0), spec.ID.String()).
+		AndEquals(event.AddressKey, address), nil
+}
@@ -0,0 +1,65 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Doubled struct {
+	Caller crypto.Address `abi:"indexed"`
+	Value  uint64         `abi:"indexed"`
+	Note   string
+}
+
+func TestEmitEvent(t *testing.T) {
+	ev, err := NewEvent("Emitted on doubling", Doubled{})
+	require.NoError(t, err)
+	assert.Equal(t, "Doubled", ev.Name())
+	assert.Equal(t, abi.GetEventID("Doubled(address,uint64,string)"), ev.Abi().ID)
+
+	evs := new(exec.Events)
+	callee := crypto.Address{9, 9, 9}
+	ctx := Context{
+		State:      engine.State{EventSink: evs},
+		CallParams: engine.CallParams{Callee: callee},
+	}
+	emitted := Doubled{Caller: crypto.Address{1, 2, 3}, Value: 42, Note: "twice as nice"}
+	require.NoError(t, EmitEvent(ctx, emitted))
+	require.Len(t, *evs, 1)
+	log := (*evs)[0].Log
+	assert.Equal(t, callee, log.Address)
+	assert.Len(t, log.Topics, 3)
+
+	decoded := new(Doubled)
+	require.NoError(t, DecodeEvent(log, decoded))
+	assert.Equal(t, emitted, *decoded)
+
+	qb, err := QueryForEvent(callee, Doubled{})
+	require.NoError(t, err)
+	qry, err := qb.Query()
+	require.NoError(t, err)
+	assert.True(t, qry.Matches((*evs)[0]))
+
+	qb, err = QueryForEvent(crypto.Address{1}, Doubled{})
+	require.NoError(t, err)
+	qry, err = qb.Query()
+	require.NoError(t, err)
+	assert.False(t, qry.Matches((*evs)[0]))
+
+	type Other struct {
+		Value uint64
+	}
+	require.Error(t, DecodeEvent(log, new(Other)))
+
+	type BadIndex struct {
+		Note string `abi:"indexed"`
+	}
+	_, err = NewEvent("", BadIndex{})
+	require.Error(t, err)
+}
@@ -25,6 +25,8 @@ type Function struct {
 	PermFlag permission.PermFlag
 	// Whether this function writes to state
 	Pure bool
+	// Fixed gas charged for each call in addition to any gas the function charges itself
+	Gas uint64
 	// Native function to which calls will be dispatched when a containing
 	F interface{}
 	// Following fields are for only for memoization
@@ -85,6 +87,13 @@ func (f *Function) execute(state engine.State, params engine.CallParams) ([]byte
 		return nil, &errors.LacksNativePermission{Address: params.Caller, NativeName: f.name}
 	}
 
+	if f.Gas > 0 {
+		if params.Gas == nil || *params.Gas < f.Gas {
+			return nil, errors.Codes.InsufficientGas
+		}
+		*params.Gas -= f.Gas
+	}
+
 	ctx := Context{
 		State:      state,
 		CallParams: params,
@@ -12,13 +12,28 @@ const (
 	GasBaseOp  uint64 = 0 // TODO: make this 1
 	GasStackOp uint64 = 1
 
-	GasEcRecover     uint64 = 1
-	GasSha256Word    uint64 = 1
-	GasSha256Base    uint64 = 1
-	GasRipemd160Word uint64 = 1
-	GasRipemd160Base uint64 = 1
-	GasExpModWord    uint64 = 1
-	GasExpModBase    uint64 = 1
-	GasIdentityWord  uint64 = 1
-	GasIdentityBase  uint64 = 1
+	GasEcRecover      uint64 = 1
+	GasSha256Word     uint64 = 1
+	GasSha256Base     uint64 = 1
+	GasSha512_256Word uint64 = 1
+	GasSha512_256Base uint64 = 1
+	GasRipemd160Word  uint64 = 1
+	GasRipemd160Base  uint64 = 1
+	GasExpModWord     uint64 = 1
+	GasExpModBase     uint64 = 1
+	GasIdentityWord   uint64 = 1
+	GasIdentityBase   uint64 = 1
+
+	// alt_bn128 costs as per EIP-1108
+	GasBn256Add             uint64 = 150
+	GasBn256ScalarMul       uint64 = 6000
+	GasBn256PairingBase     uint64 = 45000
+	GasBn256PairingPerPoint uint64 = 34000
+
+	// BLAKE2b F cost per round as per EIP-152
+	GasBlake2FRound uint64 = 1
+
+	// ed25519 verification base cost as per EIP-665
+	GasEd25519VerifyBase uint64 = 2000
+	GasEd25519VerifyWord uint64 = 1
 )
@@ -0,0 +1,118 @@
+package native
+
+import (
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/permission"
+)
+
+var NameRegistry = New().MustContract("NameRegistry",
+	`* Interface for the name registry.
+		* @dev This interface describes the functions exposed by the native name registry layer in burrow.
+		* @dev Names registered here are shared with those registered by NameTx.
+		`,
+	Function{
+		Comment: `
+			* @notice Resolves a name
+			* @param _name the name to resolve
+			* @return _data the data registered against the name (empty if the name is not registered)
+			* @return _owner the owner of the name
+			* @return _expires the height after which the registration expires
+			`,
+		Gas: GasGetAccount,
+		F:   getName,
+	},
+	Function{
+		Comment: `
+			* @notice Registers or updates a name owned by the calling account, paying from its balance for the registration period
+			* @param _name the name to register
+			* @param _data the data to register against the name
+			* @param _amount the amount to spend on registration (no amount and empty data removes the name)
+			* @return _expires the height after which the registration expires
+			`,
+		PermFlag: permission.Name,
+		Gas:      GasStorageUpdate,
+		F:        setName,
+	},
+)
+
+type getNameArgs struct {
+	Name string
+}
+
+type getNameRets struct {
+	Data    string
+	Owner   crypto.Address
+	Expires uint64
+}
+
+func getName(ctx Context, args getNameArgs) (getNameRets, error) {
+	reg, err := nameRegistry(ctx)
+	if err != nil {
+		return getNameRets{}, err
+	}
+	entry, err := reg.GetName(args.Name)
+	if err != nil {
+		return getNameRets{}, err
+	}
+	if entry == nil {
+		return getNameRets{}, nil
+	}
+	return getNameRets{
+		Data:    entry.Data,
+		Owner:   entry.Owner,
+		Expires: entry.Expires,
+	}, nil
+}
+
+type setNameArgs struct {
+	Name   string
+	Data   string
+	Amount uint64
+}
+
+type setNameRets struct {
+	Expires uint64
+}
+
+func setName(ctx Context, args setNameArgs) (setNameRets, error) {
+	reg, err := nameRegistry(ctx)
+	if err != nil {
+		return setNameRets{}, err
+	}
+	if ctx.State.Blockchain == nil {
+		return setNameRets{}, errors.Errorf(errors.Codes.NativeFunction, "blockchain is not available in this context")
+	}
+	err = names.ValidateEntry(args.Name, args.Data)
+	if err != nil {
+		return setNameRets{}, err
+	}
+	acc, err := mustAccount(ctx.State.CallFrame, ctx.Caller)
+	if err != nil {
+		return setNameRets{}, err
+	}
+	err = acc.SubtractFromBalance(args.Amount)
+	if err != nil {
+		return setNameRets{}, err
+	}
+	entry, err := names.Register(reg, ctx.Caller, args.Name, args.Data, args.Amount,
+		ctx.State.Blockchain.LastBlockHeight())
+	if err != nil {
+		return setNameRets{}, err
+	}
+	err = ctx.State.CallFrame.UpdateAccount(acc)
+	if err != nil {
+		return setNameRets{}, err
+	}
+	ctx.Logger.TraceMsg("setName", "name", entry.Name, "owner", entry.Owner, "expires", entry.Expires)
+	return setNameRets{Expires: entry.Expires}, nil
+}
+
+func nameRegistry(ctx Context) (names.ReaderWriter, error) {
+	reg := ctx.State.CallFrame.Names()
+	if reg == nil {
+		return nil, errors.Errorf(errors.Codes.NativeFunction, "name registry is not available in this context")
+	}
+	return reg, nil
+}
@@ -0,0 +1,98 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type emptyNames struct{}
+
+func (emptyNames) GetName(name string) (*names.Entry, error) { return nil, nil }
+
+func TestNameRegistry(t *testing.T) {
+	contract := NameRegistry.GetByName("NameRegistry").(*Contract)
+	st := acmstate.NewMemoryState()
+	owner := &acm.Account{
+		Address:     crypto.Address{1, 2, 3},
+		Balance:     100000,
+		Permissions: permission.NewAccountPermissions(permission.Name),
+	}
+	other := &acm.Account{
+		Address:     crypto.Address{4, 5, 6},
+		Balance:     100000,
+		Permissions: permission.NewAccountPermissions(permission.Name),
+	}
+	require.NoError(t, st.UpdateAccount(owner))
+	require.NoError(t, st.UpdateAccount(other))
+	reg := names.NewCache(emptyNames{})
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st).WithNames(reg),
+		Blockchain: &validatorSetBlockchain{},
+		EventSink:  exec.NewNoopEventSink(),
+	}
+
+	call := func(state engine.State, caller crypto.Address, name string, args ...interface{}) ([]byte, error) {
+		function := contract.FunctionByName(name)
+		packed, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		input := bc.MustSplice(function.Abi().FunctionID[:], packed)
+		gas := uint64(1000)
+		return contract.Call(state, engine.CallParams{Caller: caller, Input: input, Gas: &gas})
+	}
+
+	getName := func(name string) (data string, owner crypto.Address, expires uint64) {
+		ret, err := call(state, other.Address, "getName", name)
+		require.NoError(t, err)
+		require.NoError(t, abi.Unpack(contract.FunctionByName("getName").Abi().Outputs, ret, &data, &owner, &expires))
+		return
+	}
+
+	data, _, expires := getName("foo")
+	assert.Equal(t, "", data)
+	assert.Equal(t, uint64(0), expires)
+
+	_, err := call(state, owner.Address, "setName", "foo", "bar", uint64(10000))
+	require.NoError(t, err)
+	data, regOwner, expires := getName("foo")
+	assert.Equal(t, "bar", data)
+	assert.Equal(t, owner.Address, regOwner)
+	assert.True(t, expires > 1)
+
+	acc, err := state.CallFrame.GetAccount(owner.Address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(90000), acc.Balance)
+
+	// Only the owner may update an unexpired name
+	_, err = call(state, other.Address, "setName", "foo", "baz", uint64(10000))
+	require.Error(t, err)
+
+	// Writes are not permitted from a read-only frame
+	readOnly := state
+	readOnly.CallFrame, err = state.CallFrame.NewFrame()
+	require.NoError(t, err)
+	readOnly.CallFrame.ReadOnly()
+	_, err = call(readOnly, owner.Address, "setName", "foo", "baz", uint64(10000))
+	assert.Equal(t, errors.Codes.IllegalWrite, errors.GetCode(err))
+
+	// Invalid names are rejected
+	_, err = call(state, owner.Address, "setName", "foo!", "baz", uint64(10000))
+	assert.Equal(t, errors.Codes.InvalidString, errors.GetCode(err))
+
+	// Names are visible to the backing registry once synced
+	require.NoError(t, state.CallFrame.Sync())
+	entry, err := reg.GetName("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "bar", entry.Data)
+}
@@ -23,7 +23,7 @@ func MustDefaultNatives() *Natives {
 }
 
 func DefaultNatives() (*Natives, error) {
-	ns, err := Merge(Permissions, Precompiles)
+	ns, err := Merge(Permissions, ValidatorSet, NameRegistry, Randomness, Authorization, Cron, Escrow, Precompiles)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,16 @@ package native
 import (
 	"testing"
 
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -10,3 +20,68 @@ func TestDefaultNatives(t *testing.T) {
 	_, err := DefaultNatives()
 	require.NoError(t, err)
 }
+
+type doubleArgs struct {
+	Value uint64
+}
+
+type doubleRets struct {
+	Value uint64
+}
+
+func double(ctx Context, args doubleArgs) (doubleRets, error) {
+	return doubleRets{Value: 2 * args.Value}, nil
+}
+
+func TestContractAt(t *testing.T) {
+	address := leftPadAddress(0xBE, 0xEF)
+	custom, err := New().ContractAt("Doubler", "Doubles things", address,
+		Function{
+			Comment:  "Double the value",
+			PermFlag: permission.Call,
+			Gas:      100,
+			F:        double,
+		})
+	require.NoError(t, err)
+
+	ns, err := Merge(MustDefaultNatives(), custom)
+	require.NoError(t, err)
+	contract, ok := ns.GetByAddress(address).(*Contract)
+	require.True(t, ok)
+	assert.Equal(t, "Doubler", contract.Name)
+
+	// Cannot mount over an existing native
+	_, err = Merge(ns, New().MustContractAt("Clash", "", leftPadAddress(2), Function{F: double}))
+	require.Error(t, err)
+
+	st := acmstate.NewMemoryState()
+	caller := &acm.Account{
+		Address:     crypto.Address{1, 2, 3},
+		Permissions: permission.AccountPermissions{Base: permission.BasePermissions{SetBit: permission.Call}},
+	}
+	require.NoError(t, st.UpdateAccount(caller))
+	state := engine.State{
+		CallFrame: engine.NewCallFrame(st),
+		EventSink: exec.NewNoopEventSink(),
+	}
+	funcID := contract.FunctionByName("double").Abi().FunctionID
+	gas := uint64(150)
+	params := engine.CallParams{
+		Caller: caller.Address,
+		Input:  bc.MustSplice(funcID[:], binary.Int64ToWord256(21)),
+		Gas:    &gas,
+	}
+	_, err = contract.Call(state, params)
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+
+	caller.Permissions.Base.Perms = permission.Call
+	require.NoError(t, st.UpdateAccount(caller))
+	state.CallFrame = engine.NewCallFrame(st)
+	ret, err := contract.Call(state, params)
+	require.NoError(t, err)
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), ret)
+	assert.Equal(t, uint64(50), gas)
+
+	_, err = contract.Call(state, params)
+	assert.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+}
@@ -40,6 +40,34 @@ func Merge(nss ...*Natives) (*Natives, error) {
 	return n, nil
 }
 
+// Relocate returns a copy of ns in which each function named in addresses (which must be a function mounted directly
+// at an address, such as a precompile, rather than a function of a native contract) is mounted at the address given
+// in place of its usual address
+func (ns *Natives) Relocate(addresses map[string]crypto.Address) (*Natives, error) {
+	moved := make(map[string]Native, len(addresses))
+	for name, address := range addresses {
+		function, ok := ns.callableByName[name].(*Function)
+		if !ok || function.contractName != "" {
+			return nil, fmt.Errorf("cannot relocate %s since it is not a function mounted at an address", name)
+		}
+		relocated := *function
+		relocated.address = address
+		moved[name] = &relocated
+	}
+	n := New()
+	n.logger = ns.logger
+	for name, callable := range ns.callableByName {
+		if relocated, ok := moved[name]; ok {
+			callable = relocated
+		}
+		err := n.register(callable)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
 func (ns *Natives) WithLogger(logger *logging.Logger) *Natives {
 	ns.logger = logger
 	return ns
@@ -95,7 +123,20 @@ func (ns *Natives) MustContract(name, comment string, functions ...Function) *Na
 }
 
 func (ns *Natives) Contract(name, comment string, functions ...Function) (*Natives, error) {
-	contract, err := NewContract(name, comment, ns.logger, functions...)
+	return ns.ContractAt(name, comment, AddressFromName(name), functions...)
+}
+
+func (ns *Natives) MustContractAt(name, comment string, address crypto.Address, functions ...Function) *Natives {
+	ns, err := ns.ContractAt(name, comment, address, functions...)
+	if err != nil {
+		panic(err)
+	}
+	return ns
+}
+
+// Register a native contract at a chosen address, for example to extend the VM with custom natives when embedding Burrow
+func (ns *Natives) ContractAt(name, comment string, address crypto.Address, functions ...Function) (*Natives, error) {
+	contract, err := NewContractAt(name, comment, address, ns.logger, functions...)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +147,27 @@ func (ns *Natives) Contract(name, comment string, functions ...Function) (*Nativ
 	return ns, nil
 }
 
+func (ns *Natives) MustContractEvents(name string, events ...Event) *Natives {
+	ns, err := ns.ContractEvents(name, events...)
+	if err != nil {
+		panic(err)
+	}
+	return ns
+}
+
+// Declare the events emitted by the functions of the named contract
+func (ns *Natives) ContractEvents(name string, events ...Event) (*Natives, error) {
+	contract := ns.GetContract(name)
+	if contract == nil {
+		return nil, fmt.Errorf("no native contract named %s", name)
+	}
+	err := contract.AddEvents(events...)
+	if err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
 func (ns *Natives) MustFunction(comment string, address crypto.Address, permFlag permission.PermFlag, f interface{}) *Natives {
 	ns, err := ns.Function(comment, address, permFlag, f)
 	if err != nil {
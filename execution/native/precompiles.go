@@ -2,13 +2,17 @@ package native
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
+	bin "encoding/binary"
 	"fmt"
 	"math/big"
 
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/bn256"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/permission"
+	"golang.org/x/crypto/ed25519"
 	"golang.org/x/crypto/ripemd160"
 )
 
@@ -28,7 +32,31 @@ var Precompiles = New().
 	MustFunction(`Compute the operation base**exp % mod where the values are big ints`,
 		leftPadAddress(5),
 		permission.None,
-		expModFunc)
+		expModFunc).
+	MustFunction(`Add two points on the alt_bn128 curve`,
+		leftPadAddress(6),
+		permission.None,
+		bn256AddFunc).
+	MustFunction(`Multiply a point on the alt_bn128 curve by a scalar`,
+		leftPadAddress(7),
+		permission.None,
+		bn256ScalarMulFunc).
+	MustFunction(`Check that the product of the optimal ate pairings of the alt_bn128 points is one`,
+		leftPadAddress(8),
+		permission.None,
+		bn256PairingFunc).
+	MustFunction(`Compute the BLAKE2b F compression function over the state, message, offset, and final flag`,
+		leftPadAddress(9),
+		permission.None,
+		blake2FFunc).
+	MustFunction(`Verify an ed25519 signature over a message given the public key, returning 1 if valid and 0 otherwise`,
+		leftPadAddress(10),
+		permission.None,
+		ed25519VerifyFunc).
+	MustFunction(`Compute the SHA-512/256 hash of input`,
+		leftPadAddress(11),
+		permission.None,
+		sha512_256Func)
 
 func leftPadAddress(bs ...byte) crypto.Address {
 	return crypto.AddressFromWord256(binary.LeftPadWord256(bs))
@@ -73,6 +101,20 @@ func sha256Func(ctx Context) (output []byte, err error) {
 	return hasher.Sum(nil), nil
 }
 
+// sha512_256Func computes the SHA-512/256 hash (SHA-512 with a distinct initial state truncated to 256 bits, as
+// specified in FIPS 180-4) of the input
+func sha512_256Func(ctx Context) (output []byte, err error) {
+	// Deduct gas
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*GasSha512_256Word + GasSha512_256Base
+	if *ctx.Gas < gasRequired {
+		return nil, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas -= gasRequired
+	}
+	hash := sha512.Sum512_256(ctx.Input)
+	return hash[:], nil
+}
+
 func ripemd160Func(ctx Context) (output []byte, err error) {
 	// Deduct gas
 	gasRequired := wordsIn(uint64(len(ctx.Input)))*GasRipemd160Word + GasRipemd160Base
@@ -144,6 +186,151 @@ func expModFunc(ctx Context) (output []byte, err error) {
 	return binary.LeftPadBytes(new(big.Int).Exp(base, exp, mod).Bytes(), int(modLength)), nil
 }
 
+// bn256AddFunc implements ecAdd from EIP 196 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-196.md)
+func bn256AddFunc(ctx Context) (output []byte, err error) {
+	const errHeader = "bn256AddFunc"
+	if *ctx.Gas < GasBn256Add {
+		return nil, errors.Codes.InsufficientGas
+	}
+	*ctx.Gas -= GasBn256Add
+
+	input := binary.RightPadBytes(ctx.Input, 128)
+	x, err := newCurvePoint(input[:64])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	y, err := newCurvePoint(input[64:128])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return new(bn256.G1).Add(x, y).Marshal(), nil
+}
+
+// bn256ScalarMulFunc implements ecMul from EIP 196 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-196.md)
+func bn256ScalarMulFunc(ctx Context) (output []byte, err error) {
+	const errHeader = "bn256ScalarMulFunc"
+	if *ctx.Gas < GasBn256ScalarMul {
+		return nil, errors.Codes.InsufficientGas
+	}
+	*ctx.Gas -= GasBn256ScalarMul
+
+	input := binary.RightPadBytes(ctx.Input, 96)
+	p, err := newCurvePoint(input[:64])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return new(bn256.G1).ScalarMult(p, new(big.Int).SetBytes(input[64:96])).Marshal(), nil
+}
+
+// bn256PairingFunc implements ecPairing from EIP 197 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-197.md)
+func bn256PairingFunc(ctx Context) (output []byte, err error) {
+	const errHeader = "bn256PairingFunc"
+	const pairSize = 192
+	if len(ctx.Input)%pairSize != 0 {
+		return nil, fmt.Errorf("%s: input length %d is not a multiple of %d", errHeader, len(ctx.Input), pairSize)
+	}
+	pairs := uint64(len(ctx.Input) / pairSize)
+	gasRequired := GasBn256PairingBase + GasBn256PairingPerPoint*pairs
+	if *ctx.Gas < gasRequired {
+		return nil, errors.Codes.InsufficientGas
+	}
+	*ctx.Gas -= gasRequired
+
+	g1s := make([]*bn256.G1, pairs)
+	g2s := make([]*bn256.G2, pairs)
+	for i := range g1s {
+		pair := ctx.Input[i*pairSize : (i+1)*pairSize]
+		g1s[i], err = newCurvePoint(pair[:64])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		g2s[i] = new(bn256.G2)
+		_, err = g2s[i].Unmarshal(pair[64:])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+	}
+	if bn256.PairingCheck(g1s, g2s) {
+		return binary.LeftPadBytes([]byte{1}, binary.Word256Bytes), nil
+	}
+	return binary.LeftPadBytes(nil, binary.Word256Bytes), nil
+}
+
+// blake2FFunc implements the BLAKE2b F compression function from EIP 152
+// (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-152.md)
+func blake2FFunc(ctx Context) (output []byte, err error) {
+	const errHeader = "blake2FFunc"
+	const inputLength = 213
+	if len(ctx.Input) != inputLength {
+		return nil, fmt.Errorf("%s: input must be exactly %d bytes but is %d bytes", errHeader, inputLength,
+			len(ctx.Input))
+	}
+	rounds := bin.BigEndian.Uint32(ctx.Input[:4])
+	gasRequired := uint64(rounds) * GasBlake2FRound
+	if *ctx.Gas < gasRequired {
+		return nil, errors.Codes.InsufficientGas
+	}
+	*ctx.Gas -= gasRequired
+
+	var final bool
+	switch ctx.Input[212] {
+	case 0:
+	case 1:
+		final = true
+	default:
+		return nil, fmt.Errorf("%s: final block indicator must be 0 or 1 but is %d", errHeader, ctx.Input[212])
+	}
+	var h [8]uint64
+	var m [16]uint64
+	var t [2]uint64
+	for i := range h {
+		h[i] = bin.LittleEndian.Uint64(ctx.Input[4+i*8:])
+	}
+	for i := range m {
+		m[i] = bin.LittleEndian.Uint64(ctx.Input[68+i*8:])
+	}
+	t[0] = bin.LittleEndian.Uint64(ctx.Input[196:])
+	t[1] = bin.LittleEndian.Uint64(ctx.Input[204:])
+
+	crypto.Blake2bF(&h, &m, t, final, rounds)
+
+	output = make([]byte, 64)
+	for i, word := range h {
+		bin.LittleEndian.PutUint64(output[i*8:], word)
+	}
+	return output, nil
+}
+
+// ed25519VerifyFunc verifies an ed25519 signature against the input formed of the 32 byte public key, the 64 byte
+// signature, and the message that was signed
+func ed25519VerifyFunc(ctx Context) (output []byte, err error) {
+	const errHeader = "ed25519VerifyFunc"
+	message, segments, err := cut(ctx.Input, ed25519.PublicKeySize, ed25519.SignatureSize)
+	if err != nil {
+		return nil, fmt.Errorf("%s: input must contain a %d byte public key followed by a %d byte signature: %v",
+			errHeader, ed25519.PublicKeySize, ed25519.SignatureSize, err)
+	}
+	gasRequired := wordsIn(uint64(len(message)))*GasEd25519VerifyWord + GasEd25519VerifyBase
+	if *ctx.Gas < gasRequired {
+		return nil, errors.Codes.InsufficientGas
+	}
+	*ctx.Gas -= gasRequired
+
+	if ed25519.Verify(segments[0], message, segments[1]) {
+		return binary.LeftPadBytes([]byte{1}, binary.Word256Bytes), nil
+	}
+	return binary.LeftPadBytes(nil, binary.Word256Bytes), nil
+}
+
+func newCurvePoint(bs []byte) (*bn256.G1, error) {
+	p := new(bn256.G1)
+	_, err := p.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 // Partition the head of input into segments for each length in lengths. The first return value is the unconsumed tail
 // of input and the seconds is the segments. Returns an error if input is of insufficient length to establish each segment.
 func cut(input []byte, lengths ...uint64) ([]byte, [][]byte, error) {
@@ -0,0 +1,138 @@
+package native
+
+import (
+	bin "encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/bn256"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestBn256(t *testing.T) {
+	p := new(bn256.G1).ScalarBaseMult(big.NewInt(1))
+	q := new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+	negP := new(bn256.G1).ScalarBaseMult(new(big.Int).Sub(bn256.Order, big.NewInt(1)))
+	twoP := new(bn256.G1).ScalarBaseMult(big.NewInt(2))
+
+	t.Run("Add", func(t *testing.T) {
+		out, err := bn256AddFunc(precompileContext(append(p.Marshal(), p.Marshal()...)))
+		require.NoError(t, err)
+		assert.Equal(t, twoP.Marshal(), out)
+	})
+
+	t.Run("ScalarMul", func(t *testing.T) {
+		out, err := bn256ScalarMulFunc(precompileContext(append(p.Marshal(), binary.Int64ToWord256(2).Bytes()...)))
+		require.NoError(t, err)
+		assert.Equal(t, twoP.Marshal(), out)
+	})
+
+	t.Run("Pairing", func(t *testing.T) {
+		input := append(append(append(p.Marshal(), q.Marshal()...), negP.Marshal()...), q.Marshal()...)
+		out, err := bn256PairingFunc(precompileContext(input))
+		require.NoError(t, err)
+		assert.Equal(t, binary.One256.Bytes(), out)
+
+		input = append(append(append(p.Marshal(), q.Marshal()...), p.Marshal()...), q.Marshal()...)
+		out, err = bn256PairingFunc(precompileContext(input))
+		require.NoError(t, err)
+		assert.Equal(t, binary.Zero256.Bytes(), out)
+
+		_, err = bn256PairingFunc(precompileContext(input[:100]))
+		require.Error(t, err)
+	})
+
+	t.Run("InvalidPoint", func(t *testing.T) {
+		_, err := bn256AddFunc(precompileContext(binary.One256.Bytes()))
+		require.Error(t, err)
+	})
+}
+
+func precompileContext(input []byte) Context {
+	gas := uint64(1000000)
+	return Context{
+		CallParams: engine.CallParams{
+			Input: input,
+			Gas:   &gas,
+		},
+	}
+}
+
+func TestBlake2F(t *testing.T) {
+	// EIP-152 test vector 5: the single (final) block of BLAKE2b-512("abc")
+	input := make([]byte, 213)
+	input[3] = 12
+	h := [8]uint64{0x6a09e667f3bcc908 ^ 0x01010040, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+		0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179}
+	for i, word := range h {
+		bin.LittleEndian.PutUint64(input[4+i*8:], word)
+	}
+	copy(input[68:], "abc")
+	input[196] = 3
+	input[212] = 1
+
+	ctx := precompileContext(input)
+	out, err := blake2FFunc(ctx)
+	require.NoError(t, err)
+	expected := blake2b.Sum512([]byte("abc"))
+	assert.Equal(t, expected[:], out)
+	assert.Equal(t, uint64(1000000-12), *ctx.Gas)
+
+	input[212] = 2
+	_, err = blake2FFunc(precompileContext(input))
+	require.Error(t, err)
+
+	_, err = blake2FFunc(precompileContext(input[:212]))
+	require.Error(t, err)
+}
+
+func TestEd25519Verify(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey(nil, crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	message := []byte("burrow")
+	signature, err := privateKey.Sign(message)
+	require.NoError(t, err)
+
+	input := append(append(privateKey.GetPublicKey().PublicKey.Bytes(), signature.Signature...), message...)
+	out, err := ed25519VerifyFunc(precompileContext(input))
+	require.NoError(t, err)
+	assert.Equal(t, binary.LeftPadBytes([]byte{1}, binary.Word256Bytes), out)
+
+	input[len(input)-1] ^= 1
+	out, err = ed25519VerifyFunc(precompileContext(input))
+	require.NoError(t, err)
+	assert.Equal(t, binary.LeftPadBytes(nil, binary.Word256Bytes), out)
+
+	_, err = ed25519VerifyFunc(precompileContext(input[:95]))
+	require.Error(t, err)
+}
+
+func TestSha512_256(t *testing.T) {
+	out, err := sha512_256Func(precompileContext([]byte("abc")))
+	require.NoError(t, err)
+	assert.Equal(t, "53048E2681941EF99B2E29B76B4C7DABE4C2D0C634FC6D46E0E2F13107E7AF23",
+		binary.HexBytes(out).String())
+}
+
+func TestRelocate(t *testing.T) {
+	address := leftPadAddress(0x01, 0x00)
+	ns, err := MustDefaultNatives().Relocate(map[string]crypto.Address{"sha512_256Func": address})
+	require.NoError(t, err)
+	assert.True(t, ns.IsRegistered(address))
+	assert.False(t, ns.IsRegistered(leftPadAddress(11)))
+	assert.Equal(t, "sha512_256Func", ns.GetByAddress(address).FullName())
+	// Others remain where they were
+	assert.Equal(t, "blake2FFunc", ns.GetByAddress(leftPadAddress(9)).FullName())
+	// Leaves defaults untouched
+	assert.True(t, MustDefaultNatives().IsRegistered(leftPadAddress(11)))
+
+	_, err = MustDefaultNatives().Relocate(map[string]crypto.Address{"blake2FFunc": leftPadAddress(2)})
+	require.Error(t, err, "should not be able to relocate onto another precompile")
+	_, err = MustDefaultNatives().Relocate(map[string]crypto.Address{"Permissions": address})
+	require.Error(t, err, "should not be able to relocate a native contract")
+}
@@ -0,0 +1,75 @@
+package native
+
+import (
+	"encoding/binary"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+// Randomness provides deterministic pseudo-randomness derived from the chain so that every validator computes the same
+// value. It is predictable by anyone who can see the previous block and the transaction, and can be influenced by the
+// proposer of the previous block and by whoever chooses when to submit the transaction, so it must not be used where
+// money rides on the outcome.
+var Randomness = New().MustContract("Randomness",
+	`* Interface for deterministic pseudo-randomness.
+		* @dev This interface describes the functions exposed by the native randomness layer in burrow.
+		* @dev SECURITY: values are the keccak256 hash of the previous block hash, the current block height, the transaction
+		* @dev origin and its sequence number, and a caller-provided salt. They are deterministic so that all validators
+		* @dev agree on them, which also means they are predictable: anyone who can see the previous block and the
+		* @dev transaction can compute the value in advance, the proposer of the previous block can influence it, and a
+		* @dev sender can choose when to submit or withhold a transaction. Suitable for tie-breaking, sampling, and
+		* @dev shuffling where no party benefits from a particular outcome, NOT for lotteries, gambling, or key generation.
+		`,
+	Function{
+		Comment: `
+			* @notice Gets a pseudo-random value for the current transaction
+			* @param _salt distinguishes values drawn within the same transaction - the same salt gives the same value
+			* @return _result the pseudo-random value
+			`,
+		Gas: GasSha3,
+		F:   random,
+	},
+)
+
+type randomArgs struct {
+	Salt [32]byte
+}
+
+type randomRets struct {
+	Result [32]byte
+}
+
+func random(ctx Context, args randomArgs) (randomRets, error) {
+	if ctx.State.Blockchain == nil {
+		return randomRets{}, errors.Errorf(errors.Codes.NativeFunction, "blockchain is not available in this context")
+	}
+	height := ctx.State.Blockchain.LastBlockHeight()
+	var blockHash []byte
+	if height > 0 {
+		var err error
+		blockHash, err = ctx.State.Blockchain.BlockHash(height)
+		if err != nil {
+			return randomRets{}, err
+		}
+	}
+	origin, err := mustAccount(ctx.State.CallFrame, ctx.Origin)
+	if err != nil {
+		return randomRets{}, err
+	}
+	seed := make([]byte, 0, len(blockHash)+8+crypto.AddressLength+8+len(args.Salt))
+	seed = append(seed, blockHash...)
+	seed = appendUint64(seed, height)
+	seed = append(seed, origin.Address.Bytes()...)
+	seed = appendUint64(seed, origin.Sequence)
+	seed = append(seed, args.Salt[:]...)
+	rets := randomRets{}
+	copy(rets.Result[:], crypto.Keccak256(seed))
+	return rets, nil
+}
+
+func appendUint64(bs []byte, i uint64) []byte {
+	var word [8]byte
+	binary.BigEndian.PutUint64(word[:], i)
+	return append(bs, word[:]...)
+}
@@ -0,0 +1,66 @@
+package native
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type randomnessBlockchain struct {
+	height uint64
+}
+
+func (rb *randomnessBlockchain) LastBlockHeight() uint64  { return rb.height }
+func (rb *randomnessBlockchain) LastBlockTime() time.Time { return time.Time{} }
+func (rb *randomnessBlockchain) BlockHash(height uint64) ([]byte, error) {
+	return crypto.Keccak256([]byte{byte(height)}), nil
+}
+
+func TestRandomness(t *testing.T) {
+	contract := Randomness.GetByName("Randomness").(*Contract)
+	st := acmstate.NewMemoryState()
+	origin := &acm.Account{Address: crypto.Address{1, 2, 3}, Sequence: 4}
+	require.NoError(t, st.UpdateAccount(origin))
+	blockchain := &randomnessBlockchain{height: 10}
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st),
+		Blockchain: blockchain,
+		EventSink:  exec.NewNoopEventSink(),
+	}
+
+	random := func(salt [32]byte) [32]byte {
+		function := contract.FunctionByName("random")
+		packed, err := abi.Pack(function.Abi().Inputs, salt)
+		require.NoError(t, err)
+		input := bc.MustSplice(function.Abi().FunctionID[:], packed)
+		gas := uint64(1000)
+		ret, err := contract.Call(state, engine.CallParams{Origin: origin.Address, Caller: origin.Address,
+			Input: input, Gas: &gas})
+		require.NoError(t, err)
+		var result [32]byte
+		require.NoError(t, abi.Unpack(function.Abi().Outputs, ret, &result))
+		return result
+	}
+
+	first := random([32]byte{1})
+	assert.NotEqual(t, [32]byte{}, first)
+	// Deterministic
+	assert.Equal(t, first, random([32]byte{1}))
+	// Salt, block, and transaction all vary the result
+	assert.NotEqual(t, first, random([32]byte{2}))
+	blockchain.height = 11
+	assert.NotEqual(t, first, random([32]byte{1}))
+	blockchain.height = 10
+	origin.Sequence = 5
+	require.NoError(t, st.UpdateAccount(origin))
+	assert.NotEqual(t, first, random([32]byte{1}))
+}
@@ -0,0 +1,132 @@
+package native
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+var ValidatorSet = New().MustContract("ValidatorSet",
+	`* Interface for reading the current validator set.
+		* @dev This interface describes the functions exposed by the native validator set layer in burrow.
+		* @dev Reflects the validator set in force at the start of the current block.
+		`,
+	Function{
+		Comment: `
+			* @notice Gets the total voting power of the validator set
+			* @return _result total power of all validators
+			`,
+		Gas: GasGetAccount,
+		F:   totalPower,
+	},
+	Function{
+		Comment: `
+			* @notice Gets the voting power of a validator
+			* @param _account validator address
+			* @return _result power of the validator or zero if it is not a validator
+			`,
+		Gas: GasGetAccount,
+		F:   power,
+	},
+	Function{
+		Comment: `
+			* @notice Lists the validators and their voting powers sorted by address
+			* @return _addresses validator addresses
+			* @return _powers the power of each validator in the same order as _addresses
+			`,
+		Gas: GasGetAccount,
+		F:   validators,
+	},
+)
+
+type totalPowerArgs struct {
+}
+
+type totalPowerRets struct {
+	Result uint64
+}
+
+func totalPower(ctx Context, args totalPowerArgs) (totalPowerRets, error) {
+	total := new(big.Int)
+	err := iterateValidators(ctx, func(address crypto.Address, power uint64) {
+		total.Add(total, new(big.Int).SetUint64(power))
+	})
+	if err != nil {
+		return totalPowerRets{}, err
+	}
+	if !total.IsUint64() {
+		return totalPowerRets{}, fmt.Errorf("total validator power %v overflows uint64", total)
+	}
+	return totalPowerRets{Result: total.Uint64()}, nil
+}
+
+type powerArgs struct {
+	Account crypto.Address
+}
+
+type powerRets struct {
+	Result uint64
+}
+
+func power(ctx Context, args powerArgs) (powerRets, error) {
+	vb, err := validatorsBlockchain(ctx)
+	if err != nil {
+		return powerRets{}, err
+	}
+	p, err := vb.Validators().Power(args.Account)
+	if err != nil {
+		return powerRets{}, err
+	}
+	if p == nil {
+		return powerRets{}, nil
+	}
+	if !p.IsUint64() {
+		return powerRets{}, fmt.Errorf("power %v of validator %v overflows uint64", p, args.Account)
+	}
+	return powerRets{Result: p.Uint64()}, nil
+}
+
+type validatorsArgs struct {
+}
+
+type validatorsRets struct {
+	Addresses []crypto.Address
+	Powers    []uint64
+}
+
+func validators(ctx Context, args validatorsArgs) (validatorsRets, error) {
+	rets := validatorsRets{}
+	err := iterateValidators(ctx, func(address crypto.Address, power uint64) {
+		rets.Addresses = append(rets.Addresses, address)
+		rets.Powers = append(rets.Powers, power)
+	})
+	if err != nil {
+		return validatorsRets{}, err
+	}
+	return rets, nil
+}
+
+func validatorsBlockchain(ctx Context) (engine.ValidatorsBlockchain, error) {
+	vb, ok := ctx.State.Blockchain.(engine.ValidatorsBlockchain)
+	if !ok {
+		return nil, errors.Errorf(errors.Codes.NativeFunction, "validator set is not available in this context")
+	}
+	return vb, nil
+}
+
+func iterateValidators(ctx Context, iter func(address crypto.Address, power uint64)) error {
+	vb, err := validatorsBlockchain(ctx)
+	if err != nil {
+		return err
+	}
+	return vb.Validators().IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		if !power.IsUint64() {
+			return fmt.Errorf("power %v of validator %v overflows uint64", power, id.GetAddress())
+		}
+		iter(id.GetAddress(), power.Uint64())
+		return nil
+	})
+}
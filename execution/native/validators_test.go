@@ -0,0 +1,92 @@
+package native
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatorSetBlockchain struct {
+	validators *validator.Set
+}
+
+func (vb *validatorSetBlockchain) LastBlockHeight() uint64                 { return 1 }
+func (vb *validatorSetBlockchain) LastBlockTime() time.Time                { return time.Time{} }
+func (vb *validatorSetBlockchain) BlockHash(height uint64) ([]byte, error) { return nil, nil }
+func (vb *validatorSetBlockchain) Validators() validator.IterableReader    { return vb.validators }
+
+func TestValidatorSet(t *testing.T) {
+	val1 := crypto.PrivateKeyFromSecret("val1", crypto.CurveTypeEd25519).GetPublicKey()
+	val2 := crypto.PrivateKeyFromSecret("val2", crypto.CurveTypeEd25519).GetPublicKey()
+	set := validator.NewSet()
+	set.ChangePower(val1, big.NewInt(30))
+	set.ChangePower(val2, big.NewInt(12))
+
+	contract := ValidatorSet.GetByName("ValidatorSet").(*Contract)
+	st := acmstate.NewMemoryState()
+	caller := &acm.Account{Address: crypto.Address{1, 2, 3}}
+	require.NoError(t, st.UpdateAccount(caller))
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st),
+		Blockchain: &validatorSetBlockchain{validators: set},
+		EventSink:  exec.NewNoopEventSink(),
+	}
+
+	call := func(name string, args ...interface{}) []byte {
+		function := contract.FunctionByName(name)
+		packed, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		input := bc.MustSplice(function.Abi().FunctionID[:], packed)
+		gas := uint64(1000)
+		ret, err := contract.Call(state, engine.CallParams{Caller: caller.Address, Input: input, Gas: &gas})
+		require.NoError(t, err)
+		return ret
+	}
+
+	var total uint64
+	require.NoError(t, abi.Unpack(contract.FunctionByName("totalPower").Abi().Outputs, call("totalPower"), &total))
+	assert.Equal(t, uint64(42), total)
+
+	var power uint64
+	require.NoError(t, abi.Unpack(contract.FunctionByName("power").Abi().Outputs,
+		call("power", val2.GetAddress()), &power))
+	assert.Equal(t, uint64(12), power)
+	require.NoError(t, abi.Unpack(contract.FunctionByName("power").Abi().Outputs,
+		call("power", caller.Address), &power))
+	assert.Equal(t, uint64(0), power)
+
+	var addresses, powers string
+	require.NoError(t, abi.Unpack(contract.FunctionByName("validators").Abi().Outputs, call("validators"),
+		&addresses, &powers))
+	var expectedAddresses, expectedPowers []string
+	require.NoError(t, set.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		expectedAddresses = append(expectedAddresses, id.GetAddress().String())
+		expectedPowers = append(expectedPowers, power.String())
+		return nil
+	}))
+	assert.Equal(t, "["+strings.Join(expectedAddresses, ",")+"]", addresses)
+	assert.Equal(t, "["+strings.Join(expectedPowers, ",")+"]", powers)
+
+	// No validators available
+	state.Blockchain = nil
+	gas := uint64(1000)
+	_, err := contract.Call(state, engine.CallParams{
+		Caller: caller.Address,
+		Input:  contract.FunctionByName("totalPower").Abi().FunctionID[:],
+		Gas:    &gas,
+	})
+	assert.Equal(t, errors.Codes.NativeFunction, errors.GetCode(err))
+}
@@ -0,0 +1,99 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Prefetcher reads the state that transactions admitted to the mempool will touch in the background so that it is
+// already in the state caches when they are executed in a block. Contract calls are simulated against committed state
+// to read exactly the storage they access. The reader must be the State the committer executes against: the committer
+// reads accounts and storage missing from its per-block caches through the same State, whose trees and IAVL nodes
+// stay cached across blocks, so prefetched reads are served from memory rather than the database.
+type Prefetcher struct {
+	reader     acmstate.Reader
+	natives    *native.Natives
+	blockchain bcm.BlockchainInfo
+	queue      chan *txs.Envelope
+	logger     *logging.Logger
+}
+
+// NewPrefetcher returns a Prefetcher that reads from reader (the committer's backend), simulating calls with natives,
+// and holds up to queueSize transactions waiting to be prefetched, beyond which further transactions are dropped rather
+// than slow mempool admission
+func NewPrefetcher(reader acmstate.Reader, natives *native.Natives, blockchain bcm.BlockchainInfo, queueSize int,
+	logger *logging.Logger) *Prefetcher {
+	return &Prefetcher{
+		reader:     reader,
+		natives:    natives,
+		blockchain: blockchain,
+		queue:      make(chan *txs.Envelope, queueSize),
+		logger:     logger.WithScope("Prefetcher"),
+	}
+}
+
+// Prefetch queues the state touched by txEnv to be read, returning false if the queue is full
+func (p *Prefetcher) Prefetch(txEnv *txs.Envelope) bool {
+	select {
+	case p.queue <- txEnv:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run prefetches queued transactions until ctx is done
+func (p *Prefetcher) Run(ctx context.Context) {
+	for {
+		select {
+		case txEnv := <-p.queue:
+			p.prefetch(txEnv)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Reads the accounts (with their code) that txEnv touches and, if it calls a contract, simulates the call to read the
+// storage it touches. Errors only mean the state will not be cached so are just logged.
+func (p *Prefetcher) prefetch(txEnv *txs.Envelope) {
+	var addresses []crypto.Address
+	for _, input := range txEnv.Tx.GetInputs() {
+		addresses = append(addresses, input.Address)
+	}
+	switch tx := txEnv.Tx.Payload.(type) {
+	case *payload.SendTx:
+		for _, output := range tx.Outputs {
+			addresses = append(addresses, output.Address)
+		}
+	case *payload.CallTx:
+		if tx.Address != nil {
+			addresses = append(addresses, *tx.Address)
+		}
+	}
+	for _, address := range addresses {
+		_, err := p.reader.GetAccount(address)
+		if err != nil {
+			p.logger.TraceMsg("Could not prefetch account", "address", address, structure.ErrorKey, err)
+			return
+		}
+	}
+	tx, ok := txEnv.Tx.Payload.(*payload.CallTx)
+	if !ok || tx.Address == nil || tx.Input == nil {
+		return
+	}
+	// The simulation runs on a cache over committed state so reads but never writes storage
+	_, err := CallSim(p.reader, nil, p.natives, p.blockchain, tx.Input.Address, *tx.Address, tx.Data, p.logger)
+	if err != nil {
+		p.logger.TraceMsg("Could not simulate call to prefetch storage", structure.TxHashKey, txEnv.Tx.Hash(),
+			structure.ErrorKey, err)
+	}
+}
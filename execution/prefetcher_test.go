@@ -0,0 +1,175 @@
+package execution
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Records the accounts and storage read through it
+type recordingReader struct {
+	acmstate.Reader
+	sync.Mutex
+	accounts map[crypto.Address]bool
+	storage  map[binary.Word256]bool
+}
+
+func (rr *recordingReader) GetAccount(address crypto.Address) (*acm.Account, error) {
+	rr.Lock()
+	rr.accounts[address] = true
+	rr.Unlock()
+	return rr.Reader.GetAccount(address)
+}
+
+func (rr *recordingReader) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	rr.Lock()
+	rr.storage[key] = true
+	rr.Unlock()
+	return rr.Reader.GetStorage(address, key)
+}
+
+func (rr *recordingReader) read(address crypto.Address, key *binary.Word256) bool {
+	rr.Lock()
+	defer rr.Unlock()
+	return rr.accounts[address] && (key == nil || rr.storage[*key])
+}
+
+func TestPrefetcher(t *testing.T) {
+	caller := crypto.Address{1}
+	recipient := crypto.Address{2}
+	contract := crypto.Address{3}
+	st := acmstate.NewMemoryState()
+	require.NoError(t, st.UpdateAccount(&acm.Account{Address: caller, Balance: 1000,
+		Permissions: permission.AllAccountPermissions}))
+	// Reads storage key 5
+	require.NoError(t, st.UpdateAccount(&acm.Account{Address: contract,
+		EVMCode: []byte{byte(asm.PUSH1), 5, byte(asm.SLOAD), byte(asm.STOP)}}))
+	reader := &recordingReader{
+		Reader:   st,
+		accounts: make(map[crypto.Address]bool),
+		storage:  make(map[binary.Word256]bool),
+	}
+	prefetcher := NewPrefetcher(reader, nil, newBlockchain(testGenesisDoc), 1, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go prefetcher.Run(ctx)
+
+	send := &payload.SendTx{
+		Inputs:  []*payload.TxInput{{Address: caller, Amount: 10}},
+		Outputs: []*payload.TxOutput{{Address: recipient, Amount: 10}},
+	}
+	require.True(t, prefetch(prefetcher, send))
+	require.Eventually(t, func() bool {
+		return reader.read(caller, nil) && reader.read(recipient, nil)
+	}, time.Second, time.Millisecond)
+
+	require.True(t, prefetch(prefetcher, &payload.CallTx{
+		Input:   &payload.TxInput{Address: caller},
+		Address: &contract,
+	}))
+	key := binary.Int64ToWord256(5)
+	require.Eventually(t, func() bool {
+		return reader.read(contract, &key)
+	}, time.Second, time.Millisecond)
+
+	// Transactions are dropped rather than block when the queue is full
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	prefetch(prefetcher, send)
+	assert.False(t, prefetch(prefetcher, send))
+}
+
+// Counts the reads that reach the database
+type countingDB struct {
+	dbm.DB
+	sync.Mutex
+	gets int
+}
+
+func (cdb *countingDB) Get(key []byte) ([]byte, error) {
+	cdb.Lock()
+	cdb.gets++
+	cdb.Unlock()
+	return cdb.DB.Get(key)
+}
+
+func (cdb *countingDB) reset() int {
+	cdb.Lock()
+	defer cdb.Unlock()
+	gets := cdb.gets
+	cdb.gets = 0
+	return gets
+}
+
+func TestPrefetcher_WarmsCommitterReads(t *testing.T) {
+	caller := crypto.Address{1}
+	contract := crypto.Address{3}
+	key := binary.Int64ToWord256(5)
+	db := &countingDB{DB: dbm.NewMemDB()}
+	st := state.NewState(db)
+	_, version, err := st.Update(func(ws state.Updatable) error {
+		err := ws.UpdateAccount(&acm.Account{Address: acm.GlobalPermissionsAddress,
+			Permissions: permission.DefaultAccountPermissions})
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateAccount(&acm.Account{Address: caller, Balance: 1000,
+			Permissions: permission.AllAccountPermissions})
+		if err != nil {
+			return err
+		}
+		// Reads storage key 5
+		err = ws.UpdateAccount(&acm.Account{Address: contract,
+			EVMCode: []byte{byte(asm.PUSH1), 5, byte(asm.SLOAD), byte(asm.STOP)}})
+		if err != nil {
+			return err
+		}
+		return ws.SetStorage(contract, key, []byte{1})
+	})
+	require.NoError(t, err)
+	call := txs.Enclose(testGenesisDoc.ChainID(), &payload.CallTx{
+		Input:   &payload.TxInput{Address: caller},
+		Address: &contract,
+	})
+
+	// The committer reads what is missing from its block cache through the State it executes against
+	committerReads := func(st *state.State) int {
+		db.reset()
+		cache := acmstate.NewCache(st)
+		_, err := cache.GetAccount(contract)
+		require.NoError(t, err)
+		_, err = cache.GetStorage(contract, key)
+		require.NoError(t, err)
+		return db.reset()
+	}
+
+	// A freshly loaded state has to read storage from the database
+	cold, err := state.LoadState(db, version)
+	require.NoError(t, err)
+	assert.NotZero(t, committerReads(cold))
+
+	// But not once the transaction has been prefetched
+	warm, err := state.LoadState(db, version)
+	require.NoError(t, err)
+	NewPrefetcher(warm, nil, newBlockchain(testGenesisDoc), 1, logger).prefetch(call)
+	assert.Zero(t, committerReads(warm))
+}
+
+func prefetch(prefetcher *Prefetcher, tx payload.Payload) bool {
+	return prefetcher.Prefetch(txs.Enclose(testGenesisDoc.ChainID(), tx))
+}
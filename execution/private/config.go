@@ -0,0 +1,63 @@
+package private
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+)
+
+// Configures this node's participation in private transactions, which are disabled when absent
+type Config struct {
+	// File containing this node's hex-encoded private encryption key, a new key is generated if it does not exist
+	KeyFile string
+	// The GRPC addresses of other nodes' private transaction services to which sent payloads are distributed
+	Peers []string `json:",omitempty" toml:",omitempty"`
+	// The private transaction service is served on its own listener, separate from the public GRPC service
+	ListenHost string
+	ListenPort string
+	// The certificate and key this node presents to its peers and clients, and the CA by which their certificates
+	// must be signed. Both sides of every connection to the service are authenticated.
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		KeyFile:    "private_key",
+		ListenHost: "0.0.0.0",
+		ListenPort: "10999",
+	}
+}
+
+func (conf *Config) ListenAddress() string {
+	return net.JoinHostPort(conf.ListenHost, conf.ListenPort)
+}
+
+// TLSConfig returns the mutual TLS configuration used both to serve the private transaction service and to dial peers
+func (conf *Config) TLSConfig() (*tls.Config, error) {
+	if conf.TLSCertFile == "" || conf.TLSKeyFile == "" || conf.TLSCAFile == "" {
+		return nil, fmt.Errorf("private transactions require TLSCertFile, TLSKeyFile, and TLSCAFile")
+	}
+	cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load private transaction certificate: %v", err)
+	}
+	caPEM, err := ioutil.ReadFile(conf.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read private transaction CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in private transaction CA file %s", conf.TLSCAFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package private
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	KeyLength   = 32
+	NonceLength = 24
+)
+
+// A node's encryption key pair to which payloads are sealed
+type Key struct {
+	PublicKey  [KeyLength]byte
+	PrivateKey [KeyLength]byte
+}
+
+func GenerateKey() (*Key, error) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{PublicKey: *publicKey, PrivateKey: *privateKey}, nil
+}
+
+func KeyFromPrivateKey(privateKey []byte) (*Key, error) {
+	if len(privateKey) != KeyLength {
+		return nil, fmt.Errorf("private encryption key should be %d bytes but is %d", KeyLength, len(privateKey))
+	}
+	key := new(Key)
+	copy(key.PrivateKey[:], privateKey)
+	curve25519.ScalarBaseMult(&key.PublicKey, &key.PrivateKey)
+	return key, nil
+}
+
+// Loads the hex-encoded private key from file, generating and saving a new key if file does not exist
+func LoadOrGenerateKey(file string) (*Key, error) {
+	bs, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		key, err := GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		err = ioutil.WriteFile(file, []byte(hex.EncodeToString(key.PrivateKey[:])), 0600)
+		if err != nil {
+			return nil, fmt.Errorf("could not save private encryption key: %v", err)
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := hex.DecodeString(strings.TrimSpace(string(bs)))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode private encryption key in %s: %v", file, err)
+	}
+	return KeyFromPrivateKey(privateKey)
+}
+
+// Encrypts call under a fresh symmetric key that is sealed by sender to each of the recipients and to sender itself
+func Seal(call *PrivateCall, sender *Key, recipients [][]byte) (*EncryptedPayload, error) {
+	bs, err := call.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var secret [KeyLength]byte
+	_, err = io.ReadFull(rand.Reader, secret[:])
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+	ep := &EncryptedPayload{
+		Sender:     sender.PublicKey[:],
+		Nonce:      nonce[:],
+		Ciphertext: secretbox.Seal(nil, bs, nonce, &secret),
+	}
+	sealed := make(map[[KeyLength]byte]bool)
+	for _, recipient := range append([][]byte{sender.PublicKey[:]}, recipients...) {
+		if len(recipient) != KeyLength {
+			return nil, fmt.Errorf("recipient encryption key should be %d bytes but is %d", KeyLength,
+				len(recipient))
+		}
+		var recipientKey [KeyLength]byte
+		copy(recipientKey[:], recipient)
+		if sealed[recipientKey] {
+			continue
+		}
+		sealed[recipientKey] = true
+		nonce, err := newNonce()
+		if err != nil {
+			return nil, err
+		}
+		ep.Keys = append(ep.Keys, &SealedKey{
+			Recipient: recipientKey[:],
+			Nonce:     nonce[:],
+			Key:       box.Seal(nil, secret[:], nonce, &recipientKey, &sender.PrivateKey),
+		})
+	}
+	return ep, nil
+}
+
+// Decrypts the call in ep if key is one of its recipients
+func Open(ep *EncryptedPayload, key *Key) (*PrivateCall, error) {
+	sk := ep.SealedKeyFor(key.PublicKey[:])
+	if sk == nil {
+		return nil, fmt.Errorf("payload is not sealed to recipient %X", key.PublicKey)
+	}
+	if len(ep.Sender) != KeyLength || len(sk.Nonce) != NonceLength || len(ep.Nonce) != NonceLength {
+		return nil, fmt.Errorf("malformed encrypted payload")
+	}
+	var sender [KeyLength]byte
+	var nonce [NonceLength]byte
+	copy(sender[:], ep.Sender)
+	copy(nonce[:], sk.Nonce)
+	secretBytes, ok := box.Open(nil, sk.Key, &nonce, &sender, &key.PrivateKey)
+	if !ok || len(secretBytes) != KeyLength {
+		return nil, fmt.Errorf("could not open payload key sealed to recipient %X", key.PublicKey)
+	}
+	var secret [KeyLength]byte
+	copy(secret[:], secretBytes)
+	copy(nonce[:], ep.Nonce)
+	bs, ok := secretbox.Open(nil, ep.Ciphertext, &nonce, &secret)
+	if !ok {
+		return nil, fmt.Errorf("could not decrypt payload")
+	}
+	call := new(PrivateCall)
+	err := call.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// The hash committed on-chain by a PrivateTx
+func (ep *EncryptedPayload) Hash() ([]byte, error) {
+	bs, err := ep.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bs)
+	return hash[:], nil
+}
+
+func (ep *EncryptedPayload) SealedKeyFor(recipient []byte) *SealedKey {
+	for _, sk := range ep.Keys {
+		if bytes.Equal(sk.Recipient, recipient) {
+			return sk
+		}
+	}
+	return nil
+}
+
+func newNonce() (*[NonceLength]byte, error) {
+	nonce := new([NonceLength]byte)
+	_, err := io.ReadFull(rand.Reader, nonce[:])
+	if err != nil {
+		return nil, err
+	}
+	return nonce, nil
+}
@@ -0,0 +1,58 @@
+package private
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	alice := newKey(t)
+	bob := newKey(t)
+	carol := newKey(t)
+	call := &PrivateCall{Data: []byte{1, 2, 3}, GasLimit: 100}
+
+	ep, err := Seal(call, alice, [][]byte{bob.PublicKey[:], bob.PublicKey[:]})
+	require.NoError(t, err)
+	// Sender is always a recipient and duplicates are sealed once
+	assert.Len(t, ep.Keys, 2)
+
+	for _, key := range []*Key{alice, bob} {
+		opened, err := Open(ep, key)
+		require.NoError(t, err)
+		assert.Equal(t, call, opened)
+	}
+	_, err = Open(ep, carol)
+	require.Error(t, err)
+
+	// Cannot open a key sealed to someone else by claiming to be them
+	ep.Keys[1].Recipient = carol.PublicKey[:]
+	_, err = Open(ep, carol)
+	require.Error(t, err)
+
+	_, err = Seal(call, alice, [][]byte{{1, 2, 3}})
+	require.Error(t, err)
+}
+
+func TestLoadOrGenerateKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "private")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "private_key")
+
+	key, err := LoadOrGenerateKey(file)
+	require.NoError(t, err)
+	loaded, err := LoadOrGenerateKey(file)
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+}
+
+func newKey(t *testing.T) *Key {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	return key
+}
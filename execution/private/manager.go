@@ -0,0 +1,311 @@
+package private
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	payloadPrefix  = []byte("payload/")
+	receiptPrefix  = []byte("receipt/")
+	executedPrefix = []byte("executed/")
+	statePrefix    = []byte("state/")
+	versionKey     = []byte("version")
+)
+
+// Holds the encrypted payloads addressed to this node and executes them against a private state that is never
+// committed on-chain and so may differ between nodes according to the participant groups they belong to
+type Manager struct {
+	sync.Mutex
+	key    *Key
+	db     dbm.DB
+	state  *state.State
+	vm     *evm.EVM
+	peers  map[string]PrivateTransactionsClient
+	logger *logging.Logger
+}
+
+// Creates a Manager that stores payloads, receipts, and private state in db (which should not be shared) and dials
+// peers with creds, which are required if there are any peers
+func NewManager(key *Key, db dbm.DB, vmOptions evm.Options, peers []string, creds credentials.TransportCredentials,
+	logger *logging.Logger) (*Manager, error) {
+	m := &Manager{
+		key:    key,
+		db:     db,
+		vm:     evm.New(vmOptions),
+		peers:  make(map[string]PrivateTransactionsClient, len(peers)),
+		logger: logger.WithScope("PrivateTransactions"),
+	}
+	err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	if len(peers) > 0 && creds == nil {
+		return nil, fmt.Errorf("private transaction peers can only be dialled over TLS")
+	}
+	for _, peer := range peers {
+		// Does not block so peers need not be up
+		conn, err := grpc.Dial(peer, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("could not dial private transaction peer %s: %v", peer, err)
+		}
+		m.peers[peer] = NewPrivateTransactionsClient(conn)
+	}
+	return m, nil
+}
+
+func (m *Manager) PublicKey() []byte {
+	return m.key.PublicKey[:]
+}
+
+// Encrypts call to recipients, stores it, and distributes it to peers returning the hash to commit in a PrivateTx
+func (m *Manager) Send(call *PrivateCall, recipients [][]byte) ([]byte, error) {
+	if call.Caller == crypto.ZeroAddress {
+		return nil, fmt.Errorf("PrivateCall must name its Caller")
+	}
+	ep, err := Seal(call, m.key, recipients)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := m.store(ep)
+	if err != nil {
+		return nil, err
+	}
+	for peer, client := range m.peers {
+		_, err := client.Push(context.Background(), ep)
+		if err != nil {
+			// Distribution is best-effort, the payload can be pushed again before its PrivateTx is committed
+			m.logger.InfoMsg("Could not push private payload to peer", "peer", peer, "payload_hash",
+				fmt.Sprintf("%X", hash), "error", err)
+		}
+	}
+	return hash, nil
+}
+
+// Stores ep if it is addressed to this node, returning its hash in any case
+func (m *Manager) Push(ep *EncryptedPayload) ([]byte, error) {
+	if ep.SealedKeyFor(m.PublicKey()) == nil {
+		return ep.Hash()
+	}
+	return m.store(ep)
+}
+
+func (m *Manager) Payload(hash []byte) (*EncryptedPayload, error) {
+	bs, err := m.db.Get(prefixed(payloadPrefix, hash))
+	if err != nil || bs == nil {
+		return nil, err
+	}
+	ep := new(EncryptedPayload)
+	err = ep.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return ep, nil
+}
+
+// Get the Receipt of executing the private payload of the PrivateTx with txHash, or nil if it was not executed
+func (m *Manager) Receipt(txHash []byte) (*Receipt, error) {
+	bs, err := m.db.Get(prefixed(receiptPrefix, txHash))
+	if err != nil || bs == nil {
+		return nil, err
+	}
+	receipt := new(Receipt)
+	err = receipt.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (m *Manager) GetAccount(address crypto.Address) (*acm.Account, error) {
+	return m.state.GetAccount(address)
+}
+
+// Executes the payload of a delivered PrivateTx against the private state if this node holds it and is a recipient,
+// the result is recorded in a Receipt so that redelivery of the same transaction is a no-op. A payload is only executed
+// for the PrivateTx of its caller and only once, so that others who see its hash on chain cannot replay it.
+func (m *Manager) ExecutePrivate(blockchain engine.Blockchain, txHash []byte, tx *payload.PrivateTx) error {
+	m.Lock()
+	defer m.Unlock()
+	receipt, err := m.Receipt(txHash)
+	if err != nil || receipt != nil {
+		return err
+	}
+	ep, err := m.Payload(tx.PayloadHash)
+	if err != nil {
+		return err
+	}
+	if ep == nil {
+		// We are not party to this transaction (or have not been sent its payload)
+		return nil
+	}
+	call, err := Open(ep, m.key)
+	if err != nil {
+		return err
+	}
+	receipt = &Receipt{
+		TxHash:      txHash,
+		PayloadHash: tx.PayloadHash,
+	}
+	executedBy, err := m.db.Get(prefixed(executedPrefix, tx.PayloadHash))
+	if err != nil {
+		return err
+	}
+	cache := acmstate.NewCache(m.state)
+	switch {
+	case call.Caller != tx.Input.Address:
+		receipt.Exception = fmt.Sprintf("private payload is from caller %v but PrivateTx input is %v", call.Caller,
+			tx.Input.Address)
+	case executedBy != nil:
+		receipt.Exception = fmt.Sprintf("private payload was already executed by transaction %X", executedBy)
+	default:
+		receipt.Address, receipt.Return, receipt.GasUsed, err = m.call(cache, blockchain, call.Caller, txHash, call)
+		if err != nil {
+			receipt.Exception = err.Error()
+		}
+	}
+	bs, err := receipt.Marshal()
+	if err != nil {
+		return err
+	}
+	_, version, err := m.state.Update(func(up state.Updatable) error {
+		if receipt.Exception != "" {
+			return nil
+		}
+		return cache.Sync(up)
+	})
+	if err != nil {
+		return err
+	}
+	batch := m.db.NewBatch()
+	defer batch.Close()
+	batch.Set(prefixed(receiptPrefix, txHash), bs)
+	if call.Caller == tx.Input.Address && executedBy == nil {
+		batch.Set(prefixed(executedPrefix, tx.PayloadHash), txHash)
+	}
+	batch.Set(versionKey, versionBytes(version))
+	return batch.WriteSync()
+}
+
+func (m *Manager) call(st acmstate.ReaderWriter, blockchain engine.Blockchain, caller crypto.Address,
+	txHash []byte, call *PrivateCall) (crypto.Address, []byte, uint64, error) {
+
+	acc, err := st.GetAccount(caller)
+	if err != nil {
+		return crypto.ZeroAddress, nil, 0, err
+	}
+	if acc == nil {
+		err = native.CreateAccount(st, caller)
+		if err != nil {
+			return crypto.ZeroAddress, nil, 0, err
+		}
+	}
+	var callee crypto.Address
+	var code, input []byte
+	if call.Address == nil {
+		callee = crypto.NewContractAddress(caller, txHash)
+		err = native.CreateAccount(st, callee)
+		if err != nil {
+			return callee, nil, 0, err
+		}
+		code = call.Data
+	} else {
+		callee = *call.Address
+		acc, err := st.GetAccount(callee)
+		if err != nil {
+			return callee, nil, 0, err
+		}
+		if acc == nil {
+			return callee, nil, 0, fmt.Errorf("no private contract at %v", callee)
+		}
+		code = acc.EVMCode
+		input = call.Data
+	}
+	gas := call.GasLimit
+	output, err := m.vm.Execute(st, blockchain, exec.NewNoopEventSink(), engine.CallParams{
+		Origin: caller,
+		Caller: caller,
+		Callee: callee,
+		Input:  input,
+		Gas:    &gas,
+	}, code)
+	gasUsed := call.GasLimit - gas
+	if err != nil {
+		return callee, nil, gasUsed, err
+	}
+	if call.Address == nil {
+		err = native.InitEVMCode(st, callee, output)
+		if err != nil {
+			return callee, nil, gasUsed, err
+		}
+	}
+	return callee, output, gasUsed, nil
+}
+
+func (m *Manager) store(ep *EncryptedPayload) ([]byte, error) {
+	hash, err := ep.Hash()
+	if err != nil {
+		return nil, err
+	}
+	bs, err := ep.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	err = m.db.SetSync(prefixed(payloadPrefix, hash), bs)
+	if err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+func (m *Manager) loadState() error {
+	db := dbm.NewPrefixDB(m.db, statePrefix)
+	bs, err := m.db.Get(versionKey)
+	if err != nil {
+		return err
+	}
+	if bs != nil {
+		m.state, err = state.LoadState(db, int64(binary.BigEndian.Uint64(bs)))
+		if err != nil {
+			return fmt.Errorf("could not load private state: %v", err)
+		}
+		return nil
+	}
+	// Private contracts are not subject to the permissions of the public chain
+	m.state = state.NewState(db)
+	_, version, err := m.state.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(genesis.PermissionsAccount(permission.AllAccountPermissions))
+	})
+	if err != nil {
+		return fmt.Errorf("could not initialise private state: %v", err)
+	}
+	return m.db.SetSync(versionKey, versionBytes(version))
+}
+
+func prefixed(prefix, key []byte) []byte {
+	return append(append([]byte{}, prefix...), key...)
+}
+
+func versionBytes(version int64) []byte {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, uint64(version))
+	return bs
+}
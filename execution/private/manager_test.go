@@ -0,0 +1,137 @@
+package private
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestManager_ExecutePrivate(t *testing.T) {
+	aliceDB := dbm.NewMemDB()
+	alice := newManager(t, aliceDB)
+	bob := newManager(t, dbm.NewMemDB())
+	carol := newManager(t, dbm.NewMemDB())
+	managers := []*Manager{alice, bob, carol}
+	caller := crypto.Address{1, 2, 3}
+	mallory := crypto.Address{6, 6, 6}
+
+	// Increments a counter in storage and returns it
+	runtime := MustSplice(PUSH1, 1, PUSH1, 0, SLOAD, ADD, DUP1, PUSH1, 0, SSTORE, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0,
+		RETURN)
+	create := MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN,
+		runtime)
+
+	send := func(call *PrivateCall) []byte {
+		hash, err := alice.Send(call, [][]byte{bob.PublicKey()})
+		require.NoError(t, err)
+		ep, err := alice.Payload(hash)
+		require.NoError(t, err)
+		for _, m := range []*Manager{bob, carol} {
+			pushed, err := m.Push(ep)
+			require.NoError(t, err)
+			assert.Equal(t, hash, pushed)
+		}
+		return hash
+	}
+	execute := func(txHash []byte, input crypto.Address, payloadHash []byte) {
+		for _, m := range managers {
+			require.NoError(t, m.ExecutePrivate(blockchain{}, txHash, payload.NewPrivateTx(input, payloadHash)))
+		}
+	}
+	// Returns the counter after a call to the contract
+	counter := func(m *Manager, txHash []byte) int64 {
+		receipt, err := m.Receipt(txHash)
+		require.NoError(t, err)
+		require.Empty(t, receipt.Exception)
+		return binary.Int64FromWord256(binary.LeftPadWord256(receipt.Return))
+	}
+
+	_, err := alice.Send(&PrivateCall{Data: create, GasLimit: 100000}, [][]byte{bob.PublicKey()})
+	require.Error(t, err, "a PrivateCall must name its caller")
+
+	createHash := send(&PrivateCall{Caller: caller, Data: create, GasLimit: 100000})
+	execute([]byte("create"), caller, createHash)
+
+	receipt, err := alice.Receipt([]byte("create"))
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.Empty(t, receipt.Exception)
+	contract := receipt.Address
+	assert.Equal(t, crypto.NewContractAddress(caller, []byte("create")), contract)
+
+	// Carol is not a recipient so never stores or executes the payload
+	ep, err := carol.Payload(createHash)
+	require.NoError(t, err)
+	assert.Nil(t, ep)
+	receipt, err = carol.Receipt([]byte("create"))
+	require.NoError(t, err)
+	assert.Nil(t, receipt)
+
+	execute([]byte("call1"), caller, send(&PrivateCall{Caller: caller, Address: &contract, GasLimit: 100000}))
+	callHash := send(&PrivateCall{Caller: caller, Address: &contract, GasLimit: 100000})
+	execute([]byte("call2"), caller, callHash)
+	// Redelivery does not execute again
+	execute([]byte("call2"), caller, callHash)
+	// Nor does another PrivateTx committing to the same payload
+	execute([]byte("replay"), caller, callHash)
+	// Nor does a PrivateTx from someone other than the payload's caller, which does not prevent the caller's own
+	mallorysHash := send(&PrivateCall{Caller: caller, Address: &contract, GasLimit: 100000})
+	execute([]byte("mallory"), mallory, mallorysHash)
+	execute([]byte("call3"), caller, mallorysHash)
+
+	for _, m := range []*Manager{alice, bob} {
+		assert.Equal(t, int64(2), counter(m, []byte("call2")))
+		receipt, err = m.Receipt([]byte("replay"))
+		require.NoError(t, err)
+		assert.Contains(t, receipt.Exception, "already executed")
+		receipt, err = m.Receipt([]byte("mallory"))
+		require.NoError(t, err)
+		assert.Contains(t, receipt.Exception, "is from caller")
+		assert.Equal(t, int64(3), counter(m, []byte("call3")))
+		acc, err := m.GetAccount(contract)
+		require.NoError(t, err)
+		assert.Equal(t, acm.Bytecode(runtime), acc.EVMCode)
+	}
+	acc, err := carol.GetAccount(contract)
+	require.NoError(t, err)
+	assert.Nil(t, acc)
+
+	// Private state is reloaded
+	alice = newManager(t, aliceDB)
+	acc, err = alice.GetAccount(contract)
+	require.NoError(t, err)
+	assert.NotNil(t, acc)
+}
+
+func newManager(t *testing.T, db dbm.DB) *Manager {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	m, err := NewManager(key, db, evm.Options{}, nil, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+	return m
+}
+
+type blockchain struct{}
+
+func (blockchain) LastBlockHeight() uint64 {
+	return 1
+}
+
+func (blockchain) LastBlockTime() time.Time {
+	return time.Time{}
+}
+
+func (blockchain) BlockHash(height uint64) ([]byte, error) {
+	return make([]byte, 32), nil
+}
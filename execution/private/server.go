@@ -0,0 +1,59 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+)
+
+type privateServer struct {
+	manager *Manager
+}
+
+var _ PrivateTransactionsServer = &privateServer{}
+
+func NewPrivateServer(manager *Manager) PrivateTransactionsServer {
+	return &privateServer{manager: manager}
+}
+
+func (ps *privateServer) Send(ctx context.Context, request *SendRequest) (*PayloadHash, error) {
+	if request.Call == nil {
+		return nil, fmt.Errorf("SendRequest must contain a PrivateCall")
+	}
+	hash, err := ps.manager.Send(request.Call, request.Recipients)
+	if err != nil {
+		return nil, err
+	}
+	return &PayloadHash{Hash: hash}, nil
+}
+
+func (ps *privateServer) Push(ctx context.Context, ep *EncryptedPayload) (*PayloadHash, error) {
+	hash, err := ps.manager.Push(ep)
+	if err != nil {
+		return nil, err
+	}
+	return &PayloadHash{Hash: hash}, nil
+}
+
+func (ps *privateServer) Receipt(ctx context.Context, request *ReceiptRequest) (*Receipt, error) {
+	receipt, err := ps.manager.Receipt(request.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, fmt.Errorf("no private receipt for transaction %v", request.TxHash)
+	}
+	return receipt, nil
+}
+
+func (ps *privateServer) Account(ctx context.Context, request *AccountRequest) (*acm.Account, error) {
+	acc, err := ps.manager.GetAccount(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, fmt.Errorf("no private account at %v", request.Address)
+	}
+	return acc, nil
+}
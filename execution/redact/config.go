@@ -0,0 +1,32 @@
+package redact
+
+type Action string
+
+const (
+	// Replace the value with the Keccak256 hash of the salt followed by the value
+	Hash Action = "hash"
+	// Replace the value with zeroes (or nothing for Data)
+	Drop Action = "drop"
+)
+
+// Configures the redaction of LogEvent fields before events leave the node, through the RPC services or to
+// subscribers. Events are stored (and so contribute to the AppHash) as emitted.
+type Config struct {
+	// Mixed into hashed values so that low-entropy values cannot be recovered by hashing guesses
+	Salt string `json:",omitempty" toml:",omitempty"`
+	// Each field is redacted according to the first rule matching it
+	Rules []*Rule
+}
+
+type Rule struct {
+	// The hex address of the contract emitting the event, any contract if empty
+	Contract string `json:",omitempty" toml:",omitempty"`
+	// The event's signature, e.g. Transfer(address,address,uint256), or its hash as hex (the first topic), any event
+	// if empty
+	Event string `json:",omitempty" toml:",omitempty"`
+	// The fields to redact, any of Data, Log1, Log2, and Log3, all of them if empty. Log0 identifies the event so is
+	// never redacted.
+	Fields []string `json:",omitempty" toml:",omitempty"`
+	// Either 'hash' or 'drop'
+	Action Action
+}
@@ -0,0 +1,95 @@
+package redact
+
+import (
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// EventsReader reads the events stored in state
+type EventsReader interface {
+	IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
+		consumer func(*exec.StreamEvent) error) error
+	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	TxReceiptByHash(txHash []byte) (*exec.TxReceipt, error)
+	TxsAtHeight(height uint64) ([]*exec.TxExecution, error)
+	IterateLogs(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64,
+		consumer func(*exec.Event) error) error
+	IterateLogsByTopic(address crypto.Address, signature, topic binary.Word256, startHeight, endHeight *uint64,
+		consumer func(*exec.Event) error) error
+	IterateTxsByAddress(address crypto.Address, after []byte, descending bool,
+		consumer func(*exec.TxExecution) error) error
+}
+
+type reader struct {
+	EventsReader
+	redactor *Redactor
+}
+
+// Returns an EventsReader redacting the events read from source, or source itself if redactor is nil
+func NewReader(source EventsReader, redactor *Redactor) EventsReader {
+	if redactor == nil {
+		return source
+	}
+	return &reader{
+		EventsReader: source,
+		redactor:     redactor,
+	}
+}
+
+func (r *reader) IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
+	consumer func(*exec.StreamEvent) error) error {
+	return r.EventsReader.IterateStreamEvents(startHeight, endHeight, sortOrder, func(ev *exec.StreamEvent) error {
+		return consumer(r.redactor.StreamEvent(ev))
+	})
+}
+
+func (r *reader) TxByHash(txHash []byte) (*exec.TxExecution, error) {
+	txe, err := r.EventsReader.TxByHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return r.redactor.TxExecution(txe), nil
+}
+
+func (r *reader) TxReceiptByHash(txHash []byte) (*exec.TxReceipt, error) {
+	receipt, err := r.EventsReader.TxReceiptByHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return r.redactor.TxReceipt(receipt), nil
+}
+
+func (r *reader) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
+	txes, err := r.EventsReader.TxsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	for i, txe := range txes {
+		txes[i] = r.redactor.TxExecution(txe)
+	}
+	return txes, nil
+}
+
+func (r *reader) IterateLogs(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64,
+	consumer func(*exec.Event) error) error {
+	return r.EventsReader.IterateLogs(address, signature, startHeight, endHeight, func(ev *exec.Event) error {
+		return consumer(r.redactor.Event(ev))
+	})
+}
+
+func (r *reader) IterateLogsByTopic(address crypto.Address, signature, topic binary.Word256,
+	startHeight, endHeight *uint64, consumer func(*exec.Event) error) error {
+	return r.EventsReader.IterateLogsByTopic(address, signature, topic, startHeight, endHeight,
+		func(ev *exec.Event) error {
+			return consumer(r.redactor.Event(ev))
+		})
+}
+
+func (r *reader) IterateTxsByAddress(address crypto.Address, after []byte, descending bool,
+	consumer func(*exec.TxExecution) error) error {
+	return r.EventsReader.IterateTxsByAddress(address, after, descending, func(txe *exec.TxExecution) error {
+		return consumer(r.redactor.TxExecution(txe))
+	})
+}
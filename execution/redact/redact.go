@@ -0,0 +1,246 @@
+// Package redact hashes or drops the fields of LogEvents identified by a policy before events are served by the node,
+// for example to keep personal data emitted by a contract out of external event sinks
+package redact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+const DataField = "Data"
+
+// The topics that may be redacted, the first topic being the event signature
+const firstTopic, lastTopic = 1, 3
+
+// Redactor applies a redaction policy to copies of events. A nil Redactor (as returned when no policy is configured)
+// returns events unchanged.
+type Redactor struct {
+	salt  []byte
+	rules []*rule
+}
+
+type rule struct {
+	contract  *crypto.Address
+	signature *binary.Word256
+	data      bool
+	topics    [lastTopic + 1]bool
+	action    Action
+}
+
+// Returns a Redactor for conf, or nil if conf has no rules
+func New(conf *Config) (*Redactor, error) {
+	if conf == nil || len(conf.Rules) == 0 {
+		return nil, nil
+	}
+	redactor := &Redactor{
+		salt:  []byte(conf.Salt),
+		rules: make([]*rule, len(conf.Rules)),
+	}
+	for i, r := range conf.Rules {
+		var err error
+		redactor.rules[i], err = newRule(r)
+		if err != nil {
+			return nil, fmt.Errorf("could not read redaction rule %d: %v", i, err)
+		}
+	}
+	return redactor, nil
+}
+
+func newRule(r *Rule) (*rule, error) {
+	switch r.Action {
+	case Hash, Drop:
+	default:
+		return nil, fmt.Errorf("action must be '%s' or '%s' but is '%s'", Hash, Drop, r.Action)
+	}
+	ru := &rule{action: r.Action}
+	if r.Contract != "" {
+		address, err := crypto.AddressFromHexString(r.Contract)
+		if err != nil {
+			return nil, err
+		}
+		ru.contract = &address
+	}
+	if r.Event != "" {
+		var signature binary.Word256
+		if strings.Contains(r.Event, "(") {
+			signature = binary.LeftPadWord256(crypto.Keccak256([]byte(r.Event)))
+		} else {
+			bs, err := hex.DecodeString(strings.TrimPrefix(r.Event, "0x"))
+			if err != nil || len(bs) != binary.Word256Bytes {
+				return nil, fmt.Errorf("event '%s' is neither a signature nor a 32 byte hex hash", r.Event)
+			}
+			signature = binary.LeftPadWord256(bs)
+		}
+		ru.signature = &signature
+	}
+	if len(r.Fields) == 0 {
+		ru.data = true
+		for i := firstTopic; i <= lastTopic; i++ {
+			ru.topics[i] = true
+		}
+	}
+	for _, field := range r.Fields {
+		if field == DataField {
+			ru.data = true
+			continue
+		}
+		i, ok := topicIndex(field)
+		if !ok {
+			return nil, fmt.Errorf("field '%s' is not one of %s, %s, %s, or %s", field, DataField,
+				exec.LogNKey(1), exec.LogNKey(2), exec.LogNKey(3))
+		}
+		ru.topics[i] = true
+	}
+	return ru, nil
+}
+
+func topicIndex(field string) (int, bool) {
+	for i := firstTopic; i <= lastTopic; i++ {
+		if field == exec.LogNKey(i) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (ru *rule) matches(log *exec.LogEvent) bool {
+	if ru.contract != nil && *ru.contract != log.Address {
+		return false
+	}
+	if ru.signature != nil && (len(log.Topics) == 0 || *ru.signature != log.Topics[0]) {
+		return false
+	}
+	return true
+}
+
+// Returns a copy of log with its fields redacted, or log itself if no rule matches it
+func (rd *Redactor) Log(log *exec.LogEvent) *exec.LogEvent {
+	if rd == nil || log == nil {
+		return log
+	}
+	var redacted *exec.LogEvent
+	// Each field is redacted by the first rule to match it
+	var dataDone bool
+	var topicsDone [lastTopic + 1]bool
+	for _, ru := range rd.rules {
+		if !ru.matches(log) {
+			continue
+		}
+		if ru.data && !dataDone {
+			dataDone = true
+			if redacted == nil {
+				redacted = copyLog(log)
+			}
+			if ru.action == Hash {
+				redacted.Data = rd.hash(log.Data)
+			} else {
+				redacted.Data = nil
+			}
+			redacted.Redacted = append(redacted.Redacted, DataField)
+		}
+		for i := firstTopic; i <= lastTopic && i < len(log.Topics); i++ {
+			if !ru.topics[i] || topicsDone[i] {
+				continue
+			}
+			topicsDone[i] = true
+			if redacted == nil {
+				redacted = copyLog(log)
+			}
+			if ru.action == Hash {
+				redacted.Topics[i] = binary.LeftPadWord256(rd.hash(log.Topics[i].Bytes()))
+			} else {
+				redacted.Topics[i] = binary.Zero256
+			}
+			redacted.Redacted = append(redacted.Redacted, exec.LogNKey(i))
+		}
+	}
+	if redacted == nil {
+		return log
+	}
+	return redacted
+}
+
+// Returns a copy of ev with its LogEvent redacted, or ev itself if it is not redacted
+func (rd *Redactor) Event(ev *exec.Event) *exec.Event {
+	if rd == nil || ev == nil || ev.Log == nil {
+		return ev
+	}
+	log := rd.Log(ev.Log)
+	if log == ev.Log {
+		return ev
+	}
+	redacted := *ev
+	redacted.Log = log
+	return &redacted
+}
+
+func (rd *Redactor) StreamEvent(ev *exec.StreamEvent) *exec.StreamEvent {
+	if rd == nil || ev == nil || ev.Event == nil {
+		return ev
+	}
+	event := rd.Event(ev.Event)
+	if event == ev.Event {
+		return ev
+	}
+	redacted := *ev
+	redacted.Event = event
+	return &redacted
+}
+
+func (rd *Redactor) TxExecution(txe *exec.TxExecution) *exec.TxExecution {
+	if rd == nil || txe == nil {
+		return txe
+	}
+	redacted := *txe
+	redacted.Events = make([]*exec.Event, len(txe.Events))
+	for i, ev := range txe.Events {
+		redacted.Events[i] = rd.Event(ev)
+	}
+	if len(txe.TxExecutions) > 0 {
+		redacted.TxExecutions = make([]*exec.TxExecution, len(txe.TxExecutions))
+		for i, child := range txe.TxExecutions {
+			redacted.TxExecutions[i] = rd.TxExecution(child)
+		}
+	}
+	return &redacted
+}
+
+func (rd *Redactor) TxReceipt(receipt *exec.TxReceipt) *exec.TxReceipt {
+	if rd == nil || receipt == nil {
+		return receipt
+	}
+	redacted := *receipt
+	redacted.Logs = make([]*exec.LogEvent, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		redacted.Logs[i] = rd.Log(log)
+	}
+	return &redacted
+}
+
+func (rd *Redactor) BlockExecution(be *exec.BlockExecution) *exec.BlockExecution {
+	if rd == nil || be == nil {
+		return be
+	}
+	redacted := *be
+	redacted.TxExecutions = make([]*exec.TxExecution, len(be.TxExecutions))
+	for i, txe := range be.TxExecutions {
+		redacted.TxExecutions[i] = rd.TxExecution(txe)
+	}
+	return &redacted
+}
+
+func (rd *Redactor) hash(value []byte) []byte {
+	return crypto.Keccak256(append(append([]byte{}, rd.salt...), value...))
+}
+
+func copyLog(log *exec.LogEvent) *exec.LogEvent {
+	redacted := *log
+	redacted.Topics = append([]binary.Word256{}, log.Topics...)
+	redacted.Redacted = append([]string{}, log.Redacted...)
+	return &redacted
+}
@@ -0,0 +1,148 @@
+package redact
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signature = "Registered(address,string)"
+
+func TestRedactor_Log(t *testing.T) {
+	registry := crypto.Address{1}
+	other := crypto.Address{2}
+	eventID := binary.LeftPadWord256(crypto.Keccak256([]byte(signature)))
+	subject := binary.LeftPadWord256([]byte("subject"))
+	newLog := func(address crypto.Address) *exec.LogEvent {
+		return &exec.LogEvent{
+			Address: address,
+			Topics:  []binary.Word256{eventID, subject, binary.One256},
+			Data:    []byte("jane@example.com"),
+		}
+	}
+
+	redactor, err := New(&Config{
+		Salt: "pepper",
+		Rules: []*Rule{
+			{Contract: registry.String(), Event: signature, Fields: []string{"Log1"}, Action: Drop},
+			{Contract: registry.String(), Event: eventID.String(), Action: Hash},
+		},
+	})
+	require.NoError(t, err)
+
+	log := newLog(registry)
+	redacted := redactor.Log(log)
+	assert.Equal(t, newLog(registry), log, "should not modify original log")
+	assert.Equal(t, registry, redacted.Address)
+	// The first rule matching a field applies
+	assert.Equal(t, []binary.Word256{eventID, binary.Zero256,
+		binary.LeftPadWord256(crypto.Keccak256(append([]byte("pepper"), binary.One256.Bytes()...)))},
+		redacted.Topics)
+	assert.Equal(t, crypto.Keccak256([]byte("pepperjane@example.com")), redacted.Data.Bytes())
+	assert.Equal(t, []string{"Log1", "Data", "Log2"}, redacted.Redacted)
+
+	log = newLog(other)
+	assert.True(t, log == redactor.Log(log), "should not copy log no rule matches")
+
+	// No policy means no redaction
+	redactor, err = New(&Config{})
+	require.NoError(t, err)
+	assert.Nil(t, redactor)
+	assert.True(t, log == redactor.Log(log))
+
+	_, err = New(&Config{Rules: []*Rule{{Fields: []string{"Log0"}, Action: Drop}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Rules: []*Rule{{Action: "encrypt"}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Rules: []*Rule{{Event: "Registered", Action: Hash}}})
+	assert.Error(t, err)
+}
+
+func TestNewReader(t *testing.T) {
+	log := &exec.LogEvent{Address: crypto.Address{1}, Topics: []binary.Word256{binary.One256}, Data: []byte("secret")}
+	txe := &exec.TxExecution{
+		TxHeader: &exec.TxHeader{TxHash: []byte{1}},
+		Events:   []*exec.Event{{Log: log}},
+	}
+	source := events{txe}
+	assert.Equal(t, source, NewReader(source, nil))
+
+	redactor, err := New(&Config{Rules: []*Rule{{Fields: []string{DataField}, Action: Drop}}})
+	require.NoError(t, err)
+	reader := NewReader(source, redactor)
+
+	redacted, err := reader.TxByHash([]byte{1})
+	require.NoError(t, err)
+	assert.Empty(t, redacted.Events[0].Log.Data)
+	assert.Equal(t, "secret", string(txe.Events[0].Log.Data))
+
+	receipt, err := reader.TxReceiptByHash([]byte{1})
+	require.NoError(t, err)
+	assert.Empty(t, receipt.Logs[0].Data)
+	assert.Equal(t, "secret", string(log.Data))
+
+	err = reader.IterateStreamEvents(nil, nil, storage.AscendingSort, func(ev *exec.StreamEvent) error {
+		if ev.Event != nil {
+			assert.Empty(t, ev.Event.Log.Data)
+			assert.Equal(t, []string{DataField}, ev.Event.Log.Redacted)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+type events []*exec.TxExecution
+
+func (es events) IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
+	consumer func(*exec.StreamEvent) error) error {
+	for _, txe := range es {
+		for _, ev := range txe.StreamEvents() {
+			err := consumer(ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (es events) TxByHash(txHash []byte) (*exec.TxExecution, error) {
+	for _, txe := range es {
+		if string(txe.TxHash) == string(txHash) {
+			return txe, nil
+		}
+	}
+	return nil, nil
+}
+
+func (es events) TxReceiptByHash(txHash []byte) (*exec.TxReceipt, error) {
+	txe, err := es.TxByHash(txHash)
+	if txe == nil || err != nil {
+		return nil, err
+	}
+	return txe.TxReceipt(), nil
+}
+
+func (es events) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
+	return es, nil
+}
+
+func (es events) IterateLogs(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64,
+	consumer func(*exec.Event) error) error {
+	return nil
+}
+
+func (es events) IterateLogsByTopic(address crypto.Address, signature, topic binary.Word256,
+	startHeight, endHeight *uint64, consumer func(*exec.Event) error) error {
+	return nil
+}
+
+func (es events) IterateTxsByAddress(address crypto.Address, after []byte, descending bool,
+	consumer func(*exec.TxExecution) error) error {
+	return nil
+}
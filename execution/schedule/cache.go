@@ -0,0 +1,151 @@
+package schedule
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Cache accumulates scheduling changes made during a block so that they can be written to state on commit
+type Cache struct {
+	sync.RWMutex
+	backend Reader
+	heights map[uint64]*heightInfo
+}
+
+type heightInfo struct {
+	// GovTxs scheduled at this height within the cache
+	added []*payload.ScheduledGovTx
+	// Whether all GovTxs scheduled in the backend at this height have been removed
+	removed bool
+	// GovTxs scheduled in the backend at this height that have been individually removed, keyed by TxHash
+	vetoed map[string]bool
+}
+
+var _ ReaderWriter = &Cache{}
+
+// Returns a Cache that can write to an output Writer via Sync
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend: backend,
+		heights: make(map[uint64]*heightInfo),
+	}
+}
+
+func (cache *Cache) GetScheduledGovTxs(height uint64) ([]*payload.ScheduledGovTx, error) {
+	cache.RLock()
+	defer cache.RUnlock()
+	info := cache.heights[height]
+	if info == nil {
+		return cache.backend.GetScheduledGovTxs(height)
+	}
+	if info.removed {
+		return info.added, nil
+	}
+	scheduled, err := cache.backend.GetScheduledGovTxs(height)
+	if err != nil {
+		return nil, err
+	}
+	remaining := scheduled[:0]
+	for _, sgt := range scheduled {
+		if !info.vetoed[string(sgt.TxHash)] {
+			remaining = append(remaining, sgt)
+		}
+	}
+	return append(remaining, info.added...), nil
+}
+
+func (cache *Cache) ScheduleGovTx(scheduled *payload.ScheduledGovTx) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info := cache.get(scheduled.Height)
+	info.added = append(info.added, scheduled)
+	return nil
+}
+
+func (cache *Cache) RemoveScheduledGovTxs(height uint64) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info := cache.get(height)
+	info.added = nil
+	info.removed = true
+	info.vetoed = nil
+	return nil
+}
+
+func (cache *Cache) RemoveScheduledGovTx(height uint64, txHash binary.HexBytes) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info := cache.get(height)
+	for i, sgt := range info.added {
+		if bytes.Equal(sgt.TxHash, txHash) {
+			info.added = append(info.added[:i], info.added[i+1:]...)
+			return nil
+		}
+	}
+	if info.vetoed == nil {
+		info.vetoed = make(map[string]bool)
+	}
+	info.vetoed[string(txHash)] = true
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync or use Flush if your wish to use the output state as your next backend
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	heights := make([]uint64, 0, len(cache.heights))
+	for height := range cache.heights {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	for _, height := range heights {
+		info := cache.heights[height]
+		if info.removed {
+			err := state.RemoveScheduledGovTxs(height)
+			if err != nil {
+				return err
+			}
+		}
+		txHashes := make([]string, 0, len(info.vetoed))
+		for txHash := range info.vetoed {
+			txHashes = append(txHashes, txHash)
+		}
+		sort.Strings(txHashes)
+		for _, txHash := range txHashes {
+			err := state.RemoveScheduledGovTx(height, binary.HexBytes(txHash))
+			if err != nil {
+				return err
+			}
+		}
+		for _, scheduled := range info.added {
+			err := state.ScheduleGovTx(scheduled)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.heights = make(map[uint64]*heightInfo)
+}
+
+func (cache *Cache) get(height uint64) *heightInfo {
+	info := cache.heights[height]
+	if info == nil {
+		info = new(heightInfo)
+		cache.heights[height] = info
+	}
+	return info
+}
@@ -0,0 +1,35 @@
+package schedule
+
+import (
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type Reader interface {
+	// Returns the GovTxs scheduled for activation at height
+	GetScheduledGovTxs(height uint64) ([]*payload.ScheduledGovTx, error)
+}
+
+type Writer interface {
+	// Schedule a GovTx for activation at its height
+	ScheduleGovTx(scheduled *payload.ScheduledGovTx) error
+	// Remove all GovTxs scheduled at height (once they have been applied)
+	RemoveScheduledGovTxs(height uint64) error
+	// Remove a single GovTx scheduled at height (if it has been vetoed)
+	RemoveScheduledGovTx(height uint64, txHash binary.HexBytes) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	// Iterate over all pending scheduled GovTxs in order of activation height
+	IterateScheduledGovTxs(consumer func(scheduled *payload.ScheduledGovTx) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
@@ -5,28 +5,38 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
-// Run a contract's code on an isolated and unpersisted state
+// Run a contract's code on an isolated and unpersisted state under the chain params read from params (which may be nil)
+// with natives mounted (or the default natives if nil)
 // Cannot be used to create new contracts
-func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+func CallSim(reader acmstate.Reader, params chainparams.Reader, natives *native.Natives, blockchain bcm.BlockchainInfo,
+	fromAddress, address crypto.Address, data []byte, logger *logging.Logger) (*exec.TxExecution, error) {
 
 	cache := acmstate.NewCache(reader)
+	// Simulated calls report the gas used by each opcode
+	profile := evm.NewGasProfile()
+	vm := evm.New(evm.Options{Natives: natives})
+	vm.SetGasProfile(profile)
 	exe := contexts.CallContext{
-		EVM:           evm.Default(),
+		EVM:           vm,
 		RunCall:       true,
 		State:         cache,
 		MetadataState: acmstate.NewMemoryState(),
 		Blockchain:    blockchain,
 		Logger:        logger,
 	}
+	if params != nil {
+		exe.Params = params
+	}
 
 	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(), &payload.CallTx{
 		Input: &payload.TxInput{
@@ -43,12 +53,16 @@ func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress,
 	if err != nil {
 		return nil, err
 	}
+	if txe.Result != nil {
+		txe.Result.GasProfile = profile.Opcodes()
+	}
 	return txe, nil
 }
 
 // Run the given code on an isolated and unpersisted state
 // Cannot be used to create new contracts.
-func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, code, data []byte,
+func CallCodeSim(reader acmstate.Reader, params chainparams.Reader, natives *native.Natives,
+	blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, code, data []byte,
 	logger *logging.Logger) (*exec.TxExecution, error) {
 
 	// Attach code to target account (overwriting target)
@@ -61,5 +75,5 @@ func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddr
 	if err != nil {
 		return nil, err
 	}
-	return CallSim(cache, blockchain, fromAddress, address, data, logger)
+	return CallSim(cache, params, natives, blockchain, fromAddress, address, data, logger)
 }
@@ -1,7 +1,11 @@
 package state
 
 import (
+	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/storage"
 )
 
 func (s *ReadState) GetMetadata(metahash acmstate.MetadataHash) (string, error) {
@@ -12,3 +16,71 @@ func (s *ReadState) GetMetadata(metahash acmstate.MetadataHash) (string, error)
 func (ws *writeState) SetMetadata(metahash acmstate.MetadataHash, abi string) error {
 	return ws.plain.Set(keys.Abi.Key(metahash.Bytes()), []byte(abi))
 }
+
+func (s *ReadState) GetCodeMetadata(codehash acmstate.CodeHash) (*acm.CodeMetadata, error) {
+	bs, err := s.Plain.Get(keys.CodeMetadata.Key(codehash.Bytes()))
+	if err != nil || bs == nil {
+		return nil, err
+	}
+	codeMeta := new(acm.CodeMetadata)
+	err = encoding.Decode(bs, codeMeta)
+	if err != nil {
+		return nil, err
+	}
+	return codeMeta, nil
+}
+
+func (ws *writeState) SetCodeMetadata(codehash acmstate.CodeHash, codeMeta *acm.CodeMetadata) error {
+	bs, err := encoding.Encode(codeMeta)
+	if err != nil {
+		return err
+	}
+	return ws.plain.Set(keys.CodeMetadata.Key(codehash.Bytes()), bs)
+}
+
+func (s *ReadState) GetDeployment(address crypto.Address) (*acm.Deployment, error) {
+	bs, err := s.Plain.Get(keys.Deployment.Key(address))
+	if err != nil || bs == nil {
+		return nil, err
+	}
+	deployment := new(acm.Deployment)
+	err = encoding.Decode(bs, deployment)
+	if err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func (ws *writeState) SetDeployment(address crypto.Address, deployment *acm.Deployment) error {
+	bs, err := encoding.Encode(deployment)
+	if err != nil {
+		return err
+	}
+	return ws.plain.Set(keys.Deployment.Key(address), bs)
+}
+
+// Iterate the deployments of contracts in order of address
+func (s *ReadState) IterateDeployments(consumer func(address crypto.Address, deployment *acm.Deployment) error) error {
+	low := keys.Deployment.Key()
+	it, err := s.Plain.Iterator(low, storage.Prefix(low).Above())
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		address, err := crypto.AddressFromBytes(keys.Deployment.ScanBytes(it.Key())[0])
+		if err != nil {
+			return err
+		}
+		deployment := new(acm.Deployment)
+		err = encoding.Decode(it.Value(), deployment)
+		if err != nil {
+			return err
+		}
+		err = consumer(address, deployment)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
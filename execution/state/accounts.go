@@ -92,11 +92,21 @@ func (ws *writeState) RemoveAccount(address crypto.Address) error {
 }
 
 func (s *ReadState) IterateAccounts(consumer func(*acm.Account) error) error {
+	return s.IterateAccountsAfter(nil, consumer)
+}
+
+// IterateAccountsAfter iterates over accounts in order of address starting with the first whose address follows after
+// (or the first of all if after is nil)
+func (s *ReadState) IterateAccountsAfter(after *crypto.Address, consumer func(*acm.Account) error) error {
 	tree, err := s.Forest.Reader(keys.Account.Prefix())
 	if err != nil {
 		return err
 	}
-	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+	var start []byte
+	if after != nil {
+		start = successor(keys.Account.KeyNoPrefix(*after))
+	}
+	return tree.Iterate(start, nil, true, func(key []byte, value []byte) error {
 		account := new(acm.Account)
 		err := encoding.Decode(value, account)
 		if err != nil {
@@ -121,6 +131,19 @@ func (s *ReadState) GetStorage(address crypto.Address, key binary.Word256) ([]by
 	return tree.Get(keyFormat.KeyNoPrefix(key))
 }
 
+// StorageReader resolves the storage tree of the account at address once so that a batch of reads from it need not
+// look the tree up for each key
+func (s *ReadState) StorageReader(address crypto.Address) (func(key binary.Word256) ([]byte, error), error) {
+	keyFormat := keys.Storage.Fix(address)
+	tree, err := s.Forest.Reader(keyFormat.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	return func(key binary.Word256) ([]byte, error) {
+		return tree.Get(keyFormat.KeyNoPrefix(key))
+	}, nil
+}
+
 func (ws *writeState) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
 	keyFormat := keys.Storage.Fix(address)
 	tree, err := ws.forest.Writer(keyFormat.Prefix())
@@ -143,12 +166,23 @@ func (ws *writeState) SetStorage(address crypto.Address, key binary.Word256, val
 }
 
 func (s *ReadState) IterateStorage(address crypto.Address, consumer func(key binary.Word256, value []byte) error) error {
+	return s.IterateStorageAfter(address, nil, consumer)
+}
+
+// IterateStorageAfter iterates over the storage of address in order of key starting with the first key that follows
+// after (or the first of all if after is nil)
+func (s *ReadState) IterateStorageAfter(address crypto.Address, after *binary.Word256,
+	consumer func(key binary.Word256, value []byte) error) error {
 	keyFormat := keys.Storage.Fix(address)
 	tree, err := s.Forest.Reader(keyFormat.Prefix())
 	if err != nil {
 		return err
 	}
-	return tree.Iterate(nil, nil, true,
+	var start []byte
+	if after != nil {
+		start = successor(after.Bytes())
+	}
+	return tree.Iterate(start, nil, true,
 		func(key []byte, value []byte) error {
 
 			if len(key) != binary.Word256Bytes {
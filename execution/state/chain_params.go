@@ -0,0 +1,42 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/chainparams"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+var _ chainparams.Reader = &State{}
+
+func (s *ReadState) GetChainParams() (*payload.ChainParams, error) {
+	tree, err := s.Forest.Reader(keys.Params.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Params.KeyNoPrefix(chainParamsKey))
+	if err != nil {
+		return nil, err
+	} else if len(bs) == 0 {
+		return nil, nil
+	}
+	chainParams := new(payload.ChainParams)
+	return chainParams, encoding.Decode(bs, chainParams)
+}
+
+func (ws *writeState) UpdateChainParams(chainParams *payload.ChainParams) error {
+	if chainParams == nil {
+		return fmt.Errorf("UpdateChainParams passed nil ChainParams in State")
+	}
+	bs, err := encoding.Encode(chainParams)
+	if err != nil {
+		return fmt.Errorf("UpdateChainParams could not encode ChainParams: %v", err)
+	}
+	tree, err := ws.forest.Writer(keys.Params.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Params.KeyNoPrefix(chainParamsKey), bs)
+	return nil
+}
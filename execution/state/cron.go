@@ -0,0 +1,134 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/cron"
+)
+
+var _ cron.IterableReader = &State{}
+
+func (s *ReadState) GetCronJob(id uint64) (*cron.Job, error) {
+	tree, err := s.Forest.Reader(keys.CronJob.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.CronJob.KeyNoPrefix(id))
+	if err != nil {
+		return nil, err
+	} else if bs == nil {
+		return nil, nil
+	}
+	job := new(cron.Job)
+	return job, encoding.Decode(bs, job)
+}
+
+func (s *ReadState) GetDueCronJobs(height uint64) ([]*cron.Job, error) {
+	tree, err := s.Forest.Reader(keys.CronDue.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	var jobs []*cron.Job
+	err = tree.Iterate(keys.CronDue.KeyNoPrefix(height), keys.CronDue.KeyNoPrefix(height+1), true,
+		func(key []byte, _ []byte) error {
+			var dueHeight, id uint64
+			err := keys.CronDue.ScanNoPrefix(key, &dueHeight, &id)
+			if err != nil {
+				return err
+			}
+			job, err := s.GetCronJob(id)
+			if err != nil {
+				return err
+			} else if job == nil {
+				return fmt.Errorf("State.GetDueCronJobs() found job %d due at height %d that does not exist", id,
+					height)
+			}
+			jobs = append(jobs, job)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *ReadState) LastCronJobID() (uint64, error) {
+	return s.lastID(cronJobIDKey)
+}
+
+func (ws *writeState) UpdateCronJob(job *cron.Job) error {
+	if job == nil {
+		return fmt.Errorf("UpdateCronJob passed nil Job in State")
+	}
+	err := ws.removeCronDue(job.ID)
+	if err != nil {
+		return err
+	}
+	bs, err := encoding.Encode(job)
+	if err != nil {
+		return fmt.Errorf("UpdateCronJob could not encode Job: %v", err)
+	}
+	tree, err := ws.forest.Writer(keys.CronJob.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.CronJob.KeyNoPrefix(job.ID), bs)
+	due, err := ws.forest.Writer(keys.CronDue.Prefix())
+	if err != nil {
+		return err
+	}
+	due.Set(keys.CronDue.KeyNoPrefix(job.NextHeight, job.ID), []byte{})
+	return ws.assignedID(cronJobIDKey, job.ID)
+}
+
+func (ws *writeState) RemoveCronJob(id uint64) error {
+	err := ws.removeCronDue(id)
+	if err != nil {
+		return err
+	}
+	tree, err := ws.forest.Writer(keys.CronJob.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Delete(keys.CronJob.KeyNoPrefix(id))
+	return nil
+}
+
+// Remove the entry marking when any existing job with id is next due
+func (ws *writeState) removeCronDue(id uint64) error {
+	tree, err := ws.forest.Writer(keys.CronJob.Prefix())
+	if err != nil {
+		return err
+	}
+	bs, err := tree.Get(keys.CronJob.KeyNoPrefix(id))
+	if err != nil || bs == nil {
+		return err
+	}
+	job := new(cron.Job)
+	err = encoding.Decode(bs, job)
+	if err != nil {
+		return err
+	}
+	due, err := ws.forest.Writer(keys.CronDue.Prefix())
+	if err != nil {
+		return err
+	}
+	due.Delete(keys.CronDue.KeyNoPrefix(job.NextHeight, id))
+	return nil
+}
+
+func (s *ReadState) IterateCronJobs(consumer func(job *cron.Job) error) error {
+	tree, err := s.Forest.Reader(keys.CronJob.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(_ []byte, value []byte) error {
+		job := new(cron.Job)
+		err := encoding.Decode(value, job)
+		if err != nil {
+			return fmt.Errorf("State.IterateCronJobs() could not iterate over cron jobs: %v", err)
+		}
+		return consumer(job)
+	})
+}
@@ -0,0 +1,69 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/escrow"
+)
+
+var _ escrow.IterableReader = &State{}
+
+func (s *ReadState) GetEscrow(id uint64) (*escrow.Escrow, error) {
+	tree, err := s.Forest.Reader(keys.Escrow.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Escrow.KeyNoPrefix(id))
+	if err != nil {
+		return nil, err
+	} else if bs == nil {
+		return nil, nil
+	}
+	esc := new(escrow.Escrow)
+	return esc, encoding.Decode(bs, esc)
+}
+
+func (s *ReadState) LastEscrowID() (uint64, error) {
+	return s.lastID(escrowIDKey)
+}
+
+func (ws *writeState) UpdateEscrow(esc *escrow.Escrow) error {
+	if esc == nil {
+		return fmt.Errorf("UpdateEscrow passed nil Escrow in State")
+	}
+	bs, err := encoding.Encode(esc)
+	if err != nil {
+		return fmt.Errorf("UpdateEscrow could not encode Escrow: %v", err)
+	}
+	tree, err := ws.forest.Writer(keys.Escrow.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Escrow.KeyNoPrefix(esc.ID), bs)
+	return ws.assignedID(escrowIDKey, esc.ID)
+}
+
+func (ws *writeState) RemoveEscrow(id uint64) error {
+	tree, err := ws.forest.Writer(keys.Escrow.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Delete(keys.Escrow.KeyNoPrefix(id))
+	return nil
+}
+
+func (s *ReadState) IterateEscrows(consumer func(esc *escrow.Escrow) error) error {
+	tree, err := s.Forest.Reader(keys.Escrow.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(_ []byte, value []byte) error {
+		esc := new(escrow.Escrow)
+		err := encoding.Decode(value, esc)
+		if err != nil {
+			return fmt.Errorf("State.IterateEscrows() could not iterate over escrows: %v", err)
+		}
+		return consumer(esc)
+	})
+}
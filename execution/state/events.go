@@ -2,12 +2,16 @@ package state
 
 import (
 	"bytes"
+	bin "encoding/binary"
 	"fmt"
 	"io"
 
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs"
 )
 
 func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
@@ -21,11 +25,31 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 	if len(be.TxExecutions) == 0 {
 		return nil
 	}
+	sequenceTree, err := ws.forest.Writer(keys.LogSequence.Prefix())
+	if err != nil {
+		return err
+	}
+	// The last sequence of each address emitting logs in this block
+	sequences := make(map[crypto.Address]uint64)
 	buf := new(bytes.Buffer)
-	var offset int
+	var offset, txOffset int
+	var txHash []byte
+	var exception bool
+	// The addresses involved in the current transaction
+	var involved map[crypto.Address]struct{}
 	for _, ev := range be.StreamEvents() {
+		if ev.Event != nil && involved != nil {
+			for _, address := range involvedAddresses(ev.Event) {
+				involved[address] = struct{}{}
+			}
+		}
+
 		switch {
 		case ev.BeginTx != nil:
+			txHash = ev.BeginTx.TxHeader.TxHash
+			exception = ev.BeginTx.Exception != nil
+			txOffset = offset
+			involved = make(map[crypto.Address]struct{})
 			val := &exec.TxExecutionKey{Height: be.Height, Offset: uint64(offset)}
 			bs, err := encoding.Encode(val)
 			if err != nil {
@@ -36,6 +60,48 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 			if err != nil {
 				return err
 			}
+
+		case ev.EndTx != nil:
+			// Index the transaction by every address involved in it so that an account's transactions can be found
+			// without scanning every block
+			for address := range involved {
+				err := ws.plain.Set(keys.AddressTx.Key(address, be.Height, uint64(txOffset)), txHash)
+				if err != nil {
+					return err
+				}
+			}
+			involved = nil
+
+		case ev.Event != nil && ev.Event.Log != nil && !exception:
+			log := ev.Event.Log
+			sequence, ok := sequences[log.Address]
+			if !ok {
+				sequence, err = getLogSequence(sequenceTree.ImmutableTree, log.Address)
+				if err != nil {
+					return err
+				}
+			}
+			sequence++
+			// Set before the event is stored and published
+			log.Sequence = sequence
+			sequences[log.Address] = sequence
+			if len(log.Topics) > 0 {
+				// Index logs by emitting contract and event signature (the first topic) so that the common query for
+				// all events of a particular type from a particular contract need not scan every event
+				err := ws.plain.Set(keys.LogIndex.Key(log.Address, log.Topics[0], be.Height, uint64(offset)), txHash)
+				if err != nil {
+					return err
+				}
+			}
+			if len(log.Topics) > 1 {
+				// And by their second topic, usually the first indexed argument of the event (e.g. the sender of a
+				// transfer) so that queries for it need not scan every event of the type
+				err := ws.plain.Set(keys.LogTopicIndex.Key(log.Address, log.Topics[0], log.Topics[1], be.Height,
+					uint64(offset)), txHash)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		n, err := encoding.WriteMessage(buf, ev)
@@ -52,9 +118,74 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 	key := keys.Event.KeyNoPrefix(be.Height)
 	tree.Set(key, buf.Bytes())
 
+	for address, sequence := range sequences {
+		bs := make([]byte, uint64Length)
+		bin.BigEndian.PutUint64(bs, sequence)
+		sequenceTree.Set(keys.LogSequence.KeyNoPrefix(address), bs)
+	}
+
+	// Set after the sequences of their logs have been set above
+	err = ws.setTxReceipts(be.TxExecutions)
+	if err != nil {
+		return err
+	}
+
+	return ws.updateHotSet(be)
+}
+
+// Store a receipt for each transaction (including those nested in proposals) so that its outcome can be read without
+// decoding its block
+func (ws *writeState) setTxReceipts(txes []*exec.TxExecution) error {
+	for _, txe := range txes {
+		bs, err := encoding.Encode(txe.TxReceipt())
+		if err != nil {
+			return err
+		}
+		err = ws.plain.Set(keys.TxReceipt.Key(txe.TxHash), bs)
+		if err != nil {
+			return err
+		}
+		err = ws.setTxReceipts(txe.TxExecutions)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The addresses an event shows to be involved in its transaction: inputs, outputs, callees (including created
+// contracts), and log emitters
+func involvedAddresses(ev *exec.Event) []crypto.Address {
+	switch {
+	case ev.Input != nil:
+		return []crypto.Address{ev.Input.Address}
+	case ev.Output != nil:
+		return []crypto.Address{ev.Output.Address}
+	case ev.Call != nil && ev.Call.CallData != nil:
+		return []crypto.Address{ev.Call.CallData.Callee}
+	case ev.Log != nil:
+		return []crypto.Address{ev.Log.Address}
+	}
 	return nil
 }
 
+// LastLogSequence returns the Sequence of the last LogEvent emitted by address, or zero if it has emitted none
+func (s *ReadState) LastLogSequence(address crypto.Address) (uint64, error) {
+	tree, err := s.Forest.Reader(keys.LogSequence.Prefix())
+	if err != nil {
+		return 0, err
+	}
+	return getLogSequence(tree, address)
+}
+
+func getLogSequence(tree storage.KVReader, address crypto.Address) (uint64, error) {
+	bs, err := tree.Get(keys.LogSequence.KeyNoPrefix(address))
+	if err != nil || bs == nil {
+		return 0, err
+	}
+	return bin.BigEndian.Uint64(bs), nil
+}
+
 // Iterate SteamEvents over the closed interval [startHeight, endHeight] - i.e. startHeight and endHeight inclusive
 func (s *ReadState) IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
 	consumer func(*exec.StreamEvent) error) error {
@@ -91,6 +222,78 @@ func (s *ReadState) IterateStreamEvents(startHeight, endHeight *uint64, sortOrde
 	})
 }
 
+// Iterate the LogEvents emitted by address with signature as their first topic over the closed interval
+// [startHeight, endHeight] using the log index. LogEvents from transactions that failed with an exception are not
+// indexed.
+func (s *ReadState) IterateLogs(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64,
+	consumer func(*exec.Event) error) error {
+	return s.iterateLogIndex(keys.LogIndex.Fix(address, signature), startHeight, endHeight, consumer)
+}
+
+// Like IterateLogs but only iterates those LogEvents that also have topic as their second topic
+func (s *ReadState) IterateLogsByTopic(address crypto.Address, signature, topic binary.Word256,
+	startHeight, endHeight *uint64, consumer func(*exec.Event) error) error {
+	return s.iterateLogIndex(keys.LogTopicIndex.Fix(address, signature, topic), startHeight, endHeight, consumer)
+}
+
+// Iterate the LogEvents referred to by the entries of index, whose remaining segments are height and offset
+func (s *ReadState) iterateLogIndex(index *storage.MustKeyFormat, startHeight, endHeight *uint64,
+	consumer func(*exec.Event) error) error {
+	const errHeader = "IterateLogs():"
+	low := []byte(index.Prefix())
+	high := index.Prefix().Above()
+	if startHeight != nil {
+		low = index.Key(*startHeight)
+	}
+	if endHeight != nil {
+		high = index.Key(*endHeight + 1)
+	}
+	it, err := s.Plain.Iterator(low, high)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	blockTree, err := s.Forest.Reader(keys.Event.Prefix())
+	if err != nil {
+		return err
+	}
+	var block []byte
+	var blockHeight uint64
+	for ; it.Valid(); it.Next() {
+		var height, offset uint64
+		err = index.Scan(it.Key(), &height, &offset)
+		if err != nil {
+			return err
+		}
+		if block == nil || height != blockHeight {
+			block, err = blockTree.Get(keys.Event.KeyNoPrefix(height))
+			if err != nil {
+				return err
+			}
+			blockHeight = height
+		}
+		if offset >= uint64(len(block)) {
+			return fmt.Errorf("%s log index refers to offset %d at height %d but no such event is stored",
+				errHeader, offset, height)
+		}
+		ev := new(exec.StreamEvent)
+		_, err = encoding.ReadMessage(bytes.NewBuffer(block[offset:]), ev)
+		if err != nil {
+			return err
+		}
+		if ev.Event == nil || ev.Event.Log == nil {
+			return fmt.Errorf("%s log index refers to offset %d at height %d but found %v rather than a LogEvent",
+				errHeader, offset, height, ev)
+		}
+		err = consumer(ev.Event)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
 func (s *ReadState) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
 	const errHeader = "TxAtHeight():"
 	var stack exec.TxStack
@@ -113,35 +316,127 @@ func (s *ReadState) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
 }
 
 func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
-	const errHeader = "TxByHash():"
-	bs, err := s.Plain.Get(keys.TxHash.Key(txHash))
+	key, err := s.txExecutionKey(txHash)
+	if err != nil || key == nil {
+		return nil, err
+	}
+	blockTree, err := s.Forest.Reader(keys.Event.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	return txAt(blockTree, key.Height, key.Offset)
+}
+
+// Get the receipt of the transaction with hash txHash, or nil if no such transaction has been executed
+func (s *ReadState) TxReceiptByHash(txHash []byte) (*exec.TxReceipt, error) {
+	if len(txHash) != txs.HashLength {
+		return nil, fmt.Errorf("TxReceiptByHash(): transaction hash %X should be %d bytes long", txHash, txs.HashLength)
+	}
+	bs, err := s.Plain.Get(keys.TxReceipt.Key(txHash))
 	if err != nil {
 		return nil, err
 	}
 	if len(bs) == 0 {
 		return nil, nil
 	}
-
-	key := new(exec.TxExecutionKey)
-	err = encoding.Decode(bs, key)
+	receipt := new(exec.TxReceipt)
+	err = encoding.Decode(bs, receipt)
 	if err != nil {
 		return nil, err
 	}
+	return receipt, nil
+}
+
+// Iterate the TxExecutions involving address (as an input, output, callee, created contract, or log emitter) in
+// order of execution, or in reverse if descending is true, starting after the transaction with hash after if it is
+// non-empty.
+func (s *ReadState) IterateTxsByAddress(address crypto.Address, after []byte, descending bool,
+	consumer func(*exec.TxExecution) error) error {
+	const errHeader = "IterateTxsByAddress():"
+	low := keys.AddressTx.Key(address)
+	high := storage.Prefix(low).Above()
+	if len(after) > 0 {
+		if len(after) != txs.HashLength {
+			return fmt.Errorf("%s transaction hash %X to start after should be %d bytes long", errHeader, after,
+				txs.HashLength)
+		}
+		key, err := s.txExecutionKey(after)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return fmt.Errorf("%s could not find transaction %X to start after", errHeader, after)
+		}
+		cursor := keys.AddressTx.Key(address, key.Height, key.Offset)
+		if descending {
+			high = cursor
+		} else {
+			low = successor(cursor)
+		}
+	}
+	var it storage.KVIterator
+	var err error
+	if descending {
+		it, err = s.Plain.ReverseIterator(low, high)
+	} else {
+		it, err = s.Plain.Iterator(low, high)
+	}
+	if err != nil {
+		return err
+	}
+	defer it.Close()
 
 	blockTree, err := s.Forest.Reader(keys.Event.Prefix())
+	if err != nil {
+		return err
+	}
+	for ; it.Valid(); it.Next() {
+		var height, offset uint64
+		err = keys.AddressTx.Scan(it.Key(), nil, &height, &offset)
+		if err != nil {
+			return err
+		}
+		txe, err := txAt(blockTree, height, offset)
+		if err != nil {
+			return fmt.Errorf("%s %v", errHeader, err)
+		}
+		err = consumer(txe)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Look up where the transaction with txHash is stored, returning nil if it is not found
+func (s *ReadState) txExecutionKey(txHash []byte) (*exec.TxExecutionKey, error) {
+	bs, err := s.Plain.Get(keys.TxHash.Key(txHash))
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	key := new(exec.TxExecutionKey)
+	err = encoding.Decode(bs, key)
 	if err != nil {
 		return nil, err
 	}
+	return key, nil
+}
 
-	bs, err = blockTree.Get(keys.Event.KeyNoPrefix(key.Height))
+// Read the TxExecution whose BeginTx is stored at offset in the block at height
+func txAt(blockTree storage.KVReader, height, offset uint64) (*exec.TxExecution, error) {
+	const errHeader = "txAt():"
+	bs, err := blockTree.Get(keys.Event.KeyNoPrefix(height))
 	if err != nil {
 		return nil, err
-	} else if len(bs) == 0 {
-		return nil, fmt.Errorf("%s could not retrieve transaction with TxHash %X despite finding reference",
-			errHeader, txHash)
+	} else if uint64(len(bs)) <= offset {
+		return nil, fmt.Errorf("%s could not retrieve transaction at offset %d of block %d despite finding reference",
+			errHeader, offset, height)
 	}
 
-	buf := bytes.NewBuffer(bs[key.Offset:])
+	buf := bytes.NewBuffer(bs[offset:])
 	var stack exec.TxStack
 
 	for {
@@ -153,7 +448,7 @@ func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
 
 		txe, err := stack.Consume(ev)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %v", errHeader, err)
+			return nil, fmt.Errorf("%s %v", errHeader, err)
 		}
 		if txe != nil {
 			return txe, nil
@@ -8,6 +8,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
@@ -99,6 +100,10 @@ func TestReadState_TxByHash(t *testing.T) {
 		for txIndex := uint64(0); txIndex < numTxs; txIndex++ {
 			// Find this tx
 			tx := mkTxExecution(height, txIndex, events)
+			// Each address emits one log per transaction in the block
+			for _, ev := range tx.Events {
+				ev.Log.Sequence = txIndex + 1
+			}
 			txHash := tx.TxHash.String()
 			// Check we have no duplicates (indicates problem with how we are generating hashes for these tests
 			require.False(t, hashSet[txHash], "should be no duplicate tx hashes")
@@ -114,6 +119,200 @@ func TestReadState_TxByHash(t *testing.T) {
 	}
 }
 
+func TestReadState_TxReceiptByHash(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	numTxs := uint64(2)
+	events := uint64(2)
+	block := mkBlock(1, numTxs, events)
+	block.TxExecutions[0].Return(nil, 21000)
+	block.TxExecutions[1].Exception = errors.Errorf(errors.Codes.ExecutionReverted, "reverted")
+	_, _, err := s.Update(func(ws Updatable) error {
+		return ws.AddBlock(block)
+	})
+	require.NoError(t, err)
+
+	tx := mkTxExecution(1, 0, events)
+	receipt, err := s.TxReceiptByHash(tx.TxHash)
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.Equal(t, tx.TxHash, receipt.TxHash)
+	require.Equal(t, uint64(21000), receipt.GasUsed)
+	require.Nil(t, receipt.Exception)
+	require.Len(t, receipt.Logs, int(events))
+	for i, log := range receipt.Logs {
+		expected := mkEvent(1, 0, uint64(i)).Log
+		expected.Sequence = 1
+		require.Equal(t, source.JSONString(expected), source.JSONString(log))
+	}
+
+	// A failed transaction has no logs
+	receipt, err = s.TxReceiptByHash(mkTxExecution(1, 1, events).TxHash)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.Exception)
+	require.Equal(t, errors.Codes.ExecutionReverted, receipt.Exception.ErrorCode())
+	require.Empty(t, receipt.Logs)
+
+	receipt, err = s.TxReceiptByHash(mkTxExecution(2, 0, events).TxHash)
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+}
+
+func TestReadState_IterateLogs(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	maxHeight := uint64(3)
+	numTxs := uint64(4)
+	events := uint64(2)
+	for height := uint64(0); height < maxHeight; height++ {
+		block := mkBlock(height, numTxs, events)
+		// Logs from failed transactions should not be indexed
+		block.TxExecutions[0].Exception = errors.Errorf(errors.Codes.ExecutionReverted, "reverted")
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.AddBlock(block)
+		})
+		require.NoError(t, err)
+	}
+
+	logs := func(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64) []*exec.Event {
+		var evs []*exec.Event
+		err := s.IterateLogs(address, signature, startHeight, endHeight, func(ev *exec.Event) error {
+			evs = append(evs, ev)
+			return nil
+		})
+		require.NoError(t, err)
+		return evs
+	}
+
+	height := uint64(1)
+	evs := logs(crypto.Address{byte(height), 1}, binary.Word256{1, 2, 3}, nil, nil)
+	require.Len(t, evs, int(numTxs-1))
+	for i, ev := range evs {
+		expected := mkEvent(height, uint64(i+1), 1)
+		// The log of the failed transaction is not counted
+		expected.Log.Sequence = uint64(i + 1)
+		require.Equal(t, source.JSONString(expected), source.JSONString(ev))
+	}
+	require.Len(t, logs(crypto.Address{byte(height), 1}, binary.Word256{1, 2, 3}, &height, &height), int(numTxs-1))
+
+	// Outside of height range
+	start := height + 1
+	require.Len(t, logs(crypto.Address{byte(height), 1}, binary.Word256{1, 2, 3}, &start, nil), 0)
+	// Different signature
+	require.Len(t, logs(crypto.Address{byte(height), 1}, binary.Word256{3, 2, 1}, nil, nil), 0)
+}
+
+func TestReadState_IterateLogsByTopic(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	maxHeight := uint64(3)
+	numTxs := uint64(4)
+	for height := uint64(0); height < maxHeight; height++ {
+		block := mkBlock(height, numTxs, 2)
+		// Give the logs of each transaction its index as their second topic
+		for _, txe := range block.TxExecutions {
+			for _, ev := range txe.Events {
+				ev.Log.Topics = append(ev.Log.Topics, binary.Word256{byte(txe.Index)})
+			}
+		}
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.AddBlock(block)
+		})
+		require.NoError(t, err)
+	}
+
+	logs := func(topic binary.Word256, startHeight, endHeight *uint64) []*exec.Event {
+		var evs []*exec.Event
+		err := s.IterateLogsByTopic(crypto.Address{1, 1}, binary.Word256{1, 2, 3}, topic, startHeight, endHeight,
+			func(ev *exec.Event) error {
+				evs = append(evs, ev)
+				return nil
+			})
+		require.NoError(t, err)
+		return evs
+	}
+
+	evs := logs(binary.Word256{2}, nil, nil)
+	require.Len(t, evs, 1)
+	require.Equal(t, uint64(1), evs[0].Header.Height)
+	require.Equal(t, binary.Word256{2}, evs[0].Log.Topics[1])
+
+	height := uint64(1)
+	require.Len(t, logs(binary.Word256{2}, &height, &height), 1)
+	start := height + 1
+	require.Len(t, logs(binary.Word256{2}, &start, nil), 0)
+	// No such topic
+	require.Len(t, logs(binary.Word256{byte(numTxs)}, nil, nil), 0)
+}
+
+func TestReadState_IterateTxsByAddress(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	sender := crypto.Address{9, 9}
+	for height := uint64(0); height < 3; height++ {
+		block := mkBlock(height, 4, 2)
+		// The sender is an input to one transaction in each block
+		txe := block.TxExecutions[height]
+		txe.Events = append(txe.Events, &exec.Event{
+			Header: &exec.Header{Height: height, Index: 2, TxHash: txe.TxHash},
+			Input:  &exec.InputEvent{Address: sender},
+		})
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.AddBlock(block)
+		})
+		require.NoError(t, err)
+	}
+
+	byAddress := func(address crypto.Address, after []byte, descending bool) []binary.HexBytes {
+		var hashes []binary.HexBytes
+		err := s.IterateTxsByAddress(address, after, descending, func(txe *exec.TxExecution) error {
+			hashes = append(hashes, txe.TxHash)
+			return nil
+		})
+		require.NoError(t, err)
+		return hashes
+	}
+	hash := func(height, txIndex uint64) binary.HexBytes {
+		return mkTxExecution(height, txIndex, 2).TxHash
+	}
+
+	require.Equal(t, []binary.HexBytes{hash(0, 0), hash(1, 1), hash(2, 2)}, byAddress(sender, nil, false))
+	require.Equal(t, []binary.HexBytes{hash(1, 1), hash(2, 2)}, byAddress(sender, hash(0, 0), false))
+	require.Equal(t, []binary.HexBytes{hash(1, 1), hash(0, 0)}, byAddress(sender, hash(2, 2), true))
+	require.Len(t, byAddress(sender, hash(2, 2), false), 0)
+
+	// A log emitter is involved in every transaction in which it emits
+	emitter := crypto.Address{1, 1}
+	require.Equal(t, []binary.HexBytes{hash(1, 0), hash(1, 1), hash(1, 2), hash(1, 3)}, byAddress(emitter, nil, false))
+	require.Len(t, byAddress(crypto.Address{7}, nil, false), 0)
+
+	err := s.IterateTxsByAddress(sender, make([]byte, 32), false, func(txe *exec.TxExecution) error {
+		return nil
+	})
+	require.Error(t, err, "should not start after an unknown transaction")
+}
+
+func TestWriteState_AddBlock_LogSequence(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	address := crypto.Address{1, 1}
+	for height := uint64(1); height <= 2; height++ {
+		block := mkBlock(1, 3, 2)
+		block.Height = height
+		block.TxExecutions[1].Exception = errors.Errorf(errors.Codes.ExecutionReverted, "reverted")
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.AddBlock(block)
+		})
+		require.NoError(t, err)
+		// Sequences continue from the previous block and skip failed transactions
+		first := (height - 1) * 2
+		require.Equal(t, first+1, block.TxExecutions[0].Events[1].Log.Sequence)
+		require.Equal(t, uint64(0), block.TxExecutions[1].Events[1].Log.Sequence)
+		require.Equal(t, first+2, block.TxExecutions[2].Events[1].Log.Sequence)
+	}
+	sequence, err := s.LastLogSequence(address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), sequence)
+	sequence, err = s.LastLogSequence(crypto.Address{9})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sequence)
+}
+
 func TestLastBlockStored(t *testing.T) {
 	s := NewState(dbm.NewMemDB())
 	// Add first block
@@ -0,0 +1,57 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/heartbeat"
+)
+
+var _ heartbeat.IterableReader = &State{}
+
+func (s *ReadState) GetHeartbeat(address crypto.Address) (*heartbeat.Heartbeat, error) {
+	tree, err := s.Forest.Reader(keys.Heartbeat.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Heartbeat.KeyNoPrefix(address))
+	if err != nil {
+		return nil, err
+	} else if bs == nil {
+		return nil, nil
+	}
+	hb := new(heartbeat.Heartbeat)
+	return hb, encoding.Decode(bs, hb)
+}
+
+func (ws *writeState) UpdateHeartbeat(hb *heartbeat.Heartbeat) error {
+	if hb == nil {
+		return fmt.Errorf("UpdateHeartbeat passed nil Heartbeat in State")
+	}
+	bs, err := encoding.Encode(hb)
+	if err != nil {
+		return fmt.Errorf("UpdateHeartbeat could not encode Heartbeat: %v", err)
+	}
+	tree, err := ws.forest.Writer(keys.Heartbeat.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Heartbeat.KeyNoPrefix(hb.Address), bs)
+	return nil
+}
+
+func (s *ReadState) IterateHeartbeats(consumer func(hb *heartbeat.Heartbeat) error) error {
+	tree, err := s.Forest.Reader(keys.Heartbeat.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(_ []byte, value []byte) error {
+		hb := new(heartbeat.Heartbeat)
+		err := encoding.Decode(value, hb)
+		if err != nil {
+			return fmt.Errorf("State.IterateHeartbeats() could not iterate over heartbeats: %v", err)
+		}
+		return consumer(hb)
+	})
+}
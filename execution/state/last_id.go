@@ -0,0 +1,39 @@
+package state
+
+import (
+	bin "encoding/binary"
+)
+
+// IDs are assigned from a counter rather than from the records that exist so that an ID is not reassigned once the
+// record holding it is removed
+
+func (s *ReadState) lastID(name string) (uint64, error) {
+	tree, err := s.Forest.Reader(keys.LastID.Prefix())
+	if err != nil {
+		return 0, err
+	}
+	bs, err := tree.Get(keys.LastID.KeyNoPrefix(name))
+	if err != nil || len(bs) == 0 {
+		return 0, err
+	}
+	return bin.BigEndian.Uint64(bs), nil
+}
+
+// Records id as the highest ID assigned for name if it is higher than any before it
+func (ws *writeState) assignedID(name string, id uint64) error {
+	tree, err := ws.forest.Writer(keys.LastID.Prefix())
+	if err != nil {
+		return err
+	}
+	bs, err := tree.Get(keys.LastID.KeyNoPrefix(name))
+	if err != nil {
+		return err
+	}
+	if len(bs) > 0 && bin.BigEndian.Uint64(bs) >= id {
+		return nil
+	}
+	bs = make([]byte, uint64Length)
+	bin.BigEndian.PutUint64(bs, id)
+	tree.Set(keys.LastID.KeyNoPrefix(name), bs)
+	return nil
+}
@@ -48,11 +48,21 @@ func (ws *writeState) RemoveName(name string) error {
 }
 
 func (s *ReadState) IterateNames(consumer func(*names.Entry) error) error {
+	return s.IterateNamesAfter("", consumer)
+}
+
+// IterateNamesAfter iterates over names in lexicographic order starting with the first that follows after (or the first
+// of all if after is empty)
+func (s *ReadState) IterateNamesAfter(after string, consumer func(*names.Entry) error) error {
 	tree, err := s.Forest.Reader(keys.Name.Prefix())
 	if err != nil {
 		return err
 	}
-	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+	var start []byte
+	if after != "" {
+		start = successor(keys.Name.KeyNoPrefix(after))
+	}
+	return tree.Iterate(start, nil, true, func(key []byte, value []byte) error {
 		entry := new(names.Entry)
 		err := encoding.Decode(value, entry)
 		if err != nil {
@@ -0,0 +1,90 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/schedule"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+var _ schedule.IterableReader = &State{}
+
+func (s *ReadState) GetScheduledGovTxs(height uint64) ([]*payload.ScheduledGovTx, error) {
+	var scheduled []*payload.ScheduledGovTx
+	err := s.iterateScheduledGovTxs(keys.Schedule.KeyNoPrefix(height), keys.Schedule.KeyNoPrefix(height+1),
+		func(sgt *payload.ScheduledGovTx) error {
+			scheduled = append(scheduled, sgt)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+func (ws *writeState) ScheduleGovTx(scheduled *payload.ScheduledGovTx) error {
+	if scheduled == nil {
+		return fmt.Errorf("ScheduleGovTx passed nil ScheduledGovTx in State")
+	}
+	bs, err := encoding.Encode(scheduled)
+	if err != nil {
+		return fmt.Errorf("ScheduleGovTx could not encode ScheduledGovTx: %v", err)
+	}
+	tree, err := ws.forest.Writer(keys.Schedule.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Schedule.KeyNoPrefix(scheduled.Height, scheduled.TxHash.Bytes()), bs)
+	return nil
+}
+
+func (ws *writeState) RemoveScheduledGovTxs(height uint64) error {
+	tree, err := ws.forest.Writer(keys.Schedule.Prefix())
+	if err != nil {
+		return err
+	}
+	var toDelete [][]byte
+	err = tree.Iterate(keys.Schedule.KeyNoPrefix(height), keys.Schedule.KeyNoPrefix(height+1), true,
+		func(key []byte, _ []byte) error {
+			toDelete = append(toDelete, key)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, key := range toDelete {
+		tree.Delete(key)
+	}
+	return nil
+}
+
+func (ws *writeState) RemoveScheduledGovTx(height uint64, txHash binary.HexBytes) error {
+	tree, err := ws.forest.Writer(keys.Schedule.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Delete(keys.Schedule.KeyNoPrefix(height, txHash.Bytes()))
+	return nil
+}
+
+func (s *ReadState) IterateScheduledGovTxs(consumer func(scheduled *payload.ScheduledGovTx) error) error {
+	return s.iterateScheduledGovTxs(nil, nil, consumer)
+}
+
+func (s *ReadState) iterateScheduledGovTxs(start, end []byte,
+	consumer func(scheduled *payload.ScheduledGovTx) error) error {
+	tree, err := s.Forest.Reader(keys.Schedule.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(start, end, true, func(_ []byte, value []byte) error {
+		scheduled := new(payload.ScheduledGovTx)
+		err := encoding.Decode(value, scheduled)
+		if err != nil {
+			return fmt.Errorf("State.IterateScheduledGovTxs() could not iterate over scheduled GovTxs: %v", err)
+		}
+		return consumer(scheduled)
+	})
+}
@@ -15,13 +15,19 @@ import (
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/chainparams"
+	"github.com/hyperledger/burrow/execution/cron"
+	"github.com/hyperledger/burrow/execution/escrow"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/heartbeat"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/execution/schedule"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -34,6 +40,11 @@ const (
 	// Prefix for storage outside for the merkel tree - does not contribute to AppHash as a result
 	// Leaving the forest for the plains like early members of the homo genus
 	plainPrefix = "h"
+	// Key under which the chain parameters are stored
+	chainParamsKey = "ChainParams"
+	// Keys under which the highest escrow and cron job IDs are stored
+	escrowIDKey  = "Escrow"
+	cronJobIDKey = "CronJob"
 )
 
 // Implements account and blockchain state
@@ -42,15 +53,30 @@ var _ names.IterableReader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	Registry  *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
-	Abi       *storage.MustKeyFormat
+	Account       *storage.MustKeyFormat
+	Storage       *storage.MustKeyFormat
+	Name          *storage.MustKeyFormat
+	Proposal      *storage.MustKeyFormat
+	Validator     *storage.MustKeyFormat
+	Event         *storage.MustKeyFormat
+	Registry      *storage.MustKeyFormat
+	Schedule      *storage.MustKeyFormat
+	CronJob       *storage.MustKeyFormat
+	CronDue       *storage.MustKeyFormat
+	Escrow        *storage.MustKeyFormat
+	LastID        *storage.MustKeyFormat
+	Params        *storage.MustKeyFormat
+	LogSequence   *storage.MustKeyFormat
+	Heartbeat     *storage.MustKeyFormat
+	TxHash        *storage.MustKeyFormat
+	Abi           *storage.MustKeyFormat
+	CodeMetadata  *storage.MustKeyFormat
+	Deployment    *storage.MustKeyFormat
+	HotSet        *storage.MustKeyFormat
+	LogIndex      *storage.MustKeyFormat
+	LogTopicIndex *storage.MustKeyFormat
+	AddressTx     *storage.MustKeyFormat
+	TxReceipt     *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -69,12 +95,43 @@ var keys = KeyFormatStore{
 	Event: storage.NewMustKeyFormat("e", uint64Length),
 	// Validator -> NodeIdentity
 	Registry: storage.NewMustKeyFormat("r", crypto.AddressLength),
+	// ActivationHeight, TxHash -> ScheduledGovTx
+	Schedule: storage.NewMustKeyFormat("g", uint64Length, txs.HashLength),
+	// ID -> CronJob
+	CronJob: storage.NewMustKeyFormat("j", uint64Length),
+	// NextHeight, ID -> (nothing)
+	CronDue: storage.NewMustKeyFormat("d", uint64Length, uint64Length),
+	// ID -> Escrow
+	Escrow: storage.NewMustKeyFormat("w", uint64Length),
+	// Name -> The highest ID assigned to the named kind of record, which is never reused
+	LastID: storage.NewMustKeyFormat("x", storage.VariadicSegmentLength),
+	// Name -> ChainParams
+	Params: storage.NewMustKeyFormat("m", storage.VariadicSegmentLength),
+	// Address -> Sequence of the last LogEvent emitted by address
+	LogSequence: storage.NewMustKeyFormat("q", crypto.AddressLength),
+	// ValidatorAddress -> Heartbeat
+	Heartbeat: storage.NewMustKeyFormat("b", crypto.AddressLength),
 
 	// Stored on the plain
 	// TxHash -> TxHeight, TxIndex
 	TxHash: storage.NewMustKeyFormat("th", txs.HashLength),
-	// CodeHash -> Abi
+	// MetadataHash -> Metadata
 	Abi: storage.NewMustKeyFormat("abi", sha256.Size),
+	// CodeHash -> CodeMetadata
+	CodeMetadata: storage.NewMustKeyFormat("cm", sha256.Size),
+	// ContractAddress -> Deployment
+	Deployment: storage.NewMustKeyFormat("dp", crypto.AddressLength),
+	// -> Addresses of recently active accounts
+	HotSet: storage.NewMustKeyFormat("hot"),
+	// Address, EventSignature, Height, Offset -> TxHash
+	LogIndex: storage.NewMustKeyFormat("lg", crypto.AddressLength, binary.Word256Bytes, uint64Length, uint64Length),
+	// Address, EventSignature, Topic, Height, Offset -> TxHash
+	LogTopicIndex: storage.NewMustKeyFormat("lt", crypto.AddressLength, binary.Word256Bytes, binary.Word256Bytes,
+		uint64Length, uint64Length),
+	// Address, Height, Offset -> TxHash
+	AddressTx: storage.NewMustKeyFormat("at", crypto.AddressLength, uint64Length, uint64Length),
+	// TxHash -> TxReceipt
+	TxReceipt: storage.NewMustKeyFormat("tr", txs.HashLength),
 }
 
 var Prefixes [][]byte
@@ -92,6 +149,11 @@ type Updatable interface {
 	names.Writer
 	proposal.Writer
 	registry.Writer
+	schedule.Writer
+	cron.Writer
+	escrow.Writer
+	heartbeat.Writer
+	chainparams.Writer
 	validator.Writer
 	acmstate.MetadataWriter
 	AddBlock(blockExecution *exec.BlockExecution) error
@@ -158,6 +220,7 @@ func MakeGenesisState(db dbm.DB, genesisDoc *genesis.GenesisDoc) (*State, error)
 		acc := &acm.Account{
 			Address:     genAcc.Address,
 			Balance:     genAcc.Amount,
+			GasBalance:  genAcc.GasAmount,
 			Permissions: perm,
 		}
 		err := s.writeState.UpdateAccount(acc)
@@ -170,6 +233,37 @@ func MakeGenesisState(db dbm.DB, genesisDoc *genesis.GenesisDoc) (*State, error)
 	if err != nil {
 		return nil, fmt.Errorf("%s %v", errHeader, err)
 	}
+	// Set any initial chain parameters
+	feeExemptCalls := make([]*payload.FeeExemptCall, len(genesisDoc.Params.FeeExemptCalls))
+	for i, call := range genesisDoc.Params.FeeExemptCalls {
+		feeExemptCalls[i] = &payload.FeeExemptCall{
+			Caller:   call.Caller,
+			Callee:   call.Callee,
+			Selector: call.Selector,
+		}
+	}
+	chainParams := &payload.ChainParams{
+		BlockGasLimit:             genesisDoc.Params.BlockGasLimit,
+		MaxTxInstructions:         genesisDoc.Params.MaxTxInstructions,
+		MaxLogDataSize:            genesisDoc.Params.MaxLogDataSize,
+		MaxTxLogs:                 genesisDoc.Params.MaxTxLogs,
+		MaxValidatorPowerChange:   genesisDoc.Params.MaxValidatorPowerChange,
+		ValidatorPowerChangeDelay: genesisDoc.Params.ValidatorPowerChangeDelay,
+		MinFee:                    genesisDoc.Params.MinFee,
+		FeeExemptCalls:            feeExemptCalls,
+		SeparateGasToken:          genesisDoc.Params.SeparateGasToken,
+		CapCallGas:                genesisDoc.Params.CapCallGas,
+		NewAccountPermissions:     genesisDoc.Params.NewAccountPermissions,
+		MaxNewAccountPermissions:  genesisDoc.Params.MaxNewAccountPermissions,
+		GasSchedule:               genesisDoc.Params.GasSchedule,
+	}
+	// Leave chains without parameters with the genesis state they have always had
+	if !chainParams.IsZero() {
+		err = s.writeState.UpdateChainParams(chainParams)
+		if err != nil {
+			return nil, fmt.Errorf("%s %v", errHeader, err)
+		}
+	}
 	// Set up fallback global permissions
 	err = s.writeState.UpdateAccount(genesisDoc.GlobalPermissionsAccount())
 	if err != nil {
@@ -263,6 +357,20 @@ func (s *State) LoadHeight(height uint64) (*ReadState, error) {
 	}, nil
 }
 
+// ExportProofs passes a StateProof of every key in the state at height to fn in the standard (ICS-23) Merkle format
+// and returns the state hash at that height, against which each proof verifies
+func (s *State) ExportProofs(height uint64, fn func(proof *storage.StateProof) error) ([]byte, error) {
+	forest, err := s.writeState.forest.GetImmutable(VersionAtHeight(height))
+	if err != nil {
+		return nil, err
+	}
+	err = forest.Export(fn)
+	if err != nil {
+		return nil, fmt.Errorf("could not export proofs of state at height %d: %v", height, err)
+	}
+	return forest.Hash(), nil
+}
+
 // Perform updates to state whilst holding the write lock, allows a commit to hold the write lock across multiple
 // operations while preventing interlaced reads and writes
 func (s *State) Update(updater func(up Updatable) error) ([]byte, int64, error) {
@@ -323,3 +431,8 @@ func (s *State) SetLogger(logger *logging.Logger) {
 func (s *State) Dump() string {
 	return s.writeState.forest.Dump()
 }
+
+// The key immediately following key in iteration order, so that iteration can start after a key
+func successor(key []byte) []byte {
+	return append(key[:len(key):len(key)], 0)
+}
@@ -15,11 +15,21 @@
 package state
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/cron"
+	"github.com/hyperledger/burrow/execution/escrow"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -40,3 +50,246 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, source.JSONString(account), source.JSONString(accountOut))
 }
+
+func TestState_LastEscrowID(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	_, _, err := s.Update(func(ws Updatable) error {
+		for id := uint64(1); id <= 2; id++ {
+			err := ws.UpdateEscrow(&escrow.Escrow{ID: id, Amount: 1})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	_, _, err = s.Update(func(ws Updatable) error {
+		return ws.RemoveEscrow(2)
+	})
+	require.NoError(t, err)
+
+	// The ID of a removed escrow is not reassigned
+	id, err := s.LastEscrowID()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+}
+
+func TestState_LastCronJobID(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	_, _, err := s.Update(func(ws Updatable) error {
+		return ws.UpdateCronJob(&cron.Job{ID: 1, Interval: 1, GasLimit: 1, Budget: 1, NextHeight: 2})
+	})
+	require.NoError(t, err)
+	_, _, err = s.Update(func(ws Updatable) error {
+		return ws.RemoveCronJob(1)
+	})
+	require.NoError(t, err)
+
+	// The ID of a removed job is not reassigned
+	id, err := s.LastCronJobID()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+}
+
+func TestState_Deployment(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	address := acm.NewAccountFromSecret("Foo").Address
+	creator := acm.NewAccountFromSecret("Bar").Address
+	var codehash acmstate.CodeHash
+	copy(codehash[:], []byte("codehash"))
+	deployment := &acm.Deployment{TxHash: []byte{1, 2, 3}, Creator: creator, Height: 42}
+	codeMeta := &acm.CodeMetadata{MetadataHash: []byte{4, 5, 6}, Address: address}
+	_, _, err := s.Update(func(ws Updatable) error {
+		err := ws.SetDeployment(address, deployment)
+		if err != nil {
+			return err
+		}
+		return ws.SetCodeMetadata(codehash, codeMeta)
+	})
+	require.NoError(t, err)
+
+	deploymentOut, err := s.GetDeployment(address)
+	require.NoError(t, err)
+	assert.Equal(t, deployment, deploymentOut)
+	codeMetaOut, err := s.GetCodeMetadata(codehash)
+	require.NoError(t, err)
+	assert.Equal(t, codeMeta, codeMetaOut)
+
+	deploymentOut, err = s.GetDeployment(creator)
+	require.NoError(t, err)
+	assert.Nil(t, deploymentOut)
+
+	var addresses []crypto.Address
+	err = s.IterateDeployments(func(addr crypto.Address, dep *acm.Deployment) error {
+		addresses = append(addresses, addr)
+		assert.Equal(t, deployment, dep)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.Address{address}, addresses)
+}
+
+func TestMakeGenesisState_ChainParams(t *testing.T) {
+	genesisDoc, _, _ := genesis.NewDeterministicGenesis(1).GenesisDoc(1, 1)
+	genesisParams := func() *payload.ChainParams {
+		s, err := MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+		require.NoError(t, err)
+		require.NoError(t, s.InitialCommit())
+		params, err := s.GetChainParams()
+		require.NoError(t, err)
+		return params
+	}
+	assert.Nil(t, genesisParams())
+
+	// Every parameter is written, even when it is the only one set
+	caller := crypto.Address{1}
+	callee := crypto.Address{2}
+	genesisDoc.Params.FeeExemptCalls = []genesis.FeeExemptCall{{Caller: caller, Callee: callee}}
+	params := genesisParams()
+	require.Len(t, params.FeeExemptCalls, 1)
+	assert.Equal(t, caller, params.FeeExemptCalls[0].Caller)
+	assert.Equal(t, callee, params.FeeExemptCalls[0].Callee)
+
+	genesisDoc.Params.FeeExemptCalls = nil
+	genesisDoc.Params.ValidatorPowerChangeDelay = 10
+	assert.Equal(t, uint64(10), genesisParams().ValidatorPowerChangeDelay)
+}
+
+func TestState_ExportProofs(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	require.NoError(t, s.InitialCommit())
+	account := acm.NewAccountFromSecret("Foo")
+	hash, version, err := s.Update(func(ws Updatable) error {
+		return ws.UpdateAccount(account)
+	})
+	require.NoError(t, err)
+
+	var proofs []*storage.StateProof
+	exported, err := s.ExportProofs(HeightAtVersion(version), func(proof *storage.StateProof) error {
+		proofs = append(proofs, proof)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hash, exported)
+	require.NotEmpty(t, proofs)
+	for _, proof := range proofs {
+		require.NoError(t, proof.Verify(hash))
+	}
+}
+
+func TestState_IterateAfter(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	_, _, err := s.Update(func(ws Updatable) error {
+		for _, secret := range []string{"Foo", "Bar", "Baz"} {
+			err := ws.UpdateAccount(acm.NewAccountFromSecret(secret))
+			if err != nil {
+				return err
+			}
+		}
+		for _, name := range []string{"a", "ab", "b"} {
+			err := ws.UpdateName(&names.Entry{Name: name, Owner: acm.NewAccountFromSecret("Foo").Address})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	var addresses []crypto.Address
+	require.NoError(t, s.IterateAccounts(func(acc *acm.Account) error {
+		addresses = append(addresses, acc.Address)
+		return nil
+	}))
+	require.Len(t, addresses, 3)
+	var after []crypto.Address
+	require.NoError(t, s.IterateAccountsAfter(&addresses[0], func(acc *acm.Account) error {
+		after = append(after, acc.Address)
+		return nil
+	}))
+	assert.Equal(t, addresses[1:], after)
+
+	var entries []string
+	require.NoError(t, s.IterateNamesAfter("a", func(entry *names.Entry) error {
+		entries = append(entries, entry.Name)
+		return nil
+	}))
+	assert.Equal(t, []string{"ab", "b"}, entries)
+}
+
+func TestState_StorageReader(t *testing.T) {
+	db := &countingDB{DB: dbm.NewMemDB()}
+	s := NewState(db)
+	// More accounts with storage than the forest keeps trees cached for
+	addresses := make([]crypto.Address, defaultCacheCapacity+1)
+	for i := range addresses {
+		addresses[i] = crypto.Address{byte(i >> 8), byte(i), 1}
+	}
+	one, two := binary.Int64ToWord256(1), binary.Int64ToWord256(2)
+	_, version, err := s.Update(func(ws Updatable) error {
+		for _, address := range addresses {
+			for _, key := range []binary.Word256{one, two} {
+				err := ws.SetStorage(address, key, key.Bytes())
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	s, err = LoadState(db, version)
+	require.NoError(t, err)
+
+	target := addresses[0]
+	evict := func() {
+		for _, address := range addresses[1:] {
+			_, err := s.GetStorage(address, one)
+			require.NoError(t, err)
+		}
+	}
+	reader, err := s.StorageReader(target)
+	require.NoError(t, err)
+	value, err := reader(one)
+	require.NoError(t, err)
+	assert.Equal(t, one.Bytes(), value)
+
+	// Once the target's storage tree has been evicted a lookup by key must load it again from the database whereas
+	// the reader keeps the tree it resolved
+	evict()
+	db.reset()
+	value, err = reader(two)
+	require.NoError(t, err)
+	assert.Equal(t, two.Bytes(), value)
+	readerGets := db.reset()
+
+	evict()
+	db.reset()
+	value, err = s.GetStorage(target, two)
+	require.NoError(t, err)
+	assert.Equal(t, two.Bytes(), value)
+	lookupGets := db.reset()
+
+	assert.Less(t, readerGets, lookupGets)
+}
+
+type countingDB struct {
+	dbm.DB
+	sync.Mutex
+	gets int
+}
+
+func (cdb *countingDB) Get(key []byte) ([]byte, error) {
+	cdb.Lock()
+	cdb.gets++
+	cdb.Unlock()
+	return cdb.DB.Get(key)
+}
+
+func (cdb *countingDB) reset() int {
+	cdb.Lock()
+	defer cdb.Unlock()
+	gets := cdb.gets
+	cdb.gets = 0
+	return gets
+}
@@ -0,0 +1,120 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// The number of most recently active accounts tracked in the hot set
+const HotSetCapacity = 1024
+
+// Returns the addresses of the accounts most recently active in executed blocks, most recent first. The hot set is kept
+// on the plain so does not contribute to the AppHash.
+func (s *State) HotSet() ([]crypto.Address, error) {
+	bs, err := s.Plain.Get(keys.HotSet.Key())
+	if err != nil {
+		return nil, err
+	}
+	if len(bs)%crypto.AddressLength != 0 {
+		return nil, fmt.Errorf("stored hot set has length %d, which is not a multiple of the address length %d",
+			len(bs), crypto.AddressLength)
+	}
+	addresses := make([]crypto.Address, len(bs)/crypto.AddressLength)
+	for i := range addresses {
+		copy(addresses[i][:], bs[i*crypto.AddressLength:])
+	}
+	return addresses, nil
+}
+
+// Preloads up to maxAccounts of the most recently active accounts, and up to maxStorageKeys of each of their storage
+// entries, into the state caches so that the first blocks executed after a restart do not pay for cold reads.
+// Returns the number of accounts and storage entries loaded.
+func (s *State) Warmup(maxAccounts, maxStorageKeys int) (accounts, storageKeys int, err error) {
+	addresses, err := s.HotSet()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(addresses) > maxAccounts {
+		addresses = addresses[:maxAccounts]
+	}
+	for _, address := range addresses {
+		// Accounts carry their code
+		acc, err := s.GetAccount(address)
+		if err != nil {
+			return accounts, storageKeys, err
+		}
+		if acc == nil {
+			continue
+		}
+		accounts++
+		if maxStorageKeys <= 0 {
+			continue
+		}
+		loaded := 0
+		err = s.IterateStorage(address, func(key binary.Word256, value []byte) error {
+			loaded++
+			if loaded >= maxStorageKeys {
+				return errWarmupStorageLoaded
+			}
+			return nil
+		})
+		storageKeys += loaded
+		if err != nil && err != errWarmupStorageLoaded {
+			return accounts, storageKeys, err
+		}
+	}
+	return accounts, storageKeys, nil
+}
+
+var errWarmupStorageLoaded = fmt.Errorf("loaded maximum storage entries for warmup")
+
+// Moves the accounts active in the block to the front of the hot set, dropping the least recently active beyond
+// HotSetCapacity
+func (ws *writeState) updateHotSet(be *exec.BlockExecution) error {
+	var active []crypto.Address
+	seen := make(map[crypto.Address]struct{})
+	add := func(address crypto.Address) {
+		if _, ok := seen[address]; !ok && address != crypto.ZeroAddress {
+			seen[address] = struct{}{}
+			active = append(active, address)
+		}
+	}
+	for _, txe := range be.TxExecutions {
+		for _, ev := range txe.Events {
+			switch {
+			case ev.Input != nil:
+				add(ev.Input.Address)
+			case ev.Output != nil:
+				add(ev.Output.Address)
+			case ev.Call != nil && ev.Call.CallData != nil:
+				add(ev.Call.CallData.Callee)
+			case ev.Log != nil:
+				add(ev.Log.Address)
+			}
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+	previous, err := ws.plain.Get(keys.HotSet.Key())
+	if err != nil {
+		return err
+	}
+	bs := make([]byte, 0, HotSetCapacity*crypto.AddressLength)
+	for _, address := range active {
+		if len(bs) == cap(bs) {
+			break
+		}
+		bs = append(bs, address.Bytes()...)
+	}
+	for i := 0; i+crypto.AddressLength <= len(previous) && len(bs) < cap(bs); i += crypto.AddressLength {
+		address := crypto.MustAddressFromBytes(previous[i : i+crypto.AddressLength])
+		if _, ok := seen[address]; !ok {
+			bs = append(bs, address.Bytes()...)
+		}
+	}
+	return ws.plain.Set(keys.HotSet.Key(), bs)
+}
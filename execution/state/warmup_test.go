@@ -0,0 +1,71 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestWarmup(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	alice := crypto.Address{1}
+	bob := crypto.Address{2}
+	contract := crypto.Address{3}
+	_, _, err := s.Update(func(ws Updatable) error {
+		for _, address := range []crypto.Address{alice, bob, contract} {
+			err := ws.UpdateAccount(&acm.Account{Address: address})
+			if err != nil {
+				return err
+			}
+		}
+		for i := int64(0); i < 10; i++ {
+			err := ws.SetStorage(contract, binary.Int64ToWord256(i), binary.Int64ToWord256(i).Bytes())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	hotSet, err := s.HotSet()
+	require.NoError(t, err)
+	assert.Len(t, hotSet, 0)
+
+	addActivity(t, s, 1, &exec.Event{Input: &exec.InputEvent{Address: alice}},
+		&exec.Event{Call: &exec.CallEvent{CallData: &exec.CallData{Caller: alice, Callee: contract}}})
+	addActivity(t, s, 2, &exec.Event{Input: &exec.InputEvent{Address: bob}},
+		&exec.Event{Output: &exec.OutputEvent{Address: alice}})
+
+	hotSet, err = s.HotSet()
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.Address{bob, alice, contract}, hotSet)
+
+	accounts, storageKeys, err := s.Warmup(10, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 3, accounts)
+	assert.Equal(t, 4, storageKeys)
+
+	accounts, storageKeys, err = s.Warmup(2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 2, accounts)
+	assert.Equal(t, 0, storageKeys)
+}
+
+func addActivity(t *testing.T, s *State, height uint64, events ...*exec.Event) {
+	txe := mkTxExecution(height, 0, 0)
+	for i, ev := range events {
+		ev.Header = mkEvent(height, 0, uint64(i)).Header
+		txe.Events = append(txe.Events, ev)
+	}
+	_, _, err := s.Update(func(ws Updatable) error {
+		return ws.AddBlock(&exec.BlockExecution{Height: height, TxExecutions: []*exec.TxExecution{txe}})
+	})
+	require.NoError(t, err)
+}
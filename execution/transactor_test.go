@@ -65,3 +65,55 @@ func TestTransactor_BroadcastTxSync(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, height, txe.Height)
 }
+
+func TestTransactor_BroadcastTxSyncAuthorizer(t *testing.T) {
+	chainID := "TestChain"
+	bc := &bcm.Blockchain{}
+	evc := event.NewEmitter()
+	evc.SetLogger(logging.NewNoopLogger())
+	txCodec := txs.NewProtobufCodec()
+	address := crypto.Address{4, 5, 6}
+	tx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: address,
+		},
+		Address: &crypto.Address{1, 2, 3},
+	}
+	txEnv := txs.Enclose(chainID, tx)
+	// Verified by the authorizer contract of the account so there is no public key
+	txEnv.Signatories = []txs.Signatory{{
+		Address:   &address,
+		Signature: &crypto.Signature{CurveType: crypto.CurveTypeSecp256k1, Signature: []byte("for the authorizer")},
+	}}
+	trans := NewTransactor(bc, evc, NewAccounts(acmstate.NewMemoryState(),
+		keys.NewLocalKeyClient(keys.NewMemoryKeyStore(), logger), 100),
+		func(tx tmTypes.Tx, cb func(*abciTypes.Response), txInfo mempool.TxInfo) error {
+			// The envelope reaches the mempool intact
+			received, err := txCodec.DecodeTx(tx)
+			if err != nil {
+				return err
+			}
+			assert.Equal(t, txEnv.Signatories, received.Signatories)
+			txe := exec.NewTxExecution(received)
+			err = evc.Publish(context.Background(), txe, txe)
+			if err != nil {
+				return err
+			}
+			bs, err := txe.Receipt.Encode()
+			if err != nil {
+				return err
+			}
+			cb(abciTypes.ToResponseCheckTx(abciTypes.ResponseCheckTx{
+				Code: codes.TxExecutionSuccessCode,
+				Data: bs,
+			}))
+			return nil
+		}, "", txCodec, logger)
+	_, err := trans.BroadcastTxSync(context.Background(), txEnv)
+	require.NoError(t, err)
+
+	// But a signatory must still provide a signature
+	txEnv.Signatories[0].Signature = nil
+	_, err = trans.BroadcastTxSync(context.Background(), txEnv)
+	require.Error(t, err)
+}
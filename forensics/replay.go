@@ -200,6 +200,10 @@ func (re *Replay) Commit(height uint64) (*ReplayCapture, error) {
 	}
 
 	recap.AppHashBefore = binary.HexBytes(block.AppHash)
+	err = re.Dst.committer.BeginBlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "committer.BeginBlock()")
+	}
 	err = block.Transactions(func(txEnv *txs.Envelope) error {
 		txe, err := re.Dst.committer.Execute(txEnv)
 		if err != nil {
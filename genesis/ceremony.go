@@ -0,0 +1,111 @@
+package genesis
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// CeremonyConfig requires a new chain to have been agreed in a genesis ceremony before it is started
+type CeremonyConfig struct {
+	// The manifest holding the validators' signatures of the GenesisDoc
+	ManifestFile string
+	// The number of distinct genesis validators that must have signed, all of them if zero
+	Threshold int `json:",omitempty" toml:",omitempty"`
+}
+
+// CeremonySignature is a validator's signature of the hash of a proposed GenesisDoc
+type CeremonySignature struct {
+	PublicKey crypto.PublicKey
+	Signature *crypto.Signature
+}
+
+// CeremonyManifest collects the signatures of a genesis ceremony in which each validator signs the hash of the
+// proposed GenesisDoc to show they have reviewed and agree to it
+type CeremonyManifest struct {
+	GenesisHash binary.HexBytes
+	Signatures  []CeremonySignature
+}
+
+// SignGenesis signs the hash of genesisDoc as signer
+func SignGenesis(genesisDoc *GenesisDoc, signer acm.AddressableSigner) (*CeremonySignature, error) {
+	signature, err := signer.Sign(genesisDoc.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not sign GenesisDoc: %v", err)
+	}
+	return &CeremonySignature{
+		PublicKey: signer.GetPublicKey(),
+		Signature: signature,
+	}, nil
+}
+
+// NewCeremonyManifest returns an empty manifest for signatures of genesisDoc
+func NewCeremonyManifest(genesisDoc *GenesisDoc) *CeremonyManifest {
+	return &CeremonyManifest{
+		GenesisHash: genesisDoc.Hash(),
+	}
+}
+
+// Add verifies signatures against the GenesisHash and adds them to the manifest, replacing any earlier signature by
+// the same key
+func (cm *CeremonyManifest) Add(signatures ...CeremonySignature) error {
+	for _, sig := range signatures {
+		if sig.Signature == nil {
+			return fmt.Errorf("ceremony signature by %v has no signature", sig.PublicKey)
+		}
+		err := sig.PublicKey.Verify(cm.GenesisHash, sig.Signature)
+		if err != nil {
+			return fmt.Errorf("ceremony signature by %v does not sign GenesisDoc with hash %v: %v",
+				sig.PublicKey, cm.GenesisHash, err)
+		}
+		replaced := false
+		for i, existing := range cm.Signatures {
+			if existing.PublicKey.GetAddress() == sig.PublicKey.GetAddress() {
+				cm.Signatures[i] = sig
+				replaced = true
+			}
+		}
+		if !replaced {
+			cm.Signatures = append(cm.Signatures, sig)
+		}
+	}
+	return nil
+}
+
+// Verify checks that the manifest is for genesisDoc and holds valid signatures by at least threshold of its
+// validators (all of them if threshold is zero). Signatures by keys other than the genesis validators' are ignored.
+func (cm *CeremonyManifest) Verify(genesisDoc *GenesisDoc, threshold int) error {
+	if !bytes.Equal(cm.GenesisHash, genesisDoc.Hash()) {
+		return fmt.Errorf("ceremony manifest is for GenesisDoc with hash %v but GenesisDoc has hash %X",
+			cm.GenesisHash, genesisDoc.Hash())
+	}
+	if threshold <= 0 {
+		threshold = len(genesisDoc.Validators)
+	}
+	if threshold > len(genesisDoc.Validators) {
+		return fmt.Errorf("ceremony threshold of %d exceeds the %d genesis validators", threshold,
+			len(genesisDoc.Validators))
+	}
+	validators := make(map[crypto.Address]bool, len(genesisDoc.Validators))
+	for _, val := range genesisDoc.Validators {
+		validators[val.PublicKey.GetAddress()] = true
+	}
+	signed := make(map[crypto.Address]bool)
+	for _, sig := range cm.Signatures {
+		address := sig.PublicKey.GetAddress()
+		if !validators[address] || sig.Signature == nil {
+			continue
+		}
+		if sig.PublicKey.Verify(cm.GenesisHash, sig.Signature) == nil {
+			signed[address] = true
+		}
+	}
+	if len(signed) < threshold {
+		return fmt.Errorf("ceremony manifest holds signatures by %d of the %d genesis validators but %d are required",
+			len(signed), len(genesisDoc.Validators), threshold)
+	}
+	return nil
+}
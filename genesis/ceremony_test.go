@@ -0,0 +1,46 @@
+package genesis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCeremonyManifest(t *testing.T) {
+	genesisDoc, accounts, validators := NewDeterministicGenesis(1).GenesisDoc(1, 3)
+	manifest := NewCeremonyManifest(genesisDoc)
+	sign := func(signer *acm.PrivateAccount) CeremonySignature {
+		sig, err := SignGenesis(genesisDoc, signer)
+		require.NoError(t, err)
+		return *sig
+	}
+
+	// Signatures by accounts that are not validators do not count
+	require.NoError(t, manifest.Add(sign(validators[0]), sign(accounts[0])))
+	assert.Error(t, manifest.Verify(genesisDoc, 2))
+
+	// Signing twice replaces rather than duplicates a signature
+	require.NoError(t, manifest.Add(sign(validators[1]), sign(validators[1])))
+	assert.Len(t, manifest.Signatures, 3)
+	assert.NoError(t, manifest.Verify(genesisDoc, 2))
+	assert.Error(t, manifest.Verify(genesisDoc, 0))
+	assert.Error(t, manifest.Verify(genesisDoc, 4))
+
+	// Survives a round trip through the manifest file
+	bs, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	manifestOut := new(CeremonyManifest)
+	require.NoError(t, json.Unmarshal(bs, manifestOut))
+	require.NoError(t, manifestOut.Add(sign(validators[2])))
+	assert.NoError(t, manifestOut.Verify(genesisDoc, 0))
+
+	// Signatures of another GenesisDoc are rejected
+	otherDoc, _, otherValidators := NewDeterministicGenesis(2).GenesisDoc(1, 1)
+	otherSig, err := SignGenesis(otherDoc, otherValidators[0])
+	require.NoError(t, err)
+	assert.Error(t, manifest.Add(*otherSig))
+	assert.Error(t, NewCeremonyManifest(otherDoc).Verify(genesisDoc, 0))
+}
@@ -37,6 +37,8 @@ type Account struct {
 	BasicAccount
 	Name        string
 	Permissions permission.AccountPermissions
+	// The account's initial balance of the gas token, which only has a use when Params.SeparateGasToken is set
+	GasAmount uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 type Validator struct {
@@ -51,6 +53,49 @@ var DefaultPermissionsAccount = PermissionsAccount(permission.DefaultAccountPerm
 
 type params struct {
 	ProposalThreshold uint64
+	// The maximum total gas that may be used by the transactions of a block (zero means unlimited), this may be
+	// subsequently adjusted by a GovTx
+	BlockGasLimit uint64 `json:",omitempty" toml:",omitempty"`
+	// The maximum number of instructions a single transaction may execute (zero means unlimited), this may be
+	// subsequently adjusted by a GovTx
+	MaxTxInstructions uint64 `json:",omitempty" toml:",omitempty"`
+	// The maximum data size of a log event and number of log events per transaction beyond which a contract requires
+	// the Emit permission (zero means unlimited), these may be subsequently adjusted by a GovTx
+	MaxLogDataSize uint64 `json:",omitempty" toml:",omitempty"`
+	MaxTxLogs      uint64 `json:",omitempty" toml:",omitempty"`
+	// The largest change to validator power, as a percentage of total power, a GovTx may make within a block without
+	// being delayed by ValidatorPowerChangeDelay blocks (zero means unlimited), these may be subsequently adjusted by a
+	// GovTx
+	MaxValidatorPowerChange   uint64 `json:",omitempty" toml:",omitempty"`
+	ValidatorPowerChangeDelay uint64 `json:",omitempty" toml:",omitempty"`
+	// The minimum fee a CallTx or NameTx must pay (zero means no minimum) other than the calls of FeeExemptCalls, these
+	// may be subsequently adjusted by a GovTx
+	MinFee         uint64          `json:",omitempty" toml:",omitempty"`
+	FeeExemptCalls []FeeExemptCall `json:",omitempty" toml:",omitempty"`
+	// Whether fees are paid in the gas token (allocated to accounts by their GasAmount) rather than the native token,
+	// this may be subsequently changed by a GovTx
+	SeparateGasToken bool `json:",omitempty" toml:",omitempty"`
+	// Whether contracts' calls and creates forward at most all but one 64th of their remaining gas as on Ethereum
+	// (EIP-150), this may be subsequently changed by a GovTx
+	CapCallGas bool `json:",omitempty" toml:",omitempty"`
+	// The permissions granted to accounts created by a SendTx, a CallTx deploying a contract, or a contract and the
+	// most permissions those accounts may have when created (zero means unlimited), these may be subsequently adjusted
+	// by a GovTx
+	NewAccountPermissions    permission.PermFlag `json:",omitempty" toml:",omitempty"`
+	MaxNewAccountPermissions permission.PermFlag `json:",omitempty" toml:",omitempty"`
+	// The EVM gas schedule, one of 'burrow' (the default) or 'ethereum', this may be subsequently changed by a GovTx
+	GasSchedule string `json:",omitempty" toml:",omitempty"`
+	// Mounts the named precompiles (e.g. blake2FFunc, sha512_256Func) at the given addresses in place of their default
+	// addresses, these are fixed for the life of the chain
+	PrecompileAddresses map[string]crypto.Address `json:",omitempty" toml:",omitempty"`
+}
+
+// FeeExemptCall allows Caller to call Callee without paying the minimum fee, where Selector is non-empty only calls
+// whose input starts with it are exempt
+type FeeExemptCall struct {
+	Caller   crypto.Address
+	Callee   crypto.Address
+	Selector binary.HexBytes `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
@@ -150,6 +195,7 @@ func GenesisAccountFromAccount(name string, account *acm.Account) Account {
 			Address: account.Address,
 			Amount:  account.Balance,
 		},
+		GasAmount: account.GasBalance,
 	}
 }
 
@@ -163,6 +209,7 @@ func (genesisAccount *Account) Clone() Account {
 		},
 		Name:        genesisAccount.Name,
 		Permissions: genesisAccount.Permissions.Clone(),
+		GasAmount:   genesisAccount.GasAmount,
 	}
 }
 
@@ -171,6 +218,7 @@ func (genesisAccount *Account) AcmAccount() *acm.Account {
 		Address:     genesisAccount.Address,
 		PublicKey:   genesisAccount.PublicKey,
 		Balance:     genesisAccount.Amount,
+		GasBalance:  genesisAccount.GasAmount,
 		Permissions: genesisAccount.Permissions,
 	}
 }
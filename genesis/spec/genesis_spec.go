@@ -34,6 +34,26 @@ type GenesisSpec struct {
 
 type params struct {
 	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	BlockGasLimit     uint64 `json:",omitempty" toml:",omitempty"`
+	MaxTxInstructions uint64 `json:",omitempty" toml:",omitempty"`
+	MaxLogDataSize    uint64 `json:",omitempty" toml:",omitempty"`
+	MaxTxLogs         uint64 `json:",omitempty" toml:",omitempty"`
+
+	MaxValidatorPowerChange   uint64 `json:",omitempty" toml:",omitempty"`
+	ValidatorPowerChangeDelay uint64 `json:",omitempty" toml:",omitempty"`
+
+	MinFee         uint64                  `json:",omitempty" toml:",omitempty"`
+	FeeExemptCalls []genesis.FeeExemptCall `json:",omitempty" toml:",omitempty"`
+
+	SeparateGasToken bool `json:",omitempty" toml:",omitempty"`
+	CapCallGas       bool `json:",omitempty" toml:",omitempty"`
+
+	NewAccountPermissions    []string `json:",omitempty" toml:",omitempty"`
+	MaxNewAccountPermissions []string `json:",omitempty" toml:",omitempty"`
+
+	GasSchedule string `json:",omitempty" toml:",omitempty"`
+
+	PrecompileAddresses map[string]crypto.Address `json:",omitempty" toml:",omitempty"`
 }
 
 // Produce a fully realised GenesisDoc from a template GenesisDoc that may omit values
@@ -54,6 +74,27 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, curve crypto.CurveTy
 	if gs.Params.ProposalThreshold != 0 {
 		genesisDoc.Params.ProposalThreshold = genesis.DefaultProposalThreshold
 	}
+	genesisDoc.Params.BlockGasLimit = gs.Params.BlockGasLimit
+	genesisDoc.Params.MaxTxInstructions = gs.Params.MaxTxInstructions
+	genesisDoc.Params.MaxLogDataSize = gs.Params.MaxLogDataSize
+	genesisDoc.Params.MaxTxLogs = gs.Params.MaxTxLogs
+	genesisDoc.Params.MaxValidatorPowerChange = gs.Params.MaxValidatorPowerChange
+	genesisDoc.Params.ValidatorPowerChangeDelay = gs.Params.ValidatorPowerChangeDelay
+	genesisDoc.Params.MinFee = gs.Params.MinFee
+	genesisDoc.Params.FeeExemptCalls = gs.Params.FeeExemptCalls
+	genesisDoc.Params.SeparateGasToken = gs.Params.SeparateGasToken
+	genesisDoc.Params.CapCallGas = gs.Params.CapCallGas
+	genesisDoc.Params.GasSchedule = gs.Params.GasSchedule
+	genesisDoc.Params.PrecompileAddresses = gs.Params.PrecompileAddresses
+	var err error
+	genesisDoc.Params.NewAccountPermissions, err = permission.PermFlagFromStringList(gs.Params.NewAccountPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse NewAccountPermissions: %v", err)
+	}
+	genesisDoc.Params.MaxNewAccountPermissions, err = permission.PermFlagFromStringList(gs.Params.MaxNewAccountPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse MaxNewAccountPermissions: %v", err)
+	}
 
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
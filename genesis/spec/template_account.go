@@ -51,6 +51,7 @@ func (ta TemplateAccount) GenesisAccount(keyClient keys.KeyClient, index int, cu
 		return nil, err
 	}
 	ga.Amount = ta.Balances().GetNative(DefaultAmount)
+	ga.GasAmount = ta.Balances().GetGas(0)
 	if ta.Name == "" {
 		ga.Name = accountNameFromIndex(index)
 	} else {
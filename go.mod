@@ -15,10 +15,12 @@ require (
 	github.com/elgs/gosplitargs v0.0.0-20161028071935-a491c5eeb3c8 // indirect
 	github.com/fatih/color v1.7.0
 	github.com/go-kit/kit v0.9.0
+	github.com/go-logfmt/logfmt v0.5.0
 	github.com/go-ozzo/ozzo-validation v3.5.0+incompatible
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang/protobuf v1.3.3
 	github.com/gorilla/websocket v1.4.1
+	github.com/graphql-go/graphql v0.8.1
 	github.com/hashicorp/golang-lru v0.5.1
 	github.com/howeyc/gopass v0.0.0-20170109162249-bf9dde6d0d2c
 	github.com/iancoleman/strcase v0.0.0-20190422225806-e506e3ef7365
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"syscall"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -39,6 +41,46 @@ func TestKernelNoConsensus(t *testing.T) {
 	testKernel(t, integration.NoConsensus)
 }
 
+func TestKernelRotateNodeKey(t *testing.T) {
+	genesisDoc, privateAccounts, privateValidators := genesis.NewDeterministicGenesis(123).GenesisDoc(1, 1)
+	conf, cleanup := integration.NewTestConfig(genesisDoc)
+	defer cleanup()
+	kern, err := integration.TestKernel(privateValidators[0], rpctest.PrivateAccounts, conf)
+	require.NoError(t, err)
+	kern.SetKeyClient(keys.NewLocalKeyClient(keys.NewMemoryKeyStore(privateAccounts...), kern.Logger))
+	require.NoError(t, kern.Boot())
+
+	ctx := context.Background()
+	subID := event.GenSubID()
+	ch, err := kern.Emitter.Subscribe(ctx, subID, exec.QueryForBlockExecution(), 10)
+	require.NoError(t, err)
+	defer kern.Emitter.UnsubscribeAll(ctx, subID)
+	waitBlock := func() {
+		select {
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for block")
+		case <-ch:
+		}
+	}
+	waitBlock()
+
+	// Relative paths would be taken relative to Tendermint's root directory
+	nodeKeyFile, err := filepath.Abs("rotated_node_key.json")
+	require.NoError(t, err)
+	nodeKey, err := p2p.LoadOrGenNodeKey(nodeKeyFile)
+	require.NoError(t, err)
+	require.NotEqual(t, nodeKey.ID(), kern.Node.Current().NodeInfo().ID())
+	require.NoError(t, kern.Node.RotateNodeKey(nodeKeyFile))
+	assert.Equal(t, nodeKey.ID(), kern.Node.Current().NodeInfo().ID())
+
+	// The rebuilt node carries on making blocks
+	waitBlock()
+	waitBlock()
+
+	require.NoError(t, kern.Shutdown(ctx))
+	assert.Error(t, kern.Node.RotateNodeKey(nodeKeyFile))
+}
+
 func testKernel(t *testing.T, opts ...func(*config.BurrowConfig)) {
 	t.Run(fmt.Sprintf("Group"), func(t *testing.T) {
 		t.Parallel()
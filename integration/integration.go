@@ -96,6 +96,8 @@ func NewTestConfig(genesisDoc *genesis.GenesisDoc,
 	conf.RPC.Info.ListenPort = freeport
 	conf.RPC.Web3.ListenHost = rpc.LocalHost
 	conf.RPC.Web3.ListenPort = freeport
+	conf.RPC.Mux.ListenHost = rpc.LocalHost
+	conf.RPC.Mux.ListenPort = freeport
 	conf.Execution.TimeoutFactor = 0.5
 	conf.Execution.VMOptions = []execution.VMOption{}
 	for _, opt := range options {
@@ -138,6 +140,7 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 		return nil, err
 	}
 
+	kern.SetValidatorAddress(validatorAccount.GetAddress())
 	privVal := tendermint.NewPrivValidatorMemory(validatorAccount, validatorAccount)
 
 	err = kern.LoadTendermintFromConfig(testConfig, privVal)
@@ -0,0 +1,69 @@
+// +build integration
+
+package rpcbind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/rpc/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindings(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+	address := kern.GRPCListenAddress().String()
+	backend := &bind.Backend{
+		Transact: rpctest.NewTransactClient(t, address),
+		Query:    rpctest.NewQueryClient(t, address),
+		Events:   rpctest.NewExecutionEventsClient(t, address),
+	}
+	opts := &bind.TransactOpts{Input: rpctest.PrivateAccounts[0].GetAddress()}
+	ctx := context.Background()
+
+	t.Run("Call", func(t *testing.T) {
+		hello, _, err := DeployHelloWorld(ctx, backend, opts)
+		require.NoError(t, err)
+		deployed, err := hello.Deployed(ctx)
+		require.NoError(t, err)
+		assert.True(t, deployed)
+
+		greeting, err := hello.Hello(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Hello, World", greeting)
+
+		bound, err := NewHelloWorld(hello.Address, backend)
+		require.NoError(t, err)
+		greeting, err = bound.Hello(ctx, &bind.CallOpts{From: opts.Input})
+		require.NoError(t, err)
+		assert.Equal(t, "Hello, World", greeting)
+	})
+
+	t.Run("FilterEvents", func(t *testing.T) {
+		emitter, _, err := DeployEventEmitter(ctx, backend, opts)
+		require.NoError(t, err)
+		_, err = emitter.EmitOne(ctx, opts)
+		require.NoError(t, err)
+		_, err = emitter.EmitOne(ctx, opts)
+		require.NoError(t, err)
+
+		var events []*EventEmitterManyTypes
+		err = emitter.FilterManyTypes(ctx, nil, func(ev *EventEmitterManyTypes) error {
+			events = append(events, ev)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		ev := events[0]
+		assert.Equal(t, "Downsie!", string(ev.Direction[:8]))
+		assert.True(t, ev.Trueism)
+		assert.Equal(t, "Donaudampfschifffahrtselektrizitätenhauptbetriebswerkbauunterbeamtengesellschaft", ev.German)
+		assert.Equal(t, int64(102), ev.NewDepth)
+		assert.Equal(t, int64(42), ev.Bignum.Int64())
+		assert.Equal(t, emitter.Address, ev.Raw.Address)
+	})
+}
@@ -0,0 +1,165 @@
+// Code generated by burrow compile --bindings go. DO NOT EDIT.
+
+package rpcbind
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/bind"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Reference imports that may not otherwise be used
+var (
+	_ = context.Background
+	_ = crypto.ZeroAddress
+	_ = exec.TxExecution{}
+)
+
+// EventEmitterAbi is the ABI of the EventEmitter contract
+const EventEmitterAbi = `[{"constant":false,"inputs":[],"name":"EmitTwo","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[],"name":"EmitOne","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"direction","type":"bytes32"},{"indexed":false,"internalType":"bool","name":"trueism","type":"bool"},{"indexed":false,"internalType":"string","name":"german","type":"string"},{"indexed":true,"internalType":"int64","name":"newDepth","type":"int64"},{"indexed":false,"internalType":"int256","name":"bignum","type":"int256"},{"indexed":true,"internalType":"string","name":"hash","type":"string"}],"name":"ManyTypes","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"direction","type":"bytes32"},{"indexed":false,"internalType":"bool","name":"trueism","type":"bool"},{"indexed":false,"internalType":"string","name":"german","type":"string"},{"indexed":true,"internalType":"int128","name":"newDepth","type":"int128"},{"indexed":false,"internalType":"int8","name":"bignum","type":"int8"},{"indexed":true,"internalType":"string","name":"hash","type":"string"}],"name":"ManyTypes2","type":"event"}]`
+
+// EventEmitterBytecode is the creation bytecode of the EventEmitter contract
+var EventEmitterBytecode = hex.MustDecodeString("608060405234801561001057600080fd5b50610250806100206000396000f3fe608060405234801561001057600080fd5b50600436106100365760003560e01c8063508ed7991461003b578063e8e49a7114610045575b600080fd5b61004361004f565b005b61004d61010e565b005b60405180807f68617368000000000000000000000000000000000000000000000000000000008152506004019050604051809103902060667f446f776e736965210000000000000000000000000000000000000000000000007f2d989eca8871e173291c8e287f34adebef09917027f9e904c22ce459a2cff0ca6001602a6040518083151515158152602001806020018360000b8152602001828103825260518152602001806101cb60519139606001935050505060405180910390a4565b60405180807f68617368000000000000000000000000000000000000000000000000000000008152506004019050604051809103902060667f446f776e736965210000000000000000000000000000000000000000000000007f20aec2a3bcd8050a3a9e852e9d424805bad75ba33b57077464c73ae98d0582696001602a604051808315151515815260200180602001838152602001828103825260518152602001806101cb60519139606001935050505060405180910390a456fe446f6e617564616d7066736368696666666168727473656c656b7472697a6974c3a474656e686175707462657472696562737765726b626175756e7465726265616d74656e676573656c6c736368616674a265627a7a723158203c195a0643bb2f371aa1fbbe9e0b8eb41cb92b22a544a5f9ea322b5c806143bf64736f6c634300050b0032")
+
+// EventEmitter is a binding of the EventEmitter contract
+type EventEmitter struct {
+	*bind.Contract
+}
+
+// DeployEventEmitter deploys a new EventEmitter contract
+func DeployEventEmitter(ctx context.Context, backend *bind.Backend, opts *bind.TransactOpts) (*EventEmitter, *exec.TxExecution, error) {
+	spec, err := abi.ReadSpec([]byte(EventEmitterAbi))
+	if err != nil {
+		return nil, nil, err
+	}
+	contract, txe, err := bind.Deploy(ctx, backend, opts, spec, EventEmitterBytecode)
+	if err != nil {
+		return nil, txe, err
+	}
+	return &EventEmitter{Contract: contract}, txe, nil
+}
+
+// NewEventEmitter binds an instance of EventEmitter deployed at address
+func NewEventEmitter(address crypto.Address, backend *bind.Backend) (*EventEmitter, error) {
+	spec, err := abi.ReadSpec([]byte(EventEmitterAbi))
+	if err != nil {
+		return nil, err
+	}
+	return &EventEmitter{Contract: bind.NewContract(address, spec, backend)}, nil
+}
+
+// EmitOne calls EmitOne() in a transaction
+func (c *EventEmitter) EmitOne(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	return c.Transact(ctx, opts, "EmitOne")
+}
+
+// EmitTwo calls EmitTwo() in a transaction
+func (c *EventEmitter) EmitTwo(ctx context.Context, opts *bind.TransactOpts) (*exec.TxExecution, error) {
+	return c.Transact(ctx, opts, "EmitTwo")
+}
+
+// EventEmitterManyTypes is the ManyTypes(bytes32 indexed direction,bool trueism,string german,int64 indexed newDepth,int256 bignum,bytes32 indexed hash) event of EventEmitter
+type EventEmitterManyTypes struct {
+	Direction [32]byte
+	Trueism   bool
+	German    string
+	NewDepth  int64
+	Bignum    *big.Int
+	Hash      [32]byte
+	Raw       *exec.LogEvent
+}
+
+// UnpackManyTypes decodes a ManyTypes event
+func (c *EventEmitter) UnpackManyTypes(log *exec.LogEvent) (*EventEmitterManyTypes, error) {
+	ev := &EventEmitterManyTypes{Raw: log}
+	err := c.UnpackLog("ManyTypes", log, &ev.Direction, &ev.Trueism, &ev.German, &ev.NewDepth, &ev.Bignum, &ev.Hash)
+	return ev, err
+}
+
+// FilterManyTypes passes each ManyTypes event emitted within blockRange (all blocks to date if nil) to consumer
+func (c *EventEmitter) FilterManyTypes(ctx context.Context, blockRange *rpcevents.BlockRange,
+	consumer func(ev *EventEmitterManyTypes) error) error {
+	return c.FilterLogs(ctx, "ManyTypes", blockRange, func(log *exec.LogEvent) error {
+		ev, err := c.UnpackManyTypes(log)
+		if err != nil {
+			return err
+		}
+		return consumer(ev)
+	})
+}
+
+// EventEmitterManyTypes2 is the ManyTypes2(bytes32 indexed direction,bool trueism,string german,int128 indexed newDepth,int8 bignum,bytes32 indexed hash) event of EventEmitter
+type EventEmitterManyTypes2 struct {
+	Direction [32]byte
+	Trueism   bool
+	German    string
+	NewDepth  *big.Int
+	Bignum    int8
+	Hash      [32]byte
+	Raw       *exec.LogEvent
+}
+
+// UnpackManyTypes2 decodes a ManyTypes2 event
+func (c *EventEmitter) UnpackManyTypes2(log *exec.LogEvent) (*EventEmitterManyTypes2, error) {
+	ev := &EventEmitterManyTypes2{Raw: log}
+	err := c.UnpackLog("ManyTypes2", log, &ev.Direction, &ev.Trueism, &ev.German, &ev.NewDepth, &ev.Bignum, &ev.Hash)
+	return ev, err
+}
+
+// FilterManyTypes2 passes each ManyTypes2 event emitted within blockRange (all blocks to date if nil) to consumer
+func (c *EventEmitter) FilterManyTypes2(ctx context.Context, blockRange *rpcevents.BlockRange,
+	consumer func(ev *EventEmitterManyTypes2) error) error {
+	return c.FilterLogs(ctx, "ManyTypes2", blockRange, func(log *exec.LogEvent) error {
+		ev, err := c.UnpackManyTypes2(log)
+		if err != nil {
+			return err
+		}
+		return consumer(ev)
+	})
+}
+
+// HelloWorldAbi is the ABI of the HelloWorld contract
+const HelloWorldAbi = `[{"constant":true,"inputs":[],"name":"Hello","outputs":[{"internalType":"string","name":"","type":"string"}],"payable":false,"stateMutability":"pure","type":"function"}]`
+
+// HelloWorldBytecode is the creation bytecode of the HelloWorld contract
+var HelloWorldBytecode = hex.MustDecodeString("608060405234801561001057600080fd5b5061011d806100206000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c8063bcdfe0d514602d575b600080fd5b603360ab565b6040518080602001828103825283818151815260200191508051906020019080838360005b8381101560715780820151818401526020810190506058565b50505050905090810190601f168015609d5780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b60606040518060400160405280600c81526020017f48656c6c6f2c20576f726c64000000000000000000000000000000000000000081525090509056fea265627a7a723158200b2a5b7a53ba54371daa99f4d02346044f39e951d89df28ce64b4d36dd3fda0664736f6c634300050b0032")
+
+// HelloWorld is a binding of the HelloWorld contract
+type HelloWorld struct {
+	*bind.Contract
+}
+
+// DeployHelloWorld deploys a new HelloWorld contract
+func DeployHelloWorld(ctx context.Context, backend *bind.Backend, opts *bind.TransactOpts) (*HelloWorld, *exec.TxExecution, error) {
+	spec, err := abi.ReadSpec([]byte(HelloWorldAbi))
+	if err != nil {
+		return nil, nil, err
+	}
+	contract, txe, err := bind.Deploy(ctx, backend, opts, spec, HelloWorldBytecode)
+	if err != nil {
+		return nil, txe, err
+	}
+	return &HelloWorld{Contract: contract}, txe, nil
+}
+
+// NewHelloWorld binds an instance of HelloWorld deployed at address
+func NewHelloWorld(address crypto.Address, backend *bind.Backend) (*HelloWorld, error) {
+	spec, err := abi.ReadSpec([]byte(HelloWorldAbi))
+	if err != nil {
+		return nil, err
+	}
+	return &HelloWorld{Contract: bind.NewContract(address, spec, backend)}, nil
+}
+
+// Hello calls Hello() without making a transaction
+func (c *HelloWorld) Hello(ctx context.Context, opts *bind.CallOpts) (string, error) {
+	var ret0 string
+	err := c.Call(ctx, opts, "Hello", []interface{}{&ret0})
+	return ret0, err
+}
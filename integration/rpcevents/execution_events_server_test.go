@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
@@ -164,6 +165,210 @@ func TestExecutionEventsTest(t *testing.T) {
 			assert.Equal(t, numSends, countEventsAndCheckConsecutive(t, responses), "should receive every single input event per send")
 		})
 
+		t.Run("GetEventsOrderedAndLimited", func(t *testing.T) {
+			request := &rpcevents.BlocksRequest{
+				BlockRange: doSends(t, 50, tcli, kern, inputAddress0, 999),
+				Query: query.NewBuilder().AndEquals("Input.Address", inputAddress0.String()).String() +
+					" AND EventType IN ('AccountInputEvent', 'AccountOutputEvent') ORDER BY Height DESC LIMIT 7",
+			}
+			responses, err := getEvents(t, request, ecli)
+			require.NoError(t, err)
+			n := 0
+			for i, response := range responses {
+				if i > 0 {
+					require.True(t, response.Height < responses[i-1].Height, "blocks should be in descending order")
+				}
+				n += len(response.Events)
+			}
+			assert.Equal(t, 7, n, "should receive no more events than the limit")
+		})
+
+		t.Run("JoinEvents", func(t *testing.T) {
+			numSends := 20
+			request := &rpcevents.BlocksRequest{
+				BlockRange: doSends(t, numSends, tcli, kern, inputAddress1, 2004),
+				Query: query.NewBuilder().AndEquals("Input.Address", inputAddress1.String()).
+					AndEquals(event.EventTypeKey, exec.TypeAccountInput.String()).String(),
+			}
+			stream, err := ecli.JoinEvents(context.Background(), request)
+			require.NoError(t, err)
+			n := 0
+			joined, err := stream.Recv()
+			for err == nil {
+				n++
+				assert.Equal(t, joined.Event.Header.TxHash, joined.TxHeader.TxHash)
+				assert.Equal(t, joined.Event.Header.Height, joined.TxHeader.Height)
+				assert.Equal(t, []crypto.Address{inputAddress1}, joined.Inputs)
+				assert.False(t, joined.BlockTime.IsZero())
+				assert.NotEqual(t, crypto.ZeroAddress, joined.Proposer)
+				joined, err = stream.Recv()
+			}
+			require.Equal(t, io.EOF, err)
+			assert.Equal(t, numSends, n, "should receive every input event joined with its tx and block")
+		})
+
+		t.Run("JoinEventsResume", func(t *testing.T) {
+			request := &rpcevents.BlocksRequest{
+				BlockRange: doSends(t, 20, tcli, kern, inputAddress1, 2004),
+				Query: query.NewBuilder().AndEquals("Input.Address", inputAddress1.String()).
+					AndEquals(event.EventTypeKey, exec.TypeAccountInput.String()).String(),
+			}
+			joinEvents := func(request *rpcevents.BlocksRequest) []*rpcevents.JoinedEvent {
+				stream, err := ecli.JoinEvents(context.Background(), request)
+				require.NoError(t, err)
+				events := []*rpcevents.JoinedEvent{}
+				joined, err := stream.Recv()
+				for err == nil {
+					events = append(events, joined)
+					joined, err = stream.Recv()
+				}
+				require.Equal(t, io.EOF, err)
+				return events
+			}
+			all := joinEvents(request)
+			require.Len(t, all, 20)
+			// Resuming after any event should deliver exactly the events that followed it
+			for _, i := range []int{0, 7, 18, 19} {
+				request.Cursor = all[i].Cursor
+				assert.Equal(t, all[i+1:], joinEvents(request))
+			}
+		})
+
+		t.Run("JoinEventsSubscription", func(t *testing.T) {
+			request := &rpcevents.BlocksRequest{
+				BlockRange: doSends(t, 20, tcli, kern, inputAddress1, 2004),
+				Query: query.NewBuilder().AndEquals("Input.Address", inputAddress1.String()).
+					AndEquals(event.EventTypeKey, exec.TypeAccountInput.String()).String(),
+			}
+			joinEvents := func(request *rpcevents.BlocksRequest) []*rpcevents.JoinedEvent {
+				stream, err := ecli.JoinEvents(context.Background(), request)
+				require.NoError(t, err)
+				events := []*rpcevents.JoinedEvent{}
+				joined, err := stream.Recv()
+				for err == nil {
+					events = append(events, joined)
+					joined, err = stream.Recv()
+				}
+				require.Equal(t, io.EOF, err)
+				return events
+			}
+			all := joinEvents(request)
+			require.Len(t, all, 20)
+
+			// Stream the first half of the blocks under a name
+			partial := *request
+			partial.Subscription = "join-events-subscription"
+			partial.BlockRange = rpcevents.AbsoluteRange(request.BlockRange.Start.Index, all[9].Event.Header.Height)
+			first := joinEvents(&partial)
+			require.NotEmpty(t, first)
+
+			// Then request every block under the same name to receive only the events that followed
+			named := *request
+			named.Subscription = partial.Subscription
+			assert.Equal(t, all, append(first, joinEvents(&named)...))
+
+			// A subscription can only be resumed with the query it was created with
+			named.Query = ""
+			stream, err := ecli.JoinEvents(context.Background(), &named)
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			require.Error(t, err)
+		})
+
+		t.Run("GetTxsByAddress", func(t *testing.T) {
+			txe, err := rpctest.CreateContract(tcli, inputAddress0, solidity.Bytecode_Revert, nil)
+			require.NoError(t, err)
+			contractAddress := txe.Receipt.ContractAddress
+			txHashes := []binary.HexBytes{txe.TxHash}
+			spec, err := abi.ReadSpec(solidity.Abi_Revert)
+			require.NoError(t, err)
+			data, _, err := spec.Pack("RevertAt", 4)
+			require.NoError(t, err)
+			for i := 0; i < 2; i++ {
+				// Failed calls still involve the contract
+				txe, err = rpctest.CallContract(tcli, inputAddress0, contractAddress, data)
+				require.NoError(t, err)
+				txHashes = append(txHashes, txe.TxHash)
+			}
+
+			var pages [][]binary.HexBytes
+			request := &rpcevents.TxsByAddressRequest{Address: contractAddress, Limit: 2}
+			for {
+				response, err := ecli.GetTxsByAddress(context.Background(), request)
+				require.NoError(t, err)
+				var page []binary.HexBytes
+				for _, txe := range response.TxExecutions {
+					page = append(page, txe.TxHash)
+				}
+				pages = append(pages, page)
+				if len(response.Next) == 0 {
+					break
+				}
+				request.After = response.Next
+			}
+			assert.Equal(t, [][]binary.HexBytes{txHashes[:2], txHashes[2:]}, pages)
+
+			response, err := ecli.GetTxsByAddress(context.Background(),
+				&rpcevents.TxsByAddressRequest{Address: contractAddress, Descending: true, Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, response.TxExecutions, 1)
+			assert.Equal(t, txHashes[2], response.TxExecutions[0].TxHash)
+			assert.Equal(t, txHashes[2], response.Next)
+		})
+
+		t.Run("GetTxReceipt", func(t *testing.T) {
+			createTxe, err := rpctest.CreateContract(tcli, inputAddress0, solidity.Bytecode_Revert, nil)
+			require.NoError(t, err)
+			receipt, err := ecli.GetTxReceipt(context.Background(), &rpcevents.TxRequest{TxHash: createTxe.TxHash})
+			require.NoError(t, err)
+			assert.Equal(t, createTxe.TxHeader, receipt.TxHeader)
+			assert.True(t, receipt.CreatesContract)
+			assert.Equal(t, createTxe.Receipt.ContractAddress, receipt.ContractAddress)
+			assert.Equal(t, createTxe.Result.GasUsed, receipt.GasUsed)
+			assert.Nil(t, receipt.Exception)
+
+			spec, err := abi.ReadSpec(solidity.Abi_Revert)
+			require.NoError(t, err)
+			data, _, err := spec.Pack("RevertAt", 4)
+			require.NoError(t, err)
+			callTxe, err := rpctest.CallContract(tcli, inputAddress0, createTxe.Receipt.ContractAddress, data)
+			require.NoError(t, err)
+			receipt, err = ecli.GetTxReceipt(context.Background(), &rpcevents.TxRequest{TxHash: callTxe.TxHash})
+			require.NoError(t, err)
+			assert.Equal(t, errors.Codes.ExecutionReverted, errors.GetCode(receipt.Exception))
+			// The logs emitted before the revert are not part of the outcome
+			assert.NotEmpty(t, callTxe.Events)
+			assert.Empty(t, receipt.Logs)
+			assert.False(t, receipt.CreatesContract)
+
+			_, err = ecli.GetTxReceipt(context.Background(), &rpcevents.TxRequest{TxHash: make([]byte, 32)})
+			require.Error(t, err)
+		})
+
+		t.Run("BlockExecutions", func(t *testing.T) {
+			numSends := 20
+			request := &rpcevents.BlockExecutionsRequest{
+				BlockRange: doSends(t, numSends, tcli, kern, inputAddress1, 2004),
+				BatchSize:  2,
+			}
+			stream, err := ecli.BlockExecutions(context.Background(), request)
+			require.NoError(t, err)
+			var height uint64
+			response, err := stream.Recv()
+			for err == nil {
+				require.NotEmpty(t, response.BlockExecutions)
+				require.True(t, len(response.BlockExecutions) <= 2, "batches should be no larger than requested")
+				for _, be := range response.BlockExecutions {
+					require.True(t, be.Height > height, "blocks should be delivered in order")
+					height = be.Height
+					numSends -= len(be.TxExecutions)
+				}
+				response, err = stream.Recv()
+			}
+			require.Equal(t, io.EOF, err)
+			assert.Equal(t, 0, numSends, "should receive every transaction within its block")
+		})
+
 		t.Run("Revert", func(t *testing.T) {
 			txe, err := rpctest.CreateContract(tcli, inputAddress0, solidity.Bytecode_Revert, nil)
 			require.NoError(t, err)
@@ -187,6 +392,49 @@ func TestExecutionEventsTest(t *testing.T) {
 			n := countEventsAndCheckConsecutive(t, evs)
 			assert.Equal(t, 0, n, "should not see reverted events")
 		})
+
+		t.Run("GetEventsByLogTopic", func(t *testing.T) {
+			txe, err := rpctest.CreateContract(tcli, inputAddress0, solidity.Bytecode_StrangeLoop, nil)
+			require.NoError(t, err)
+			contractAddress := txe.Receipt.ContractAddress
+			spec, err := abi.ReadSpec(solidity.Abi_StrangeLoop)
+			require.NoError(t, err)
+			data, _, err := spec.Pack("UpsieDownsie")
+			require.NoError(t, err)
+			txe, err = rpctest.CallContract(tcli, inputAddress0, contractAddress, data)
+			require.NoError(t, err)
+
+			signature := binary.LeftPadWord256(spec.EventsByName["ChangeLevel"].ID.Bytes())
+			upsie := binary.RightPadWord256([]byte("Upsie!"))
+			// Selected from the log index by address, signature and second topic
+			request := &rpcevents.BlocksRequest{
+				BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(0), rpcevents.LatestBound()),
+				Query: query.NewBuilder().AndEquals(event.AddressKey, contractAddress.String()).
+					AndEquals(exec.LogNKey(0), signature.String()).
+					AndEquals(exec.LogNKey(1), upsie.String()).String(),
+			}
+			responses, err := getEvents(t, request, ecli)
+			require.NoError(t, err)
+			require.Len(t, responses, 1)
+			assert.Equal(t, txe.Height, responses[0].Height)
+			assert.Equal(t, 17, countEventsAndCheckConsecutive(t, responses), "should receive each Upsie!")
+			for _, ev := range responses[0].Events {
+				assert.Equal(t, upsie, ev.Log.Topics[1])
+			}
+
+			logs, err := ecli.Logs(context.Background(), &rpcevents.LogsRequest{
+				Address:   contractAddress,
+				Signature: signature,
+				Topic:     binary.RightPadWord256([]byte("Downsie!")).Bytes(),
+			})
+			require.NoError(t, err)
+			n := 0
+			for _, err = logs.Recv(); err == nil; _, err = logs.Recv() {
+				n++
+			}
+			require.Equal(t, io.EOF, err)
+			assert.Equal(t, 11, n, "should receive each Downsie!")
+		})
 	})
 }
 
@@ -0,0 +1,133 @@
+//go:build integration
+// +build integration
+
+package rpcexplorer
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/rpc/rpcexplorer"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplorerServer(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+
+	cli := rpctest.NewExplorerClient(t, kern.GRPCListenAddress().String())
+	tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+	input := rpctest.PrivateAccounts[0].GetAddress()
+
+	var deployments []*rpcexplorer.ContractDeployment
+	for i := 0; i < 3; i++ {
+		txe, err := rpctest.CreateContract(tcli, input, []byte{byte(asm.STOP)}, nil)
+		require.NoError(t, err)
+		deployments = append(deployments, &rpcexplorer.ContractDeployment{
+			Address: txe.Receipt.ContractAddress,
+			Deployment: &acm.Deployment{
+				TxHash:  txe.TxHash,
+				Creator: input,
+				Height:  txe.Height,
+			},
+		})
+	}
+
+	t.Run("ListBlocks", func(t *testing.T) {
+		stream, err := cli.ListBlocks(context.Background(), &rpcexplorer.ListBlocksParam{Limit: 3})
+		require.NoError(t, err)
+		var blocks []*rpcexplorer.BlockSummary
+		for {
+			block, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			blocks = append(blocks, block)
+		}
+		require.Len(t, blocks, 3)
+		for i, block := range blocks {
+			if i > 0 {
+				assert.Equal(t, blocks[i-1].Height-1, block.Height)
+			}
+			assert.NotEmpty(t, block.Hash)
+			assert.Equal(t, rpctest.PrivateAccounts[0].GetAddress(), block.Proposer)
+		}
+
+		last := deployments[len(deployments)-1].Deployment.Height
+		stream, err = cli.ListBlocks(context.Background(), &rpcexplorer.ListBlocksParam{Height: last, Limit: 1})
+		require.NoError(t, err)
+		block, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, last, block.Height)
+		assert.Equal(t, uint64(1), block.NumTxs)
+	})
+
+	t.Run("ListTopAccounts", func(t *testing.T) {
+		_, err := tcli.SendTxSync(context.Background(), &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: rpctest.PrivateAccounts[1].GetAddress(), Amount: 1000}},
+			Outputs: []*payload.TxOutput{{Address: rpctest.PrivateAccounts[2].GetAddress(), Amount: 1000}},
+		})
+		require.NoError(t, err)
+
+		stream, err := cli.ListTopAccounts(context.Background(), &rpcexplorer.ListTopAccountsParam{Limit: 5})
+		require.NoError(t, err)
+		var accounts []*acm.Account
+		for {
+			acc, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			accounts = append(accounts, acc)
+		}
+		require.Len(t, accounts, 5)
+		assert.Equal(t, rpctest.PrivateAccounts[2].GetAddress(), accounts[0].Address)
+		for i := 1; i < len(accounts); i++ {
+			assert.True(t, accounts[i-1].Balance >= accounts[i].Balance)
+		}
+	})
+
+	t.Run("ListRecentDeployments", func(t *testing.T) {
+		stream, err := cli.ListRecentDeployments(context.Background(),
+			&rpcexplorer.ListRecentDeploymentsParam{Limit: 2})
+		require.NoError(t, err)
+		var recent []*rpcexplorer.ContractDeployment
+		for {
+			cd, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			recent = append(recent, cd)
+		}
+		require.Len(t, recent, 2)
+		assert.Equal(t, deployments[2], recent[0])
+		assert.Equal(t, deployments[1], recent[1])
+	})
+
+	t.Run("GetChainStats", func(t *testing.T) {
+		stats, err := cli.GetChainStats(context.Background(), &rpcexplorer.GetChainStatsParam{})
+		require.NoError(t, err)
+		assert.Equal(t, rpctest.GenesisDoc.ChainID(), stats.ChainID)
+		assert.Equal(t, uint64(1), stats.Validators)
+		// Our deployments leave no code so count as plain accounts
+		assert.True(t, stats.Accounts >= uint64(len(deployments)))
+		// The window can reach back no further than the first block
+		assert.Equal(t, stats.LatestHeight-1, stats.Window)
+		assert.True(t, stats.WindowTxs >= uint64(len(deployments)-1))
+		assert.True(t, stats.AverageBlockTime > 0)
+		assert.True(t, stats.TxsPerSecond > 0)
+
+		stats, err = cli.GetChainStats(context.Background(), &rpcexplorer.GetChainStatsParam{Window: 1})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), stats.Window)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/txs/payload"
 
@@ -24,8 +25,10 @@ import (
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/rpcinfo/infoclient"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/stretchr/testify/assert"
@@ -56,6 +59,20 @@ func TestInfoServer(t *testing.T) {
 					"ChainID should match NodeInfo.Network")
 			})
 
+			t.Run("BootReport", func(t *testing.T) {
+				t.Parallel()
+				resp, err := infoclient.BootReport(rpcClient)
+				require.NoError(t, err)
+				assert.False(t, resp.Finished.IsZero(), "should have finished booting")
+				steps := make(map[string]*process.BootStep)
+				for _, step := range resp.Steps {
+					steps[step.Name] = step
+				}
+				require.Contains(t, steps, "database")
+				require.Contains(t, steps, core.InfoProcessName)
+				assert.Equal(t, process.BootOK, steps[core.InfoProcessName].Status)
+			})
+
 			t.Run("Account", func(t *testing.T) {
 				t.Parallel()
 				acc := rpctest.GetAccount(t, rpcClient, rpctest.PrivateAccounts[0].GetAddress())
@@ -276,3 +293,22 @@ func broadcastTxSync(t testing.TB, cli rpctransact.TransactClient, txEnv *txs.En
 	require.NoError(t, err)
 	return txe
 }
+
+func TestInfoServerMux(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts,
+		func(conf *config.BurrowConfig) {
+			conf.RPC.Mux.Enabled = true
+		})
+	defer shutdown()
+	// The info and GRPC servers share the mux port
+	address := kern.InfoListenAddress().String()
+	require.Equal(t, address, kern.GRPCListenAddress().String())
+
+	resp, err := infoclient.Status(client.NewJSONRPCClient(address))
+	require.NoError(t, err)
+	assert.Equal(t, rpctest.GenesisDoc.ChainID(), resp.NodeInfo.Network)
+
+	status, err := rpctest.NewQueryClient(t, address).Status(context.Background(), &rpcquery.StatusParam{})
+	require.NoError(t, err)
+	assert.Equal(t, rpctest.GenesisDoc.ChainID(), status.ChainID)
+}
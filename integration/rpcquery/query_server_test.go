@@ -3,21 +3,30 @@
 package rpcquery
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/burrow/integration"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/heartbeat"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/integration/rpctest"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,6 +66,16 @@ func TestQueryServer(t *testing.T) {
 		assert.Contains(t, err.Error(), "have not committed a block sufficiently recently")
 	})
 
+	t.Run("GetCapabilities", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		capabilities, err := cli.GetCapabilities(context.Background(), &rpcquery.GetCapabilitiesParam{})
+		require.NoError(t, err)
+		assert.Contains(t, capabilities.PayloadTypes, "CallTx")
+		assert.Contains(t, capabilities.RPCMethods, "/rpcquery.Query/GetCapabilities")
+		assert.Contains(t, capabilities.Opcodes, "CREATE2")
+		assert.Equal(t, uint64(exec.EventSchemaVersion), capabilities.EventSchemaVersion)
+	})
+
 	t.Run("GetAccount", func(t *testing.T) {
 		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
 		acc, err := cli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
@@ -87,6 +106,60 @@ func TestQueryServer(t *testing.T) {
 		assert.Len(t, accs, len(rpctest.GenesisDoc.Accounts)+1)
 	})
 
+	t.Run("ListAccountsFields", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		stream, err := cli.ListAccounts(context.Background(), &rpcquery.ListAccountsParam{
+			Fields: []string{"Address", "Balance"},
+		})
+		require.NoError(t, err)
+		n := 0
+		acc, err := stream.Recv()
+		for err == nil {
+			n++
+			assert.NotZero(t, acc.Balance)
+			assert.Empty(t, acc.PublicKey.PublicKey)
+			assert.Empty(t, acc.Permissions.Base.Perms)
+			acc, err = stream.Recv()
+		}
+		if err != nil && err != io.EOF {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.Equal(t, len(rpctest.GenesisDoc.Accounts)+1, n)
+
+		_, err = cli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: rpctest.PrivateAccounts[2].GetAddress(),
+			Fields:  []string{"Colour"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("ListAccountsPages", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		var addresses []crypto.Address
+		param := &rpcquery.ListAccountsParam{Fields: []string{"Address"}, Limit: 2}
+		for {
+			stream, err := cli.ListAccounts(context.Background(), param)
+			require.NoError(t, err)
+			n := 0
+			acc, err := stream.Recv()
+			for err == nil {
+				n++
+				addresses = append(addresses, acc.Address)
+				acc, err = stream.Recv()
+			}
+			require.Equal(t, io.EOF, err)
+			require.LessOrEqual(t, n, 2)
+			if n == 0 {
+				break
+			}
+			param.After = &addresses[len(addresses)-1]
+		}
+		require.Len(t, addresses, len(rpctest.GenesisDoc.Accounts)+1)
+		for i := 1; i < len(addresses); i++ {
+			assert.Equal(t, -1, bytes.Compare(addresses[i-1].Bytes(), addresses[i].Bytes()))
+		}
+	})
+
 	t.Run("ListNames", func(t *testing.T) {
 		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
 		dataA, dataB := "NO TAMBOURINES", "ELEPHANTS WELCOME"
@@ -108,6 +181,21 @@ func TestQueryServer(t *testing.T) {
 		if assert.Len(t, entries, n/2) {
 			assert.Equal(t, dataA, entries[0].Data)
 		}
+
+		stream, err := qcli.ListNames(context.Background(), &rpcquery.ListNamesParam{
+			Query: query.NewBuilder().AndEquals("Data", dataB).String(),
+			After: "Flub/1",
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		var page []string
+		entry, err := stream.Recv()
+		for err == nil {
+			page = append(page, entry.Name)
+			entry, err = stream.Recv()
+		}
+		require.Equal(t, io.EOF, err)
+		assert.Equal(t, []string{"Flub/3", "Flub/5"}, page)
 	})
 
 	t.Run("GetBlockHeader", func(t *testing.T) {
@@ -122,6 +210,132 @@ func TestQueryServer(t *testing.T) {
 		assert.Equal(t, int64(height), header.Height)
 		assert.Len(t, header.AppHash, tmhash.Size)
 	})
+
+	t.Run("GetStateBatch", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		// Stores 42 at key 1
+		code := []byte{byte(asm.PUSH1), 42, byte(asm.PUSH1), 1, byte(asm.SSTORE), byte(asm.STOP)}
+		txe, err := rpctest.CreateContract(tcli, rpctest.PrivateAccounts[0].GetAddress(), code, nil)
+		require.NoError(t, err)
+		contract := txe.Receipt.ContractAddress
+
+		batch, err := cli.GetStateBatch(context.Background(), &rpcquery.GetStateBatchParam{
+			Accounts: []crypto.Address{rpctest.PrivateAccounts[2].GetAddress(), {1, 2, 3}},
+			Storage: []*rpcquery.GetStorageParam{
+				{Address: contract, Key: binary.LeftPadWord256([]byte{1})},
+				{Address: contract, Key: binary.LeftPadWord256([]byte{2})},
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, batch.Height >= txe.Height)
+		require.Len(t, batch.Accounts, 2)
+		assert.Equal(t, rpctest.PrivateAccounts[2].GetAddress(), batch.Accounts[0].Address)
+		assert.Equal(t, crypto.ZeroAddress, batch.Accounts[1].Address)
+		require.Len(t, batch.Storage, 2)
+		assert.Equal(t, binary.LeftPadBytes([]byte{42}, binary.Word256Bytes), batch.Storage[0].Value.Bytes())
+		assert.Empty(t, batch.Storage[1].Value)
+
+		// A later contract has no storage in the state at the height of the first
+		later, err := rpctest.CreateContract(tcli, rpctest.PrivateAccounts[0].GetAddress(), code, nil)
+		require.NoError(t, err)
+		require.True(t, later.Height > txe.Height)
+		slot := &rpcquery.GetStorageParam{Address: later.Receipt.ContractAddress, Key: binary.LeftPadWord256([]byte{1})}
+		batch, err = cli.GetStateBatch(context.Background(), &rpcquery.GetStateBatchParam{
+			Storage: []*rpcquery.GetStorageParam{slot},
+			Height:  txe.Height,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, txe.Height, batch.Height)
+		assert.Empty(t, batch.Storage[0].Value)
+
+		_, err = cli.GetStateBatch(context.Background(), &rpcquery.GetStateBatchParam{Height: batch.Height + 1000})
+		require.Error(t, err)
+	})
+
+	t.Run("GetStorageRange", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		// Stores 10 * k at each key k from 1 to 5
+		var code []byte
+		for k := byte(1); k <= 5; k++ {
+			code = append(code, byte(asm.PUSH1), 10*k, byte(asm.PUSH1), k, byte(asm.SSTORE))
+		}
+		code = append(code, byte(asm.STOP))
+		txe, err := rpctest.CreateContract(tcli, rpctest.PrivateAccounts[0].GetAddress(), code, nil)
+		require.NoError(t, err)
+
+		param := &rpcquery.GetStorageRangeParam{Address: txe.Receipt.ContractAddress, Limit: 2}
+		var pages [][]byte
+		for {
+			storageRange, err := cli.GetStorageRange(context.Background(), param)
+			require.NoError(t, err)
+			var page []byte
+			for _, entry := range storageRange.Entries {
+				k := entry.Key.Bytes()[binary.Word256Bytes-1]
+				assert.Equal(t, binary.LeftPadBytes([]byte{10 * k}, binary.Word256Bytes), entry.Value.Bytes())
+				page = append(page, k)
+			}
+			pages = append(pages, page)
+			if storageRange.Next == nil {
+				break
+			}
+			// Read every page from the same state
+			param.Height = storageRange.Height
+			param.After = storageRange.Next
+		}
+		assert.Equal(t, [][]byte{{1, 2}, {3, 4}, {5}}, pages)
+	})
+
+	t.Run("GetAccountActivity", func(t *testing.T) {
+		cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		address := rpctest.PrivateAccounts[3].GetAddress()
+		payee := crypto.Address{7, 7}
+
+		nameTxe, err := rpctest.UpdateName(tcli, address, "activity", "data", 200)
+		require.NoError(t, err)
+		createTxe, err := rpctest.CreateContract(tcli, address, []byte{byte(asm.STOP)}, nil)
+		require.NoError(t, err)
+		sendTxe, err := tcli.SendTxSync(context.Background(), &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: address, Amount: 300}},
+			Outputs: []*payload.TxOutput{{Address: payee, Amount: 300}},
+		})
+		require.NoError(t, err)
+
+		param := &rpcquery.GetAccountActivityParam{Address: address, Limit: 2}
+		var activities []*rpcquery.Activity
+		for {
+			activity, err := cli.GetAccountActivity(context.Background(), param)
+			require.NoError(t, err)
+			activities = append(activities, activity.Activities...)
+			if len(activity.Next) == 0 {
+				break
+			}
+			param.After = activity.Next
+		}
+		require.Len(t, activities, 3)
+		for i, txe := range []*exec.TxExecution{nameTxe, createTxe, sendTxe} {
+			assert.Equal(t, txe.TxHash, activities[i].TxHash)
+			assert.Equal(t, txe.Height, activities[i].Height)
+			assert.Equal(t, txe.TxType, activities[i].TxType)
+			assert.False(t, activities[i].Time.IsZero())
+			assert.Contains(t, activities[i].Roles, rpcquery.ActivityRole_INPUT)
+		}
+		assert.Equal(t, "activity", activities[0].Name)
+		assert.Equal(t, createTxe.Receipt.ContractAddress, *activities[1].Counterparty)
+		assert.Equal(t, payee, *activities[2].Counterparty)
+		assert.Equal(t, uint64(300), activities[2].Amount)
+
+		activity, err := cli.GetAccountActivity(context.Background(),
+			&rpcquery.GetAccountActivityParam{Address: payee})
+		require.NoError(t, err)
+		require.Len(t, activity.Activities, 1)
+		assert.Equal(t, []rpcquery.ActivityRole{rpcquery.ActivityRole_OUTPUT}, activity.Activities[0].Roles)
+		assert.Equal(t, address, *activity.Activities[0].Counterparty)
+		assert.Equal(t, uint64(300), activity.Activities[0].Amount)
+		assert.Empty(t, activity.Next)
+	})
 }
 
 func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*names.Entry {
@@ -140,3 +354,44 @@ func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*name
 	}
 	return entries
 }
+
+func TestHeartbeats(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts,
+		func(conf *config.BurrowConfig) {
+			conf.Execution.HeartbeatInterval = "100ms"
+		})
+	defer shutdown()
+
+	cli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+	address := rpctest.PrivateAccounts[0].GetAddress()
+	var hb *heartbeat.Heartbeat
+	var err error
+	for i := 0; i < 100; i++ {
+		hb, err = cli.GetHeartbeat(context.Background(), &rpcquery.GetHeartbeatParam{Address: address})
+		if err == nil && hb.Count > 1 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, address, hb.Address)
+	assert.True(t, hb.Count > 1)
+	assert.True(t, hb.ObservedHeight > 0 && hb.ObservedHeight < hb.Height)
+	blockHash, err := kern.Blockchain.BlockHash(hb.ObservedHeight)
+	require.NoError(t, err)
+	assert.Equal(t, binary.HexBytes(blockHash), hb.BlockHash)
+
+	stream, err := cli.ListHeartbeats(context.Background(), &rpcquery.ListHeartbeatsParam{
+		Query: fmt.Sprintf("Address = '%v'", address),
+	})
+	require.NoError(t, err)
+	listed, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, address, listed.Address)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = cli.GetHeartbeat(context.Background(),
+		&rpcquery.GetHeartbeatParam{Address: rpctest.PrivateAccounts[1].GetAddress()})
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcexplorer"
 	"github.com/hyperledger/burrow/rpc/rpcinfo/infoclient"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
@@ -49,6 +50,12 @@ func NewQueryClient(t testing.TB, listenAddress string) rpcquery.QueryClient {
 	return rpcquery.NewQueryClient(conn)
 }
 
+func NewExplorerClient(t testing.TB, listenAddress string) rpcexplorer.ExplorerClient {
+	conn, err := grpc.Dial(listenAddress, grpc.WithInsecure())
+	require.NoError(t, err)
+	return rpcexplorer.NewExplorerClient(conn)
+}
+
 type MetadataMap struct {
 	DeployedCode []byte
 	Abi          []byte
@@ -2,4 +2,5 @@ export { Burrow } from './lib/burrow';
 export { Contract } from './lib/contracts/contract';
 export * from './lib/utils/utils';
 export { TxInput, CallTx } from '../proto/payload_pb'
-export { TxExecution } from '../proto/exec_pb';
\ No newline at end of file
+export { TxExecution } from '../proto/exec_pb';
+export { EventStream } from './lib/events';
@@ -11,6 +11,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/hyperledger/burrow/logging/loggers"
+	"github.com/hyperledger/burrow/logging/structure"
 )
 
 type LoggingConfig struct {
@@ -72,9 +73,6 @@ func (lc *LoggingConfig) NewLogger() (*logging.Logger, error) {
 		return nil, err
 	}
 	logger := logging.NewLogger(outputLogger)
-	if !lc.Trace {
-		logger.Trace = log.NewNopLogger()
-	}
 	go func() {
 		err := <-errCh.Out()
 		if err != nil {
@@ -102,6 +100,10 @@ func newLogger(loggingConfig *LoggingConfig) (log.Logger, channels.Channel, erro
 	}
 	var errCh channels.Channel = channels.NewDeadChannel()
 	var logger log.Logger = loggers.NewBurrowFormatLogger(outputLogger)
+	if !loggingConfig.Trace {
+		// Drop the Trace channel from the output rather than the logger so that it can be enabled by UpdateLogger
+		logger = loggers.FilterLogger(logger, isTrace)
+	}
 	if loggingConfig.NonBlocking {
 		logger, errCh = loggers.NonBlockingLogger(logger)
 		return logger, errCh, nil
@@ -109,6 +111,10 @@ func newLogger(loggingConfig *LoggingConfig) (log.Logger, channels.Channel, erro
 	return logger, errCh, err
 }
 
+func isTrace(keyvals []interface{}) bool {
+	return structure.Value(keyvals, structure.ChannelKey) == structure.TraceChannelName
+}
+
 func TOMLString(v interface{}) string {
 	buf := new(bytes.Buffer)
 	encoder := toml.NewEncoder(buf)
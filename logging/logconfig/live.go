@@ -0,0 +1,77 @@
+package logconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Live holds the logging configuration of a running node so that it can be inspected and changed without a restart
+// by hot swapping the output of the node's logger
+type Live struct {
+	sync.Mutex
+	config *LoggingConfig
+	logger *logging.Logger
+}
+
+// Returns a Live config for logger, which must have been obtained from config
+func NewLive(config *LoggingConfig, logger *logging.Logger) *Live {
+	return &Live{
+		config: config,
+		logger: logger,
+	}
+}
+
+// Returns a copy of the current logging configuration
+func (live *Live) Config() *LoggingConfig {
+	live.Lock()
+	defer live.Unlock()
+	config, err := live.config.copy()
+	if err != nil {
+		// Our config always round-trips
+		panic(err)
+	}
+	return config
+}
+
+// Applies update to a copy of the current logging configuration and swaps the output of the logger for one built from
+// it, leaving the configuration unchanged if update fails or the logger cannot be built
+func (live *Live) Update(update func(config *LoggingConfig) error) (*LoggingConfig, error) {
+	live.Lock()
+	defer live.Unlock()
+	config, err := live.config.copy()
+	if err != nil {
+		return nil, err
+	}
+	err = update(config)
+	if err != nil {
+		return nil, err
+	}
+	errCh, err := config.UpdateLogger(live.logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not update logger: %v", err)
+	}
+	go func() {
+		err := <-errCh.Out()
+		if err != nil {
+			fmt.Printf("Logging error: %v", err)
+		}
+	}()
+	live.config = config
+	return config.copy()
+}
+
+func (lc *LoggingConfig) copy() (*LoggingConfig, error) {
+	bs, err := json.Marshal(lc)
+	if err != nil {
+		return nil, err
+	}
+	config := new(LoggingConfig)
+	err = json.Unmarshal(bs, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
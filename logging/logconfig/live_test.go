@@ -0,0 +1,74 @@
+package logconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLive_Update(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-live-logging")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "node.log")
+
+	config := &LoggingConfig{
+		RootSink: Sink().AddSinks(Sink().SetOutput(FileOutput(file).SetFormat(JSONFormat))),
+	}
+	logger, err := config.NewLogger()
+	require.NoError(t, err)
+	live := NewLive(config, logger)
+
+	logger.InfoMsg("info before")
+	logger.TraceMsg("trace before")
+
+	updated, err := live.Update(func(config *LoggingConfig) error {
+		return config.SetChannelEnabled(structure.TraceChannelName, true)
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.Trace)
+	// The config we started with is left alone
+	assert.False(t, config.Trace)
+	logger.TraceMsg("trace enabled")
+
+	_, err = live.Update(func(config *LoggingConfig) error {
+		sink, err := config.Sink("root.0")
+		if err != nil {
+			return err
+		}
+		sink.Disabled = true
+		return nil
+	})
+	require.NoError(t, err)
+	logger.InfoMsg("sink disabled")
+
+	// Failed updates change nothing
+	_, err = live.Update(func(config *LoggingConfig) error {
+		return config.SetChannelEnabled(structure.InfoChannelName, false)
+	})
+	require.Error(t, err)
+	_, err = live.Update(func(config *LoggingConfig) error {
+		_, err := config.Sink("root.1")
+		return err
+	})
+	require.Error(t, err)
+
+	bs, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	logged := string(bs)
+	assert.True(t, strings.Contains(logged, "info before"))
+	assert.False(t, strings.Contains(logged, "trace before"))
+	assert.True(t, strings.Contains(logged, "trace enabled"))
+	assert.False(t, strings.Contains(logged, "sink disabled"))
+
+	assert.Equal(t, []*SinkInfo{
+		{Path: "root", Description: "1 child sinks", Enabled: true},
+		{Path: "root.0", Description: "output file " + file + " (json)", Enabled: false},
+	}, live.Config().Sinks())
+}
@@ -151,6 +151,8 @@ type (
 		Transform *TransformConfig `json:",omitempty" toml:",omitempty"`
 		Sinks     []*SinkConfig    `json:",omitempty" toml:",omitempty"`
 		Output    *OutputConfig    `json:",omitempty" toml:",omitempty"`
+		// Drop the log lines sent to this sink rather than passing them to its transform, output, and children
+		Disabled bool `json:",omitempty" toml:",omitempty"`
 	}
 )
 
@@ -305,7 +307,7 @@ func (sinkConfig *SinkConfig) BuildLogger() (log.Logger, map[string]*loggers.Cap
 func BuildLoggerFromSinkConfig(sinkConfig *SinkConfig, captures map[string]*loggers.CaptureLogger) (log.Logger,
 	map[string]*loggers.CaptureLogger, error) {
 
-	if sinkConfig == nil {
+	if sinkConfig == nil || sinkConfig.Disabled {
 		return log.NewNopLogger(), captures, nil
 	}
 	numSinks := len(sinkConfig.Sinks)
@@ -0,0 +1,110 @@
+package logconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+// The path of the root sink, the path of each child sink appends its index amongst its siblings separated by a '.'
+const RootSinkPath = "root"
+
+// A SinkInfo describes a sink of a logging configuration so that it can be toggled by its path
+type SinkInfo struct {
+	Path        string
+	Description string
+	Enabled     bool
+}
+
+// Returns whether the named logging channel is enabled
+func (lc *LoggingConfig) ChannelEnabled(channel string) (bool, error) {
+	switch channel {
+	case structure.InfoChannelName:
+		return true, nil
+	case structure.TraceChannelName:
+		return lc.Trace, nil
+	default:
+		return false, fmt.Errorf("unknown logging channel '%s', expected %s or %s", channel,
+			structure.InfoChannelName, structure.TraceChannelName)
+	}
+}
+
+func (lc *LoggingConfig) SetChannelEnabled(channel string, enabled bool) error {
+	switch channel {
+	case structure.InfoChannelName:
+		if !enabled {
+			return fmt.Errorf("the %s channel cannot be disabled, disable its sinks instead", channel)
+		}
+		return nil
+	case structure.TraceChannelName:
+		lc.Trace = enabled
+		return nil
+	default:
+		return fmt.Errorf("unknown logging channel '%s', expected %s or %s", channel,
+			structure.InfoChannelName, structure.TraceChannelName)
+	}
+}
+
+// Lists the sinks of the config with the root sink first and each sink before its children
+func (lc *LoggingConfig) Sinks() []*SinkInfo {
+	var infos []*SinkInfo
+	var walk func(path string, sinkConfig *SinkConfig)
+	walk = func(path string, sinkConfig *SinkConfig) {
+		infos = append(infos, &SinkInfo{
+			Path:        path,
+			Description: sinkConfig.Describe(),
+			Enabled:     !sinkConfig.Disabled,
+		})
+		for i, sc := range sinkConfig.Sinks {
+			walk(fmt.Sprintf("%s.%d", path, i), sc)
+		}
+	}
+	if lc.RootSink != nil {
+		walk(RootSinkPath, lc.RootSink)
+	}
+	return infos
+}
+
+// Returns the sink at path
+func (lc *LoggingConfig) Sink(path string) (*SinkConfig, error) {
+	indices := strings.Split(path, ".")
+	if indices[0] != RootSinkPath || lc.RootSink == nil {
+		return nil, fmt.Errorf("no logging sink at path '%s'", path)
+	}
+	sinkConfig := lc.RootSink
+	for _, index := range indices[1:] {
+		i, err := strconv.Atoi(index)
+		if err != nil || i < 0 || i >= len(sinkConfig.Sinks) {
+			return nil, fmt.Errorf("no logging sink at path '%s'", path)
+		}
+		sinkConfig = sinkConfig.Sinks[i]
+	}
+	return sinkConfig, nil
+}
+
+// Returns a short human-readable summary of the transform and output of the sink
+func (sinkConfig *SinkConfig) Describe() string {
+	var parts []string
+	if sinkConfig.Transform != nil && sinkConfig.Transform.TransformType != NoTransform {
+		parts = append(parts, fmt.Sprintf("transform %s", sinkConfig.Transform.TransformType))
+	}
+	if sinkConfig.Output != nil && sinkConfig.Output.OutputType != NoOutput {
+		output := fmt.Sprintf("output %s", sinkConfig.Output.OutputType)
+		if sinkConfig.Output.FileConfig != nil {
+			output += " " + sinkConfig.Output.FileConfig.Path
+		}
+		if sinkConfig.Output.Format != "" {
+			output += fmt.Sprintf(" (%s)", sinkConfig.Output.Format)
+		}
+		parts = append(parts, output)
+	}
+	if len(sinkConfig.Sinks) > 0 {
+		parts = append(parts, fmt.Sprintf("%d child sinks", len(sinkConfig.Sinks)))
+	}
+	if len(parts) == 0 {
+		return "no output"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,37 @@
+package loggers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+)
+
+const Redacted = "[redacted]"
+
+// Creates a logger that replaces any occurrence of the strings returned by secrets in the keys and values of lines
+// logged to it before passing them on to the underlying output logger. secrets is called for each line so the set of
+// secrets may grow as they become known.
+func RedactLogger(outputLogger log.Logger, secrets func() []string) log.Logger {
+	return log.LoggerFunc(func(keyvals ...interface{}) error {
+		ss := secrets()
+		if len(ss) == 0 {
+			return outputLogger.Log(keyvals...)
+		}
+		redacted := make([]interface{}, len(keyvals))
+		for i, kv := range keyvals {
+			redacted[i] = kv
+			str, ok := kv.(string)
+			if !ok {
+				str = fmt.Sprint(kv)
+			}
+			for _, secret := range ss {
+				if secret != "" && strings.Contains(str, secret) {
+					str = strings.ReplaceAll(str, secret, Redacted)
+					redacted[i] = str
+				}
+			}
+		}
+		return outputLogger.Log(redacted...)
+	})
+}
@@ -0,0 +1,226 @@
+// Package logquery searches the log lines written by file sinks so that a node's logs can be investigated without
+// external log infrastructure
+package logquery
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logfmt/logfmt"
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+// Log lines can be long (for example those holding a transaction) so allow for more than bufio's default
+const maxLineSize = 1 << 20
+
+var templateActionRegex = regexp.MustCompile(`{{[^}]*}}`)
+
+// Query selects log lines, a zero value field places no constraint on the lines selected
+type Query struct {
+	// Lines must be on one of these channels (e.g. Info or Trace)
+	Channels []string
+	// Lines must have one of these levels (e.g. info or error)
+	Levels []string
+	// Lines must be timestamped at or after Since
+	Since time.Time
+	// Lines must be timestamped before Until
+	Until time.Time
+	// Lines must mention this transaction hash, either under the tx_hash key or within any other value
+	TxHash string
+}
+
+// Line is a log line that matched a query
+type Line struct {
+	// The file the line was read from
+	File string
+	// The line as written to file
+	Raw string
+	// Its values by key (where a key appears more than once the last value is kept)
+	Values map[string]string
+}
+
+// Files returns the files written by the file outputs of the sinks of config. A path containing template actions
+// (such as {{.Timestamp}}) is expanded to every file it could have produced.
+func Files(config *logconfig.LoggingConfig) ([]string, error) {
+	if config == nil {
+		return nil, nil
+	}
+	var files []string
+	seen := make(map[string]bool)
+	var walk func(sink *logconfig.SinkConfig) error
+	walk = func(sink *logconfig.SinkConfig) error {
+		if sink == nil {
+			return nil
+		}
+		if sink.Output != nil && sink.Output.OutputType == logconfig.File && sink.Output.FileConfig != nil {
+			matches, err := filepath.Glob(templateActionRegex.ReplaceAllString(sink.Output.FileConfig.Path, "*"))
+			if err != nil {
+				return fmt.Errorf("could not expand log file path %s: %v", sink.Output.FileConfig.Path, err)
+			}
+			sort.Strings(matches)
+			for _, match := range matches {
+				if !seen[match] {
+					seen[match] = true
+					files = append(files, match)
+				}
+			}
+		}
+		for _, child := range sink.Sinks {
+			err := walk(child)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return files, walk(config.RootSink)
+}
+
+// Search passes every line of files that matches query to fn, file by file in the order given. An error returned by
+// fn stops the search and is returned as is.
+func (query *Query) Search(files []string, fn func(line *Line) error) error {
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		err = query.Scan(file, f, fn)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Scan passes every line read from r that matches query to fn. Lines may be in JSON or logfmt format (as written by
+// the json, logfmt, and terminal formats), lines in neither format are skipped.
+func (query *Query) Scan(file string, r io.Reader, fn func(line *Line) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		values, err := parseLine(raw)
+		if err != nil {
+			continue
+		}
+		line := &Line{
+			File:   file,
+			Raw:    string(raw),
+			Values: values,
+		}
+		if query.Matches(line) {
+			err = fn(line)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read %s: %v", file, err)
+	}
+	return nil
+}
+
+// Matches reports whether line is selected by query
+func (query *Query) Matches(line *Line) bool {
+	if len(query.Channels) > 0 && !oneOf(line.Values[structure.ChannelKey], query.Channels) {
+		return false
+	}
+	if len(query.Levels) > 0 && !oneOf(line.Values[structure.LevelKey], query.Levels) {
+		return false
+	}
+	if !query.Since.IsZero() || !query.Until.IsZero() {
+		timestamp, err := time.Parse(time.RFC3339Nano, line.Values[structure.TimeKey])
+		if err != nil {
+			// We cannot place a line without a timestamp in time
+			return false
+		}
+		if !query.Since.IsZero() && timestamp.Before(query.Since) {
+			return false
+		}
+		if !query.Until.IsZero() && !timestamp.Before(query.Until) {
+			return false
+		}
+	}
+	if query.TxHash != "" && !mentions(line, query.TxHash) {
+		return false
+	}
+	return true
+}
+
+func mentions(line *Line, txHash string) bool {
+	txHash = strings.ToUpper(strings.TrimPrefix(txHash, "0x"))
+	if strings.ToUpper(line.Values[structure.TxHashKey]) == txHash {
+		return true
+	}
+	for _, value := range line.Values {
+		if strings.Contains(strings.ToUpper(value), txHash) {
+			return true
+		}
+	}
+	return false
+}
+
+func oneOf(value string, options []string) bool {
+	for _, option := range options {
+		if strings.EqualFold(value, option) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLine(raw []byte) (map[string]string, error) {
+	if raw[0] == '{' {
+		fields := make(map[string]interface{})
+		err := json.Unmarshal(raw, &fields)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]string, len(fields))
+		for key, value := range fields {
+			switch v := value.(type) {
+			case string:
+				values[key] = v
+			default:
+				bs, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				values[key] = string(bs)
+			}
+		}
+		return values, nil
+	}
+	values := make(map[string]string)
+	decoder := logfmt.NewDecoder(bytes.NewReader(raw))
+	for decoder.ScanRecord() {
+		for decoder.ScanKeyval() {
+			// Free text parses as keys without values which we do not count as log values
+			if decoder.Value() != nil {
+				values[string(decoder.Key())] = string(decoder.Value())
+			}
+		}
+	}
+	if decoder.Err() != nil {
+		return nil, decoder.Err()
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no values in log line")
+	}
+	return values, nil
+}
@@ -0,0 +1,74 @@
+package logquery
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const logLines = `{"log_channel":"Info","message":"Executing transaction","tx_hash":"A1B2C3","time":"2020-03-01T10:00:00Z","height":5}
+{"log_channel":"Trace","message":"Sending event","time":"2020-03-01T10:00:01Z"}
+not a log line {
+log_channel=Info level=error message="could not apply tx a1b2c3" time=2020-03-01T10:00:02Z
+log_channel=Info level=info message="committed block" time=2020-03-01T10:00:03Z
+`
+
+func TestQuery_Scan(t *testing.T) {
+	scan := func(query *Query) []string {
+		var messages []string
+		err := query.Scan("test.log", strings.NewReader(logLines), func(line *Line) error {
+			messages = append(messages, line.Values["message"])
+			return nil
+		})
+		require.NoError(t, err)
+		return messages
+	}
+
+	assert.Len(t, scan(&Query{}), 4)
+	assert.Equal(t, []string{"Sending event"}, scan(&Query{Channels: []string{"trace"}}))
+	assert.Equal(t, []string{"could not apply tx a1b2c3"}, scan(&Query{Levels: []string{"ERROR"}}))
+	assert.Equal(t, []string{"Executing transaction", "could not apply tx a1b2c3"},
+		scan(&Query{TxHash: "0xa1b2c3"}))
+	assert.Equal(t, []string{"Sending event", "could not apply tx a1b2c3"}, scan(&Query{
+		Since: time.Date(2020, 3, 1, 10, 0, 1, 0, time.UTC),
+		Until: time.Date(2020, 3, 1, 10, 0, 3, 0, time.UTC),
+	}))
+}
+
+func TestFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logquery")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"burrow_a.log", "burrow_b.log", "other.log"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(logLines), 0644))
+	}
+	config := &logconfig.LoggingConfig{
+		RootSink: logconfig.Sink().
+			SetOutput(logconfig.StderrOutput()).
+			AddSinks(
+				logconfig.Sink().SetOutput(logconfig.FileOutput(filepath.Join(dir, "burrow_{{.Timestamp}}.log"))),
+				logconfig.Sink().SetOutput(logconfig.FileOutput(filepath.Join(dir, "other.log")))),
+	}
+	files, err := Files(config)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "burrow_a.log"),
+		filepath.Join(dir, "burrow_b.log"),
+		filepath.Join(dir, "other.log"),
+	}, files)
+
+	count := 0
+	err = (&Query{TxHash: "A1B2C3"}).Search(files, func(line *Line) error {
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 6, count)
+}
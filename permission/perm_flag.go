@@ -53,14 +53,25 @@ const (
 	AddRole
 	RemoveRole
 
-	NumPermissions uint = 18 // NOTE Adjust this too. We can support upto 64
+	// Emit permits a contract to emit EVM log events beyond the size and count thresholds set in the chain parameters
+	// (which are unlimited by default). Unsetting it quarantines a noisy contract without otherwise freezing it.
+	Emit
+
+	// Pause permits an account to pause and unpause any contract with a PermsTx, so that calls to the contract are
+	// refused until it is unpaused. An account may also pause a single contract by holding the role PauseRole(address).
+	Pause
+
+	// Schedule permits an account to register recurring calls with the Cron native contract
+	Schedule
+
+	NumPermissions uint = 21 // NOTE Adjust this too. We can support upto 64
 
 	// To allow an operation with no permission flags set at all
 	None PermFlag = 0
 
 	TopPermFlag      PermFlag = 1 << (NumPermissions - 1)
 	AllPermFlags     PermFlag = TopPermFlag | (TopPermFlag - 1)
-	DefaultPermFlags PermFlag = Send | Call | CreateContract | CreateAccount | Bond | Name | HasBase | HasRole | Proposal | Input | Batch
+	DefaultPermFlags PermFlag = Send | Call | CreateContract | CreateAccount | Bond | Name | HasBase | HasRole | Proposal | Input | Batch | Emit
 
 	// Chain permissions strings
 	RootString           = "root"
@@ -74,6 +85,9 @@ const (
 	ProposalString       = "proposal"
 	InputString          = "input"
 	BatchString          = "batch"
+	EmitString           = "emit"
+	PauseString          = "pause"
+	ScheduleString       = "schedule"
 
 	// Moderator permissions strings
 	HasBaseString    = "hasBase"
@@ -138,6 +152,12 @@ func (pf PermFlag) String() string {
 		return AddRoleString
 	case RemoveRole:
 		return RemoveRoleString
+	case Emit:
+		return EmitString
+	case Pause:
+		return PauseString
+	case Schedule:
+		return ScheduleString
 	default:
 		return UnknownString
 	}
@@ -185,6 +205,12 @@ func PermStringToFlag(perm string) (PermFlag, error) {
 		return AddRole, nil
 	case RemoveRoleString, "removerole", "rmrole", "rm_role":
 		return RemoveRole, nil
+	case EmitString:
+		return Emit, nil
+	case PauseString:
+		return Pause, nil
+	case ScheduleString:
+		return Schedule, nil
 	default:
 		return 0, fmt.Errorf("unknown permission %s", perm)
 	}
@@ -8,7 +8,7 @@ import (
 )
 
 func TestAllPermissions(t *testing.T) {
-	assert.Equal(t, AllPermFlags, DefaultPermFlags|AddRole|RemoveRole|SetBase|UnsetBase|Root|SetGlobal|Proposal|Identify)
+	assert.Equal(t, AllPermFlags, DefaultPermFlags|AddRole|RemoveRole|SetBase|UnsetBase|Root|SetGlobal|Proposal|Identify|Pause|Schedule)
 }
 
 func TestName(t *testing.T) {
@@ -37,7 +37,12 @@ func (pa PermArgs) EnsureValid() error {
 	if pa.Target == nil && pf != SetGlobal {
 		return fmt.Errorf("PermArgs for PermFlag %v requires Address to be provided but was nil", pf)
 	}
-	if pf == HasRole || pf == AddRole || pf == RemoveRole {
+	if pf == Pause {
+		// Value
+		if pa.Value == nil {
+			return fmt.Errorf("PermArgs for PermFlag %v requires Value to be provided but was nil", pf)
+		}
+	} else if pf == HasRole || pf == AddRole || pf == RemoveRole {
 		// Role
 		if pa.Role == nil {
 			return fmt.Errorf("PermArgs for PermFlag %v requires Role to be provided but was nil", pf)
@@ -108,3 +113,16 @@ func RemoveRoleArgs(address crypto.Address, role string) PermArgs {
 		Role:   &role,
 	}
 }
+
+func PauseArgs(address crypto.Address, paused bool) PermArgs {
+	return PermArgs{
+		Action: Pause,
+		Target: &address,
+		Value:  &paused,
+	}
+}
+
+// PauseRole is the role that permits its holder to pause and unpause the contract at address
+func PauseRole(address crypto.Address) string {
+	return PauseString + ":" + address.String()
+}
@@ -30,7 +30,7 @@ func TestBasePermissionsToStringList(t *testing.T) {
 
 	permStrings = BasePermissionsToStringList(allSetBasePermission(AllPermFlags))
 	assert.Equal(t, []string{"root", "send", "call", "createContract", "createAccount", "bond", "name", "proposal", "input", "batch", "identify", "hasBase",
-		"setBase", "unsetBase", "setGlobal", "hasRole", "addRole", "removeRole"}, permStrings)
+		"setBase", "unsetBase", "setGlobal", "hasRole", "addRole", "removeRole", "emit", "pause", "schedule"}, permStrings)
 
 	permStrings = BasePermissionsToStringList(allSetBasePermission(AllPermFlags + 1))
 	assert.Equal(t, []string{}, permStrings)
@@ -39,7 +39,7 @@ func TestBasePermissionsToStringList(t *testing.T) {
 func TestBasePermissionsString(t *testing.T) {
 	permissionString := BasePermissionsString(allSetBasePermission(AllPermFlags &^ Root))
 	assert.Equal(t, "send | call | createContract | createAccount | bond | name | proposal | input | batch | identify | hasBase | "+
-		"setBase | unsetBase | setGlobal | hasRole | addRole | removeRole", permissionString)
+		"setBase | unsetBase | setGlobal | hasRole | addRole | removeRole | emit | pause | schedule", permissionString)
 }
 
 func allSetBasePermission(perms PermFlag) BasePermissions {
@@ -0,0 +1,134 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+type BootStatus string
+
+const (
+	BootRunning  BootStatus = "running"
+	BootOK       BootStatus = "ok"
+	BootFailed   BootStatus = "failed"
+	BootDisabled BootStatus = "disabled"
+)
+
+// BootStep records how a stage of booting a node ended and how long it took
+type BootStep struct {
+	Name     string
+	Status   BootStatus
+	Started  time.Time
+	Duration time.Duration
+	// Describes what the stage found or did, e.g. the height of the state loaded
+	Detail string `json:",omitempty"`
+	Error  string `json:",omitempty"`
+}
+
+// BootReport records each stage of booting a node, in the order they were started, so that slow or failing starts can
+// be diagnosed. It is safe for concurrent use.
+type BootReport struct {
+	sync.Mutex
+	Started time.Time
+	// Zero until booting has finished
+	Finished time.Time
+	Steps    []*BootStep
+}
+
+func NewBootReport() *BootReport {
+	return &BootReport{
+		Started: time.Now(),
+	}
+}
+
+// Start records the start of the named stage and returns a function to call with its outcome and a description of what
+// it found or did (which may be empty) when it ends
+func (br *BootReport) Start(name string) func(err error, detail string) {
+	step := &BootStep{
+		Name:    name,
+		Status:  BootRunning,
+		Started: time.Now(),
+	}
+	br.Lock()
+	br.Steps = append(br.Steps, step)
+	br.Unlock()
+	return func(err error, detail string) {
+		br.Lock()
+		defer br.Unlock()
+		step.Duration = time.Since(step.Started)
+		step.Status = BootOK
+		if err != nil {
+			step.Status = BootFailed
+			step.Error = err.Error()
+		}
+		step.Detail = detail
+	}
+}
+
+// Time records the stage run by fn
+func (br *BootReport) Time(name string, fn func() error) error {
+	done := br.Start(name)
+	err := fn()
+	done(err, "")
+	return err
+}
+
+// Disabled records a stage that was skipped
+func (br *BootReport) Disabled(name string) {
+	br.Lock()
+	defer br.Unlock()
+	br.Steps = append(br.Steps, &BootStep{
+		Name:    name,
+		Status:  BootDisabled,
+		Started: time.Now(),
+	})
+}
+
+func (br *BootReport) Finish() {
+	br.Lock()
+	defer br.Unlock()
+	br.Finished = time.Now()
+}
+
+// Duration returns how long booting took, or has taken so far if it has not finished
+func (br *BootReport) Duration() time.Duration {
+	br.Lock()
+	defer br.Unlock()
+	if br.Finished.IsZero() {
+		return time.Since(br.Started)
+	}
+	return br.Finished.Sub(br.Started)
+}
+
+// Copy returns a snapshot of the report
+func (br *BootReport) Copy() *BootReport {
+	br.Lock()
+	defer br.Unlock()
+	cp := &BootReport{
+		Started:  br.Started,
+		Finished: br.Finished,
+		Steps:    make([]*BootStep, len(br.Steps)),
+	}
+	for i, step := range br.Steps {
+		stepCopy := *step
+		cp.Steps[i] = &stepCopy
+	}
+	return cp
+}
+
+// String formats the report as a table of stages
+func (br *BootReport) String() string {
+	report := br.Copy()
+	sb := new(strings.Builder)
+	fmt.Fprintf(sb, "Boot report (%v):\n", report.Duration().Round(time.Millisecond))
+	tw := tabwriter.NewWriter(sb, 0, 4, 2, ' ', 0)
+	for _, step := range report.Steps {
+		fmt.Fprintf(tw, "  %s\t%s\t%v\t%s\n", step.Name, step.Status, step.Duration.Round(time.Microsecond),
+			strings.TrimSpace(step.Detail+" "+step.Error))
+	}
+	tw.Flush()
+	return sb.String()
+}
@@ -0,0 +1,37 @@
+package process
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootReport(t *testing.T) {
+	report := NewBootReport()
+	done := report.Start("state")
+	assert.Equal(t, BootRunning, report.Copy().Steps[0].Status)
+	done(nil, "height 42")
+	err := report.Time("keys", func() error {
+		return fmt.Errorf("could not reach keys server")
+	})
+	require.Error(t, err)
+	report.Disabled("tendermint")
+	assert.True(t, report.Copy().Finished.IsZero())
+	report.Finish()
+
+	cp := report.Copy()
+	assert.False(t, cp.Finished.IsZero())
+	require.Len(t, cp.Steps, 3)
+	assert.Equal(t, &BootStep{Name: "state", Status: BootOK, Started: cp.Steps[0].Started,
+		Duration: cp.Steps[0].Duration, Detail: "height 42"}, cp.Steps[0])
+	assert.Equal(t, BootFailed, cp.Steps[1].Status)
+	assert.Equal(t, "could not reach keys server", cp.Steps[1].Error)
+	assert.Equal(t, BootDisabled, cp.Steps[2].Status)
+
+	str := report.String()
+	assert.Contains(t, str, "height 42")
+	assert.Contains(t, str, "could not reach keys server")
+	assert.Contains(t, str, "disabled")
+}
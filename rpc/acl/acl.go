@@ -0,0 +1,117 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"google.golang.org/grpc/metadata"
+)
+
+// The identity of clients that present no token
+const AnonymousIdentity = "anonymous"
+
+// The gRPC metadata key from which a client's bearer token is read
+const AuthorizationKey = "authorization"
+
+const bearerPrefix = "Bearer "
+
+// ACL restricts the transactions that RPC clients may broadcast through this node according to the identity they
+// authenticated as with RPC auth (clients that present no token, or call when RPC auth is disabled, have the identity
+// AnonymousIdentity). A nil ACL permits everything.
+type ACL struct {
+	sync.RWMutex
+	config *Config
+	// Rules by identity
+	rules  map[string][]*rule
+	logger *logging.Logger
+}
+
+func New(config *Config, logger *logging.Logger) (*ACL, error) {
+	acl := &ACL{
+		config: config,
+		logger: logger.WithScope("BroadcastACL"),
+	}
+	err := acl.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+// Re-read the rules file, keeping the existing rules in force if it cannot be loaded
+func (acl *ACL) Reload() error {
+	if acl == nil {
+		return nil
+	}
+	rules := acl.config.Rules
+	if acl.config.RulesFile != "" {
+		file := new(rulesFile)
+		err := source.FromFile(acl.config.RulesFile, file)
+		if err != nil {
+			return fmt.Errorf("could not load broadcast ACL rules from %s: %v", acl.config.RulesFile, err)
+		}
+		rules = append(append([]*Rule{}, rules...), file.Rules...)
+	}
+	compiled := make(map[string][]*rule)
+	for _, r := range rules {
+		cr, err := r.compile()
+		if err != nil {
+			return err
+		}
+		compiled[r.Identity] = append(compiled[r.Identity], cr)
+	}
+	acl.Lock()
+	defer acl.Unlock()
+	acl.rules = compiled
+	acl.logger.InfoMsg("Loaded broadcast ACL", "rules", len(rules))
+	return nil
+}
+
+// Returns an error if the authenticated identity (as returned by auth.Identity) may not broadcast txEnv
+func (acl *ACL) Authorize(identity string, txEnv *txs.Envelope) error {
+	if acl == nil {
+		return nil
+	}
+	acl.RLock()
+	rules, ok := acl.rules[identity]
+	acl.RUnlock()
+	if !ok {
+		return errors.Errorf(errors.Codes.PermissionDenied, "identity '%s' may not broadcast transactions", identity)
+	}
+	ty := txEnv.Tx.Type()
+	inputs := txEnv.Tx.GetInputs()
+	for _, r := range rules {
+		if r.permits(ty, inputs) {
+			return nil
+		}
+	}
+	acl.logger.InfoMsg("Rejected broadcast", "identity", identity, "tx_type", ty.String(),
+		"tx_hash", txEnv.Tx.Hash())
+	return errors.Errorf(errors.Codes.PermissionDenied, "identity '%s' may not broadcast %v from these inputs",
+		identity, ty)
+}
+
+// Returns the bearer token presented in the incoming metadata of ctx or the empty string if there is none
+func Token(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(AuthorizationKey)
+	if len(values) == 0 {
+		return "", nil
+	}
+	if len(values) > 1 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", fmt.Errorf("expected a single '%s: %s<token>' metadata value", AuthorizationKey, bearerPrefix)
+	}
+	return strings.TrimPrefix(values[0], bearerPrefix), nil
+}
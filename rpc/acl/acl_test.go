@@ -0,0 +1,89 @@
+package acl
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACL_Authorize(t *testing.T) {
+	alice := crypto.Address{1}
+	bob := crypto.Address{2}
+	dir, err := ioutil.TempDir("", "acl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rulesFile := path.Join(dir, "rules.toml")
+	require.NoError(t, ioutil.WriteFile(rulesFile, []byte(`
+[[Rules]]
+  Identity = "orgB"
+  Payloads = ["SendTx"]
+`), 0600))
+
+	acl, err := New(&Config{
+		RulesFile: rulesFile,
+		Rules: []*Rule{
+			{Identity: "orgA", Inputs: []string{alice.String()}},
+			{Identity: AnonymousIdentity, Payloads: []string{"NameTx"}},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	callFrom := func(address crypto.Address) *txs.Envelope {
+		return txs.Enclose("test", &payload.CallTx{Input: &payload.TxInput{Address: address}})
+	}
+	sendFrom := func(address crypto.Address) *txs.Envelope {
+		return txs.Enclose("test", &payload.SendTx{Inputs: []*payload.TxInput{{Address: address}}})
+	}
+	nameFrom := func(address crypto.Address) *txs.Envelope {
+		return txs.Enclose("test", &payload.NameTx{Input: &payload.TxInput{Address: address}})
+	}
+	denied := func(err error) {
+		t.Helper()
+		assert.Equal(t, errors.Codes.PermissionDenied, errors.GetCode(err))
+	}
+
+	orgA := "orgA"
+	require.NoError(t, acl.Authorize(orgA, callFrom(alice)))
+	require.NoError(t, acl.Authorize(orgA, sendFrom(alice)))
+	denied(acl.Authorize(orgA, callFrom(bob)))
+
+	orgB := "orgB"
+	require.NoError(t, acl.Authorize(orgB, sendFrom(bob)))
+	denied(acl.Authorize(orgB, callFrom(bob)))
+
+	denied(acl.Authorize("orgC", nameFrom(bob)))
+	require.NoError(t, acl.Authorize(AnonymousIdentity, nameFrom(bob)))
+	denied(acl.Authorize(AnonymousIdentity, callFrom(bob)))
+
+	// Rules can be changed without a restart
+	require.NoError(t, ioutil.WriteFile(rulesFile, []byte(`
+[[Rules]]
+  Identity = "orgB"
+  Payloads = ["CallTx"]
+`), 0600))
+	require.NoError(t, acl.Reload())
+	require.NoError(t, acl.Authorize(orgB, callFrom(bob)))
+	denied(acl.Authorize(orgB, sendFrom(bob)))
+
+	// Bad rules leave the existing ones in force
+	require.NoError(t, ioutil.WriteFile(rulesFile, []byte(`
+[[Rules]]
+  Identity = "orgB"
+  Payloads = ["NotATx"]
+`), 0600))
+	require.Error(t, acl.Reload())
+	require.NoError(t, acl.Authorize(orgB, callFrom(bob)))
+
+	// A nil ACL permits everything
+	var none *ACL
+	require.NoError(t, none.Authorize(AnonymousIdentity, callFrom(bob)))
+}
@@ -0,0 +1,82 @@
+package acl
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Configures which RPC identities (as authenticated by RPC auth) may broadcast transactions through this node. Rules may
+// be given inline and in a separate RulesFile, which is re-read when the node receives SIGHUP so that rules can be
+// changed without a restart.
+type Config struct {
+	// A TOML or JSON file containing further rules (as a list of Rules), re-read on SIGHUP
+	RulesFile string `json:",omitempty" toml:",omitempty"`
+	// Rules that are always in force
+	Rules []*Rule `json:",omitempty" toml:",omitempty"`
+}
+
+// Grants an identity permission to broadcast. An identity may have multiple rules in which case it may broadcast any
+// transaction permitted by one of them.
+type Rule struct {
+	// The identity as authenticated by RPC auth - clients that present no token have the identity 'anonymous'
+	Identity string
+	// The payload types (e.g. CallTx) the identity may broadcast - all types if empty
+	Payloads []string `json:",omitempty" toml:",omitempty"`
+	// The input addresses the identity may broadcast transactions from - any address if empty
+	Inputs []string `json:",omitempty" toml:",omitempty"`
+}
+
+type rulesFile struct {
+	Rules []*Rule
+}
+
+// A rule with its payload types and input addresses parsed
+type rule struct {
+	payloads map[payload.Type]struct{}
+	inputs   map[crypto.Address]struct{}
+}
+
+func (r *Rule) compile() (*rule, error) {
+	if r.Identity == "" {
+		return nil, fmt.Errorf("broadcast ACL rule has no Identity")
+	}
+	compiled := &rule{
+		payloads: make(map[payload.Type]struct{}, len(r.Payloads)),
+		inputs:   make(map[crypto.Address]struct{}, len(r.Inputs)),
+	}
+	for _, name := range r.Payloads {
+		ty := payload.TxTypeFromString(name)
+		if ty == payload.TypeUnknown {
+			return nil, fmt.Errorf("payload type '%s' in broadcast ACL rule for identity '%s' not recognised",
+				name, r.Identity)
+		}
+		compiled.payloads[ty] = struct{}{}
+	}
+	for _, input := range r.Inputs {
+		address, err := crypto.AddressFromHexString(input)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse input address in broadcast ACL rule for identity '%s': %v",
+				r.Identity, err)
+		}
+		compiled.inputs[address] = struct{}{}
+	}
+	return compiled, nil
+}
+
+func (r *rule) permits(ty payload.Type, inputs []*payload.TxInput) bool {
+	if len(r.payloads) > 0 {
+		if _, ok := r.payloads[ty]; !ok {
+			return false
+		}
+	}
+	if len(r.inputs) > 0 {
+		for _, input := range inputs {
+			if _, ok := r.inputs[input.Address]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
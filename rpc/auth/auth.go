@@ -0,0 +1,219 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator establishes the identity of the bearer of a token. It returns ok false if it does not recognise the
+// form of the token so that another Authenticator may try, and an error if the token is of its form but invalid.
+type Authenticator interface {
+	Authenticate(token string) (identity string, ok bool, err error)
+}
+
+// Auth requires RPC clients to authenticate with a bearer token (as 'authorization: Bearer <token>' gRPC metadata or
+// HTTP header) before calling protected methods. A nil Auth permits everything.
+type Auth struct {
+	authenticators []Authenticator
+	rules          []*methodRule
+	logger         *logging.Logger
+}
+
+type identityKey struct{}
+
+// The methods of the admin service, which change how the node runs, so may only be called by identities that a rule
+// matching them permits. They cannot be called at all unless auth is enabled.
+const AdminMethodPrefix = "/rpcadmin.Admin/"
+
+// New returns an Auth for config, or nil if it is not enabled. Further Authenticators may be passed to accept other
+// kinds of token alongside the configured API keys and JWTs.
+func New(config *Config, logger *logging.Logger, authenticators ...Authenticator) (*Auth, error) {
+	if config == nil || !config.Enabled {
+		return nil, nil
+	}
+	auth := &Auth{
+		logger: logger.WithScope("RPCAuth"),
+	}
+	if len(config.APIKeys) > 0 {
+		keys, err := NewAPIKeyAuthenticator(config.APIKeys)
+		if err != nil {
+			return nil, err
+		}
+		auth.authenticators = append(auth.authenticators, keys)
+	}
+	if config.JWT != nil {
+		jwt, err := NewJWTAuthenticator(config.JWT)
+		if err != nil {
+			return nil, err
+		}
+		auth.authenticators = append(auth.authenticators, jwt)
+	}
+	auth.authenticators = append(auth.authenticators, authenticators...)
+	if len(auth.authenticators) == 0 {
+		return nil, fmt.Errorf("RPC auth is enabled but neither APIKeys nor JWT are configured")
+	}
+	for _, r := range config.Methods {
+		cr, err := r.compile()
+		if err != nil {
+			return nil, err
+		}
+		auth.rules = append(auth.rules, cr)
+	}
+	return auth, nil
+}
+
+// Authorize returns an error with status code Unauthenticated if method requires a token that was not given or is
+// invalid, or PermissionDenied if the identity it authenticates may not call method. Otherwise it returns the
+// identity, which is acl.AnonymousIdentity if no token was required or given.
+func (auth *Auth) Authorize(method, token string) (string, error) {
+	admin := strings.HasPrefix(method, AdminMethodPrefix)
+	if auth == nil {
+		if admin {
+			return "", status.Errorf(codes.PermissionDenied, "%s may only be called when RPC auth is enabled", method)
+		}
+		return acl.AnonymousIdentity, nil
+	}
+	rule := auth.rule(method)
+	if rule == nil && admin {
+		return "", status.Errorf(codes.PermissionDenied, "%s may only be called by identities an auth rule permits",
+			method)
+	}
+	if rule == nil && token == "" {
+		return acl.AnonymousIdentity, nil
+	}
+	if token == "" {
+		return "", status.Errorf(codes.Unauthenticated, "%s requires an authorization token", method)
+	}
+	identity, err := auth.authenticate(token)
+	if err != nil {
+		auth.logger.InfoMsg("Rejected RPC token", "method", method, "error", err)
+		return "", status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	if rule != nil && !rule.permits(identity) {
+		auth.logger.InfoMsg("Rejected RPC call", "method", method, "identity", identity)
+		return "", status.Errorf(codes.PermissionDenied, "identity '%s' may not call %s", identity, method)
+	}
+	return identity, nil
+}
+
+// AuthorizeContext authorizes a call to method with the token in the incoming gRPC metadata of ctx and returns ctx
+// carrying the authenticated identity
+func (auth *Auth) AuthorizeContext(ctx context.Context, method string) (context.Context, error) {
+	if auth == nil {
+		_, err := auth.Authorize(method, "")
+		if err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	}
+	token, err := acl.Token(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	identity, err := auth.Authorize(method, token)
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
+
+// Handler authorizes HTTP requests to next by their path with the token in their Authorization header
+func (auth *Auth) Handler(next http.Handler) http.Handler {
+	if auth == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		header := r.Header.Get(acl.AuthorizationKey)
+		if header != "" {
+			if !strings.HasPrefix(header, "Bearer ") {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "expected 'Authorization: Bearer <token>' header", http.StatusUnauthorized)
+				return
+			}
+			token = strings.TrimPrefix(header, "Bearer ")
+		}
+		identity, err := auth.Authorize(r.URL.Path, token)
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.PermissionDenied {
+				http.Error(w, st.Message(), http.StatusForbidden)
+				return
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, st.Message(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), identityKey{}, identity)))
+	})
+}
+
+// Identity returns the identity authorized to make the call whose context is ctx, or acl.AnonymousIdentity if the
+// call was not authorized
+func Identity(ctx context.Context) string {
+	identity, ok := ctx.Value(identityKey{}).(string)
+	if !ok {
+		return acl.AnonymousIdentity
+	}
+	return identity
+}
+
+func (auth *Auth) rule(method string) *methodRule {
+	for _, r := range auth.rules {
+		if r.matches(method) {
+			return r
+		}
+	}
+	return nil
+}
+
+func (auth *Auth) authenticate(token string) (string, error) {
+	for _, authenticator := range auth.authenticators {
+		identity, ok, err := authenticator.Authenticate(token)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return identity, nil
+		}
+	}
+	return "", fmt.Errorf("authorization token not recognised")
+}
+
+// APIKeyAuthenticator identifies the bearer of one of a fixed set of keys
+type APIKeyAuthenticator struct {
+	keys []*APIKey
+}
+
+func NewAPIKeyAuthenticator(keys []*APIKey) (*APIKeyAuthenticator, error) {
+	for _, key := range keys {
+		if key.Identity == "" {
+			return nil, fmt.Errorf("RPC API key has no Identity")
+		}
+		if key.Key == "" {
+			return nil, fmt.Errorf("RPC API key for identity '%s' has no Key", key.Identity)
+		}
+	}
+	return &APIKeyAuthenticator{keys: keys}, nil
+}
+
+func (aka *APIKeyAuthenticator) Authenticate(token string) (string, bool, error) {
+	for _, key := range aka.keys {
+		// Compare in constant time so keys cannot be guessed by timing
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key.Key)) == 1 {
+			return key.Identity, true, nil
+		}
+	}
+	return "", false, nil
+}
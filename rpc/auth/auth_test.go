@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const broadcast = "/rpctransact.Transact/BroadcastTxSync"
+const getAccount = "/rpcquery.Query/GetAccount"
+
+func TestAuth_Authorize(t *testing.T) {
+	secret := "jwt-secret"
+	auth, err := New(&Config{
+		Enabled: true,
+		APIKeys: []*APIKey{{Identity: "orgA", Key: "keyA"}},
+		JWT:     &JWTConfig{Secret: secret},
+		Methods: []*MethodRule{
+			{Method: "/rpcdump.Dump/*", Identities: []string{"orgA"}},
+			{Method: "/rpctransact.Transact/*"},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	code := func(err error) codes.Code {
+		return status.Code(err)
+	}
+
+	identity, err := auth.Authorize(getAccount, "")
+	require.NoError(t, err)
+	assert.Equal(t, acl.AnonymousIdentity, identity)
+
+	_, err = auth.Authorize(broadcast, "")
+	assert.Equal(t, codes.Unauthenticated, code(err))
+	_, err = auth.Authorize(broadcast, "wrong")
+	assert.Equal(t, codes.Unauthenticated, code(err))
+
+	identity, err = auth.Authorize(broadcast, "keyA")
+	require.NoError(t, err)
+	assert.Equal(t, "orgA", identity)
+
+	orgB, err := SignJWT([]byte(secret), "orgB", time.Minute)
+	require.NoError(t, err)
+	identity, err = auth.Authorize(broadcast, orgB)
+	require.NoError(t, err)
+	assert.Equal(t, "orgB", identity)
+
+	// Only orgA may dump
+	_, err = auth.Authorize("/rpcdump.Dump/GetDump", orgB)
+	assert.Equal(t, codes.PermissionDenied, code(err))
+	_, err = auth.Authorize("/rpcdump.Dump/GetDump", "keyA")
+	require.NoError(t, err)
+
+	forged, err := SignJWT([]byte("not-the-secret"), "orgA", time.Minute)
+	require.NoError(t, err)
+	_, err = auth.Authorize(broadcast, forged)
+	assert.Equal(t, codes.Unauthenticated, code(err))
+
+	expired, err := SignJWT([]byte(secret), "orgB", -time.Minute)
+	require.NoError(t, err)
+	_, err = auth.Authorize(broadcast, expired)
+	assert.Equal(t, codes.Unauthenticated, code(err))
+
+	ctx, err := auth.AuthorizeContext(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(acl.AuthorizationKey, "Bearer keyA")), broadcast)
+	require.NoError(t, err)
+	assert.Equal(t, "orgA", Identity(ctx))
+}
+
+func TestAuth_AuthorizeAdmin(t *testing.T) {
+	const setLogSpec = AdminMethodPrefix + "SetLogSpec"
+	code := func(err error) codes.Code {
+		return status.Code(err)
+	}
+
+	// Admin methods are closed without auth
+	var noAuth *Auth
+	_, err := noAuth.Authorize(setLogSpec, "")
+	assert.Equal(t, codes.PermissionDenied, code(err))
+	_, err = noAuth.AuthorizeContext(context.Background(), setLogSpec)
+	assert.Equal(t, codes.PermissionDenied, code(err))
+	_, err = noAuth.AuthorizeContext(context.Background(), getAccount)
+	require.NoError(t, err)
+
+	// And to every identity unless a rule permits them
+	auth, err := New(&Config{
+		Enabled: true,
+		APIKeys: []*APIKey{{Identity: "admin", Key: "keyAdmin"}, {Identity: "orgA", Key: "keyA"}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	_, err = auth.Authorize(setLogSpec, "keyAdmin")
+	assert.Equal(t, codes.PermissionDenied, code(err))
+
+	conf := DefaultConfig()
+	conf.Enabled = true
+	conf.APIKeys = []*APIKey{{Identity: "admin", Key: "keyAdmin"}, {Identity: "orgA", Key: "keyA"}}
+	auth, err = New(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	_, err = auth.Authorize(setLogSpec, "")
+	assert.Equal(t, codes.Unauthenticated, code(err))
+	_, err = auth.Authorize(setLogSpec, "keyA")
+	assert.Equal(t, codes.PermissionDenied, code(err))
+	identity, err := auth.Authorize(setLogSpec, "keyAdmin")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", identity)
+}
+
+func TestAuth_Handler(t *testing.T) {
+	auth, err := New(&Config{
+		Enabled: true,
+		APIKeys: []*APIKey{{Identity: "orgA", Key: "keyA"}},
+		Methods: []*MethodRule{{Method: "/rpctransact.Transact/*", Identities: []string{"orgA"}}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	handler := auth.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(Identity(r.Context())))
+	}))
+
+	call := func(path, authorization string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, path, nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := call(getAccount, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, acl.AnonymousIdentity, w.Body.String())
+
+	w = call(broadcast, "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+
+	w = call(broadcast, "Bearer keyA")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "orgA", w.Body.String())
+}
+
+func TestNew(t *testing.T) {
+	auth, err := New(DefaultConfig(), logging.NewNoopLogger())
+	require.NoError(t, err)
+	// Disabled so permits everything
+	assert.Nil(t, auth)
+	_, err = auth.Authorize(broadcast, "")
+	require.NoError(t, err)
+
+	_, err = New(&Config{Enabled: true}, logging.NewNoopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Configures which RPC methods require clients to authenticate and which identities may call them. Methods not matched
+// by any rule are open to anonymous clients, except for those of the admin service, which are closed.
+type Config struct {
+	Enabled bool
+	// Static API keys that clients may present as bearer tokens
+	APIKeys []*APIKey `json:",omitempty" toml:",omitempty"`
+	// Accept HMAC-SHA256 signed JSON Web Tokens whose subject is the identity
+	JWT *JWTConfig `json:",omitempty" toml:",omitempty"`
+	// Methods requiring authentication, the first rule matching a method applies
+	Methods []*MethodRule `json:",omitempty" toml:",omitempty"`
+}
+
+type APIKey struct {
+	// The name of the identity, used for logging and to refer to it in MethodRules
+	Identity string
+	Key      string
+}
+
+type JWTConfig struct {
+	// The shared secret tokens are signed with
+	Secret string `json:",omitempty" toml:",omitempty"`
+	// A file containing the shared secret, used in preference to Secret
+	SecretFile string `json:",omitempty" toml:",omitempty"`
+	// If set tokens must have this 'iss' claim
+	Issuer string `json:",omitempty" toml:",omitempty"`
+	// If set tokens must include this in their 'aud' claim
+	Audience string `json:",omitempty" toml:",omitempty"`
+}
+
+type MethodRule struct {
+	// The gRPC method (e.g. /rpctransact.Transact/BroadcastTxSync) or HTTP path, a trailing '*' matches any suffix
+	Method string
+	// The identities that may call the method - any authenticated identity if empty
+	Identities []string `json:",omitempty" toml:",omitempty"`
+}
+
+// By default (once enabled) transactions may only be submitted by authenticated clients, the admin service may only be
+// called by the 'admin' identity, and queries are open
+func DefaultConfig() *Config {
+	return &Config{
+		Enabled: false,
+		Methods: []*MethodRule{
+			{Method: "/rpctransact.Transact/*"},
+			{Method: AdminMethodPrefix + "*", Identities: []string{"admin"}},
+		},
+	}
+}
+
+// A rule with its identities indexed
+type methodRule struct {
+	method     string
+	prefix     bool
+	identities map[string]struct{}
+}
+
+func (r *MethodRule) compile() (*methodRule, error) {
+	if r.Method == "" {
+		return nil, fmt.Errorf("RPC auth method rule has no Method")
+	}
+	compiled := &methodRule{
+		method:     strings.TrimSuffix(r.Method, "*"),
+		prefix:     strings.HasSuffix(r.Method, "*"),
+		identities: make(map[string]struct{}, len(r.Identities)),
+	}
+	for _, identity := range r.Identities {
+		compiled.identities[identity] = struct{}{}
+	}
+	return compiled, nil
+}
+
+func (r *methodRule) matches(method string) bool {
+	if r.prefix {
+		return strings.HasPrefix(method, r.method)
+	}
+	return method == r.method
+}
+
+func (r *methodRule) permits(identity string) bool {
+	if len(r.identities) == 0 {
+		return true
+	}
+	_, ok := r.identities[identity]
+	return ok
+}
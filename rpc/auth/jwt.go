@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+// JWTAuthenticator accepts compact JSON Web Tokens signed with HS256 and identifies their bearer by the 'sub' claim
+type JWTAuthenticator struct {
+	secret   []byte
+	issuer   string
+	audience string
+	// So tests can control expiry
+	now func() time.Time
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss,omitempty"`
+	Audience  audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+}
+
+// The 'aud' claim may be a single string or an array of strings
+type audience []string
+
+func (aud *audience) UnmarshalJSON(bs []byte) error {
+	var single string
+	if json.Unmarshal(bs, &single) == nil {
+		*aud = audience{single}
+		return nil
+	}
+	return json.Unmarshal(bs, (*[]string)(aud))
+}
+
+func NewJWTAuthenticator(conf *JWTConfig) (*JWTAuthenticator, error) {
+	secret := []byte(conf.Secret)
+	if conf.SecretFile != "" {
+		bs, err := ioutil.ReadFile(conf.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read JWT secret: %v", err)
+		}
+		secret = []byte(strings.TrimSpace(string(bs)))
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("JWT authentication requires a Secret or SecretFile")
+	}
+	return &JWTAuthenticator{
+		secret:   secret,
+		issuer:   conf.Issuer,
+		audience: conf.Audience,
+		now:      time.Now,
+	}, nil
+}
+
+func (ja *JWTAuthenticator) Authenticate(token string) (string, bool, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		// Not a JWT so perhaps meant for another Authenticator
+		return "", false, nil
+	}
+	header := new(jwtHeader)
+	err := decodeSegment(parts[0], header)
+	if err != nil {
+		return "", true, fmt.Errorf("could not decode JWT header: %v", err)
+	}
+	if header.Alg != "HS256" {
+		return "", true, fmt.Errorf("JWT signing algorithm '%s' not supported, expected HS256", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", true, fmt.Errorf("could not decode JWT signature: %v", err)
+	}
+	if !hmac.Equal(signature, ja.sign(parts[0]+"."+parts[1])) {
+		return "", true, fmt.Errorf("JWT signature invalid")
+	}
+	claims := new(jwtClaims)
+	err = decodeSegment(parts[1], claims)
+	if err != nil {
+		return "", true, fmt.Errorf("could not decode JWT claims: %v", err)
+	}
+	now := ja.now().Unix()
+	if claims.ExpiresAt != 0 && now >= claims.ExpiresAt {
+		return "", true, fmt.Errorf("JWT expired")
+	}
+	if claims.NotBefore != 0 && now < claims.NotBefore {
+		return "", true, fmt.Errorf("JWT not valid yet")
+	}
+	if ja.issuer != "" && claims.Issuer != ja.issuer {
+		return "", true, fmt.Errorf("JWT issuer '%s' not accepted", claims.Issuer)
+	}
+	if ja.audience != "" && !claims.Audience.contains(ja.audience) {
+		return "", true, fmt.Errorf("JWT not intended for audience '%s'", ja.audience)
+	}
+	if claims.Subject == "" {
+		return "", true, fmt.Errorf("JWT has no subject")
+	}
+	return claims.Subject, true, nil
+}
+
+// SignJWT returns an HS256 JWT for subject that expires after ttl (or never if ttl is zero)
+func SignJWT(secret []byte, subject string, ttl time.Duration) (string, error) {
+	claims := &jwtClaims{Subject: subject}
+	if ttl != 0 {
+		claims.ExpiresAt = time.Now().Add(ttl).Unix()
+	}
+	header, err := encodeSegment(&jwtHeader{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+	ja := &JWTAuthenticator{secret: secret}
+	signingInput := header + "." + payload
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(ja.sign(signingInput)), nil
+}
+
+func (ja *JWTAuthenticator) sign(signingInput string) []byte {
+	mac := hmac.New(sha256.New, ja.secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func (aud audience) contains(audience string) bool {
+	for _, a := range aud {
+		if a == audience {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, value interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, value)
+}
+
+func encodeSegment(value interface{}) (string, error) {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
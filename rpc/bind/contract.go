@@ -0,0 +1,257 @@
+// Package bind provides the runtime for Go contract bindings generated by burrow compile --bindings go. A bound
+// contract is deployed, transacted with, and queried through the rpctransact, rpcquery, and rpcevents gRPC clients.
+// The package also generates TypeScript bindings (--bindings ts) that wrap the JavaScript client.
+package bind
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
+	"google.golang.org/grpc"
+)
+
+// The gas limit of transactions whose TransactOpts do not set one
+const DefaultGasLimit = 1000000
+
+// Backend holds the clients of the node with which bound contracts interact
+type Backend struct {
+	Transact rpctransact.TransactClient
+	Query    rpcquery.QueryClient
+	Events   rpcevents.ExecutionEventsClient
+	conn     *grpc.ClientConn
+}
+
+// Dial returns a Backend connected to the node at address whose calls are bounded, retried, and hedged according to
+// policy (rpc.DefaultCallPolicy if nil) so that they fail rather than hang if the node becomes unresponsive
+func Dial(address string, policy *rpc.CallPolicy, opts ...grpc.DialOption) (*Backend, error) {
+	if policy == nil {
+		policy = rpc.DefaultCallPolicy()
+	}
+	opts = append([]grpc.DialOption{grpc.WithInsecure()}, opts...)
+	conn, err := grpc.Dial(address, append(opts, policy.DialOptions()...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Backend{
+		Transact: rpctransact.NewTransactClient(conn),
+		Query:    rpcquery.NewQueryClient(conn),
+		Events:   rpcevents.NewExecutionEventsClient(conn),
+		conn:     conn,
+	}, nil
+}
+
+// Close closes the connection of a Backend returned by Dial
+func (backend *Backend) Close() error {
+	if backend.conn == nil {
+		return nil
+	}
+	return backend.conn.Close()
+}
+
+// TransactOpts are the parameters of a transaction that deploys or calls a contract. The transaction is signed by
+// the node so it must hold the key of the input account.
+type TransactOpts struct {
+	Input    crypto.Address
+	Amount   uint64
+	Fee      uint64
+	GasLimit uint64
+}
+
+// CallOpts are the parameters of a simulated call that queries a contract without making a transaction, a nil
+// CallOpts makes the call from the zero address
+type CallOpts struct {
+	From crypto.Address
+}
+
+// Contract is a deployed contract bound to a Backend
+type Contract struct {
+	Address crypto.Address
+	Spec    *abi.Spec
+	backend *Backend
+}
+
+// NewContract binds the contract with ABI spec at address
+func NewContract(address crypto.Address, spec *abi.Spec, backend *Backend) *Contract {
+	return &Contract{
+		Address: address,
+		Spec:    spec,
+		backend: backend,
+	}
+}
+
+// Deploy creates a contract from bytecode passing args to its constructor
+func Deploy(ctx context.Context, backend *Backend, opts *TransactOpts, spec *abi.Spec, bytecode []byte,
+	args ...interface{}) (*Contract, *exec.TxExecution, error) {
+	data := make([]byte, len(bytecode))
+	copy(data, bytecode)
+	if len(spec.Constructor.Inputs) > 0 {
+		packed, err := abi.Pack(spec.Constructor.Inputs, args...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not pack constructor arguments: %v", err)
+		}
+		data = append(data, packed...)
+	}
+	txe, err := transact(ctx, backend, opts, nil, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = exception(spec, txe)
+	if err != nil {
+		return nil, txe, err
+	}
+	return NewContract(txe.Receipt.ContractAddress, spec, backend), txe, nil
+}
+
+// Deployed reports whether there is code at the contract's address
+func (c *Contract) Deployed(ctx context.Context) (bool, error) {
+	acc, err := c.backend.Query.GetAccount(ctx, &rpcquery.GetAccountParam{Address: c.Address})
+	if err != nil {
+		return false, err
+	}
+	return acc != nil && (len(acc.EVMCode) > 0 || len(acc.WASMCode) > 0), nil
+}
+
+// Call simulates a call of method without making a transaction, which is suitable for view and pure functions, and
+// unpacks its return values into results (which must be pointers)
+func (c *Contract) Call(ctx context.Context, opts *CallOpts, method string, results []interface{},
+	args ...interface{}) error {
+	data, funcSpec, err := c.Spec.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = new(CallOpts)
+	}
+	txe, err := c.backend.Transact.CallTxSim(ctx, &payload.CallTx{
+		Input:   &payload.TxInput{Address: opts.From},
+		Address: &c.Address,
+		Data:    data,
+	})
+	if err != nil {
+		return err
+	}
+	err = exception(c.Spec, txe)
+	if err != nil {
+		return err
+	}
+	return Unpack(funcSpec.Outputs, txe.Result.Return, results...)
+}
+
+// Transact calls method in a transaction, the return values of which may be unpacked from the TxExecution with
+// UnpackReturn
+func (c *Contract) Transact(ctx context.Context, opts *TransactOpts, method string,
+	args ...interface{}) (*exec.TxExecution, error) {
+	data, _, err := c.Spec.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := transact(ctx, c.backend, opts, &c.Address, data)
+	if err != nil {
+		return nil, err
+	}
+	return txe, exception(c.Spec, txe)
+}
+
+// UnpackReturn unpacks the return values of method from a transaction that called it into results
+func (c *Contract) UnpackReturn(txe *exec.TxExecution, method string, results ...interface{}) error {
+	funcSpec, ok := c.Spec.Functions[method]
+	if !ok {
+		return fmt.Errorf("unknown function %s", method)
+	}
+	if txe.Result == nil {
+		return fmt.Errorf("transaction %v has no result", txe.TxHash)
+	}
+	return Unpack(funcSpec.Outputs, txe.Result.Return, results...)
+}
+
+// FilterLogs passes each LogEvent emitted by the contract for event within blockRange to consumer. A nil blockRange
+// selects every block to date.
+func (c *Contract) FilterLogs(ctx context.Context, event string, blockRange *rpcevents.BlockRange,
+	consumer func(log *exec.LogEvent) error) error {
+	eventSpec, ok := c.Spec.EventsByName[event]
+	if !ok {
+		return fmt.Errorf("unknown event %s", event)
+	}
+	if blockRange == nil {
+		blockRange = rpcevents.NewBlockRange(rpcevents.AbsoluteBound(0), rpcevents.LatestBound())
+	}
+	stream, err := c.backend.Events.Logs(ctx, &rpcevents.LogsRequest{
+		Address:    c.Address,
+		Signature:  binary.Word256(eventSpec.ID),
+		BlockRange: blockRange,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if ev.Log == nil {
+			continue
+		}
+		err = consumer(ev.Log)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// UnpackLog unpacks the fields of event from log into fields (which must be pointers, one per event input)
+func (c *Contract) UnpackLog(event string, log *exec.LogEvent, fields ...interface{}) error {
+	eventSpec, ok := c.Spec.EventsByName[event]
+	if !ok {
+		return fmt.Errorf("unknown event %s", event)
+	}
+	return UnpackEvent(eventSpec, log.Topics, log.Data, fields...)
+}
+
+func transact(ctx context.Context, backend *Backend, opts *TransactOpts, address *crypto.Address,
+	data []byte) (*exec.TxExecution, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("TransactOpts are required to make a transaction")
+	}
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGasLimit
+	}
+	return backend.Transact.CallTxSync(ctx, &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: opts.Input,
+			Amount:  opts.Amount,
+		},
+		Address:  address,
+		Data:     data,
+		Fee:      opts.Fee,
+		GasLimit: gasLimit,
+	})
+}
+
+// Returns the exception of a transaction as an error including any revert reason
+func exception(spec *abi.Spec, txe *exec.TxExecution) error {
+	if txe.Exception == nil {
+		return nil
+	}
+	if txe.Exception.ErrorCode() == errors.Codes.ExecutionReverted && txe.Result != nil {
+		message, err := abi.UnpackRevertWithSpec(spec, txe.Result.Return)
+		if err == nil && message != nil {
+			return fmt.Errorf("%v: %s", txe.Exception.AsError(), *message)
+		}
+	}
+	return txe.Exception.AsError()
+}
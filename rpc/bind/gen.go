@@ -0,0 +1,410 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/iancoleman/strcase"
+)
+
+// ContractSource is the compiler output from which the bindings of a contract are generated
+type ContractSource struct {
+	Name string
+	Abi  []byte
+	// Hex encoded creation bytecode, if empty no deploy function is generated
+	Bytecode string
+}
+
+const bindingsTemplateText = `// Code generated by burrow compile --bindings go. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+{{- if .UsesBig}}
+	"math/big"
+{{- end}}
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/bind"
+{{- if .UsesEvents}}
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+{{- end}}
+{{- if .UsesHex}}
+	hex "github.com/tmthrgd/go-hex"
+{{- end}}
+)
+
+// Reference imports that may not otherwise be used
+var (
+	_ = context.Background
+	_ = crypto.ZeroAddress
+	_ = exec.TxExecution{}
+)
+{{range .Contracts}}{{$contract := .}}
+// {{.Name}}Abi is the ABI of the {{.Name}} contract
+const {{.Name}}Abi = {{.AbiLiteral}}
+{{- if .Bytecode}}
+
+// {{.Name}}Bytecode is the creation bytecode of the {{.Name}} contract
+var {{.Name}}Bytecode = hex.MustDecodeString("{{.Bytecode}}")
+{{- end}}
+
+// {{.Name}} is a binding of the {{.Name}} contract
+type {{.Name}} struct {
+	*bind.Contract
+}
+{{- if .Bytecode}}
+
+// Deploy{{.Name}} deploys a new {{.Name}} contract
+func Deploy{{.Name}}(ctx context.Context, backend *bind.Backend, opts *bind.TransactOpts{{.Constructor.Params}}) (*{{.Name}}, *exec.TxExecution, error) {
+	spec, err := abi.ReadSpec([]byte({{.Name}}Abi))
+	if err != nil {
+		return nil, nil, err
+	}
+	contract, txe, err := bind.Deploy(ctx, backend, opts, spec, {{.Name}}Bytecode{{.Constructor.Args}})
+	if err != nil {
+		return nil, txe, err
+	}
+	return &{{.Name}}{Contract: contract}, txe, nil
+}
+{{- end}}
+
+// New{{.Name}} binds an instance of {{.Name}} deployed at address
+func New{{.Name}}(address crypto.Address, backend *bind.Backend) (*{{.Name}}, error) {
+	spec, err := abi.ReadSpec([]byte({{.Name}}Abi))
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Name}}{Contract: bind.NewContract(address, spec, backend)}, nil
+}
+{{range .Functions}}
+{{- if .Constant}}
+// {{.GoName}} calls {{.Signature}} without making a transaction
+func (c *{{$contract.Name}}) {{.GoName}}(ctx context.Context, opts *bind.CallOpts{{.Params}}) ({{.ReturnTypes}}error) {
+{{- range $i, $type := .Outputs}}
+	var ret{{$i}} {{$type}}
+{{- end}}
+	err := c.Call(ctx, opts, "{{.Name}}", []interface{}{ {{- .ResultPtrs -}} }{{.Args}})
+	return {{.Returns}}err
+}
+{{else}}
+// {{.GoName}} calls {{.Signature}} in a transaction
+func (c *{{$contract.Name}}) {{.GoName}}(ctx context.Context, opts *bind.TransactOpts{{.Params}}) (*exec.TxExecution, error) {
+	return c.Transact(ctx, opts, "{{.Name}}"{{.Args}})
+}
+{{end}}
+{{- end}}
+{{- range .Events}}
+// {{$contract.Name}}{{.GoName}} is the {{.Signature}} event of {{$contract.Name}}
+type {{$contract.Name}}{{.GoName}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}}
+{{- end}}
+	Raw *exec.LogEvent
+}
+
+// Unpack{{.GoName}} decodes a {{.Name}} event
+func (c *{{$contract.Name}}) Unpack{{.GoName}}(log *exec.LogEvent) (*{{$contract.Name}}{{.GoName}}, error) {
+	ev := &{{$contract.Name}}{{.GoName}}{Raw: log}
+	err := c.UnpackLog("{{.Name}}", log{{range .Fields}}, &ev.{{.Name}}{{end}})
+	return ev, err
+}
+{{- if not .Anonymous}}
+
+// Filter{{.GoName}} passes each {{.Name}} event emitted within blockRange (all blocks to date if nil) to consumer
+func (c *{{$contract.Name}}) Filter{{.GoName}}(ctx context.Context, blockRange *rpcevents.BlockRange,
+	consumer func(ev *{{$contract.Name}}{{.GoName}}) error) error {
+	return c.FilterLogs(ctx, "{{.Name}}", blockRange, func(log *exec.LogEvent) error {
+		ev, err := c.Unpack{{.GoName}}(log)
+		if err != nil {
+			return err
+		}
+		return consumer(ev)
+	})
+}
+{{- end}}
+{{end}}
+{{- end}}`
+
+var bindingsTemplate = template.Must(template.New("GoBindings").Parse(bindingsTemplateText))
+
+type bindings struct {
+	Package    string
+	Contracts  []*contractBinding
+	UsesBig    bool
+	UsesEvents bool
+	UsesHex    bool
+}
+
+type contractBinding struct {
+	Name        string
+	AbiLiteral  string
+	Bytecode    string
+	Constructor *functionBinding
+	Functions   []*functionBinding
+	Events      []*eventBinding
+}
+
+type functionBinding struct {
+	Name      string
+	GoName    string
+	Signature string
+	Constant  bool
+	Params    string
+	Args      string
+	Outputs   []string
+}
+
+type eventBinding struct {
+	Name      string
+	GoName    string
+	Signature string
+	Anonymous bool
+	Fields    []*fieldBinding
+}
+
+type fieldBinding struct {
+	Name string
+	Type string
+}
+
+// The subset of an ABI entry not retained by abi.Spec that we need to generate bindings
+type abiEntry struct {
+	Type            string
+	Name            string
+	Constant        bool
+	StateMutability string
+}
+
+// Generate returns formatted Go source in package pkg with bindings for each contract
+func Generate(pkg string, contracts ...ContractSource) ([]byte, error) {
+	b := &bindings{Package: pkg}
+	for _, source := range contracts {
+		contract, err := b.contract(source)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate bindings for %s: %v", source.Name, err)
+		}
+		b.Contracts = append(b.Contracts, contract)
+	}
+	buf := new(bytes.Buffer)
+	err := bindingsTemplate.Execute(buf, b)
+	if err != nil {
+		return nil, err
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not format generated bindings: %v", err)
+	}
+	return code, nil
+}
+
+func (b *bindings) contract(source ContractSource) (*contractBinding, error) {
+	if !token.IsIdentifier(source.Name) {
+		return nil, fmt.Errorf("contract name %q is not a valid Go identifier", source.Name)
+	}
+	spec, err := abi.ReadSpec(source.Abi)
+	if err != nil {
+		return nil, err
+	}
+	constant, err := constantFunctions(source.Abi)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := &contractBinding{
+		Name:       strcase.ToCamel(source.Name),
+		AbiLiteral: "`" + strings.ReplaceAll(string(source.Abi), "`", "` + \"`\" + `") + "`",
+		Bytecode:   source.Bytecode,
+	}
+	if source.Bytecode != "" {
+		b.UsesHex = true
+	}
+	contract.Constructor, err = b.function(spec.Constructor, false)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(spec.Functions))
+	for name := range spec.Functions {
+		if token.IsIdentifier(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		function, err := b.function(spec.Functions[name], constant[name])
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %v", name, err)
+		}
+		contract.Functions = append(contract.Functions, function)
+	}
+
+	names = names[:0]
+	for name := range spec.EventsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		event, err := b.event(spec.EventsByName[name])
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %v", name, err)
+		}
+		contract.Events = append(contract.Events, event)
+	}
+	return contract, nil
+}
+
+func (b *bindings) function(spec *abi.FunctionSpec, constant bool) (*functionBinding, error) {
+	function := &functionBinding{
+		Name:      spec.Name,
+		GoName:    strcase.ToCamel(spec.Name),
+		Signature: abi.Signature(spec.Name, spec.Inputs),
+		Constant:  constant,
+	}
+	used := map[string]bool{"c": true, "ctx": true, "opts": true, "err": true, "backend": true}
+	for i, arg := range spec.Inputs {
+		typ, err := b.goType(arg)
+		if err != nil {
+			return nil, err
+		}
+		name := paramName(arg.Name, i, used)
+		function.Params += fmt.Sprintf(", %s %s", name, typ)
+		function.Args += ", " + name
+	}
+	for _, arg := range spec.Outputs {
+		typ, err := b.goType(arg)
+		if err != nil {
+			return nil, err
+		}
+		function.Outputs = append(function.Outputs, typ)
+	}
+	return function, nil
+}
+
+func (b *bindings) event(spec *abi.EventSpec) (*eventBinding, error) {
+	if !spec.Anonymous {
+		b.UsesEvents = true
+	}
+	event := &eventBinding{
+		Name:      spec.Name,
+		GoName:    strcase.ToCamel(spec.Name),
+		Signature: spec.String(),
+		Anonymous: spec.Anonymous,
+	}
+	used := map[string]bool{"Raw": true}
+	for i, arg := range spec.Inputs {
+		typ, err := b.goType(arg)
+		if err != nil {
+			return nil, err
+		}
+		name := strcase.ToCamel(paramName(arg.Name, i, used))
+		used[name] = true
+		event.Fields = append(event.Fields, &fieldBinding{Name: name, Type: typ})
+	}
+	return event, nil
+}
+
+// Returns whether each function of an ABI is constant (view or pure) and so can be called without a transaction
+func constantFunctions(abiJSON []byte) (map[string]bool, error) {
+	var entries []abiEntry
+	err := json.Unmarshal(abiJSON, &entries)
+	if err != nil {
+		return nil, err
+	}
+	constant := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.Type == "function" {
+			constant[entry.Name] = entry.Constant || entry.StateMutability == "view" ||
+				entry.StateMutability == "pure"
+		}
+	}
+	return constant, nil
+}
+
+// Returns the Go type bindings use for an argument, as understood by Unpack
+func (b *bindings) goType(arg abi.Argument) (string, error) {
+	var typ string
+	switch evm := arg.EVM.(type) {
+	case abi.EVMBool:
+		typ = "bool"
+	case abi.EVMUint:
+		typ = b.intType("uint", evm.M)
+	case abi.EVMInt:
+		typ = b.intType("int", evm.M)
+	case abi.EVMAddress:
+		typ = "crypto.Address"
+	case abi.EVMString:
+		typ = "string"
+	case abi.EVMBytes:
+		if evm.M == 0 {
+			typ = "[]byte"
+		} else {
+			typ = fmt.Sprintf("[%d]byte", evm.M)
+		}
+	default:
+		return "", fmt.Errorf("type %s of %s is not supported", arg.EVM.GetSignature(), arg.Name)
+	}
+	if arg.IsArray {
+		return "[]" + typ, nil
+	}
+	return typ, nil
+}
+
+func (b *bindings) intType(prefix string, bits uint64) string {
+	switch bits {
+	case 8, 16, 32, 64:
+		return fmt.Sprintf("%s%d", prefix, bits)
+	default:
+		b.UsesBig = true
+		return "*big.Int"
+	}
+}
+
+// Returns a Go identifier for a parameter not already used
+func paramName(name string, index int, used map[string]bool) string {
+	name = strcase.ToLowerCamel(strings.TrimLeft(name, "_"))
+	if name == "" || !token.IsIdentifier(name) || token.IsKeyword(name) {
+		name = fmt.Sprintf("arg%d", index)
+	}
+	for used[name] {
+		name += "_"
+	}
+	used[name] = true
+	return name
+}
+
+func (f *functionBinding) ReturnTypes() string {
+	if len(f.Outputs) == 0 {
+		return ""
+	}
+	return strings.Join(f.Outputs, ", ") + ", "
+}
+
+func (f *functionBinding) ResultPtrs() string {
+	ptrs := make([]string, len(f.Outputs))
+	for i := range f.Outputs {
+		ptrs[i] = fmt.Sprintf("&ret%d", i)
+	}
+	return strings.Join(ptrs, ", ")
+}
+
+func (f *functionBinding) Returns() string {
+	rets := make([]string, len(f.Outputs))
+	for i := range f.Outputs {
+		rets[i] = fmt.Sprintf("ret%d", i)
+	}
+	if len(rets) == 0 {
+		return ""
+	}
+	return strings.Join(rets, ", ") + ", "
+}
@@ -0,0 +1,63 @@
+package bind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	code, err := Generate("tokens", ContractSource{
+		Name: "Token",
+		Abi: []byte(`[
+			{"type":"constructor","inputs":[{"name":"_supply","type":"uint256"}]},
+			{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],
+				"outputs":[{"name":"","type":"uint256"}]},
+			{"type":"function","name":"transfer","stateMutability":"nonpayable",
+				"inputs":[{"name":"to","type":"address"},{"name":"type","type":"uint64"}],"outputs":[{"name":"","type":"bool"}]},
+			{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},
+				{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]}]`),
+		Bytecode: "6080",
+	})
+	require.NoError(t, err)
+	source := string(code)
+	require.Contains(t, source, "package tokens")
+	require.Contains(t, source, "func DeployToken(ctx context.Context, backend *bind.Backend, opts *bind.TransactOpts, supply *big.Int) (*Token, *exec.TxExecution, error)")
+	require.Contains(t, source, "func (c *Token) BalanceOf(ctx context.Context, opts *bind.CallOpts, owner crypto.Address) (*big.Int, error)")
+	require.Contains(t, source, "func (c *Token) Transfer(ctx context.Context, opts *bind.TransactOpts, to crypto.Address, arg1 uint64) (*exec.TxExecution, error)")
+	require.Contains(t, source, "func (c *Token) FilterTransfer(ctx context.Context, blockRange *rpcevents.BlockRange,")
+	require.Contains(t, source, "Value *big.Int")
+
+	_, err = Generate("bad", ContractSource{
+		Name: "Fixed",
+		Abi:  []byte(`[{"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"fixed128x18"}]}]`),
+	})
+	require.Error(t, err)
+}
+
+func TestGenerateTypeScript(t *testing.T) {
+	code, err := GenerateTypeScript(ContractSource{
+		Name: "Token",
+		Abi: []byte(`[
+			{"type":"constructor","inputs":[{"name":"_supply","type":"uint256"}]},
+			{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],
+				"outputs":[{"name":"","type":"uint256"}]},
+			{"type":"function","name":"pair","stateMutability":"pure","inputs":[],
+				"outputs":[{"name":"","type":"bool"},{"name":"","type":"bytes32[]"}]},
+			{"type":"function","name":"transfer","stateMutability":"nonpayable",
+				"inputs":[{"name":"to","type":"address"},{"name":"new","type":"uint64"}],"outputs":[{"name":"","type":"bool"}]},
+			{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},
+				{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]}]`),
+		Bytecode: "6080",
+	})
+	require.NoError(t, err)
+	source := string(code)
+	require.Contains(t, source, "static async deploy(burrow: Burrow, supply: number): Promise<Token>")
+	require.Contains(t, source, "async balanceOf(owner: string): Promise<number> {\n"+
+		"    const raw: any[] = await (this.contract as any)['balanceOf'].sim(owner);")
+	require.Contains(t, source, "async pair(): Promise<[boolean, string[]]>")
+	require.Contains(t, source, "async transfer(to: string, arg1: number): Promise<boolean> {\n"+
+		"    const raw: any[] = await (this.contract as any)['transfer'](to, arg1);")
+	require.Contains(t, source, "export type TokenTransfer = {\n  from: string;\n  to: string;\n  value: number;\n};")
+	require.Contains(t, source, "onTransfer(callback: (err: Error, event: TokenTransfer) => void): EventStream")
+}
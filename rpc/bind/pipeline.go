@@ -0,0 +1,156 @@
+package bind
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// The number of times a Pipeline resynchronises its sequence with the chain and resubmits a transaction rejected
+// for having the wrong sequence before giving up
+const DefaultResequenceAttempts = 5
+
+// Pipeline signs transactions from a single account locally and keeps many of them in flight at once. Sequences are
+// allocated, and transactions signed and admitted to the mempool, one at a time in the order they were sent - which
+// is fast - while the wait for each transaction to be included in a block happens concurrently. Send may be called
+// from any number of goroutines.
+//
+// If the node rejects a transaction for having the wrong sequence (because another client sent from the same
+// account, or a transaction was dropped from the mempool) the Pipeline reads the account's sequence from the chain
+// and resubmits the transaction with a fresh one.
+type Pipeline struct {
+	// How many times to resubmit a transaction rejected for its sequence
+	ResequenceAttempts int
+	// How long to wait before resubmitting, giving pending transactions from elsewhere a chance to be committed
+	ResequenceBackoff time.Duration
+	backend           *Backend
+	signer            acm.AddressableSigner
+	chainID           string
+	mtx               sync.Mutex
+	// The last sequence allocated, valid only when synced
+	sequence uint64
+	synced   bool
+}
+
+// NewPipeline returns a Pipeline sending transactions signed by signer through backend
+func NewPipeline(ctx context.Context, backend *Backend, signer acm.AddressableSigner) (*Pipeline, error) {
+	status, err := backend.Query.Status(ctx, &rpcquery.StatusParam{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain ID for Pipeline: %v", err)
+	}
+	return &Pipeline{
+		ResequenceAttempts: DefaultResequenceAttempts,
+		ResequenceBackoff:  100 * time.Millisecond,
+		backend:            backend,
+		signer:             signer,
+		chainID:            status.ChainID,
+	}, nil
+}
+
+// Send signs tx (whose inputs must all be from the Pipeline's account) with the next sequence, broadcasts it, and
+// waits for its execution. An exception raised by the transaction is returned as an error along with the TxExecution.
+// Since a transaction may be dropped by the mempool after being admitted ctx should carry a deadline.
+func (p *Pipeline) Send(ctx context.Context, tx payload.Payload) (*exec.TxExecution, error) {
+	receipt, err := p.Submit(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return p.Confirm(ctx, receipt)
+}
+
+// Submit signs tx with the next sequence and broadcasts it, returning once it has been admitted to the mempool
+func (p *Pipeline) Submit(ctx context.Context, tx payload.Payload) (*txs.Receipt, error) {
+	address := p.signer.GetAddress()
+	inputs := tx.GetInputs()
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("transaction %v has no inputs to sequence", tx)
+	}
+	for _, input := range inputs {
+		if input.Address != address {
+			return nil, fmt.Errorf("transaction has input from %v but Pipeline signs for %v", input.Address, address)
+		}
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for attempt := 0; ; attempt++ {
+		if !p.synced {
+			err := p.resync(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		sequence := p.sequence + 1
+		for _, input := range inputs {
+			input.Sequence = sequence
+		}
+		txEnv := txs.Enclose(p.chainID, tx)
+		err := txEnv.Sign(p.signer)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := p.backend.Transact.BroadcastTxAsync(ctx, &rpctransact.TxEnvelopeParam{Envelope: txEnv})
+		if err == nil {
+			p.sequence = sequence
+			return receipt, nil
+		}
+		if !invalidSequence(err) || attempt >= p.ResequenceAttempts {
+			return nil, err
+		}
+		// Our idea of the account's sequence has diverged from the node's so start again from the chain's
+		p.synced = false
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(p.ResequenceBackoff):
+		}
+	}
+}
+
+// Confirm waits for the execution of a transaction returned by Submit
+func (p *Pipeline) Confirm(ctx context.Context, receipt *txs.Receipt) (*exec.TxExecution, error) {
+	txe, err := p.backend.Events.Tx(ctx, &rpcevents.TxRequest{TxHash: receipt.TxHash, Wait: true})
+	if err != nil {
+		return nil, err
+	}
+	if txe.Exception != nil {
+		return txe, txe.Exception.AsError()
+	}
+	return txe, nil
+}
+
+// Sequence returns the last sequence allocated by the Pipeline
+func (p *Pipeline) Sequence() uint64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.sequence
+}
+
+func (p *Pipeline) resync(ctx context.Context) error {
+	acc, err := p.backend.Query.GetAccount(ctx, &rpcquery.GetAccountParam{Address: p.signer.GetAddress()})
+	if err != nil {
+		return fmt.Errorf("could not get sequence of %v: %v", p.signer.GetAddress(), err)
+	}
+	if acc == nil {
+		return fmt.Errorf("account %v does not exist", p.signer.GetAddress())
+	}
+	p.sequence = acc.Sequence
+	p.synced = true
+	return nil
+}
+
+// The rejection is carried as the text of the ABCI log so this is the best we can do
+func invalidSequence(err error) bool {
+	return errors.GetCode(err) == errors.Codes.InvalidSequence ||
+		strings.Contains(strings.ToLower(err.Error()), "invalid sequence")
+}
@@ -0,0 +1,130 @@
+package bind
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const chainID = "pipeline-chain"
+
+// A node that admits transactions only in sequence
+type fakeNode struct {
+	rpctransact.TransactClient
+	rpcquery.QueryClient
+	rpcevents.ExecutionEventsClient
+	sync.Mutex
+	// The sequence of the account in committed state and including the mempool
+	committed uint64
+	pending   uint64
+	executed  map[string]*exec.TxExecution
+}
+
+func (node *fakeNode) Status(ctx context.Context, in *rpcquery.StatusParam,
+	opts ...grpc.CallOption) (*rpc.ResultStatus, error) {
+	return &rpc.ResultStatus{ChainID: chainID}, nil
+}
+
+func (node *fakeNode) GetAccount(ctx context.Context, in *rpcquery.GetAccountParam,
+	opts ...grpc.CallOption) (*acm.Account, error) {
+	node.Lock()
+	defer node.Unlock()
+	return &acm.Account{Address: in.Address, Sequence: node.committed}, nil
+}
+
+func (node *fakeNode) BroadcastTxAsync(ctx context.Context, in *rpctransact.TxEnvelopeParam,
+	opts ...grpc.CallOption) (*txs.Receipt, error) {
+	node.Lock()
+	defer node.Unlock()
+	err := in.Envelope.Verify(chainID)
+	if err != nil {
+		return nil, err
+	}
+	input := in.Envelope.Tx.GetInputs()[0]
+	if input.Sequence != node.pending+1 {
+		return nil, fmt.Errorf("error 2 returned by Tendermint in BroadcastTxSync ABCI log: Error invalid sequence "+
+			"in input: input has sequence %d, but account has sequence %d", input.Sequence, node.pending)
+	}
+	node.pending++
+	// Commit immediately
+	node.committed = node.pending
+	txHash := in.Envelope.Tx.Hash()
+	node.executed[txHash.String()] = &exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: txHash}}
+	return &txs.Receipt{TxHash: txHash}, nil
+}
+
+func (node *fakeNode) Tx(ctx context.Context, in *rpcevents.TxRequest,
+	opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	node.Lock()
+	defer node.Unlock()
+	txe, ok := node.executed[in.TxHash.String()]
+	if !ok {
+		return nil, fmt.Errorf("transaction %v not found", in.TxHash)
+	}
+	return txe, nil
+}
+
+func TestPipeline(t *testing.T) {
+	node := &fakeNode{committed: 3, pending: 3, executed: make(map[string]*exec.TxExecution)}
+	backend := &Backend{Transact: node, Query: node, Events: node}
+	signer := acm.GeneratePrivateAccountFromSecret("pipeline")
+	ctx := context.Background()
+	pipeline, err := NewPipeline(ctx, backend, signer)
+	require.NoError(t, err)
+	pipeline.ResequenceBackoff = 0
+
+	send := func() (*exec.TxExecution, error) {
+		address := crypto.Address{1}
+		return pipeline.Send(ctx, &payload.CallTx{
+			Input:    &payload.TxInput{Address: signer.GetAddress(), Amount: 1},
+			Address:  &address,
+			GasLimit: 100,
+		})
+	}
+
+	const n = 50
+	wg := new(sync.WaitGroup)
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := send()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(3+n), pipeline.Sequence())
+	assert.Len(t, node.executed, n)
+
+	// Another client sends from the same account so the pipeline must resequence
+	node.committed += 2
+	node.pending += 2
+	_, err = send()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3+n+3), pipeline.Sequence())
+
+	// Give up if the node never agrees
+	node.pending += 2
+	pipeline.ResequenceAttempts = 1
+	_, err = send()
+	require.Error(t, err)
+	assert.True(t, invalidSequence(err))
+
+	// Refuse transactions the pipeline cannot sign
+	_, err = pipeline.Send(ctx, &payload.CallTx{Input: &payload.TxInput{Address: crypto.Address{2}}})
+	require.Error(t, err)
+}
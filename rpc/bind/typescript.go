@@ -0,0 +1,314 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/iancoleman/strcase"
+)
+
+const typeScriptTemplateText = `// Code generated by burrow compile --bindings ts. DO NOT EDIT.
+
+import { Burrow, Contract, EventStream } from '@hyperledger/burrow';
+{{range .Contracts}}{{$contract := .}}
+// {{.Name}}Abi is the ABI of the {{.Name}} contract
+export const {{.Name}}Abi: any[] = {{.Abi}};
+
+// {{.Name}}Bytecode is the creation bytecode of the {{.Name}} contract
+export const {{.Name}}Bytecode = '{{.Bytecode}}';
+{{range .Events}}
+// {{$contract.Name}}{{.Name}} is the {{.Signature}} event of {{$contract.Name}}
+export type {{$contract.Name}}{{.Name}} = {
+{{- range .Fields}}
+  {{.Name}}: {{.Type}};
+{{- end}}
+};
+{{end}}
+// {{.Name}} is a typed wrapper of the {{.Name}} contract
+export class {{.Name}} {
+  readonly contract: Contract;
+
+  constructor(contract: Contract) {
+    this.contract = contract;
+  }
+
+  get address(): string {
+    return this.contract.address;
+  }
+
+  // at binds an instance of {{.Name}} deployed at address
+  static at(burrow: Burrow, address: string): {{.Name}} {
+    return new {{.Name}}(new Contract({{.Name}}Abi, {{.Name}}Bytecode, address, burrow));
+  }
+{{- if .Bytecode}}
+
+  // deploy creates a new {{.Name}} contract
+  static async deploy(burrow: Burrow{{.Constructor.Params}}): Promise<{{.Name}}> {
+    return new {{.Name}}(await burrow.contracts.deploy({{.Name}}Abi, {{.Name}}Bytecode, undefined{{.Constructor.Args}}));
+  }
+{{- end}}
+{{range .Functions}}
+  // {{.Method}} {{if .Constant}}simulates a call of{{else}}calls{{end}} {{.Signature}}{{if not .Constant}} in a transaction{{end}}
+  async {{.Method}}({{.Params}}): Promise<{{.ReturnType}}> {
+    {{if .Outputs}}const raw: any[] = {{end}}await (this.contract as any)['{{.Name}}']{{if .Constant}}.sim{{end}}({{.Args}});
+{{- if .Outputs}}
+    return {{.Return}};
+{{- end}}
+  }
+{{end}}
+{{- range .Events}}
+  // on{{.Name}} subscribes to {{.Signature}} events of this contract
+  on{{.Name}}(callback: (err: Error, event: {{$contract.Name}}{{.Name}}) => void): EventStream {
+    return (this.contract as any)['{{.AbiName}}']((err: Error, result: any) =>
+      callback(err, result && result.args));
+  }
+{{end -}}
+}
+{{end}}`
+
+var typeScriptTemplate = template.Must(template.New("TypeScriptBindings").Parse(typeScriptTemplateText))
+
+var typeScriptIdentifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// Words that may not be used as TypeScript parameter names
+var typeScriptReserved = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true, "debugger": true,
+	"default": true, "delete": true, "do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true, "import": true, "in": true,
+	"instanceof": true, "new": true, "null": true, "return": true, "super": true, "switch": true, "this": true,
+	"throw": true, "true": true, "try": true, "typeof": true, "var": true, "void": true, "while": true, "with": true,
+	"implements": true, "interface": true, "let": true, "package": true, "private": true, "protected": true,
+	"public": true, "static": true, "yield": true, "await": true, "burrow": true, "callback": true,
+}
+
+type typeScriptBindings struct {
+	Contracts []*typeScriptContract
+}
+
+type typeScriptContract struct {
+	Name        string
+	Abi         string
+	Bytecode    string
+	Constructor *typeScriptFunction
+	Functions   []*typeScriptFunction
+	Events      []*typeScriptEvent
+}
+
+type typeScriptFunction struct {
+	Name      string
+	Method    string
+	Signature string
+	Constant  bool
+	Params    string
+	Args      string
+	Outputs   []string
+}
+
+type typeScriptEvent struct {
+	Name      string
+	AbiName   string
+	Signature string
+	Fields    []*fieldBinding
+}
+
+// GenerateTypeScript returns a TypeScript module with a typed wrapper of each contract for the Burrow JavaScript client
+// (@hyperledger/burrow). Values are typed as that client converts them: integers as numbers, and addresses and bytes
+// as hex strings.
+func GenerateTypeScript(contracts ...ContractSource) ([]byte, error) {
+	b := new(typeScriptBindings)
+	for _, source := range contracts {
+		contract, err := typeScriptContractOf(source)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate bindings for %s: %v", source.Name, err)
+		}
+		b.Contracts = append(b.Contracts, contract)
+	}
+	buf := new(bytes.Buffer)
+	err := typeScriptTemplate.Execute(buf, b)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func typeScriptContractOf(source ContractSource) (*typeScriptContract, error) {
+	if !typeScriptIdentifierRegex.MatchString(source.Name) {
+		return nil, fmt.Errorf("contract name %q is not a valid TypeScript identifier", source.Name)
+	}
+	spec, err := abi.ReadSpec(source.Abi)
+	if err != nil {
+		return nil, err
+	}
+	constant, err := constantFunctions(source.Abi)
+	if err != nil {
+		return nil, err
+	}
+	// Compact the ABI so that it sits on one line
+	abiJSON := new(bytes.Buffer)
+	err = json.Compact(abiJSON, source.Abi)
+	if err != nil {
+		return nil, err
+	}
+	contract := &typeScriptContract{
+		Name:     strcase.ToCamel(source.Name),
+		Abi:      abiJSON.String(),
+		Bytecode: source.Bytecode,
+	}
+	contract.Constructor, err = typeScriptFunctionOf(spec.Constructor, false)
+	if err != nil {
+		return nil, err
+	}
+	if contract.Constructor.Params != "" {
+		contract.Constructor.Params = ", " + contract.Constructor.Params
+		contract.Constructor.Args = ", " + contract.Constructor.Args
+	}
+
+	// The methods of the class other than those we generate for functions
+	methods := map[string]bool{"contract": true, "address": true, "constructor": true}
+	for name := range spec.EventsByName {
+		methods["on"+strcase.ToCamel(name)] = true
+	}
+	names := make([]string, 0, len(spec.Functions))
+	for name := range spec.Functions {
+		if typeScriptIdentifierRegex.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		function, err := typeScriptFunctionOf(spec.Functions[name], constant[name])
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %v", name, err)
+		}
+		for methods[function.Method] {
+			function.Method += "_"
+		}
+		methods[function.Method] = true
+		contract.Functions = append(contract.Functions, function)
+	}
+
+	names = names[:0]
+	for name := range spec.EventsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		event, err := typeScriptEventOf(spec.EventsByName[name])
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %v", name, err)
+		}
+		contract.Events = append(contract.Events, event)
+	}
+	return contract, nil
+}
+
+func typeScriptFunctionOf(spec *abi.FunctionSpec, constant bool) (*typeScriptFunction, error) {
+	function := &typeScriptFunction{
+		Name:      spec.Name,
+		Method:    spec.Name,
+		Signature: abi.Signature(spec.Name, spec.Inputs),
+		Constant:  constant,
+	}
+	used := make(map[string]bool)
+	params := make([]string, len(spec.Inputs))
+	args := make([]string, len(spec.Inputs))
+	for i, arg := range spec.Inputs {
+		typ, err := typeScriptType(arg)
+		if err != nil {
+			return nil, err
+		}
+		name := typeScriptParamName(arg.Name, i, used)
+		params[i] = fmt.Sprintf("%s: %s", name, typ)
+		args[i] = name
+	}
+	function.Params = strings.Join(params, ", ")
+	function.Args = strings.Join(args, ", ")
+	for _, arg := range spec.Outputs {
+		typ, err := typeScriptType(arg)
+		if err != nil {
+			return nil, err
+		}
+		function.Outputs = append(function.Outputs, typ)
+	}
+	return function, nil
+}
+
+func typeScriptEventOf(spec *abi.EventSpec) (*typeScriptEvent, error) {
+	event := &typeScriptEvent{
+		Name:      strcase.ToCamel(spec.Name),
+		AbiName:   spec.Name,
+		Signature: spec.String(),
+	}
+	for _, arg := range spec.Inputs {
+		// The client keys the values of an event by the names of its inputs
+		if arg.Name == "" {
+			continue
+		}
+		typ, err := typeScriptType(arg)
+		if err != nil {
+			return nil, err
+		}
+		name := arg.Name
+		if !typeScriptIdentifierRegex.MatchString(name) {
+			name = fmt.Sprintf("%q", name)
+		}
+		event.Fields = append(event.Fields, &fieldBinding{Name: name, Type: typ})
+	}
+	return event, nil
+}
+
+// Returns the TypeScript type of an argument as converted by the JavaScript client
+func typeScriptType(arg abi.Argument) (string, error) {
+	var typ string
+	switch arg.EVM.(type) {
+	case abi.EVMBool:
+		typ = "boolean"
+	case abi.EVMUint, abi.EVMInt:
+		typ = "number"
+	case abi.EVMAddress, abi.EVMString, abi.EVMBytes:
+		typ = "string"
+	default:
+		return "", fmt.Errorf("type %s of %s is not supported", arg.EVM.GetSignature(), arg.Name)
+	}
+	if arg.IsArray {
+		return typ + "[]", nil
+	}
+	return typ, nil
+}
+
+// Returns a TypeScript identifier for a parameter not already used
+func typeScriptParamName(name string, index int, used map[string]bool) string {
+	name = strcase.ToLowerCamel(strings.TrimLeft(name, "_"))
+	if name == "" || !typeScriptIdentifierRegex.MatchString(name) || typeScriptReserved[name] {
+		name = fmt.Sprintf("arg%d", index)
+	}
+	for used[name] {
+		name += "_"
+	}
+	used[name] = true
+	return name
+}
+
+func (f *typeScriptFunction) ReturnType() string {
+	switch len(f.Outputs) {
+	case 0:
+		return "void"
+	case 1:
+		return f.Outputs[0]
+	default:
+		return "[" + strings.Join(f.Outputs, ", ") + "]"
+	}
+}
+
+func (f *typeScriptFunction) Return() string {
+	if len(f.Outputs) == 1 {
+		return "raw[0]"
+	}
+	return fmt.Sprintf("raw as %s", f.ReturnType())
+}
@@ -0,0 +1,126 @@
+package bind
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+// Unpack decodes data according to args into results, which must be pointers to the Go types of the arguments as
+// generated for bindings. Unlike abi.Unpack, pointer types such as *big.Int and arrays as typed slices are supported.
+func Unpack(args []abi.Argument, data []byte, results ...interface{}) error {
+	holder, err := newHolder(args, results)
+	if err != nil {
+		return err
+	}
+	err = abi.Unpack(args, data, holder.Addr().Interface())
+	if err != nil {
+		return err
+	}
+	return setResults(args, holder, results)
+}
+
+// UnpackEvent decodes the topics and data of a LogEvent according to eventSpec into fields, one per event input
+func UnpackEvent(eventSpec *abi.EventSpec, topics []binary.Word256, data []byte, fields ...interface{}) error {
+	holder, err := newHolder(eventSpec.Inputs, fields)
+	if err != nil {
+		return err
+	}
+	err = abi.UnpackEvent(eventSpec, topics, data, holder.Addr().Interface())
+	if err != nil {
+		return err
+	}
+	return setResults(eventSpec.Inputs, holder, fields)
+}
+
+// Returns an addressable struct with a field into which abi.Unpack can decode each argument
+func newHolder(args []abi.Argument, results []interface{}) (reflect.Value, error) {
+	if len(results) != len(args) {
+		return reflect.Value{}, fmt.Errorf("%d results expected but %d given", len(args), len(results))
+	}
+	fields := make([]reflect.StructField, len(args))
+	for i, arg := range args {
+		rt := reflect.TypeOf(results[i])
+		if rt == nil || rt.Kind() != reflect.Ptr {
+			return reflect.Value{}, fmt.Errorf("result %d must be a pointer but is %v", i, rt)
+		}
+		var ft reflect.Type
+		switch {
+		case arg.IsArray:
+			if rt.Elem().Kind() != reflect.Slice {
+				return reflect.Value{}, fmt.Errorf("result %d for %s must point to a slice", i, arg.Name)
+			}
+			ft = reflect.TypeOf([]interface{}{})
+		case rt.Elem().Kind() == reflect.Ptr:
+			// e.g. *big.Int which abi.Unpack decodes into a big.Int
+			ft = rt.Elem().Elem()
+		default:
+			ft = rt.Elem()
+		}
+		fields[i] = reflect.StructField{Name: fmt.Sprintf("Field%d", i), Type: ft}
+	}
+	holder := reflect.New(reflect.StructOf(fields)).Elem()
+	for i, arg := range args {
+		if arg.IsArray && arg.ArrayLength > 0 {
+			// Fixed length arrays are decoded into the elements provided
+			et := reflect.TypeOf(results[i]).Elem().Elem()
+			elements := make([]interface{}, arg.ArrayLength)
+			for n := range elements {
+				if et.Kind() == reflect.Ptr {
+					elements[n] = reflect.New(et.Elem()).Interface()
+				} else {
+					elements[n] = reflect.New(et).Interface()
+				}
+			}
+			holder.Field(i).Set(reflect.ValueOf(elements))
+		}
+	}
+	return holder, nil
+}
+
+func setResults(args []abi.Argument, holder reflect.Value, results []interface{}) error {
+	for i, arg := range args {
+		result := reflect.ValueOf(results[i]).Elem()
+		field := holder.Field(i)
+		switch {
+		case arg.IsArray:
+			elements := field.Interface().([]interface{})
+			slice := reflect.MakeSlice(result.Type(), len(elements), len(elements))
+			for n, element := range elements {
+				err := setElement(slice.Index(n), reflect.ValueOf(element))
+				if err != nil {
+					return fmt.Errorf("could not set element %d of %s: %v", n, arg.Name, err)
+				}
+			}
+			result.Set(slice)
+		case result.Kind() == reflect.Ptr:
+			result.Set(field.Addr())
+		default:
+			result.Set(field)
+		}
+	}
+	return nil
+}
+
+// Sets target from the pointer to a decoded array element
+func setElement(target, element reflect.Value) error {
+	if element.Type().AssignableTo(target.Type()) {
+		target.Set(element)
+		return nil
+	}
+	element = element.Elem()
+	switch {
+	case element.Type().AssignableTo(target.Type()):
+		target.Set(element)
+	case element.Kind() == reflect.Slice && target.Kind() == reflect.Array:
+		// bytesN elements are decoded as slices
+		reflect.Copy(target, element)
+	case element.Type().ConvertibleTo(target.Type()):
+		target.Set(element.Convert(target.Type()))
+	default:
+		return fmt.Errorf("cannot assign %v to %v", element.Type(), target.Type())
+	}
+	return nil
+}
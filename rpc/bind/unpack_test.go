@@ -0,0 +1,53 @@
+package bind
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpack(t *testing.T) {
+	spec, err := abi.ReadSpec([]byte(`[{"type":"function","name":"get","inputs":[],"outputs":[
+		{"name":"total","type":"uint256"},
+		{"name":"owner","type":"address"},
+		{"name":"counts","type":"uint64[]"},
+		{"name":"pair","type":"int256[2]"},
+		{"name":"tag","type":"bytes4"}]}]`))
+	require.NoError(t, err)
+	outputs := spec.Functions["get"].Outputs
+
+	owner := crypto.Address{1, 2, 3}
+	data, err := abi.Pack(outputs, big.NewInt(1337), owner, []uint64{4, 5, 6}, []*big.Int{big.NewInt(-1), big.NewInt(7)},
+		[4]byte{0xde, 0xad, 0xbe, 0xef})
+	require.NoError(t, err)
+
+	var total *big.Int
+	var gotOwner crypto.Address
+	var counts []uint64
+	var pair []*big.Int
+	var tag [4]byte
+	err = Unpack(outputs, data, &total, &gotOwner, &counts, &pair, &tag)
+	require.NoError(t, err)
+	require.Equal(t, int64(1337), total.Int64())
+	require.Equal(t, owner, gotOwner)
+	require.Equal(t, []uint64{4, 5, 6}, counts)
+	require.Len(t, pair, 2)
+	require.Equal(t, int64(-1), pair[0].Int64())
+	require.Equal(t, int64(7), pair[1].Int64())
+	require.Equal(t, [4]byte{0xde, 0xad, 0xbe, 0xef}, tag)
+
+	// A single pointer result is not mistaken for a struct of results
+	single, err := abi.ReadSpec([]byte(`[{"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"uint256"}]}]`))
+	require.NoError(t, err)
+	data, err = abi.Pack(single.Functions["get"].Outputs, big.NewInt(42))
+	require.NoError(t, err)
+	err = Unpack(single.Functions["get"].Outputs, data, &total)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), total.Int64())
+
+	err = Unpack(outputs, data, &total)
+	require.Error(t, err)
+}
@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// How often we ping the node on an idle connection, the GRPC server allows pings this often (see
+// keepaliveEnforcementPolicy)
+const DefaultKeepaliveTime = time.Minute
+
+// The methods of the transact service that do not change state and so may be attempted more than once
+var idempotentTransactMethods = map[string]bool{
+	"/rpctransact.Transact/CallTxSim":           true,
+	"/rpctransact.Transact/CallCodeSim":         true,
+	"/rpctransact.Transact/CallTxMaxValue":      true,
+	"/rpctransact.Transact/FormulateTx":         true,
+	"/rpctransact.Transact/FormulateTxTemplate": true,
+}
+
+// CallPolicy bounds, retries, and hedges the calls a gRPC client makes so that it fails rather than hangs when the
+// node it is connected to is unresponsive. Only calls to idempotent methods are ever attempted more than once.
+type CallPolicy struct {
+	// The deadline of a unary call whose context has none (zero for none), streams are unbounded since they may follow
+	// the chain indefinitely
+	Timeout time.Duration
+	// The maximum number of attempts of an idempotent call (one or less for no retries)
+	MaxAttempts int
+	// The wait before the first retry, doubled for each subsequent retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// When non-zero an idempotent unary call is hedged: a further attempt is made each time HedgingDelay passes
+	// without a response (up to MaxAttempts) and the first success is used
+	HedgingDelay time.Duration
+	// The status codes on which an idempotent call is retried
+	RetryableCodes []codes.Code
+	// Reports whether the method with full name (e.g. /rpcquery.Query/GetAccount) may be attempted more than once,
+	// IdempotentMethod if nil
+	Idempotent func(method string) bool
+	// How long a connection may be idle before we ping the node to check it is alive (zero to never ping)
+	KeepaliveTime time.Duration
+	// The largest message in bytes the client will receive (the gRPC default of 4MB if zero), large dumps or blocks
+	// may need more
+	MaxRecvMsgSize int
+	// The compressor with which to compress requests (e.g. gzip), the node compresses its responses to match
+	Compressor string
+}
+
+func DefaultCallPolicy() *CallPolicy {
+	return &CallPolicy{
+		Timeout:        30 * time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		RetryableCodes: []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+		KeepaliveTime:  DefaultKeepaliveTime,
+	}
+}
+
+// IdempotentMethod reports whether a method of the query, events, or transact services leaves state unchanged
+func IdempotentMethod(method string) bool {
+	return strings.HasPrefix(method, "/rpcquery.") || strings.HasPrefix(method, "/rpcevents.") ||
+		idempotentTransactMethods[method]
+}
+
+// DialOptions returns the options that apply the policy to the calls made on a connection
+func (p *CallPolicy) DialOptions() []grpc.DialOption {
+	opts := []grpc.DialOption{
+		grpc.WithUnaryInterceptor(p.unaryInterceptor),
+		grpc.WithStreamInterceptor(p.streamInterceptor),
+	}
+	var callOpts []grpc.CallOption
+	if p.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(p.MaxRecvMsgSize))
+	}
+	if p.Compressor != "" {
+		callOpts = append(callOpts, grpc.UseCompressor(p.Compressor))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+	if p.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    p.KeepaliveTime,
+			Timeout: p.KeepaliveTime / 3,
+		}))
+	}
+	return opts
+}
+
+func (p *CallPolicy) unaryInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	if _, ok := ctx.Deadline(); !ok && p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+	attempts := p.attempts(method)
+	if attempts > 1 && p.HedgingDelay > 0 {
+		return p.hedge(ctx, attempts, func(ctx context.Context, reply interface{}) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}, reply)
+	}
+	return p.retry(ctx, attempts, func() error {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+}
+
+func (p *CallPolicy) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+	var stream grpc.ClientStream
+	err := p.retry(ctx, p.attempts(method), func() error {
+		var err error
+		stream, err = streamer(ctx, desc, cc, method, opts...)
+		return err
+	})
+	return stream, err
+}
+
+func (p *CallPolicy) attempts(method string) int {
+	idempotent := p.Idempotent
+	if idempotent == nil {
+		idempotent = IdempotentMethod
+	}
+	if p.MaxAttempts > 1 && idempotent(method) {
+		return p.MaxAttempts
+	}
+	return 1
+}
+
+func (p *CallPolicy) retry(ctx context.Context, attempts int, attempt func() error) error {
+	backoff := p.InitialBackoff
+	for i := 1; ; i++ {
+		err := attempt()
+		if err == nil || i >= attempts || !p.retryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
+
+// Races attempts started HedgingDelay apart (or immediately after a retryable failure) and copies the reply of the
+// first to succeed into reply
+func (p *CallPolicy) hedge(ctx context.Context, attempts int, attempt func(ctx context.Context, reply interface{}) error,
+	reply interface{}) error {
+
+	// Cancel the attempts still in flight when we are done
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply interface{}
+		err   error
+	}
+	results := make(chan result, attempts)
+	launched, pending := 0, 0
+	launch := func() {
+		launched++
+		pending++
+		// Each attempt gets its own reply since they may be decoded concurrently
+		attemptReply := reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+		go func() {
+			results <- result{reply: attemptReply, err: attempt(ctx, attemptReply)}
+		}()
+	}
+
+	launch()
+	for {
+		var hedgingTimer <-chan time.Time
+		if launched < attempts {
+			hedgingTimer = time.After(p.HedgingDelay)
+		}
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				reflect.ValueOf(reply).Elem().Set(reflect.ValueOf(res.reply).Elem())
+				return nil
+			}
+			if !p.retryable(res.err) {
+				return res.err
+			}
+			if launched < attempts {
+				launch()
+			} else if pending == 0 {
+				return res.err
+			}
+		case <-hedgingTimer:
+			launch()
+		}
+	}
+}
+
+func (p *CallPolicy) retryable(err error) bool {
+	code := status.Code(err)
+	for _, retryable := range p.RetryableCodes {
+		if code == retryable {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Fails its first failures calls, and when it does not fail its first call delays its response by delay
+type flakyHealthServer struct {
+	calls    int32
+	failures int32
+	delay    time.Duration
+}
+
+func (s *flakyHealthServer) Check(ctx context.Context,
+	req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	call := atomic.AddInt32(&s.calls, 1)
+	if call <= s.failures {
+		return nil, status.Error(codes.Unavailable, "not yet")
+	}
+	if call == 1 {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+func (s *flakyHealthServer) Watch(req *grpc_health_v1.HealthCheckRequest,
+	stream grpc_health_v1.Health_WatchServer) error {
+	return status.Error(codes.Unimplemented, "no watching")
+}
+
+func TestCallPolicy(t *testing.T) {
+	check := func(server *flakyHealthServer, policy *CallPolicy) error {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		grpcServer := grpc.NewServer()
+		grpc_health_v1.RegisterHealthServer(grpcServer, server)
+		go grpcServer.Serve(listener)
+		defer grpcServer.Stop()
+
+		conn, err := grpc.Dial(listener.Addr().String(), append(policy.DialOptions(), grpc.WithInsecure())...)
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		return err
+	}
+	idempotent := func(policy *CallPolicy) *CallPolicy {
+		policy.Idempotent = func(method string) bool { return true }
+		return policy
+	}
+
+	t.Run("Retry", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 2}
+		require.NoError(t, check(server, idempotent(DefaultCallPolicy())))
+		assert.Equal(t, int32(3), server.calls)
+
+		server = &flakyHealthServer{failures: 3}
+		require.Equal(t, codes.Unavailable, status.Code(check(server, idempotent(DefaultCallPolicy()))))
+		assert.Equal(t, int32(3), server.calls)
+	})
+
+	t.Run("NotIdempotent", func(t *testing.T) {
+		server := &flakyHealthServer{failures: 1}
+		require.Equal(t, codes.Unavailable, status.Code(check(server, DefaultCallPolicy())))
+		assert.Equal(t, int32(1), server.calls)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		policy := DefaultCallPolicy()
+		policy.Timeout = 50 * time.Millisecond
+		server := &flakyHealthServer{delay: time.Minute}
+		require.Equal(t, codes.DeadlineExceeded, status.Code(check(server, policy)))
+	})
+
+	t.Run("Hedging", func(t *testing.T) {
+		policy := idempotent(DefaultCallPolicy())
+		policy.HedgingDelay = 20 * time.Millisecond
+		server := &flakyHealthServer{delay: time.Minute}
+		start := time.Now()
+		require.NoError(t, check(server, policy))
+		assert.True(t, time.Since(start) < time.Second)
+		assert.Equal(t, int32(2), server.calls)
+	})
+}
+
+func TestIdempotentMethod(t *testing.T) {
+	assert.True(t, IdempotentMethod("/rpcquery.Query/GetAccount"))
+	assert.True(t, IdempotentMethod("/rpcevents.ExecutionEvents/Stream"))
+	assert.True(t, IdempotentMethod("/rpctransact.Transact/CallTxSim"))
+	assert.False(t, IdempotentMethod("/rpctransact.Transact/CallTxSync"))
+	assert.False(t, IdempotentMethod("/rpctransact.Transact/BroadcastTxAsync"))
+}
@@ -2,6 +2,11 @@ package rpc
 
 import (
 	"net"
+
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/rpc/feature"
+	"github.com/hyperledger/burrow/rpc/ratelimit"
 )
 
 // 'LocalHost' gets interpreted as ipv6
@@ -15,6 +20,63 @@ type RPCConfig struct {
 	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
 	Web3     *ServerConfig  `json:",omitempty" toml:",omitempty"`
+	// Restricts which identities (as authenticated by Auth) may broadcast which transactions - if absent any client may
+	// broadcast any transaction
+	BroadcastACL *acl.Config `json:",omitempty" toml:",omitempty"`
+	// Requires clients to present an API key or JWT to call protected methods of the GRPC, gateway, GraphQL, and
+	// explorer servers
+	Auth *auth.Config `json:",omitempty" toml:",omitempty"`
+	// Disables GRPC methods or restricts the heights they serve, except for chosen authenticated identities
+	Features *feature.Config `json:",omitempty" toml:",omitempty"`
+	// Limits how often each client may call methods of the GRPC, gateway, GraphQL, and explorer servers
+	RateLimit *ratelimit.Config `json:",omitempty" toml:",omitempty"`
+	// Provides the Verifier gRPC service that recompiles submitted Solidity source to verify it against deployed code
+	Verify *VerifyConfig `json:",omitempty" toml:",omitempty"`
+	// Serves gRPC-web (for browser clients) alongside gRPC on the GRPC listener
+	GRPCWeb *GRPCWebConfig `json:",omitempty" toml:",omitempty"`
+	// Serves the GRPC listener (including gRPC-web) over TLS, optionally requiring client certificates
+	GRPCTLS *TLSConfig `json:",omitempty" toml:",omitempty"`
+	// Registers the gRPC server reflection service so that clients such as grpcurl can discover the GRPC services
+	GRPCReflection *GRPCReflectionConfig `json:",omitempty" toml:",omitempty"`
+	// Sets the message size limits and compression of the GRPC server
+	GRPCMessages *GRPCMessagesConfig `json:",omitempty" toml:",omitempty"`
+	// Serves the query, transact, and events gRPC services as JSON over plain HTTP (requires the GRPC server)
+	Gateway *ServerConfig `json:",omitempty" toml:",omitempty"`
+	// Serves a read-only GraphQL API over accounts, names, blocks, transactions, and events
+	GraphQL *ServerConfig `json:",omitempty" toml:",omitempty"`
+	// Serves a read-only REST API over blocks, transactions, accounts, and token transfers for block explorers
+	Explorer *ServerConfig `json:",omitempty" toml:",omitempty"`
+	// Serves the GRPC and info servers together on this one port instead of on their own, telling their connections
+	// apart by protocol
+	Mux *ServerConfig `json:",omitempty" toml:",omitempty"`
+	// Allows browsers to make cross-origin requests to the info, gateway, GraphQL, and explorer servers
+	CORS *CORSConfig `json:",omitempty" toml:",omitempty"`
+}
+
+type VerifyConfig struct {
+	// Requires solc to be available on the node's PATH
+	Enabled bool
+}
+
+type GRPCWebConfig struct {
+	Enabled bool
+	// Origins from which browsers may make cross-origin requests ("*" allows any origin)
+	AllowedOrigins []string
+}
+
+type GRPCReflectionConfig struct {
+	Enabled bool
+}
+
+type GRPCMessagesConfig struct {
+	// The largest message in bytes the GRPC server will receive (the gRPC default of 4MB if zero)
+	MaxRecvMsgSize int
+	// The largest message in bytes the GRPC server will send (the gRPC default, effectively unlimited, if zero). The
+	// gateway accepts messages of this size from the GRPC server.
+	MaxSendMsgSize int
+	// The compress/gzip level with which responses are compressed for clients that compress their requests with gzip
+	// (the default level if zero), clients that do not compress receive uncompressed responses
+	GzipLevel int `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
@@ -35,11 +97,23 @@ type MetricsConfig struct {
 
 func DefaultRPCConfig() *RPCConfig {
 	return &RPCConfig{
-		Info:     DefaultInfoConfig(),
-		Profiler: DefaultProfilerConfig(),
-		GRPC:     DefaultGRPCConfig(),
-		Metrics:  DefaultMetricsConfig(),
-		Web3:     DefaultWeb3Config(),
+		Info:           DefaultInfoConfig(),
+		Profiler:       DefaultProfilerConfig(),
+		GRPC:           DefaultGRPCConfig(),
+		GRPCWeb:        DefaultGRPCWebConfig(),
+		GRPCTLS:        DefaultGRPCTLSConfig(),
+		GRPCReflection: DefaultGRPCReflectionConfig(),
+		GRPCMessages:   DefaultGRPCMessagesConfig(),
+		Gateway:        DefaultGatewayConfig(),
+		GraphQL:        DefaultGraphQLConfig(),
+		Explorer:       DefaultExplorerConfig(),
+		Mux:            DefaultMuxConfig(),
+		CORS:           DefaultCORSConfig(),
+		Metrics:        DefaultMetricsConfig(),
+		Web3:           DefaultWeb3Config(),
+		Auth:           auth.DefaultConfig(),
+		Features:       feature.DefaultConfig(),
+		RateLimit:      ratelimit.DefaultConfig(),
 	}
 }
 
@@ -59,6 +133,57 @@ func DefaultGRPCConfig() *ServerConfig {
 	}
 }
 
+func DefaultGRPCWebConfig() *GRPCWebConfig {
+	return &GRPCWebConfig{
+		Enabled:        true,
+		AllowedOrigins: []string{"*"},
+	}
+}
+
+func DefaultGRPCReflectionConfig() *GRPCReflectionConfig {
+	return &GRPCReflectionConfig{
+		Enabled: false,
+	}
+}
+
+func DefaultGRPCMessagesConfig() *GRPCMessagesConfig {
+	return &GRPCMessagesConfig{
+		MaxRecvMsgSize: 4 << 20,
+	}
+}
+
+func DefaultGatewayConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled:    false,
+		ListenHost: AnyLocal,
+		ListenPort: "26661",
+	}
+}
+
+func DefaultGraphQLConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled:    false,
+		ListenHost: AnyLocal,
+		ListenPort: "26662",
+	}
+}
+
+func DefaultExplorerConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled:    false,
+		ListenHost: AnyLocal,
+		ListenPort: "26663",
+	}
+}
+
+func DefaultMuxConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled:    false,
+		ListenHost: AnyLocal,
+		ListenPort: "10998",
+	}
+}
+
 func DefaultProfilerConfig() *ServerConfig {
 	return &ServerConfig{
 		Enabled:    false,
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type CORSConfig struct {
+	Enabled bool
+	// Origins from which browsers may make cross-origin requests ("*" allows any origin)
+	AllowedOrigins []string
+	// Methods browsers may use in cross-origin requests
+	AllowedMethods []string
+	// Request headers browsers may send in cross-origin requests ("*" allows any header)
+	AllowedHeaders []string
+	// Response headers scripts may read from cross-origin responses
+	ExposedHeaders []string `json:",omitempty" toml:",omitempty"`
+	// Whether browsers may send cookies and the authorization header with cross-origin requests
+	AllowCredentials bool
+	// How long in seconds browsers may cache the response to a preflight request
+	MaxAge int `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultCORSConfig() *CORSConfig {
+	return &CORSConfig{
+		Enabled:        false,
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodOptions},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		MaxAge:         600,
+	}
+}
+
+// Handler answers CORS preflight requests and adds CORS headers to the responses of next to cross-origin requests from
+// allowed origins, refusing requests from other origins. If CORS is not enabled it returns next unchanged.
+func (conf *CORSConfig) Handler(next http.Handler) http.Handler {
+	if conf == nil || !conf.Enabled {
+		return next
+	}
+	methods := strings.Join(conf.AllowedMethods, ", ")
+	headers := strings.Join(conf.AllowedHeaders, ", ")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		header := w.Header()
+		// Replace any headers set by the server by default
+		for _, k := range []string{"Access-Control-Allow-Origin", "Access-Control-Allow-Credentials",
+			"Access-Control-Expose-Headers"} {
+			header.Del(k)
+		}
+		header.Add("Vary", "Origin")
+		if !conf.allowOrigin(origin) {
+			http.Error(w, fmt.Sprintf("origin %s is not allowed", origin), http.StatusForbidden)
+			return
+		}
+		header.Set("Access-Control-Allow-Origin", origin)
+		if conf.AllowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+		if len(conf.ExposedHeaders) > 0 {
+			header.Set("Access-Control-Expose-Headers", strings.Join(conf.ExposedHeaders, ", "))
+		}
+		if r.Method != http.MethodOptions || r.Header.Get("Access-Control-Request-Method") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// Preflight
+		if !conf.allowMethod(r.Header.Get("Access-Control-Request-Method")) {
+			http.Error(w, fmt.Sprintf("method %s is not allowed", r.Header.Get("Access-Control-Request-Method")),
+				http.StatusForbidden)
+			return
+		}
+		header.Set("Access-Control-Allow-Methods", methods)
+		if conf.allowAnyHeader() {
+			header.Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+		} else if headers != "" {
+			header.Set("Access-Control-Allow-Headers", headers)
+		}
+		if conf.MaxAge > 0 {
+			header.Set("Access-Control-Max-Age", strconv.Itoa(conf.MaxAge))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (conf *CORSConfig) allowOrigin(origin string) bool {
+	for _, allowed := range conf.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (conf *CORSConfig) allowMethod(method string) bool {
+	for _, allowed := range conf.AllowedMethods {
+		if strings.EqualFold(allowed, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (conf *CORSConfig) allowAnyHeader() bool {
+	for _, allowed := range conf.AllowedHeaders {
+		if allowed == "*" {
+			return true
+		}
+	}
+	return false
+}
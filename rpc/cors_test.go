@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSConfig_Handler(t *testing.T) {
+	conf := DefaultCORSConfig()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	// Not enabled so passes everything through
+	r := httptest.NewRequest(http.MethodOptions, "/status", nil)
+	r.Header.Set("Origin", "http://evil.example")
+	w := httptest.NewRecorder()
+	conf.Handler(next).ServeHTTP(w, r)
+	assert.Equal(t, "ok", w.Body.String())
+
+	conf.Enabled = true
+	conf.AllowedOrigins = []string{"http://dapp.example"}
+	conf.ExposedHeaders = []string{"X-Server-Time"}
+	handler := conf.Handler(next)
+
+	call := func(method, origin string, headers ...string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/status", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		for i := 0; i < len(headers); i += 2 {
+			r.Header.Set(headers[i], headers[i+1])
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	// Same-origin requests are untouched
+	w = call(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	w = call(http.MethodGet, "http://dapp.example")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok", w.Body.String())
+	assert.Equal(t, "http://dapp.example", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Server-Time", w.Header().Get("Access-Control-Expose-Headers"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+
+	w = call(http.MethodGet, "http://evil.example")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	w = call(http.MethodOptions, "http://dapp.example", "Access-Control-Request-Method", http.MethodPost)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "GET, POST, OPTIONS", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type, Authorization", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+	assert.Empty(t, w.Body.String())
+
+	w = call(http.MethodOptions, "http://dapp.example", "Access-Control-Request-Method", http.MethodDelete)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -16,11 +16,14 @@ import (
 	x "github.com/hyperledger/burrow/encoding/hex"
 	"github.com/hyperledger/burrow/encoding/rlp"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/rpc/acl"
 	"github.com/hyperledger/burrow/rpc/web3"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -36,14 +39,22 @@ const (
 	pending      = "null"
 )
 
+// EthState provides the accounts and chain params the web3 provider reads and simulates calls against
+type EthState interface {
+	acmstate.IterableStatsReader
+	chainparams.Reader
+}
+
 // EthService is a web3 provider
 type EthService struct {
-	accounts   acmstate.IterableStatsReader
+	accounts   EthState
+	natives    *native.Natives
 	events     EventsReader
 	blockchain bcm.BlockchainInfo
 	validators validator.History
 	nodeView   *tendermint.NodeView
 	trans      *execution.Transactor
+	acl        *acl.ACL
 	keyClient  keys.KeyClient
 	keyStore   *keys.FilesystemKeyStore
 	config     *tmConfig.Config
@@ -51,21 +62,23 @@ type EthService struct {
 }
 
 // NewEthService returns our web3 provider
-func NewEthService(accounts acmstate.IterableStatsReader,
+func NewEthService(accounts EthState, natives *native.Natives,
 	events EventsReader, blockchain bcm.BlockchainInfo,
 	validators validator.History, nodeView *tendermint.NodeView,
-	trans *execution.Transactor, keyStore *keys.FilesystemKeyStore,
+	trans *execution.Transactor, broadcastACL *acl.ACL, keyStore *keys.FilesystemKeyStore,
 	logger *logging.Logger) *EthService {
 
 	keyClient := keys.NewLocalKeyClient(keyStore, logger)
 
 	return &EthService{
 		accounts,
+		natives,
 		events,
 		blockchain,
 		validators,
 		nodeView,
 		trans,
+		broadcastACL,
 		keyClient,
 		keyStore,
 		tmConfig.DefaultConfig(),
@@ -168,7 +181,7 @@ func (srv *EthService) EthCall(req *web3.EthCallParams) (*web3.EthCallResult, er
 		return nil, err
 	}
 
-	txe, err := execution.CallSim(srv.accounts, srv.blockchain, from, to, data, srv.logger)
+	txe, err := execution.CallSim(srv.accounts, srv.accounts, srv.natives, srv.blockchain, from, to, data, srv.logger)
 	if err != nil {
 		return nil, err
 	} else if txe.Exception != nil {
@@ -640,7 +653,12 @@ func (srv *EthService) EthSendRawTransaction(req *web3.EthSendRawTransactionPara
 		},
 	}
 
+	// Web3 clients cannot authenticate so are subject to the anonymous broadcast rules
 	ctx := context.Background()
+	err = srv.acl.Authorize(acl.AnonymousIdentity, txEnv)
+	if err != nil {
+		return nil, err
+	}
 	txe, err := srv.trans.BroadcastTxSync(ctx, txEnv)
 	if err != nil {
 		return nil, err
@@ -879,7 +897,12 @@ func (srv *EthService) EthSendTransaction(req *web3.EthSendTransactionParams) (*
 
 	txEnv := txs.Enclose(srv.blockchain.ChainID(), tx)
 
+	// Web3 clients cannot authenticate so are subject to the anonymous broadcast rules
 	ctx := context.Background()
+	err = srv.acl.Authorize(acl.AnonymousIdentity, txEnv)
+	if err != nil {
+		return nil, err
+	}
 	txe, err := srv.trans.BroadcastTxSync(ctx, txEnv)
 	if err != nil {
 		return nil, err
@@ -55,8 +55,8 @@ func TestWeb3Service(t *testing.T) {
 	accountState := kern.State
 	eventsState := kern.State
 	validatorState := kern.State
-	eth := rpc.NewEthService(accountState, eventsState, kern.Blockchain, validatorState,
-		nodeView, kern.Transactor, store, kern.Logger)
+	eth := rpc.NewEthService(accountState, nil, eventsState, kern.Blockchain, validatorState,
+		nodeView, kern.Transactor, nil, store, kern.Logger)
 
 	t.Run("Web3Sha3", func(t *testing.T) {
 		result, err := eth.Web3Sha3(&web3.Web3Sha3Params{"0x68656c6c6f20776f726c64"}) // hello world
@@ -0,0 +1,72 @@
+package feature
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Configures restrictions on individual RPC methods, for example so that a public node can serve recent state while
+// keeping historical dumps to its operators
+type Config struct {
+	// The first rule matching a method applies, methods not matched by any rule are unrestricted
+	Rules []*Rule `json:",omitempty" toml:",omitempty"`
+}
+
+type Rule struct {
+	// The gRPC method (e.g. /rpcdump.Dump/GetDump), a trailing '*' matches any suffix
+	Method string
+	// Only Identities may call the method
+	Disabled bool `json:",omitempty" toml:",omitempty"`
+	// Requests for heights below MinHeight are refused
+	MinHeight uint64 `json:",omitempty" toml:",omitempty"`
+	// Requests for heights above MaxHeight are refused (if non-zero)
+	MaxHeight uint64 `json:",omitempty" toml:",omitempty"`
+	// Requests for heights more than MaxLookback blocks below the latest block are refused (if non-zero)
+	MaxLookback uint64 `json:",omitempty" toml:",omitempty"`
+	// Identities authenticated by the RPC Auth config to which this rule does not apply
+	Identities []string `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// A rule with its identities indexed
+type rule struct {
+	*Rule
+	method     string
+	prefix     bool
+	identities map[string]struct{}
+}
+
+func (r *Rule) compile() (*rule, error) {
+	if r.Method == "" {
+		return nil, fmt.Errorf("RPC feature rule has no Method")
+	}
+	if r.MaxHeight != 0 && r.MaxHeight < r.MinHeight {
+		return nil, fmt.Errorf("RPC feature rule for %s has MaxHeight %d below MinHeight %d",
+			r.Method, r.MaxHeight, r.MinHeight)
+	}
+	compiled := &rule{
+		Rule:       r,
+		method:     strings.TrimSuffix(r.Method, "*"),
+		prefix:     strings.HasSuffix(r.Method, "*"),
+		identities: make(map[string]struct{}, len(r.Identities)),
+	}
+	for _, identity := range r.Identities {
+		compiled.identities[identity] = struct{}{}
+	}
+	return compiled, nil
+}
+
+func (r *rule) matches(method string) bool {
+	if r.prefix {
+		return strings.HasPrefix(method, r.method)
+	}
+	return method == r.method
+}
+
+func (r *rule) exempts(identity string) bool {
+	_, ok := r.identities[identity]
+	return ok
+}
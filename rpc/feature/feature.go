@@ -0,0 +1,116 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package feature
+
+import (
+	"context"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Blockchain interface {
+	LastBlockHeight() uint64
+}
+
+// Requests for a single height, where zero means the latest height
+type heightRequest interface {
+	GetHeight() uint64
+}
+
+type blockRangeRequest interface {
+	GetBlockRange() *rpcevents.BlockRange
+}
+
+// Gate refuses calls to RPC methods that a Rule disables, or that request heights outside of the range a Rule
+// permits, unless the caller has an identity the Rule exempts. A nil Gate permits everything.
+type Gate struct {
+	rules      []*rule
+	blockchain Blockchain
+	logger     *logging.Logger
+}
+
+// New returns a Gate for config, or nil if it has no rules
+func New(config *Config, blockchain Blockchain, logger *logging.Logger) (*Gate, error) {
+	if config == nil || len(config.Rules) == 0 {
+		return nil, nil
+	}
+	gate := &Gate{
+		blockchain: blockchain,
+		logger:     logger.WithScope("RPCFeatures"),
+	}
+	for _, r := range config.Rules {
+		cr, err := r.compile()
+		if err != nil {
+			return nil, err
+		}
+		gate.rules = append(gate.rules, cr)
+	}
+	return gate, nil
+}
+
+// Check returns an error with status code PermissionDenied if method is disabled, or OutOfRange if request is for
+// heights outside of those permitted, for the identity authorized in ctx (see auth.Identity)
+func (gate *Gate) Check(ctx context.Context, method string, request interface{}) error {
+	if gate == nil {
+		return nil
+	}
+	r := gate.rule(method)
+	if r == nil {
+		return nil
+	}
+	identity := auth.Identity(ctx)
+	if r.exempts(identity) {
+		return nil
+	}
+	if r.Disabled {
+		gate.logger.InfoMsg("Refused call to disabled RPC method", "method", method, "identity", identity)
+		return status.Errorf(codes.PermissionDenied, "%s is not available to identity '%s'", method, identity)
+	}
+	latest := gate.blockchain.LastBlockHeight()
+	switch req := request.(type) {
+	case heightRequest:
+		height := req.GetHeight()
+		if height == 0 {
+			height = latest
+		}
+		return r.checkHeights(method, latest, height, height)
+	case blockRangeRequest:
+		start, end, streaming := req.GetBlockRange().Bounds(latest)
+		if streaming && r.MaxHeight != 0 {
+			return status.Errorf(codes.OutOfRange, "%s may not stream beyond height %d", method, r.MaxHeight)
+		}
+		return r.checkHeights(method, latest, start, end)
+	}
+	return nil
+}
+
+// Returns the first rule matching method, if any
+func (gate *Gate) rule(method string) *rule {
+	for _, r := range gate.rules {
+		if r.matches(method) {
+			return r
+		}
+	}
+	return nil
+}
+
+func (r *rule) checkHeights(method string, latest, start, end uint64) error {
+	min := r.MinHeight
+	if r.MaxLookback != 0 && latest > r.MaxLookback && latest-r.MaxLookback > min {
+		min = latest - r.MaxLookback
+	}
+	if start < min {
+		return status.Errorf(codes.OutOfRange, "%s is only available from height %d but height %d was requested",
+			method, min, start)
+	}
+	if r.MaxHeight != 0 && end > r.MaxHeight {
+		return status.Errorf(codes.OutOfRange, "%s is only available up to height %d but height %d was requested",
+			method, r.MaxHeight, end)
+	}
+	return nil
+}
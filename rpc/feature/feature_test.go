@@ -0,0 +1,89 @@
+package feature
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/rpc/rpcdump"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const getDump = "/rpcdump.Dump/GetDump"
+const stream = "/rpcevents.ExecutionEvents/Stream"
+
+const getBlockHeader = "/rpcquery.Query/GetBlockHeader"
+
+// Stands in for rpcquery.GetBlockParam since rpcquery imports this package
+type getBlockParam uint64
+
+func (height getBlockParam) GetHeight() uint64 {
+	return uint64(height)
+}
+
+type blockchain uint64
+
+func (b blockchain) LastBlockHeight() uint64 {
+	return uint64(b)
+}
+
+func TestGate_Check(t *testing.T) {
+	gate, err := New(&Config{
+		Rules: []*Rule{
+			{Method: getDump, Disabled: true, Identities: []string{"operator"}},
+			{Method: "/rpcevents.ExecutionEvents/*", MinHeight: 10, MaxLookback: 100},
+			{Method: getBlockHeader, MaxHeight: 500},
+		},
+	}, blockchain(1000), logging.NewNoopLogger())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	code := func(err error) codes.Code {
+		return status.Code(err)
+	}
+
+	assert.Equal(t, codes.PermissionDenied, code(gate.Check(ctx, getDump, &rpcdump.GetDumpParam{})))
+	authn, err := auth.New(&auth.Config{
+		Enabled: true,
+		APIKeys: []*auth.APIKey{{Identity: "operator", Key: "key"}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	operatorCtx, err := authn.AuthorizeContext(metadata.NewIncomingContext(ctx,
+		metadata.Pairs("authorization", "Bearer key")), getDump)
+	require.NoError(t, err)
+	require.NoError(t, gate.Check(operatorCtx, getDump, &rpcdump.GetDumpParam{}))
+
+	// Only the last 100 blocks are available
+	require.NoError(t, gate.Check(ctx, stream, &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.RelativeBound(100), rpcevents.StreamBound()),
+	}))
+	err = gate.Check(ctx, stream, &rpcevents.BlocksRequest{BlockRange: rpcevents.AbsoluteRange(800, 950)})
+	assert.Equal(t, codes.OutOfRange, code(err))
+
+	require.NoError(t, gate.Check(ctx, getBlockHeader, getBlockParam(500)))
+	err = gate.Check(ctx, getBlockHeader, getBlockParam(501))
+	assert.Equal(t, codes.OutOfRange, code(err))
+	// Zero means the latest height
+	err = gate.Check(ctx, getBlockHeader, getBlockParam(0))
+	assert.Equal(t, codes.OutOfRange, code(err))
+
+	// Unmatched methods are unrestricted
+	require.NoError(t, gate.Check(ctx, "/rpcquery.Query/GetAccount", &rpcdump.GetDumpParam{Height: 1}))
+}
+
+func TestNew(t *testing.T) {
+	gate, err := New(DefaultConfig(), blockchain(1), logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.Nil(t, gate)
+	require.NoError(t, gate.Check(context.Background(), getDump, &rpcdump.GetDumpParam{}))
+
+	_, err = New(&Config{Rules: []*Rule{{Method: getDump, MinHeight: 10, MaxHeight: 5}}}, blockchain(1),
+		logging.NewNoopLogger())
+	require.Error(t, err)
+}
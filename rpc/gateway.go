@@ -0,0 +1,277 @@
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/hyperledger/burrow/rpc/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The proto files of the services the gateway serves by default
+var GatewayProtoFiles = []string{"rpcquery.proto", "rpctransact.proto", "rpcevents.proto", "rpcexplorer.proto"}
+
+// Gateway serves the unary and server streaming methods of gRPC services as JSON over plain HTTP by calling them on a
+// gRPC connection. A method is called by POSTing its request as JSON to its gRPC path (e.g. /rpcquery.Query/GetAccount),
+// a method whose request is empty may also be called with GET. The response of a unary method is written as a JSON
+// object, and those of a server streaming method as one JSON object per line.
+type Gateway struct {
+	conn    *grpc.ClientConn
+	methods map[string]*gatewayMethod
+}
+
+type gatewayMethod struct {
+	name          string
+	request       reflect.Type
+	response      reflect.Type
+	serverStreams bool
+}
+
+type gatewayError struct {
+	Code  string
+	Error string
+}
+
+// NewGateway returns a Gateway for the services defined in protoFiles (as registered with gogoproto)
+func NewGateway(conn *grpc.ClientConn, protoFiles ...string) (*Gateway, error) {
+	gw := &Gateway{
+		conn:    conn,
+		methods: make(map[string]*gatewayMethod),
+	}
+	for _, protoFile := range protoFiles {
+		fd, err := fileDescriptor(protoFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range fd.Service {
+			serviceName := service.GetName()
+			if fd.GetPackage() != "" {
+				serviceName = fd.GetPackage() + "." + serviceName
+			}
+			for _, method := range service.Method {
+				// Clients cannot stream requests over a single HTTP request
+				if method.GetClientStreaming() {
+					continue
+				}
+				request, err := messageType(method.GetInputType())
+				if err != nil {
+					return nil, fmt.Errorf("method %s of %s: %v", method.GetName(), serviceName, err)
+				}
+				response, err := messageType(method.GetOutputType())
+				if err != nil {
+					return nil, fmt.Errorf("method %s of %s: %v", method.GetName(), serviceName, err)
+				}
+				name := fmt.Sprintf("/%s/%s", serviceName, method.GetName())
+				gw.methods[name] = &gatewayMethod{
+					name:          name,
+					request:       request,
+					response:      response,
+					serverStreams: method.GetServerStreaming(),
+				}
+			}
+		}
+	}
+	return gw, nil
+}
+
+// Methods returns the paths of the methods served
+func (gw *Gateway) Methods() []string {
+	names := make([]string, 0, len(gw.methods))
+	for name := range gw.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/" {
+		writeJSON(w, http.StatusOK, gw.Methods())
+		return
+	}
+	method, ok := gw.methods[r.URL.Path]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, &gatewayError{
+			Code:  codes.Unimplemented.String(),
+			Error: fmt.Sprintf("no method %s, GET / lists methods", r.URL.Path),
+		})
+		return
+	}
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET, POST")
+		writeJSON(w, http.StatusMethodNotAllowed, &gatewayError{
+			Code:  codes.Unimplemented.String(),
+			Error: fmt.Sprintf("method %s not allowed, POST the request as JSON", r.Method),
+		})
+		return
+	}
+	request := reflect.New(method.request).Interface()
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeStatus(w, status.New(codes.InvalidArgument, err.Error()))
+		return
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		err = json.Unmarshal(body, request)
+		if err != nil {
+			writeStatus(w, status.Newf(codes.InvalidArgument, "could not decode %s: %v", method.request.Name(), err))
+			return
+		}
+	}
+	ctx := r.Context()
+	// Pass the client's credentials through to the gRPC server so it can authorize the call
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+	// So the gRPC server rate limits the client rather than the gateway
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, ratelimit.ForwardedForKey, host)
+	}
+	if method.serverStreams {
+		gw.stream(ctx, w, method, request)
+		return
+	}
+	response := reflect.New(method.response).Interface()
+	err = gw.conn.Invoke(ctx, method.name, request, response)
+	if err != nil {
+		writeStatus(w, status.Convert(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (gw *Gateway) stream(ctx context.Context, w http.ResponseWriter, method *gatewayMethod, request interface{}) {
+	stream, err := gw.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, method.name)
+	if err == nil {
+		err = stream.SendMsg(request)
+	}
+	if err == nil {
+		err = stream.CloseSend()
+	}
+	if err != nil {
+		writeStatus(w, status.Convert(err))
+		return
+	}
+	// Wait for the first response so that we can still report an error with its status code
+	response := reflect.New(method.response).Interface()
+	err = stream.RecvMsg(response)
+	if err != nil && err != io.EOF {
+		writeStatus(w, status.Convert(err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	for err == nil {
+		err = encoder.Encode(response)
+		if err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		response = reflect.New(method.response).Interface()
+		err = stream.RecvMsg(response)
+	}
+	if err != io.EOF {
+		st := status.Convert(err)
+		encoder.Encode(&gatewayError{Code: st.Code().String(), Error: st.Message()})
+	}
+}
+
+func writeStatus(w http.ResponseWriter, st *status.Status) {
+	writeJSON(w, httpStatus(st.Code()), &gatewayError{Code: st.Code().String(), Error: st.Message()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(value)
+}
+
+// Maps gRPC status codes to HTTP status codes as grpc-gateway does
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func fileDescriptor(protoFile string) (*descriptor.FileDescriptorProto, error) {
+	gz := proto.FileDescriptor(protoFile)
+	if gz == nil {
+		return nil, fmt.Errorf("proto file %s is not registered", protoFile)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	bs, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	fd := new(descriptor.FileDescriptorProto)
+	err = proto.Unmarshal(bs, fd)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode descriptor of %s: %v", protoFile, err)
+	}
+	return fd, nil
+}
+
+// Our protos import Tendermint's ABCI types from the package types but Tendermint registers them under its full package
+var protoPackageAliases = map[string]string{"types.": "tendermint.abci.types."}
+
+func messageType(name string) (reflect.Type, error) {
+	name = strings.TrimPrefix(name, ".")
+	typ := proto.MessageType(name)
+	for prefix, alias := range protoPackageAliases {
+		if typ == nil && strings.HasPrefix(name, prefix) {
+			typ = proto.MessageType(alias + strings.TrimPrefix(name, prefix))
+		}
+	}
+	if typ == nil {
+		return nil, fmt.Errorf("message type %s is not registered", name)
+	}
+	// MessageType returns a pointer type
+	return typ.Elem(), nil
+}
@@ -0,0 +1,115 @@
+package rpc_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type queryServer struct {
+	rpcquery.UnimplementedQueryServer
+	accounts []*acm.Account
+}
+
+func (qs *queryServer) GetAccount(ctx context.Context, param *rpcquery.GetAccountParam) (*acm.Account, error) {
+	for _, account := range qs.accounts {
+		if account.Address == param.Address {
+			return account, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "no account %v", param.Address)
+}
+
+func (qs *queryServer) ListAccounts(param *rpcquery.ListAccountsParam, stream rpcquery.Query_ListAccountsServer) error {
+	for _, account := range qs.accounts {
+		err := stream.Send(account)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestGateway(t *testing.T) {
+	accounts := []*acm.Account{
+		{Address: crypto.Address{1}, Balance: 100},
+		{Address: crypto.Address{2}, Balance: 200},
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	rpcquery.RegisterQueryServer(grpcServer, &queryServer{accounts: accounts})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	gateway, err := rpc.NewGateway(conn, rpc.GatewayProtoFiles...)
+	require.NoError(t, err)
+	assert.Contains(t, gateway.Methods(), "/rpcquery.Query/GetAccount")
+	assert.Contains(t, gateway.Methods(), "/rpctransact.Transact/CallTxSync")
+	assert.Contains(t, gateway.Methods(), "/rpcevents.ExecutionEvents/Stream")
+	server := httptest.NewServer(gateway)
+	defer server.Close()
+
+	post := func(path, body string) *http.Response {
+		resp, err := http.Post(server.URL+path, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := post("/rpcquery.Query/GetAccount", `{"Address": "`+accounts[1].Address.String()+`"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	account := new(acm.Account)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(account))
+	resp.Body.Close()
+	assert.Equal(t, accounts[1].Address, account.Address)
+	assert.Equal(t, uint64(200), account.Balance)
+
+	resp = post("/rpcquery.Query/GetAccount", `{"Address": "`+crypto.Address{3}.String()+`"}`)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = post("/rpcquery.Query/GetAccount", `{"Address": 3}`)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Server streaming methods respond with a JSON object per line
+	resp, err = http.Get(server.URL + "/rpcquery.Query/ListAccounts")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	scanner := bufio.NewScanner(resp.Body)
+	var balances []uint64
+	for scanner.Scan() {
+		account := new(acm.Account)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), account))
+		balances = append(balances, account.Balance)
+	}
+	resp.Body.Close()
+	assert.Equal(t, []uint64{100, 200}, balances)
+
+	// Services not registered with the server are reported as unimplemented
+	resp = post("/rpctransact.Transact/CallTxSync", `{}`)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	resp = post("/rpcquery.Query/NoSuchMethod", `{}`)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
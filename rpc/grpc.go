@@ -6,16 +6,65 @@ import (
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/rpc/feature"
+	"github.com/hyperledger/burrow/rpc/ratelimit"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 )
 
-func NewGRPCServer(logger *logging.Logger) *grpc.Server {
-	return grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor(logger)),
-		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"))))
+// Allow clients to check their connection is alive as often as a CallPolicy does by default
+var keepaliveEnforcementPolicy = keepalive.EnforcementPolicy{
+	MinTime:             DefaultKeepaliveTime / 2,
+	PermitWithoutStream: true,
 }
 
-func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
+// NewGRPCServer returns a server whose calls are authorized by authn, restricted by gate, and rate limited by limiter
+// (any of which may be nil to permit all calls)
+func NewGRPCServer(logger *logging.Logger, authn *auth.Auth, gate *feature.Gate, limiter *ratelimit.Limiter,
+	opts ...grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(append([]grpc.ServerOption{
+		grpc.UnaryInterceptor(unaryInterceptor(logger, authn, gate, limiter)),
+		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"), authn, gate, limiter)),
+		grpc.KeepaliveEnforcementPolicy(keepaliveEnforcementPolicy),
+	}, opts...)...)
+}
+
+// ServerOptions returns the options that apply the message size limits to a server. Since gRPC compressors are
+// registered globally it sets the level of gzip compression for the whole process.
+func (conf *GRPCMessagesConfig) ServerOptions() ([]grpc.ServerOption, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	var opts []grpc.ServerOption
+	if conf.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(conf.MaxRecvMsgSize))
+	}
+	if conf.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(conf.MaxSendMsgSize))
+	}
+	if conf.GzipLevel != 0 {
+		err := gzip.SetLevel(conf.GzipLevel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GzipLevel: %v", err)
+		}
+	}
+	return opts, nil
+}
+
+// DialOptions returns the options for a client of a server with conf (such as the gateway) to accept all the messages
+// the server may send it
+func (conf *GRPCMessagesConfig) DialOptions() []grpc.DialOption {
+	if conf == nil || conf.MaxSendMsgSize <= 0 {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(conf.MaxSendMsgSize))}
+}
+
+func unaryInterceptor(logger *logging.Logger, authn *auth.Auth, gate *feature.Gate,
+	limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
@@ -28,11 +77,24 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 			}
 		}()
 		logger.TraceMsg("GRPC unary call")
+		ctx, err = authn.AuthorizeContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		err = limiter.AllowContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		err = gate.Check(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
 		return handler(ctx, req)
 	}
 }
 
-func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
+func streamInterceptor(logger *logging.Logger, authn *auth.Auth, gate *feature.Gate,
+	limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
 		logger = logger.With("method", info.FullMethod,
@@ -46,6 +108,34 @@ func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 			}
 		}()
 		logger.TraceMsg("GRPC stream call")
-		return handler(srv, ss)
+		ctx, err := authn.AuthorizeContext(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		err = limiter.AllowContext(ctx, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx, method: info.FullMethod, gate: gate})
+	}
+}
+
+// Replaces the context of a stream with one carrying the authorized identity and checks the requests it receives
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	method string
+	gate   *feature.Gate
+}
+
+func (css *contextServerStream) Context() context.Context {
+	return css.ctx
+}
+
+func (css *contextServerStream) RecvMsg(m interface{}) error {
+	err := css.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
 	}
+	return css.gate.Check(css.ctx, css.method, m)
 }
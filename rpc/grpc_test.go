@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCMessagesConfig(t *testing.T) {
+	conf := &GRPCMessagesConfig{MaxRecvMsgSize: 100, GzipLevel: 9}
+	opts, err := conf.ServerOptions()
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := NewGRPCServer(logging.NewNoopLogger(), nil, nil, nil, opts...)
+	grpc_health_v1.RegisterHealthServer(grpcServer, &flakyHealthServer{})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	check := func(policy *CallPolicy, service string) error {
+		conn, err := grpc.Dial(listener.Addr().String(), append(policy.DialOptions(), grpc.WithInsecure())...)
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(),
+			&grpc_health_v1.HealthCheckRequest{Service: service})
+		return err
+	}
+
+	require.NoError(t, check(DefaultCallPolicy(), "small"))
+	err = check(DefaultCallPolicy(), strings.Repeat("large", 100))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The server decompresses requests
+	policy := DefaultCallPolicy()
+	policy.Compressor = "gzip"
+	require.NoError(t, check(policy, "compressed"))
+
+	_, err = (&GRPCMessagesConfig{GzipLevel: 42}).ServerOptions()
+	require.Error(t, err)
+}
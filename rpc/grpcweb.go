@@ -0,0 +1,303 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcContentType        = "application/grpc"
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	// The flag marking a gRPC-web frame as holding trailers rather than a message
+	grpcWebTrailerFlag = 0x80
+	// How long we wait for a new connection to send its first bytes so we can tell whether it is HTTP/2
+	sniffTimeout = 10 * time.Second
+)
+
+// The trailers the gRPC server sets on completion of a call
+var grpcTrailers = []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"}
+
+// NewGRPCWebHandler returns a handler that serves gRPC-web requests (as made by browser clients over HTTP/1.1) by
+// translating them into gRPC requests for grpcServer. Cross-origin requests are allowed from allowedOrigins ("*"
+// allows any origin).
+func NewGRPCWebHandler(grpcServer *grpc.Server, allowedOrigins []string) http.Handler {
+	return &grpcWebHandler{
+		grpcServer:     grpcServer,
+		allowedOrigins: allowedOrigins,
+	}
+}
+
+type grpcWebHandler struct {
+	grpcServer     *grpc.Server
+	allowedOrigins []string
+}
+
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin != "" {
+		if !h.allowOrigin(origin) {
+			http.Error(w, fmt.Sprintf("origin %s is not allowed", origin), http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(grpcTrailers, ", "))
+		w.Header().Add("Vary", "Origin")
+	}
+	if r.Method == http.MethodOptions {
+		// CORS preflight
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+		w.Header().Set("Access-Control-Max-Age", "600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	var subtype string
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+	switch {
+	case text:
+		subtype = strings.TrimPrefix(contentType, grpcWebTextContentType)
+	case strings.HasPrefix(contentType, grpcWebContentType):
+		subtype = strings.TrimPrefix(contentType, grpcWebContentType)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported content type %s, expected %s or %s", contentType,
+			grpcWebContentType, grpcWebTextContentType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	// Present the request to the gRPC server as it would have arrived over HTTP/2
+	req := r.WithContext(r.Context())
+	req.Proto = "HTTP/2.0"
+	req.ProtoMajor = 2
+	req.ProtoMinor = 0
+	req.Header = make(http.Header, len(r.Header))
+	for k, vs := range r.Header {
+		req.Header[k] = vs
+	}
+	req.Header.Set("Content-Type", grpcContentType+subtype)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	if text {
+		req.Body = ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	rw := &grpcWebResponseWriter{
+		w:           w,
+		header:      make(http.Header),
+		contentType: contentType,
+		text:        text,
+	}
+	h.grpcServer.ServeHTTP(rw, req)
+	rw.finish()
+}
+
+func (h *grpcWebHandler) allowOrigin(origin string) bool {
+	for _, allowed := range h.allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// Collects the headers and trailers written by the gRPC server and writes trailers as a final gRPC-web frame in the
+// response body, base64 encoding the body for the text format
+type grpcWebResponseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	contentType string
+	text        bool
+	wroteHeader bool
+	// Body written since the last flush (for the text format which we base64 encode chunk by chunk)
+	buf bytes.Buffer
+}
+
+func (rw *grpcWebResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *grpcWebResponseWriter) WriteHeader(code int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+	h := rw.w.Header()
+	for k, vs := range rw.header {
+		// Trailers are sent in the body so must not be declared
+		if k == "Trailer" || strings.HasPrefix(k, http2.TrailerPrefix) {
+			continue
+		}
+		h[k] = vs
+	}
+	h.Set("Content-Type", rw.contentType)
+	rw.w.WriteHeader(code)
+}
+
+func (rw *grpcWebResponseWriter) Write(bs []byte) (int, error) {
+	if !rw.wroteHeader {
+		rw.WriteHeader(http.StatusOK)
+	}
+	if rw.text {
+		return rw.buf.Write(bs)
+	}
+	return rw.w.Write(bs)
+}
+
+func (rw *grpcWebResponseWriter) Flush() {
+	if !rw.wroteHeader {
+		rw.WriteHeader(http.StatusOK)
+	}
+	if rw.text && rw.buf.Len() > 0 {
+		rw.w.Write([]byte(base64.StdEncoding.EncodeToString(rw.buf.Bytes())))
+		rw.buf.Reset()
+	}
+	if flusher, ok := rw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Writes the trailers set by the gRPC server as a trailer frame
+func (rw *grpcWebResponseWriter) finish() {
+	trailers := make(map[string][]string)
+	for _, k := range grpcTrailers {
+		if vs, ok := rw.header[k]; ok {
+			trailers[strings.ToLower(k)] = vs
+		}
+	}
+	for k, vs := range rw.header {
+		if strings.HasPrefix(k, http2.TrailerPrefix) {
+			trailers[strings.ToLower(strings.TrimPrefix(k, http2.TrailerPrefix))] = vs
+		}
+	}
+	keys := make([]string, 0, len(trailers))
+	for k := range trailers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	block := new(bytes.Buffer)
+	for _, k := range keys {
+		for _, v := range trailers[k] {
+			fmt.Fprintf(block, "%s: %s\r\n", k, v)
+		}
+	}
+	frame := make([]byte, 5, 5+block.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(block.Len()))
+	rw.Write(append(frame, block.Bytes()...))
+	rw.Flush()
+}
+
+// SplitHTTP2 splits listener into a listener of the HTTP/2 connections made by gRPC clients and a listener of the
+// HTTP/1 connections made by everyone else, such as gRPC-web (browser) clients or the clients of the info server, so
+// that both may be served on the same port. Closing either closes listener.
+func SplitHTTP2(listener net.Listener) (grpcListener net.Listener, httpListener net.Listener) {
+	s := &listenerSplitter{
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	grpcConns := &subListener{listenerSplitter: s, conns: make(chan net.Conn)}
+	webConns := &subListener{listenerSplitter: s, conns: make(chan net.Conn)}
+	go s.split(grpcConns, webConns)
+	return grpcConns, webConns
+}
+
+type listenerSplitter struct {
+	listener net.Listener
+	done     chan struct{}
+	err      error
+	once     sync.Once
+}
+
+func (s *listenerSplitter) split(grpcConns, webConns *subListener) {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			s.close(err)
+			return
+		}
+		go func() {
+			sniffed, isHTTP2 := sniff(conn)
+			conns := webConns.conns
+			if isHTTP2 {
+				conns = grpcConns.conns
+			}
+			select {
+			case conns <- sniffed:
+			case <-s.done:
+				conn.Close()
+			}
+		}()
+	}
+}
+
+func (s *listenerSplitter) close(err error) error {
+	var closeErr error
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+		closeErr = s.listener.Close()
+	})
+	return closeErr
+}
+
+// Reports whether conn opens with the HTTP/2 client connection preface, returning a connection that replays the
+// bytes read to find out
+func sniff(conn net.Conn) (net.Conn, bool) {
+	reader := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(sniffTimeout))
+	preface, _ := reader.Peek(len(http2.ClientPreface))
+	conn.SetReadDeadline(time.Time{})
+	return &sniffedConn{Conn: conn, reader: reader}, string(preface) == http2.ClientPreface
+}
+
+type sniffedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (conn *sniffedConn) Read(bs []byte) (int, error) {
+	return conn.reader.Read(bs)
+}
+
+type subListener struct {
+	*listenerSplitter
+	conns chan net.Conn
+}
+
+func (l *subListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		if l.err != nil {
+			return nil, l.err
+		}
+		return nil, fmt.Errorf("listener closed")
+	}
+}
+
+func (l *subListener) Close() error {
+	return l.close(nil)
+}
+
+func (l *subListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
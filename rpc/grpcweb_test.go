@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGRPCWeb(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	grpcListener, webListener := SplitHTTP2(listener)
+	webServer := &http.Server{Handler: NewGRPCWebHandler(grpcServer, []string{"http://dapp.example"})}
+	go grpcServer.Serve(grpcListener)
+	go webServer.Serve(webListener)
+	defer webServer.Close()
+	defer grpcServer.Stop()
+
+	// Plain gRPC is still served
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	response, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+
+	url := "http://" + listener.Addr().String() + "/grpc.health.v1.Health/Check"
+	request, err := proto.Marshal(&grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	body := frame(0, request)
+
+	for _, text := range []bool{false, true} {
+		contentType := grpcWebContentType + "+proto"
+		reqBody := body
+		if text {
+			contentType = grpcWebTextContentType + "+proto"
+			reqBody = []byte(base64.StdEncoding.EncodeToString(body))
+		}
+		req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(reqBody))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Origin", "http://dapp.example")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		respBody, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(respBody))
+		assert.Equal(t, contentType, resp.Header.Get("Content-Type"))
+		assert.Equal(t, "http://dapp.example", resp.Header.Get("Access-Control-Allow-Origin"))
+		if text {
+			respBody = decodeChunks(t, respBody)
+		}
+
+		flag, message, rest := unframe(t, respBody)
+		require.Equal(t, byte(0), flag)
+		response := new(grpc_health_v1.HealthCheckResponse)
+		require.NoError(t, proto.Unmarshal(message, response))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+
+		flag, trailers, rest := unframe(t, rest)
+		require.Equal(t, byte(grpcWebTrailerFlag), flag)
+		assert.Contains(t, string(trailers), "grpc-status: 0\r\n")
+		assert.Empty(t, rest)
+	}
+
+	// Requests from other origins are refused
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", grpcWebContentType)
+	req.Header.Set("Origin", "http://evil.example")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func frame(flag byte, message []byte) []byte {
+	bs := make([]byte, 5, 5+len(message))
+	bs[0] = flag
+	binary.BigEndian.PutUint32(bs[1:], uint32(len(message)))
+	return append(bs, message...)
+}
+
+func unframe(t *testing.T, bs []byte) (flag byte, message []byte, rest []byte) {
+	require.True(t, len(bs) >= 5, "frame too short")
+	length := int(binary.BigEndian.Uint32(bs[1:5]))
+	require.True(t, len(bs) >= 5+length, "frame too short")
+	return bs[0], bs[5 : 5+length], bs[5+length:]
+}
+
+// The text format may be a concatenation of independently padded base64 chunks
+func decodeChunks(t *testing.T, bs []byte) []byte {
+	var decoded []byte
+	for len(bs) > 0 {
+		end := bytes.IndexByte(bs, '=')
+		if end < 0 {
+			end = len(bs)
+		} else {
+			for end < len(bs) && bs[end] == '=' {
+				end++
+			}
+		}
+		chunk, err := base64.StdEncoding.DecodeString(string(bs[:end]))
+		require.NoError(t, err)
+		decoded = append(decoded, chunk...)
+		bs = bs[end:]
+	}
+	return decoded
+}
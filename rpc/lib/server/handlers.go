@@ -113,44 +113,85 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger *logging.Logger) htt
 			return
 		}
 
-		var request types.RPCRequest
-		err = json.Unmarshal(b, &request)
-		if err != nil {
-			WriteRPCResponseHTTP(w, types.RPCParseError("", errors.Wrap(err, "Error unmarshalling request")))
-			return
-		}
-		// A Notification is a Request object without an "id" member.
-		// The Server MUST NOT reply to a Notification, including those that are within a batch request.
-		if request.ID == "" {
-			logger.TraceMsg("HTTPJSONRPC received a notification, skipping... (please send a non-empty ID if you want to call a method)")
-			return
-		}
 		if len(r.URL.Path) > 1 {
-			WriteRPCResponseHTTP(w, types.RPCInvalidRequestError(request.ID, errors.Errorf("Path %s is invalid", r.URL.Path)))
-			return
-		}
-		rpcFunc := funcMap[request.Method]
-		if rpcFunc == nil || rpcFunc.ws {
-			WriteRPCResponseHTTP(w, types.RPCMethodNotFoundError(request.ID))
+			WriteRPCResponseHTTP(w, types.RPCInvalidRequestError("", errors.Errorf("Path %s is invalid", r.URL.Path)))
 			return
 		}
-		var args []reflect.Value
-		if len(request.Params) > 0 {
-			args, err = jsonParamsToArgsRPC(rpcFunc, request.Params)
+		// A batch is an array of requests answered by an array of responses
+		if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '[' {
+			var batch []json.RawMessage
+			err = json.Unmarshal(trimmed, &batch)
 			if err != nil {
-				WriteRPCResponseHTTP(w, types.RPCInvalidParamsError(request.ID, errors.Wrap(err, "Error converting json params to arguments")))
+				WriteRPCResponseHTTP(w, types.RPCParseError("", errors.Wrap(err, "Error unmarshalling batch request")))
+				return
+			}
+			if len(batch) == 0 {
+				WriteRPCResponseHTTP(w, types.RPCInvalidRequestError("", errors.New("Empty batch request")))
 				return
 			}
+			responses := make([]types.RPCResponse, 0, len(batch))
+			for _, raw := range batch {
+				var request types.RPCRequest
+				err = json.Unmarshal(raw, &request)
+				if err != nil {
+					responses = append(responses, types.RPCInvalidRequestError("",
+						errors.Wrap(err, "Error unmarshalling request")))
+					continue
+				}
+				response, ok := callJSONRPC(funcMap, request, logger)
+				if ok {
+					responses = append(responses, response)
+				}
+			}
+			// Nothing is returned for a batch of notifications
+			if len(responses) == 0 {
+				return
+			}
+			WriteRPCResponsesHTTP(w, responses)
+			return
 		}
-		returns := rpcFunc.f.Call(args)
-		logger.InfoMsg("HTTP JSONRPC called", "method", request.Method, "args", args, "returns", returns)
-		result, err := unreflectResult(returns)
+
+		var request types.RPCRequest
+		err = json.Unmarshal(b, &request)
 		if err != nil {
-			WriteRPCResponseHTTP(w, types.RPCInternalError(request.ID, err))
+			WriteRPCResponseHTTP(w, types.RPCParseError("", errors.Wrap(err, "Error unmarshalling request")))
 			return
 		}
-		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(request.ID, result))
+		response, ok := callJSONRPC(funcMap, request, logger)
+		if ok {
+			WriteRPCResponseHTTP(w, response)
+		}
+	}
+}
+
+// Calls the method of request returning its response, or false if the request is a notification which must not be
+// answered
+func callJSONRPC(funcMap map[string]*RPCFunc, request types.RPCRequest, logger *logging.Logger) (types.RPCResponse, bool) {
+	// A Notification is a Request object without an "id" member.
+	// The Server MUST NOT reply to a Notification, including those that are within a batch request.
+	if request.ID == "" {
+		logger.TraceMsg("HTTPJSONRPC received a notification, skipping... (please send a non-empty ID if you want to call a method)")
+		return types.RPCResponse{}, false
+	}
+	rpcFunc := funcMap[request.Method]
+	if rpcFunc == nil || rpcFunc.ws {
+		return types.RPCMethodNotFoundError(request.ID), true
+	}
+	var args []reflect.Value
+	if len(request.Params) > 0 {
+		var err error
+		args, err = jsonParamsToArgsRPC(rpcFunc, request.Params)
+		if err != nil {
+			return types.RPCInvalidParamsError(request.ID, errors.Wrap(err, "Error converting json params to arguments")), true
+		}
+	}
+	returns := rpcFunc.f.Call(args)
+	logger.InfoMsg("HTTP JSONRPC called", "method", request.Method, "args", args, "returns", returns)
+	result, err := unreflectResult(returns)
+	if err != nil {
+		return types.RPCInternalError(request.ID, err), true
 	}
+	return types.NewRPCSuccessResponse(request.ID, result), true
 }
 
 func mapParamsToArgs(rpcFunc *RPCFunc, params map[string]json.RawMessage, argsOffset int) ([]reflect.Value, error) {
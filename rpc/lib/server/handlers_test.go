@@ -90,3 +90,46 @@ func TestRPCNotification(t *testing.T) {
 	require.Nil(t, err, "reading from the body should not give back an error")
 	require.Equal(t, len(blob), 0, "a notification SHOULD NOT be responded to by the server")
 }
+
+func TestRPCBatch(t *testing.T) {
+	mux := testMux()
+	call := func(payload string) (*http.Response, []byte) {
+		req, _ := http.NewRequest("POST", "http://localhost/", strings.NewReader(payload))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		res := rec.Result()
+		blob, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, blob
+	}
+
+	res, blob := call(`[
+		{"jsonrpc": "2.0", "method": "c", "id": "1", "params": ["a", 10]},
+		{"jsonrpc": "2.0", "method": "c", "params": ["a", 10]},
+		{"jsonrpc": "2.0", "method": "y", "id": "2"},
+		1
+	]`)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var recv []types.RPCResponse
+	require.NoError(t, json.Unmarshal(blob, &recv), "expecting an array of RPCResponse:\nblob: %s", blob)
+	// The notification is not answered
+	require.Len(t, recv, 3)
+	assert.Equal(t, "1", recv[0].ID)
+	assert.Nil(t, recv[0].Error)
+	assert.Equal(t, `"foo"`, string(recv[0].Result))
+	assert.Equal(t, "2", recv[1].ID)
+	assert.Contains(t, recv[1].Error.Message, "Method Not Found")
+	assert.Equal(t, types.RPCErrorCodeInvalidRequest, recv[2].Error.Code)
+
+	// An empty batch is a single invalid request
+	res, blob = call(`[]`)
+	single := new(types.RPCResponse)
+	require.NoError(t, json.Unmarshal(blob, single))
+	assert.Equal(t, types.RPCErrorCodeInvalidRequest, single.Error.Code)
+	assert.Equal(t, single.Error.HTTPStatusCode(), res.StatusCode)
+
+	// A batch of notifications gets nothing back
+	res, blob = call(`[{"jsonrpc": "2.0", "method": "c", "params": ["a", 10]}]`)
+	assert.True(t, statusOK(res.StatusCode))
+	assert.Empty(t, blob)
+}
@@ -39,6 +39,17 @@ func WriteRPCResponseHTTP(w http.ResponseWriter, res types.RPCResponse) {
 	w.Write(jsonBytes) // nolint: errcheck, gas
 }
 
+// Writes the responses to a batch request which, since each carries its own error, are always sent with status 200
+func WriteRPCResponsesHTTP(w http.ResponseWriter, res []types.RPCResponse) {
+	jsonBytes, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes) // nolint: errcheck, gas
+}
+
 //-----------------------------------------------------------------------------
 
 // Wraps an HTTP handler, adding error logging.
@@ -109,3 +120,10 @@ func (w *ResponseWriterWrapper) WriteHeader(status int) {
 func (w *ResponseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return w.ResponseWriter.(http.Hijacker).Hijack()
 }
+
+// implements http.Flusher
+func (w *ResponseWriterWrapper) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
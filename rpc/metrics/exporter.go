@@ -3,13 +3,18 @@
 package metrics
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"sort"
+	"sync"
 
 	"github.com/tendermint/tendermint/types"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/pubsub"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/rpc"
@@ -33,7 +38,14 @@ type Exporter struct {
 	blockSampleSize              uint64
 	txPerBlockHistogramBuilder   HistogramBuilder
 	timePerBlockHistogramBuilder HistogramBuilder
-	logger                       *logging.Logger
+	// Counts of execution warnings by code accumulated from TxExecutions (see CountWarnings)
+	warnings     map[exec.WarningCode]uint64
+	warningsLock sync.Mutex
+	// The emitter whose load is reported if set
+	emitter *event.Emitter
+	// The storage reads of executed transactions reported if set
+	storageReads *acmstate.StorageReads
+	logger       *logging.Logger
 }
 
 // Subset of rpc.Service
@@ -76,6 +88,7 @@ func NewExporter(service InfoService, blockSampleSize int, logger *logging.Logge
 		blockSampleSize:              uint64(blockSampleSize),
 		txPerBlockHistogramBuilder:   makeHistogramBuilder(identity),
 		timePerBlockHistogramBuilder: makeHistogramBuilder(significantFiguresRounder(significantFiguresForSeconds)),
+		warnings:                     make(map[exec.WarningCode]uint64),
 		logger:                       logger.With(structure.ComponentKey, "Metrics_Exporter"),
 	}, nil
 }
@@ -163,9 +176,89 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.validatorMoniker,
 	)
 
+	e.warningsLock.Lock()
+	for code, count := range e.warnings {
+		ch <- prometheus.MustNewConstMetric(
+			ExecutionWarnings,
+			prometheus.CounterValue,
+			float64(count),
+			e.chainID,
+			e.validatorMoniker,
+			code.String(),
+		)
+	}
+	e.warningsLock.Unlock()
+
+	if e.emitter != nil {
+		e.collectEvents(ch, e.emitter.Stats())
+	}
+
+	if e.storageReads != nil {
+		ch <- prometheus.MustNewConstMetric(StorageReads, prometheus.CounterValue,
+			float64(e.storageReads.Reads()), e.chainID, e.validatorMoniker)
+		ch <- prometheus.MustNewConstMetric(StorageReadsAvoided, prometheus.CounterValue,
+			float64(e.storageReads.Avoided()), e.chainID, e.validatorMoniker)
+	}
+
 	e.logger.InfoMsg("All Metrics successfully collected")
 }
 
+func (e *Exporter) collectEvents(ch chan<- prometheus.Metric, stats pubsub.Stats) {
+	for _, m := range []struct {
+		desc      *prometheus.Desc
+		valueType prometheus.ValueType
+		value     float64
+	}{
+		{EventsPublished, prometheus.CounterValue, float64(stats.Published)},
+		{EventsDropped, prometheus.CounterValue, float64(stats.Dropped)},
+		{EventsUndelivered, prometheus.CounterValue, float64(stats.Undelivered)},
+		{EventsQueued, prometheus.GaugeValue, float64(stats.Queued)},
+		{EventsQueueCapacity, prometheus.GaugeValue, float64(stats.Capacity)},
+		{EventsWorkersQueued, prometheus.GaugeValue, float64(stats.WorkersQueued)},
+		{EventsWorkersQueueCapacity, prometheus.GaugeValue, float64(stats.WorkersCapacity)},
+		{EventsSubscriptions, prometheus.GaugeValue, float64(stats.Subscriptions)},
+	} {
+		ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, m.value, e.chainID, e.validatorMoniker)
+	}
+}
+
+// CountWarnings accumulates the warnings raised by each TxExecution in blocks published by emitter until ctx is done
+func (e *Exporter) CountWarnings(ctx context.Context, emitter *event.Emitter) error {
+	subID := event.GenSubID()
+	out, err := emitter.Subscribe(ctx, subID, exec.QueryForBlockExecution(), 100)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer emitter.UnsubscribeAll(context.Background(), subID)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-out:
+				if !ok {
+					return
+				}
+				be, ok := msg.(*exec.BlockExecution)
+				if ok {
+					e.addWarnings(be.TxExecutions)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (e *Exporter) addWarnings(txes []*exec.TxExecution) {
+	e.warningsLock.Lock()
+	defer e.warningsLock.Unlock()
+	for _, txe := range txes {
+		for _, warning := range txe.Warnings {
+			e.warnings[warning.Code]++
+		}
+	}
+}
+
 // gatherData - Collects the data from the API and stores into struct
 func (e *Exporter) gatherData() error {
 	var err error
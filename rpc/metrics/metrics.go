@@ -49,6 +49,61 @@ var (
 		prometheus.BuildFQName("burrow", "accounts", "users"),
 		"Current users on the chain",
 		[]string{"chain_id", "moniker"})
+
+	ExecutionWarnings = newDesc(
+		prometheus.BuildFQName("burrow", "execution", "warnings"),
+		"Warnings raised by transaction executions since node start",
+		[]string{"chain_id", "moniker", "code"})
+
+	StorageReads = newDesc(
+		prometheus.BuildFQName("burrow", "execution", "storage_reads"),
+		"Contract storage reads made by executed transactions since node start",
+		[]string{"chain_id", "moniker"})
+
+	StorageReadsAvoided = newDesc(
+		prometheus.BuildFQName("burrow", "execution", "storage_reads_avoided"),
+		"Contract storage reads served from execution caches rather than loaded from state storage since node start",
+		[]string{"chain_id", "moniker"})
+
+	EventsPublished = newDesc(
+		prometheus.BuildFQName("burrow", "events", "published"),
+		"Events accepted for delivery to subscribers since node start",
+		[]string{"chain_id", "moniker"})
+
+	EventsDropped = newDesc(
+		prometheus.BuildFQName("burrow", "events", "dropped"),
+		"Events dropped because the event queue remained full for longer than the publish timeout",
+		[]string{"chain_id", "moniker"})
+
+	EventsUndelivered = newDesc(
+		prometheus.BuildFQName("burrow", "events", "undelivered"),
+		"Deliveries of events skipped because a subscriber's buffer was full",
+		[]string{"chain_id", "moniker"})
+
+	EventsQueued = newDesc(
+		prometheus.BuildFQName("burrow", "events", "queued"),
+		"Current depth of the event queue",
+		[]string{"chain_id", "moniker"})
+
+	EventsQueueCapacity = newDesc(
+		prometheus.BuildFQName("burrow", "events", "queue_capacity"),
+		"Capacity of the event queue",
+		[]string{"chain_id", "moniker"})
+
+	EventsWorkersQueued = newDesc(
+		prometheus.BuildFQName("burrow", "events", "workers_queued"),
+		"Current total depth of the queues of the event delivery workers",
+		[]string{"chain_id", "moniker"})
+
+	EventsWorkersQueueCapacity = newDesc(
+		prometheus.BuildFQName("burrow", "events", "workers_queue_capacity"),
+		"Total capacity of the queues of the event delivery workers",
+		[]string{"chain_id", "moniker"})
+
+	EventsSubscriptions = newDesc(
+		prometheus.BuildFQName("burrow", "events", "subscriptions"),
+		"Current number of event subscriptions",
+		[]string{"chain_id", "moniker"})
 )
 
 func newDesc(fqName, help string, variableLabels []string) *prometheus.Desc {
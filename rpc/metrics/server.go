@@ -3,6 +3,7 @@
 package metrics
 
 import (
+	"context"
 	"net"
 	"net/http"
 
@@ -10,13 +11,15 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
-func StartServer(service *rpc.Service, pattern string, listener net.Listener, blockSampleSize int,
-	logger *logging.Logger) (*http.Server, error) {
+func StartServer(service *rpc.Service, emitter *event.Emitter, storageReads *acmstate.StorageReads, pattern string,
+	listener net.Listener, blockSampleSize int, logger *logging.Logger) (*http.Server, error) {
 
 	// instantiate metrics and variables we do not expect to change during runtime
 	exporter, err := NewExporter(service, blockSampleSize, logger)
@@ -24,6 +27,18 @@ func StartServer(service *rpc.Service, pattern string, listener net.Listener, bl
 		return nil, err
 	}
 
+	// Report the load on event delivery
+	exporter.emitter = emitter
+
+	// Report the storage reads of executed transactions
+	exporter.storageReads = storageReads
+
+	// Accumulate execution warnings for the lifetime of the node
+	err = exporter.CountWarnings(context.Background(), emitter)
+	if err != nil {
+		return nil, err
+	}
+
 	// Register Metrics from each of the endpoints
 	// This invokes the Collect method through the prometheus client libraries.
 	prometheus.MustRegister(exporter)
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Configures how often each client may call RPC methods. Clients are identified by the identity they authenticated as
+// (see RPC Auth) or otherwise by their IP address.
+type Config struct {
+	Enabled bool
+	// The first limit matching a method applies, methods not matched by any limit are unlimited
+	Limits []*Limit `json:",omitempty" toml:",omitempty"`
+}
+
+type Limit struct {
+	// The gRPC method (e.g. /rpctransact.Transact/BroadcastTxSync) or HTTP path, a trailing '*' matches any suffix
+	Method string
+	// The sustained number of calls per second each client may make
+	Rate float64
+	// The number of calls each client may make in a burst above Rate (at least 1)
+	Burst int
+}
+
+// By default (once enabled) clients may broadcast less often than they query or stream events
+func DefaultConfig() *Config {
+	return &Config{
+		Enabled: false,
+		Limits: []*Limit{
+			{Method: "/rpctransact.Transact/*", Rate: 10, Burst: 20},
+			{Method: "/rpcevents.ExecutionEvents/*", Rate: 10, Burst: 20},
+			{Method: "/rpcquery.Query/*", Rate: 100, Burst: 200},
+		},
+	}
+}
+
+type limit struct {
+	*Limit
+	method string
+	prefix bool
+}
+
+func (l *Limit) compile() (*limit, error) {
+	if l.Method == "" {
+		return nil, fmt.Errorf("RPC rate limit has no Method")
+	}
+	if l.Rate <= 0 {
+		return nil, fmt.Errorf("RPC rate limit for %s must have a positive Rate", l.Method)
+	}
+	if l.Burst < 1 {
+		return nil, fmt.Errorf("RPC rate limit for %s must have a Burst of at least 1", l.Method)
+	}
+	return &limit{
+		Limit:  l,
+		method: strings.TrimSuffix(l.Method, "*"),
+		prefix: strings.HasSuffix(l.Method, "*"),
+	}, nil
+}
+
+func (l *limit) matches(method string) bool {
+	if l.prefix {
+		return strings.HasPrefix(method, l.method)
+	}
+	return method == l.method
+}
@@ -0,0 +1,195 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The metadata key under which a proxy in front of the gRPC server (such as the gateway) passes the address of its
+// client. It is only trusted from loopback peers.
+const ForwardedForKey = "x-forwarded-for"
+
+// How often to drop the buckets of clients that have not called recently
+const sweepInterval = time.Minute
+
+// Limiter limits the rate at which each client may call RPC methods with a token bucket per client and Limit. A nil
+// Limiter permits everything.
+type Limiter struct {
+	limits  []*limit
+	buckets map[bucketKey]*bucket
+	swept   time.Time
+	mtx     sync.Mutex
+	logger  *logging.Logger
+	// So tests can control refill
+	now func() time.Time
+}
+
+type bucketKey struct {
+	limit  *limit
+	client string
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New returns a Limiter for config, or nil if it is not enabled
+func New(config *Config, logger *logging.Logger) (*Limiter, error) {
+	if config == nil || !config.Enabled {
+		return nil, nil
+	}
+	limiter := &Limiter{
+		buckets: make(map[bucketKey]*bucket),
+		logger:  logger.WithScope("RPCRateLimit"),
+		now:     time.Now,
+	}
+	for _, l := range config.Limits {
+		cl, err := l.compile()
+		if err != nil {
+			return nil, err
+		}
+		limiter.limits = append(limiter.limits, cl)
+	}
+	return limiter, nil
+}
+
+// Allow takes a token from client's bucket for method, returning an error with status code ResourceExhausted if there
+// are none left
+func (limiter *Limiter) Allow(method, client string) error {
+	if limiter == nil {
+		return nil
+	}
+	l := limiter.limit(method)
+	if l == nil {
+		return nil
+	}
+	limiter.mtx.Lock()
+	defer limiter.mtx.Unlock()
+	now := limiter.now()
+	limiter.sweep(now)
+	key := bucketKey{limit: l, client: client}
+	b, ok := limiter.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Burst), last: now}
+		limiter.buckets[key] = b
+	}
+	b.refill(l, now)
+	if b.tokens < 1 {
+		limiter.logger.TraceMsg("Rate limited RPC call", "method", method, "client", client)
+		return status.Errorf(codes.ResourceExhausted, "rate limit of %v calls per second to %s exceeded",
+			l.Rate, l.Method)
+	}
+	b.tokens--
+	return nil
+}
+
+// AllowContext takes a token for a gRPC call to method by the client making the call whose context is ctx
+func (limiter *Limiter) AllowContext(ctx context.Context, method string) error {
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Allow(method, Client(ctx))
+}
+
+// Handler limits HTTP requests to next by their path
+func (limiter *Limiter) Handler(next http.Handler) http.Handler {
+	if limiter == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := identityClient(r.Context())
+		if client == "" {
+			client = hostClient(r.RemoteAddr)
+		}
+		err := limiter.Allow(r.URL.Path, client)
+		if err != nil {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, status.Convert(err).Message(), http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Client returns the key by which the client making the call whose context is ctx is limited: its authenticated
+// identity if it has one, otherwise its IP address
+func Client(ctx context.Context) string {
+	if client := identityClient(ctx); client != "" {
+		return client
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	client := hostClient(p.Addr.String())
+	if ip := net.ParseIP(client); ip != nil && ip.IsLoopback() {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if forwarded := md.Get(ForwardedForKey); len(forwarded) > 0 {
+				return forwarded[0]
+			}
+		}
+	}
+	return client
+}
+
+func identityClient(ctx context.Context) string {
+	if identity := auth.Identity(ctx); identity != acl.AnonymousIdentity {
+		return "identity:" + identity
+	}
+	return ""
+}
+
+func hostClient(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
+
+func (limiter *Limiter) limit(method string) *limit {
+	for _, l := range limiter.limits {
+		if l.matches(method) {
+			return l
+		}
+	}
+	return nil
+}
+
+// Drop buckets that have refilled since they are indistinguishable from new ones
+func (limiter *Limiter) sweep(now time.Time) {
+	if now.Sub(limiter.swept) < sweepInterval {
+		return
+	}
+	for key, b := range limiter.buckets {
+		b.refill(key.limit, now)
+		if b.tokens >= float64(key.limit.Burst) {
+			delete(limiter.buckets, key)
+		}
+	}
+	limiter.swept = now
+}
+
+func (b *bucket) refill(l *limit, now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(l.Burst), b.tokens+elapsed*l.Rate)
+		b.last = now
+	}
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const broadcast = "/rpctransact.Transact/BroadcastTxSync"
+const getAccount = "/rpcquery.Query/GetAccount"
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter, err := New(&Config{
+		Enabled: true,
+		Limits: []*Limit{
+			{Method: "/rpctransact.Transact/*", Rate: 1, Burst: 2},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	limiter.now = func() time.Time { return now }
+
+	require.NoError(t, limiter.Allow(broadcast, "a"))
+	require.NoError(t, limiter.Allow(broadcast, "a"))
+	err = limiter.Allow(broadcast, "a")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// Clients have their own buckets
+	require.NoError(t, limiter.Allow(broadcast, "b"))
+	// Unlimited methods
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Allow(getAccount, "a"))
+	}
+
+	// Refills at Rate
+	now = now.Add(time.Second)
+	require.NoError(t, limiter.Allow(broadcast, "a"))
+	err = limiter.Allow(broadcast, "a")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Idle clients are forgotten
+	now = now.Add(sweepInterval)
+	require.NoError(t, limiter.Allow(broadcast, "c"))
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func TestClient(t *testing.T) {
+	remote := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}
+	local := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}
+	forwarded := metadata.Pairs(ForwardedForKey, "10.0.0.2")
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: remote})
+	assert.Equal(t, "10.0.0.1", Client(ctx))
+	// Only trust forwarded addresses from a local proxy
+	assert.Equal(t, "10.0.0.1", Client(metadata.NewIncomingContext(ctx, forwarded)))
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: local})
+	assert.Equal(t, "10.0.0.2", Client(metadata.NewIncomingContext(ctx, forwarded)))
+}
+
+func TestLimiter_Handler(t *testing.T) {
+	limiter, err := New(&Config{
+		Enabled: true,
+		Limits:  []*Limit{{Method: "/graphql", Rate: 1, Burst: 1}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	call := func() int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", nil))
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, call())
+	assert.Equal(t, http.StatusTooManyRequests, call())
+}
+
+func TestNew(t *testing.T) {
+	limiter, err := New(DefaultConfig(), logging.NewNoopLogger())
+	require.NoError(t, err)
+	// Disabled so permits everything
+	assert.Nil(t, limiter)
+	require.NoError(t, limiter.Allow(broadcast, "a"))
+
+	_, err = New(&Config{Enabled: true, Limits: []*Limit{{Method: broadcast}}}, logging.NewNoopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Reflection clients resolve imports by path from the registry of golang/protobuf but some of the files our protos
+// import are only registered with gogoproto, or under a different path (and, for Tendermint's ABCI types, a different
+// package) from the one they are imported by
+var reflectionFileAliases = map[string]struct {
+	// The path the file is registered under
+	path string
+	// The package our protos refer to the file's types by, if it differs from the registered one
+	pkg string
+}{
+	"github.com/gogo/protobuf/gogoproto/gogo.proto":           {path: "gogo.proto"},
+	"third_party/proto/gogoproto/gogo.proto":                  {path: "gogo.proto"},
+	"github.com/tendermint/tendermint/abci/types/types.proto": {path: "abci/types/types.proto", pkg: "types"},
+}
+
+// RegisterReflection registers the server reflection service on grpcServer so that clients such as grpcurl can
+// discover and call its services without compiled protos. It must be called after the services are registered.
+func RegisterReflection(grpcServer *grpc.Server) error {
+	registered := make(map[string]bool)
+	for _, info := range grpcServer.GetServiceInfo() {
+		if protoFile, ok := info.Metadata.(string); ok {
+			err := registerReflectionFile(protoFile, registered)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	reflection.Register(grpcServer)
+	return nil
+}
+
+// Ensures protoFile and its imports are registered with golang/protobuf under the paths they are imported by
+func registerReflectionFile(protoFile string, registered map[string]bool) error {
+	if registered[protoFile] {
+		return nil
+	}
+	registered[protoFile] = true
+	gz := golang_proto.FileDescriptor(protoFile)
+	if gz != nil {
+		fd, err := decodeFileDescriptor(gz)
+		if err != nil {
+			return fmt.Errorf("could not decode descriptor of %s: %v", protoFile, err)
+		}
+		return registerReflectionImports(fd, registered)
+	}
+	alias, ok := reflectionFileAliases[protoFile]
+	if !ok {
+		alias.path = protoFile
+	}
+	gz = golang_proto.FileDescriptor(alias.path)
+	if gz == nil {
+		gz = proto.FileDescriptor(alias.path)
+	}
+	if gz == nil {
+		return fmt.Errorf("proto file %s is not registered", alias.path)
+	}
+	fd, err := decodeFileDescriptor(gz)
+	if err != nil {
+		return fmt.Errorf("could not decode descriptor of %s: %v", alias.path, err)
+	}
+	fd.Name = &protoFile
+	if alias.pkg != "" {
+		renamePackage(fd, alias.pkg)
+	}
+	gz, err = encodeFileDescriptor(fd)
+	if err != nil {
+		return err
+	}
+	golang_proto.RegisterFile(protoFile, gz)
+	return registerReflectionImports(fd, registered)
+}
+
+func registerReflectionImports(fd *descriptor.FileDescriptorProto, registered map[string]bool) error {
+	for _, dep := range fd.Dependency {
+		err := registerReflectionFile(dep, registered)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Moves the types defined in fd to pkg
+func renamePackage(fd *descriptor.FileDescriptorProto, pkg string) {
+	oldPrefix := "." + fd.GetPackage() + "."
+	newPrefix := "." + pkg + "."
+	fd.Package = &pkg
+	rename := func(typeName *string) {
+		if typeName != nil && strings.HasPrefix(*typeName, oldPrefix) {
+			*typeName = newPrefix + strings.TrimPrefix(*typeName, oldPrefix)
+		}
+	}
+	var renameMessages func(messages []*descriptor.DescriptorProto)
+	renameMessages = func(messages []*descriptor.DescriptorProto) {
+		for _, message := range messages {
+			for _, field := range message.Field {
+				rename(field.TypeName)
+				rename(field.Extendee)
+			}
+			for _, field := range message.Extension {
+				rename(field.TypeName)
+				rename(field.Extendee)
+			}
+			renameMessages(message.NestedType)
+		}
+	}
+	renameMessages(fd.MessageType)
+	for _, field := range fd.Extension {
+		rename(field.TypeName)
+		rename(field.Extendee)
+	}
+	for _, service := range fd.Service {
+		for _, method := range service.Method {
+			rename(method.InputType)
+			rename(method.OutputType)
+		}
+	}
+}
+
+func decodeFileDescriptor(gz []byte) (*descriptor.FileDescriptorProto, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	bs, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	fd := new(descriptor.FileDescriptorProto)
+	err = golang_proto.Unmarshal(bs, fd)
+	if err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+func encodeFileDescriptor(fd *descriptor.FileDescriptorProto) ([]byte, error) {
+	bs, err := golang_proto.Marshal(fd)
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	writer := gzip.NewWriter(buf)
+	_, err = writer.Write(bs)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,125 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	golang_proto "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+func TestRegisterReflection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	rpcquery.RegisterQueryServer(grpcServer, &queryServer{})
+	rpctransact.RegisterTransactServer(grpcServer, new(rpctransact.UnimplementedTransactServer))
+	rpcevents.RegisterExecutionEventsServer(grpcServer, new(rpcevents.UnimplementedExecutionEventsServer))
+	require.NoError(t, rpc.RegisterReflection(grpcServer))
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	request := func(req *rpb.ServerReflectionRequest) *rpb.ServerReflectionResponse {
+		require.NoError(t, stream.Send(req))
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Nil(t, resp.GetErrorResponse(), "%v", resp.GetErrorResponse().GetErrorMessage())
+		return resp
+	}
+
+	resp := request(&rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_ListServices{}})
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	assert.Contains(t, services, "rpcquery.Query")
+	assert.Contains(t, services, "rpctransact.Transact")
+	assert.Contains(t, services, "rpcevents.ExecutionEvents")
+
+	// Fetch the files defining the services along with all their imports as a client would
+	files := make(map[string]*descriptor.FileDescriptorProto)
+	var fetch func(files [][]byte)
+	fetch = func(encoded [][]byte) {
+		for _, bs := range encoded {
+			fd := new(descriptor.FileDescriptorProto)
+			require.NoError(t, golang_proto.Unmarshal(bs, fd))
+			files[fd.GetName()] = fd
+			for _, dep := range fd.Dependency {
+				if files[dep] == nil {
+					resp := request(&rpb.ServerReflectionRequest{
+						MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: dep},
+					})
+					fetch(resp.GetFileDescriptorResponse().GetFileDescriptorProto())
+					require.NotNil(t, files[dep], "file %s should have been fetched", dep)
+				}
+			}
+		}
+	}
+	for _, service := range services {
+		resp := request(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: service},
+		})
+		fetch(resp.GetFileDescriptorResponse().GetFileDescriptorProto())
+	}
+
+	// Every type referred to must be defined by one of the files
+	defined := make(map[string]bool)
+	var define func(prefix string, messages []*descriptor.DescriptorProto)
+	define = func(prefix string, messages []*descriptor.DescriptorProto) {
+		for _, message := range messages {
+			name := prefix + message.GetName()
+			defined[name] = true
+			for _, enum := range message.EnumType {
+				defined[name+"."+enum.GetName()] = true
+			}
+			define(name+".", message.NestedType)
+		}
+	}
+	for _, fd := range files {
+		prefix := "."
+		if fd.GetPackage() != "" {
+			prefix += fd.GetPackage() + "."
+		}
+		define(prefix, fd.MessageType)
+		for _, enum := range fd.EnumType {
+			defined[prefix+enum.GetName()] = true
+		}
+	}
+	var check func(messages []*descriptor.DescriptorProto)
+	check = func(messages []*descriptor.DescriptorProto) {
+		for _, message := range messages {
+			for _, field := range message.Field {
+				if field.TypeName != nil {
+					assert.True(t, defined[field.GetTypeName()], "type %s of field %s.%s is not defined",
+						field.GetTypeName(), message.GetName(), field.GetName())
+				}
+			}
+			check(message.NestedType)
+		}
+	}
+	for _, fd := range files {
+		check(fd.MessageType)
+		for _, service := range fd.Service {
+			for _, method := range service.Method {
+				assert.True(t, defined[method.GetInputType()], "input type %s of method %s is not defined",
+					method.GetInputType(), method.GetName())
+				assert.True(t, defined[method.GetOutputType()], "output type %s of method %s is not defined",
+					method.GetOutputType(), method.GetName())
+			}
+		}
+	}
+}
@@ -4,6 +4,8 @@
 package rpc
 
 import (
+	"time"
+
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/binary"
@@ -12,6 +14,7 @@ import (
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/registry"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/txs"
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/consensus"
@@ -181,6 +184,14 @@ type ResultGenesis struct {
 	Genesis genesis.GenesisDoc
 }
 
+type ResultBootReport struct {
+	Started time.Time
+	// Zero while the node is still booting
+	Finished time.Time
+	Duration string
+	Steps    []*process.BootStep
+}
+
 type ResultSignTx struct {
 	Tx *txs.Envelope
 }
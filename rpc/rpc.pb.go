@@ -120,39 +120,136 @@ func (m *ResultStatus) GetValidatorInfo() *validator.Validator {
 func (*ResultStatus) XXX_MessageName() string {
 	return "rpc.ResultStatus"
 }
+
+// Describes the features supported by a node so that clients can adapt to it
+type ResultCapabilities struct {
+	BurrowVersion string `protobuf:"bytes,1,opt,name=BurrowVersion,proto3" json:"BurrowVersion,omitempty"`
+	// Version of the structure of streamed execution events
+	EventSchemaVersion uint64 `protobuf:"varint,2,opt,name=EventSchemaVersion,proto3" json:"EventSchemaVersion,omitempty"`
+	// Names of the transaction payload types the node will execute
+	PayloadTypes []string `protobuf:"bytes,3,rep,name=PayloadTypes,proto3" json:"PayloadTypes,omitempty"`
+	// Fully qualified names of the GRPC methods served by the node
+	RPCMethods []string `protobuf:"bytes,4,rep,name=RPCMethods,proto3" json:"RPCMethods,omitempty"`
+	// Names of the EVM opcodes the node supports
+	Opcodes []string `protobuf:"bytes,5,rep,name=Opcodes,proto3" json:"Opcodes,omitempty"`
+	// Ethereum hard forks whose EVM semantics are supported
+	HardForks            []string `protobuf:"bytes,6,rep,name=HardForks,proto3" json:"HardForks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultCapabilities) Reset()         { *m = ResultCapabilities{} }
+func (m *ResultCapabilities) String() string { return proto.CompactTextString(m) }
+func (*ResultCapabilities) ProtoMessage()    {}
+func (*ResultCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+}
+func (m *ResultCapabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultCapabilities.Unmarshal(m, b)
+}
+func (m *ResultCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultCapabilities.Marshal(b, m, deterministic)
+}
+func (m *ResultCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultCapabilities.Merge(m, src)
+}
+func (m *ResultCapabilities) XXX_Size() int {
+	return xxx_messageInfo_ResultCapabilities.Size(m)
+}
+func (m *ResultCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultCapabilities proto.InternalMessageInfo
+
+func (m *ResultCapabilities) GetBurrowVersion() string {
+	if m != nil {
+		return m.BurrowVersion
+	}
+	return ""
+}
+
+func (m *ResultCapabilities) GetEventSchemaVersion() uint64 {
+	if m != nil {
+		return m.EventSchemaVersion
+	}
+	return 0
+}
+
+func (m *ResultCapabilities) GetPayloadTypes() []string {
+	if m != nil {
+		return m.PayloadTypes
+	}
+	return nil
+}
+
+func (m *ResultCapabilities) GetRPCMethods() []string {
+	if m != nil {
+		return m.RPCMethods
+	}
+	return nil
+}
+
+func (m *ResultCapabilities) GetOpcodes() []string {
+	if m != nil {
+		return m.Opcodes
+	}
+	return nil
+}
+
+func (m *ResultCapabilities) GetHardForks() []string {
+	if m != nil {
+		return m.HardForks
+	}
+	return nil
+}
+
+func (*ResultCapabilities) XXX_MessageName() string {
+	return "rpc.ResultCapabilities"
+}
 func init() {
 	proto.RegisterType((*ResultStatus)(nil), "rpc.ResultStatus")
 	golang_proto.RegisterType((*ResultStatus)(nil), "rpc.ResultStatus")
+	proto.RegisterType((*ResultCapabilities)(nil), "rpc.ResultCapabilities")
+	golang_proto.RegisterType((*ResultCapabilities)(nil), "rpc.ResultCapabilities")
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 func init() { golang_proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbd, 0x6e, 0xe2, 0x40,
-	0x14, 0x85, 0x19, 0x7e, 0xcd, 0x00, 0xda, 0xd5, 0x88, 0xc2, 0xa2, 0x30, 0xde, 0x15, 0x85, 0xb7,
-	0x58, 0x7b, 0xb5, 0x68, 0x9b, 0x2d, 0xcd, 0x4a, 0x0b, 0x4d, 0x8a, 0x41, 0x21, 0x52, 0x3a, 0xff,
-	0x0c, 0xf6, 0x48, 0x30, 0x63, 0x8d, 0xc7, 0x49, 0xfc, 0x76, 0x29, 0x79, 0x84, 0x28, 0x05, 0x8a,
-	0xa0, 0xcb, 0x33, 0xa4, 0x88, 0x18, 0x30, 0x31, 0x4d, 0xba, 0x7b, 0xbe, 0x73, 0xef, 0x91, 0x7d,
-	0x06, 0xb6, 0x45, 0x12, 0xd8, 0x89, 0xe0, 0x92, 0xa3, 0x9a, 0x48, 0x82, 0xc1, 0xcf, 0x88, 0xca,
-	0x38, 0xf3, 0xed, 0x80, 0xaf, 0x9d, 0x88, 0x47, 0xdc, 0x51, 0x9e, 0x9f, 0x2d, 0x95, 0x52, 0x42,
-	0x4d, 0xc7, 0x9b, 0xc1, 0x57, 0x49, 0x58, 0x48, 0xc4, 0x9a, 0x32, 0x79, 0x22, 0x5f, 0xee, 0xbc,
-	0x15, 0x0d, 0x3d, 0xc9, 0xc5, 0x09, 0xb4, 0xfd, 0x60, 0x7d, 0x1c, 0xbf, 0xbf, 0x55, 0x61, 0x17,
-	0x93, 0x34, 0x5b, 0xc9, 0xb9, 0xf4, 0x64, 0x96, 0x22, 0x1d, 0xb6, 0x26, 0xb1, 0x47, 0xd9, 0xec,
-	0x9f, 0x0e, 0x4c, 0x60, 0xb5, 0x71, 0x21, 0x51, 0x1f, 0x36, 0x70, 0x76, 0xe0, 0x55, 0xc5, 0x8f,
-	0x02, 0x8d, 0x60, 0xcf, 0xcd, 0x84, 0xe0, 0xf7, 0x0b, 0x22, 0x52, 0xca, 0x99, 0x5e, 0x53, 0xee,
-	0x25, 0x44, 0x37, 0xb0, 0xf3, 0x9f, 0x30, 0x92, 0xd2, 0x74, 0xea, 0xa5, 0xb1, 0x5e, 0x37, 0x81,
-	0xd5, 0x75, 0xff, 0x6c, 0xb6, 0xc3, 0xca, 0xf3, 0x76, 0x58, 0xfe, 0xc1, 0x38, 0x4f, 0x88, 0x58,
-	0x91, 0x30, 0x22, 0xc2, 0xf1, 0x55, 0x84, 0xe3, 0x53, 0xe6, 0x89, 0xdc, 0x9e, 0x92, 0x07, 0x37,
-	0x97, 0x24, 0xc5, 0xe5, 0x24, 0xf4, 0x0b, 0x6a, 0x57, 0x3c, 0x24, 0x33, 0xb6, 0xe4, 0x7a, 0xc3,
-	0x04, 0x56, 0xe7, 0x77, 0xdf, 0x2e, 0x15, 0x50, 0x78, 0xf8, 0xbc, 0x85, 0x7e, 0x40, 0x6d, 0x9e,
-	0xb3, 0x40, 0x5d, 0x34, 0xd5, 0x45, 0xcf, 0x3e, 0xf4, 0x51, 0x40, 0x7c, 0xb6, 0xd1, 0x08, 0xc2,
-	0x89, 0x27, 0x83, 0x98, 0xb2, 0xe8, 0x3a, 0xd1, 0x35, 0x13, 0x58, 0x9a, 0x5b, 0x7f, 0xdd, 0x0e,
-	0x2b, 0xb8, 0xc4, 0xd1, 0x5f, 0xd8, 0x5b, 0x14, 0x05, 0xab, 0xd4, 0xd6, 0xe9, 0x3b, 0x3e, 0x6a,
-	0x3f, 0xfb, 0xf8, 0x72, 0xd5, 0x1d, 0x3f, 0xed, 0x0c, 0xf0, 0xb2, 0x33, 0xc0, 0xe3, 0xde, 0x00,
-	0x9b, 0xbd, 0x01, 0x6e, 0xbf, 0x7d, 0x5e, 0x86, 0x48, 0x02, 0xbf, 0xa9, 0x9e, 0x6e, 0xfc, 0x1e,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0x87, 0x65, 0xef, 0x29, 0x02, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe7, 0xf5, 0x65, 0x8d, 0xd7, 0x0a, 0x64, 0xed, 0x60, 0x4d, 0x28, 0x0d, 0xd5, 0x0e,
+	0xe1, 0x40, 0x8a, 0x98, 0xb8, 0x70, 0x4c, 0x79, 0xe9, 0x0e, 0xc0, 0xe4, 0xc2, 0x90, 0xb8, 0x39,
+	0x89, 0x97, 0x58, 0xa4, 0x76, 0x64, 0x3b, 0x83, 0x7c, 0x3b, 0x8e, 0xfb, 0x08, 0x88, 0x43, 0x85,
+	0x36, 0x89, 0x03, 0x9f, 0x81, 0x03, 0xaa, 0xdb, 0x74, 0xa9, 0xa8, 0xb8, 0xf9, 0xf9, 0x3d, 0xcf,
+	0xff, 0xaf, 0xe4, 0xb1, 0xa1, 0xa3, 0x8a, 0x38, 0x28, 0x94, 0x34, 0x12, 0xb5, 0x54, 0x11, 0x1f,
+	0x3f, 0x4e, 0xb9, 0xc9, 0xca, 0x28, 0x88, 0xe5, 0x7c, 0x9c, 0xca, 0x54, 0x8e, 0xad, 0x17, 0x95,
+	0x97, 0x56, 0x59, 0x61, 0x4f, 0xab, 0x99, 0xe3, 0xfb, 0x86, 0x89, 0x84, 0xa9, 0x39, 0x17, 0x66,
+	0x4d, 0xee, 0x5d, 0xd1, 0x9c, 0x27, 0xd4, 0x48, 0xb5, 0x06, 0x4e, 0x14, 0xcf, 0x57, 0xc7, 0xd1,
+	0x9f, 0x7d, 0xd8, 0x27, 0x4c, 0x97, 0xb9, 0x99, 0x19, 0x6a, 0x4a, 0x8d, 0x30, 0x3c, 0x98, 0x64,
+	0x94, 0x8b, 0xb3, 0x17, 0x18, 0x78, 0xc0, 0x77, 0x48, 0x2d, 0xd1, 0x11, 0xec, 0x90, 0x72, 0xc9,
+	0xf7, 0x2d, 0x5f, 0x09, 0x74, 0x02, 0x07, 0x61, 0xa9, 0x94, 0xfc, 0x72, 0xc1, 0x94, 0xe6, 0x52,
+	0xe0, 0x96, 0x75, 0xb7, 0x21, 0xfa, 0x08, 0x0f, 0x5f, 0x33, 0xc1, 0x34, 0xd7, 0x53, 0xaa, 0x33,
+	0xdc, 0xf6, 0x80, 0xdf, 0x0f, 0x9f, 0x5d, 0x2f, 0x86, 0x7b, 0x3f, 0x16, 0xc3, 0xe6, 0x0f, 0x66,
+	0x55, 0xc1, 0x54, 0xce, 0x92, 0x94, 0xa9, 0x71, 0x64, 0x57, 0x8c, 0x23, 0x2e, 0xa8, 0xaa, 0x82,
+	0x29, 0xfb, 0x1a, 0x56, 0x86, 0x69, 0xd2, 0xdc, 0x84, 0x9e, 0xc0, 0xde, 0x5b, 0x99, 0xb0, 0x33,
+	0x71, 0x29, 0x71, 0xc7, 0x03, 0xfe, 0xe1, 0xd3, 0xa3, 0xa0, 0x51, 0x40, 0xed, 0x91, 0x4d, 0x0a,
+	0x3d, 0x82, 0xbd, 0x59, 0x25, 0x62, 0x3b, 0xd1, 0xb5, 0x13, 0x83, 0x60, 0xd9, 0x47, 0x0d, 0xc9,
+	0xc6, 0x46, 0x27, 0x10, 0x4e, 0xa8, 0x89, 0x33, 0x2e, 0xd2, 0x0f, 0x05, 0xee, 0x79, 0xc0, 0xef,
+	0x85, 0xed, 0xdf, 0x8b, 0xe1, 0x1e, 0x69, 0x70, 0xf4, 0x1c, 0x0e, 0x2e, 0xea, 0x82, 0xed, 0xd6,
+	0x83, 0xf5, 0x77, 0xdc, 0xd5, 0xbe, 0xf1, 0xc9, 0x76, 0x74, 0xf4, 0x0b, 0x40, 0xb4, 0xaa, 0x7f,
+	0x42, 0x0b, 0x1a, 0xf1, 0x9c, 0x1b, 0xce, 0xf4, 0xbf, 0xa5, 0x82, 0x5d, 0xa5, 0x06, 0x10, 0xbd,
+	0xbc, 0x62, 0xc2, 0xcc, 0xe2, 0x8c, 0xcd, 0x69, 0x1d, 0x5d, 0xde, 0x4e, 0x9b, 0xec, 0x70, 0xd0,
+	0x08, 0xf6, 0xcf, 0x69, 0x95, 0x4b, 0x9a, 0xbc, 0xaf, 0x0a, 0xa6, 0x71, 0xcb, 0x6b, 0xf9, 0x0e,
+	0xd9, 0x62, 0xc8, 0x85, 0x90, 0x9c, 0x4f, 0xde, 0x30, 0x93, 0xc9, 0x44, 0xe3, 0xb6, 0x4d, 0x34,
+	0xc8, 0xf2, 0x79, 0xbc, 0x2b, 0x62, 0x99, 0x30, 0x8d, 0x3b, 0xd6, 0xac, 0x25, 0x7a, 0x00, 0x9d,
+	0x29, 0x55, 0xc9, 0x2b, 0xa9, 0x3e, 0x6b, 0xdc, 0xb5, 0xde, 0x1d, 0x08, 0x4f, 0xbf, 0xdf, 0xb8,
+	0xe0, 0xe7, 0x8d, 0x0b, 0xbe, 0xdd, 0xba, 0xe0, 0xfa, 0xd6, 0x05, 0x9f, 0x1e, 0xfe, 0xff, 0xd6,
+	0x55, 0x11, 0x47, 0x5d, 0xfb, 0x46, 0x4f, 0xff, 0x0e, 0x00, 0x41, 0x8e, 0x79, 0xa0, 0x12, 0x03,
+	0x00, 0x00,
 }
 
 func (m *ResultStatus) Size() (n int) {
@@ -196,6 +293,49 @@ func (m *ResultStatus) Size() (n int) {
 	return n
 }
 
+func (m *ResultCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BurrowVersion)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.EventSchemaVersion != 0 {
+		n += 1 + sovRpc(uint64(m.EventSchemaVersion))
+	}
+	if len(m.PayloadTypes) > 0 {
+		for _, s := range m.PayloadTypes {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if len(m.RPCMethods) > 0 {
+		for _, s := range m.RPCMethods {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if len(m.Opcodes) > 0 {
+		for _, s := range m.Opcodes {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if len(m.HardForks) > 0 {
+		for _, s := range m.HardForks {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRpc(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -0,0 +1,135 @@
+package rpcadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/breaker"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+const SubscribeBufferSize = 100
+
+type adminServer struct {
+	circuitBreaker *breaker.CircuitBreaker
+	emitter        *event.Emitter
+	blockchain     bcm.BlockchainInfo
+	logging        *logconfig.Live
+	logger         *logging.Logger
+}
+
+var _ AdminServer = &adminServer{}
+
+func NewAdminServer(circuitBreaker *breaker.CircuitBreaker, emitter *event.Emitter, blockchain bcm.BlockchainInfo,
+	liveLogging *logconfig.Live, logger *logging.Logger) *adminServer {
+	return &adminServer{
+		circuitBreaker: circuitBreaker,
+		emitter:        emitter,
+		blockchain:     blockchain,
+		logging:        liveLogging,
+		logger:         logger,
+	}
+}
+
+func (as *adminServer) GetCircuitBreaker(ctx context.Context, param *GetCircuitBreakerParam) (*breaker.Status, error) {
+	if as.circuitBreaker == nil {
+		return nil, fmt.Errorf("circuit breaker is not enabled on this node")
+	}
+	return as.circuitBreaker.Status(), nil
+}
+
+func (as *adminServer) OverrideCircuitBreaker(ctx context.Context,
+	param *OverrideCircuitBreakerParam) (*breaker.Status, error) {
+	if as.circuitBreaker == nil {
+		return nil, fmt.Errorf("circuit breaker is not enabled on this node")
+	}
+	as.logger.InfoMsg("Overriding circuit breaker", "override", param.Override.String())
+	return as.circuitBreaker.Override(param.Override, as.blockchain.LastBlockHeight()), nil
+}
+
+func (as *adminServer) StreamCircuitBreakerEvents(param *StreamCircuitBreakerEventsParam,
+	stream Admin_StreamCircuitBreakerEventsServer) (err error) {
+	ctx := stream.Context()
+	subID := event.GenSubID()
+	out, err := as.emitter.Subscribe(ctx, subID, breaker.Query, SubscribeBufferSize)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = as.emitter.UnsubscribeAll(context.Background(), subID)
+		for range out {
+			// flush
+		}
+	}()
+
+	for msg := range out {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			err = stream.Send(msg.(*breaker.Event))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (as *adminServer) GetLogSpec(ctx context.Context, param *GetLogSpecParam) (*LogSpec, error) {
+	if as.logging == nil {
+		return nil, fmt.Errorf("logging configuration is not available on this node")
+	}
+	return logSpec(as.logging.Config()), nil
+}
+
+func (as *adminServer) SetLogSpec(ctx context.Context, param *SetLogSpecParam) (*LogSpec, error) {
+	if as.logging == nil {
+		return nil, fmt.Errorf("logging configuration is not available on this node")
+	}
+	config, err := as.logging.Update(func(config *logconfig.LoggingConfig) error {
+		for _, channel := range param.Channels {
+			err := config.SetChannelEnabled(channel.Name, channel.Enabled)
+			if err != nil {
+				return err
+			}
+		}
+		for _, sink := range param.Sinks {
+			sinkConfig, err := config.Sink(sink.Path)
+			if err != nil {
+				return err
+			}
+			sinkConfig.Disabled = !sink.Enabled
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	spec := logSpec(config)
+	as.logger.InfoMsg("Changed logging configuration", "log_spec", spec.String())
+	return spec, nil
+}
+
+func logSpec(config *logconfig.LoggingConfig) *LogSpec {
+	channelNames := []string{structure.InfoChannelName, structure.TraceChannelName}
+	spec := &LogSpec{
+		Channels: make([]*LogChannel, len(channelNames)),
+	}
+	for i, name := range channelNames {
+		enabled, _ := config.ChannelEnabled(name)
+		spec.Channels[i] = &LogChannel{Name: name, Enabled: enabled}
+	}
+	for _, sink := range config.Sinks() {
+		spec.Sinks = append(spec.Sinks, &LogSink{
+			Path:        sink.Path,
+			Description: sink.Description,
+			Enabled:     sink.Enabled,
+		})
+	}
+	return spec
+}
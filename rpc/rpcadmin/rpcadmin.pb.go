@@ -0,0 +1,852 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpcadmin.proto
+
+package rpcadmin
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	breaker "github.com/hyperledger/burrow/execution/breaker"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetCircuitBreakerParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCircuitBreakerParam) Reset()         { *m = GetCircuitBreakerParam{} }
+func (m *GetCircuitBreakerParam) String() string { return proto.CompactTextString(m) }
+func (*GetCircuitBreakerParam) ProtoMessage()    {}
+func (*GetCircuitBreakerParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{0}
+}
+func (m *GetCircuitBreakerParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCircuitBreakerParam.Unmarshal(m, b)
+}
+func (m *GetCircuitBreakerParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCircuitBreakerParam.Marshal(b, m, deterministic)
+}
+func (m *GetCircuitBreakerParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCircuitBreakerParam.Merge(m, src)
+}
+func (m *GetCircuitBreakerParam) XXX_Size() int {
+	return xxx_messageInfo_GetCircuitBreakerParam.Size(m)
+}
+func (m *GetCircuitBreakerParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCircuitBreakerParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCircuitBreakerParam proto.InternalMessageInfo
+
+func (*GetCircuitBreakerParam) XXX_MessageName() string {
+	return "rpcadmin.GetCircuitBreakerParam"
+}
+
+type OverrideCircuitBreakerParam struct {
+	Override             breaker.Override `protobuf:"varint,1,opt,name=Override,proto3,enum=breaker.Override" json:"Override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *OverrideCircuitBreakerParam) Reset()         { *m = OverrideCircuitBreakerParam{} }
+func (m *OverrideCircuitBreakerParam) String() string { return proto.CompactTextString(m) }
+func (*OverrideCircuitBreakerParam) ProtoMessage()    {}
+func (*OverrideCircuitBreakerParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{1}
+}
+func (m *OverrideCircuitBreakerParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OverrideCircuitBreakerParam.Unmarshal(m, b)
+}
+func (m *OverrideCircuitBreakerParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OverrideCircuitBreakerParam.Marshal(b, m, deterministic)
+}
+func (m *OverrideCircuitBreakerParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverrideCircuitBreakerParam.Merge(m, src)
+}
+func (m *OverrideCircuitBreakerParam) XXX_Size() int {
+	return xxx_messageInfo_OverrideCircuitBreakerParam.Size(m)
+}
+func (m *OverrideCircuitBreakerParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverrideCircuitBreakerParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverrideCircuitBreakerParam proto.InternalMessageInfo
+
+func (m *OverrideCircuitBreakerParam) GetOverride() breaker.Override {
+	if m != nil {
+		return m.Override
+	}
+	return breaker.Override_AUTO
+}
+
+func (*OverrideCircuitBreakerParam) XXX_MessageName() string {
+	return "rpcadmin.OverrideCircuitBreakerParam"
+}
+
+type StreamCircuitBreakerEventsParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamCircuitBreakerEventsParam) Reset()         { *m = StreamCircuitBreakerEventsParam{} }
+func (m *StreamCircuitBreakerEventsParam) String() string { return proto.CompactTextString(m) }
+func (*StreamCircuitBreakerEventsParam) ProtoMessage()    {}
+func (*StreamCircuitBreakerEventsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{2}
+}
+func (m *StreamCircuitBreakerEventsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamCircuitBreakerEventsParam.Unmarshal(m, b)
+}
+func (m *StreamCircuitBreakerEventsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamCircuitBreakerEventsParam.Marshal(b, m, deterministic)
+}
+func (m *StreamCircuitBreakerEventsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamCircuitBreakerEventsParam.Merge(m, src)
+}
+func (m *StreamCircuitBreakerEventsParam) XXX_Size() int {
+	return xxx_messageInfo_StreamCircuitBreakerEventsParam.Size(m)
+}
+func (m *StreamCircuitBreakerEventsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamCircuitBreakerEventsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamCircuitBreakerEventsParam proto.InternalMessageInfo
+
+func (*StreamCircuitBreakerEventsParam) XXX_MessageName() string {
+	return "rpcadmin.StreamCircuitBreakerEventsParam"
+}
+
+type GetLogSpecParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogSpecParam) Reset()         { *m = GetLogSpecParam{} }
+func (m *GetLogSpecParam) String() string { return proto.CompactTextString(m) }
+func (*GetLogSpecParam) ProtoMessage()    {}
+func (*GetLogSpecParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{3}
+}
+func (m *GetLogSpecParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogSpecParam.Unmarshal(m, b)
+}
+func (m *GetLogSpecParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogSpecParam.Marshal(b, m, deterministic)
+}
+func (m *GetLogSpecParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogSpecParam.Merge(m, src)
+}
+func (m *GetLogSpecParam) XXX_Size() int {
+	return xxx_messageInfo_GetLogSpecParam.Size(m)
+}
+func (m *GetLogSpecParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogSpecParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogSpecParam proto.InternalMessageInfo
+
+func (*GetLogSpecParam) XXX_MessageName() string {
+	return "rpcadmin.GetLogSpecParam"
+}
+
+type SetLogSpecParam struct {
+	// The channels to enable or disable by Name, channels not listed are left as they are
+	Channels []*LogChannel `protobuf:"bytes,1,rep,name=Channels,proto3" json:"Channels,omitempty"`
+	// The sinks to enable or disable by Path, sinks not listed are left as they are
+	Sinks                []*LogSink `protobuf:"bytes,2,rep,name=Sinks,proto3" json:"Sinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetLogSpecParam) Reset()         { *m = SetLogSpecParam{} }
+func (m *SetLogSpecParam) String() string { return proto.CompactTextString(m) }
+func (*SetLogSpecParam) ProtoMessage()    {}
+func (*SetLogSpecParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{4}
+}
+func (m *SetLogSpecParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogSpecParam.Unmarshal(m, b)
+}
+func (m *SetLogSpecParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogSpecParam.Marshal(b, m, deterministic)
+}
+func (m *SetLogSpecParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogSpecParam.Merge(m, src)
+}
+func (m *SetLogSpecParam) XXX_Size() int {
+	return xxx_messageInfo_SetLogSpecParam.Size(m)
+}
+func (m *SetLogSpecParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogSpecParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogSpecParam proto.InternalMessageInfo
+
+func (m *SetLogSpecParam) GetChannels() []*LogChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *SetLogSpecParam) GetSinks() []*LogSink {
+	if m != nil {
+		return m.Sinks
+	}
+	return nil
+}
+
+func (*SetLogSpecParam) XXX_MessageName() string {
+	return "rpcadmin.SetLogSpecParam"
+}
+
+type LogSpec struct {
+	Channels []*LogChannel `protobuf:"bytes,1,rep,name=Channels,proto3" json:"Channels,omitempty"`
+	// The sinks of the logging configuration with each listed before its children
+	Sinks                []*LogSink `protobuf:"bytes,2,rep,name=Sinks,proto3" json:"Sinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *LogSpec) Reset()         { *m = LogSpec{} }
+func (m *LogSpec) String() string { return proto.CompactTextString(m) }
+func (*LogSpec) ProtoMessage()    {}
+func (*LogSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{5}
+}
+func (m *LogSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogSpec.Unmarshal(m, b)
+}
+func (m *LogSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogSpec.Marshal(b, m, deterministic)
+}
+func (m *LogSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSpec.Merge(m, src)
+}
+func (m *LogSpec) XXX_Size() int {
+	return xxx_messageInfo_LogSpec.Size(m)
+}
+func (m *LogSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSpec proto.InternalMessageInfo
+
+func (m *LogSpec) GetChannels() []*LogChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *LogSpec) GetSinks() []*LogSink {
+	if m != nil {
+		return m.Sinks
+	}
+	return nil
+}
+
+func (*LogSpec) XXX_MessageName() string {
+	return "rpcadmin.LogSpec"
+}
+
+type LogChannel struct {
+	// Info or Trace
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogChannel) Reset()         { *m = LogChannel{} }
+func (m *LogChannel) String() string { return proto.CompactTextString(m) }
+func (*LogChannel) ProtoMessage()    {}
+func (*LogChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{6}
+}
+func (m *LogChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogChannel.Unmarshal(m, b)
+}
+func (m *LogChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogChannel.Marshal(b, m, deterministic)
+}
+func (m *LogChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogChannel.Merge(m, src)
+}
+func (m *LogChannel) XXX_Size() int {
+	return xxx_messageInfo_LogChannel.Size(m)
+}
+func (m *LogChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogChannel proto.InternalMessageInfo
+
+func (m *LogChannel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LogChannel) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (*LogChannel) XXX_MessageName() string {
+	return "rpcadmin.LogChannel"
+}
+
+type LogSink struct {
+	// The path of the sink from the root sink, e.g. root.0.1 for the second child of the first child of the root
+	Path                 string   `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Enabled              bool     `protobuf:"varint,3,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogSink) Reset()         { *m = LogSink{} }
+func (m *LogSink) String() string { return proto.CompactTextString(m) }
+func (*LogSink) ProtoMessage()    {}
+func (*LogSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_442f34316cb3b746, []int{7}
+}
+func (m *LogSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogSink.Unmarshal(m, b)
+}
+func (m *LogSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogSink.Marshal(b, m, deterministic)
+}
+func (m *LogSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSink.Merge(m, src)
+}
+func (m *LogSink) XXX_Size() int {
+	return xxx_messageInfo_LogSink.Size(m)
+}
+func (m *LogSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSink proto.InternalMessageInfo
+
+func (m *LogSink) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *LogSink) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *LogSink) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (*LogSink) XXX_MessageName() string {
+	return "rpcadmin.LogSink"
+}
+func init() {
+	proto.RegisterType((*GetCircuitBreakerParam)(nil), "rpcadmin.GetCircuitBreakerParam")
+	golang_proto.RegisterType((*GetCircuitBreakerParam)(nil), "rpcadmin.GetCircuitBreakerParam")
+	proto.RegisterType((*OverrideCircuitBreakerParam)(nil), "rpcadmin.OverrideCircuitBreakerParam")
+	golang_proto.RegisterType((*OverrideCircuitBreakerParam)(nil), "rpcadmin.OverrideCircuitBreakerParam")
+	proto.RegisterType((*StreamCircuitBreakerEventsParam)(nil), "rpcadmin.StreamCircuitBreakerEventsParam")
+	golang_proto.RegisterType((*StreamCircuitBreakerEventsParam)(nil), "rpcadmin.StreamCircuitBreakerEventsParam")
+	proto.RegisterType((*GetLogSpecParam)(nil), "rpcadmin.GetLogSpecParam")
+	golang_proto.RegisterType((*GetLogSpecParam)(nil), "rpcadmin.GetLogSpecParam")
+	proto.RegisterType((*SetLogSpecParam)(nil), "rpcadmin.SetLogSpecParam")
+	golang_proto.RegisterType((*SetLogSpecParam)(nil), "rpcadmin.SetLogSpecParam")
+	proto.RegisterType((*LogSpec)(nil), "rpcadmin.LogSpec")
+	golang_proto.RegisterType((*LogSpec)(nil), "rpcadmin.LogSpec")
+	proto.RegisterType((*LogChannel)(nil), "rpcadmin.LogChannel")
+	golang_proto.RegisterType((*LogChannel)(nil), "rpcadmin.LogChannel")
+	proto.RegisterType((*LogSink)(nil), "rpcadmin.LogSink")
+	golang_proto.RegisterType((*LogSink)(nil), "rpcadmin.LogSink")
+}
+
+func init() { proto.RegisterFile("rpcadmin.proto", fileDescriptor_442f34316cb3b746) }
+func init() { golang_proto.RegisterFile("rpcadmin.proto", fileDescriptor_442f34316cb3b746) }
+
+var fileDescriptor_442f34316cb3b746 = []byte{
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x97, 0x3b, 0xc6, 0xba, 0x6f, 0xa2, 0x55, 0x2d, 0x34, 0x85, 0x20, 0x95, 0x10, 0x09, 0x51,
+	0x90, 0xd6, 0x4c, 0xe5, 0x56, 0x71, 0x61, 0x63, 0xec, 0x52, 0x41, 0x95, 0x9c, 0xc6, 0xcd, 0x49,
+	0x4c, 0x6a, 0xb5, 0xb1, 0x23, 0xc7, 0x19, 0xe2, 0xed, 0x38, 0xf2, 0x08, 0x1c, 0xd1, 0xf6, 0x0a,
+	0x3c, 0x00, 0x8a, 0xbd, 0x24, 0x0d, 0x0b, 0xec, 0xb4, 0x9b, 0xbf, 0xef, 0xf7, 0xe7, 0xf3, 0x9f,
+	0x9f, 0x61, 0x20, 0xb3, 0x88, 0xc4, 0x29, 0xe3, 0xd3, 0x4c, 0x0a, 0x25, 0x70, 0xbf, 0xaa, 0xed,
+	0xa3, 0x84, 0xa9, 0x55, 0x11, 0x4e, 0x23, 0x91, 0x7a, 0x89, 0x48, 0x84, 0xa7, 0x09, 0x61, 0xf1,
+	0x45, 0x57, 0xba, 0xd0, 0x2b, 0x23, 0xb4, 0x1f, 0x85, 0x92, 0x92, 0x35, 0x95, 0xa6, 0x74, 0x2d,
+	0x38, 0x3c, 0xa7, 0xea, 0x94, 0xc9, 0xa8, 0x60, 0xea, 0xc4, 0x40, 0x4b, 0x22, 0x49, 0xea, 0x2e,
+	0xe0, 0xe9, 0xa7, 0x4b, 0x2a, 0x25, 0x8b, 0x69, 0x07, 0x8c, 0x8f, 0xa0, 0x5f, 0xc1, 0x16, 0x72,
+	0xd0, 0x64, 0x30, 0x1b, 0x4d, 0x2b, 0xeb, 0x0a, 0xf0, 0x6b, 0x8a, 0xfb, 0x1c, 0x9e, 0x05, 0x4a,
+	0x52, 0x92, 0xb6, 0xbd, 0xce, 0x2e, 0x29, 0x57, 0xb9, 0x19, 0x38, 0x82, 0xe1, 0x39, 0x55, 0x0b,
+	0x91, 0x04, 0x19, 0x8d, 0x4c, 0x6b, 0x03, 0xc3, 0xa0, 0xdd, 0xc2, 0xc7, 0xd0, 0x3f, 0x5d, 0x11,
+	0xce, 0xe9, 0x26, 0xb7, 0x90, 0xb3, 0x33, 0x39, 0x98, 0x3d, 0x9e, 0xd6, 0x77, 0xb3, 0x10, 0xc9,
+	0x0d, 0xe8, 0xd7, 0x2c, 0xfc, 0x12, 0x76, 0x03, 0xc6, 0xd7, 0xb9, 0xd5, 0xd3, 0xf4, 0x51, 0x8b,
+	0x5e, 0x22, 0xbe, 0xc1, 0xdd, 0x18, 0xf6, 0x6e, 0x46, 0xdd, 0xe7, 0x94, 0x39, 0x40, 0x63, 0x80,
+	0x31, 0x3c, 0xf8, 0x48, 0x52, 0x73, 0x85, 0xfb, 0xbe, 0x5e, 0x63, 0x0b, 0xf6, 0xce, 0x38, 0x09,
+	0x37, 0x34, 0xb6, 0x7a, 0x0e, 0x9a, 0xf4, 0xfd, 0xaa, 0x74, 0x2f, 0xcc, 0x0e, 0x19, 0x5f, 0x97,
+	0xc2, 0x25, 0x51, 0xab, 0x4a, 0x58, 0xae, 0xb1, 0x03, 0x07, 0xef, 0x69, 0x1e, 0x49, 0x96, 0x29,
+	0x26, 0xb8, 0x16, 0xef, 0xfb, 0xdb, 0xad, 0x6d, 0xeb, 0x9d, 0x96, 0xf5, 0xec, 0x77, 0x0f, 0x76,
+	0xdf, 0x95, 0xfb, 0xc5, 0x1f, 0x60, 0x74, 0x2b, 0x12, 0xd8, 0x69, 0xce, 0xd3, 0x9d, 0x17, 0x7b,
+	0x58, 0x3f, 0x7f, 0xa0, 0x88, 0x2a, 0x72, 0xbc, 0x84, 0xc3, 0xee, 0x00, 0xe1, 0x17, 0x8d, 0xd9,
+	0x7f, 0x22, 0x76, 0xdb, 0xf1, 0x02, 0xec, 0x7f, 0x87, 0x08, 0xbf, 0x6a, 0x5c, 0xef, 0x88, 0x9a,
+	0x3d, 0xa8, 0x9d, 0x75, 0xf7, 0x18, 0xe1, 0x39, 0x40, 0x13, 0x3e, 0xfc, 0xa4, 0x75, 0xda, 0xed,
+	0xfc, 0xd9, 0x7f, 0x3d, 0x6c, 0xc9, 0x9e, 0x03, 0x04, 0x9d, 0xda, 0xe0, 0x4e, 0xed, 0xc9, 0xdb,
+	0x9f, 0x57, 0x63, 0xf4, 0xeb, 0x6a, 0x8c, 0xbe, 0x5f, 0x8f, 0xd1, 0x8f, 0xeb, 0x31, 0xfa, 0xfc,
+	0x7a, 0xeb, 0x3f, 0xaf, 0xbe, 0x65, 0x54, 0x6e, 0x68, 0x9c, 0x50, 0xe9, 0x85, 0x85, 0x94, 0xe2,
+	0xab, 0x27, 0xb3, 0xc8, 0xab, 0x5c, 0xc2, 0x87, 0xfa, 0x13, 0xbf, 0xf9, 0x33, 0x00, 0x27, 0x05,
+	0x0e, 0xe8, 0x1e, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// Get the current state of the execution circuit breaker
+	GetCircuitBreaker(ctx context.Context, in *GetCircuitBreakerParam, opts ...grpc.CallOption) (*breaker.Status, error)
+	// Override the automatic behaviour of the execution circuit breaker
+	OverrideCircuitBreaker(ctx context.Context, in *OverrideCircuitBreakerParam, opts ...grpc.CallOption) (*breaker.Status, error)
+	// Stream notifications of the circuit breaker tripping and resetting
+	StreamCircuitBreakerEvents(ctx context.Context, in *StreamCircuitBreakerEventsParam, opts ...grpc.CallOption) (Admin_StreamCircuitBreakerEventsClient, error)
+	// Get the logging channels and sinks of the node and whether each is enabled
+	GetLogSpec(ctx context.Context, in *GetLogSpecParam, opts ...grpc.CallOption) (*LogSpec, error)
+	// Enable or disable logging channels and sinks without restarting the node
+	SetLogSpec(ctx context.Context, in *SetLogSpecParam, opts ...grpc.CallOption) (*LogSpec, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetCircuitBreaker(ctx context.Context, in *GetCircuitBreakerParam, opts ...grpc.CallOption) (*breaker.Status, error) {
+	out := new(breaker.Status)
+	err := c.cc.Invoke(ctx, "/rpcadmin.Admin/GetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) OverrideCircuitBreaker(ctx context.Context, in *OverrideCircuitBreakerParam, opts ...grpc.CallOption) (*breaker.Status, error) {
+	out := new(breaker.Status)
+	err := c.cc.Invoke(ctx, "/rpcadmin.Admin/OverrideCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) StreamCircuitBreakerEvents(ctx context.Context, in *StreamCircuitBreakerEventsParam, opts ...grpc.CallOption) (Admin_StreamCircuitBreakerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Admin_serviceDesc.Streams[0], "/rpcadmin.Admin/StreamCircuitBreakerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminStreamCircuitBreakerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_StreamCircuitBreakerEventsClient interface {
+	Recv() (*breaker.Event, error)
+	grpc.ClientStream
+}
+
+type adminStreamCircuitBreakerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminStreamCircuitBreakerEventsClient) Recv() (*breaker.Event, error) {
+	m := new(breaker.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) GetLogSpec(ctx context.Context, in *GetLogSpecParam, opts ...grpc.CallOption) (*LogSpec, error) {
+	out := new(LogSpec)
+	err := c.cc.Invoke(ctx, "/rpcadmin.Admin/GetLogSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetLogSpec(ctx context.Context, in *SetLogSpecParam, opts ...grpc.CallOption) (*LogSpec, error) {
+	out := new(LogSpec)
+	err := c.cc.Invoke(ctx, "/rpcadmin.Admin/SetLogSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// Get the current state of the execution circuit breaker
+	GetCircuitBreaker(context.Context, *GetCircuitBreakerParam) (*breaker.Status, error)
+	// Override the automatic behaviour of the execution circuit breaker
+	OverrideCircuitBreaker(context.Context, *OverrideCircuitBreakerParam) (*breaker.Status, error)
+	// Stream notifications of the circuit breaker tripping and resetting
+	StreamCircuitBreakerEvents(*StreamCircuitBreakerEventsParam, Admin_StreamCircuitBreakerEventsServer) error
+	// Get the logging channels and sinks of the node and whether each is enabled
+	GetLogSpec(context.Context, *GetLogSpecParam) (*LogSpec, error)
+	// Enable or disable logging channels and sinks without restarting the node
+	SetLogSpec(context.Context, *SetLogSpecParam) (*LogSpec, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) GetCircuitBreaker(ctx context.Context, req *GetCircuitBreakerParam) (*breaker.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCircuitBreaker not implemented")
+}
+func (*UnimplementedAdminServer) OverrideCircuitBreaker(ctx context.Context, req *OverrideCircuitBreakerParam) (*breaker.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideCircuitBreaker not implemented")
+}
+func (*UnimplementedAdminServer) StreamCircuitBreakerEvents(req *StreamCircuitBreakerEventsParam, srv Admin_StreamCircuitBreakerEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCircuitBreakerEvents not implemented")
+}
+func (*UnimplementedAdminServer) GetLogSpec(ctx context.Context, req *GetLogSpecParam) (*LogSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogSpec not implemented")
+}
+func (*UnimplementedAdminServer) SetLogSpec(ctx context.Context, req *SetLogSpecParam) (*LogSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogSpec not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_GetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCircuitBreakerParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcadmin.Admin/GetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetCircuitBreaker(ctx, req.(*GetCircuitBreakerParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_OverrideCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideCircuitBreakerParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).OverrideCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcadmin.Admin/OverrideCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).OverrideCircuitBreaker(ctx, req.(*OverrideCircuitBreakerParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_StreamCircuitBreakerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCircuitBreakerEventsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).StreamCircuitBreakerEvents(m, &adminStreamCircuitBreakerEventsServer{stream})
+}
+
+type Admin_StreamCircuitBreakerEventsServer interface {
+	Send(*breaker.Event) error
+	grpc.ServerStream
+}
+
+type adminStreamCircuitBreakerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminStreamCircuitBreakerEventsServer) Send(m *breaker.Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_GetLogSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogSpecParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetLogSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcadmin.Admin/GetLogSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetLogSpec(ctx, req.(*GetLogSpecParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetLogSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogSpecParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetLogSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcadmin.Admin/SetLogSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetLogSpec(ctx, req.(*SetLogSpecParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcadmin.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCircuitBreaker",
+			Handler:    _Admin_GetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "OverrideCircuitBreaker",
+			Handler:    _Admin_OverrideCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "GetLogSpec",
+			Handler:    _Admin_GetLogSpec_Handler,
+		},
+		{
+			MethodName: "SetLogSpec",
+			Handler:    _Admin_SetLogSpec_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCircuitBreakerEvents",
+			Handler:       _Admin_StreamCircuitBreakerEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcadmin.proto",
+}
+
+func (m *GetCircuitBreakerParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OverrideCircuitBreakerParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Override != 0 {
+		n += 1 + sovRpcadmin(uint64(m.Override))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamCircuitBreakerEventsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetLogSpecParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetLogSpecParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovRpcadmin(uint64(l))
+		}
+	}
+	if len(m.Sinks) > 0 {
+		for _, e := range m.Sinks {
+			l = e.Size()
+			n += 1 + l + sovRpcadmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovRpcadmin(uint64(l))
+		}
+	}
+	if len(m.Sinks) > 0 {
+		for _, e := range m.Sinks {
+			l = e.Size()
+			n += 1 + l + sovRpcadmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcadmin(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRpcadmin(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRpcadmin(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcadmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcadmin(x uint64) (n int) {
+	return sovRpcadmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
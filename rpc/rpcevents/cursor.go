@@ -0,0 +1,72 @@
+package rpcevents
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const cursorVersion = 1
+
+// The length of an encoded cursor: a version byte followed by Height and Index
+const cursorLength = 1 + 2*8
+
+// A Cursor marks a position in a stream as the number of messages (Index) of the stream already delivered for the block
+// at Height. Since a stream delivers the same messages for the same request it can be resumed from a Cursor by
+// replaying the block at Height and skipping the messages already delivered. Cursors are handed to clients as opaque
+// tokens.
+type Cursor struct {
+	Height uint64
+	Index  uint64
+}
+
+func ParseCursor(token []byte) (*Cursor, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	if len(token) != cursorLength || token[0] != cursorVersion {
+		return nil, fmt.Errorf("invalid stream cursor %X", token)
+	}
+	return &Cursor{
+		Height: binary.BigEndian.Uint64(token[1:]),
+		Index:  binary.BigEndian.Uint64(token[9:]),
+	}, nil
+}
+
+func (c Cursor) Token() []byte {
+	token := make([]byte, cursorLength)
+	token[0] = cursorVersion
+	binary.BigEndian.PutUint64(token[1:], c.Height)
+	binary.BigEndian.PutUint64(token[9:], c.Index)
+	return token
+}
+
+// Returns a BlockRange starting from the block of the cursor if there is one, otherwise blockRange
+func (c *Cursor) BlockRange(blockRange *BlockRange) *BlockRange {
+	if c == nil {
+		return blockRange
+	}
+	return NewBlockRange(AbsoluteBound(c.Height), blockRange.GetEnd())
+}
+
+// Tracks the position of a stream resumed from a Cursor (or started afresh with a nil Cursor)
+type cursorTracker struct {
+	resume   *Cursor
+	position Cursor
+}
+
+func newCursorTracker(resume *Cursor) *cursorTracker {
+	return &cursorTracker{resume: resume}
+}
+
+// Advances the position of the stream by a message for the block at height, returning the token marking the position
+// after the message, or false if the message was delivered before the stream was resumed so should be skipped
+func (ct *cursorTracker) Next(height uint64) ([]byte, bool) {
+	if height != ct.position.Height {
+		ct.position = Cursor{Height: height}
+	}
+	ct.position.Index++
+	if ct.resume != nil && height == ct.resume.Height && ct.position.Index <= ct.resume.Index {
+		return nil, false
+	}
+	return ct.position.Token(), true
+}
@@ -0,0 +1,53 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_Token(t *testing.T) {
+	cursor := Cursor{Height: 345, Index: 2}
+	parsed, err := ParseCursor(cursor.Token())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *parsed)
+
+	parsed, err = ParseCursor(nil)
+	require.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	_, err = ParseCursor([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestCursorTracker(t *testing.T) {
+	heights := []uint64{3, 3, 3, 5, 8, 8}
+	var tokens [][]byte
+	cursor := newCursorTracker(nil)
+	for _, height := range heights {
+		token, ok := cursor.Next(height)
+		require.True(t, ok)
+		tokens = append(tokens, token)
+	}
+
+	// Resuming from each message should deliver just those after it
+	for i, token := range tokens {
+		resume, err := ParseCursor(token)
+		require.NoError(t, err)
+		assert.Equal(t, AbsoluteBound(heights[i]), resume.BlockRange(AbsoluteRange(0, 10)).Start)
+		cursor = newCursorTracker(resume)
+		resumed := [][]byte{}
+		for _, height := range heights {
+			// The stream is replayed from the block of the cursor
+			if height < resume.Height {
+				continue
+			}
+			token, ok := cursor.Next(height)
+			if ok {
+				resumed = append(resumed, token)
+			}
+		}
+		assert.Equal(t, tokens[i+1:], resumed)
+	}
+}
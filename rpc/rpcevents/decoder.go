@@ -0,0 +1,150 @@
+package rpcevents
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Provides the on-chain metadata from which ABIs are obtained to decode LogEvents
+type MetadataProvider interface {
+	acmstate.AccountGetter
+	acmstate.MetadataReader
+}
+
+// Decodes LogEvents using the ABI registered on-chain for the contract that emitted them, falling back to an uploaded
+// ABI. ABIs are memoised by address for the lifetime of the decoder so a decoder should be used for a single request.
+type logDecoder struct {
+	metadata MetadataProvider
+	uploaded *abi.Spec
+	specs    map[crypto.Address]*abi.Spec
+	logger   *logging.Logger
+}
+
+func newLogDecoder(metadata MetadataProvider, abiJSON string, logger *logging.Logger) (*logDecoder, error) {
+	decoder := &logDecoder{
+		metadata: metadata,
+		specs:    make(map[crypto.Address]*abi.Spec),
+		logger:   logger,
+	}
+	if abiJSON != "" {
+		var err error
+		decoder.uploaded, err = abi.ReadSpec([]byte(abiJSON))
+		if err != nil {
+			return nil, fmt.Errorf("could not read ABI with which to decode events: %v", err)
+		}
+	}
+	return decoder, nil
+}
+
+// Returns ev with its LogEvent decoded where an ABI for it can be found. Events are copied rather than modified since
+// they may be shared with other subscribers. Redacted logs are not decoded since their values would be garbled.
+func (ld *logDecoder) decode(ev *exec.Event) *exec.Event {
+	if ld == nil || ev.Log == nil || len(ev.Log.Topics) == 0 || len(ev.Log.Redacted) > 0 {
+		return ev
+	}
+	eventSpec, err := ld.eventSpec(ev.Log)
+	if err != nil {
+		ld.logger.InfoMsg("Could not get ABI with which to decode LogEvent", "address", ev.Log.Address,
+			"error", err)
+		return ev
+	}
+	if eventSpec == nil {
+		return ev
+	}
+	decoded, err := ev.Log.Decode(eventSpec)
+	if err != nil {
+		ld.logger.TraceMsg("Could not decode LogEvent", "address", ev.Log.Address, "event_name", eventSpec.Name,
+			"error", err)
+		return ev
+	}
+	log := *ev.Log
+	log.Decoded = decoded
+	decodedEv := *ev
+	decodedEv.Log = &log
+	return &decodedEv
+}
+
+func (ld *logDecoder) eventSpec(log *exec.LogEvent) (*abi.EventSpec, error) {
+	spec, ok := ld.specs[log.Address]
+	if !ok {
+		var err error
+		spec, err = ld.registeredSpec(log.Address)
+		if err != nil {
+			return nil, err
+		}
+		ld.specs[log.Address] = spec
+	}
+	eventID := log.SolidityEventID()
+	if spec != nil {
+		if eventSpec, ok := spec.EventsByID[eventID]; ok {
+			return eventSpec, nil
+		}
+	}
+	if ld.uploaded != nil {
+		return ld.uploaded.EventsByID[eventID], nil
+	}
+	return nil, nil
+}
+
+// Merges the ABIs of all metadata registered for the contract at address (including against its code hash)
+func (ld *logDecoder) registeredSpec(address crypto.Address) (*abi.Spec, error) {
+	acc, err := ld.metadata.GetAccount(address)
+	if err != nil || acc == nil {
+		return nil, err
+	}
+	contractMeta := append([]*acm.ContractMeta{}, acc.ContractMeta...)
+	if acc.Forebear != nil {
+		forebear, err := ld.metadata.GetAccount(*acc.Forebear)
+		if err != nil {
+			return nil, err
+		}
+		if forebear != nil {
+			contractMeta = append(contractMeta, forebear.ContractMeta...)
+		}
+	}
+	if len(acc.CodeHash) == len(acmstate.CodeHash{}) {
+		var codehash acmstate.CodeHash
+		copy(codehash[:], acc.CodeHash)
+		codeMeta, err := ld.metadata.GetCodeMetadata(codehash)
+		if err != nil {
+			return nil, err
+		}
+		if codeMeta != nil {
+			contractMeta = append(contractMeta, &acm.ContractMeta{MetadataHash: codeMeta.MetadataHash})
+		}
+	}
+	var specs []*abi.Spec
+	for _, cm := range contractMeta {
+		metadata := cm.Metadata
+		if metadata == "" {
+			var metahash acmstate.MetadataHash
+			copy(metahash[:], cm.MetadataHash)
+			metadata, err = ld.metadata.GetMetadata(metahash)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var meta struct {
+			Abi json.RawMessage
+		}
+		if json.Unmarshal([]byte(metadata), &meta) != nil || len(meta.Abi) == 0 {
+			continue
+		}
+		spec, err := abi.ReadSpec(meta.Abi)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	return abi.MergeSpec(specs), nil
+}
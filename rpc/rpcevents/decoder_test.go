@@ -0,0 +1,70 @@
+package rpcevents
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferABI = `[{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},` +
+	`{"name":"amount","type":"uint256","indexed":false}]}]`
+
+func TestLogDecoder(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	registered := crypto.Address{1}
+	unregistered := crypto.Address{2}
+	from := crypto.Address{3}
+	require.NoError(t, st.UpdateAccount(&acm.Account{
+		Address:      registered,
+		EVMCode:      []byte{1},
+		ContractMeta: []*acm.ContractMeta{{Metadata: fmt.Sprintf(`{"ContractName":"Token","Abi":%s}`, transferABI)}},
+	}))
+	require.NoError(t, st.UpdateAccount(&acm.Account{Address: unregistered, EVMCode: []byte{1}}))
+
+	spec, err := abi.ReadSpec([]byte(transferABI))
+	require.NoError(t, err)
+	topics, data, err := abi.PackEvent(spec.EventsByName["Transfer"], from, uint64(42))
+	require.NoError(t, err)
+	event := func(address crypto.Address) *exec.Event {
+		return &exec.Event{Log: &exec.LogEvent{Address: address, Topics: topics, Data: data}}
+	}
+
+	decoder, err := newLogDecoder(st, "", logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	ev := event(registered)
+	decodedEv := decoder.decode(ev)
+	assert.Nil(t, ev.Log.Decoded, "should not modify original event")
+	decoded := decodedEv.Log.Decoded
+	require.NotNil(t, decoded)
+	assert.Equal(t, "Transfer", decoded.EventName)
+	assert.Equal(t, "Transfer(address,uint256)", decoded.Signature)
+	assert.Equal(t, []*exec.DecodedArg{
+		{Name: "from", Type: "address", Value: from.String(), Indexed: true},
+		{Name: "amount", Type: "uint256", Value: "42"},
+	}, decoded.Args)
+
+	assert.Nil(t, decoder.decode(event(unregistered)).Log.Decoded)
+
+	// With an uploaded ABI
+	decoder, err = newLogDecoder(st, transferABI, logging.NewNoopLogger())
+	require.NoError(t, err)
+	require.NotNil(t, decoder.decode(event(unregistered)).Log.Decoded)
+
+	// Redacted logs are left undecoded
+	redacted := event(registered)
+	redacted.Log.Redacted = []string{"Data"}
+	assert.Nil(t, decoder.decode(redacted).Log.Decoded)
+
+	// Not decoding is a no-op
+	decoder = nil
+	assert.Equal(t, ev, decoder.decode(ev))
+}
@@ -0,0 +1,57 @@
+package rpcevents
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// Returned by a stream consumer once the LIMIT of its query has been reached to end the stream
+var errLimitReached = fmt.Errorf("limit of query reached")
+
+// Returns the order in which blocks should be streamed for request according to the ORDER BY directive of its query.
+// Blocks can only be ordered by height and streams in descending order cannot be resumed.
+func streamOrder(qry query.Query, request *BlocksRequest) (storage.SortOrder, error) {
+	directives := query.DirectivesOf(qry)
+	if directives.OrderBy != "" && directives.OrderBy != event.HeightKey {
+		return storage.AscendingSort, fmt.Errorf("streams can only be ordered by %s but query orders by %s",
+			event.HeightKey, directives.OrderBy)
+	}
+	if !directives.Descending {
+		return storage.AscendingSort, nil
+	}
+	if len(request.Cursor) > 0 || request.Subscription != "" {
+		return storage.AscendingSort, fmt.Errorf("streams in descending order cannot be resumed from a cursor " +
+			"or named subscription")
+	}
+	return storage.DescendingSort, nil
+}
+
+// Counts the results delivered by a stream against the LIMIT directive of its query
+type resultLimit struct {
+	limit     uint64
+	delivered uint64
+}
+
+func newResultLimit(qry query.Query) *resultLimit {
+	return &resultLimit{limit: query.DirectivesOf(qry).Limit}
+}
+
+// Returns how many of n further results may be delivered and counts them as delivered
+func (rl *resultLimit) take(n int) int {
+	if rl.limit == 0 {
+		return n
+	}
+	if remaining := rl.limit - rl.delivered; uint64(n) > remaining {
+		n = int(remaining)
+	}
+	rl.delivered += uint64(n)
+	return n
+}
+
+// Whether the limit has been reached so no more results should be delivered
+func (rl *resultLimit) reached() bool {
+	return rl.limit > 0 && rl.delivered >= rl.limit
+}
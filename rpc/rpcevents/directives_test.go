@@ -0,0 +1,39 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamOrder(t *testing.T) {
+	order, err := streamOrder(query.Empty{}, &BlocksRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, storage.AscendingSort, order)
+
+	order, err = streamOrder(query.MustParse("ORDER BY Height DESC"), &BlocksRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, storage.DescendingSort, order)
+
+	_, err = streamOrder(query.MustParse("ORDER BY Height DESC"), &BlocksRequest{Subscription: "frogs"})
+	require.Error(t, err)
+
+	_, err = streamOrder(query.MustParse("ORDER BY Index"), &BlocksRequest{})
+	require.Error(t, err)
+}
+
+func TestResultLimit(t *testing.T) {
+	limit := newResultLimit(query.Empty{})
+	assert.Equal(t, 100, limit.take(100))
+	assert.False(t, limit.reached())
+
+	limit = newResultLimit(query.MustParse("LIMIT 5"))
+	assert.Equal(t, 3, limit.take(3))
+	assert.False(t, limit.reached())
+	assert.Equal(t, 2, limit.take(3))
+	assert.True(t, limit.reached())
+	assert.Equal(t, 0, limit.take(1))
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -15,28 +18,53 @@ import (
 
 const SubscribeBufferSize = 100
 
+// The most transactions returned by a single GetTxsByAddress
+const MaxTxsByAddress = 100
+
+const (
+	// The number of BlockExecutions delivered in each BlockExecutionsResponse if no batch size is requested
+	DefaultBlockExecutionsBatchSize = 10
+	// The most BlockExecutions delivered in a single BlockExecutionsResponse
+	MaxBlockExecutionsBatchSize = 100
+)
+
 type Provider interface {
 	// Get transactions
 	IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
 		consumer func(*exec.StreamEvent) error) (err error)
 	// Get a particular TxExecution by hash
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	// Get the receipt of a particular transaction by hash
+	TxReceiptByHash(txHash []byte) (*exec.TxReceipt, error)
+	// Get LogEvents by emitting address and event signature
+	IterateLogs(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64,
+		consumer func(*exec.Event) error) error
+	// Get LogEvents by emitting address, event signature and second topic
+	IterateLogsByTopic(address crypto.Address, signature, topic binary.Word256, startHeight, endHeight *uint64,
+		consumer func(*exec.Event) error) error
+	// Get TxExecutions by an address involved in them
+	IterateTxsByAddress(address crypto.Address, after []byte, descending bool,
+		consumer func(*exec.TxExecution) error) error
 }
 
 type executionEventsServer struct {
 	eventsProvider Provider
+	metadata       MetadataProvider
 	emitter        *event.Emitter
 	tip            bcm.BlockchainInfo
+	subscriptions  *Subscriptions
 	logger         *logging.Logger
 }
 
-func NewExecutionEventsServer(eventsProvider Provider, emitter *event.Emitter,
-	tip bcm.BlockchainInfo, logger *logging.Logger) ExecutionEventsServer {
+func NewExecutionEventsServer(eventsProvider Provider, metadata MetadataProvider, emitter *event.Emitter,
+	tip bcm.BlockchainInfo, subscriptions *Subscriptions, logger *logging.Logger) ExecutionEventsServer {
 
 	return &executionEventsServer{
 		eventsProvider: eventsProvider,
+		metadata:       metadata,
 		emitter:        emitter,
 		tip:            tip,
+		subscriptions:  subscriptions,
 		logger:         logger.WithScope("NewExecutionEventsServer"),
 	}
 }
@@ -69,17 +97,64 @@ func (ees *executionEventsServer) Tx(ctx context.Context, request *TxRequest) (*
 	return nil, fmt.Errorf("subscription waiting for tx %v ended prematurely", request.TxHash)
 }
 
+func (ees *executionEventsServer) GetTxReceipt(ctx context.Context, request *TxRequest) (*exec.TxReceipt, error) {
+	receipt, err := ees.eventsProvider.TxReceiptByHash(request.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt != nil {
+		return receipt, nil
+	}
+	if !request.Wait {
+		return nil, fmt.Errorf("receipt of transaction with hash %v not found in state", request.TxHash)
+	}
+	txe, err := ees.Tx(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return txe.TxReceipt(), nil
+}
+
 func (ees *executionEventsServer) Stream(request *BlocksRequest, stream ExecutionEvents_StreamServer) error {
+	if request.Subscription != "" {
+		return fmt.Errorf("named subscriptions are not supported by Stream, use Events or JoinEvents")
+	}
 	qry, err := query.NewOrEmpty(request.Query)
 	if err != nil {
 		return fmt.Errorf("could not parse TxExecution query: %v", err)
 	}
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(ev *exec.StreamEvent) error {
+	order, err := streamOrder(qry, request)
+	if err != nil {
+		return err
+	}
+	decoder, err := ees.decoder(request.Decode, request.Abi)
+	if err != nil {
+		return err
+	}
+	limit := newResultLimit(qry)
+	// Stream delivers the events of failed transactions, which are not in the log index, so reads every block
+	err = ees.streamEvents(stream.Context(), request.BlockRange, order, nil, func(ev *exec.StreamEvent) error {
 		if qry.Matches(ev) {
-			return stream.Send(ev)
+			if decoder != nil && ev.Event != nil && ev.Event.Log != nil {
+				decodedEv := *ev
+				decodedEv.Event = decoder.decode(ev.Event)
+				ev = &decodedEv
+			}
+			err := stream.Send(ev)
+			if err != nil {
+				return err
+			}
+			limit.take(1)
+			if limit.reached() {
+				return errLimitReached
+			}
 		}
 		return nil
 	})
+	if err == errLimitReached {
+		return nil
+	}
+	return err
 }
 
 func (ees *executionEventsServer) Events(request *BlocksRequest, stream ExecutionEvents_EventsServer) error {
@@ -88,9 +163,29 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	if err != nil {
 		return fmt.Errorf("could not parse Event query: %v", err)
 	}
+	order, err := streamOrder(qry, request)
+	if err != nil {
+		return err
+	}
+	decoder, err := ees.decoder(request.Decode, request.Abi)
+	if err != nil {
+		return err
+	}
+	sub, err := ees.subscriptions.open(stream.Context(), "Events", request.Subscription, request.Query)
+	if err != nil {
+		return err
+	}
+	defer sub.close()
+	resume, err := ParseCursor(sub.resume(request.Cursor))
+	if err != nil {
+		return err
+	}
+	cursor := newCursorTracker(resume)
+	limit := newResultLimit(qry)
 	var response *EventsResponse
 	var stack exec.TxStack
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(sev *exec.StreamEvent) error {
+	blockRange := resume.BlockRange(request.BlockRange)
+	err = ees.streamEvents(stream.Context(), blockRange, order, newLogFilter(qry), func(sev *exec.StreamEvent) error {
 		switch {
 		case sev.BeginBlock != nil:
 			response = &EventsResponse{
@@ -98,7 +193,31 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 			}
 
 		case sev.EndBlock != nil && len(response.Events) > 0:
-			return stream.Send(response)
+			token, ok := cursor.Next(response.Height)
+			if !ok {
+				return nil
+			}
+			if n := limit.take(len(response.Events)); n < len(response.Events) {
+				// Resuming after a truncated response would skip the rest of its block so we give it no cursor
+				response.Events = response.Events[:n]
+				err = stream.Send(response)
+				if err != nil {
+					return err
+				}
+				return errLimitReached
+			}
+			response.Cursor = token
+			err = stream.Send(response)
+			if err != nil {
+				return err
+			}
+			err = sub.save(token)
+			if err != nil {
+				return err
+			}
+			if limit.reached() {
+				return errLimitReached
+			}
 
 		default:
 			// We need to consume transaction to exclude events belong to an exceptional transaction
@@ -109,7 +228,7 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 			if txe != nil && txe.Exception == nil {
 				for _, ev := range txe.Events {
 					if qry.Matches(ev) {
-						response.Events = append(response.Events, ev)
+						response.Events = append(response.Events, decoder.decode(ev))
 					}
 				}
 			}
@@ -117,20 +236,246 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 
 		return nil
 	})
+	if err == errLimitReached {
+		return nil
+	}
+	return err
+}
+
+func (ees *executionEventsServer) JoinEvents(request *BlocksRequest, stream ExecutionEvents_JoinEventsServer) error {
+	const errHeader = "JoinEvents()"
+	qry, err := query.NewOrEmpty(request.Query)
+	if err != nil {
+		return fmt.Errorf("could not parse Event query: %v", err)
+	}
+	order, err := streamOrder(qry, request)
+	if err != nil {
+		return err
+	}
+	decoder, err := ees.decoder(request.Decode, request.Abi)
+	if err != nil {
+		return err
+	}
+	sub, err := ees.subscriptions.open(stream.Context(), "JoinEvents", request.Subscription, request.Query)
+	if err != nil {
+		return err
+	}
+	defer sub.close()
+	resume, err := ParseCursor(sub.resume(request.Cursor))
+	if err != nil {
+		return err
+	}
+	cursor := newCursorTracker(resume)
+	var blockTime time.Time
+	var proposer crypto.Address
+	limit := newResultLimit(qry)
+	var stack exec.TxStack
+	blockRange := resume.BlockRange(request.BlockRange)
+	err = ees.streamEvents(stream.Context(), blockRange, order, newLogFilter(qry), func(sev *exec.StreamEvent) error {
+		if sev.BeginBlock != nil {
+			blockTime, proposer = time.Time{}, crypto.ZeroAddress
+			if header := sev.BeginBlock.Header; header != nil {
+				blockTime = header.Time
+				proposer, err = crypto.AddressFromBytes(header.ProposerAddress)
+				if err != nil {
+					return fmt.Errorf("%s: could not read proposer of block %d: %v", errHeader, sev.BeginBlock.Height, err)
+				}
+			}
+		}
+		txe, err := stack.Consume(sev)
+		if err != nil {
+			return fmt.Errorf("%s: %v", errHeader, err)
+		}
+		if txe == nil || txe.Exception != nil {
+			return nil
+		}
+		var inputs []crypto.Address
+		for _, input := range txe.Envelope.Tx.GetInputs() {
+			inputs = append(inputs, input.Address)
+		}
+		var gasUsed uint64
+		if txe.Result != nil {
+			gasUsed = txe.Result.GasUsed
+		}
+		for _, ev := range txe.Events {
+			if qry.Matches(ev) {
+				token, ok := cursor.Next(txe.Height)
+				if !ok {
+					continue
+				}
+				err = stream.Send(&JoinedEvent{
+					Event:     decoder.decode(ev),
+					TxHeader:  txe.TxHeader,
+					Inputs:    inputs,
+					GasUsed:   gasUsed,
+					BlockTime: blockTime,
+					Proposer:  proposer,
+					Cursor:    token,
+				})
+				if err != nil {
+					return err
+				}
+				err = sub.save(token)
+				if err != nil {
+					return err
+				}
+				limit.take(1)
+				if limit.reached() {
+					return errLimitReached
+				}
+			}
+		}
+		return nil
+	})
+	if err == errLimitReached {
+		return nil
+	}
+	return err
+}
+
+func (ees *executionEventsServer) Logs(request *LogsRequest, stream ExecutionEvents_LogsServer) error {
+	start, end, _ := request.BlockRange.Bounds(ees.tip.LastBlockHeight())
+	ees.logger.TraceMsg("Iterating logs", "address", request.Address, "signature", request.Signature,
+		"topic", request.Topic, "start", start, "end", end)
+	decoder, err := ees.decoder(request.Decode, request.Abi)
+	if err != nil {
+		return err
+	}
+	consumer := func(ev *exec.Event) error {
+		return stream.Send(decoder.decode(ev))
+	}
+	if len(request.Topic) == 0 {
+		return ees.eventsProvider.IterateLogs(request.Address, request.Signature, &start, &end, consumer)
+	}
+	if len(request.Topic) != binary.Word256Bytes {
+		return fmt.Errorf("Logs(): topic must be %d bytes but is %d bytes", binary.Word256Bytes, len(request.Topic))
+	}
+	return ees.eventsProvider.IterateLogsByTopic(request.Address, request.Signature,
+		binary.LeftPadWord256(request.Topic), &start, &end, consumer)
+}
+
+func (ees *executionEventsServer) GetTxsByAddress(ctx context.Context,
+	request *TxsByAddressRequest) (*TxsByAddressResponse, error) {
+	limit := request.Limit
+	if limit == 0 || limit > MaxTxsByAddress {
+		limit = MaxTxsByAddress
+	}
+	response := new(TxsByAddressResponse)
+	err := ees.eventsProvider.IterateTxsByAddress(request.Address, request.After, request.Descending,
+		func(txe *exec.TxExecution) error {
+			if uint64(len(response.TxExecutions)) == limit {
+				// There is at least one more so let the caller continue from the last we return
+				response.Next = response.TxExecutions[limit-1].TxHash
+				return io.EOF
+			}
+			response.TxExecutions = append(response.TxExecutions, txe)
+			return nil
+		})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (ees *executionEventsServer) BlockExecutions(request *BlockExecutionsRequest,
+	stream ExecutionEvents_BlockExecutionsServer) error {
+	batchSize := request.BatchSize
+	if batchSize == 0 {
+		batchSize = DefaultBlockExecutionsBatchSize
+	} else if batchSize > MaxBlockExecutionsBatchSize {
+		batchSize = MaxBlockExecutionsBatchSize
+	}
+	sub, err := ees.subscriptions.open(stream.Context(), "BlockExecutions", request.Subscription, "")
+	if err != nil {
+		return err
+	}
+	defer sub.close()
+	resume, err := ParseCursor(sub.resume(request.Cursor))
+	if err != nil {
+		return err
+	}
+	cursor := newCursorTracker(resume)
+	response := new(BlockExecutionsResponse)
+	flush := func() error {
+		if len(response.BlockExecutions) == 0 {
+			return nil
+		}
+		err := stream.Send(response)
+		if err != nil {
+			return err
+		}
+		err = sub.save(response.Cursor)
+		response = new(BlockExecutionsResponse)
+		return err
+	}
+	// Blocks without transactions are not stored so may be missing from the stream
+	ba := exec.NewBlockAccumulator(exec.NonConsecutiveBlocks)
+	blockRange := resume.BlockRange(request.BlockRange)
+	err = ees.streamEvents(stream.Context(), blockRange, storage.AscendingSort, nil, func(sev *exec.StreamEvent) error {
+		be, err := ba.Consume(sev)
+		if err != nil {
+			return fmt.Errorf("BlockExecutions(): %v", err)
+		}
+		if be == nil {
+			return nil
+		}
+		token, ok := cursor.Next(be.Height)
+		if !ok {
+			return nil
+		}
+		response.BlockExecutions = append(response.BlockExecutions, be)
+		response.Cursor = token
+		// Once we have caught up with the chain deliver blocks as they are produced rather than wait to fill a batch
+		if uint64(len(response.BlockExecutions)) == batchSize || be.Height >= ees.tip.LastBlockHeight() {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
 }
 
-func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange,
-	consumer func(execution *exec.StreamEvent) error) error {
+func (ees *executionEventsServer) DeleteSubscription(ctx context.Context,
+	request *SubscriptionRequest) (*Subscription, error) {
+	return ees.subscriptions.delete(ctx, request.Method, request.Name)
+}
+
+// Returns a decoder for a request if decoding was requested, otherwise nil (on which decode is a no-op)
+func (ees *executionEventsServer) decoder(decode bool, abiJSON string) (*logDecoder, error) {
+	if !decode {
+		return nil, nil
+	}
+	return newLogDecoder(ees.metadata, abiJSON, ees.logger)
+}
+
+// Streams the blocks in blockRange, first from state and then as they are produced if blockRange is streaming. If logs is
+// not nil only those blocks in state holding the LogEvents it selects are read.
+func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange, sortOrder storage.SortOrder,
+	logs *logFilter, consumer func(execution *exec.StreamEvent) error) error {
 
 	start, end, streaming := blockRange.Bounds(ees.tip.LastBlockHeight())
-	ees.logger.TraceMsg("Streaming blocks", "start", start, "end", end, "streaming", streaming)
+	ees.logger.TraceMsg("Streaming blocks", "start", start, "end", end, "streaming", streaming,
+		"descending", sortOrder == storage.DescendingSort, "log_index", logs != nil)
+
+	if sortOrder == storage.DescendingSort {
+		// Blocks are only produced in ascending order so we can only deliver those already in state
+		if streaming {
+			return fmt.Errorf("blocks can only be streamed in descending order over a BlockRange with an end")
+		}
+		if logs != nil {
+			return ees.iterateLogBlocks(logs, start, end, storage.DescendingSort, consumer)
+		}
+		return ees.eventsProvider.IterateStreamEvents(&start, &end, storage.DescendingSort, consumer)
+	}
 
 	// Pull blocks from state and receive the upper bound (exclusive) on the what we were able to send
 	// Set this to start since it will be the start of next streaming batch (if needed)
-	start, err := ees.iterateStreamEvents(start, end, consumer)
+	start, err := ees.iterateStreamEvents(start, end, logs, consumer)
 
-	// If we are not streaming and all blocks requested were retrieved from state then we are done
-	if !streaming && start > end {
+	// If we failed or are not streaming and all blocks requested were retrieved from state then we are done
+	if err != nil || !streaming && start > end {
 		return err
 	}
 
@@ -149,7 +494,7 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 			if catchupEnd > end {
 				catchupEnd = end
 			}
-			start, err = ees.iterateStreamEvents(start, catchupEnd, consumer)
+			start, err = ees.iterateStreamEvents(start, catchupEnd, logs, consumer)
 			if err != nil {
 				return err
 			}
@@ -200,8 +545,18 @@ func (ees *executionEventsServer) subscribeBlockExecution(ctx context.Context,
 	return nil
 }
 
-func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64,
+func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64, logs *logFilter,
 	consumer func(*exec.StreamEvent) error) (uint64, error) {
+	if logs != nil {
+		// The log index covers the blocks committed to state, which include every block up to the tip
+		if latestHeight := ees.tip.LastBlockHeight(); endHeight > latestHeight {
+			endHeight = latestHeight
+		}
+		if startHeight > endHeight {
+			return startHeight, nil
+		}
+		return endHeight + 1, ees.iterateLogBlocks(logs, startHeight, endHeight, storage.AscendingSort, consumer)
+	}
 	// Assume that we have seen the previous block before start to have ended up here
 	// NOTE: this will underflow when start is 0 (as it often will be - and needs to be for restored chains)
 	// however we at most underflow by 1 and we always add 1 back on when returning so we get away with this.
@@ -216,3 +571,19 @@ func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uin
 	// Returns the appropriate _next_ starting block - the one after the one we have seen - from which to stream next
 	return lastHeightSeen + 1, err
 }
+
+// Reads the blocks within [startHeight, endHeight] in state that hold the LogEvents selected by logs in sortOrder
+func (ees *executionEventsServer) iterateLogBlocks(logs *logFilter, startHeight, endHeight uint64,
+	sortOrder storage.SortOrder, consumer func(*exec.StreamEvent) error) error {
+	heights, err := logs.heights(ees.eventsProvider, startHeight, endHeight, sortOrder)
+	if err != nil {
+		return err
+	}
+	for _, height := range heights {
+		err = ees.eventsProvider.IterateStreamEvents(&height, &height, storage.AscendingSort, consumer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package rpcevents
+
+import (
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/tmthrgd/go-hex"
+)
+
+// Selects the LogEvents emitted by a single contract with a particular signature (first topic) and optionally second
+// topic that a query is restricted to by its conditions, e.g.:
+//
+//	Address = '...' AND Log0 = '...' AND Log1 = '...'
+//
+// so that the blocks in state holding them can be found from the log index rather than by reading every block.
+type logFilter struct {
+	address   crypto.Address
+	signature binary.Word256
+	topic     *binary.Word256
+}
+
+// Returns the logFilter for qry or nil if qry can match events other than the LogEvents of a single contract and
+// signature. Only LogEvents from transactions that did not fail are indexed so the filter must not be used where
+// events from failed transactions may be delivered.
+func newLogFilter(qry query.Query) *logFilter {
+	equalities := query.EqualitiesOf(qry)
+	value, ok := equalities[event.AddressKey]
+	if !ok {
+		return nil
+	}
+	address, err := crypto.AddressFromHexString(value)
+	// Addresses are matched as upper case hex so any other form of address matches nothing, which we leave to the query
+	if err != nil || address.String() != value {
+		return nil
+	}
+	signature, ok := topicFilter(equalities, 0)
+	if !ok {
+		return nil
+	}
+	lf := &logFilter{
+		address:   address,
+		signature: signature,
+	}
+	if topic, ok := topicFilter(equalities, 1); ok {
+		lf.topic = &topic
+	}
+	return lf
+}
+
+func topicFilter(equalities map[string]string, i int) (binary.Word256, bool) {
+	value, ok := equalities[exec.LogNKey(i)]
+	if !ok {
+		return binary.Zero256, false
+	}
+	bs, err := hex.DecodeString(value)
+	if err != nil || len(bs) != binary.Word256Bytes || hex.EncodeUpperToString(bs) != value {
+		return binary.Zero256, false
+	}
+	return binary.LeftPadWord256(bs), true
+}
+
+// Returns the heights of the blocks within [startHeight, endHeight] that hold LogEvents selected by the filter in
+// sortOrder
+func (lf *logFilter) heights(provider Provider, startHeight, endHeight uint64,
+	sortOrder storage.SortOrder) ([]uint64, error) {
+	var heights []uint64
+	consumer := func(ev *exec.Event) error {
+		if height := ev.Header.GetHeight(); len(heights) == 0 || heights[len(heights)-1] != height {
+			heights = append(heights, height)
+		}
+		return nil
+	}
+	var err error
+	if lf.topic == nil {
+		err = provider.IterateLogs(lf.address, lf.signature, &startHeight, &endHeight, consumer)
+	} else {
+		err = provider.IterateLogsByTopic(lf.address, lf.signature, *lf.topic, &startHeight, &endHeight, consumer)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sortOrder == storage.DescendingSort {
+		for i, j := 0, len(heights)-1; i < j; i, j = i+1, j-1 {
+			heights[i], heights[j] = heights[j], heights[i]
+		}
+	}
+	return heights, nil
+}
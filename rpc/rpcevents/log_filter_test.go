@@ -0,0 +1,77 @@
+package rpcevents
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogFilter(t *testing.T) {
+	address := crypto.Address{1, 2, 3}
+	signature := binary.Word256{0xab, 0xcd, 0xef}
+	topic := binary.Word256{7, 8, 9}
+	qry := func(format string, args ...interface{}) query.Query {
+		return query.MustParse(fmt.Sprintf(format, args...))
+	}
+
+	assert.Nil(t, newLogFilter(query.Empty{}))
+	assert.Nil(t, newLogFilter(qry("Address = '%v'", address)))
+	assert.Nil(t, newLogFilter(qry("Address = '%v' OR Log0 = '%v'", address, signature)))
+	// Topics are matched as upper case hex so this matches nothing, which we leave to the query
+	assert.Nil(t, newLogFilter(qry("Address = '%v' AND Log0 = '%x'", address, signature.Bytes())))
+
+	assert.Equal(t, &logFilter{address: address, signature: signature},
+		newLogFilter(qry("EventType = 'LogEvent' AND Address = '%v' AND Log0 = '%v'", address, signature)))
+	assert.Equal(t, &logFilter{address: address, signature: signature, topic: &topic},
+		newLogFilter(qry("Address = '%v' AND Log0 = '%v' AND Log1 = '%v' AND Height > 2", address, signature,
+			topic)))
+
+	provider := &logsProvider{heights: []uint64{2, 2, 5, 7}}
+	heights, err := newLogFilter(qry("Address = '%v' AND Log0 = '%v'", address, signature)).
+		heights(provider, 1, 10, storage.AscendingSort)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 5, 7}, heights)
+	assert.False(t, provider.byTopic)
+
+	heights, err = newLogFilter(qry("Address = '%v' AND Log0 = '%v' AND Log1 = '%v'", address, signature, topic)).
+		heights(provider, 1, 10, storage.DescendingSort)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{7, 5, 2}, heights)
+	assert.True(t, provider.byTopic)
+}
+
+// Provides a LogEvent at each of heights
+type logsProvider struct {
+	Provider
+	heights []uint64
+	byTopic bool
+}
+
+func (lp *logsProvider) IterateLogs(address crypto.Address, signature binary.Word256, startHeight, endHeight *uint64,
+	consumer func(*exec.Event) error) error {
+	lp.byTopic = false
+	return lp.iterate(consumer)
+}
+
+func (lp *logsProvider) IterateLogsByTopic(address crypto.Address, signature, topic binary.Word256,
+	startHeight, endHeight *uint64, consumer func(*exec.Event) error) error {
+	lp.byTopic = true
+	return lp.iterate(consumer)
+}
+
+func (lp *logsProvider) iterate(consumer func(*exec.Event) error) error {
+	for _, height := range lp.heights {
+		err := consumer(&exec.Event{Header: &exec.Header{Height: height}, Log: &exec.LogEvent{}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
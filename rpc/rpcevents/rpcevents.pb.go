@@ -9,11 +9,15 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 	exec "github.com/hyperledger/burrow/execution/exec"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -25,6 +29,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -68,7 +73,7 @@ func (x Bound_BoundType) String() string {
 }
 
 func (Bound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{6, 0}
+	return fileDescriptor_580b21d8d2fd68e4, []int{14, 0}
 }
 
 type GetBlockRequest struct {
@@ -223,7 +228,17 @@ type BlocksRequest struct {
 	//
 	// For example:
 	// EventType = 'LogEvent' AND EventID CONTAINS 'bar' AND TxHash = '020304' AND Height >= 34 AND Index < 3 AND Address = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
-	Query                string   `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Decode LogEvents using the ABI registered on-chain for the emitting contract (or Abi)
+	Decode bool `protobuf:"varint,3,opt,name=Decode,proto3" json:"Decode,omitempty"`
+	// Additional ABI JSON with which to decode LogEvents of contracts with no ABI registered on-chain
+	Abi string `protobuf:"bytes,4,opt,name=Abi,proto3" json:"Abi,omitempty"`
+	// The Cursor of the last message received from Events or JoinEvents for an otherwise identical request from which
+	// to resume the stream (overriding the start of BlockRange) without missing or repeating messages
+	Cursor github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,5,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	// The name of a subscription for Events or JoinEvents whose position the node persists as messages are sent so
+	// that a request with the same Subscription and Query resumes from it, even after the node restarts
+	Subscription         string   `protobuf:"bytes,6,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -276,30 +291,59 @@ func (m *BlocksRequest) GetQuery() string {
 	return ""
 }
 
+func (m *BlocksRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
+func (m *BlocksRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *BlocksRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
 func (*BlocksRequest) XXX_MessageName() string {
 	return "rpcevents.BlocksRequest"
 }
 
-type EventsResponse struct {
-	Height               uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Events               []*exec.Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type BlockExecutionsRequest struct {
+	BlockRange *BlockRange `protobuf:"bytes,1,opt,name=BlockRange,proto3" json:"BlockRange,omitempty"`
+	// The most BlockExecutions to deliver in each response (DefaultBlockExecutionsBatchSize if zero and at most
+	// MaxBlockExecutionsBatchSize). Smaller batches are delivered once the stream reaches the latest block.
+	BatchSize uint64 `protobuf:"varint,2,opt,name=BatchSize,proto3" json:"BatchSize,omitempty"`
+	// The Cursor of the last response received for an otherwise identical request from which to resume the stream
+	// (overriding the start of BlockRange) without missing or repeating blocks
+	Cursor github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	// The name of a subscription whose position the node persists as responses are sent so that a request with the
+	// same Subscription resumes from it, even after the node restarts
+	Subscription         string   `protobuf:"bytes,4,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
-func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()    {}
-func (*EventsResponse) Descriptor() ([]byte, []int) {
+func (m *BlockExecutionsRequest) Reset()         { *m = BlockExecutionsRequest{} }
+func (m *BlockExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockExecutionsRequest) ProtoMessage()    {}
+func (*BlockExecutionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_580b21d8d2fd68e4, []int{3}
 }
-func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+func (m *BlockExecutionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockExecutionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -309,57 +353,64 @@ func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsResponse.Merge(m, src)
+func (m *BlockExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockExecutionsRequest.Merge(m, src)
 }
-func (m *EventsResponse) XXX_Size() int {
+func (m *BlockExecutionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+func (m *BlockExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockExecutionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_BlockExecutionsRequest proto.InternalMessageInfo
 
-func (m *EventsResponse) GetHeight() uint64 {
+func (m *BlockExecutionsRequest) GetBlockRange() *BlockRange {
 	if m != nil {
-		return m.Height
+		return m.BlockRange
+	}
+	return nil
+}
+
+func (m *BlockExecutionsRequest) GetBatchSize() uint64 {
+	if m != nil {
+		return m.BatchSize
 	}
 	return 0
 }
 
-func (m *EventsResponse) GetEvents() []*exec.Event {
+func (m *BlockExecutionsRequest) GetSubscription() string {
 	if m != nil {
-		return m.Events
+		return m.Subscription
 	}
-	return nil
+	return ""
 }
 
-func (*EventsResponse) XXX_MessageName() string {
-	return "rpcevents.EventsResponse"
+func (*BlockExecutionsRequest) XXX_MessageName() string {
+	return "rpcevents.BlockExecutionsRequest"
 }
 
-type GetTxsRequest struct {
-	StartHeight          uint64   `protobuf:"varint,1,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
-	EndHeight            uint64   `protobuf:"varint,2,opt,name=EndHeight,proto3" json:"EndHeight,omitempty"`
-	Query                string   `protobuf:"bytes,3,opt,name=Query,proto3" json:"Query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BlockExecutionsResponse struct {
+	BlockExecutions []*exec.BlockExecution `protobuf:"bytes,1,rep,name=BlockExecutions,proto3" json:"BlockExecutions,omitempty"`
+	// Opaque position in the stream after this response
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *GetTxsRequest) Reset()         { *m = GetTxsRequest{} }
-func (m *GetTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTxsRequest) ProtoMessage()    {}
-func (*GetTxsRequest) Descriptor() ([]byte, []int) {
+func (m *BlockExecutionsResponse) Reset()         { *m = BlockExecutionsResponse{} }
+func (m *BlockExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockExecutionsResponse) ProtoMessage()    {}
+func (*BlockExecutionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_580b21d8d2fd68e4, []int{4}
 }
-func (m *GetTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *BlockExecutionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockExecutionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -369,63 +420,130 @@ func (m *GetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *GetTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxsRequest.Merge(m, src)
+func (m *BlockExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockExecutionsResponse.Merge(m, src)
 }
-func (m *GetTxsRequest) XXX_Size() int {
+func (m *BlockExecutionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxsRequest.DiscardUnknown(m)
+func (m *BlockExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockExecutionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BlockExecutionsResponse proto.InternalMessageInfo
 
-func (m *GetTxsRequest) GetStartHeight() uint64 {
+func (m *BlockExecutionsResponse) GetBlockExecutions() []*exec.BlockExecution {
 	if m != nil {
-		return m.StartHeight
+		return m.BlockExecutions
 	}
-	return 0
+	return nil
 }
 
-func (m *GetTxsRequest) GetEndHeight() uint64 {
+func (*BlockExecutionsResponse) XXX_MessageName() string {
+	return "rpcevents.BlockExecutionsResponse"
+}
+
+type LogsRequest struct {
+	// Address of the contract that emitted the LogEvents
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Event signature (the first topic) of the LogEvents
+	Signature github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Signature,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Signature"`
+	// Blocks from which to return LogEvents - a streaming end bound is treated as the latest block
+	BlockRange *BlockRange `protobuf:"bytes,3,opt,name=BlockRange,proto3" json:"BlockRange,omitempty"`
+	// Decode LogEvents using the ABI registered on-chain for the emitting contract (or Abi)
+	Decode bool `protobuf:"varint,4,opt,name=Decode,proto3" json:"Decode,omitempty"`
+	// Additional ABI JSON with which to decode LogEvents of contracts with no ABI registered on-chain
+	Abi string `protobuf:"bytes,5,opt,name=Abi,proto3" json:"Abi,omitempty"`
+	// If set only return LogEvents with this as their second topic (usually the first indexed argument of the event)
+	Topic                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,6,opt,name=Topic,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Topic"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *LogsRequest) Reset()         { *m = LogsRequest{} }
+func (m *LogsRequest) String() string { return proto.CompactTextString(m) }
+func (*LogsRequest) ProtoMessage()    {}
+func (*LogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{5}
+}
+func (m *LogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsRequest.Merge(m, src)
+}
+func (m *LogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsRequest proto.InternalMessageInfo
+
+func (m *LogsRequest) GetBlockRange() *BlockRange {
 	if m != nil {
-		return m.EndHeight
+		return m.BlockRange
 	}
-	return 0
+	return nil
 }
 
-func (m *GetTxsRequest) GetQuery() string {
+func (m *LogsRequest) GetDecode() bool {
 	if m != nil {
-		return m.Query
+		return m.Decode
+	}
+	return false
+}
+
+func (m *LogsRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
 	return ""
 }
 
-func (*GetTxsRequest) XXX_MessageName() string {
-	return "rpcevents.GetTxsRequest"
+func (*LogsRequest) XXX_MessageName() string {
+	return "rpcevents.LogsRequest"
 }
 
-type GetTxsResponse struct {
-	Height               uint64              `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	TxExecutions         []*exec.TxExecution `protobuf:"bytes,2,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type TxsByAddressRequest struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Return transactions following the transaction with this hash (the Next of the previous page)
+	After github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"After"`
+	// The maximum number of transactions to return (at most MaxTxsByAddress, which is used if zero)
+	Limit uint64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Return the most recent transactions first
+	Descending           bool     `protobuf:"varint,4,opt,name=Descending,proto3" json:"Descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTxsResponse) Reset()         { *m = GetTxsResponse{} }
-func (m *GetTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTxsResponse) ProtoMessage()    {}
-func (*GetTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{5}
+func (m *TxsByAddressRequest) Reset()         { *m = TxsByAddressRequest{} }
+func (m *TxsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*TxsByAddressRequest) ProtoMessage()    {}
+func (*TxsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{6}
 }
-func (m *GetTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *TxsByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxsByAddressRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -435,56 +553,57 @@ func (m *GetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *GetTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxsResponse.Merge(m, src)
+func (m *TxsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxsByAddressRequest.Merge(m, src)
 }
-func (m *GetTxsResponse) XXX_Size() int {
+func (m *TxsByAddressRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxsResponse.DiscardUnknown(m)
+func (m *TxsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxsByAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TxsByAddressRequest proto.InternalMessageInfo
 
-func (m *GetTxsResponse) GetHeight() uint64 {
+func (m *TxsByAddressRequest) GetLimit() uint64 {
 	if m != nil {
-		return m.Height
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *GetTxsResponse) GetTxExecutions() []*exec.TxExecution {
+func (m *TxsByAddressRequest) GetDescending() bool {
 	if m != nil {
-		return m.TxExecutions
+		return m.Descending
 	}
-	return nil
+	return false
 }
 
-func (*GetTxsResponse) XXX_MessageName() string {
-	return "rpcevents.GetTxsResponse"
+func (*TxsByAddressRequest) XXX_MessageName() string {
+	return "rpcevents.TxsByAddressRequest"
 }
 
-type Bound struct {
-	Type                 Bound_BoundType `protobuf:"varint,1,opt,name=Type,proto3,enum=rpcevents.Bound_BoundType" json:"Type,omitempty"`
-	Index                uint64          `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type TxsByAddressResponse struct {
+	TxExecutions []*exec.TxExecution `protobuf:"bytes,1,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// The hash of the last transaction returned if there may be more to fetch by passing it as After, otherwise empty
+	Next                 github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Next,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Next"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *Bound) Reset()         { *m = Bound{} }
-func (m *Bound) String() string { return proto.CompactTextString(m) }
-func (*Bound) ProtoMessage()    {}
-func (*Bound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{6}
+func (m *TxsByAddressResponse) Reset()         { *m = TxsByAddressResponse{} }
+func (m *TxsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*TxsByAddressResponse) ProtoMessage()    {}
+func (*TxsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{7}
 }
-func (m *Bound) XXX_Unmarshal(b []byte) error {
+func (m *TxsByAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxsByAddressResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -494,62 +613,122 @@ func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Bound) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Bound.Merge(m, src)
+func (m *TxsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxsByAddressResponse.Merge(m, src)
 }
-func (m *Bound) XXX_Size() int {
+func (m *TxsByAddressResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Bound) XXX_DiscardUnknown() {
-	xxx_messageInfo_Bound.DiscardUnknown(m)
+func (m *TxsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxsByAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Bound proto.InternalMessageInfo
+var xxx_messageInfo_TxsByAddressResponse proto.InternalMessageInfo
 
-func (m *Bound) GetType() Bound_BoundType {
+func (m *TxsByAddressResponse) GetTxExecutions() []*exec.TxExecution {
 	if m != nil {
-		return m.Type
+		return m.TxExecutions
 	}
-	return Bound_ABSOLUTE
+	return nil
 }
 
-func (m *Bound) GetIndex() uint64 {
+func (*TxsByAddressResponse) XXX_MessageName() string {
+	return "rpcevents.TxsByAddressResponse"
+}
+
+type EventsResponse struct {
+	Height uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Events []*exec.Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+	// Opaque position in the stream after this response
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{8}
+}
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(m, src)
+}
+func (m *EventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+
+func (m *EventsResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.Index
+		return m.Height
 	}
 	return 0
 }
 
-func (*Bound) XXX_MessageName() string {
-	return "rpcevents.Bound"
+func (m *EventsResponse) GetEvents() []*exec.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
 }
 
-// An inclusive range of blocks to include in output
-type BlockRange struct {
-	// Bounds can be set to:
-	// absolute: block height
-	// relative: block height counting back from latest
-	// latest: latest block when call is processed
-	// stream: for End keep sending new blocks, for start same as latest
-	Start                *Bound   `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
-	End                  *Bound   `protobuf:"bytes,2,opt,name=End,proto3" json:"End,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (*EventsResponse) XXX_MessageName() string {
+	return "rpcevents.EventsResponse"
 }
 
-func (m *BlockRange) Reset()         { *m = BlockRange{} }
-func (m *BlockRange) String() string { return proto.CompactTextString(m) }
-func (*BlockRange) ProtoMessage()    {}
-func (*BlockRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{7}
-}
-func (m *BlockRange) XXX_Unmarshal(b []byte) error {
+// An event along with the transaction and block in which it was emitted
+type JoinedEvent struct {
+	Event *exec.Event `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
+	// The transaction that emitted the event
+	TxHeader *exec.TxHeader `protobuf:"bytes,2,opt,name=TxHeader,proto3" json:"TxHeader,omitempty"`
+	// The input accounts of the transaction
+	Inputs []github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,3,rep,name=Inputs,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Inputs"`
+	// Gas used by the transaction
+	GasUsed uint64 `protobuf:"varint,4,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// The time of the block
+	BlockTime time.Time `protobuf:"bytes,5,opt,name=BlockTime,proto3,stdtime" json:"BlockTime"`
+	// The address of the validator that proposed the block
+	Proposer github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,6,opt,name=Proposer,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Proposer"`
+	// Opaque position in the stream after this event
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,7,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *JoinedEvent) Reset()         { *m = JoinedEvent{} }
+func (m *JoinedEvent) String() string { return proto.CompactTextString(m) }
+func (*JoinedEvent) ProtoMessage()    {}
+func (*JoinedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{9}
+}
+func (m *JoinedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JoinedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockRange.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JoinedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,847 +738,2378 @@ func (m *BlockRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *BlockRange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockRange.Merge(m, src)
+func (m *JoinedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinedEvent.Merge(m, src)
 }
-func (m *BlockRange) XXX_Size() int {
+func (m *JoinedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockRange) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockRange.DiscardUnknown(m)
+func (m *JoinedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockRange proto.InternalMessageInfo
+var xxx_messageInfo_JoinedEvent proto.InternalMessageInfo
 
-func (m *BlockRange) GetStart() *Bound {
+func (m *JoinedEvent) GetEvent() *exec.Event {
 	if m != nil {
-		return m.Start
+		return m.Event
 	}
 	return nil
 }
 
-func (m *BlockRange) GetEnd() *Bound {
+func (m *JoinedEvent) GetTxHeader() *exec.TxHeader {
 	if m != nil {
-		return m.End
+		return m.TxHeader
 	}
 	return nil
 }
 
-func (*BlockRange) XXX_MessageName() string {
-	return "rpcevents.BlockRange"
+func (m *JoinedEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
 }
-func init() {
-	proto.RegisterEnum("rpcevents.Bound_BoundType", Bound_BoundType_name, Bound_BoundType_value)
-	golang_proto.RegisterEnum("rpcevents.Bound_BoundType", Bound_BoundType_name, Bound_BoundType_value)
-	proto.RegisterType((*GetBlockRequest)(nil), "rpcevents.GetBlockRequest")
-	golang_proto.RegisterType((*GetBlockRequest)(nil), "rpcevents.GetBlockRequest")
-	proto.RegisterType((*TxRequest)(nil), "rpcevents.TxRequest")
-	golang_proto.RegisterType((*TxRequest)(nil), "rpcevents.TxRequest")
-	proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
-	golang_proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
-	proto.RegisterType((*EventsResponse)(nil), "rpcevents.EventsResponse")
-	golang_proto.RegisterType((*EventsResponse)(nil), "rpcevents.EventsResponse")
-	proto.RegisterType((*GetTxsRequest)(nil), "rpcevents.GetTxsRequest")
-	golang_proto.RegisterType((*GetTxsRequest)(nil), "rpcevents.GetTxsRequest")
-	proto.RegisterType((*GetTxsResponse)(nil), "rpcevents.GetTxsResponse")
-	golang_proto.RegisterType((*GetTxsResponse)(nil), "rpcevents.GetTxsResponse")
-	proto.RegisterType((*Bound)(nil), "rpcevents.Bound")
-	golang_proto.RegisterType((*Bound)(nil), "rpcevents.Bound")
-	proto.RegisterType((*BlockRange)(nil), "rpcevents.BlockRange")
-	golang_proto.RegisterType((*BlockRange)(nil), "rpcevents.BlockRange")
-}
-
-func init() { proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
-func init() { golang_proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
 
-var fileDescriptor_580b21d8d2fd68e4 = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xed, 0xe6, 0xc3, 0xaa, 0x27, 0xfd, 0x30, 0xab, 0x82, 0x42, 0x84, 0xd2, 0xc8, 0x48, 0xa8,
-	0x12, 0xaa, 0x53, 0x05, 0x55, 0x9c, 0x10, 0x8a, 0x25, 0xd3, 0x16, 0xb5, 0x42, 0xac, 0x97, 0x0f,
-	0x21, 0x24, 0xe4, 0x38, 0x8b, 0x13, 0xd1, 0xda, 0xc6, 0x5e, 0x83, 0xf3, 0x53, 0xf8, 0x2b, 0x9c,
-	0x38, 0xf6, 0xc8, 0x99, 0x43, 0x85, 0xd2, 0x3f, 0x82, 0xbc, 0x6b, 0x3b, 0x4e, 0x29, 0xe5, 0x12,
-	0xed, 0xcc, 0x7b, 0x33, 0xf3, 0xf2, 0x76, 0xd6, 0xb0, 0x19, 0x85, 0x2e, 0xfb, 0xc2, 0x7c, 0x1e,
-	0x1b, 0x61, 0x14, 0xf0, 0x00, 0xab, 0x65, 0xa2, 0xb3, 0xeb, 0x4d, 0xf9, 0x24, 0x19, 0x19, 0x6e,
-	0x70, 0xd6, 0xf7, 0x02, 0x2f, 0xe8, 0x0b, 0xc6, 0x28, 0xf9, 0x28, 0x22, 0x11, 0x88, 0x93, 0xac,
-	0xec, 0x00, 0x4b, 0x99, 0x2b, 0xcf, 0xfa, 0x13, 0xd8, 0x3c, 0x60, 0xdc, 0x3c, 0x0d, 0xdc, 0x4f,
-	0x84, 0x7d, 0x4e, 0x58, 0xcc, 0xf1, 0x1d, 0x50, 0x0e, 0xd9, 0xd4, 0x9b, 0xf0, 0x36, 0xea, 0xa1,
-	0x9d, 0x06, 0xc9, 0x23, 0x8c, 0xa1, 0xf1, 0xc6, 0x99, 0xf2, 0x76, 0xad, 0x87, 0x76, 0x56, 0x89,
-	0x38, 0xeb, 0x3e, 0xa8, 0x34, 0x2d, 0x0a, 0x4f, 0x40, 0xa1, 0xe9, 0xa1, 0x13, 0x4f, 0x44, 0xe1,
-	0x9a, 0xb9, 0x7f, 0x7e, 0xb1, 0xbd, 0xf2, 0xeb, 0x62, 0xbb, 0x2a, 0x6f, 0x32, 0x0b, 0x59, 0x74,
-	0xca, 0xc6, 0x1e, 0x8b, 0xfa, 0xa3, 0x24, 0x8a, 0x82, 0xaf, 0xfd, 0xd1, 0xd4, 0x77, 0xa2, 0x99,
-	0x71, 0xc8, 0x52, 0x73, 0xc6, 0x59, 0x4c, 0xf2, 0x26, 0xd7, 0xce, 0x7b, 0x0f, 0xeb, 0x42, 0x6b,
-	0x5c, 0xcc, 0xdc, 0x07, 0x90, 0xe2, 0x1d, 0xdf, 0x63, 0x62, 0x6e, 0x6b, 0x70, 0xdb, 0x58, 0x78,
-	0xb5, 0x00, 0x49, 0x85, 0x88, 0xb7, 0xa0, 0xf9, 0x32, 0x61, 0xd1, 0x4c, 0x34, 0x57, 0x89, 0x0c,
-	0xf4, 0x13, 0xd8, 0xb0, 0x44, 0x19, 0x61, 0x71, 0x18, 0xf8, 0x31, 0xfb, 0xa7, 0x17, 0xf7, 0x41,
-	0x91, 0xcc, 0x76, 0xad, 0x57, 0xdf, 0x69, 0x0d, 0x5a, 0x86, 0xf0, 0x54, 0xe4, 0x48, 0x0e, 0xe9,
-	0x0c, 0xd6, 0x0f, 0x18, 0xa7, 0x69, 0x29, 0xb6, 0x07, 0x2d, 0x9b, 0x3b, 0x11, 0x5f, 0x6a, 0x59,
-	0x4d, 0xe1, 0x7b, 0xa0, 0x5a, 0xfe, 0x38, 0xc7, 0x6b, 0x02, 0x5f, 0x24, 0x16, 0xaa, 0xeb, 0x55,
-	0xd5, 0x1f, 0x60, 0xa3, 0x18, 0xf3, 0x1f, 0xd5, 0xfb, 0xb0, 0x46, 0x53, 0x2b, 0x65, 0x6e, 0xc2,
-	0xa7, 0x81, 0x5f, 0x68, 0xbf, 0x25, 0xb5, 0x57, 0x10, 0xb2, 0x44, 0xd3, 0xbf, 0x21, 0x68, 0x9a,
-	0x41, 0xe2, 0x8f, 0xb1, 0x01, 0x0d, 0x3a, 0x0b, 0xa5, 0xcf, 0x1b, 0x83, 0x4e, 0xd5, 0xe7, 0x0c,
-	0x97, 0xbf, 0x19, 0x83, 0x08, 0x5e, 0x26, 0xf8, 0xc8, 0x1f, 0xb3, 0x34, 0xff, 0x2b, 0x32, 0xd0,
-	0x9f, 0x83, 0x5a, 0x12, 0xf1, 0x1a, 0xac, 0x0e, 0x4d, 0xfb, 0xc5, 0xf1, 0x2b, 0x6a, 0x69, 0x2b,
-	0x59, 0x44, 0xac, 0xe3, 0x21, 0x3d, 0x7a, 0x6d, 0x69, 0x08, 0xab, 0xd0, 0x7c, 0x76, 0x44, 0x6c,
-	0xaa, 0xd5, 0x30, 0x80, 0x72, 0x3c, 0xa4, 0x96, 0x4d, 0xb5, 0x7a, 0x76, 0xb6, 0x29, 0xb1, 0x86,
-	0x27, 0x5a, 0x43, 0x7f, 0x5b, 0xbd, 0x7f, 0xfc, 0x00, 0x9a, 0xc2, 0xcd, 0x7c, 0x11, 0xb4, 0xab,
-	0x02, 0x89, 0x84, 0xb1, 0x0e, 0x75, 0xcb, 0x1f, 0x0b, 0x55, 0xd7, 0xb1, 0x32, 0x70, 0xf0, 0x1d,
-	0xc1, 0x66, 0x69, 0x82, 0xbc, 0x51, 0xfc, 0x18, 0x14, 0x9b, 0x47, 0xcc, 0x39, 0xc3, 0xed, 0xab,
-	0x3b, 0x56, 0x5c, 0x72, 0x27, 0xb7, 0x53, 0xf2, 0x44, 0xdd, 0x1e, 0xc2, 0xbb, 0x50, 0xa3, 0x29,
-	0xde, 0xaa, 0x14, 0x95, 0xcf, 0xa6, 0xf3, 0xb7, 0xff, 0xf8, 0x69, 0xb1, 0x5e, 0x37, 0xcc, 0xb9,
-	0x5b, 0x41, 0x96, 0xb7, 0x76, 0x0f, 0x99, 0xc3, 0xf3, 0x79, 0x17, 0xfd, 0x9c, 0x77, 0xd1, 0xef,
-	0x79, 0x17, 0xfd, 0xb8, 0xec, 0xa2, 0xf3, 0xcb, 0x2e, 0x7a, 0xf7, 0xf0, 0xe6, 0x87, 0x18, 0x85,
-	0x6e, 0xbf, 0xec, 0x39, 0x52, 0xc4, 0x07, 0xe2, 0xd1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x65,
-	0xc9, 0x65, 0x0f, 0x79, 0x04, 0x00, 0x00,
+func (m *JoinedEvent) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ExecutionEventsClient is the client API for ExecutionEvents service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ExecutionEventsClient interface {
-	// Get StreamEvents (including transactions) for a range of block heights
-	Stream(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_StreamClient, error)
-	// Get a particular TxExecution by hash
-	Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*exec.TxExecution, error)
-	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
-	// are guaranteed to be delivered in each GetEventsResponse
-	Events(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_EventsClient, error)
+func (*JoinedEvent) XXX_MessageName() string {
+	return "rpcevents.JoinedEvent"
 }
 
-type executionEventsClient struct {
-	cc *grpc.ClientConn
+// The position of a named subscription persisted by the node
+type Subscription struct {
+	// The method streaming the subscription
+	Method string `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The query the subscription was created with, which it can only be resumed with
+	Query string `protobuf:"bytes,3,opt,name=Query,proto3" json:"Query,omitempty"`
+	// The position in the stream after the last message sent
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func NewExecutionEventsClient(cc *grpc.ClientConn) ExecutionEventsClient {
-	return &executionEventsClient{cc}
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{10}
 }
-
-func (c *executionEventsClient) Stream(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[0], "/rpcevents.ExecutionEvents/Stream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &executionEventsStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type ExecutionEvents_StreamClient interface {
-	Recv() (*exec.StreamEvent, error)
-	grpc.ClientStream
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
 }
-
-type executionEventsStreamClient struct {
-	grpc.ClientStream
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
 }
 
-func (x *executionEventsStreamClient) Recv() (*exec.StreamEvent, error) {
-	m := new(exec.StreamEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return m, nil
+	return ""
 }
 
-func (c *executionEventsClient) Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*exec.TxExecution, error) {
-	out := new(exec.TxExecution)
-	err := c.cc.Invoke(ctx, "/rpcevents.ExecutionEvents/Tx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Subscription) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *executionEventsClient) Events(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[1], "/rpcevents.ExecutionEvents/Events", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &executionEventsEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Subscription) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return x, nil
+	return ""
 }
 
-type ExecutionEvents_EventsClient interface {
-	Recv() (*EventsResponse, error)
-	grpc.ClientStream
+func (*Subscription) XXX_MessageName() string {
+	return "rpcevents.Subscription"
 }
 
-type executionEventsEventsClient struct {
-	grpc.ClientStream
+type SubscriptionRequest struct {
+	// The method streaming the subscription (Events, JoinEvents, or BlockExecutions)
+	Method               string   `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *executionEventsEventsClient) Recv() (*EventsResponse, error) {
-	m := new(EventsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SubscriptionRequest) Reset()         { *m = SubscriptionRequest{} }
+func (m *SubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRequest) ProtoMessage()    {}
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{11}
 }
-
-// ExecutionEventsServer is the server API for ExecutionEvents service.
-type ExecutionEventsServer interface {
-	// Get StreamEvents (including transactions) for a range of block heights
-	Stream(*BlocksRequest, ExecutionEvents_StreamServer) error
-	// Get a particular TxExecution by hash
-	Tx(context.Context, *TxRequest) (*exec.TxExecution, error)
-	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
-	// are guaranteed to be delivered in each GetEventsResponse
-	Events(*BlocksRequest, ExecutionEvents_EventsServer) error
+func (m *SubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// UnimplementedExecutionEventsServer can be embedded to have forward compatible implementations.
-type UnimplementedExecutionEventsServer struct {
+func (m *SubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (*UnimplementedExecutionEventsServer) Stream(req *BlocksRequest, srv ExecutionEvents_StreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+func (m *SubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRequest.Merge(m, src)
 }
-func (*UnimplementedExecutionEventsServer) Tx(ctx context.Context, req *TxRequest) (*exec.TxExecution, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tx not implemented")
+func (m *SubscriptionRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedExecutionEventsServer) Events(req *BlocksRequest, srv ExecutionEvents_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+func (m *SubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRequest.DiscardUnknown(m)
 }
 
-func RegisterExecutionEventsServer(s *grpc.Server, srv ExecutionEventsServer) {
-	s.RegisterService(&_ExecutionEvents_serviceDesc, srv)
-}
+var xxx_messageInfo_SubscriptionRequest proto.InternalMessageInfo
 
-func _ExecutionEvents_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(BlocksRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *SubscriptionRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return srv.(ExecutionEventsServer).Stream(m, &executionEventsStreamServer{stream})
+	return ""
 }
 
-type ExecutionEvents_StreamServer interface {
-	Send(*exec.StreamEvent) error
-	grpc.ServerStream
+func (m *SubscriptionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type executionEventsStreamServer struct {
-	grpc.ServerStream
+func (*SubscriptionRequest) XXX_MessageName() string {
+	return "rpcevents.SubscriptionRequest"
 }
 
-func (x *executionEventsStreamServer) Send(m *exec.StreamEvent) error {
-	return x.ServerStream.SendMsg(m)
+type GetTxsRequest struct {
+	StartHeight          uint64   `protobuf:"varint,1,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	EndHeight            uint64   `protobuf:"varint,2,opt,name=EndHeight,proto3" json:"EndHeight,omitempty"`
+	Query                string   `protobuf:"bytes,3,opt,name=Query,proto3" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ExecutionEvents_Tx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ExecutionEventsServer).Tx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcevents.ExecutionEvents/Tx",
+func (m *GetTxsRequest) Reset()         { *m = GetTxsRequest{} }
+func (m *GetTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsRequest) ProtoMessage()    {}
+func (*GetTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{12}
+}
+func (m *GetTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExecutionEventsServer).Tx(ctx, req.(*TxRequest))
+}
+func (m *GetTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsRequest.Merge(m, src)
+}
+func (m *GetTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxsRequest proto.InternalMessageInfo
+
+func (m *GetTxsRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ExecutionEvents_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(BlocksRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *GetTxsRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return srv.(ExecutionEventsServer).Events(m, &executionEventsEventsServer{stream})
+	return 0
 }
 
-type ExecutionEvents_EventsServer interface {
-	Send(*EventsResponse) error
-	grpc.ServerStream
+func (m *GetTxsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
 
-type executionEventsEventsServer struct {
-	grpc.ServerStream
+func (*GetTxsRequest) XXX_MessageName() string {
+	return "rpcevents.GetTxsRequest"
 }
 
-func (x *executionEventsEventsServer) Send(m *EventsResponse) error {
-	return x.ServerStream.SendMsg(m)
+type GetTxsResponse struct {
+	Height               uint64              `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	TxExecutions         []*exec.TxExecution `protobuf:"bytes,2,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-var _ExecutionEvents_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcevents.ExecutionEvents",
-	HandlerType: (*ExecutionEventsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Tx",
-			Handler:    _ExecutionEvents_Tx_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Stream",
-			Handler:       _ExecutionEvents_Stream_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Events",
-			Handler:       _ExecutionEvents_Events_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "rpcevents.proto",
+func (m *GetTxsResponse) Reset()         { *m = GetTxsResponse{} }
+func (m *GetTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxsResponse) ProtoMessage()    {}
+func (*GetTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{13}
 }
-
-func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsResponse.Merge(m, src)
+}
+func (m *GetTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsResponse.DiscardUnknown(m)
 }
 
-func (m *GetBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Wait {
-		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Height != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_GetTxsResponse proto.InternalMessageInfo
+
+func (m *GetTxsResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetTxsResponse) GetTxExecutions() []*exec.TxExecution {
+	if m != nil {
+		return m.TxExecutions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*GetTxsResponse) XXX_MessageName() string {
+	return "rpcevents.GetTxsResponse"
 }
 
-func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Wait {
-		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.TxHash.Size()
-		i -= size
-		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRpcevents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type Bound struct {
+	Type                 Bound_BoundType `protobuf:"varint,1,opt,name=Type,proto3,enum=rpcevents.Bound_BoundType" json:"Type,omitempty"`
+	Index                uint64          `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *BlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{14}
 }
-
-func (m *BlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockRange != nil {
-		{
-			size, err := m.BlockRange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
+}
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
 }
 
-func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetType() Bound_BoundType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return Bound_ABSOLUTE
 }
 
-func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Bound) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRpcevents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Height != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*Bound) XXX_MessageName() string {
+	return "rpcevents.Bound"
 }
 
-func (m *GetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// An inclusive range of blocks to include in output
+type BlockRange struct {
+	// Bounds can be set to:
+	// absolute: block height
+	// relative: block height counting back from latest
+	// latest: latest block when call is processed
+	// stream: for End keep sending new blocks, for start same as latest
+	Start                *Bound   `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	End                  *Bound   `protobuf:"bytes,2,opt,name=End,proto3" json:"End,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockRange) Reset()         { *m = BlockRange{} }
+func (m *BlockRange) String() string { return proto.CompactTextString(m) }
+func (*BlockRange) ProtoMessage()    {}
+func (*BlockRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{15}
 }
-
-func (m *GetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *BlockRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *BlockRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRange.Merge(m, src)
+}
+func (m *BlockRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRange proto.InternalMessageInfo
+
+func (m *BlockRange) GetStart() *Bound {
+	if m != nil {
+		return m.Start
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockRange) GetEnd() *Bound {
+	if m != nil {
+		return m.End
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*BlockRange) XXX_MessageName() string {
+	return "rpcevents.BlockRange"
+}
+func init() {
+	proto.RegisterEnum("rpcevents.Bound_BoundType", Bound_BoundType_name, Bound_BoundType_value)
+	golang_proto.RegisterEnum("rpcevents.Bound_BoundType", Bound_BoundType_name, Bound_BoundType_value)
+	proto.RegisterType((*GetBlockRequest)(nil), "rpcevents.GetBlockRequest")
+	golang_proto.RegisterType((*GetBlockRequest)(nil), "rpcevents.GetBlockRequest")
+	proto.RegisterType((*TxRequest)(nil), "rpcevents.TxRequest")
+	golang_proto.RegisterType((*TxRequest)(nil), "rpcevents.TxRequest")
+	proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
+	golang_proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
+	proto.RegisterType((*BlockExecutionsRequest)(nil), "rpcevents.BlockExecutionsRequest")
+	golang_proto.RegisterType((*BlockExecutionsRequest)(nil), "rpcevents.BlockExecutionsRequest")
+	proto.RegisterType((*BlockExecutionsResponse)(nil), "rpcevents.BlockExecutionsResponse")
+	golang_proto.RegisterType((*BlockExecutionsResponse)(nil), "rpcevents.BlockExecutionsResponse")
+	proto.RegisterType((*LogsRequest)(nil), "rpcevents.LogsRequest")
+	golang_proto.RegisterType((*LogsRequest)(nil), "rpcevents.LogsRequest")
+	proto.RegisterType((*TxsByAddressRequest)(nil), "rpcevents.TxsByAddressRequest")
+	golang_proto.RegisterType((*TxsByAddressRequest)(nil), "rpcevents.TxsByAddressRequest")
+	proto.RegisterType((*TxsByAddressResponse)(nil), "rpcevents.TxsByAddressResponse")
+	golang_proto.RegisterType((*TxsByAddressResponse)(nil), "rpcevents.TxsByAddressResponse")
+	proto.RegisterType((*EventsResponse)(nil), "rpcevents.EventsResponse")
+	golang_proto.RegisterType((*EventsResponse)(nil), "rpcevents.EventsResponse")
+	proto.RegisterType((*JoinedEvent)(nil), "rpcevents.JoinedEvent")
+	golang_proto.RegisterType((*JoinedEvent)(nil), "rpcevents.JoinedEvent")
+	proto.RegisterType((*Subscription)(nil), "rpcevents.Subscription")
+	golang_proto.RegisterType((*Subscription)(nil), "rpcevents.Subscription")
+	proto.RegisterType((*SubscriptionRequest)(nil), "rpcevents.SubscriptionRequest")
+	golang_proto.RegisterType((*SubscriptionRequest)(nil), "rpcevents.SubscriptionRequest")
+	proto.RegisterType((*GetTxsRequest)(nil), "rpcevents.GetTxsRequest")
+	golang_proto.RegisterType((*GetTxsRequest)(nil), "rpcevents.GetTxsRequest")
+	proto.RegisterType((*GetTxsResponse)(nil), "rpcevents.GetTxsResponse")
+	golang_proto.RegisterType((*GetTxsResponse)(nil), "rpcevents.GetTxsResponse")
+	proto.RegisterType((*Bound)(nil), "rpcevents.Bound")
+	golang_proto.RegisterType((*Bound)(nil), "rpcevents.Bound")
+	proto.RegisterType((*BlockRange)(nil), "rpcevents.BlockRange")
+	golang_proto.RegisterType((*BlockRange)(nil), "rpcevents.BlockRange")
 }
 
-func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TxExecutions) > 0 {
-		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRpcevents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Height != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
+func init() { golang_proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
+
+var fileDescriptor_580b21d8d2fd68e4 = []byte{
+	// 1167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x73, 0xdb, 0x44,
+	0x14, 0xaf, 0x6c, 0xd9, 0x89, 0x9f, 0xd3, 0xc4, 0x6c, 0x43, 0x2a, 0x3c, 0x8c, 0x93, 0x8a, 0x19,
+	0x26, 0x03, 0xd4, 0xc9, 0x18, 0x02, 0x27, 0x3e, 0x6c, 0x62, 0x92, 0xb4, 0x4e, 0x28, 0x6b, 0x95,
+	0x32, 0xbd, 0x30, 0xb2, 0xf4, 0x6a, 0x6b, 0x88, 0x25, 0x21, 0xad, 0x41, 0xe6, 0xaf, 0xe0, 0x08,
+	0x33, 0xcc, 0x70, 0x85, 0xff, 0x82, 0x63, 0x8e, 0x9c, 0x39, 0xb4, 0x25, 0xbd, 0xf0, 0x2f, 0x70,
+	0x63, 0xb4, 0x5a, 0x59, 0x6b, 0xe7, 0xa3, 0xa1, 0x49, 0x2f, 0x9e, 0x7d, 0x1f, 0xfb, 0xbe, 0xf4,
+	0xde, 0xfb, 0xad, 0x61, 0x29, 0xf0, 0x2d, 0xfc, 0x0e, 0x5d, 0x16, 0xd6, 0xfd, 0xc0, 0x63, 0x1e,
+	0x29, 0x4d, 0x18, 0xd5, 0xdb, 0x7d, 0x87, 0x0d, 0x46, 0xbd, 0xba, 0xe5, 0x0d, 0x37, 0xfa, 0x5e,
+	0xdf, 0xdb, 0xe0, 0x1a, 0xbd, 0xd1, 0x23, 0x4e, 0x71, 0x82, 0x9f, 0x92, 0x9b, 0xd5, 0xd5, 0xbe,
+	0xe7, 0xf5, 0x0f, 0x31, 0xd3, 0x62, 0xce, 0x10, 0x43, 0x66, 0x0e, 0x7d, 0xa1, 0x00, 0x18, 0xa1,
+	0x95, 0x9c, 0xf5, 0x0f, 0x61, 0x69, 0x07, 0x59, 0xeb, 0xd0, 0xb3, 0xbe, 0xa1, 0xf8, 0xed, 0x08,
+	0x43, 0x46, 0x56, 0xa0, 0xb8, 0x8b, 0x4e, 0x7f, 0xc0, 0x34, 0x65, 0x4d, 0x59, 0x57, 0xa9, 0xa0,
+	0x08, 0x01, 0xf5, 0x81, 0xe9, 0x30, 0x2d, 0xb7, 0xa6, 0xac, 0xcf, 0x53, 0x7e, 0xd6, 0x5d, 0x28,
+	0x19, 0x51, 0x7a, 0x71, 0x1f, 0x8a, 0x46, 0xb4, 0x6b, 0x86, 0x03, 0x7e, 0x71, 0xa1, 0xb5, 0x75,
+	0xf4, 0x78, 0xf5, 0xda, 0x5f, 0x8f, 0x57, 0xe5, 0xf8, 0x07, 0x63, 0x1f, 0x83, 0x43, 0xb4, 0xfb,
+	0x18, 0x6c, 0xf4, 0x46, 0x41, 0xe0, 0x7d, 0xbf, 0xd1, 0x73, 0x5c, 0x33, 0x18, 0xd7, 0x77, 0x31,
+	0x6a, 0x8d, 0x19, 0x86, 0x54, 0x18, 0x39, 0xd5, 0xdf, 0xbf, 0x0a, 0x5c, 0xe7, 0xc1, 0x86, 0xa9,
+	0xd3, 0x2d, 0x80, 0x24, 0x7a, 0xd3, 0xed, 0x23, 0x77, 0x5c, 0x6e, 0xbc, 0x5a, 0xcf, 0xaa, 0x99,
+	0x09, 0xa9, 0xa4, 0x48, 0x96, 0xa1, 0xf0, 0xc5, 0x08, 0x83, 0x31, 0xb7, 0x5e, 0xa2, 0x09, 0x11,
+	0xa7, 0xbe, 0x8d, 0x96, 0x67, 0xa3, 0x96, 0xe7, 0x4e, 0x05, 0x45, 0x2a, 0x90, 0x6f, 0xf6, 0x1c,
+	0x4d, 0xe5, 0xba, 0xf1, 0x31, 0xce, 0xf5, 0xd3, 0x51, 0x10, 0x7a, 0x81, 0x56, 0xb8, 0x54, 0xae,
+	0x89, 0x11, 0xa2, 0xc3, 0x42, 0x77, 0xd4, 0x0b, 0xad, 0xc0, 0xf1, 0x99, 0xe3, 0xb9, 0x5a, 0x91,
+	0x7b, 0x9a, 0xe2, 0xe9, 0x4f, 0x15, 0x58, 0xe1, 0x19, 0xb4, 0x23, 0xb4, 0x46, 0x31, 0xeb, 0xb2,
+	0x45, 0x78, 0x1d, 0x4a, 0x2d, 0x93, 0x59, 0x83, 0xae, 0xf3, 0x03, 0xf2, 0x42, 0xa8, 0x34, 0x63,
+	0x48, 0x29, 0xe6, 0x5f, 0x46, 0x8a, 0xea, 0x29, 0x29, 0xfe, 0xa6, 0xc0, 0xcd, 0x13, 0x29, 0x86,
+	0xbe, 0xe7, 0x86, 0x48, 0x3e, 0x82, 0xa5, 0x19, 0x91, 0xa6, 0xac, 0xe5, 0xd7, 0xcb, 0x8d, 0xe5,
+	0x3a, 0xef, 0xe7, 0x69, 0x21, 0x9d, 0x55, 0x96, 0xd2, 0xc9, 0x5d, 0x41, 0x3a, 0xfa, 0x93, 0x1c,
+	0x94, 0x3b, 0x5e, 0x7f, 0xf2, 0x09, 0x0e, 0x60, 0xae, 0x69, 0xdb, 0x01, 0x86, 0xa1, 0xe8, 0xfe,
+	0xf7, 0x84, 0xfd, 0x77, 0xce, 0xb7, 0x6f, 0x05, 0x63, 0x9f, 0x79, 0x75, 0x71, 0x97, 0xa6, 0x46,
+	0x08, 0x85, 0x52, 0xd7, 0xe9, 0xbb, 0x26, 0x1b, 0x05, 0xa8, 0xe5, 0xfe, 0x8f, 0x45, 0x11, 0xf1,
+	0x03, 0x2f, 0xb0, 0x1b, 0x5b, 0xef, 0xd3, 0xcc, 0xcc, 0x4c, 0x9b, 0xe4, 0x2f, 0xda, 0x26, 0xd9,
+	0x54, 0xa8, 0xa7, 0x4d, 0x45, 0x21, 0x9b, 0x8a, 0xbb, 0x50, 0x30, 0x3c, 0xdf, 0xb1, 0xb4, 0xe2,
+	0x65, 0x4a, 0x9c, 0xd8, 0xd0, 0xff, 0x51, 0xe0, 0x86, 0x11, 0x85, 0xad, 0x71, 0x5a, 0x9b, 0x97,
+	0x54, 0xe9, 0xbb, 0x50, 0x68, 0x3e, 0x62, 0x78, 0xc9, 0xbe, 0x48, 0x6c, 0xc4, 0x7b, 0xa5, 0xe3,
+	0x0c, 0x1d, 0xc6, 0xab, 0xab, 0xd2, 0x84, 0x20, 0x35, 0x80, 0x6d, 0x0c, 0x2d, 0x74, 0x6d, 0xc7,
+	0xed, 0x8b, 0x2a, 0x4a, 0x1c, 0xfd, 0x27, 0x05, 0x96, 0xa7, 0x53, 0x15, 0x4d, 0xbf, 0x05, 0x0b,
+	0x46, 0x74, 0xa2, 0xe3, 0x5f, 0x49, 0x3a, 0x5e, 0x92, 0xd0, 0x29, 0x35, 0xb2, 0x07, 0xea, 0x01,
+	0x46, 0xec, 0x72, 0x19, 0x71, 0x13, 0xfa, 0x2f, 0x0a, 0x2c, 0xb6, 0x79, 0x7b, 0x4c, 0x82, 0x3a,
+	0x0b, 0x20, 0xde, 0x80, 0x62, 0xa2, 0xa9, 0xe5, 0x78, 0x98, 0xe5, 0x24, 0x4c, 0xce, 0xa3, 0x42,
+	0x74, 0xc5, 0x5b, 0x45, 0xff, 0x3d, 0x0f, 0xe5, 0x3b, 0x9e, 0xe3, 0xa2, 0xcd, 0xed, 0x93, 0x5b,
+	0x50, 0xe0, 0x07, 0xb1, 0x04, 0xa7, 0x42, 0x48, 0x24, 0xe4, 0x2d, 0x98, 0x37, 0xa2, 0x5d, 0x34,
+	0x6d, 0xf1, 0xc9, 0xcb, 0x8d, 0xc5, 0xb4, 0x9e, 0x09, 0x97, 0x4e, 0xe4, 0xa4, 0x03, 0xc5, 0x3d,
+	0xd7, 0x1f, 0xb1, 0x50, 0xcb, 0xaf, 0xe5, 0x5f, 0xb8, 0xd5, 0x84, 0x0d, 0xa2, 0xc1, 0xdc, 0x8e,
+	0x19, 0xde, 0x0f, 0xd1, 0xe6, 0x3d, 0xa0, 0xd2, 0x94, 0x24, 0x2d, 0x28, 0xf1, 0x81, 0x33, 0x9c,
+	0x21, 0xf2, 0x81, 0x2a, 0x37, 0xaa, 0xf5, 0x04, 0xc7, 0xeb, 0x29, 0x8e, 0xd7, 0x8d, 0x14, 0xc7,
+	0x5b, 0xf3, 0x71, 0x18, 0x3f, 0x3e, 0x59, 0x55, 0x68, 0x76, 0x8d, 0xdc, 0x83, 0xf9, 0x7b, 0x81,
+	0xe7, 0x7b, 0x21, 0x06, 0x62, 0xfe, 0x5e, 0x2c, 0xda, 0x89, 0x15, 0xe9, 0x5b, 0xcd, 0x5d, 0xc5,
+	0xb7, 0xfa, 0x55, 0x99, 0x86, 0x80, 0xb8, 0x91, 0xf6, 0x91, 0x0d, 0x3c, 0x9b, 0x7f, 0xad, 0x12,
+	0x15, 0x54, 0x8c, 0xfc, 0x07, 0xe6, 0x10, 0x05, 0x36, 0xf3, 0x73, 0x06, 0xd8, 0x79, 0x19, 0xb0,
+	0xb3, 0x08, 0xd5, 0xab, 0x88, 0xb0, 0x09, 0x37, 0xe4, 0x00, 0xa5, 0x17, 0xd1, 0x45, 0xe3, 0xd4,
+	0x11, 0xae, 0xef, 0x20, 0x33, 0xa2, 0xc9, 0xba, 0x5a, 0x83, 0x72, 0x97, 0x99, 0x01, 0x9b, 0x1a,
+	0x19, 0x99, 0x15, 0xc3, 0x70, 0xdb, 0xb5, 0x85, 0x5c, 0xc0, 0xf0, 0x84, 0x71, 0x7a, 0xe2, 0xfa,
+	0xd7, 0xb0, 0x98, 0xba, 0x79, 0xce, 0x54, 0xce, 0xae, 0x90, 0xdc, 0x85, 0x56, 0x88, 0xfe, 0xb3,
+	0x02, 0x85, 0x96, 0x37, 0x72, 0x6d, 0x52, 0x07, 0xd5, 0x18, 0xfb, 0xc9, 0xb3, 0x62, 0xb1, 0x51,
+	0x95, 0xf1, 0x22, 0x96, 0x27, 0xbf, 0xb1, 0x06, 0xe5, 0x7a, 0x71, 0xc0, 0x7b, 0xae, 0x8d, 0x91,
+	0x48, 0x25, 0x21, 0xf4, 0x3b, 0x50, 0x9a, 0x28, 0x92, 0x05, 0x98, 0x6f, 0xb6, 0xba, 0x9f, 0x77,
+	0xee, 0x1b, 0xed, 0xca, 0xb5, 0x98, 0xa2, 0xed, 0x4e, 0xd3, 0xd8, 0xfb, 0xb2, 0x5d, 0x51, 0x48,
+	0x09, 0x0a, 0x9f, 0xed, 0xd1, 0xae, 0x51, 0xc9, 0x11, 0x80, 0x62, 0xa7, 0x69, 0xb4, 0xbb, 0x46,
+	0x25, 0x1f, 0x9f, 0xbb, 0x06, 0x6d, 0x37, 0xf7, 0x2b, 0xaa, 0xfe, 0x95, 0x8c, 0x63, 0xe4, 0x4d,
+	0x28, 0xf0, 0x6a, 0x8a, 0x91, 0xaf, 0xcc, 0x06, 0x48, 0x13, 0x31, 0xd1, 0x21, 0xdf, 0x76, 0x6d,
+	0x2d, 0x77, 0x86, 0x56, 0x2c, 0x6c, 0xfc, 0xad, 0xc2, 0xd2, 0xa4, 0x08, 0x62, 0x63, 0x7d, 0x00,
+	0xc5, 0x2e, 0x0b, 0xd0, 0x1c, 0x12, 0x6d, 0x16, 0x2b, 0xd3, 0x8f, 0x5c, 0x15, 0xe5, 0x4c, 0xf4,
+	0xf8, 0xbd, 0x4d, 0x85, 0xdc, 0x86, 0x9c, 0x11, 0x91, 0x65, 0xe9, 0x92, 0x11, 0xcd, 0x5c, 0x90,
+	0x4a, 0x4e, 0x3e, 0x4e, 0xd7, 0xe7, 0x39, 0x7e, 0x5e, 0x93, 0x24, 0xd3, 0x5b, 0x99, 0xfb, 0x53,
+	0xe3, 0x17, 0x09, 0x59, 0x91, 0x94, 0xa4, 0x27, 0x4a, 0x55, 0x5e, 0x86, 0x9b, 0x0a, 0xf9, 0x04,
+	0x20, 0xde, 0x9c, 0xcf, 0xf5, 0x29, 0x9b, 0x93, 0x56, 0xed, 0xa6, 0x42, 0x28, 0xff, 0xf3, 0x20,
+	0x03, 0x17, 0xa9, 0x4d, 0x65, 0x7b, 0x02, 0xbc, 0xab, 0xab, 0x67, 0xca, 0x33, 0xc4, 0xe3, 0x36,
+	0x29, 0x5a, 0xe8, 0xf8, 0xec, 0x8c, 0xf2, 0x2d, 0xa5, 0xe5, 0x4b, 0xd5, 0x1e, 0x9e, 0x78, 0x1d,
+	0x92, 0x5b, 0xb3, 0x19, 0x9d, 0x78, 0x37, 0x57, 0xf5, 0xf3, 0x54, 0x26, 0x75, 0xdd, 0x07, 0xb2,
+	0x8d, 0x87, 0xc8, 0x70, 0x6a, 0x79, 0xc9, 0x99, 0x9e, 0xb2, 0x34, 0xaa, 0x37, 0xcf, 0x90, 0xb7,
+	0x9a, 0x47, 0xc7, 0x35, 0xe5, 0xcf, 0xe3, 0x9a, 0xf2, 0xf4, 0xb8, 0xa6, 0xfc, 0xf1, 0xac, 0xa6,
+	0x1c, 0x3d, 0xab, 0x29, 0x0f, 0xdf, 0x3e, 0x7f, 0x63, 0x05, 0xbe, 0xb5, 0x31, 0xb1, 0xd7, 0x2b,
+	0x72, 0x4c, 0x78, 0xf7, 0xbf, 0x01, 0x00, 0x10, 0x25, 0x7c, 0x60, 0x36, 0x0e, 0x00, 0x00,
 }
 
-func (m *Bound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExecutionEventsClient is the client API for ExecutionEvents service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExecutionEventsClient interface {
+	// Get StreamEvents (including transactions) for a range of block heights
+	Stream(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_StreamClient, error)
+	// Get a particular TxExecution by hash
+	Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
+	// are guaranteed to be delivered in each GetEventsResponse
+	Events(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_EventsClient, error)
+	// Get the LogEvents emitted by a particular contract with a particular event signature (first topic) from the log
+	// index without scanning every event
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (ExecutionEvents_LogsClient, error)
+	// Get the events matching a query over a range of blocks, each joined with the transaction that emitted it and
+	// the header of its block, so consumers need not look these up for every event
+	JoinEvents(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_JoinEventsClient, error)
+	// Get a page of the transactions in which an address was involved (as an input, output, callee, created contract,
+	// or log emitter) from the address index without scanning every block
+	GetTxsByAddress(ctx context.Context, in *TxsByAddressRequest, opts ...grpc.CallOption) (*TxsByAddressResponse, error)
+	// Get the receipt (outcome, gas used, logs, and contract address) of a particular transaction by hash without its
+	// full TxExecution
+	GetTxReceipt(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*exec.TxReceipt, error)
+	// Get complete BlockExecutions for a range of block heights delivered in batches so that indexers can backfill
+	// without requesting each block in turn
+	BlockExecutions(ctx context.Context, in *BlockExecutionsRequest, opts ...grpc.CallOption) (ExecutionEvents_BlockExecutionsClient, error)
+	// Delete a named subscription of the calling identity that is not being streamed, returning its last position
+	DeleteSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type executionEventsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExecutionEventsClient(cc *grpc.ClientConn) ExecutionEventsClient {
+	return &executionEventsClient{cc}
+}
+
+func (c *executionEventsClient) Stream(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[0], "/rpcevents.ExecutionEvents/Stream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &executionEventsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExecutionEvents_StreamClient interface {
+	Recv() (*exec.StreamEvent, error)
+	grpc.ClientStream
 }
 
-func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Index != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+type executionEventsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsStreamClient) Recv() (*exec.StreamEvent, error) {
+	m := new(exec.StreamEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *BlockRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *executionEventsClient) Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	out := new(exec.TxExecution)
+	err := c.cc.Invoke(ctx, "/rpcevents.ExecutionEvents/Tx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BlockRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *executionEventsClient) Events(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[1], "/rpcevents.ExecutionEvents/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executionEventsEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *BlockRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.End != nil {
-		{
-			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRpcevents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Start != nil {
-		{
-			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRpcevents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ExecutionEvents_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
 }
 
-func encodeVarintRpcevents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRpcevents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type executionEventsEventsClient struct {
+	grpc.ClientStream
 }
-func (m *GetBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRpcevents(uint64(m.Height))
-	}
-	if m.Wait {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (x *executionEventsEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *TxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *executionEventsClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (ExecutionEvents_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[2], "/rpcevents.ExecutionEvents/Logs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TxHash.Size()
-	n += 1 + l + sovRpcevents(uint64(l))
-	if m.Wait {
-		n += 2
+	x := &executionEventsLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *BlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRange != nil {
-		l = m.BlockRange.Size()
-		n += 1 + l + sovRpcevents(uint64(l))
-	}
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovRpcevents(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type ExecutionEvents_LogsClient interface {
+	Recv() (*exec.Event, error)
+	grpc.ClientStream
 }
 
-func (m *EventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRpcevents(uint64(m.Height))
-	}
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovRpcevents(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type executionEventsLogsClient struct {
+	grpc.ClientStream
 }
 
-func (m *GetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovRpcevents(uint64(m.StartHeight))
+func (x *executionEventsLogsClient) Recv() (*exec.Event, error) {
+	m := new(exec.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.EndHeight != 0 {
-		n += 1 + sovRpcevents(uint64(m.EndHeight))
+	return m, nil
+}
+
+func (c *executionEventsClient) JoinEvents(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_JoinEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[3], "/rpcevents.ExecutionEvents/JoinEvents", opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovRpcevents(uint64(l))
+	x := &executionEventsJoinEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *GetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRpcevents(uint64(m.Height))
-	}
-	if len(m.TxExecutions) > 0 {
-		for _, e := range m.TxExecutions {
-			l = e.Size()
-			n += 1 + l + sovRpcevents(uint64(l))
-		}
+type ExecutionEvents_JoinEventsClient interface {
+	Recv() (*JoinedEvent, error)
+	grpc.ClientStream
+}
+
+type executionEventsJoinEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsJoinEventsClient) Recv() (*JoinedEvent, error) {
+	m := new(JoinedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return m, nil
+}
+
+func (c *executionEventsClient) GetTxsByAddress(ctx context.Context, in *TxsByAddressRequest, opts ...grpc.CallOption) (*TxsByAddressResponse, error) {
+	out := new(TxsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/rpcevents.ExecutionEvents/GetTxsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Bound) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *executionEventsClient) GetTxReceipt(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*exec.TxReceipt, error) {
+	out := new(exec.TxReceipt)
+	err := c.cc.Invoke(ctx, "/rpcevents.ExecutionEvents/GetTxReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRpcevents(uint64(m.Type))
+	return out, nil
+}
+
+func (c *executionEventsClient) BlockExecutions(ctx context.Context, in *BlockExecutionsRequest, opts ...grpc.CallOption) (ExecutionEvents_BlockExecutionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExecutionEvents_serviceDesc.Streams[4], "/rpcevents.ExecutionEvents/BlockExecutions", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovRpcevents(uint64(m.Index))
+	x := &executionEventsBlockExecutionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *BlockRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Start != nil {
-		l = m.Start.Size()
-		n += 1 + l + sovRpcevents(uint64(l))
-	}
-	if m.End != nil {
-		l = m.End.Size()
-		n += 1 + l + sovRpcevents(uint64(l))
+type ExecutionEvents_BlockExecutionsClient interface {
+	Recv() (*BlockExecutionsResponse, error)
+	grpc.ClientStream
+}
+
+type executionEventsBlockExecutionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsBlockExecutionsClient) Recv() (*BlockExecutionsResponse, error) {
+	m := new(BlockExecutionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return m, nil
+}
+
+func (c *executionEventsClient) DeleteSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, "/rpcevents.ExecutionEvents/DeleteSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovRpcevents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// ExecutionEventsServer is the server API for ExecutionEvents service.
+type ExecutionEventsServer interface {
+	// Get StreamEvents (including transactions) for a range of block heights
+	Stream(*BlocksRequest, ExecutionEvents_StreamServer) error
+	// Get a particular TxExecution by hash
+	Tx(context.Context, *TxRequest) (*exec.TxExecution, error)
+	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
+	// are guaranteed to be delivered in each GetEventsResponse
+	Events(*BlocksRequest, ExecutionEvents_EventsServer) error
+	// Get the LogEvents emitted by a particular contract with a particular event signature (first topic) from the log
+	// index without scanning every event
+	Logs(*LogsRequest, ExecutionEvents_LogsServer) error
+	// Get the events matching a query over a range of blocks, each joined with the transaction that emitted it and
+	// the header of its block, so consumers need not look these up for every event
+	JoinEvents(*BlocksRequest, ExecutionEvents_JoinEventsServer) error
+	// Get a page of the transactions in which an address was involved (as an input, output, callee, created contract,
+	// or log emitter) from the address index without scanning every block
+	GetTxsByAddress(context.Context, *TxsByAddressRequest) (*TxsByAddressResponse, error)
+	// Get the receipt (outcome, gas used, logs, and contract address) of a particular transaction by hash without its
+	// full TxExecution
+	GetTxReceipt(context.Context, *TxRequest) (*exec.TxReceipt, error)
+	// Get complete BlockExecutions for a range of block heights delivered in batches so that indexers can backfill
+	// without requesting each block in turn
+	BlockExecutions(*BlockExecutionsRequest, ExecutionEvents_BlockExecutionsServer) error
+	// Delete a named subscription of the calling identity that is not being streamed, returning its last position
+	DeleteSubscription(context.Context, *SubscriptionRequest) (*Subscription, error)
 }
-func sozRpcevents(x uint64) (n int) {
-	return sovRpcevents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedExecutionEventsServer can be embedded to have forward compatible implementations.
+type UnimplementedExecutionEventsServer struct {
+}
+
+func (*UnimplementedExecutionEventsServer) Stream(req *BlocksRequest, srv ExecutionEvents_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedExecutionEventsServer) Tx(ctx context.Context, req *TxRequest) (*exec.TxExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tx not implemented")
+}
+func (*UnimplementedExecutionEventsServer) Events(req *BlocksRequest, srv ExecutionEvents_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (*UnimplementedExecutionEventsServer) Logs(req *LogsRequest, srv ExecutionEvents_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (*UnimplementedExecutionEventsServer) JoinEvents(req *BlocksRequest, srv ExecutionEvents_JoinEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinEvents not implemented")
+}
+func (*UnimplementedExecutionEventsServer) GetTxsByAddress(ctx context.Context, req *TxsByAddressRequest) (*TxsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxsByAddress not implemented")
+}
+func (*UnimplementedExecutionEventsServer) GetTxReceipt(ctx context.Context, req *TxRequest) (*exec.TxReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxReceipt not implemented")
+}
+func (*UnimplementedExecutionEventsServer) BlockExecutions(req *BlockExecutionsRequest, srv ExecutionEvents_BlockExecutionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method BlockExecutions not implemented")
+}
+func (*UnimplementedExecutionEventsServer) DeleteSubscription(ctx context.Context, req *SubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+
+func RegisterExecutionEventsServer(s *grpc.Server, srv ExecutionEventsServer) {
+	s.RegisterService(&_ExecutionEvents_serviceDesc, srv)
+}
+
+func _ExecutionEvents_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).Stream(m, &executionEventsStreamServer{stream})
+}
+
+type ExecutionEvents_StreamServer interface {
+	Send(*exec.StreamEvent) error
+	grpc.ServerStream
+}
+
+type executionEventsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsStreamServer) Send(m *exec.StreamEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_Tx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).Tx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/Tx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).Tx(ctx, req.(*TxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionEvents_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).Events(m, &executionEventsEventsServer{stream})
+}
+
+type ExecutionEvents_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
+}
+
+type executionEventsEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).Logs(m, &executionEventsLogsServer{stream})
+}
+
+type ExecutionEvents_LogsServer interface {
+	Send(*exec.Event) error
+	grpc.ServerStream
+}
+
+type executionEventsLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsLogsServer) Send(m *exec.Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_JoinEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).JoinEvents(m, &executionEventsJoinEventsServer{stream})
+}
+
+type ExecutionEvents_JoinEventsServer interface {
+	Send(*JoinedEvent) error
+	grpc.ServerStream
+}
+
+type executionEventsJoinEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsJoinEventsServer) Send(m *JoinedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_GetTxsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).GetTxsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/GetTxsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).GetTxsByAddress(ctx, req.(*TxsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionEvents_GetTxReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).GetTxReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/GetTxReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).GetTxReceipt(ctx, req.(*TxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionEvents_BlockExecutions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockExecutionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).BlockExecutions(m, &executionEventsBlockExecutionsServer{stream})
+}
+
+type ExecutionEvents_BlockExecutionsServer interface {
+	Send(*BlockExecutionsResponse) error
+	grpc.ServerStream
+}
+
+type executionEventsBlockExecutionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsBlockExecutionsServer) Send(m *BlockExecutionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/DeleteSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).DeleteSubscription(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExecutionEvents_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcevents.ExecutionEvents",
+	HandlerType: (*ExecutionEventsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Tx",
+			Handler:    _ExecutionEvents_Tx_Handler,
+		},
+		{
+			MethodName: "GetTxsByAddress",
+			Handler:    _ExecutionEvents_GetTxsByAddress_Handler,
+		},
+		{
+			MethodName: "GetTxReceipt",
+			Handler:    _ExecutionEvents_GetTxReceipt_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _ExecutionEvents_DeleteSubscription_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _ExecutionEvents_Stream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Events",
+			Handler:       _ExecutionEvents_Events_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _ExecutionEvents_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JoinEvents",
+			Handler:       _ExecutionEvents_JoinEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BlockExecutions",
+			Handler:       _ExecutionEvents_BlockExecutions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcevents.proto",
+}
+
+func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Wait {
+		i--
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Wait {
+		i--
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockRange != nil {
+		{
+			size, err := m.BlockRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BatchSize != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRange != nil {
+		{
+			size, err := m.BlockRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BlockExecutions) > 0 {
+		for iNdEx := len(m.BlockExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Topic.Size()
+		i -= size
+		if _, err := m.Topic.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockRange != nil {
+		{
+			size, err := m.BlockRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Signature.Size()
+		i -= size
+		if _, err := m.Signature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Descending {
+		i--
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.After.Size()
+		i -= size
+		if _, err := m.After.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Next.Size()
+		i -= size
+		if _, err := m.Next.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxExecutions) > 0 {
+		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Proposer.Size()
+		i -= size
+		if _, err := m.Proposer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintRpcevents(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.GasUsed != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Inputs[iNdEx].Size()
+				i -= size
+				if _, err := m.Inputs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TxHeader != nil {
+		{
+			size, err := m.TxHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TxExecutions) > 0 {
+		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Index != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcevents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcevents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcevents(uint64(m.Height))
+	}
+	if m.Wait {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.Wait {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRange != nil {
+		l = m.BlockRange.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.Decode {
+		n += 2
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRange != nil {
+		l = m.BlockRange.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovRpcevents(uint64(m.BatchSize))
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockExecutions) > 0 {
+		for _, e := range m.BlockExecutions {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = m.Signature.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.BlockRange != nil {
+		l = m.BlockRange.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.Decode {
+		n += 2
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = m.Topic.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovRpcevents(uint64(m.Limit))
+	}
+	if m.Descending {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxExecutions) > 0 {
+		for _, e := range m.TxExecutions {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
+	}
+	l = m.Next.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcevents(uint64(m.Height))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JoinedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.TxHeader != nil {
+		l = m.TxHeader.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRpcevents(uint64(m.GasUsed))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = m.Proposer.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovRpcevents(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRpcevents(uint64(m.EndHeight))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcevents(uint64(m.Height))
+	}
+	if len(m.TxExecutions) > 0 {
+		for _, e := range m.TxExecutions {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRpcevents(uint64(m.Type))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRpcevents(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcevents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcevents(x uint64) (n int) {
+	return sovRpcevents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1411,30 +3121,1497 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRpcevents
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockExecutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockExecutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockExecutions = append(m.BlockExecutions, &exec.BlockExecution{})
+			if err := m.BlockExecutions[len(m.BlockExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxExecutions = append(m.TxExecutions, &exec.TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &exec.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &exec.Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TxHeader == nil {
+				m.TxHeader = &exec.TxHeader{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Height = 0
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1444,16 +4621,32 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Inputs = append(m.Inputs, v)
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var v int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1463,69 +4656,47 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcevents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpcevents
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcevents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1552,15 +4723,15 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1570,12 +4741,25 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -1601,7 +4785,7 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *Subscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1624,17 +4808,17 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1644,29 +4828,57 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcevents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRange == nil {
-				m.BlockRange = &BlockRange{}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
@@ -1698,6 +4910,39 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -1723,7 +4968,7 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventsResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1746,17 +4991,17 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1766,16 +5011,29 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1785,25 +5043,23 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcevents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &exec.Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,201 @@
+package rpcevents
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The most named subscriptions that each identity may have persisted at once
+const MaxSubscriptionsPerIdentity = 100
+
+// Subscriptions are keyed by a hash of the identity that owns them (so that any identity fits a fixed width prefix
+// under which its subscriptions can be counted) then by method and name
+var subscriptionKey = storage.NewMustKeyFormat("s", sha256.Size, 0)
+
+// Subscriptions persists the position of named subscriptions as their messages are sent so that a consumer can resume
+// a stream from where it left off after reconnecting, even to a node that has since restarted. Each subscription
+// belongs to the authenticated identity that created it. When RPC auth is disabled every caller is anonymous so all
+// share one namespace of subscriptions. A nil Subscriptions does not persist anything.
+type Subscriptions struct {
+	sync.Mutex
+	db dbm.DB
+	// Whether anonymous callers may use the anonymous namespace, which is only safe when no caller can authenticate
+	// (otherwise any unauthenticated client could resume or delete the subscriptions of another)
+	anonymous bool
+	// The subscriptions currently being streamed, each of which may only be streamed by one request at a time
+	active map[string]bool
+}
+
+// Returns Subscriptions persisted in db, where anonymous should be true if and only if RPC auth is disabled
+func NewSubscriptions(db dbm.DB, anonymous bool) *Subscriptions {
+	return &Subscriptions{
+		db:        db,
+		anonymous: anonymous,
+		active:    make(map[string]bool),
+	}
+}
+
+// A subscription being streamed, methods on a nil subscription (for requests not naming one) do nothing
+type subscription struct {
+	*Subscription
+	key  []byte
+	subs *Subscriptions
+}
+
+// Opens the subscription named by a request to method for streaming, loading its position if it exists and otherwise
+// creating it for query. Returns nil if there is no subscription to open. The subscription must be closed once the
+// stream ends.
+func (subs *Subscriptions) open(ctx context.Context, method, name, query string) (*subscription, error) {
+	if name == "" {
+		return nil, nil
+	}
+	if subs == nil {
+		return nil, fmt.Errorf("named subscriptions are not available on this node")
+	}
+	identity, key, err := subs.subscriptionOf(ctx, method, name)
+	if err != nil {
+		return nil, err
+	}
+	subs.Lock()
+	defer subs.Unlock()
+	if subs.active[string(key)] {
+		return nil, fmt.Errorf("subscription '%s' to %s is already being streamed", name, method)
+	}
+	sub := &subscription{
+		Subscription: &Subscription{
+			Method: method,
+			Name:   name,
+			Query:  query,
+		},
+		key:  key,
+		subs: subs,
+	}
+	bs, err := subs.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bs != nil {
+		stored := new(Subscription)
+		err = encoding.Decode(bs, stored)
+		if err != nil {
+			return nil, fmt.Errorf("could not read subscription '%s' to %s: %v", name, method, err)
+		}
+		if stored.Query != query {
+			return nil, fmt.Errorf("subscription '%s' to %s was created with query '%s' so cannot be resumed "+
+				"with query '%s', use another name for a different query", name, method, stored.Query, query)
+		}
+		sub.Subscription = stored
+	} else {
+		count, err := subs.count(identity)
+		if err != nil {
+			return nil, err
+		}
+		if count >= MaxSubscriptionsPerIdentity {
+			return nil, status.Errorf(codes.ResourceExhausted, "identity '%s' already has the maximum of %d "+
+				"subscriptions, delete one with DeleteSubscription before creating another", identity,
+				MaxSubscriptionsPerIdentity)
+		}
+	}
+	subs.active[string(key)] = true
+	return sub, nil
+}
+
+// Deletes the subscription of the identity calling with ctx named by a request to method, returning it
+func (subs *Subscriptions) delete(ctx context.Context, method, name string) (*Subscription, error) {
+	if subs == nil {
+		return nil, fmt.Errorf("named subscriptions are not available on this node")
+	}
+	_, key, err := subs.subscriptionOf(ctx, method, name)
+	if err != nil {
+		return nil, err
+	}
+	subs.Lock()
+	defer subs.Unlock()
+	if subs.active[string(key)] {
+		return nil, status.Errorf(codes.FailedPrecondition, "subscription '%s' to %s is being streamed so cannot "+
+			"be deleted", name, method)
+	}
+	bs, err := subs.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bs == nil {
+		return nil, status.Errorf(codes.NotFound, "no subscription '%s' to %s", name, method)
+	}
+	deleted := new(Subscription)
+	err = encoding.Decode(bs, deleted)
+	if err != nil {
+		return nil, fmt.Errorf("could not read subscription '%s' to %s: %v", name, method, err)
+	}
+	return deleted, subs.db.Delete(key)
+}
+
+// Counts the subscriptions persisted for identity
+func (subs *Subscriptions) count(identity string) (int, error) {
+	prefix := storage.Prefix(subscriptionKey.Key(identityHash(identity)))
+	it, err := subs.db.Iterator(prefix, prefix.Above())
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	count := 0
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count, it.Error()
+}
+
+// Returns the identity calling with ctx and the key of its subscription named by a request to method
+func (subs *Subscriptions) subscriptionOf(ctx context.Context, method, name string) (string, []byte, error) {
+	identity := auth.Identity(ctx)
+	if identity == acl.AnonymousIdentity && !subs.anonymous {
+		return "", nil, status.Errorf(codes.Unauthenticated, "named subscriptions belong to the identity that "+
+			"created them so require an authorization token when RPC auth is enabled")
+	}
+	return identity, subscriptionKey.Key(identityHash(identity), []byte(method+"/"+name)), nil
+}
+
+func identityHash(identity string) []byte {
+	hash := sha256.Sum256([]byte(identity))
+	return hash[:]
+}
+
+// Returns the cursor from which to resume the stream: cursor if the request has one, otherwise the stored position
+func (sub *subscription) resume(cursor []byte) []byte {
+	if sub == nil || len(cursor) > 0 {
+		return cursor
+	}
+	return sub.Cursor
+}
+
+// Persists the position of the stream after a message has been sent
+func (sub *subscription) save(cursor []byte) error {
+	if sub == nil {
+		return nil
+	}
+	sub.Cursor = cursor
+	bs, err := encoding.Encode(sub.Subscription)
+	if err != nil {
+		return err
+	}
+	return sub.subs.db.Set(sub.key, bs)
+}
+
+func (sub *subscription) close() {
+	if sub == nil {
+		return
+	}
+	sub.subs.Lock()
+	defer sub.subs.Unlock()
+	delete(sub.subs.active, string(sub.key))
+}
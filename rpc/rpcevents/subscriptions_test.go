@@ -0,0 +1,168 @@
+package rpcevents
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubscriptions(t *testing.T) {
+	db := dbm.NewMemDB()
+	subs := NewSubscriptions(db, false)
+	orgA := identityContext(t, "orgA")
+
+	sub, err := subs.open(orgA, "Events", "foo", "EventType = 'LogEvent'")
+	require.NoError(t, err)
+	cursor := Cursor{Height: 3, Index: 2}.Token()
+	// A new subscription starts from the request
+	assert.Nil(t, sub.resume(nil))
+	require.NoError(t, sub.save(cursor))
+
+	// Each subscription can only be streamed once at a time
+	_, err = subs.open(orgA, "Events", "foo", "EventType = 'LogEvent'")
+	require.Error(t, err)
+	// But names are per method
+	other, err := subs.open(orgA, "JoinEvents", "foo", "")
+	require.NoError(t, err)
+	assert.Nil(t, other.resume(nil))
+	other.close()
+	// And per identity
+	other, err = subs.open(identityContext(t, "orgB"), "Events", "foo", "EventType = 'CallEvent'")
+	require.NoError(t, err)
+	assert.Nil(t, other.resume(nil))
+	other.close()
+	sub.close()
+
+	// Positions survive a restart
+	subs = NewSubscriptions(db, false)
+	_, err = subs.open(orgA, "Events", "foo", "EventType = 'CallEvent'")
+	require.Error(t, err, "subscription should only be resumed with the query it was created with")
+	sub, err = subs.open(orgA, "Events", "foo", "EventType = 'LogEvent'")
+	require.NoError(t, err)
+	assert.Equal(t, cursor, sub.resume(nil))
+	// An explicit cursor takes precedence
+	explicit := Cursor{Height: 1}.Token()
+	assert.Equal(t, explicit, sub.resume(explicit))
+	sub.close()
+
+	// Requests without a name need no subscriptions
+	var none *Subscriptions
+	sub, err = none.open(context.Background(), "Events", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, cursor, sub.resume(cursor))
+	require.NoError(t, sub.save(cursor))
+	sub.close()
+	_, err = none.open(orgA, "Events", "foo", "")
+	require.Error(t, err)
+
+	// Anonymous callers cannot own subscriptions when they could be authenticated
+	_, err = subs.open(context.Background(), "Events", "bar", "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestSubscriptions_Anonymous(t *testing.T) {
+	db := dbm.NewMemDB()
+	// Without RPC auth every caller is anonymous and shares one namespace
+	subs := NewSubscriptions(db, true)
+	cursor := Cursor{Height: 2}.Token()
+	sub, err := subs.open(context.Background(), "Events", "foo", "")
+	require.NoError(t, err)
+	require.NoError(t, sub.save(cursor))
+	sub.close()
+
+	sub, err = subs.open(context.Background(), "Events", "foo", "")
+	require.NoError(t, err)
+	assert.Equal(t, cursor, sub.resume(nil))
+	sub.close()
+
+	// Which is closed to anonymous callers once auth is enabled
+	subs = NewSubscriptions(db, false)
+	_, err = subs.open(context.Background(), "Events", "foo", "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = subs.delete(context.Background(), "Events", "foo")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestSubscriptions_Delete(t *testing.T) {
+	subs := NewSubscriptions(dbm.NewMemDB(), false)
+	orgA := identityContext(t, "orgA")
+	orgB := identityContext(t, "orgB")
+
+	sub, err := subs.open(orgA, "Events", "foo", "")
+	require.NoError(t, err)
+	cursor := Cursor{Height: 3}.Token()
+	require.NoError(t, sub.save(cursor))
+	_, err = subs.delete(orgA, "Events", "foo")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "should not delete a subscription being streamed")
+	sub.close()
+
+	// Only the owner can see the subscription to delete it
+	_, err = subs.delete(orgB, "Events", "foo")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = subs.delete(context.Background(), "Events", "foo")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	deleted, err := subs.delete(orgA, "Events", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, cursor, []byte(deleted.Cursor))
+	_, err = subs.delete(orgA, "Events", "foo")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Opening the name again starts afresh
+	sub, err = subs.open(orgA, "Events", "foo", "")
+	require.NoError(t, err)
+	assert.Nil(t, sub.resume(nil))
+	sub.close()
+}
+
+func TestSubscriptions_Limit(t *testing.T) {
+	subs := NewSubscriptions(dbm.NewMemDB(), false)
+	orgA := identityContext(t, "orgA")
+
+	for i := 0; i < MaxSubscriptionsPerIdentity; i++ {
+		sub, err := subs.open(orgA, "Events", fmt.Sprintf("sub-%d", i), "")
+		require.NoError(t, err)
+		require.NoError(t, sub.save(Cursor{Height: 1}.Token()))
+		sub.close()
+	}
+	_, err := subs.open(orgA, "Events", "one-too-many", "")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Existing subscriptions can still be resumed
+	sub, err := subs.open(orgA, "Events", "sub-0", "")
+	require.NoError(t, err)
+	sub.close()
+	// The limit is per identity
+	sub, err = subs.open(identityContext(t, "orgB"), "Events", "sub-0", "")
+	require.NoError(t, err)
+	sub.close()
+
+	_, err = subs.delete(orgA, "Events", "sub-0")
+	require.NoError(t, err)
+	sub, err = subs.open(orgA, "Events", "one-too-many", "")
+	require.NoError(t, err)
+	sub.close()
+}
+
+// Returns a context authorized as identity
+func identityContext(t *testing.T, identity string) context.Context {
+	a, err := auth.New(&auth.Config{
+		Enabled: true,
+		APIKeys: []*auth.APIKey{{Identity: identity, Key: identity + "-key"}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	ctx, err := a.AuthorizeContext(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(acl.AuthorizationKey, "Bearer "+identity+"-key")), "/rpcevents.ExecutionEvents/Events")
+	require.NoError(t, err)
+	return ctx
+}
@@ -0,0 +1,240 @@
+package rpcexplorer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// The most blocks, accounts, or deployments returned by a single list call
+const MaxListSize = 100
+
+// The number of recent blocks over which GetChainStats measures activity when no window is given
+const DefaultStatsWindow = 100
+
+// The most recent blocks over which GetChainStats will measure activity
+const MaxStatsWindow = 10000
+
+type ExplorerState interface {
+	acmstate.IterableStatsReader
+	validator.History
+	IterateDeployments(consumer func(address crypto.Address, deployment *acm.Deployment) error) error
+}
+
+type explorerServer struct {
+	state      ExplorerState
+	blockchain bcm.BlockchainInfo
+	// The MaxListSize accounts with the largest balances as of topHeight, so that we scan all accounts at most once a block
+	topLock     sync.Mutex
+	topAccounts []*acm.Account
+	topHeight   uint64
+	logger      *logging.Logger
+}
+
+var _ ExplorerServer = &explorerServer{}
+
+func NewExplorerServer(state ExplorerState, blockchain bcm.BlockchainInfo, logger *logging.Logger) *explorerServer {
+	return &explorerServer{
+		state:      state,
+		blockchain: blockchain,
+		logger:     logger.WithScope("NewExplorerServer"),
+	}
+}
+
+func (es *explorerServer) ListBlocks(param *ListBlocksParam, stream Explorer_ListBlocksServer) error {
+	return es.iterateBlocks(param.Height, listLimit(param.Limit), stream.Send)
+}
+
+// Passes summaries of up to limit blocks to consumer, most recent first and starting from height (or the latest block
+// if zero)
+func (es *explorerServer) iterateBlocks(height, limit uint64, consumer func(*BlockSummary) error) error {
+	last := es.blockchain.LastBlockHeight()
+	if height == 0 || height > last {
+		height = last
+	}
+	for ; height > 0 && limit > 0; height-- {
+		block, err := es.blockSummary(height)
+		if err != nil {
+			// The block store may have been pruned below here so we stop at the first block we do not have
+			es.logger.InfoMsg("Could not load block", "height", height, "error", err)
+			return nil
+		}
+		err = consumer(block)
+		if err != nil {
+			return err
+		}
+		limit--
+	}
+	return nil
+}
+
+func (es *explorerServer) blockSummary(height uint64) (*BlockSummary, error) {
+	header, err := es.blockchain.GetBlockHeader(height)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := crypto.AddressFromBytes(header.ProposerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not read proposer of block %d: %w", height, err)
+	}
+	numTxs, err := es.blockchain.GetNumTxs(height)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockSummary{
+		Height:   height,
+		Time:     header.Time,
+		Hash:     header.Hash().Bytes(),
+		Proposer: proposer,
+		NumTxs:   uint64(numTxs),
+	}, nil
+}
+
+func (es *explorerServer) ListTopAccounts(param *ListTopAccountsParam, stream Explorer_ListTopAccountsServer) error {
+	top, err := es.loadTopAccounts()
+	if err != nil {
+		return err
+	}
+	if limit := listLimit(param.Limit); uint64(len(top)) > limit {
+		top = top[:limit]
+	}
+	for _, acc := range top {
+		err = stream.Send(acc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the MaxListSize accounts with the largest balances, only scanning the accounts again once a new block has been
+// committed
+func (es *explorerServer) loadTopAccounts() ([]*acm.Account, error) {
+	es.topLock.Lock()
+	defer es.topLock.Unlock()
+	height := es.blockchain.LastBlockHeight()
+	if es.topAccounts != nil && es.topHeight == height {
+		return es.topAccounts, nil
+	}
+	// Keep only the top accounts seen so far, ordered by descending balance then ascending address
+	top := make([]*acm.Account, 0, MaxListSize)
+	err := es.state.IterateAccounts(func(acc *acm.Account) error {
+		i := sort.Search(len(top), func(i int) bool {
+			return top[i].Balance < acc.Balance ||
+				top[i].Balance == acc.Balance && bytes.Compare(top[i].Address[:], acc.Address[:]) > 0
+		})
+		if i == MaxListSize {
+			return nil
+		}
+		if len(top) < MaxListSize {
+			top = append(top, nil)
+		}
+		copy(top[i+1:], top[i:])
+		top[i] = acc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	es.topAccounts = top
+	es.topHeight = height
+	return top, nil
+}
+
+func (es *explorerServer) ListRecentDeployments(param *ListRecentDeploymentsParam,
+	stream Explorer_ListRecentDeploymentsServer) error {
+	limit := listLimit(param.Limit)
+	// Keep only the most recent deployments seen so far, ordered by descending height then ascending address
+	var recent []*ContractDeployment
+	err := es.state.IterateDeployments(func(address crypto.Address, deployment *acm.Deployment) error {
+		// Deployments are iterated in order of address so a later one at the same height sorts after
+		i := sort.Search(len(recent), func(i int) bool {
+			return recent[i].Deployment.Height < deployment.Height
+		})
+		if uint64(i) == limit {
+			return nil
+		}
+		if uint64(len(recent)) < limit {
+			recent = append(recent, nil)
+		}
+		copy(recent[i+1:], recent[i:])
+		recent[i] = &ContractDeployment{
+			Address:    address,
+			Deployment: deployment,
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, cd := range recent {
+		err = stream.Send(cd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (es *explorerServer) GetChainStats(ctx context.Context, param *GetChainStatsParam) (*ChainStats, error) {
+	accountStats := es.state.GetAccountStats()
+	stats := &ChainStats{
+		ChainID:         es.blockchain.ChainID(),
+		LatestHeight:    es.blockchain.LastBlockHeight(),
+		LatestBlockTime: es.blockchain.LastBlockTime(),
+		GenesisTime:     es.blockchain.GenesisDoc().GenesisTime,
+		Accounts:        accountStats.AccountsWithCode + accountStats.AccountsWithoutCode,
+		Contracts:       accountStats.AccountsWithCode,
+		Validators:      uint64(validator.Copy(es.state.Validators(0)).Size()),
+	}
+	window := param.Window
+	switch {
+	case window == 0:
+		window = DefaultStatsWindow
+	case window > MaxStatsWindow:
+		window = MaxStatsWindow
+	}
+	// We measure from the block before the window and the genesis time is no guide to when the first block was made
+	if window >= stats.LatestHeight {
+		if stats.LatestHeight < 2 {
+			return stats, nil
+		}
+		window = stats.LatestHeight - 1
+	}
+	start := stats.LatestHeight - window
+	header, err := es.blockchain.GetBlockHeader(start)
+	if err != nil {
+		return nil, fmt.Errorf("could not load block %d at start of stats window: %w", start, err)
+	}
+	for height := start + 1; height <= stats.LatestHeight; height++ {
+		numTxs, err := es.blockchain.GetNumTxs(height)
+		if err != nil {
+			return nil, err
+		}
+		stats.WindowTxs += uint64(numTxs)
+	}
+	stats.Window = window
+	elapsed := stats.LatestBlockTime.Sub(header.Time)
+	stats.AverageBlockTime = elapsed / time.Duration(window)
+	if elapsed > 0 {
+		stats.TxsPerSecond = float64(stats.WindowTxs) / elapsed.Seconds()
+	}
+	return stats, nil
+}
+
+func listLimit(limit uint64) uint64 {
+	if limit == 0 || limit > MaxListSize {
+		return MaxListSize
+	}
+	return limit
+}
@@ -0,0 +1,63 @@
+package rpcexplorer
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+type topAccountsStream struct {
+	grpc.ServerStream
+	accounts []*acm.Account
+}
+
+func (stream *topAccountsStream) Send(acc *acm.Account) error {
+	stream.accounts = append(stream.accounts, acc)
+	return nil
+}
+
+func TestListTopAccounts(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	bc := &blockchain{headers: map[uint64]*types.Header{1: {Height: 1}}}
+	es := NewExplorerServer(st, bc, logging.NewNoopLogger())
+	updateBalances := func(balances map[crypto.Address]uint64) {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			for address, balance := range balances {
+				err := ws.UpdateAccount(&acm.Account{Address: address, Balance: balance})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	listTop := func(limit uint64) []crypto.Address {
+		stream := new(topAccountsStream)
+		err := es.ListTopAccounts(&ListTopAccountsParam{Limit: limit}, stream)
+		require.NoError(t, err)
+		var addresses []crypto.Address
+		for _, acc := range stream.accounts {
+			addresses = append(addresses, acc.Address)
+		}
+		return addresses
+	}
+
+	updateBalances(map[crypto.Address]uint64{alice: 100, bob: 200, token: 100})
+	assert.Equal(t, []crypto.Address{bob, alice, token}, listTop(0))
+	assert.Equal(t, []crypto.Address{bob, alice}, listTop(2))
+
+	// The ranking is only recomputed once there is a new block
+	updateBalances(map[crypto.Address]uint64{token: 300})
+	assert.Equal(t, []crypto.Address{bob, alice, token}, listTop(0))
+	bc.headers[2] = &types.Header{Height: 2}
+	assert.Equal(t, []crypto.Address{token, bob, alice}, listTop(0))
+}
@@ -0,0 +1,406 @@
+package rpcexplorer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	bcerrors "github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// The number of results returned by a list endpoint when no limit is given
+const DefaultRESTPageSize = 20
+
+// The topic of the Transfer(address,address,uint256) event of ERC20 and ERC721 tokens
+var TransferEventSignature = binary.RightPadWord256(crypto.Keccak256([]byte("Transfer(address,address,uint256)")))
+
+// Stops iteration once a page is full
+var errLimitReached = errors.New("limit reached")
+
+type RESTState interface {
+	ExplorerState
+	rpcevents.Provider
+}
+
+// A block along with its decoded transactions
+type Block struct {
+	*BlockSummary
+	Txs []*Tx
+}
+
+// A transaction decoded from its envelope along with the events it emitted
+type Tx struct {
+	Hash      binary.HexBytes
+	Height    uint64
+	Index     uint64
+	Type      payload.Type
+	Payload   payload.Payload
+	Exception *bcerrors.Exception `json:",omitempty"`
+	GasUsed   uint64
+	Events    []*exec.Event
+}
+
+// An account along with a page of its recent activity, most recent first
+type AccountPage struct {
+	Account  *acm.Account
+	Activity []*rpcquery.Activity
+	// Pass as the after parameter to fetch the following page
+	Next binary.HexBytes `json:",omitempty"`
+}
+
+// A transfer of a token recorded by a Transfer event
+type TokenTransfer struct {
+	Height uint64
+	TxHash binary.HexBytes
+	Token  crypto.Address
+	From   crypto.Address
+	To     crypto.Address
+	// The amount transferred (of an ERC20 token) as a decimal string
+	Value string `json:",omitempty"`
+	// The token transferred (of an ERC721 token) as a decimal string
+	TokenID string `json:",omitempty"`
+}
+
+type TokenTransfers struct {
+	Transfers []*TokenTransfer
+	// Pass as the from parameter to fetch the following page
+	Next uint64 `json:",omitempty"`
+}
+
+type restError struct {
+	Error string
+}
+
+type restHandler struct {
+	*explorerServer
+	state RESTState
+	mux   *http.ServeMux
+}
+
+// NewRESTHandler serves read-only GET endpoints returning JSON for explorers: summaries of blocks (/blocks), a block
+// with its decoded transactions (/blocks/{height}), a transaction (/txs/{hash}), an account with its recent activity
+// (/accounts/{address}), the transfers of a token (/tokens/{address}/transfers), and chain statistics (/stats)
+func NewRESTHandler(state RESTState, blockchain bcm.BlockchainInfo, logger *logging.Logger) http.Handler {
+	rh := &restHandler{
+		explorerServer: NewExplorerServer(state, blockchain, logger),
+		state:          state,
+		mux:            http.NewServeMux(),
+	}
+	rh.mux.HandleFunc("/blocks", rh.handle(rh.blocks))
+	rh.mux.HandleFunc("/blocks/", rh.handle(rh.block))
+	rh.mux.HandleFunc("/txs/", rh.handle(rh.tx))
+	rh.mux.HandleFunc("/accounts/", rh.handle(rh.account))
+	rh.mux.HandleFunc("/tokens/", rh.handle(rh.tokenTransfers))
+	rh.mux.HandleFunc("/stats", rh.handle(rh.stats))
+	return rh
+}
+
+func (rh *restHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		writeJSON(w, http.StatusMethodNotAllowed, &restError{Error: "the explorer API is read-only"})
+		return
+	}
+	rh.mux.ServeHTTP(w, r)
+}
+
+// Errors with an HTTP status
+type statusError struct {
+	status int
+	error
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return statusError{status: http.StatusBadRequest, error: fmt.Errorf(format, args...)}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return statusError{status: http.StatusNotFound, error: fmt.Errorf(format, args...)}
+}
+
+func (rh *restHandler) handle(endpoint func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result, err := endpoint(r)
+		if err != nil {
+			status := http.StatusInternalServerError
+			var se statusError
+			if errors.As(err, &se) {
+				status = se.status
+			} else {
+				rh.logger.InfoMsg("Explorer request failed", "path", r.URL.Path, "error", err)
+			}
+			writeJSON(w, status, &restError{Error: err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func (rh *restHandler) blocks(r *http.Request) (interface{}, error) {
+	height, err := uintParam(r, "height")
+	if err != nil {
+		return nil, err
+	}
+	limit, err := limitParam(r, MaxListSize)
+	if err != nil {
+		return nil, err
+	}
+	blocks := []*BlockSummary{}
+	err = rh.iterateBlocks(height, limit, func(block *BlockSummary) error {
+		blocks = append(blocks, block)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+func (rh *restHandler) block(r *http.Request) (interface{}, error) {
+	height, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/blocks/"), 10, 64)
+	if err != nil || height == 0 {
+		return nil, badRequest("could not parse block height from %s", r.URL.Path)
+	}
+	if height > rh.blockchain.LastBlockHeight() {
+		return nil, notFound("block %d has not been committed", height)
+	}
+	summary, err := rh.blockSummary(height)
+	if err != nil {
+		return nil, notFound("block %d is not held: %v", height, err)
+	}
+	block := &Block{
+		BlockSummary: summary,
+		Txs:          []*Tx{},
+	}
+	accumulator := exec.NewBlockAccumulator(exec.NonConsecutiveBlocks)
+	err = rh.state.IterateStreamEvents(&height, &height, storage.AscendingSort, func(ev *exec.StreamEvent) error {
+		be, err := accumulator.Consume(ev)
+		if err != nil || be == nil {
+			return err
+		}
+		for _, txe := range be.TxExecutions {
+			block.Txs = append(block.Txs, newTx(txe))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (rh *restHandler) tx(r *http.Request) (interface{}, error) {
+	hash, err := hex.DecodeString(strings.TrimPrefix(r.URL.Path, "/txs/"))
+	if err != nil || len(hash) != txs.HashLength {
+		return nil, badRequest("could not parse transaction hash from %s", r.URL.Path)
+	}
+	txe, err := rh.state.TxByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if txe == nil {
+		return nil, notFound("transaction %X not found", hash)
+	}
+	return newTx(txe), nil
+}
+
+func (rh *restHandler) account(r *http.Request) (interface{}, error) {
+	address, err := crypto.AddressFromHexString(strings.TrimPrefix(r.URL.Path, "/accounts/"))
+	if err != nil {
+		return nil, badRequest("could not parse address from %s: %v", r.URL.Path, err)
+	}
+	after, err := hex.DecodeString(r.URL.Query().Get("after"))
+	if err != nil {
+		return nil, badRequest("could not parse after: %v", err)
+	}
+	limit, err := limitParam(r, rpcquery.MaxAccountActivity)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := rh.state.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	page := &AccountPage{
+		Account:  acc,
+		Activity: []*rpcquery.Activity{},
+	}
+	blockTimes := make(map[uint64]time.Time)
+	err = rh.state.IterateTxsByAddress(address, after, true, func(txe *exec.TxExecution) error {
+		if uint64(len(page.Activity)) == limit {
+			page.Next = page.Activity[limit-1].TxHash
+			return errLimitReached
+		}
+		activity := rpcquery.NewActivity(address, txe)
+		blockTime, ok := blockTimes[txe.Height]
+		if !ok {
+			blockTime = rh.blockTime(txe.Height)
+			blockTimes[txe.Height] = blockTime
+		}
+		activity.Time = blockTime
+		page.Activity = append(page.Activity, activity)
+		return nil
+	})
+	if err != nil && err != errLimitReached {
+		return nil, err
+	}
+	if acc == nil && len(page.Activity) == 0 {
+		return nil, notFound("account %v not found", address)
+	}
+	return page, nil
+}
+
+func (rh *restHandler) tokenTransfers(r *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(r.URL.Path, "/tokens/")
+	if !strings.HasSuffix(path, "/transfers") {
+		return nil, notFound("no such endpoint %s", r.URL.Path)
+	}
+	token, err := crypto.AddressFromHexString(strings.TrimSuffix(path, "/transfers"))
+	if err != nil {
+		return nil, badRequest("could not parse token address from %s: %v", r.URL.Path, err)
+	}
+	from, err := uintParam(r, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := uintParam(r, "to")
+	if err != nil {
+		return nil, err
+	}
+	if to == 0 {
+		to = rh.blockchain.LastBlockHeight()
+	}
+	limit, err := limitParam(r, MaxListSize)
+	if err != nil {
+		return nil, err
+	}
+	transfers := &TokenTransfers{
+		Transfers: []*TokenTransfer{},
+	}
+	err = rh.state.IterateLogs(token, TransferEventSignature, &from, &to, func(ev *exec.Event) error {
+		height := ev.Header.GetHeight()
+		// We only break between blocks so that the following page can start from a height
+		if uint64(len(transfers.Transfers)) >= limit && height > transfers.Transfers[len(transfers.Transfers)-1].Height {
+			transfers.Next = height
+			return errLimitReached
+		}
+		transfer := newTokenTransfer(ev)
+		if transfer != nil {
+			transfers.Transfers = append(transfers.Transfers, transfer)
+		}
+		return nil
+	})
+	if err != nil && err != errLimitReached {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (rh *restHandler) stats(r *http.Request) (interface{}, error) {
+	window, err := uintParam(r, "window")
+	if err != nil {
+		return nil, err
+	}
+	return rh.GetChainStats(r.Context(), &GetChainStatsParam{Window: window})
+}
+
+// The time of the block at height, or the zero time if the block is not held
+func (rh *restHandler) blockTime(height uint64) time.Time {
+	header, err := rh.blockchain.GetBlockHeader(height)
+	if err != nil {
+		return time.Time{}
+	}
+	return header.Time
+}
+
+func newTx(txe *exec.TxExecution) *Tx {
+	tx := &Tx{
+		Hash:      txe.TxHash,
+		Height:    txe.Height,
+		Index:     txe.Index,
+		Type:      txe.TxType,
+		Exception: txe.Exception,
+		Events:    txe.Events,
+	}
+	if txe.Envelope != nil && txe.Envelope.Tx != nil {
+		tx.Payload = txe.Envelope.Tx.Payload
+	}
+	if txe.Result != nil {
+		tx.GasUsed = txe.Result.GasUsed
+	}
+	return tx
+}
+
+// Returns the transfer recorded by a Transfer event, or nil if ev does not have the shape of an ERC20 or ERC721 transfer
+func newTokenTransfer(ev *exec.Event) *TokenTransfer {
+	log := ev.Log
+	if log == nil || len(log.Topics) < 3 {
+		return nil
+	}
+	transfer := &TokenTransfer{
+		Height: ev.Header.GetHeight(),
+		TxHash: ev.Header.TxHash,
+		Token:  log.Address,
+		From:   crypto.AddressFromWord256(log.Topics[1]),
+		To:     crypto.AddressFromWord256(log.Topics[2]),
+	}
+	switch {
+	case len(log.Topics) == 4:
+		// ERC721 indexes the token
+		transfer.TokenID = new(big.Int).SetBytes(log.Topics[3].Bytes()).String()
+	case len(log.Data) == binary.Word256Bytes:
+		transfer.Value = new(big.Int).SetBytes(log.Data).String()
+	default:
+		return nil
+	}
+	return transfer
+}
+
+func uintParam(r *http.Request, name string) (uint64, error) {
+	str := r.URL.Query().Get(name)
+	if str == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return 0, badRequest("could not parse %s: %v", name, err)
+	}
+	return value, nil
+}
+
+func limitParam(r *http.Request, max uint64) (uint64, error) {
+	limit, err := uintParam(r, "limit")
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case limit == 0:
+		return DefaultRESTPageSize, nil
+	case limit > max:
+		return max, nil
+	}
+	return limit, nil
+}
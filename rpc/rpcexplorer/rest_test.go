@@ -0,0 +1,268 @@
+package rpcexplorer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type blockchain struct {
+	bcm.BlockchainInfo
+	headers map[uint64]*types.Header
+	numTxs  map[uint64]int
+}
+
+func (bc *blockchain) LastBlockHeight() uint64 {
+	return uint64(len(bc.headers))
+}
+
+func (bc *blockchain) LastBlockTime() time.Time {
+	return bc.headers[bc.LastBlockHeight()].Time
+}
+
+func (bc *blockchain) ChainID() string {
+	return "TestChain"
+}
+
+func (bc *blockchain) GenesisDoc() genesis.GenesisDoc {
+	return genesis.GenesisDoc{ChainName: "TestChain"}
+}
+
+func (bc *blockchain) GetBlockHeader(height uint64) (*types.Header, error) {
+	header, ok := bc.headers[height]
+	if !ok {
+		return nil, fmt.Errorf("no block at height %d", height)
+	}
+	return header, nil
+}
+
+func (bc *blockchain) GetNumTxs(height uint64) (int, error) {
+	return bc.numTxs[height], nil
+}
+
+var (
+	alice   = crypto.Address{1}
+	bob     = crypto.Address{2}
+	token   = crypto.Address{3}
+	aliceTx = crypto.Keccak256([]byte("alice"))
+	bobTx   = crypto.Keccak256([]byte("bob"))
+)
+
+func TestRESTHandler(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	_, _, err := st.Update(func(ws state.Updatable) error {
+		for _, acc := range []*acm.Account{
+			{Address: alice, Balance: 100, Sequence: 2},
+			{Address: bob, Balance: 200},
+			{Address: token, EVMCode: []byte{0x60, 0x60}},
+		} {
+			err := ws.UpdateAccount(acc)
+			if err != nil {
+				return err
+			}
+		}
+		err := ws.AddBlock(&exec.BlockExecution{
+			Height:       1,
+			TxExecutions: []*exec.TxExecution{mkTransfer(1, aliceTx, alice, bob, 40)},
+		})
+		if err != nil {
+			return err
+		}
+		return ws.AddBlock(&exec.BlockExecution{
+			Height:       3,
+			TxExecutions: []*exec.TxExecution{mkTransfer(3, bobTx, bob, alice, 15)},
+		})
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	bc := &blockchain{
+		headers: make(map[uint64]*types.Header),
+		numTxs:  map[uint64]int{1: 1, 3: 1},
+	}
+	for height := uint64(1); height <= 3; height++ {
+		bc.headers[height] = &types.Header{
+			Height:          int64(height),
+			Time:            start.Add(time.Duration(height) * time.Second),
+			ProposerAddress: alice.Bytes(),
+		}
+	}
+
+	server := httptest.NewServer(NewRESTHandler(st, bc, logging.NewNoopLogger()))
+	defer server.Close()
+
+	t.Run("Blocks", func(t *testing.T) {
+		var blocks []*BlockSummary
+		get(t, server, "/blocks?limit=2", http.StatusOK, &blocks)
+		require.Len(t, blocks, 2)
+		assert.Equal(t, uint64(3), blocks[0].Height)
+		assert.Equal(t, uint64(2), blocks[1].Height)
+		assert.Equal(t, alice, blocks[0].Proposer)
+		assert.Equal(t, uint64(1), blocks[0].NumTxs)
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		// The payloads of transactions cannot be decoded back into the Payload interface so we skip them
+		type block struct {
+			*BlockSummary
+			Txs []struct {
+				Hash binary.HexBytes
+				Type payload.Type
+			}
+		}
+		b := new(block)
+		get(t, server, "/blocks/1", http.StatusOK, b)
+		assert.Equal(t, uint64(1), b.Height)
+		require.Len(t, b.Txs, 1)
+		assert.Equal(t, binary.HexBytes(aliceTx), b.Txs[0].Hash)
+		assert.Equal(t, payload.TypeCall, b.Txs[0].Type)
+
+		b = new(block)
+		get(t, server, "/blocks/2", http.StatusOK, b)
+		assert.Equal(t, uint64(2), b.Height)
+		assert.Empty(t, b.Txs)
+
+		get(t, server, "/blocks/4", http.StatusNotFound, nil)
+		get(t, server, "/blocks/latest", http.StatusBadRequest, nil)
+	})
+
+	t.Run("Tx", func(t *testing.T) {
+		tx := make(map[string]interface{})
+		get(t, server, "/txs/"+binary.HexBytes(bobTx).String(), http.StatusOK, &tx)
+		assert.Equal(t, float64(3), tx["Height"])
+		assert.Equal(t, "CallTx", tx["Type"])
+		assert.Equal(t, bob.String(), tx["Payload"].(map[string]interface{})["Input"].(map[string]interface{})["Address"])
+
+		get(t, server, "/txs/"+binary.HexBytes(crypto.Keccak256([]byte("carol"))).String(), http.StatusNotFound, nil)
+		get(t, server, "/txs/00", http.StatusBadRequest, nil)
+	})
+
+	t.Run("Account", func(t *testing.T) {
+		page := new(AccountPage)
+		get(t, server, "/accounts/"+token.String()+"?limit=1", http.StatusOK, page)
+		assert.Equal(t, acm.Bytecode{0x60, 0x60}, page.Account.EVMCode)
+		require.Len(t, page.Activity, 1)
+		// Most recent first
+		assert.Equal(t, binary.HexBytes(bobTx), page.Activity[0].TxHash)
+		assert.Equal(t, start.Add(3*time.Second), page.Activity[0].Time)
+		assert.Equal(t, binary.HexBytes(bobTx), page.Next)
+
+		next := page.Next
+		page = new(AccountPage)
+		get(t, server, "/accounts/"+token.String()+"?limit=1&after="+next.String(), http.StatusOK, page)
+		require.Len(t, page.Activity, 1)
+		assert.Equal(t, binary.HexBytes(aliceTx), page.Activity[0].TxHash)
+		assert.Empty(t, page.Next)
+
+		get(t, server, "/accounts/"+crypto.Address{9}.String(), http.StatusNotFound, nil)
+		get(t, server, "/accounts/alice", http.StatusBadRequest, nil)
+	})
+
+	t.Run("TokenTransfers", func(t *testing.T) {
+		transfers := new(TokenTransfers)
+		get(t, server, "/tokens/"+token.String()+"/transfers?limit=1", http.StatusOK, transfers)
+		require.Len(t, transfers.Transfers, 1)
+		assert.Equal(t, &TokenTransfer{
+			Height: 1,
+			TxHash: aliceTx,
+			Token:  token,
+			From:   alice,
+			To:     bob,
+			Value:  "40",
+		}, transfers.Transfers[0])
+		assert.Equal(t, uint64(3), transfers.Next)
+
+		transfers = new(TokenTransfers)
+		get(t, server, "/tokens/"+token.String()+"/transfers?from=3", http.StatusOK, transfers)
+		require.Len(t, transfers.Transfers, 1)
+		assert.Equal(t, "15", transfers.Transfers[0].Value)
+		assert.Equal(t, bob, transfers.Transfers[0].From)
+		assert.Zero(t, transfers.Next)
+
+		get(t, server, "/tokens/"+token.String(), http.StatusNotFound, nil)
+	})
+
+	t.Run("Stats", func(t *testing.T) {
+		stats := new(ChainStats)
+		get(t, server, "/stats", http.StatusOK, stats)
+		assert.Equal(t, "TestChain", stats.ChainID)
+		assert.Equal(t, uint64(3), stats.LatestHeight)
+		assert.Equal(t, uint64(2), stats.Window)
+		assert.Equal(t, uint64(1), stats.WindowTxs)
+		assert.Equal(t, time.Second, stats.AverageBlockTime)
+	})
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		resp, err := http.Post(server.URL+"/blocks", "application/json", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func get(t *testing.T, server *httptest.Server, path string, status int, result interface{}) {
+	resp, err := http.Get(server.URL + path)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, status, resp.StatusCode, path)
+	if result != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+	}
+}
+
+// A CallTx to the token contract from sender that emits an ERC20 Transfer of amount to recipient
+func mkTransfer(height uint64, hash []byte, sender, recipient crypto.Address, amount uint64) *exec.TxExecution {
+	header := &exec.Header{
+		TxType: payload.TypeCall,
+		TxHash: hash,
+		Height: height,
+	}
+	input := *header
+	input.EventType = exec.TypeAccountInput
+	log := *header
+	log.EventType = exec.TypeLog
+	log.Index = 1
+	return &exec.TxExecution{
+		TxHeader: &exec.TxHeader{
+			TxType: payload.TypeCall,
+			TxHash: hash,
+			Height: height,
+		},
+		Envelope: txs.Enclose("TestChain", &payload.CallTx{
+			Input:   &payload.TxInput{Address: sender, Amount: 1},
+			Address: &token,
+		}),
+		Events: []*exec.Event{
+			{
+				Header: &input,
+				Input:  &exec.InputEvent{Address: sender},
+			},
+			{
+				Header: &log,
+				Log: &exec.LogEvent{
+					Address: token,
+					Topics:  []binary.Word256{TransferEventSignature, sender.Word256(), recipient.Word256()},
+					Data:    binary.Int64ToWord256(int64(amount)).Bytes(),
+				},
+			},
+		},
+	}
+}
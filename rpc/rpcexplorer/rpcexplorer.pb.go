@@ -0,0 +1,952 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpcexplorer.proto
+
+package rpcexplorer
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	acm "github.com/hyperledger/burrow/acm"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListBlocksParam struct {
+	// The height of the first (most recent) block to return, or the latest block if zero
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The maximum number of blocks to return (at most MaxListSize, which is used if zero)
+	Limit                uint64   `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBlocksParam) Reset()         { *m = ListBlocksParam{} }
+func (m *ListBlocksParam) String() string { return proto.CompactTextString(m) }
+func (*ListBlocksParam) ProtoMessage()    {}
+func (*ListBlocksParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{0}
+}
+func (m *ListBlocksParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlocksParam.Unmarshal(m, b)
+}
+func (m *ListBlocksParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlocksParam.Marshal(b, m, deterministic)
+}
+func (m *ListBlocksParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlocksParam.Merge(m, src)
+}
+func (m *ListBlocksParam) XXX_Size() int {
+	return xxx_messageInfo_ListBlocksParam.Size(m)
+}
+func (m *ListBlocksParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlocksParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlocksParam proto.InternalMessageInfo
+
+func (m *ListBlocksParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ListBlocksParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListBlocksParam) XXX_MessageName() string {
+	return "rpcexplorer.ListBlocksParam"
+}
+
+type BlockSummary struct {
+	Height uint64                                        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Time   time.Time                                     `protobuf:"bytes,2,opt,name=Time,proto3,stdtime" json:"Time"`
+	Hash   github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Hash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Hash"`
+	// The address of the validator that proposed the block
+	Proposer             github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,opt,name=Proposer,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Proposer"`
+	NumTxs               uint64                                       `protobuf:"varint,5,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *BlockSummary) Reset()         { *m = BlockSummary{} }
+func (m *BlockSummary) String() string { return proto.CompactTextString(m) }
+func (*BlockSummary) ProtoMessage()    {}
+func (*BlockSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{1}
+}
+func (m *BlockSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSummary.Unmarshal(m, b)
+}
+func (m *BlockSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSummary.Marshal(b, m, deterministic)
+}
+func (m *BlockSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSummary.Merge(m, src)
+}
+func (m *BlockSummary) XXX_Size() int {
+	return xxx_messageInfo_BlockSummary.Size(m)
+}
+func (m *BlockSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSummary proto.InternalMessageInfo
+
+func (m *BlockSummary) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockSummary) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *BlockSummary) GetNumTxs() uint64 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (*BlockSummary) XXX_MessageName() string {
+	return "rpcexplorer.BlockSummary"
+}
+
+type ListTopAccountsParam struct {
+	// The maximum number of accounts to return (at most MaxListSize, which is used if zero)
+	Limit                uint64   `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTopAccountsParam) Reset()         { *m = ListTopAccountsParam{} }
+func (m *ListTopAccountsParam) String() string { return proto.CompactTextString(m) }
+func (*ListTopAccountsParam) ProtoMessage()    {}
+func (*ListTopAccountsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{2}
+}
+func (m *ListTopAccountsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTopAccountsParam.Unmarshal(m, b)
+}
+func (m *ListTopAccountsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTopAccountsParam.Marshal(b, m, deterministic)
+}
+func (m *ListTopAccountsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTopAccountsParam.Merge(m, src)
+}
+func (m *ListTopAccountsParam) XXX_Size() int {
+	return xxx_messageInfo_ListTopAccountsParam.Size(m)
+}
+func (m *ListTopAccountsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTopAccountsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTopAccountsParam proto.InternalMessageInfo
+
+func (m *ListTopAccountsParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListTopAccountsParam) XXX_MessageName() string {
+	return "rpcexplorer.ListTopAccountsParam"
+}
+
+type ListRecentDeploymentsParam struct {
+	// The maximum number of deployments to return (at most MaxListSize, which is used if zero)
+	Limit                uint64   `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRecentDeploymentsParam) Reset()         { *m = ListRecentDeploymentsParam{} }
+func (m *ListRecentDeploymentsParam) String() string { return proto.CompactTextString(m) }
+func (*ListRecentDeploymentsParam) ProtoMessage()    {}
+func (*ListRecentDeploymentsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{3}
+}
+func (m *ListRecentDeploymentsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRecentDeploymentsParam.Unmarshal(m, b)
+}
+func (m *ListRecentDeploymentsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRecentDeploymentsParam.Marshal(b, m, deterministic)
+}
+func (m *ListRecentDeploymentsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRecentDeploymentsParam.Merge(m, src)
+}
+func (m *ListRecentDeploymentsParam) XXX_Size() int {
+	return xxx_messageInfo_ListRecentDeploymentsParam.Size(m)
+}
+func (m *ListRecentDeploymentsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRecentDeploymentsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRecentDeploymentsParam proto.InternalMessageInfo
+
+func (m *ListRecentDeploymentsParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListRecentDeploymentsParam) XXX_MessageName() string {
+	return "rpcexplorer.ListRecentDeploymentsParam"
+}
+
+type ContractDeployment struct {
+	// The address of the contract
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Deployment           *acm.Deployment                              `protobuf:"bytes,2,opt,name=Deployment,proto3" json:"Deployment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *ContractDeployment) Reset()         { *m = ContractDeployment{} }
+func (m *ContractDeployment) String() string { return proto.CompactTextString(m) }
+func (*ContractDeployment) ProtoMessage()    {}
+func (*ContractDeployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{4}
+}
+func (m *ContractDeployment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractDeployment.Unmarshal(m, b)
+}
+func (m *ContractDeployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractDeployment.Marshal(b, m, deterministic)
+}
+func (m *ContractDeployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractDeployment.Merge(m, src)
+}
+func (m *ContractDeployment) XXX_Size() int {
+	return xxx_messageInfo_ContractDeployment.Size(m)
+}
+func (m *ContractDeployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractDeployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractDeployment proto.InternalMessageInfo
+
+func (m *ContractDeployment) GetDeployment() *acm.Deployment {
+	if m != nil {
+		return m.Deployment
+	}
+	return nil
+}
+
+func (*ContractDeployment) XXX_MessageName() string {
+	return "rpcexplorer.ContractDeployment"
+}
+
+type GetChainStatsParam struct {
+	// The number of recent blocks over which to measure activity (at most MaxStatsWindow, DefaultStatsWindow if zero)
+	Window               uint64   `protobuf:"varint,1,opt,name=Window,proto3" json:"Window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChainStatsParam) Reset()         { *m = GetChainStatsParam{} }
+func (m *GetChainStatsParam) String() string { return proto.CompactTextString(m) }
+func (*GetChainStatsParam) ProtoMessage()    {}
+func (*GetChainStatsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{5}
+}
+func (m *GetChainStatsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChainStatsParam.Unmarshal(m, b)
+}
+func (m *GetChainStatsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChainStatsParam.Marshal(b, m, deterministic)
+}
+func (m *GetChainStatsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChainStatsParam.Merge(m, src)
+}
+func (m *GetChainStatsParam) XXX_Size() int {
+	return xxx_messageInfo_GetChainStatsParam.Size(m)
+}
+func (m *GetChainStatsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChainStatsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChainStatsParam proto.InternalMessageInfo
+
+func (m *GetChainStatsParam) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (*GetChainStatsParam) XXX_MessageName() string {
+	return "rpcexplorer.GetChainStatsParam"
+}
+
+type ChainStats struct {
+	ChainID         string    `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	LatestHeight    uint64    `protobuf:"varint,2,opt,name=LatestHeight,proto3" json:"LatestHeight,omitempty"`
+	LatestBlockTime time.Time `protobuf:"bytes,3,opt,name=LatestBlockTime,proto3,stdtime" json:"LatestBlockTime"`
+	GenesisTime     time.Time `protobuf:"bytes,4,opt,name=GenesisTime,proto3,stdtime" json:"GenesisTime"`
+	Accounts        uint64    `protobuf:"varint,5,opt,name=Accounts,proto3" json:"Accounts,omitempty"`
+	// The number of accounts with code
+	Contracts  uint64 `protobuf:"varint,6,opt,name=Contracts,proto3" json:"Contracts,omitempty"`
+	Validators uint64 `protobuf:"varint,7,opt,name=Validators,proto3" json:"Validators,omitempty"`
+	// The number of recent blocks over which the following were measured
+	Window uint64 `protobuf:"varint,8,opt,name=Window,proto3" json:"Window,omitempty"`
+	// The number of transactions in the recent blocks
+	WindowTxs uint64 `protobuf:"varint,9,opt,name=WindowTxs,proto3" json:"WindowTxs,omitempty"`
+	// The mean interval between the recent blocks
+	AverageBlockTime time.Duration `protobuf:"bytes,10,opt,name=AverageBlockTime,proto3,stdduration" json:"AverageBlockTime"`
+	// The mean rate of transactions over the recent blocks
+	TxsPerSecond         float64  `protobuf:"fixed64,11,opt,name=TxsPerSecond,proto3" json:"TxsPerSecond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainStats) Reset()         { *m = ChainStats{} }
+func (m *ChainStats) String() string { return proto.CompactTextString(m) }
+func (*ChainStats) ProtoMessage()    {}
+func (*ChainStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5518b45c3d598c6, []int{6}
+}
+func (m *ChainStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainStats.Unmarshal(m, b)
+}
+func (m *ChainStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainStats.Marshal(b, m, deterministic)
+}
+func (m *ChainStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStats.Merge(m, src)
+}
+func (m *ChainStats) XXX_Size() int {
+	return xxx_messageInfo_ChainStats.Size(m)
+}
+func (m *ChainStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStats proto.InternalMessageInfo
+
+func (m *ChainStats) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ChainStats) GetLatestHeight() uint64 {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return 0
+}
+
+func (m *ChainStats) GetLatestBlockTime() time.Time {
+	if m != nil {
+		return m.LatestBlockTime
+	}
+	return time.Time{}
+}
+
+func (m *ChainStats) GetGenesisTime() time.Time {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return time.Time{}
+}
+
+func (m *ChainStats) GetAccounts() uint64 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *ChainStats) GetContracts() uint64 {
+	if m != nil {
+		return m.Contracts
+	}
+	return 0
+}
+
+func (m *ChainStats) GetValidators() uint64 {
+	if m != nil {
+		return m.Validators
+	}
+	return 0
+}
+
+func (m *ChainStats) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ChainStats) GetWindowTxs() uint64 {
+	if m != nil {
+		return m.WindowTxs
+	}
+	return 0
+}
+
+func (m *ChainStats) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
+	}
+	return 0
+}
+
+func (m *ChainStats) GetTxsPerSecond() float64 {
+	if m != nil {
+		return m.TxsPerSecond
+	}
+	return 0
+}
+
+func (*ChainStats) XXX_MessageName() string {
+	return "rpcexplorer.ChainStats"
+}
+func init() {
+	proto.RegisterType((*ListBlocksParam)(nil), "rpcexplorer.ListBlocksParam")
+	golang_proto.RegisterType((*ListBlocksParam)(nil), "rpcexplorer.ListBlocksParam")
+	proto.RegisterType((*BlockSummary)(nil), "rpcexplorer.BlockSummary")
+	golang_proto.RegisterType((*BlockSummary)(nil), "rpcexplorer.BlockSummary")
+	proto.RegisterType((*ListTopAccountsParam)(nil), "rpcexplorer.ListTopAccountsParam")
+	golang_proto.RegisterType((*ListTopAccountsParam)(nil), "rpcexplorer.ListTopAccountsParam")
+	proto.RegisterType((*ListRecentDeploymentsParam)(nil), "rpcexplorer.ListRecentDeploymentsParam")
+	golang_proto.RegisterType((*ListRecentDeploymentsParam)(nil), "rpcexplorer.ListRecentDeploymentsParam")
+	proto.RegisterType((*ContractDeployment)(nil), "rpcexplorer.ContractDeployment")
+	golang_proto.RegisterType((*ContractDeployment)(nil), "rpcexplorer.ContractDeployment")
+	proto.RegisterType((*GetChainStatsParam)(nil), "rpcexplorer.GetChainStatsParam")
+	golang_proto.RegisterType((*GetChainStatsParam)(nil), "rpcexplorer.GetChainStatsParam")
+	proto.RegisterType((*ChainStats)(nil), "rpcexplorer.ChainStats")
+	golang_proto.RegisterType((*ChainStats)(nil), "rpcexplorer.ChainStats")
+}
+
+func init() { proto.RegisterFile("rpcexplorer.proto", fileDescriptor_f5518b45c3d598c6) }
+func init() { golang_proto.RegisterFile("rpcexplorer.proto", fileDescriptor_f5518b45c3d598c6) }
+
+var fileDescriptor_f5518b45c3d598c6 = []byte{
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xfe, 0xb7, 0x4d, 0xdb, 0x64, 0x92, 0x5f, 0xf9, 0xff, 0x55, 0x01, 0xd7, 0xaa, 0x92, 0x92,
+	0x0b, 0x3d, 0x14, 0xa7, 0x2a, 0x20, 0x71, 0xab, 0x9a, 0x06, 0xda, 0x4a, 0x55, 0x89, 0xdc, 0x08,
+	0x24, 0x6e, 0x1b, 0x7b, 0x71, 0x2c, 0x62, 0xaf, 0xb5, 0xbb, 0xa6, 0xc9, 0x5b, 0x70, 0xa9, 0xc4,
+	0xe3, 0x70, 0x2c, 0x6f, 0x80, 0x38, 0x14, 0xd4, 0x1e, 0x78, 0x0d, 0xe4, 0xb5, 0x1d, 0xdb, 0x49,
+	0xa1, 0xaa, 0xb8, 0xf9, 0x9b, 0x99, 0xef, 0x93, 0x67, 0xf6, 0x9b, 0x81, 0xff, 0x79, 0x60, 0xd1,
+	0x71, 0x30, 0x62, 0x9c, 0x72, 0x23, 0xe0, 0x4c, 0x32, 0x5c, 0xcd, 0x85, 0xf4, 0xc7, 0x8e, 0x2b,
+	0x87, 0xe1, 0xc0, 0xb0, 0x98, 0xd7, 0x76, 0x98, 0xc3, 0xda, 0xaa, 0x66, 0x10, 0xbe, 0x53, 0x48,
+	0x01, 0xf5, 0x15, 0x73, 0xf5, 0xa6, 0xc3, 0x98, 0x33, 0xa2, 0x59, 0x95, 0x74, 0x3d, 0x2a, 0x24,
+	0xf1, 0x82, 0xa4, 0xa0, 0x31, 0x5b, 0x60, 0x87, 0x9c, 0x48, 0x97, 0xf9, 0x49, 0xbe, 0x42, 0x2c,
+	0x2f, 0xfe, 0x6c, 0xed, 0x42, 0xfd, 0xd8, 0x15, 0xb2, 0x33, 0x62, 0xd6, 0x7b, 0xd1, 0x23, 0x9c,
+	0x78, 0xf8, 0x3e, 0x2c, 0x1f, 0x52, 0xd7, 0x19, 0x4a, 0x0d, 0x6d, 0xa0, 0xcd, 0x92, 0x99, 0x20,
+	0xbc, 0x0a, 0x4b, 0xc7, 0xae, 0xe7, 0x4a, 0x6d, 0x41, 0x85, 0x63, 0xd0, 0x3a, 0x5f, 0x80, 0x9a,
+	0x62, 0x9f, 0x86, 0x9e, 0x47, 0xf8, 0xe4, 0xb7, 0xf4, 0xe7, 0x50, 0xea, 0xbb, 0x1e, 0x55, 0xec,
+	0xea, 0x8e, 0x6e, 0xc4, 0xff, 0x68, 0xa4, 0xff, 0x68, 0xf4, 0xd3, 0x26, 0x3a, 0xe5, 0x8b, 0xcb,
+	0xe6, 0x3f, 0x1f, 0xbf, 0x37, 0x91, 0xa9, 0x18, 0xf8, 0x08, 0x4a, 0x87, 0x44, 0x0c, 0xb5, 0xc5,
+	0x0d, 0xb4, 0x59, 0xeb, 0x3c, 0x8b, 0xb2, 0xdf, 0x2e, 0x9b, 0xf9, 0xa1, 0x0d, 0x27, 0x01, 0xe5,
+	0x23, 0x6a, 0x3b, 0x94, 0xb7, 0x07, 0x21, 0xe7, 0xec, 0xac, 0x3d, 0x70, 0x7d, 0xc2, 0x27, 0xc6,
+	0x21, 0x1d, 0x77, 0x26, 0x92, 0x0a, 0x53, 0x49, 0xe0, 0x1e, 0x94, 0x7b, 0x9c, 0x05, 0x4c, 0x50,
+	0xae, 0x95, 0x94, 0xdc, 0xd3, 0x44, 0x6e, 0xeb, 0xcf, 0x72, 0x16, 0x9f, 0x04, 0x92, 0x19, 0x7b,
+	0xb6, 0xcd, 0xa9, 0x10, 0xe6, 0x54, 0x25, 0x6a, 0xf7, 0x24, 0xf4, 0xfa, 0x63, 0xa1, 0x2d, 0xc5,
+	0xed, 0xc6, 0xa8, 0xb5, 0x05, 0xab, 0xd1, 0x60, 0xfb, 0x2c, 0xd8, 0xb3, 0x2c, 0x16, 0xfa, 0x32,
+	0x99, 0xee, 0x74, 0x8a, 0x28, 0x3f, 0xc5, 0x1d, 0xd0, 0xa3, 0x6a, 0x93, 0x5a, 0xd4, 0x97, 0x5d,
+	0x1a, 0x8c, 0xd8, 0xc4, 0xa3, 0xb7, 0x70, 0xce, 0x11, 0xe0, 0x7d, 0xe6, 0x4b, 0x4e, 0xac, 0x1c,
+	0x05, 0x9f, 0xc0, 0x4a, 0xf2, 0x97, 0x1a, 0xfa, 0x8b, 0x0e, 0x53, 0x11, 0xdc, 0x06, 0xc8, 0xd4,
+	0x93, 0xd7, 0xab, 0x1b, 0x91, 0x83, 0xb2, 0xb0, 0x99, 0x2b, 0x69, 0x6d, 0x01, 0x3e, 0xa0, 0x72,
+	0x7f, 0x48, 0x5c, 0xff, 0x54, 0x12, 0x99, 0xb9, 0xea, 0x8d, 0xeb, 0xdb, 0xec, 0x2c, 0xb5, 0x45,
+	0x8c, 0x5a, 0x3f, 0x17, 0x01, 0xb2, 0x5a, 0xac, 0xc1, 0x8a, 0x42, 0x47, 0x5d, 0x55, 0x57, 0x31,
+	0x53, 0x88, 0x5b, 0x50, 0x3b, 0x26, 0x92, 0x0a, 0x99, 0xb8, 0x2b, 0x76, 0x61, 0x21, 0x86, 0x4f,
+	0xa0, 0x1e, 0x63, 0xe5, 0x48, 0x65, 0xb7, 0xc5, 0x3b, 0xd8, 0x6d, 0x96, 0x8c, 0x5f, 0x42, 0xf5,
+	0x80, 0xfa, 0x54, 0xb8, 0x42, 0x69, 0x95, 0xee, 0xa0, 0x95, 0x27, 0x62, 0x1d, 0xca, 0xa9, 0x0b,
+	0x12, 0x9b, 0x4c, 0x31, 0x5e, 0x87, 0x4a, 0xfa, 0x8a, 0x42, 0x5b, 0x56, 0xc9, 0x2c, 0x80, 0x1b,
+	0x00, 0xaf, 0xc9, 0xc8, 0xb5, 0x89, 0x64, 0x5c, 0x68, 0x2b, 0x2a, 0x9d, 0x8b, 0xe4, 0xc6, 0x5a,
+	0xce, 0x8f, 0x35, 0x52, 0x8d, 0xbf, 0x22, 0x67, 0x56, 0x62, 0xd5, 0x69, 0x00, 0xbf, 0x82, 0xff,
+	0xf6, 0x3e, 0x50, 0x4e, 0x1c, 0x9a, 0x0d, 0x0a, 0x54, 0x73, 0x6b, 0x73, 0xcd, 0x75, 0x93, 0xdb,
+	0x11, 0xf7, 0xf6, 0x29, 0xea, 0x6d, 0x8e, 0x1c, 0x3d, 0x4e, 0x7f, 0x2c, 0x7a, 0x94, 0x9f, 0x52,
+	0x8b, 0xf9, 0xb6, 0x56, 0xdd, 0x40, 0x9b, 0xc8, 0x2c, 0xc4, 0x76, 0xbe, 0x2c, 0x40, 0xf9, 0x45,
+	0x72, 0xf2, 0xf0, 0x01, 0x40, 0x76, 0x77, 0xf0, 0xba, 0x91, 0xbf, 0x90, 0x33, 0x07, 0x49, 0x5f,
+	0x2b, 0x64, 0xf3, 0xc7, 0x66, 0x1b, 0xe1, 0x2e, 0xd4, 0x67, 0xf6, 0x0c, 0x3f, 0x9c, 0x53, 0x9b,
+	0xdd, 0x42, 0xbd, 0xa6, 0x0c, 0x9c, 0xc4, 0xb6, 0x11, 0xb6, 0xe0, 0xde, 0x8d, 0xfb, 0x87, 0x1f,
+	0xcd, 0x69, 0xdd, 0xbc, 0xa3, 0x7a, 0xb3, 0x50, 0x38, 0xbf, 0x97, 0xdb, 0x08, 0x1f, 0xc1, 0xbf,
+	0x85, 0xc5, 0xc0, 0x45, 0xce, 0xfc, 0xd2, 0xe8, 0x0f, 0x8a, 0xa2, 0xd3, 0x6c, 0x67, 0xf7, 0xeb,
+	0x55, 0x03, 0xfd, 0xb8, 0x6a, 0xa0, 0xcf, 0xd7, 0x0d, 0x74, 0x71, 0xdd, 0x40, 0x6f, 0x6f, 0x39,
+	0x87, 0x3c, 0xb0, 0xda, 0x39, 0xad, 0xc1, 0xb2, 0x7a, 0xdf, 0x27, 0xbf, 0x06, 0x00, 0x49, 0x7f,
+	0x9f, 0xeb, 0x9b, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExplorerClient is the client API for Explorer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExplorerClient interface {
+	// ListBlocks returns a summary of each of a run of blocks, most recent first
+	ListBlocks(ctx context.Context, in *ListBlocksParam, opts ...grpc.CallOption) (Explorer_ListBlocksClient, error)
+	// ListTopAccounts returns the accounts with the largest balances, largest first
+	ListTopAccounts(ctx context.Context, in *ListTopAccountsParam, opts ...grpc.CallOption) (Explorer_ListTopAccountsClient, error)
+	// ListRecentDeployments returns the contracts most recently deployed by a CallTx, most recent first
+	ListRecentDeployments(ctx context.Context, in *ListRecentDeploymentsParam, opts ...grpc.CallOption) (Explorer_ListRecentDeploymentsClient, error)
+	// GetChainStats returns statistics of the chain as a whole and over its recent blocks
+	GetChainStats(ctx context.Context, in *GetChainStatsParam, opts ...grpc.CallOption) (*ChainStats, error)
+}
+
+type explorerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExplorerClient(cc *grpc.ClientConn) ExplorerClient {
+	return &explorerClient{cc}
+}
+
+func (c *explorerClient) ListBlocks(ctx context.Context, in *ListBlocksParam, opts ...grpc.CallOption) (Explorer_ListBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Explorer_serviceDesc.Streams[0], "/rpcexplorer.Explorer/ListBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &explorerListBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Explorer_ListBlocksClient interface {
+	Recv() (*BlockSummary, error)
+	grpc.ClientStream
+}
+
+type explorerListBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *explorerListBlocksClient) Recv() (*BlockSummary, error) {
+	m := new(BlockSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *explorerClient) ListTopAccounts(ctx context.Context, in *ListTopAccountsParam, opts ...grpc.CallOption) (Explorer_ListTopAccountsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Explorer_serviceDesc.Streams[1], "/rpcexplorer.Explorer/ListTopAccounts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &explorerListTopAccountsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Explorer_ListTopAccountsClient interface {
+	Recv() (*acm.Account, error)
+	grpc.ClientStream
+}
+
+type explorerListTopAccountsClient struct {
+	grpc.ClientStream
+}
+
+func (x *explorerListTopAccountsClient) Recv() (*acm.Account, error) {
+	m := new(acm.Account)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *explorerClient) ListRecentDeployments(ctx context.Context, in *ListRecentDeploymentsParam, opts ...grpc.CallOption) (Explorer_ListRecentDeploymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Explorer_serviceDesc.Streams[2], "/rpcexplorer.Explorer/ListRecentDeployments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &explorerListRecentDeploymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Explorer_ListRecentDeploymentsClient interface {
+	Recv() (*ContractDeployment, error)
+	grpc.ClientStream
+}
+
+type explorerListRecentDeploymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *explorerListRecentDeploymentsClient) Recv() (*ContractDeployment, error) {
+	m := new(ContractDeployment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *explorerClient) GetChainStats(ctx context.Context, in *GetChainStatsParam, opts ...grpc.CallOption) (*ChainStats, error) {
+	out := new(ChainStats)
+	err := c.cc.Invoke(ctx, "/rpcexplorer.Explorer/GetChainStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExplorerServer is the server API for Explorer service.
+type ExplorerServer interface {
+	// ListBlocks returns a summary of each of a run of blocks, most recent first
+	ListBlocks(*ListBlocksParam, Explorer_ListBlocksServer) error
+	// ListTopAccounts returns the accounts with the largest balances, largest first
+	ListTopAccounts(*ListTopAccountsParam, Explorer_ListTopAccountsServer) error
+	// ListRecentDeployments returns the contracts most recently deployed by a CallTx, most recent first
+	ListRecentDeployments(*ListRecentDeploymentsParam, Explorer_ListRecentDeploymentsServer) error
+	// GetChainStats returns statistics of the chain as a whole and over its recent blocks
+	GetChainStats(context.Context, *GetChainStatsParam) (*ChainStats, error)
+}
+
+// UnimplementedExplorerServer can be embedded to have forward compatible implementations.
+type UnimplementedExplorerServer struct {
+}
+
+func (*UnimplementedExplorerServer) ListBlocks(req *ListBlocksParam, srv Explorer_ListBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (*UnimplementedExplorerServer) ListTopAccounts(req *ListTopAccountsParam, srv Explorer_ListTopAccountsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTopAccounts not implemented")
+}
+func (*UnimplementedExplorerServer) ListRecentDeployments(req *ListRecentDeploymentsParam, srv Explorer_ListRecentDeploymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRecentDeployments not implemented")
+}
+func (*UnimplementedExplorerServer) GetChainStats(ctx context.Context, req *GetChainStatsParam) (*ChainStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStats not implemented")
+}
+
+func RegisterExplorerServer(s *grpc.Server, srv ExplorerServer) {
+	s.RegisterService(&_Explorer_serviceDesc, srv)
+}
+
+func _Explorer_ListBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBlocksParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExplorerServer).ListBlocks(m, &explorerListBlocksServer{stream})
+}
+
+type Explorer_ListBlocksServer interface {
+	Send(*BlockSummary) error
+	grpc.ServerStream
+}
+
+type explorerListBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *explorerListBlocksServer) Send(m *BlockSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Explorer_ListTopAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTopAccountsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExplorerServer).ListTopAccounts(m, &explorerListTopAccountsServer{stream})
+}
+
+type Explorer_ListTopAccountsServer interface {
+	Send(*acm.Account) error
+	grpc.ServerStream
+}
+
+type explorerListTopAccountsServer struct {
+	grpc.ServerStream
+}
+
+func (x *explorerListTopAccountsServer) Send(m *acm.Account) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Explorer_ListRecentDeployments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRecentDeploymentsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExplorerServer).ListRecentDeployments(m, &explorerListRecentDeploymentsServer{stream})
+}
+
+type Explorer_ListRecentDeploymentsServer interface {
+	Send(*ContractDeployment) error
+	grpc.ServerStream
+}
+
+type explorerListRecentDeploymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *explorerListRecentDeploymentsServer) Send(m *ContractDeployment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Explorer_GetChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainStatsParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExplorerServer).GetChainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcexplorer.Explorer/GetChainStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExplorerServer).GetChainStats(ctx, req.(*GetChainStatsParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Explorer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcexplorer.Explorer",
+	HandlerType: (*ExplorerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChainStats",
+			Handler:    _Explorer_GetChainStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListBlocks",
+			Handler:       _Explorer_ListBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTopAccounts",
+			Handler:       _Explorer_ListTopAccounts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRecentDeployments",
+			Handler:       _Explorer_ListRecentDeployments_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcexplorer.proto",
+}
+
+func (m *ListBlocksParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Height))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	l = m.Hash.Size()
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	l = m.Proposer.Size()
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	if m.NumTxs != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.NumTxs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTopAccountsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRecentDeploymentsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContractDeployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	if m.Deployment != nil {
+		l = m.Deployment.Size()
+		n += 1 + l + sovRpcexplorer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetChainStatsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Window))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRpcexplorer(uint64(l))
+	}
+	if m.LatestHeight != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.LatestHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestBlockTime)
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.GenesisTime)
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	if m.Accounts != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Accounts))
+	}
+	if m.Contracts != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Contracts))
+	}
+	if m.Validators != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Validators))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.Window))
+	}
+	if m.WindowTxs != 0 {
+		n += 1 + sovRpcexplorer(uint64(m.WindowTxs))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovRpcexplorer(uint64(l))
+	if m.TxsPerSecond != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcexplorer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcexplorer(x uint64) (n int) {
+	return sovRpcexplorer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
@@ -0,0 +1,60 @@
+package rpcgraphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+)
+
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+type handler struct {
+	schema graphql.Schema
+}
+
+// NewHandler serves queries against schema POSTed as JSON ({"query": ..., "variables": ..., "operationName": ...})
+// or passed as the query parameter of a GET
+func NewHandler(schema graphql.Schema) http.Handler {
+	return &handler{schema: schema}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := new(request)
+	switch r.Method {
+	case http.MethodGet:
+		values := r.URL.Query()
+		req.Query = values.Get("query")
+		req.OperationName = values.Get("operationName")
+		if variables := values.Get("variables"); variables != "" {
+			err := json.Unmarshal([]byte(variables), &req.Variables)
+			if err != nil {
+				http.Error(w, "could not decode variables: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		err := json.NewDecoder(r.Body).Decode(req)
+		if err != nil {
+			http.Error(w, "could not decode request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "POST the query as JSON", http.StatusMethodNotAllowed)
+		return
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        r.Context(),
+	})
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,735 @@
+// Package rpcgraphql serves a read-only GraphQL API over accounts, names, blocks, transactions, and events so that
+// front-ends can fetch nested views of the chain in a single request
+package rpcgraphql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/storage"
+)
+
+const (
+	// The number of items a list field returns when first is not given
+	DefaultPageSize = 100
+	// The most items a list field may return
+	MaxPageSize = 1000
+)
+
+type State interface {
+	acmstate.IterableStatsReader
+	names.IterableReader
+	rpcevents.Provider
+}
+
+type resolvers struct {
+	state      State
+	blockchain bcm.BlockchainInfo
+}
+
+// GraphQL Int is 32-bit so we need our own scalar for heights, balances, and the like
+var uint64Type = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Uint64",
+	Description: "An unsigned 64-bit integer, which may be given as a string",
+	Serialize: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case uint64:
+			return v
+		case *uint64:
+			if v == nil {
+				return nil
+			}
+			return *v
+		}
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case float64:
+			if v >= 0 && v == float64(uint64(v)) {
+				return uint64(v)
+			}
+		case int:
+			if v >= 0 {
+				return uint64(v)
+			}
+		case string:
+			u, err := strconv.ParseUint(v, 10, 64)
+			if err == nil {
+				return u
+			}
+		}
+		return nil
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch v := valueAST.(type) {
+		case *ast.IntValue:
+			u, err := strconv.ParseUint(v.Value, 10, 64)
+			if err == nil {
+				return u
+			}
+		case *ast.StringValue:
+			u, err := strconv.ParseUint(v.Value, 10, 64)
+			if err == nil {
+				return u
+			}
+		}
+		return nil
+	},
+})
+
+var filterArgument = &graphql.ArgumentConfig{
+	Type:        graphql.String,
+	Description: "Only return items matching this query (e.g. \"Balance > 100\")",
+}
+
+var firstArgument = &graphql.ArgumentConfig{
+	Type:         graphql.Int,
+	DefaultValue: DefaultPageSize,
+	Description:  fmt.Sprintf("Return at most this many items (up to %d)", MaxPageSize),
+}
+
+// NewSchema returns the GraphQL schema of the chain held in state
+func NewSchema(state State, blockchain bcm.BlockchainInfo) (graphql.Schema, error) {
+	r := &resolvers{
+		state:      state,
+		blockchain: blockchain,
+	}
+
+	var accountType, nameType, blockType, txType, eventType *graphql.Object
+
+	accountType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"address": field(graphql.NewNonNull(graphql.String), func(acc *acm.Account) interface{} {
+					return acc.Address.String()
+				}),
+				"publicKey": field(graphql.String, func(acc *acm.Account) interface{} {
+					if acc.PublicKey.IsSet() {
+						return acc.PublicKey.String()
+					}
+					return nil
+				}),
+				"sequence": field(graphql.NewNonNull(uint64Type), func(acc *acm.Account) interface{} {
+					return acc.Sequence
+				}),
+				"balance": field(graphql.NewNonNull(uint64Type), func(acc *acm.Account) interface{} {
+					return acc.Balance
+				}),
+				"code": field(graphql.String, func(acc *acm.Account) interface{} {
+					if len(acc.EVMCode) == 0 {
+						return nil
+					}
+					return acc.EVMCode.String()
+				}),
+				"permissions": field(graphql.NewList(graphql.NewNonNull(graphql.String)),
+					func(acc *acm.Account) interface{} {
+						return permission.BasePermissionsToStringList(acc.Permissions.Base)
+					}),
+				"storage": &graphql.Field{
+					Type:        graphql.String,
+					Description: "The value stored at a key (as 32-byte hex) of a contract",
+					Args: graphql.FieldConfigArgument{
+						"key": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					},
+					Resolve: r.storage,
+				},
+			}
+		}),
+	})
+
+	nameType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Name",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": field(graphql.NewNonNull(graphql.String), func(entry *names.Entry) interface{} {
+					return entry.Name
+				}),
+				"data": field(graphql.NewNonNull(graphql.String), func(entry *names.Entry) interface{} {
+					return entry.Data
+				}),
+				"expires": field(graphql.NewNonNull(uint64Type), func(entry *names.Entry) interface{} {
+					return entry.Expires
+				}),
+				"owner": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return r.account(p.Source.(*names.Entry).Owner)
+					},
+				},
+			}
+		}),
+	})
+
+	blockType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Description: "A block, only blocks holding transactions are stored in state so the header of an empty block " +
+			"is null",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"height": field(graphql.NewNonNull(uint64Type), func(block *exec.BlockExecution) interface{} {
+					return block.Height
+				}),
+				"time": field(graphql.String, func(block *exec.BlockExecution) interface{} {
+					if block.Header == nil {
+						return nil
+					}
+					return block.Header.Time.Format(time.RFC3339Nano)
+				}),
+				"chainID": field(graphql.String, func(block *exec.BlockExecution) interface{} {
+					if block.Header == nil {
+						return nil
+					}
+					return block.Header.ChainID
+				}),
+				"appHash": field(graphql.String, func(block *exec.BlockExecution) interface{} {
+					if block.Header == nil {
+						return nil
+					}
+					return binary.HexBytes(block.Header.AppHash).String()
+				}),
+				"proposerAddress": field(graphql.String, func(block *exec.BlockExecution) interface{} {
+					if block.Header == nil {
+						return nil
+					}
+					return binary.HexBytes(block.Header.ProposerAddress).String()
+				}),
+				"txs": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(txType))),
+					Args: graphql.FieldConfigArgument{"filter": filterArgument},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						qry, err := query.NewOrEmpty(stringArg(p, "filter"))
+						if err != nil {
+							return nil, err
+						}
+						var txes []*exec.TxExecution
+						for _, txe := range p.Source.(*exec.BlockExecution).TxExecutions {
+							if qry.Matches(txe) {
+								txes = append(txes, txe)
+							}
+						}
+						return txes, nil
+					},
+				},
+			}
+		}),
+	})
+
+	txType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Tx",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"hash": field(graphql.NewNonNull(graphql.String), func(txe *exec.TxExecution) interface{} {
+					return txe.TxHash.String()
+				}),
+				"type": field(graphql.NewNonNull(graphql.String), func(txe *exec.TxExecution) interface{} {
+					return txe.TxType.String()
+				}),
+				"height": field(graphql.NewNonNull(uint64Type), func(txe *exec.TxExecution) interface{} {
+					return txe.Height
+				}),
+				"index": field(graphql.NewNonNull(uint64Type), func(txe *exec.TxExecution) interface{} {
+					return txe.Index
+				}),
+				"gasUsed": field(uint64Type, func(txe *exec.TxExecution) interface{} {
+					if txe.Result == nil {
+						return nil
+					}
+					return txe.Result.GasUsed
+				}),
+				"return": field(graphql.String, func(txe *exec.TxExecution) interface{} {
+					if txe.Result == nil {
+						return nil
+					}
+					return binary.HexBytes(txe.Result.Return).String()
+				}),
+				"exception": field(graphql.String, func(txe *exec.TxExecution) interface{} {
+					if txe.Exception == nil {
+						return nil
+					}
+					return txe.Exception.Error()
+				}),
+				"contractAddress": field(graphql.String, func(txe *exec.TxExecution) interface{} {
+					if txe.Receipt == nil || !txe.Receipt.CreatesContract {
+						return nil
+					}
+					return txe.Receipt.ContractAddress.String()
+				}),
+				"inputs": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountType))),
+					Description: "The accounts that signed the transaction (as they are now)",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						txe := p.Source.(*exec.TxExecution)
+						accounts := []*acm.Account{}
+						if txe.Envelope == nil {
+							return accounts, nil
+						}
+						for _, input := range txe.Envelope.Tx.GetInputs() {
+							acc, err := r.state.GetAccount(input.Address)
+							if err != nil {
+								return nil, err
+							}
+							if acc != nil {
+								accounts = append(accounts, acc)
+							}
+						}
+						return accounts, nil
+					},
+				},
+				"block": &graphql.Field{
+					Type: blockType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return r.block(p.Source.(*exec.TxExecution).Height)
+					},
+				},
+				"events": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType))),
+					Args: graphql.FieldConfigArgument{"filter": filterArgument},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						qry, err := query.NewOrEmpty(stringArg(p, "filter"))
+						if err != nil {
+							return nil, err
+						}
+						events := []*exec.Event{}
+						for _, ev := range p.Source.(*exec.TxExecution).Events {
+							if qry.Matches(ev) {
+								events = append(events, ev)
+							}
+						}
+						return events, nil
+					},
+				},
+			}
+		}),
+	})
+
+	logType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Log",
+		Fields: graphql.Fields{
+			"address": field(graphql.NewNonNull(graphql.String), func(log *exec.LogEvent) interface{} {
+				return log.Address.String()
+			}),
+			"data": field(graphql.NewNonNull(graphql.String), func(log *exec.LogEvent) interface{} {
+				return log.Data.String()
+			}),
+			"topics": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+				func(log *exec.LogEvent) interface{} {
+					topics := make([]string, len(log.Topics))
+					for i, topic := range log.Topics {
+						topics[i] = topic.String()
+					}
+					return topics
+				}),
+			"sequence": field(graphql.NewNonNull(uint64Type), func(log *exec.LogEvent) interface{} {
+				return log.Sequence
+			}),
+		},
+	})
+
+	callType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Call",
+		Fields: graphql.Fields{
+			"type": field(graphql.NewNonNull(graphql.String), func(call *exec.CallEvent) interface{} {
+				return call.CallType.String()
+			}),
+			"origin": field(graphql.NewNonNull(graphql.String), func(call *exec.CallEvent) interface{} {
+				return call.Origin.String()
+			}),
+			"caller": field(graphql.String, func(call *exec.CallEvent) interface{} {
+				if call.CallData == nil {
+					return nil
+				}
+				return call.CallData.Caller.String()
+			}),
+			"callee": field(graphql.String, func(call *exec.CallEvent) interface{} {
+				if call.CallData == nil {
+					return nil
+				}
+				return call.CallData.Callee.String()
+			}),
+			"value": field(uint64Type, func(call *exec.CallEvent) interface{} {
+				if call.CallData == nil {
+					return nil
+				}
+				return call.CallData.Value
+			}),
+			"data": field(graphql.String, func(call *exec.CallEvent) interface{} {
+				if call.CallData == nil {
+					return nil
+				}
+				return call.CallData.Data.String()
+			}),
+			"return": field(graphql.NewNonNull(graphql.String), func(call *exec.CallEvent) interface{} {
+				return call.Return.String()
+			}),
+			"stackDepth": field(graphql.NewNonNull(uint64Type), func(call *exec.CallEvent) interface{} {
+				return call.StackDepth
+			}),
+		},
+	})
+
+	eventType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Event",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"type": field(graphql.NewNonNull(graphql.String), func(ev *exec.Event) interface{} {
+					return ev.Header.EventType.String()
+				}),
+				"height": field(graphql.NewNonNull(uint64Type), func(ev *exec.Event) interface{} {
+					return ev.Header.Height
+				}),
+				"index": field(graphql.NewNonNull(uint64Type), func(ev *exec.Event) interface{} {
+					return ev.Header.Index
+				}),
+				"txHash": field(graphql.NewNonNull(graphql.String), func(ev *exec.Event) interface{} {
+					return ev.Header.TxHash.String()
+				}),
+				"tx": &graphql.Field{
+					Type: txType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return r.tx(p.Source.(*exec.Event).Header.TxHash)
+					},
+				},
+				"log": field(logType, func(ev *exec.Event) interface{} {
+					if ev.Log == nil {
+						return nil
+					}
+					return ev.Log
+				}),
+				"call": field(callType, func(ev *exec.Event) interface{} {
+					if ev.Call == nil {
+						return nil
+					}
+					return ev.Call
+				}),
+				"input": field(graphql.String, func(ev *exec.Event) interface{} {
+					if ev.Input == nil {
+						return nil
+					}
+					return ev.Input.Address.String()
+				}),
+				"output": field(graphql.String, func(ev *exec.Event) interface{} {
+					if ev.Output == nil {
+						return nil
+					}
+					return ev.Output.Address.String()
+				}),
+			}
+		}),
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"account": &graphql.Field{
+				Type: accountType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address, err := crypto.AddressFromHexString(stringArg(p, "address"))
+					if err != nil {
+						return nil, err
+					}
+					return r.account(address)
+				},
+			},
+			"accounts": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountType))),
+				Args: graphql.FieldConfigArgument{
+					"filter": filterArgument,
+					"first":  firstArgument,
+				},
+				Resolve: r.accounts,
+			},
+			"name": &graphql.Field{
+				Type: nameType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					entry, err := r.state.GetName(stringArg(p, "name"))
+					if entry == nil || err != nil {
+						return nil, err
+					}
+					return entry, nil
+				},
+			},
+			"names": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(nameType))),
+				Args: graphql.FieldConfigArgument{
+					"filter": filterArgument,
+					"first":  firstArgument,
+				},
+				Resolve: r.names,
+			},
+			"block": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"height": &graphql.ArgumentConfig{
+						Type:        uint64Type,
+						Description: "The height of the block, the latest block if omitted",
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					height, ok := p.Args["height"].(uint64)
+					if !ok {
+						height = r.blockchain.LastBlockHeight()
+					}
+					return r.block(height)
+				},
+			},
+			"blocks": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(blockType))),
+				Description: "The blocks holding transactions within a range of heights",
+				Args: graphql.FieldConfigArgument{
+					"from":  &graphql.ArgumentConfig{Type: uint64Type, Description: "The first height (default 1)"},
+					"to":    &graphql.ArgumentConfig{Type: uint64Type, Description: "The last height (default latest)"},
+					"first": firstArgument,
+				},
+				Resolve: r.blocks,
+			},
+			"tx": &graphql.Field{
+				Type: txType,
+				Args: graphql.FieldConfigArgument{
+					"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					hash, err := hex.DecodeString(stringArg(p, "hash"))
+					if err != nil {
+						return nil, err
+					}
+					return r.tx(hash)
+				},
+			},
+			"events": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType))),
+				Description: "The events of successful transactions within a range of heights (e.g. filter " +
+					"\"EventType = 'LogEvent' AND Address = '<contract address>'\")",
+				Args: graphql.FieldConfigArgument{
+					"from":   &graphql.ArgumentConfig{Type: uint64Type, Description: "The first height (default 1)"},
+					"to":     &graphql.ArgumentConfig{Type: uint64Type, Description: "The last height (default latest)"},
+					"filter": filterArgument,
+					"first":  firstArgument,
+				},
+				Resolve: r.events,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func (r *resolvers) account(address crypto.Address) (interface{}, error) {
+	acc, err := r.state.GetAccount(address)
+	if acc == nil || err != nil {
+		// Return an untyped nil so the field is null
+		return nil, err
+	}
+	return acc, nil
+}
+
+func (r *resolvers) storage(p graphql.ResolveParams) (interface{}, error) {
+	key, err := hex.DecodeString(stringArg(p, "key"))
+	if err != nil {
+		return nil, err
+	}
+	value, err := r.state.GetStorage(p.Source.(*acm.Account).Address, binary.LeftPadWord256(key))
+	if value == nil || err != nil {
+		return nil, err
+	}
+	return binary.HexBytes(value).String(), nil
+}
+
+func (r *resolvers) accounts(p graphql.ResolveParams) (interface{}, error) {
+	qry, err := query.NewOrEmpty(stringArg(p, "filter"))
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(p)
+	accounts := []*acm.Account{}
+	err = r.state.IterateAccounts(func(acc *acm.Account) error {
+		if !qry.Matches(acc) {
+			return nil
+		}
+		accounts = append(accounts, acc)
+		if len(accounts) >= limit {
+			return errPageFull
+		}
+		return nil
+	})
+	if err != nil && err != errPageFull {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (r *resolvers) names(p graphql.ResolveParams) (interface{}, error) {
+	qry, err := query.NewOrEmpty(stringArg(p, "filter"))
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(p)
+	entries := []*names.Entry{}
+	err = r.state.IterateNames(func(entry *names.Entry) error {
+		if !qry.Matches(entry) {
+			return nil
+		}
+		entries = append(entries, entry)
+		if len(entries) >= limit {
+			return errPageFull
+		}
+		return nil
+	})
+	if err != nil && err != errPageFull {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *resolvers) tx(hash []byte) (interface{}, error) {
+	txe, err := r.state.TxByHash(hash)
+	if txe == nil || err != nil {
+		return nil, err
+	}
+	return txe, nil
+}
+
+// Returns the block at height, which has no header or transactions if it is empty
+func (r *resolvers) block(height uint64) (interface{}, error) {
+	if height == 0 || height > r.blockchain.LastBlockHeight() {
+		return nil, nil
+	}
+	var found *exec.BlockExecution
+	err := r.iterateBlocks(height, height, func(block *exec.BlockExecution) error {
+		found = block
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return &exec.BlockExecution{Height: height}, nil
+	}
+	return found, nil
+}
+
+func (r *resolvers) blocks(p graphql.ResolveParams) (interface{}, error) {
+	from, to := r.heights(p)
+	limit := pageSize(p)
+	blocks := []*exec.BlockExecution{}
+	err := r.iterateBlocks(from, to, func(block *exec.BlockExecution) error {
+		blocks = append(blocks, block)
+		if len(blocks) >= limit {
+			return errPageFull
+		}
+		return nil
+	})
+	if err != nil && err != errPageFull {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+func (r *resolvers) events(p graphql.ResolveParams) (interface{}, error) {
+	qry, err := query.NewOrEmpty(stringArg(p, "filter"))
+	if err != nil {
+		return nil, err
+	}
+	from, to := r.heights(p)
+	limit := pageSize(p)
+	events := []*exec.Event{}
+	err = r.iterateBlocks(from, to, func(block *exec.BlockExecution) error {
+		for _, txe := range block.TxExecutions {
+			// Exclude the events of transactions that were reverted
+			if txe.Exception != nil {
+				continue
+			}
+			for _, ev := range txe.Events {
+				if qry.Matches(ev) {
+					events = append(events, ev)
+					if len(events) >= limit {
+						return errPageFull
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil && err != errPageFull {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *resolvers) iterateBlocks(from, to uint64, consumer func(*exec.BlockExecution) error) error {
+	accumulator := exec.NewBlockAccumulator(exec.NonConsecutiveBlocks)
+	return r.state.IterateStreamEvents(&from, &to, storage.AscendingSort, func(ev *exec.StreamEvent) error {
+		block, err := accumulator.Consume(ev)
+		if err != nil || block == nil {
+			return err
+		}
+		return consumer(block)
+	})
+}
+
+func (r *resolvers) heights(p graphql.ResolveParams) (from, to uint64) {
+	from, ok := p.Args["from"].(uint64)
+	if !ok || from == 0 {
+		from = 1
+	}
+	to, ok = p.Args["to"].(uint64)
+	if !ok {
+		to = r.blockchain.LastBlockHeight()
+	}
+	return from, to
+}
+
+var errPageFull = fmt.Errorf("page full")
+
+func pageSize(p graphql.ResolveParams) int {
+	first, ok := p.Args["first"].(int)
+	if !ok || first <= 0 {
+		return DefaultPageSize
+	}
+	if first > MaxPageSize {
+		return MaxPageSize
+	}
+	return first
+}
+
+func stringArg(p graphql.ResolveParams, name string) string {
+	str, _ := p.Args[name].(string)
+	return str
+}
+
+// Returns a field resolved by a function of its source (which must be of type T in fn func(T) interface{})
+func field(typ graphql.Output, fn interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type:    typ,
+		Resolve: resolveSource(fn),
+	}
+}
+
+func resolveSource(fn interface{}) graphql.FieldResolveFn {
+	fv := reflect.ValueOf(fn)
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		return fv.Call([]reflect.Value{reflect.ValueOf(p.Source)})[0].Interface(), nil
+	}
+}
@@ -0,0 +1,169 @@
+package rpcgraphql
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type blockchain struct {
+	bcm.BlockchainInfo
+	height uint64
+}
+
+func (bc *blockchain) LastBlockHeight() uint64 {
+	return bc.height
+}
+
+var (
+	alice   = crypto.Address{1}
+	bob     = crypto.Address{2}
+	token   = crypto.Address{3}
+	aliceTx = crypto.Keccak256([]byte("alice"))
+	bobTx   = crypto.Keccak256([]byte("bob"))
+)
+
+func TestSchema(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	_, _, err := st.Update(func(ws state.Updatable) error {
+		for _, acc := range []*acm.Account{
+			{Address: alice, Balance: 100, Sequence: 1},
+			{Address: bob, Balance: 200},
+			{Address: token, EVMCode: []byte{0x60, 0x60}},
+		} {
+			err := ws.UpdateAccount(acc)
+			if err != nil {
+				return err
+			}
+		}
+		err := ws.SetStorage(token, binary.LeftPadWord256([]byte{1}), []byte{42})
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateName(&names.Entry{Name: "alice", Owner: alice, Data: "hello", Expires: 1000})
+		if err != nil {
+			return err
+		}
+		return ws.AddBlock(&exec.BlockExecution{
+			Height: 2,
+			TxExecutions: []*exec.TxExecution{
+				mkTxExecution(2, 0, aliceTx, alice, nil),
+				// Events of transactions that failed are not returned by events
+				mkTxExecution(2, 1, bobTx, bob, errors.Errorf(errors.Codes.InsufficientBalance, "no")),
+			},
+		})
+	})
+	require.NoError(t, err)
+
+	schema, err := NewSchema(st, &blockchain{height: 3})
+	require.NoError(t, err)
+
+	t.Run("Account", func(t *testing.T) {
+		data := do(t, schema, `{account(address: "`+alice.String()+`") {balance sequence}}`)
+		assert.JSONEq(t, `{"account": {"balance": 100, "sequence": 1}}`, data)
+
+		data = do(t, schema, `{account(address: "`+token.String()+`") {code storage(key: "01")}}`)
+		assert.JSONEq(t, `{"account": {"code": "6060", "storage": "2A"}}`, data)
+
+		data = do(t, schema, `{account(address: "`+crypto.Address{9}.String()+`") {balance}}`)
+		assert.JSONEq(t, `{"account": null}`, data)
+	})
+
+	t.Run("Accounts", func(t *testing.T) {
+		data := do(t, schema, `{accounts(filter: "Balance >= 100") {balance}}`)
+		assert.JSONEq(t, `{"accounts": [{"balance": 100}, {"balance": 200}]}`, data)
+
+		data = do(t, schema, `{accounts(first: 1) {address}}`)
+		assert.JSONEq(t, `{"accounts": [{"address": "`+alice.String()+`"}]}`, data)
+	})
+
+	t.Run("Name", func(t *testing.T) {
+		data := do(t, schema, `{name(name: "alice") {data owner {balance}}}`)
+		assert.JSONEq(t, `{"name": {"data": "hello", "owner": {"balance": 100}}}`, data)
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		data := do(t, schema, `{block(height: 2) {height txs {index exception inputs {balance}}}}`)
+		assert.JSONEq(t, `{"block": {"height": 2, "txs": [
+			{"index": 0, "exception": null, "inputs": [{"balance": 100}]},
+			{"index": 1, "exception": "error 3 - Error 3: insufficient balance: no", "inputs": [{"balance": 200}]}
+		]}}`, data)
+
+		// Blocks without transactions are not stored
+		data = do(t, schema, `{block {height time txs {index}}}`)
+		assert.JSONEq(t, `{"block": {"height": 3, "time": null, "txs": []}}`, data)
+
+		data = do(t, schema, `{block(height: 4) {height}}`)
+		assert.JSONEq(t, `{"block": null}`, data)
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		data := do(t, schema, `{events(filter: "EventType = 'LogEvent'") {height log {address} tx {index}}}`)
+		assert.JSONEq(t, `{"events": [{"height": 2, "log": {"address": "`+alice.String()+`"}, "tx": {"index": 0}}]}`,
+			data)
+	})
+
+	t.Run("Handler", func(t *testing.T) {
+		server := httptest.NewServer(NewHandler(schema))
+		defer server.Close()
+		resp, err := http.Post(server.URL, "application/json", strings.NewReader(
+			`{"query": "query($hash: String!) {tx(hash: $hash) {height}}", "variables": {"hash": "`+
+				hex.EncodeToString(aliceTx)+`"}}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		result := new(graphql.Result)
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+		require.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{"tx": map[string]interface{}{"height": float64(2)}}, result.Data)
+	})
+}
+
+func do(t *testing.T, schema graphql.Schema, query string) string {
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	require.Empty(t, result.Errors)
+	bs, err := json.Marshal(result.Data)
+	require.NoError(t, err)
+	return string(bs)
+}
+
+func mkTxExecution(height, index uint64, hash []byte, input crypto.Address, exception *errors.Exception) *exec.TxExecution {
+	txEnv := txs.Enclose("TestChain", &payload.CallTx{
+		Input: &payload.TxInput{Address: input, Amount: 1},
+	})
+	return &exec.TxExecution{
+		TxHeader: &exec.TxHeader{
+			TxHash: hash,
+			Height: height,
+			Index:  index,
+		},
+		Envelope:  txEnv,
+		Exception: exception,
+		Events: []*exec.Event{{
+			Header: &exec.Header{
+				EventType: exec.TypeLog,
+				TxHash:    hash,
+				Height:    height,
+			},
+			Log: &exec.LogEvent{Address: input},
+		}},
+	}
+}
@@ -13,14 +13,15 @@ import (
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
-func StartServer(service *rpc.Service, pattern string, listener net.Listener, logger *logging.Logger) (*http.Server, error) {
+func StartServer(service *rpc.Service, pattern string, listener net.Listener, cors *rpc.CORSConfig,
+	logger *logging.Logger) (*http.Server, error) {
 	logger = logger.With(structure.ComponentKey, "RPC_Info")
 	routes := GetRoutes(service)
 	mux := http.NewServeMux()
 	wm := server.NewWebsocketManager(routes, logger)
 	mux.HandleFunc(pattern, wm.WebsocketHandler)
 	server.RegisterRPCFuncs(mux, routes, logger)
-	srv, err := server.StartHTTPServer(listener, mux, logger)
+	srv, err := server.StartHTTPServer(listener, cors.Handler(mux), logger)
 	if err != nil {
 		return nil, err
 	}
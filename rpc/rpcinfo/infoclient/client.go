@@ -27,6 +27,15 @@ func Status(client RPCClient) (*rpc.ResultStatus, error) {
 	return res, nil
 }
 
+func BootReport(client RPCClient) (*rpc.ResultBootReport, error) {
+	res := new(rpc.ResultBootReport)
+	_, err := client.Call(rpcinfo.BootReport, pmap(), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func ChainId(client RPCClient) (*rpc.ResultChainId, error) {
 	res := new(rpc.ResultChainId)
 	_, err := client.Call(rpcinfo.ChainID, pmap(), &res)
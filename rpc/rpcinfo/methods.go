@@ -16,6 +16,7 @@ const (
 	Status          = "status"
 	Network         = "network"
 	NetworkRegistry = "network/registry"
+	BootReport      = "boot_report"
 
 	// Accounts
 	Accounts        = "accounts"
@@ -57,6 +58,10 @@ const maxRegexLength = 255
 //
 // curl -X POST -d '{"method": "names", "id": "foo", "params": ["loves"]}' http://0.0.0.0:26658
 //
+// or in a JSON-RPC batch answered by an array of responses:
+//
+// curl -X POST -d '[{"method": "status", "id": "1"}, {"method": "validators", "id": "2"}]' http://0.0.0.0:26658
+//
 func GetRoutes(service *rpc.Service) map[string]*server.RPCFunc {
 	// TODO: overhaul this with gRPC-gateway / swagger
 	return map[string]*server.RPCFunc{
@@ -64,6 +69,7 @@ func GetRoutes(service *rpc.Service) map[string]*server.RPCFunc {
 		Status:          server.NewRPCFunc(service.StatusWithin, "block_time_within,block_seen_time_within"),
 		Network:         server.NewRPCFunc(service.Network, ""),
 		NetworkRegistry: server.NewRPCFunc(service.NetworkRegistry, ""),
+		BootReport:      server.NewRPCFunc(service.BootReport, ""),
 
 		// Accounts
 		Accounts: server.NewRPCFunc(func() (*rpc.ResultAccounts, error) {
@@ -0,0 +1,90 @@
+package rpcquery
+
+import (
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// The most activities returned by a page of GetAccountActivity
+const MaxAccountActivity = 100
+
+// Summarises a transaction in which address was involved as the activity of address
+func NewActivity(address crypto.Address, txe *exec.TxExecution) *Activity {
+	activity := &Activity{
+		Height:    txe.Height,
+		TxHash:    txe.TxHash,
+		TxType:    txe.TxType,
+		Exception: txe.Exception,
+	}
+	roles := make(map[ActivityRole]bool)
+	var received uint64
+	for _, ev := range txe.Events {
+		switch {
+		case ev.Input != nil && ev.Input.Address == address:
+			roles[ActivityRole_INPUT] = true
+		case ev.Output != nil && ev.Output.Address == address:
+			roles[ActivityRole_OUTPUT] = true
+		case ev.Call != nil && ev.Call.CallData != nil && ev.Call.CallData.Callee == address:
+			roles[ActivityRole_CALLEE] = true
+			received += ev.Call.CallData.Value
+		case ev.Log != nil && ev.Log.Address == address:
+			roles[ActivityRole_EMITTER] = true
+		}
+	}
+	if txe.Receipt != nil && txe.Receipt.CreatesContract && txe.Receipt.ContractAddress == address {
+		roles[ActivityRole_CREATED] = true
+	}
+	for role := ActivityRole_INPUT; role <= ActivityRole_EMITTER; role++ {
+		if roles[role] {
+			activity.Roles = append(activity.Roles, role)
+		}
+	}
+	if txe.Envelope == nil {
+		return activity
+	}
+
+	sender := roles[ActivityRole_INPUT]
+	tx := txe.Envelope.Tx.Payload
+	if sender {
+		for _, input := range tx.GetInputs() {
+			if input.Address == address {
+				activity.Amount += input.Amount
+			}
+		}
+	}
+	switch tx := tx.(type) {
+	case *payload.SendTx:
+		if sender {
+			if len(tx.Outputs) > 0 {
+				activity.Counterparty = &tx.Outputs[0].Address
+			}
+		} else {
+			for _, output := range tx.Outputs {
+				if output.Address == address {
+					activity.Amount += output.Amount
+				}
+			}
+			if len(tx.Inputs) > 0 {
+				activity.Counterparty = &tx.Inputs[0].Address
+			}
+		}
+	case *payload.CallTx:
+		if sender {
+			if tx.Address != nil {
+				activity.Counterparty = tx.Address
+			} else if txe.Receipt != nil {
+				activity.Counterparty = &txe.Receipt.ContractAddress
+			}
+		} else {
+			activity.Amount = received
+			activity.Counterparty = &tx.Input.Address
+		}
+	case *payload.NameTx:
+		activity.Name = tx.Name
+	case *payload.PermsTx:
+		activity.PermArgs = &tx.PermArgs
+		activity.Counterparty = tx.PermArgs.Target
+	}
+	return activity
+}
@@ -3,25 +3,36 @@ package rpcquery
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/escrow"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/heartbeat"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/execution/schedule"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -30,26 +41,55 @@ type queryServer struct {
 	state      QueryState
 	blockchain bcm.BlockchainInfo
 	nodeView   *tendermint.NodeView
-	logger     *logging.Logger
+	services   ServiceInfoProvider
+	// The payload types the node can execute
+	payloadTypes []payload.Type
+	logger       *logging.Logger
 }
 
 var _ QueryServer = &queryServer{}
 
+// The most accounts and storage slots that may be requested together from GetStateBatch
+const MaxStateBatchSize = 10000
+
+// The most storage slots returned by a page of GetStorageRange
+const MaxStorageRangeSize = 1000
+
+// Stops iteration once a list has returned as many results as were asked for
+var errLimitReached = errors.New("limit reached")
+
 type QueryState interface {
 	acmstate.IterableStatsReader
 	acmstate.MetadataReader
 	names.IterableReader
 	registry.IterableReader
 	proposal.IterableReader
+	schedule.IterableReader
+	escrow.IterableReader
+	heartbeat.IterableReader
+	IterateAccountsAfter(after *crypto.Address, consumer func(*acm.Account) error) error
+	IterateNamesAfter(after string, consumer func(*names.Entry) error) error
+	LastLogSequence(address crypto.Address) (uint64, error)
+	IterateTxsByAddress(address crypto.Address, after []byte, descending bool,
+		consumer func(*exec.TxExecution) error) error
+	LoadHeight(height uint64) (*state.ReadState, error)
 	validator.History
 }
 
-func NewQueryServer(state QueryState, blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
+// Provides the GRPC services registered with a server (e.g. *grpc.Server)
+type ServiceInfoProvider interface {
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
+func NewQueryServer(state QueryState, blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView,
+	services ServiceInfoProvider, payloadTypes []payload.Type, logger *logging.Logger) *queryServer {
 	return &queryServer{
-		state:      state,
-		blockchain: blockchain,
-		nodeView:   nodeView,
-		logger:     logger,
+		state:        state,
+		blockchain:   blockchain,
+		nodeView:     nodeView,
+		services:     services,
+		payloadTypes: payloadTypes,
+		logger:       logger,
 	}
 }
 
@@ -57,21 +97,35 @@ func (qs *queryServer) Status(ctx context.Context, param *StatusParam) (*rpc.Res
 	return rpc.Status(qs.blockchain, qs.state, qs.nodeView, param.BlockTimeWithin, param.BlockSeenTimeWithin)
 }
 
+func (qs *queryServer) GetCapabilities(ctx context.Context, param *GetCapabilitiesParam) (*rpc.ResultCapabilities, error) {
+	var services map[string]grpc.ServiceInfo
+	if qs.services != nil {
+		services = qs.services.GetServiceInfo()
+	}
+	return rpc.Capabilities(services, qs.payloadTypes), nil
+}
+
 // Account state
 
 func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (*acm.Account, error) {
 	acc, err := qs.state.GetAccount(param.Address)
+	if err != nil {
+		return &acm.Account{}, err
+	}
 	if acc == nil {
-		acc = &acm.Account{}
+		return &acm.Account{}, nil
 	}
-	return acc, err
+	return acc.Mask(param.Fields...)
 }
 
-// GetMetadata returns empty metadata string if not found. Metadata can be retrieved by account, or
-// by metadata hash
+// GetMetadata returns empty metadata string if not found. Metadata can be retrieved by account, by metadata hash, or
+// by code hash. Where the metadata was produced by burrow deploy its fields are decoded into the result, and where the
+// contract was deployed by a transaction (or for a code hash, the contract that registered the metadata) its deployment
+// is included.
 func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam) (*MetadataResult, error) {
 	metadata := &MetadataResult{}
 	var contractMeta *acm.ContractMeta
+	var deployed []crypto.Address
 	var err error
 	if param.Address != nil {
 		acc, err := qs.state.GetAccount(*param.Address)
@@ -80,7 +134,9 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 		}
 		if acc != nil && acc.CodeHash != nil {
 			codehash := acc.CodeHash
+			deployed = append(deployed, *param.Address)
 			if acc.Forebear != nil {
+				deployed = append(deployed, *acc.Forebear)
 				acc, err = qs.state.GetAccount(*acc.Forebear)
 				if err != nil {
 					return metadata, err
@@ -103,15 +159,33 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 					}
 				}
 			}
+
+			if contractMeta == nil {
+				// Fall back to metadata registered by any deployment of the same code
+				contractMeta, _, err = qs.codeMetadata(codehash)
+				if err != nil {
+					return metadata, err
+				}
+			}
 		}
 	} else if param.MetadataHash != nil {
 		contractMeta = &acm.ContractMeta{
 			MetadataHash: *param.MetadataHash,
 		}
+	} else if param.CodeHash != nil {
+		var registeredBy *crypto.Address
+		contractMeta, registeredBy, err = qs.codeMetadata(*param.CodeHash)
+		if err != nil {
+			return metadata, err
+		}
+		if registeredBy != nil {
+			deployed = append(deployed, *registeredBy)
+		}
 	}
 	if contractMeta == nil {
 		return metadata, nil
 	}
+	metadata.CodeHash = contractMeta.CodeHash
 	if contractMeta.Metadata != "" {
 		// Looks like the metadata is already memoised - (e.g. by native.State)
 		metadata.Metadata = contractMeta.Metadata
@@ -119,8 +193,42 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 		var metadataHash acmstate.MetadataHash
 		copy(metadataHash[:], contractMeta.MetadataHash)
 		metadata.Metadata, err = qs.state.GetMetadata(metadataHash)
+		if err != nil {
+			return metadata, err
+		}
+	}
+	var meta compile.Metadata
+	if json.Unmarshal([]byte(metadata.Metadata), &meta) == nil {
+		metadata.ContractName = meta.ContractName
+		metadata.SourceFile = meta.SourceFile
+		metadata.CompilerVersion = meta.CompilerVersion
+		metadata.SourceHash = meta.SourceHash
+		metadata.Abi = string(meta.Abi)
+	}
+	for _, address := range deployed {
+		metadata.Deployment, err = qs.state.GetDeployment(address)
+		if err != nil || metadata.Deployment != nil {
+			return metadata, err
+		}
+	}
+	return metadata, nil
+}
+
+// Returns the metadata registered for codehash and the contract whose deployment registered it
+func (qs *queryServer) codeMetadata(codehash []byte) (*acm.ContractMeta, *crypto.Address, error) {
+	var ch acmstate.CodeHash
+	if len(codehash) != len(ch) {
+		return nil, nil, fmt.Errorf("code hash should be %d bytes but is %d", len(ch), len(codehash))
 	}
-	return metadata, err
+	copy(ch[:], codehash)
+	codeMeta, err := qs.state.GetCodeMetadata(ch)
+	if err != nil || codeMeta == nil {
+		return nil, nil, err
+	}
+	return &acm.ContractMeta{
+		CodeHash:     codehash,
+		MetadataHash: codeMeta.MetadataHash,
+	}, &codeMeta.Address, nil
 }
 
 func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
@@ -128,23 +236,190 @@ func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (
 	return &StorageValue{Value: val}, err
 }
 
+func (qs *queryServer) GetStorageRange(ctx context.Context, param *GetStorageRangeParam) (*StorageRange, error) {
+	limit := param.Limit
+	if limit == 0 || limit > MaxStorageRangeSize {
+		limit = MaxStorageRangeSize
+	}
+	height, st, err := qs.loadHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	storageRange := &StorageRange{
+		Height: height,
+	}
+	err = st.IterateStorageAfter(param.Address, param.After, func(key binary.Word256, value []byte) error {
+		if uint64(len(storageRange.Entries)) == limit {
+			// There is at least one more slot so return a cursor to the next page
+			next := storageRange.Entries[limit-1].Key
+			storageRange.Next = &next
+			return errLimitReached
+		}
+		storageRange.Entries = append(storageRange.Entries, &StorageEntry{Key: key, Value: value})
+		return nil
+	})
+	if err != nil && err != errLimitReached {
+		return nil, err
+	}
+	return storageRange, nil
+}
+
+func (qs *queryServer) GetStateBatch(ctx context.Context, param *GetStateBatchParam) (*StateBatch, error) {
+	if len(param.Accounts)+len(param.Storage) > MaxStateBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d accounts and storage slots may be requested",
+			MaxStateBatchSize)
+	}
+	// Read every value from the same version of state even if blocks are committed in the meantime
+	height, st, err := qs.loadHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	batch := &StateBatch{
+		Height:   height,
+		Accounts: make([]*acm.Account, len(param.Accounts)),
+		Storage:  make([]*StorageValue, len(param.Storage)),
+	}
+	for i, address := range param.Accounts {
+		acc, err := st.GetAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		if acc == nil {
+			acc = &acm.Account{}
+		}
+		batch.Accounts[i] = acc
+	}
+	for i, slot := range param.Storage {
+		val, err := st.GetStorage(slot.Address, slot.Key)
+		if err != nil {
+			return nil, err
+		}
+		batch.Storage[i] = &StorageValue{Value: val}
+	}
+	return batch, nil
+}
+
+func (qs *queryServer) GetDisassembly(ctx context.Context, param *GetDisassemblyParam) (*Disassembly, error) {
+	acc, err := qs.state.GetAccount(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil || len(acc.EVMCode) == 0 {
+		return nil, fmt.Errorf("no EVM code deployed at %v", param.Address)
+	}
+	return qs.disassemble(ctx, acc), nil
+}
+
+func (qs *queryServer) GetCode(ctx context.Context, param *GetCodeParam) (*Code, error) {
+	acc, err := qs.state.GetAccount(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, fmt.Errorf("no account at %v", param.Address)
+	}
+	code := &Code{
+		Code:     acc.Code(),
+		CodeHash: acc.CodeHash,
+		WASM:     len(acc.WASMCode) > 0,
+	}
+	if param.Disassemble && len(acc.EVMCode) > 0 {
+		code.Disassembly = qs.disassemble(ctx, acc)
+	}
+	return code, nil
+}
+
+func (qs *queryServer) disassemble(ctx context.Context, acc *acm.Account) *Disassembly {
+	instructions, metadata := asm.Disassemble(acc.EVMCode)
+	// Name dispatched functions where we have the contract's ABI
+	functions := make(map[abi.FunctionID]string)
+	meta, err := qs.GetMetadata(ctx, &GetMetadataParam{Address: &acc.Address})
+	if err == nil && meta.Abi != "" {
+		spec, err := abi.ReadSpec([]byte(meta.Abi))
+		if err == nil {
+			for _, f := range spec.Functions {
+				functions[f.FunctionID] = abi.Signature(f.Name, f.Inputs)
+			}
+		}
+	}
+	disassembly := &Disassembly{
+		Instructions: make([]*Instruction, len(instructions)),
+		Metadata:     metadata,
+		CodeHash:     acc.CodeHash,
+	}
+	for i, ins := range instructions {
+		annotation := ins.Annotation
+		if ins.OpCode == asm.PUSH4 {
+			var id abi.FunctionID
+			copy(id[:], ins.Immediate)
+			if name, ok := functions[id]; ok {
+				annotation = "function selector of " + name
+			}
+		}
+		disassembly.Instructions[i] = &Instruction{
+			PC:         uint64(ins.PC),
+			OpCode:     ins.OpCode.Name(),
+			Immediate:  ins.Immediate,
+			Annotation: annotation,
+			Label:      ins.Label,
+		}
+	}
+	for _, sel := range asm.FunctionSelectors(instructions) {
+		var id abi.FunctionID
+		copy(id[:], sel.Selector)
+		disassembly.Selectors = append(disassembly.Selectors, &FunctionSelector{
+			Selector:    sel.Selector,
+			Destination: uint64(sel.Destination),
+			Label:       sel.Label,
+			Signature:   functions[id],
+		})
+	}
+	return disassembly
+}
+
 func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListAccountsServer) error {
 	qry, err := query.NewOrEmpty(param.Query)
 	if err != nil {
 		return err
 	}
-	var streamErr error
-	err = qs.state.IterateAccounts(func(acc *acm.Account) error {
-		if qry.Matches(acc) {
-			return stream.Send(acc)
-		} else {
+	var sent uint64
+	err = qs.state.IterateAccountsAfter(param.After, func(acc *acm.Account) error {
+		if !qry.Matches(acc) {
 			return nil
 		}
+		acc, err := acc.Mask(param.Fields...)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(acc)
+		if err != nil {
+			return err
+		}
+		sent++
+		if sent == param.Limit {
+			return errLimitReached
+		}
+		return nil
 	})
-	if err != nil {
+	if err != nil && err != errLimitReached {
 		return err
 	}
-	return streamErr
+	return nil
+}
+
+// Returns the state at height, or the latest state if height is zero, along with its height
+func (qs *queryServer) loadHeight(height uint64) (uint64, *state.ReadState, error) {
+	latest := qs.blockchain.LastBlockHeight()
+	if height == 0 {
+		height = latest
+	} else if height > latest {
+		return 0, nil, status.Errorf(codes.OutOfRange, "height %d is above the latest height %d", height, latest)
+	}
+	st, err := qs.state.LoadHeight(height)
+	if err != nil {
+		return 0, nil, err
+	}
+	return height, st, nil
 }
 
 // Names
@@ -162,18 +437,25 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 	if err != nil {
 		return err
 	}
-	var streamErr error
-	err = qs.state.IterateNames(func(entry *names.Entry) error {
-		if qry.Matches(entry) {
-			return stream.Send(entry)
-		} else {
+	var sent uint64
+	err = qs.state.IterateNamesAfter(param.After, func(entry *names.Entry) error {
+		if !qry.Matches(entry) {
 			return nil
 		}
+		err := stream.Send(entry)
+		if err != nil {
+			return err
+		}
+		sent++
+		if sent == param.Limit {
+			return errLimitReached
+		}
+		return nil
 	})
-	if err != nil {
+	if err != nil && err != errLimitReached {
 		return err
 	}
-	return streamErr
+	return nil
 }
 
 // Validators
@@ -209,6 +491,27 @@ func (qs *queryServer) GetValidatorSetHistory(ctx context.Context, param *GetVal
 	return history, nil
 }
 
+func (qs *queryServer) GetHeartbeat(ctx context.Context, param *GetHeartbeatParam) (hb *heartbeat.Heartbeat, err error) {
+	hb, err = qs.state.GetHeartbeat(param.Address)
+	if hb == nil && err == nil {
+		err = status.Error(codes.NotFound, fmt.Sprintf("no heartbeat from validator %v", param.Address))
+	}
+	return
+}
+
+func (qs *queryServer) ListHeartbeats(param *ListHeartbeatsParam, stream Query_ListHeartbeatsServer) error {
+	qry, err := query.NewOrEmpty(param.Query)
+	if err != nil {
+		return err
+	}
+	return qs.state.IterateHeartbeats(func(hb *heartbeat.Heartbeat) error {
+		if qry.Matches(hb) {
+			return stream.Send(hb)
+		}
+		return nil
+	})
+}
+
 func (qs *queryServer) GetNetworkRegistry(ctx context.Context, param *GetNetworkRegistryParam) (*NetworkRegistry, error) {
 	rv := make([]*RegisteredValidator, 0)
 	err := qs.state.IterateNodes(func(id crypto.Address, rn *registry.NodeIdentity) error {
@@ -246,6 +549,44 @@ func (qs *queryServer) ListProposals(param *ListProposalsParam, stream Query_Lis
 	return streamErr
 }
 
+func (qs *queryServer) ListScheduledGovTxs(param *ListScheduledGovTxsParam,
+	stream Query_ListScheduledGovTxsServer) error {
+	return qs.state.IterateScheduledGovTxs(func(scheduled *payload.ScheduledGovTx) error {
+		return stream.Send(scheduled)
+	})
+}
+
+// Escrows
+
+func (qs *queryServer) GetEscrow(ctx context.Context, param *GetEscrowParam) (esc *escrow.Escrow, err error) {
+	esc, err = qs.state.GetEscrow(param.ID)
+	if esc == nil && err == nil {
+		err = status.Error(codes.NotFound, fmt.Sprintf("escrow %d not found", param.ID))
+	}
+	return
+}
+
+func (qs *queryServer) ListEscrows(param *ListEscrowsParam, stream Query_ListEscrowsServer) error {
+	qry, err := query.NewOrEmpty(param.Query)
+	if err != nil {
+		return err
+	}
+	return qs.state.IterateEscrows(func(esc *escrow.Escrow) error {
+		if qry.Matches(esc) {
+			return stream.Send(esc)
+		}
+		return nil
+	})
+}
+
+func (qs *queryServer) GetLogSequence(ctx context.Context, param *GetLogSequenceParam) (*LogSequence, error) {
+	sequence, err := qs.state.LastLogSequence(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &LogSequence{Address: param.Address, Sequence: sequence}, nil
+}
+
 func (qs *queryServer) GetStats(ctx context.Context, param *GetStatsParam) (*Stats, error) {
 	stats := qs.state.GetAccountStats()
 
@@ -265,3 +606,43 @@ func (qs *queryServer) GetBlockHeader(ctx context.Context, param *GetBlockParam)
 	abciHeader := tmtypes.TM2PB.Header(header)
 	return &abciHeader, nil
 }
+
+func (qs *queryServer) GetAccountActivity(ctx context.Context, param *GetAccountActivityParam) (*AccountActivity, error) {
+	limit := param.Limit
+	if limit == 0 || limit > MaxAccountActivity {
+		limit = MaxAccountActivity
+	}
+	activity := new(AccountActivity)
+	blockTimes := make(map[uint64]time.Time)
+	err := qs.state.IterateTxsByAddress(param.Address, param.After, param.Descending,
+		func(txe *exec.TxExecution) error {
+			if uint64(len(activity.Activities)) == limit {
+				// There is at least one more so let the caller continue from the last we return
+				activity.Next = activity.Activities[limit-1].TxHash
+				return errLimitReached
+			}
+			act := NewActivity(param.Address, txe)
+			blockTime, ok := blockTimes[txe.Height]
+			if !ok {
+				blockTime = qs.blockTime(txe.Height)
+				blockTimes[txe.Height] = blockTime
+			}
+			act.Time = blockTime
+			activity.Activities = append(activity.Activities, act)
+			return nil
+		})
+	if err != nil && err != errLimitReached {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// The time of the block at height, or the zero time if the block is not held (e.g. when state was restored from a dump)
+func (qs *queryServer) blockTime(height uint64) time.Time {
+	header, err := qs.blockchain.GetBlockHeader(height)
+	if err != nil {
+		qs.logger.TraceMsg("Could not get block time", "height", height, "error", err)
+		return time.Time{}
+	}
+	return header.Time
+}
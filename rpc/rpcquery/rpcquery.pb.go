@@ -8,17 +8,25 @@ import (
 	fmt "fmt"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	acm "github.com/hyperledger/burrow/acm"
 	validator "github.com/hyperledger/burrow/acm/validator"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	errors "github.com/hyperledger/burrow/execution/errors"
+	escrow "github.com/hyperledger/burrow/execution/escrow"
+	heartbeat "github.com/hyperledger/burrow/execution/heartbeat"
 	names "github.com/hyperledger/burrow/execution/names"
 	registry "github.com/hyperledger/burrow/execution/registry"
+	permission "github.com/hyperledger/burrow/permission"
 	rpc "github.com/hyperledger/burrow/rpc"
+	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
 	payload "github.com/hyperledger/burrow/txs/payload"
 	types "github.com/tendermint/tendermint/abci/types"
 	grpc "google.golang.org/grpc"
@@ -31,6 +39,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +47,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ActivityRole int32
+
+const (
+	// The account signed the transaction as an input
+	ActivityRole_INPUT ActivityRole = 0
+	// The account received an output of a SendTx
+	ActivityRole_OUTPUT ActivityRole = 1
+	// The account was called
+	ActivityRole_CALLEE ActivityRole = 2
+	// The account was created as a contract
+	ActivityRole_CREATED ActivityRole = 3
+	// The account emitted a LogEvent
+	ActivityRole_EMITTER ActivityRole = 4
+)
+
+var ActivityRole_name = map[int32]string{
+	0: "INPUT",
+	1: "OUTPUT",
+	2: "CALLEE",
+	3: "CREATED",
+	4: "EMITTER",
+}
+
+var ActivityRole_value = map[string]int32{
+	"INPUT":   0,
+	"OUTPUT":  1,
+	"CALLEE":  2,
+	"CREATED": 3,
+	"EMITTER": 4,
+}
+
+func (x ActivityRole) String() string {
+	return proto.EnumName(ActivityRole_name, int32(x))
+}
+
+func (ActivityRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{0}
+}
+
 type StatusParam struct {
 	BlockTimeWithin      string   `protobuf:"bytes,1,opt,name=BlockTimeWithin,proto3" json:"BlockTimeWithin,omitempty"`
 	BlockSeenTimeWithin  string   `protobuf:"bytes,2,opt,name=BlockSeenTimeWithin,proto3" json:"BlockSeenTimeWithin,omitempty"`
@@ -88,18 +136,54 @@ func (*StatusParam) XXX_MessageName() string {
 	return "rpcquery.StatusParam"
 }
 
+type GetCapabilitiesParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCapabilitiesParam) Reset()         { *m = GetCapabilitiesParam{} }
+func (m *GetCapabilitiesParam) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesParam) ProtoMessage()    {}
+func (*GetCapabilitiesParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{1}
+}
+func (m *GetCapabilitiesParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapabilitiesParam.Unmarshal(m, b)
+}
+func (m *GetCapabilitiesParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapabilitiesParam.Marshal(b, m, deterministic)
+}
+func (m *GetCapabilitiesParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesParam.Merge(m, src)
+}
+func (m *GetCapabilitiesParam) XXX_Size() int {
+	return xxx_messageInfo_GetCapabilitiesParam.Size(m)
+}
+func (m *GetCapabilitiesParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesParam proto.InternalMessageInfo
+
+func (*GetCapabilitiesParam) XXX_MessageName() string {
+	return "rpcquery.GetCapabilitiesParam"
+}
+
 type GetAccountParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The fields of the account to return (e.g. Address, Balance), all fields are returned if none are given
+	Fields               []string `protobuf:"bytes,2,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetAccountParam) Reset()         { *m = GetAccountParam{} }
 func (m *GetAccountParam) String() string { return proto.CompactTextString(m) }
 func (*GetAccountParam) ProtoMessage()    {}
 func (*GetAccountParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{1}
+	return fileDescriptor_88e25d9b99e39f02, []int{2}
 }
 func (m *GetAccountParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAccountParam.Unmarshal(m, b)
@@ -119,13 +203,22 @@ func (m *GetAccountParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetAccountParam proto.InternalMessageInfo
 
+func (m *GetAccountParam) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 func (*GetAccountParam) XXX_MessageName() string {
 	return "rpcquery.GetAccountParam"
 }
 
 type GetMetadataParam struct {
-	Address              *github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
-	MetadataHash         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=MetadataHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"MetadataHash,omitempty"`
+	Address      *github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	MetadataHash *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=MetadataHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"MetadataHash,omitempty"`
+	// The hash of a contract's deployed code for which metadata was registered when deploying any contract with that code
+	CodeHash             *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
 	XXX_unrecognized     []byte                                         `json:"-"`
 	XXX_sizecache        int32                                          `json:"-"`
@@ -135,7 +228,7 @@ func (m *GetMetadataParam) Reset()         { *m = GetMetadataParam{} }
 func (m *GetMetadataParam) String() string { return proto.CompactTextString(m) }
 func (*GetMetadataParam) ProtoMessage()    {}
 func (*GetMetadataParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{2}
+	return fileDescriptor_88e25d9b99e39f02, []int{3}
 }
 func (m *GetMetadataParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMetadataParam.Unmarshal(m, b)
@@ -160,17 +253,29 @@ func (*GetMetadataParam) XXX_MessageName() string {
 }
 
 type MetadataResult struct {
-	Metadata             string   `protobuf:"bytes,1,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Metadata string `protobuf:"bytes,1,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+	// The following are decoded from Metadata when it was produced by burrow deploy
+	ContractName    string `protobuf:"bytes,2,opt,name=ContractName,proto3" json:"ContractName,omitempty"`
+	SourceFile      string `protobuf:"bytes,3,opt,name=SourceFile,proto3" json:"SourceFile,omitempty"`
+	CompilerVersion string `protobuf:"bytes,4,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+	// The keccak256 hash of the source file
+	SourceHash string `protobuf:"bytes,5,opt,name=SourceHash,proto3" json:"SourceHash,omitempty"`
+	// The contract ABI as JSON
+	Abi string `protobuf:"bytes,6,opt,name=Abi,proto3" json:"Abi,omitempty"`
+	// The hash of the code the metadata describes
+	CodeHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,7,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	// The transaction that deployed the contract (or for a code hash, the contract that registered the metadata)
+	Deployment           *acm.Deployment `protobuf:"bytes,8,opt,name=Deployment,proto3" json:"Deployment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *MetadataResult) Reset()         { *m = MetadataResult{} }
 func (m *MetadataResult) String() string { return proto.CompactTextString(m) }
 func (*MetadataResult) ProtoMessage()    {}
 func (*MetadataResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{3}
+	return fileDescriptor_88e25d9b99e39f02, []int{4}
 }
 func (m *MetadataResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MetadataResult.Unmarshal(m, b)
@@ -197,273 +302,1010 @@ func (m *MetadataResult) GetMetadata() string {
 	return ""
 }
 
-func (*MetadataResult) XXX_MessageName() string {
-	return "rpcquery.MetadataResult"
+func (m *MetadataResult) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
 }
 
-type GetStorageParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Key                  github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+func (m *MetadataResult) GetSourceFile() string {
+	if m != nil {
+		return m.SourceFile
+	}
+	return ""
 }
 
-func (m *GetStorageParam) Reset()         { *m = GetStorageParam{} }
-func (m *GetStorageParam) String() string { return proto.CompactTextString(m) }
-func (*GetStorageParam) ProtoMessage()    {}
-func (*GetStorageParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{4}
-}
-func (m *GetStorageParam) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStorageParam.Unmarshal(m, b)
-}
-func (m *GetStorageParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStorageParam.Marshal(b, m, deterministic)
-}
-func (m *GetStorageParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStorageParam.Merge(m, src)
+func (m *MetadataResult) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
 }
-func (m *GetStorageParam) XXX_Size() int {
-	return xxx_messageInfo_GetStorageParam.Size(m)
+
+func (m *MetadataResult) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
 }
-func (m *GetStorageParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStorageParam.DiscardUnknown(m)
+
+func (m *MetadataResult) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetStorageParam proto.InternalMessageInfo
+func (m *MetadataResult) GetDeployment() *acm.Deployment {
+	if m != nil {
+		return m.Deployment
+	}
+	return nil
+}
 
-func (*GetStorageParam) XXX_MessageName() string {
-	return "rpcquery.GetStorageParam"
+func (*MetadataResult) XXX_MessageName() string {
+	return "rpcquery.MetadataResult"
 }
 
-type StorageValue struct {
-	Value                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+type GetDisassemblyParam struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
 }
 
-func (m *StorageValue) Reset()         { *m = StorageValue{} }
-func (m *StorageValue) String() string { return proto.CompactTextString(m) }
-func (*StorageValue) ProtoMessage()    {}
-func (*StorageValue) Descriptor() ([]byte, []int) {
+func (m *GetDisassemblyParam) Reset()         { *m = GetDisassemblyParam{} }
+func (m *GetDisassemblyParam) String() string { return proto.CompactTextString(m) }
+func (*GetDisassemblyParam) ProtoMessage()    {}
+func (*GetDisassemblyParam) Descriptor() ([]byte, []int) {
 	return fileDescriptor_88e25d9b99e39f02, []int{5}
 }
-func (m *StorageValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StorageValue.Unmarshal(m, b)
+func (m *GetDisassemblyParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDisassemblyParam.Unmarshal(m, b)
 }
-func (m *StorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StorageValue.Marshal(b, m, deterministic)
+func (m *GetDisassemblyParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDisassemblyParam.Marshal(b, m, deterministic)
 }
-func (m *StorageValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StorageValue.Merge(m, src)
+func (m *GetDisassemblyParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDisassemblyParam.Merge(m, src)
 }
-func (m *StorageValue) XXX_Size() int {
-	return xxx_messageInfo_StorageValue.Size(m)
+func (m *GetDisassemblyParam) XXX_Size() int {
+	return xxx_messageInfo_GetDisassemblyParam.Size(m)
 }
-func (m *StorageValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_StorageValue.DiscardUnknown(m)
+func (m *GetDisassemblyParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDisassemblyParam.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StorageValue proto.InternalMessageInfo
+var xxx_messageInfo_GetDisassemblyParam proto.InternalMessageInfo
 
-func (*StorageValue) XXX_MessageName() string {
-	return "rpcquery.StorageValue"
+func (*GetDisassemblyParam) XXX_MessageName() string {
+	return "rpcquery.GetDisassemblyParam"
 }
 
-type ListAccountsParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Disassembly struct {
+	Instructions []*Instruction `protobuf:"bytes,1,rep,name=Instructions,proto3" json:"Instructions,omitempty"`
+	// Solidity metadata appended to the code, which is not disassembled
+	Metadata github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Metadata,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Metadata"`
+	CodeHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	// The table by which the contract dispatches calls to its public functions
+	Selectors            []*FunctionSelector `protobuf:"bytes,4,rep,name=Selectors,proto3" json:"Selectors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ListAccountsParam) Reset()         { *m = ListAccountsParam{} }
-func (m *ListAccountsParam) String() string { return proto.CompactTextString(m) }
-func (*ListAccountsParam) ProtoMessage()    {}
-func (*ListAccountsParam) Descriptor() ([]byte, []int) {
+func (m *Disassembly) Reset()         { *m = Disassembly{} }
+func (m *Disassembly) String() string { return proto.CompactTextString(m) }
+func (*Disassembly) ProtoMessage()    {}
+func (*Disassembly) Descriptor() ([]byte, []int) {
 	return fileDescriptor_88e25d9b99e39f02, []int{6}
 }
-func (m *ListAccountsParam) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListAccountsParam.Unmarshal(m, b)
+func (m *Disassembly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Disassembly.Unmarshal(m, b)
 }
-func (m *ListAccountsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListAccountsParam.Marshal(b, m, deterministic)
+func (m *Disassembly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Disassembly.Marshal(b, m, deterministic)
 }
-func (m *ListAccountsParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListAccountsParam.Merge(m, src)
+func (m *Disassembly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Disassembly.Merge(m, src)
 }
-func (m *ListAccountsParam) XXX_Size() int {
-	return xxx_messageInfo_ListAccountsParam.Size(m)
+func (m *Disassembly) XXX_Size() int {
+	return xxx_messageInfo_Disassembly.Size(m)
 }
-func (m *ListAccountsParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListAccountsParam.DiscardUnknown(m)
+func (m *Disassembly) XXX_DiscardUnknown() {
+	xxx_messageInfo_Disassembly.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListAccountsParam proto.InternalMessageInfo
+var xxx_messageInfo_Disassembly proto.InternalMessageInfo
 
-func (m *ListAccountsParam) GetQuery() string {
+func (m *Disassembly) GetInstructions() []*Instruction {
 	if m != nil {
-		return m.Query
+		return m.Instructions
 	}
-	return ""
+	return nil
 }
 
-func (*ListAccountsParam) XXX_MessageName() string {
-	return "rpcquery.ListAccountsParam"
+func (m *Disassembly) GetSelectors() []*FunctionSelector {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
 }
 
-type GetNameParam struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+func (*Disassembly) XXX_MessageName() string {
+	return "rpcquery.Disassembly"
+}
+
+type FunctionSelector struct {
+	Selector github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Selector,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Selector"`
+	// Offset of the JUMPDEST to which calls with the selector are dispatched
+	Destination uint64 `protobuf:"varint,2,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	// The label of that JUMPDEST
+	Label string `protobuf:"bytes,3,opt,name=Label,proto3" json:"Label,omitempty"`
+	// The signature of the function where the contract's ABI is known
+	Signature            string   `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetNameParam) Reset()         { *m = GetNameParam{} }
-func (m *GetNameParam) String() string { return proto.CompactTextString(m) }
-func (*GetNameParam) ProtoMessage()    {}
-func (*GetNameParam) Descriptor() ([]byte, []int) {
+func (m *FunctionSelector) Reset()         { *m = FunctionSelector{} }
+func (m *FunctionSelector) String() string { return proto.CompactTextString(m) }
+func (*FunctionSelector) ProtoMessage()    {}
+func (*FunctionSelector) Descriptor() ([]byte, []int) {
 	return fileDescriptor_88e25d9b99e39f02, []int{7}
 }
-func (m *GetNameParam) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetNameParam.Unmarshal(m, b)
+func (m *FunctionSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionSelector.Unmarshal(m, b)
 }
-func (m *GetNameParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetNameParam.Marshal(b, m, deterministic)
+func (m *FunctionSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionSelector.Marshal(b, m, deterministic)
 }
-func (m *GetNameParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNameParam.Merge(m, src)
+func (m *FunctionSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionSelector.Merge(m, src)
 }
-func (m *GetNameParam) XXX_Size() int {
-	return xxx_messageInfo_GetNameParam.Size(m)
+func (m *FunctionSelector) XXX_Size() int {
+	return xxx_messageInfo_FunctionSelector.Size(m)
 }
-func (m *GetNameParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNameParam.DiscardUnknown(m)
+func (m *FunctionSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionSelector.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNameParam proto.InternalMessageInfo
+var xxx_messageInfo_FunctionSelector proto.InternalMessageInfo
 
-func (m *GetNameParam) GetName() string {
+func (m *FunctionSelector) GetDestination() uint64 {
 	if m != nil {
-		return m.Name
+		return m.Destination
+	}
+	return 0
+}
+
+func (m *FunctionSelector) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
 	return ""
 }
 
-func (*GetNameParam) XXX_MessageName() string {
-	return "rpcquery.GetNameParam"
+func (m *FunctionSelector) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-type ListNamesParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+func (*FunctionSelector) XXX_MessageName() string {
+	return "rpcquery.FunctionSelector"
+}
+
+type GetCodeParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Whether to include the disassembly of EVM code
+	Disassemble          bool     `protobuf:"varint,2,opt,name=Disassemble,proto3" json:"Disassemble,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListNamesParam) Reset()         { *m = ListNamesParam{} }
-func (m *ListNamesParam) String() string { return proto.CompactTextString(m) }
-func (*ListNamesParam) ProtoMessage()    {}
-func (*ListNamesParam) Descriptor() ([]byte, []int) {
+func (m *GetCodeParam) Reset()         { *m = GetCodeParam{} }
+func (m *GetCodeParam) String() string { return proto.CompactTextString(m) }
+func (*GetCodeParam) ProtoMessage()    {}
+func (*GetCodeParam) Descriptor() ([]byte, []int) {
 	return fileDescriptor_88e25d9b99e39f02, []int{8}
 }
-func (m *ListNamesParam) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListNamesParam.Unmarshal(m, b)
+func (m *GetCodeParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodeParam.Unmarshal(m, b)
 }
-func (m *ListNamesParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListNamesParam.Marshal(b, m, deterministic)
+func (m *GetCodeParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodeParam.Marshal(b, m, deterministic)
 }
-func (m *ListNamesParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListNamesParam.Merge(m, src)
+func (m *GetCodeParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodeParam.Merge(m, src)
 }
-func (m *ListNamesParam) XXX_Size() int {
-	return xxx_messageInfo_ListNamesParam.Size(m)
+func (m *GetCodeParam) XXX_Size() int {
+	return xxx_messageInfo_GetCodeParam.Size(m)
 }
-func (m *ListNamesParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListNamesParam.DiscardUnknown(m)
+func (m *GetCodeParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodeParam.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListNamesParam proto.InternalMessageInfo
+var xxx_messageInfo_GetCodeParam proto.InternalMessageInfo
 
-func (m *ListNamesParam) GetQuery() string {
+func (m *GetCodeParam) GetDisassemble() bool {
 	if m != nil {
-		return m.Query
+		return m.Disassemble
 	}
-	return ""
+	return false
 }
 
-func (*ListNamesParam) XXX_MessageName() string {
-	return "rpcquery.ListNamesParam"
+func (*GetCodeParam) XXX_MessageName() string {
+	return "rpcquery.GetCodeParam"
 }
 
-type GetNetworkRegistryParam struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Code struct {
+	// The EVM or WASM code deployed at the address
+	Code                 github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Code,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Code"`
+	CodeHash             github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	WASM                 bool                                          `protobuf:"varint,3,opt,name=WASM,proto3" json:"WASM,omitempty"`
+	Disassembly          *Disassembly                                  `protobuf:"bytes,4,opt,name=Disassembly,proto3" json:"Disassembly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *GetNetworkRegistryParam) Reset()         { *m = GetNetworkRegistryParam{} }
-func (m *GetNetworkRegistryParam) String() string { return proto.CompactTextString(m) }
-func (*GetNetworkRegistryParam) ProtoMessage()    {}
-func (*GetNetworkRegistryParam) Descriptor() ([]byte, []int) {
+func (m *Code) Reset()         { *m = Code{} }
+func (m *Code) String() string { return proto.CompactTextString(m) }
+func (*Code) ProtoMessage()    {}
+func (*Code) Descriptor() ([]byte, []int) {
 	return fileDescriptor_88e25d9b99e39f02, []int{9}
 }
-func (m *GetNetworkRegistryParam) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetNetworkRegistryParam.Unmarshal(m, b)
+func (m *Code) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Code.Unmarshal(m, b)
 }
-func (m *GetNetworkRegistryParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetNetworkRegistryParam.Marshal(b, m, deterministic)
+func (m *Code) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Code.Marshal(b, m, deterministic)
 }
-func (m *GetNetworkRegistryParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNetworkRegistryParam.Merge(m, src)
+func (m *Code) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Code.Merge(m, src)
 }
-func (m *GetNetworkRegistryParam) XXX_Size() int {
-	return xxx_messageInfo_GetNetworkRegistryParam.Size(m)
+func (m *Code) XXX_Size() int {
+	return xxx_messageInfo_Code.Size(m)
 }
-func (m *GetNetworkRegistryParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNetworkRegistryParam.DiscardUnknown(m)
+func (m *Code) XXX_DiscardUnknown() {
+	xxx_messageInfo_Code.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNetworkRegistryParam proto.InternalMessageInfo
+var xxx_messageInfo_Code proto.InternalMessageInfo
 
-func (*GetNetworkRegistryParam) XXX_MessageName() string {
-	return "rpcquery.GetNetworkRegistryParam"
+func (m *Code) GetWASM() bool {
+	if m != nil {
+		return m.WASM
+	}
+	return false
 }
 
-type GetValidatorSetParam struct {
+func (m *Code) GetDisassembly() *Disassembly {
+	if m != nil {
+		return m.Disassembly
+	}
+	return nil
+}
+
+func (*Code) XXX_MessageName() string {
+	return "rpcquery.Code"
+}
+
+type Instruction struct {
+	// Offset of the instruction in the code
+	PC     uint64 `protobuf:"varint,1,opt,name=PC,proto3" json:"PC,omitempty"`
+	OpCode string `protobuf:"bytes,2,opt,name=OpCode,proto3" json:"OpCode,omitempty"`
+	// The bytes pushed by a PUSH<N>
+	Immediate github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Immediate,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Immediate"`
+	// Notes on the instruction's role, including the name of dispatched functions where the contract's ABI is known
+	Annotation string `protobuf:"bytes,4,opt,name=Annotation,proto3" json:"Annotation,omitempty"`
+	// The label of a JUMPDEST by which jumps to it are annotated
+	Label                string   `protobuf:"bytes,5,opt,name=Label,proto3" json:"Label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetValidatorSetParam) Reset()         { *m = GetValidatorSetParam{} }
-func (m *GetValidatorSetParam) String() string { return proto.CompactTextString(m) }
-func (*GetValidatorSetParam) ProtoMessage()    {}
-func (*GetValidatorSetParam) Descriptor() ([]byte, []int) {
+func (m *Instruction) Reset()         { *m = Instruction{} }
+func (m *Instruction) String() string { return proto.CompactTextString(m) }
+func (*Instruction) ProtoMessage()    {}
+func (*Instruction) Descriptor() ([]byte, []int) {
 	return fileDescriptor_88e25d9b99e39f02, []int{10}
 }
-func (m *GetValidatorSetParam) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetValidatorSetParam.Unmarshal(m, b)
+func (m *Instruction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Instruction.Unmarshal(m, b)
 }
-func (m *GetValidatorSetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Instruction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Instruction.Marshal(b, m, deterministic)
+}
+func (m *Instruction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instruction.Merge(m, src)
+}
+func (m *Instruction) XXX_Size() int {
+	return xxx_messageInfo_Instruction.Size(m)
+}
+func (m *Instruction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instruction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instruction proto.InternalMessageInfo
+
+func (m *Instruction) GetPC() uint64 {
+	if m != nil {
+		return m.PC
+	}
+	return 0
+}
+
+func (m *Instruction) GetOpCode() string {
+	if m != nil {
+		return m.OpCode
+	}
+	return ""
+}
+
+func (m *Instruction) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *Instruction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (*Instruction) XXX_MessageName() string {
+	return "rpcquery.Instruction"
+}
+
+type GetStorageParam struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key                  github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GetStorageParam) Reset()         { *m = GetStorageParam{} }
+func (m *GetStorageParam) String() string { return proto.CompactTextString(m) }
+func (*GetStorageParam) ProtoMessage()    {}
+func (*GetStorageParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{11}
+}
+func (m *GetStorageParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageParam.Unmarshal(m, b)
+}
+func (m *GetStorageParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageParam.Marshal(b, m, deterministic)
+}
+func (m *GetStorageParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageParam.Merge(m, src)
+}
+func (m *GetStorageParam) XXX_Size() int {
+	return xxx_messageInfo_GetStorageParam.Size(m)
+}
+func (m *GetStorageParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageParam proto.InternalMessageInfo
+
+func (*GetStorageParam) XXX_MessageName() string {
+	return "rpcquery.GetStorageParam"
+}
+
+type StorageValue struct {
+	Value                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageValue) Reset()         { *m = StorageValue{} }
+func (m *StorageValue) String() string { return proto.CompactTextString(m) }
+func (*StorageValue) ProtoMessage()    {}
+func (*StorageValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{12}
+}
+func (m *StorageValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageValue.Unmarshal(m, b)
+}
+func (m *StorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageValue.Marshal(b, m, deterministic)
+}
+func (m *StorageValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageValue.Merge(m, src)
+}
+func (m *StorageValue) XXX_Size() int {
+	return xxx_messageInfo_StorageValue.Size(m)
+}
+func (m *StorageValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageValue proto.InternalMessageInfo
+
+func (*StorageValue) XXX_MessageName() string {
+	return "rpcquery.StorageValue"
+}
+
+type GetStorageRangeParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Storage is returned starting after this key if given, so the Next key of a previous page fetches the page after it
+	After *github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"After,omitempty"`
+	// The most storage slots to return, at most MaxStorageRangeSize and that many if zero
+	Limit uint64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// The height of the state to read, the latest if zero. Pages read at the same height see the same storage.
+	Height               uint64   `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStorageRangeParam) Reset()         { *m = GetStorageRangeParam{} }
+func (m *GetStorageRangeParam) String() string { return proto.CompactTextString(m) }
+func (*GetStorageRangeParam) ProtoMessage()    {}
+func (*GetStorageRangeParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{13}
+}
+func (m *GetStorageRangeParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageRangeParam.Unmarshal(m, b)
+}
+func (m *GetStorageRangeParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageRangeParam.Marshal(b, m, deterministic)
+}
+func (m *GetStorageRangeParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageRangeParam.Merge(m, src)
+}
+func (m *GetStorageRangeParam) XXX_Size() int {
+	return xxx_messageInfo_GetStorageRangeParam.Size(m)
+}
+func (m *GetStorageRangeParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageRangeParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageRangeParam proto.InternalMessageInfo
+
+func (m *GetStorageRangeParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetStorageRangeParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*GetStorageRangeParam) XXX_MessageName() string {
+	return "rpcquery.GetStorageRangeParam"
+}
+
+type StorageRange struct {
+	// The height of the state read
+	Height  uint64          `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Entries []*StorageEntry `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	// The key to pass as After to fetch the next page, unset if there are no more
+	Next                 *github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,opt,name=Next,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageRange) Reset()         { *m = StorageRange{} }
+func (m *StorageRange) String() string { return proto.CompactTextString(m) }
+func (*StorageRange) ProtoMessage()    {}
+func (*StorageRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{14}
+}
+func (m *StorageRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageRange.Unmarshal(m, b)
+}
+func (m *StorageRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageRange.Marshal(b, m, deterministic)
+}
+func (m *StorageRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageRange.Merge(m, src)
+}
+func (m *StorageRange) XXX_Size() int {
+	return xxx_messageInfo_StorageRange.Size(m)
+}
+func (m *StorageRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageRange proto.InternalMessageInfo
+
+func (m *StorageRange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StorageRange) GetEntries() []*StorageEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (*StorageRange) XXX_MessageName() string {
+	return "rpcquery.StorageRange"
+}
+
+type StorageEntry struct {
+	Key                  github_com_hyperledger_burrow_binary.Word256  `protobuf:"bytes,1,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Value                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageEntry) Reset()         { *m = StorageEntry{} }
+func (m *StorageEntry) String() string { return proto.CompactTextString(m) }
+func (*StorageEntry) ProtoMessage()    {}
+func (*StorageEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{15}
+}
+func (m *StorageEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageEntry.Unmarshal(m, b)
+}
+func (m *StorageEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageEntry.Marshal(b, m, deterministic)
+}
+func (m *StorageEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageEntry.Merge(m, src)
+}
+func (m *StorageEntry) XXX_Size() int {
+	return xxx_messageInfo_StorageEntry.Size(m)
+}
+func (m *StorageEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageEntry proto.InternalMessageInfo
+
+func (*StorageEntry) XXX_MessageName() string {
+	return "rpcquery.StorageEntry"
+}
+
+type GetStateBatchParam struct {
+	// The accounts to return
+	Accounts []github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,rep,name=Accounts,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Accounts"`
+	// The storage slots whose values to return
+	Storage []*GetStorageParam `protobuf:"bytes,2,rep,name=Storage,proto3" json:"Storage,omitempty"`
+	// The height of the state to read, the latest if zero
+	Height               uint64   `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateBatchParam) Reset()         { *m = GetStateBatchParam{} }
+func (m *GetStateBatchParam) String() string { return proto.CompactTextString(m) }
+func (*GetStateBatchParam) ProtoMessage()    {}
+func (*GetStateBatchParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{16}
+}
+func (m *GetStateBatchParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateBatchParam.Unmarshal(m, b)
+}
+func (m *GetStateBatchParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateBatchParam.Marshal(b, m, deterministic)
+}
+func (m *GetStateBatchParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateBatchParam.Merge(m, src)
+}
+func (m *GetStateBatchParam) XXX_Size() int {
+	return xxx_messageInfo_GetStateBatchParam.Size(m)
+}
+func (m *GetStateBatchParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateBatchParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateBatchParam proto.InternalMessageInfo
+
+func (m *GetStateBatchParam) GetStorage() []*GetStorageParam {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *GetStateBatchParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*GetStateBatchParam) XXX_MessageName() string {
+	return "rpcquery.GetStateBatchParam"
+}
+
+type StateBatch struct {
+	// The height of the state read
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The account at each requested address in order, with an empty account for any that do not exist
+	Accounts []*acm.Account `protobuf:"bytes,2,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+	// The value of each requested storage slot in order, empty for any that is unset
+	Storage              []*StorageValue `protobuf:"bytes,3,rep,name=Storage,proto3" json:"Storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StateBatch) Reset()         { *m = StateBatch{} }
+func (m *StateBatch) String() string { return proto.CompactTextString(m) }
+func (*StateBatch) ProtoMessage()    {}
+func (*StateBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{17}
+}
+func (m *StateBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateBatch.Unmarshal(m, b)
+}
+func (m *StateBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateBatch.Marshal(b, m, deterministic)
+}
+func (m *StateBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateBatch.Merge(m, src)
+}
+func (m *StateBatch) XXX_Size() int {
+	return xxx_messageInfo_StateBatch.Size(m)
+}
+func (m *StateBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateBatch proto.InternalMessageInfo
+
+func (m *StateBatch) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StateBatch) GetAccounts() []*acm.Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *StateBatch) GetStorage() []*StorageValue {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (*StateBatch) XXX_MessageName() string {
+	return "rpcquery.StateBatch"
+}
+
+type ListAccountsParam struct {
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// The fields of each account to return (e.g. Address, Balance), all fields are returned if none are given
+	Fields []string `protobuf:"bytes,2,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// Accounts are returned in order of address, starting after this address if given. Passing the address of the last
+	// account returned by a previous call as a cursor returns the next page of accounts.
+	After *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,3,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"After,omitempty"`
+	// The maximum number of accounts to return, all are returned if zero
+	Limit                uint64   `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsParam) Reset()         { *m = ListAccountsParam{} }
+func (m *ListAccountsParam) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsParam) ProtoMessage()    {}
+func (*ListAccountsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{18}
+}
+func (m *ListAccountsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsParam.Unmarshal(m, b)
+}
+func (m *ListAccountsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsParam.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsParam.Merge(m, src)
+}
+func (m *ListAccountsParam) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsParam.Size(m)
+}
+func (m *ListAccountsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsParam proto.InternalMessageInfo
+
+func (m *ListAccountsParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListAccountsParam) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListAccountsParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListAccountsParam) XXX_MessageName() string {
+	return "rpcquery.ListAccountsParam"
+}
+
+type GetNameParam struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNameParam) Reset()         { *m = GetNameParam{} }
+func (m *GetNameParam) String() string { return proto.CompactTextString(m) }
+func (*GetNameParam) ProtoMessage()    {}
+func (*GetNameParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{19}
+}
+func (m *GetNameParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNameParam.Unmarshal(m, b)
+}
+func (m *GetNameParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNameParam.Marshal(b, m, deterministic)
+}
+func (m *GetNameParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNameParam.Merge(m, src)
+}
+func (m *GetNameParam) XXX_Size() int {
+	return xxx_messageInfo_GetNameParam.Size(m)
+}
+func (m *GetNameParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNameParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNameParam proto.InternalMessageInfo
+
+func (m *GetNameParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (*GetNameParam) XXX_MessageName() string {
+	return "rpcquery.GetNameParam"
+}
+
+type ListNamesParam struct {
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Names are returned in lexicographic order, starting after this name if given. Passing the last name returned by a
+	// previous call as a cursor returns the next page of names.
+	After string `protobuf:"bytes,2,opt,name=After,proto3" json:"After,omitempty"`
+	// The maximum number of names to return, all are returned if zero
+	Limit                uint64   `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNamesParam) Reset()         { *m = ListNamesParam{} }
+func (m *ListNamesParam) String() string { return proto.CompactTextString(m) }
+func (*ListNamesParam) ProtoMessage()    {}
+func (*ListNamesParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{20}
+}
+func (m *ListNamesParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNamesParam.Unmarshal(m, b)
+}
+func (m *ListNamesParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNamesParam.Marshal(b, m, deterministic)
+}
+func (m *ListNamesParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamesParam.Merge(m, src)
+}
+func (m *ListNamesParam) XXX_Size() int {
+	return xxx_messageInfo_ListNamesParam.Size(m)
+}
+func (m *ListNamesParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamesParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamesParam proto.InternalMessageInfo
+
+func (m *ListNamesParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListNamesParam) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *ListNamesParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListNamesParam) XXX_MessageName() string {
+	return "rpcquery.ListNamesParam"
+}
+
+type GetNetworkRegistryParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNetworkRegistryParam) Reset()         { *m = GetNetworkRegistryParam{} }
+func (m *GetNetworkRegistryParam) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkRegistryParam) ProtoMessage()    {}
+func (*GetNetworkRegistryParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{21}
+}
+func (m *GetNetworkRegistryParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkRegistryParam.Unmarshal(m, b)
+}
+func (m *GetNetworkRegistryParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkRegistryParam.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkRegistryParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkRegistryParam.Merge(m, src)
+}
+func (m *GetNetworkRegistryParam) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkRegistryParam.Size(m)
+}
+func (m *GetNetworkRegistryParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkRegistryParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNetworkRegistryParam proto.InternalMessageInfo
+
+func (*GetNetworkRegistryParam) XXX_MessageName() string {
+	return "rpcquery.GetNetworkRegistryParam"
+}
+
+type GetValidatorSetParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetValidatorSetParam) Reset()         { *m = GetValidatorSetParam{} }
+func (m *GetValidatorSetParam) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorSetParam) ProtoMessage()    {}
+func (*GetValidatorSetParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{22}
+}
+func (m *GetValidatorSetParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorSetParam.Unmarshal(m, b)
+}
+func (m *GetValidatorSetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetValidatorSetParam.Marshal(b, m, deterministic)
 }
-func (m *GetValidatorSetParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValidatorSetParam.Merge(m, src)
+func (m *GetValidatorSetParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorSetParam.Merge(m, src)
+}
+func (m *GetValidatorSetParam) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorSetParam.Size(m)
+}
+func (m *GetValidatorSetParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorSetParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorSetParam proto.InternalMessageInfo
+
+func (*GetValidatorSetParam) XXX_MessageName() string {
+	return "rpcquery.GetValidatorSetParam"
+}
+
+type GetHeartbeatParam struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GetHeartbeatParam) Reset()         { *m = GetHeartbeatParam{} }
+func (m *GetHeartbeatParam) String() string { return proto.CompactTextString(m) }
+func (*GetHeartbeatParam) ProtoMessage()    {}
+func (*GetHeartbeatParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{23}
+}
+func (m *GetHeartbeatParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHeartbeatParam.Unmarshal(m, b)
+}
+func (m *GetHeartbeatParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHeartbeatParam.Marshal(b, m, deterministic)
+}
+func (m *GetHeartbeatParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHeartbeatParam.Merge(m, src)
+}
+func (m *GetHeartbeatParam) XXX_Size() int {
+	return xxx_messageInfo_GetHeartbeatParam.Size(m)
+}
+func (m *GetHeartbeatParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHeartbeatParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHeartbeatParam proto.InternalMessageInfo
+
+func (*GetHeartbeatParam) XXX_MessageName() string {
+	return "rpcquery.GetHeartbeatParam"
+}
+
+type ListHeartbeatsParam struct {
+	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHeartbeatsParam) Reset()         { *m = ListHeartbeatsParam{} }
+func (m *ListHeartbeatsParam) String() string { return proto.CompactTextString(m) }
+func (*ListHeartbeatsParam) ProtoMessage()    {}
+func (*ListHeartbeatsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{24}
 }
-func (m *GetValidatorSetParam) XXX_Size() int {
-	return xxx_messageInfo_GetValidatorSetParam.Size(m)
+func (m *ListHeartbeatsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHeartbeatsParam.Unmarshal(m, b)
 }
-func (m *GetValidatorSetParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetValidatorSetParam.DiscardUnknown(m)
+func (m *ListHeartbeatsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHeartbeatsParam.Marshal(b, m, deterministic)
+}
+func (m *ListHeartbeatsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHeartbeatsParam.Merge(m, src)
+}
+func (m *ListHeartbeatsParam) XXX_Size() int {
+	return xxx_messageInfo_ListHeartbeatsParam.Size(m)
+}
+func (m *ListHeartbeatsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHeartbeatsParam.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetValidatorSetParam proto.InternalMessageInfo
+var xxx_messageInfo_ListHeartbeatsParam proto.InternalMessageInfo
 
-func (*GetValidatorSetParam) XXX_MessageName() string {
-	return "rpcquery.GetValidatorSetParam"
+func (m *ListHeartbeatsParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (*ListHeartbeatsParam) XXX_MessageName() string {
+	return "rpcquery.ListHeartbeatsParam"
 }
 
 type GetValidatorSetHistoryParam struct {
@@ -478,7 +1320,7 @@ func (m *GetValidatorSetHistoryParam) Reset()         { *m = GetValidatorSetHist
 func (m *GetValidatorSetHistoryParam) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorSetHistoryParam) ProtoMessage()    {}
 func (*GetValidatorSetHistoryParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{11}
+	return fileDescriptor_88e25d9b99e39f02, []int{25}
 }
 func (m *GetValidatorSetHistoryParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetValidatorSetHistoryParam.Unmarshal(m, b)
@@ -520,7 +1362,7 @@ func (m *NetworkRegistry) Reset()         { *m = NetworkRegistry{} }
 func (m *NetworkRegistry) String() string { return proto.CompactTextString(m) }
 func (*NetworkRegistry) ProtoMessage()    {}
 func (*NetworkRegistry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{12}
+	return fileDescriptor_88e25d9b99e39f02, []int{26}
 }
 func (m *NetworkRegistry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkRegistry.Unmarshal(m, b)
@@ -563,7 +1405,7 @@ func (m *RegisteredValidator) Reset()         { *m = RegisteredValidator{} }
 func (m *RegisteredValidator) String() string { return proto.CompactTextString(m) }
 func (*RegisteredValidator) ProtoMessage()    {}
 func (*RegisteredValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{13}
+	return fileDescriptor_88e25d9b99e39f02, []int{27}
 }
 func (m *RegisteredValidator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisteredValidator.Unmarshal(m, b)
@@ -605,7 +1447,7 @@ func (m *ValidatorSetHistory) Reset()         { *m = ValidatorSetHistory{} }
 func (m *ValidatorSetHistory) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetHistory) ProtoMessage()    {}
 func (*ValidatorSetHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{14}
+	return fileDescriptor_88e25d9b99e39f02, []int{28}
 }
 func (m *ValidatorSetHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidatorSetHistory.Unmarshal(m, b)
@@ -648,7 +1490,7 @@ func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()    {}
 func (*ValidatorSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{15}
+	return fileDescriptor_88e25d9b99e39f02, []int{29}
 }
 func (m *ValidatorSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidatorSet.Unmarshal(m, b)
@@ -697,7 +1539,7 @@ func (m *GetProposalParam) Reset()         { *m = GetProposalParam{} }
 func (m *GetProposalParam) String() string { return proto.CompactTextString(m) }
 func (*GetProposalParam) ProtoMessage()    {}
 func (*GetProposalParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{16}
+	return fileDescriptor_88e25d9b99e39f02, []int{30}
 }
 func (m *GetProposalParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProposalParam.Unmarshal(m, b)
@@ -739,7 +1581,7 @@ func (m *ListProposalsParam) Reset()         { *m = ListProposalsParam{} }
 func (m *ListProposalsParam) String() string { return proto.CompactTextString(m) }
 func (*ListProposalsParam) ProtoMessage()    {}
 func (*ListProposalsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{17}
+	return fileDescriptor_88e25d9b99e39f02, []int{31}
 }
 func (m *ListProposalsParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProposalsParam.Unmarshal(m, b)
@@ -782,7 +1624,7 @@ func (m *ProposalResult) Reset()         { *m = ProposalResult{} }
 func (m *ProposalResult) String() string { return proto.CompactTextString(m) }
 func (*ProposalResult) ProtoMessage()    {}
 func (*ProposalResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{18}
+	return fileDescriptor_88e25d9b99e39f02, []int{32}
 }
 func (m *ProposalResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProposalResult.Unmarshal(m, b)
@@ -820,6 +1662,411 @@ func (*ProposalResult) XXX_MessageName() string {
 	return "rpcquery.ProposalResult"
 }
 
+type ListScheduledGovTxsParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListScheduledGovTxsParam) Reset()         { *m = ListScheduledGovTxsParam{} }
+func (m *ListScheduledGovTxsParam) String() string { return proto.CompactTextString(m) }
+func (*ListScheduledGovTxsParam) ProtoMessage()    {}
+func (*ListScheduledGovTxsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{33}
+}
+func (m *ListScheduledGovTxsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListScheduledGovTxsParam.Unmarshal(m, b)
+}
+func (m *ListScheduledGovTxsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListScheduledGovTxsParam.Marshal(b, m, deterministic)
+}
+func (m *ListScheduledGovTxsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListScheduledGovTxsParam.Merge(m, src)
+}
+func (m *ListScheduledGovTxsParam) XXX_Size() int {
+	return xxx_messageInfo_ListScheduledGovTxsParam.Size(m)
+}
+func (m *ListScheduledGovTxsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListScheduledGovTxsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListScheduledGovTxsParam proto.InternalMessageInfo
+
+func (*ListScheduledGovTxsParam) XXX_MessageName() string {
+	return "rpcquery.ListScheduledGovTxsParam"
+}
+
+type GetEscrowParam struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEscrowParam) Reset()         { *m = GetEscrowParam{} }
+func (m *GetEscrowParam) String() string { return proto.CompactTextString(m) }
+func (*GetEscrowParam) ProtoMessage()    {}
+func (*GetEscrowParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{34}
+}
+func (m *GetEscrowParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEscrowParam.Unmarshal(m, b)
+}
+func (m *GetEscrowParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEscrowParam.Marshal(b, m, deterministic)
+}
+func (m *GetEscrowParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEscrowParam.Merge(m, src)
+}
+func (m *GetEscrowParam) XXX_Size() int {
+	return xxx_messageInfo_GetEscrowParam.Size(m)
+}
+func (m *GetEscrowParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEscrowParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEscrowParam proto.InternalMessageInfo
+
+func (m *GetEscrowParam) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (*GetEscrowParam) XXX_MessageName() string {
+	return "rpcquery.GetEscrowParam"
+}
+
+type ListEscrowsParam struct {
+	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEscrowsParam) Reset()         { *m = ListEscrowsParam{} }
+func (m *ListEscrowsParam) String() string { return proto.CompactTextString(m) }
+func (*ListEscrowsParam) ProtoMessage()    {}
+func (*ListEscrowsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{35}
+}
+func (m *ListEscrowsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEscrowsParam.Unmarshal(m, b)
+}
+func (m *ListEscrowsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEscrowsParam.Marshal(b, m, deterministic)
+}
+func (m *ListEscrowsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEscrowsParam.Merge(m, src)
+}
+func (m *ListEscrowsParam) XXX_Size() int {
+	return xxx_messageInfo_ListEscrowsParam.Size(m)
+}
+func (m *ListEscrowsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEscrowsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEscrowsParam proto.InternalMessageInfo
+
+func (m *ListEscrowsParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (*ListEscrowsParam) XXX_MessageName() string {
+	return "rpcquery.ListEscrowsParam"
+}
+
+type GetLogSequenceParam struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GetLogSequenceParam) Reset()         { *m = GetLogSequenceParam{} }
+func (m *GetLogSequenceParam) String() string { return proto.CompactTextString(m) }
+func (*GetLogSequenceParam) ProtoMessage()    {}
+func (*GetLogSequenceParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{36}
+}
+func (m *GetLogSequenceParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogSequenceParam.Unmarshal(m, b)
+}
+func (m *GetLogSequenceParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogSequenceParam.Marshal(b, m, deterministic)
+}
+func (m *GetLogSequenceParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogSequenceParam.Merge(m, src)
+}
+func (m *GetLogSequenceParam) XXX_Size() int {
+	return xxx_messageInfo_GetLogSequenceParam.Size(m)
+}
+func (m *GetLogSequenceParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogSequenceParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogSequenceParam proto.InternalMessageInfo
+
+func (*GetLogSequenceParam) XXX_MessageName() string {
+	return "rpcquery.GetLogSequenceParam"
+}
+
+type LogSequence struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Zero if the address has emitted no logs
+	Sequence             uint64   `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogSequence) Reset()         { *m = LogSequence{} }
+func (m *LogSequence) String() string { return proto.CompactTextString(m) }
+func (*LogSequence) ProtoMessage()    {}
+func (*LogSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{37}
+}
+func (m *LogSequence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogSequence.Unmarshal(m, b)
+}
+func (m *LogSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogSequence.Marshal(b, m, deterministic)
+}
+func (m *LogSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSequence.Merge(m, src)
+}
+func (m *LogSequence) XXX_Size() int {
+	return xxx_messageInfo_LogSequence.Size(m)
+}
+func (m *LogSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSequence proto.InternalMessageInfo
+
+func (m *LogSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (*LogSequence) XXX_MessageName() string {
+	return "rpcquery.LogSequence"
+}
+
+type GetAccountActivityParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Return activity following the transaction with this hash (the Next of the previous page)
+	After github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"After"`
+	// The maximum number of activities to return (at most MaxAccountActivity, which is used if zero)
+	Limit uint64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Return the most recent activity first
+	Descending           bool     `protobuf:"varint,4,opt,name=Descending,proto3" json:"Descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountActivityParam) Reset()         { *m = GetAccountActivityParam{} }
+func (m *GetAccountActivityParam) String() string { return proto.CompactTextString(m) }
+func (*GetAccountActivityParam) ProtoMessage()    {}
+func (*GetAccountActivityParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{38}
+}
+func (m *GetAccountActivityParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountActivityParam.Unmarshal(m, b)
+}
+func (m *GetAccountActivityParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountActivityParam.Marshal(b, m, deterministic)
+}
+func (m *GetAccountActivityParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountActivityParam.Merge(m, src)
+}
+func (m *GetAccountActivityParam) XXX_Size() int {
+	return xxx_messageInfo_GetAccountActivityParam.Size(m)
+}
+func (m *GetAccountActivityParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountActivityParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountActivityParam proto.InternalMessageInfo
+
+func (m *GetAccountActivityParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAccountActivityParam) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (*GetAccountActivityParam) XXX_MessageName() string {
+	return "rpcquery.GetAccountActivityParam"
+}
+
+type AccountActivity struct {
+	Activities []*Activity `protobuf:"bytes,1,rep,name=Activities,proto3" json:"Activities,omitempty"`
+	// The hash of the last transaction returned if there may be more to fetch by passing it as After, otherwise empty
+	Next                 github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Next,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Next"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *AccountActivity) Reset()         { *m = AccountActivity{} }
+func (m *AccountActivity) String() string { return proto.CompactTextString(m) }
+func (*AccountActivity) ProtoMessage()    {}
+func (*AccountActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{39}
+}
+func (m *AccountActivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountActivity.Unmarshal(m, b)
+}
+func (m *AccountActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountActivity.Marshal(b, m, deterministic)
+}
+func (m *AccountActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountActivity.Merge(m, src)
+}
+func (m *AccountActivity) XXX_Size() int {
+	return xxx_messageInfo_AccountActivity.Size(m)
+}
+func (m *AccountActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountActivity proto.InternalMessageInfo
+
+func (m *AccountActivity) GetActivities() []*Activity {
+	if m != nil {
+		return m.Activities
+	}
+	return nil
+}
+
+func (*AccountActivity) XXX_MessageName() string {
+	return "rpcquery.AccountActivity"
+}
+
+// A transaction in which an account was involved
+type Activity struct {
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The time of the block, unset if the block is no longer held by this node
+	Time   time.Time                                      `protobuf:"bytes,2,opt,name=Time,proto3,stdtime" json:"Time"`
+	TxHash github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,3,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	TxType github_com_hyperledger_burrow_txs_payload.Type `protobuf:"varint,4,opt,name=TxType,proto3,casttype=github.com/hyperledger/burrow/txs/payload.Type" json:"TxType,omitempty"`
+	// The parts the account played in the transaction
+	Roles []ActivityRole `protobuf:"varint,5,rep,packed,name=Roles,proto3,enum=rpcquery.ActivityRole" json:"Roles,omitempty"`
+	// The account at the other end of a send, the contract called or created by a call, or the target of a permission
+	// change, if there is one
+	Counterparty *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,6,opt,name=Counterparty,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Counterparty,omitempty"`
+	// The native token sent (if the account is an input) or received (otherwise) by the account
+	Amount uint64 `protobuf:"varint,7,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// The name registered by a NameTx
+	Name string `protobuf:"bytes,8,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The permission change made by a PermsTx
+	PermArgs *permission.PermArgs `protobuf:"bytes,9,opt,name=PermArgs,proto3" json:"PermArgs,omitempty"`
+	// Set if the transaction failed
+	Exception            *errors.Exception `protobuf:"bytes,10,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Activity) Reset()         { *m = Activity{} }
+func (m *Activity) String() string { return proto.CompactTextString(m) }
+func (*Activity) ProtoMessage()    {}
+func (*Activity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{40}
+}
+func (m *Activity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Activity.Unmarshal(m, b)
+}
+func (m *Activity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Activity.Marshal(b, m, deterministic)
+}
+func (m *Activity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Activity.Merge(m, src)
+}
+func (m *Activity) XXX_Size() int {
+	return xxx_messageInfo_Activity.Size(m)
+}
+func (m *Activity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Activity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Activity proto.InternalMessageInfo
+
+func (m *Activity) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Activity) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Activity) GetTxType() github_com_hyperledger_burrow_txs_payload.Type {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *Activity) GetRoles() []ActivityRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *Activity) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Activity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Activity) GetPermArgs() *permission.PermArgs {
+	if m != nil {
+		return m.PermArgs
+	}
+	return nil
+}
+
+func (m *Activity) GetException() *errors.Exception {
+	if m != nil {
+		return m.Exception
+	}
+	return nil
+}
+
+func (*Activity) XXX_MessageName() string {
+	return "rpcquery.Activity"
+}
+
 type GetStatsParam struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -830,7 +2077,7 @@ func (m *GetStatsParam) Reset()         { *m = GetStatsParam{} }
 func (m *GetStatsParam) String() string { return proto.CompactTextString(m) }
 func (*GetStatsParam) ProtoMessage()    {}
 func (*GetStatsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{19}
+	return fileDescriptor_88e25d9b99e39f02, []int{41}
 }
 func (m *GetStatsParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsParam.Unmarshal(m, b)
@@ -866,7 +2113,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{20}
+	return fileDescriptor_88e25d9b99e39f02, []int{42}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stats.Unmarshal(m, b)
@@ -915,7 +2162,7 @@ func (m *GetBlockParam) Reset()         { *m = GetBlockParam{} }
 func (m *GetBlockParam) String() string { return proto.CompactTextString(m) }
 func (*GetBlockParam) ProtoMessage()    {}
 func (*GetBlockParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{21}
+	return fileDescriptor_88e25d9b99e39f02, []int{43}
 }
 func (m *GetBlockParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockParam.Unmarshal(m, b)
@@ -946,18 +2193,44 @@ func (*GetBlockParam) XXX_MessageName() string {
 	return "rpcquery.GetBlockParam"
 }
 func init() {
+	proto.RegisterEnum("rpcquery.ActivityRole", ActivityRole_name, ActivityRole_value)
+	golang_proto.RegisterEnum("rpcquery.ActivityRole", ActivityRole_name, ActivityRole_value)
 	proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
 	golang_proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
+	proto.RegisterType((*GetCapabilitiesParam)(nil), "rpcquery.GetCapabilitiesParam")
+	golang_proto.RegisterType((*GetCapabilitiesParam)(nil), "rpcquery.GetCapabilitiesParam")
 	proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
 	golang_proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
 	proto.RegisterType((*GetMetadataParam)(nil), "rpcquery.GetMetadataParam")
 	golang_proto.RegisterType((*GetMetadataParam)(nil), "rpcquery.GetMetadataParam")
 	proto.RegisterType((*MetadataResult)(nil), "rpcquery.MetadataResult")
 	golang_proto.RegisterType((*MetadataResult)(nil), "rpcquery.MetadataResult")
+	proto.RegisterType((*GetDisassemblyParam)(nil), "rpcquery.GetDisassemblyParam")
+	golang_proto.RegisterType((*GetDisassemblyParam)(nil), "rpcquery.GetDisassemblyParam")
+	proto.RegisterType((*Disassembly)(nil), "rpcquery.Disassembly")
+	golang_proto.RegisterType((*Disassembly)(nil), "rpcquery.Disassembly")
+	proto.RegisterType((*FunctionSelector)(nil), "rpcquery.FunctionSelector")
+	golang_proto.RegisterType((*FunctionSelector)(nil), "rpcquery.FunctionSelector")
+	proto.RegisterType((*GetCodeParam)(nil), "rpcquery.GetCodeParam")
+	golang_proto.RegisterType((*GetCodeParam)(nil), "rpcquery.GetCodeParam")
+	proto.RegisterType((*Code)(nil), "rpcquery.Code")
+	golang_proto.RegisterType((*Code)(nil), "rpcquery.Code")
+	proto.RegisterType((*Instruction)(nil), "rpcquery.Instruction")
+	golang_proto.RegisterType((*Instruction)(nil), "rpcquery.Instruction")
 	proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
 	golang_proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
 	proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
 	golang_proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
+	proto.RegisterType((*GetStorageRangeParam)(nil), "rpcquery.GetStorageRangeParam")
+	golang_proto.RegisterType((*GetStorageRangeParam)(nil), "rpcquery.GetStorageRangeParam")
+	proto.RegisterType((*StorageRange)(nil), "rpcquery.StorageRange")
+	golang_proto.RegisterType((*StorageRange)(nil), "rpcquery.StorageRange")
+	proto.RegisterType((*StorageEntry)(nil), "rpcquery.StorageEntry")
+	golang_proto.RegisterType((*StorageEntry)(nil), "rpcquery.StorageEntry")
+	proto.RegisterType((*GetStateBatchParam)(nil), "rpcquery.GetStateBatchParam")
+	golang_proto.RegisterType((*GetStateBatchParam)(nil), "rpcquery.GetStateBatchParam")
+	proto.RegisterType((*StateBatch)(nil), "rpcquery.StateBatch")
+	golang_proto.RegisterType((*StateBatch)(nil), "rpcquery.StateBatch")
 	proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
 	golang_proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
 	proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
@@ -968,6 +2241,10 @@ func init() {
 	golang_proto.RegisterType((*GetNetworkRegistryParam)(nil), "rpcquery.GetNetworkRegistryParam")
 	proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
 	golang_proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
+	proto.RegisterType((*GetHeartbeatParam)(nil), "rpcquery.GetHeartbeatParam")
+	golang_proto.RegisterType((*GetHeartbeatParam)(nil), "rpcquery.GetHeartbeatParam")
+	proto.RegisterType((*ListHeartbeatsParam)(nil), "rpcquery.ListHeartbeatsParam")
+	golang_proto.RegisterType((*ListHeartbeatsParam)(nil), "rpcquery.ListHeartbeatsParam")
 	proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
 	golang_proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
 	proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
@@ -984,6 +2261,22 @@ func init() {
 	golang_proto.RegisterType((*ListProposalsParam)(nil), "rpcquery.ListProposalsParam")
 	proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
 	golang_proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
+	proto.RegisterType((*ListScheduledGovTxsParam)(nil), "rpcquery.ListScheduledGovTxsParam")
+	golang_proto.RegisterType((*ListScheduledGovTxsParam)(nil), "rpcquery.ListScheduledGovTxsParam")
+	proto.RegisterType((*GetEscrowParam)(nil), "rpcquery.GetEscrowParam")
+	golang_proto.RegisterType((*GetEscrowParam)(nil), "rpcquery.GetEscrowParam")
+	proto.RegisterType((*ListEscrowsParam)(nil), "rpcquery.ListEscrowsParam")
+	golang_proto.RegisterType((*ListEscrowsParam)(nil), "rpcquery.ListEscrowsParam")
+	proto.RegisterType((*GetLogSequenceParam)(nil), "rpcquery.GetLogSequenceParam")
+	golang_proto.RegisterType((*GetLogSequenceParam)(nil), "rpcquery.GetLogSequenceParam")
+	proto.RegisterType((*LogSequence)(nil), "rpcquery.LogSequence")
+	golang_proto.RegisterType((*LogSequence)(nil), "rpcquery.LogSequence")
+	proto.RegisterType((*GetAccountActivityParam)(nil), "rpcquery.GetAccountActivityParam")
+	golang_proto.RegisterType((*GetAccountActivityParam)(nil), "rpcquery.GetAccountActivityParam")
+	proto.RegisterType((*AccountActivity)(nil), "rpcquery.AccountActivity")
+	golang_proto.RegisterType((*AccountActivity)(nil), "rpcquery.AccountActivity")
+	proto.RegisterType((*Activity)(nil), "rpcquery.Activity")
+	golang_proto.RegisterType((*Activity)(nil), "rpcquery.Activity")
 	proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
 	golang_proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
 	proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
@@ -996,73 +2289,153 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
 
 var fileDescriptor_88e25d9b99e39f02 = []byte{
-	// 1051 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xef, 0x6e, 0x1b, 0x45,
-	0x10, 0xe7, 0x9a, 0xc4, 0x71, 0xc6, 0x8e, 0xdd, 0x6e, 0x82, 0x9b, 0x5e, 0xa9, 0x53, 0x56, 0x22,
-	0x0d, 0x51, 0x7b, 0x36, 0xa6, 0x01, 0x04, 0x48, 0xa8, 0x8e, 0xc0, 0x0e, 0xa5, 0x51, 0x38, 0x43,
-	0x2b, 0x81, 0x84, 0xb4, 0xbe, 0x5b, 0xec, 0x53, 0xcf, 0xb7, 0x66, 0x6f, 0xaf, 0xc5, 0x8f, 0x81,
-	0x78, 0x0a, 0x1e, 0x80, 0xef, 0x7c, 0xec, 0x23, 0xa0, 0x7e, 0x88, 0x50, 0xfb, 0x22, 0xe8, 0xf6,
-	0x8f, 0x7d, 0x77, 0x71, 0x23, 0x40, 0xf4, 0x8b, 0xb5, 0x33, 0x3b, 0xf3, 0x1b, 0xef, 0xec, 0xfc,
-	0x7e, 0x7b, 0x50, 0xe3, 0x53, 0xef, 0xa7, 0x84, 0xf2, 0x99, 0x33, 0xe5, 0x4c, 0x30, 0x54, 0x36,
-	0xb6, 0x7d, 0x67, 0x14, 0x88, 0x71, 0x32, 0x74, 0x3c, 0x36, 0x69, 0x8d, 0xd8, 0x88, 0xb5, 0x64,
-	0xc0, 0x30, 0xf9, 0x51, 0x5a, 0xd2, 0x90, 0x2b, 0x95, 0x68, 0x7f, 0x98, 0x09, 0x17, 0x34, 0xf2,
-	0x29, 0x9f, 0x04, 0x91, 0xc8, 0x2e, 0xc9, 0xd0, 0x0b, 0x5a, 0x62, 0x36, 0xa5, 0xb1, 0xfa, 0xd5,
-	0x89, 0x95, 0x88, 0x4c, 0xe6, 0xc6, 0x06, 0xf1, 0x26, 0x7a, 0x59, 0x7f, 0x42, 0xc2, 0xc0, 0x27,
-	0x82, 0x71, 0xed, 0xa8, 0x71, 0x3a, 0x0a, 0x62, 0x61, 0xfe, 0xaa, 0xbd, 0xc1, 0xa7, 0x9e, 0x5e,
-	0x6e, 0x4e, 0xc9, 0x2c, 0x64, 0xc4, 0x57, 0x26, 0x0e, 0xa0, 0x32, 0x10, 0x44, 0x24, 0xf1, 0x29,
-	0xe1, 0x64, 0x82, 0xf6, 0xa1, 0xde, 0x0d, 0x99, 0xf7, 0xf8, 0x9b, 0x60, 0x42, 0x1f, 0x05, 0x62,
-	0x1c, 0x44, 0x3b, 0xd6, 0x4d, 0x6b, 0x7f, 0xc3, 0x2d, 0xba, 0x51, 0x1b, 0xb6, 0xa4, 0x6b, 0x40,
-	0x69, 0x94, 0x89, 0xbe, 0x24, 0xa3, 0x97, 0x6d, 0x61, 0x02, 0xf5, 0x1e, 0x15, 0xf7, 0x3c, 0x8f,
-	0x25, 0x91, 0x50, 0xe5, 0x4e, 0x60, 0xfd, 0x9e, 0xef, 0x73, 0x1a, 0xc7, 0xb2, 0x4c, 0xb5, 0x7b,
-	0xf7, 0xd9, 0xd9, 0xee, 0x1b, 0xcf, 0xcf, 0x76, 0x6f, 0x67, 0x5a, 0x34, 0x9e, 0x4d, 0x29, 0x0f,
-	0xa9, 0x3f, 0xa2, 0xbc, 0x35, 0x4c, 0x38, 0x67, 0x4f, 0x5b, 0x1e, 0x9f, 0x4d, 0x05, 0x73, 0x74,
-	0xae, 0x6b, 0x40, 0xf0, 0xef, 0x16, 0x5c, 0xee, 0x51, 0xf1, 0x80, 0x0a, 0xe2, 0x13, 0x41, 0x54,
-	0x91, 0x2f, 0x8b, 0x45, 0xda, 0xff, 0xb9, 0x00, 0xfa, 0x16, 0xaa, 0x06, 0xbc, 0x4f, 0xe2, 0xb1,
-	0x3c, 0x6e, 0xb5, 0xfb, 0xde, 0xf3, 0xb3, 0xdd, 0x3b, 0x17, 0x03, 0x0e, 0x83, 0x88, 0xf0, 0x99,
-	0xd3, 0xa7, 0x3f, 0x77, 0x67, 0x82, 0xc6, 0x6e, 0x0e, 0x06, 0xdf, 0x86, 0x9a, 0xb1, 0x5d, 0x1a,
-	0x27, 0xa1, 0x40, 0x36, 0x94, 0x8d, 0x47, 0xdf, 0xc0, 0xdc, 0xc6, 0xbf, 0x59, 0xb2, 0x93, 0x03,
-	0xc1, 0x38, 0x19, 0xd1, 0xd7, 0xd2, 0x49, 0xf4, 0x05, 0xac, 0xdc, 0xa7, 0x33, 0x7d, 0xbe, 0x7f,
-	0x88, 0xa5, 0xcf, 0xf8, 0x88, 0x71, 0xbf, 0x73, 0xf8, 0x81, 0x9b, 0x02, 0xe0, 0xef, 0xa1, 0xaa,
-	0xff, 0xe7, 0x43, 0x12, 0x26, 0x14, 0xdd, 0x87, 0x35, 0xb9, 0xd0, 0xff, 0xf2, 0x50, 0x23, 0xff,
-	0xcb, 0xee, 0x29, 0x0c, 0xfc, 0x2e, 0x5c, 0xf9, 0x2a, 0x88, 0xcd, 0x48, 0xe9, 0x11, 0xde, 0x86,
-	0xb5, 0xaf, 0x53, 0x56, 0xea, 0xb6, 0x29, 0x03, 0x63, 0xa8, 0xf6, 0xa8, 0x38, 0x21, 0x13, 0xdd,
-	0x2f, 0x04, 0xab, 0xa9, 0xa1, 0x83, 0xe4, 0x1a, 0xef, 0x41, 0x2d, 0x85, 0x4b, 0xd7, 0x17, 0x62,
-	0x5d, 0x83, 0xab, 0x29, 0x16, 0x15, 0x4f, 0x19, 0x7f, 0xec, 0x6a, 0xa6, 0xc9, 0x04, 0xdc, 0x80,
-	0xed, 0x1e, 0x15, 0x0f, 0x0d, 0x1d, 0x07, 0x54, 0x0d, 0x3a, 0xee, 0xc1, 0xf5, 0x82, 0xbf, 0x1f,
-	0xc4, 0x82, 0xe9, 0xb4, 0x94, 0x76, 0xc7, 0x91, 0x17, 0x26, 0x3e, 0x3d, 0xe5, 0xf4, 0x49, 0xc0,
-	0x12, 0x75, 0x8b, 0x2b, 0x6e, 0xd1, 0x8d, 0xbb, 0x50, 0x2f, 0x14, 0x46, 0x2d, 0x58, 0x19, 0x50,
-	0xb1, 0x63, 0xdd, 0x5c, 0xd9, 0xaf, 0x74, 0x6e, 0x38, 0x73, 0x95, 0x52, 0x01, 0x94, 0x53, 0x7f,
-	0x5e, 0xd7, 0x4d, 0x23, 0xf1, 0x2f, 0x16, 0x6c, 0x2d, 0xd9, 0xfc, 0xdf, 0x67, 0xe8, 0x00, 0x56,
-	0x4f, 0x98, 0x4f, 0xe5, 0x10, 0x55, 0x3a, 0x0d, 0x67, 0x2e, 0x4a, 0xa9, 0xf7, 0xd8, 0xa7, 0x91,
-	0x08, 0xc4, 0xcc, 0x95, 0x31, 0xb8, 0x07, 0x5b, 0x4b, 0xba, 0x83, 0xda, 0xb0, 0xae, 0x97, 0xfa,
-	0x7c, 0x8d, 0xc5, 0xf9, 0xb2, 0xf1, 0xae, 0x09, 0xc3, 0x27, 0x50, 0xcd, 0x6e, 0xa0, 0x06, 0x94,
-	0xc6, 0x34, 0x18, 0x8d, 0x85, 0x3c, 0xd3, 0xaa, 0xab, 0x2d, 0xb4, 0xa7, 0xba, 0x76, 0x49, 0xa2,
-	0x6e, 0x3b, 0x0b, 0x05, 0x2d, 0x34, 0x6b, 0x4f, 0x2a, 0xca, 0x29, 0x67, 0x53, 0x16, 0x93, 0x70,
-	0x3e, 0x3c, 0x92, 0xfd, 0xb2, 0x4b, 0xae, 0x5c, 0xe3, 0x36, 0xa0, 0x74, 0x78, 0x4c, 0xa0, 0x1e,
-	0x20, 0x1b, 0xca, 0xca, 0x43, 0x7d, 0x19, 0x5d, 0x76, 0xe7, 0x36, 0x7e, 0x00, 0x35, 0x13, 0xad,
-	0x49, 0xbf, 0x04, 0x17, 0xdd, 0x82, 0x52, 0x97, 0x84, 0x21, 0x13, 0xba, 0x8d, 0x75, 0xc7, 0x08,
-	0xb8, 0x72, 0xbb, 0x7a, 0x1b, 0xd7, 0x61, 0x53, 0x8a, 0x02, 0xd1, 0x44, 0xc0, 0x14, 0xd6, 0xa4,
-	0x85, 0x0e, 0xe0, 0xb2, 0xa1, 0x48, 0x2a, 0xc5, 0x47, 0xe9, 0x9d, 0xa8, 0x66, 0x9c, 0xf3, 0xa7,
-	0xb2, 0x9e, 0xf5, 0xb1, 0x44, 0x1c, 0x99, 0x2b, 0x5c, 0x75, 0x97, 0x6d, 0xe1, 0x5b, 0xb2, 0xae,
-	0x14, 0x7c, 0x75, 0xe6, 0x06, 0x94, 0xfa, 0xb9, 0x8e, 0x2b, 0xab, 0xf3, 0xeb, 0xba, 0x66, 0x13,
-	0xea, 0x40, 0x49, 0x3d, 0x3a, 0xe8, 0xcd, 0xc5, 0x75, 0x66, 0x9e, 0x21, 0xfb, 0x4a, 0xea, 0x76,
-	0x54, 0x57, 0x74, 0xe4, 0x21, 0xc0, 0xe2, 0xf5, 0x40, 0xd7, 0x16, 0x79, 0x85, 0x37, 0xc5, 0xae,
-	0x3a, 0xe9, 0xc3, 0x68, 0x02, 0x8f, 0xa0, 0x92, 0x79, 0x10, 0x90, 0x9d, 0xcb, 0xcb, 0xbd, 0x13,
-	0xf6, 0xce, 0x62, 0xaf, 0x20, 0xc6, 0x9f, 0xc9, 0xda, 0x5a, 0xc7, 0x0a, 0xb5, 0xb3, 0x2a, 0x6c,
-	0x37, 0xb2, 0xc7, 0xc9, 0xa8, 0xde, 0x27, 0x50, 0xcd, 0x0a, 0x15, 0xba, 0xbe, 0x88, 0x3b, 0x27,
-	0x60, 0xf9, 0x03, 0xb4, 0x2d, 0xd4, 0x82, 0x75, 0x2d, 0x5d, 0xa8, 0x91, 0x2b, 0x3d, 0x57, 0x33,
-	0xbb, 0xea, 0xa8, 0x2f, 0x83, 0xcf, 0xa3, 0x54, 0x10, 0x0e, 0x61, 0x63, 0xae, 0x63, 0x68, 0x27,
-	0x5f, 0x6a, 0x21, 0x6e, 0xf9, 0xa4, 0xb6, 0x85, 0x5c, 0x40, 0xe7, 0x65, 0x0d, 0xbd, 0x9d, 0x2f,
-	0xb9, 0x44, 0xf4, 0xec, 0x4c, 0x43, 0x8a, 0xd9, 0xc7, 0xf2, 0xa5, 0xca, 0x11, 0xb2, 0x99, 0x03,
-	0x3c, 0x27, 0x95, 0xf6, 0x2b, 0x18, 0x8e, 0x7e, 0x80, 0xc6, 0x72, 0x09, 0x45, 0xef, 0xbc, 0x12,
-	0x31, 0x2b, 0xb2, 0xf6, 0x8d, 0xe5, 0xc0, 0x06, 0xe5, 0x63, 0x39, 0x29, 0x86, 0x91, 0x85, 0x49,
-	0xc9, 0xf1, 0xdf, 0x2e, 0x72, 0x10, 0x1d, 0xc3, 0x66, 0x8e, 0xfc, 0xe8, 0xad, 0x7c, 0xd7, 0xf3,
-	0xaa, 0x90, 0x9d, 0xb4, 0xbc, 0x02, 0xb4, 0x2d, 0x74, 0x17, 0xca, 0x86, 0xc6, 0xe8, 0x6a, 0x61,
-	0xd2, 0x0c, 0xb5, 0xed, 0x7a, 0x9e, 0x36, 0x31, 0xfa, 0x08, 0x6a, 0x86, 0x84, 0x7d, 0x4a, 0x7c,
-	0xca, 0x0b, 0xb9, 0x0b, 0x7a, 0xda, 0x9b, 0x8e, 0xfa, 0xa4, 0x54, 0x71, 0xdd, 0x4f, 0xff, 0x7c,
-	0xd1, 0xb4, 0xfe, 0x7a, 0xd1, 0xb4, 0xfe, 0x78, 0xd9, 0xb4, 0x9e, 0xbd, 0x6c, 0x5a, 0xdf, 0x1d,
-	0x5c, 0xac, 0xf6, 0x7c, 0xea, 0xb5, 0x0c, 0xf4, 0xb0, 0x24, 0xbf, 0x22, 0xdf, 0xff, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x73, 0xa1, 0xad, 0x1c, 0x0b, 0x00, 0x00,
+	// 2324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xcf, 0x92, 0x94, 0x44, 0x3e, 0x52, 0x94, 0x3c, 0x52, 0x6c, 0x66, 0x13, 0x4b, 0xca, 0x02,
+	0x4d, 0x04, 0x37, 0x21, 0x19, 0x25, 0x6e, 0xdc, 0xa6, 0x40, 0x41, 0x7d, 0x58, 0xa2, 0x6d, 0x29,
+	0xf2, 0x90, 0xb6, 0x81, 0x16, 0x28, 0xb0, 0xdc, 0x9d, 0x50, 0x8b, 0x2c, 0x77, 0x99, 0xd9, 0xa1,
+	0x2d, 0xde, 0x72, 0xe8, 0xa9, 0xa7, 0xf4, 0x1f, 0x68, 0x0f, 0xbd, 0xb4, 0xe7, 0x1e, 0x7b, 0xc9,
+	0x31, 0xc7, 0x1e, 0x8b, 0x20, 0x70, 0x8b, 0xe4, 0xda, 0x4b, 0xaf, 0x3d, 0x15, 0xf3, 0xb1, 0xbb,
+	0xb3, 0x2b, 0x4a, 0x68, 0x25, 0xeb, 0x22, 0xed, 0xbc, 0x79, 0xf3, 0x66, 0xe6, 0xcd, 0xef, 0xcd,
+	0xfc, 0xde, 0x23, 0xd4, 0xe9, 0xd8, 0xf9, 0x62, 0x42, 0xe8, 0xb4, 0x39, 0xa6, 0x21, 0x0b, 0x51,
+	0x39, 0x6e, 0x9b, 0xef, 0x0f, 0x3d, 0x76, 0x32, 0x19, 0x34, 0x9d, 0x70, 0xd4, 0x1a, 0x86, 0xc3,
+	0xb0, 0x25, 0x14, 0x06, 0x93, 0xcf, 0x44, 0x4b, 0x34, 0xc4, 0x97, 0x1c, 0x68, 0x7e, 0xac, 0xa9,
+	0x33, 0x12, 0xb8, 0x84, 0x8e, 0xbc, 0x80, 0xe9, 0x9f, 0xf6, 0xc0, 0xf1, 0x5a, 0x6c, 0x3a, 0x26,
+	0x91, 0xfc, 0xab, 0x06, 0xae, 0x0f, 0xc3, 0x70, 0xe8, 0x93, 0xd4, 0x3c, 0xf3, 0x46, 0x24, 0x62,
+	0xf6, 0x68, 0xac, 0x14, 0xaa, 0x81, 0x3d, 0x4a, 0xb4, 0x2b, 0xb6, 0x33, 0x52, 0x9f, 0x4b, 0xcf,
+	0x6d, 0xdf, 0x73, 0x6d, 0x16, 0x52, 0x25, 0xa8, 0x53, 0x32, 0xf4, 0x22, 0x16, 0xef, 0xc5, 0xac,
+	0xd0, 0xb1, 0xa3, 0x3e, 0x17, 0xc7, 0xf6, 0xd4, 0x0f, 0x6d, 0x57, 0x35, 0x6b, 0x24, 0x72, 0x68,
+	0xf8, 0x22, 0x36, 0x74, 0x42, 0x6c, 0xca, 0x06, 0xc4, 0x66, 0x4a, 0xb0, 0x3c, 0xe6, 0x4b, 0x8e,
+	0x22, 0x2f, 0x0c, 0x92, 0x01, 0x94, 0x86, 0x54, 0x2d, 0xc2, 0xf2, 0xa0, 0xda, 0x63, 0x36, 0x9b,
+	0x44, 0xc7, 0x36, 0xb5, 0x47, 0x68, 0x13, 0x96, 0xb6, 0xfd, 0xd0, 0xf9, 0xbc, 0xef, 0x8d, 0xc8,
+	0x33, 0x8f, 0x9d, 0x78, 0x41, 0xc3, 0xd8, 0x30, 0x36, 0x2b, 0x38, 0x2f, 0x46, 0x6d, 0x58, 0x11,
+	0xa2, 0x1e, 0x21, 0x81, 0xa6, 0x5d, 0x10, 0xda, 0xb3, 0xba, 0xac, 0x9b, 0xb0, 0xba, 0x4f, 0xd8,
+	0x8e, 0x3d, 0xb6, 0x07, 0x9e, 0xef, 0x31, 0x8f, 0xc8, 0x39, 0xad, 0x29, 0x2c, 0xed, 0x13, 0xd6,
+	0x71, 0x9c, 0x70, 0x12, 0x30, 0xb9, 0x8c, 0x23, 0x58, 0xe8, 0xb8, 0x2e, 0x25, 0x51, 0x24, 0xa6,
+	0xaf, 0x6d, 0x7f, 0xf4, 0xcd, 0xcb, 0xf5, 0xd7, 0xbe, 0x7d, 0xb9, 0xfe, 0x9e, 0x76, 0x34, 0x27,
+	0xd3, 0x31, 0xa1, 0x3e, 0x71, 0x87, 0x84, 0xb6, 0x06, 0x13, 0x4a, 0xc3, 0x17, 0x2d, 0x87, 0x4e,
+	0xc7, 0x2c, 0x6c, 0xaa, 0xb1, 0x38, 0x36, 0x82, 0x6e, 0xc2, 0xfc, 0x7d, 0x8f, 0xf8, 0x6e, 0xd4,
+	0x28, 0x6c, 0x14, 0x37, 0x2b, 0x58, 0xb5, 0xac, 0xdf, 0x14, 0x60, 0x79, 0x9f, 0xb0, 0x43, 0xc2,
+	0x6c, 0xd7, 0x66, 0xb6, 0x9c, 0xfc, 0x41, 0x7e, 0xf2, 0xf6, 0xe5, 0x27, 0x7e, 0x02, 0xb5, 0xd8,
+	0xf8, 0x81, 0x1d, 0x9d, 0x08, 0xf7, 0xd4, 0xb6, 0x3f, 0xf8, 0xf6, 0xe5, 0xfa, 0xfb, 0x17, 0x1b,
+	0x1c, 0x78, 0x81, 0x4d, 0xa7, 0xcd, 0x03, 0x72, 0xba, 0x3d, 0x65, 0x24, 0xc2, 0x19, 0x33, 0xe8,
+	0x10, 0xca, 0x3b, 0xa1, 0x4b, 0x84, 0xc9, 0xe2, 0x65, 0x4d, 0x26, 0x26, 0xac, 0xbf, 0x15, 0xa0,
+	0x1e, 0xdb, 0xc7, 0x24, 0x9a, 0xf8, 0x0c, 0x99, 0x50, 0x8e, 0x25, 0x0a, 0x01, 0x49, 0x1b, 0x59,
+	0x50, 0xdb, 0x09, 0x03, 0x46, 0x6d, 0x87, 0x1d, 0xd9, 0x23, 0xa2, 0xce, 0x3c, 0x23, 0x43, 0x6b,
+	0x00, 0xbd, 0x70, 0x42, 0x1d, 0x72, 0xdf, 0xf3, 0x89, 0x58, 0x63, 0x05, 0x6b, 0x12, 0x0e, 0xb4,
+	0x9d, 0x70, 0x34, 0xf6, 0x7c, 0x42, 0x9f, 0x12, 0xca, 0xe1, 0xd9, 0x28, 0x49, 0xa0, 0xe5, 0xc4,
+	0xa9, 0x25, 0xb1, 0xdb, 0x39, 0xdd, 0x92, 0xf0, 0xc5, 0x32, 0x14, 0x3b, 0x03, 0xaf, 0x31, 0x2f,
+	0x3a, 0xf8, 0x27, 0x7a, 0xac, 0x79, 0x67, 0x41, 0x78, 0xe7, 0xae, 0x82, 0xcf, 0x65, 0x3d, 0x84,
+	0x5a, 0x00, 0xbb, 0x64, 0xec, 0x87, 0xd3, 0x11, 0x09, 0x58, 0xa3, 0xbc, 0x61, 0x6c, 0x56, 0xb7,
+	0x96, 0x9a, 0x3c, 0x80, 0x53, 0x31, 0xd6, 0x54, 0x2c, 0x02, 0x2b, 0xfb, 0x84, 0xed, 0x7a, 0x91,
+	0x1d, 0x45, 0x64, 0x34, 0xf0, 0xa7, 0xd7, 0x02, 0x6c, 0xeb, 0x4f, 0x05, 0xa8, 0x6a, 0x93, 0xa0,
+	0x9f, 0x42, 0xad, 0x1b, 0x44, 0x8c, 0x4e, 0x1c, 0xe6, 0x85, 0x01, 0x9f, 0xa4, 0xb8, 0x59, 0xdd,
+	0x7a, 0xbd, 0x99, 0x5c, 0x8d, 0x5a, 0x2f, 0xce, 0xa8, 0x72, 0xaf, 0x25, 0x27, 0x5e, 0xb8, 0x92,
+	0xd7, 0x12, 0xa0, 0x3c, 0x3e, 0x03, 0xd3, 0x2b, 0x1f, 0xc4, 0x3d, 0xa8, 0xf4, 0x88, 0x4f, 0x1c,
+	0x16, 0xd2, 0xa8, 0x51, 0x12, 0xbb, 0x33, 0xd3, 0xdd, 0xdd, 0x9f, 0x04, 0x62, 0x37, 0xb1, 0x0a,
+	0x4e, 0x95, 0xad, 0xbf, 0x1a, 0xb0, 0x9c, 0xef, 0xe7, 0x2b, 0x8c, 0xbf, 0x1b, 0xc6, 0x95, 0x56,
+	0x98, 0x98, 0xdc, 0x80, 0xea, 0x2e, 0x89, 0x98, 0x17, 0xd8, 0x7c, 0x26, 0xe1, 0xca, 0x12, 0xd6,
+	0x45, 0x68, 0x15, 0xe6, 0x1e, 0xd9, 0x03, 0xe2, 0xab, 0xb0, 0x90, 0x0d, 0xf4, 0x16, 0x54, 0x7a,
+	0xde, 0x30, 0xb0, 0xd9, 0x84, 0x12, 0x15, 0x0b, 0xa9, 0xc0, 0xfa, 0xd2, 0x80, 0x1a, 0xbf, 0x3d,
+	0x43, 0x97, 0x5c, 0xcf, 0x15, 0xb9, 0xa1, 0x03, 0x49, 0xc6, 0x74, 0x19, 0xeb, 0x22, 0xeb, 0xdf,
+	0x06, 0x94, 0xf8, 0xfc, 0xa8, 0x2b, 0xff, 0x5f, 0xcd, 0x61, 0xd2, 0x94, 0x8e, 0x90, 0xc2, 0xab,
+	0x41, 0x08, 0x82, 0xd2, 0xb3, 0x4e, 0xef, 0x50, 0x38, 0xb7, 0x8c, 0xc5, 0x37, 0xfa, 0x38, 0x13,
+	0x25, 0xc2, 0xbb, 0x99, 0xa8, 0xd0, 0x3a, 0xf5, 0x3d, 0x4f, 0xad, 0xaf, 0x0d, 0xa8, 0x6a, 0x51,
+	0x82, 0xea, 0x50, 0x38, 0xde, 0x11, 0x1b, 0x2f, 0xe1, 0xc2, 0xf1, 0x0e, 0x7f, 0x58, 0x3e, 0x1d,
+	0x0b, 0x67, 0xc8, 0x4b, 0x50, 0xb5, 0x50, 0x0f, 0x2a, 0xdd, 0xd1, 0x88, 0xb8, 0x9e, 0xcd, 0xc8,
+	0xd5, 0xa0, 0x9f, 0xda, 0xe1, 0x37, 0x61, 0x27, 0x08, 0x42, 0x26, 0x81, 0x25, 0x21, 0xa2, 0x49,
+	0x52, 0x5c, 0xcd, 0x69, 0xb8, 0xb2, 0xfe, 0x6c, 0x88, 0xf7, 0xb5, 0xc7, 0x42, 0x6a, 0x0f, 0xaf,
+	0x09, 0x3c, 0xf7, 0xa1, 0xf8, 0x90, 0x4c, 0x1b, 0x85, 0xff, 0xc7, 0x96, 0xda, 0xe8, 0xb3, 0x90,
+	0xba, 0x5b, 0x77, 0x7f, 0x82, 0xb9, 0x01, 0xeb, 0x57, 0x50, 0x53, 0xeb, 0x7c, 0x6a, 0xfb, 0x13,
+	0x82, 0x1e, 0xc2, 0x9c, 0xf8, 0xb8, 0x1a, 0xd4, 0xa4, 0x0d, 0xeb, 0x3b, 0x43, 0x10, 0x10, 0x35,
+	0x01, 0xb6, 0x83, 0xeb, 0xf3, 0xc6, 0x5c, 0xe7, 0x33, 0x46, 0x68, 0xa3, 0xf0, 0xbf, 0xd2, 0x87,
+	0x9c, 0x2f, 0xe4, 0x70, 0x71, 0x9e, 0xde, 0xc8, 0x63, 0x02, 0x40, 0x25, 0x2c, 0x1b, 0x1c, 0x72,
+	0x07, 0xc4, 0x1b, 0x9e, 0x30, 0x81, 0x80, 0x12, 0x56, 0x2d, 0xeb, 0xf7, 0x06, 0xd4, 0xf4, 0xbd,
+	0x69, 0x8a, 0x86, 0xae, 0x88, 0xda, 0xb0, 0xb0, 0x17, 0x30, 0xea, 0x11, 0xc9, 0x86, 0xaa, 0x5b,
+	0x37, 0xd3, 0x40, 0x50, 0x06, 0x78, 0xff, 0x14, 0xc7, 0x6a, 0x68, 0x17, 0x4a, 0x47, 0xe4, 0x94,
+	0x35, 0x8a, 0x97, 0xdc, 0x8f, 0x18, 0x6d, 0xfd, 0x31, 0x5d, 0xa0, 0xb0, 0x1f, 0xa3, 0xc6, 0xb8,
+	0x22, 0x6a, 0x52, 0x94, 0x14, 0x5e, 0x01, 0x4a, 0xfe, 0x62, 0x00, 0x12, 0x28, 0xb1, 0x19, 0xd9,
+	0xb6, 0x99, 0x73, 0x22, 0x31, 0x72, 0x0c, 0x65, 0xc5, 0x50, 0xe5, 0xa3, 0x7a, 0x59, 0x90, 0x24,
+	0x56, 0xd0, 0x87, 0xb0, 0xa0, 0xbc, 0xa1, 0x8e, 0xe1, 0x8d, 0xf4, 0x18, 0x72, 0xf1, 0x8a, 0x63,
+	0x4d, 0xed, 0x4c, 0x8b, 0x99, 0xc3, 0xff, 0xd2, 0x00, 0x48, 0x97, 0x7c, 0xee, 0xd1, 0x6f, 0x6a,
+	0xbb, 0x90, 0x93, 0xd6, 0x04, 0x89, 0x51, 0x42, 0x6d, 0x75, 0xed, 0x74, 0x75, 0xc5, 0x73, 0x40,
+	0x22, 0xfc, 0x95, 0x2c, 0xcd, 0xfa, 0x83, 0x01, 0x37, 0x1e, 0x79, 0x51, 0x4c, 0xe4, 0x55, 0x42,
+	0xb1, 0x0a, 0x73, 0x8f, 0xf9, 0x20, 0x45, 0x22, 0x65, 0xe3, 0x3c, 0x3e, 0x9e, 0x46, 0x4e, 0xf1,
+	0x92, 0xc4, 0x3b, 0x1f, 0x39, 0x25, 0x2d, 0x72, 0x2c, 0x4b, 0x3c, 0xa1, 0x9c, 0x9e, 0xca, 0xb5,
+	0x21, 0x28, 0xf1, 0x86, 0x5a, 0x9a, 0xf8, 0xb6, 0x30, 0xd4, 0xf9, 0x26, 0xf8, 0xf7, 0x85, 0x3b,
+	0x58, 0xd5, 0x63, 0xbc, 0x72, 0x61, 0xc4, 0x5a, 0x6f, 0xc0, 0x2d, 0x3e, 0x2f, 0x61, 0x2f, 0x42,
+	0xfa, 0x39, 0x56, 0x89, 0x9d, 0xcc, 0x7d, 0x64, 0x4e, 0xf4, 0x34, 0xce, 0xfe, 0x7a, 0x44, 0x26,
+	0x40, 0x96, 0x03, 0x37, 0xf6, 0x09, 0x3b, 0x88, 0x93, 0xb9, 0xeb, 0x21, 0x8f, 0x3f, 0x86, 0x15,
+	0xbe, 0xd7, 0x64, 0x96, 0x8b, 0x36, 0x6c, 0xed, 0xc3, 0x9b, 0xb9, 0x95, 0x1e, 0x78, 0x11, 0x0b,
+	0xd5, 0x46, 0x38, 0x9f, 0xef, 0x06, 0x8e, 0x3f, 0x71, 0xc9, 0x31, 0x25, 0xcf, 0xbd, 0x70, 0x22,
+	0xd7, 0x58, 0xc4, 0x79, 0xb1, 0xb5, 0x0d, 0x4b, 0x39, 0x57, 0xa0, 0x16, 0x14, 0x7b, 0x84, 0x29,
+	0xb2, 0x7a, 0x3b, 0x05, 0x9a, 0x54, 0x20, 0x94, 0xb8, 0xc9, 0xbc, 0x98, 0x6b, 0x5a, 0xbf, 0x33,
+	0x60, 0x65, 0x46, 0xe7, 0x2b, 0xbf, 0xc9, 0xef, 0x40, 0xe9, 0x28, 0x7e, 0xdc, 0x45, 0x08, 0xc4,
+	0x59, 0x39, 0x97, 0x76, 0x5d, 0x12, 0x30, 0x8f, 0x4d, 0xb1, 0xd0, 0xb1, 0xf6, 0x61, 0x65, 0x86,
+	0x77, 0x78, 0x20, 0xa9, 0xcf, 0x86, 0x91, 0x0f, 0x24, 0x5d, 0x1f, 0xc7, 0x6a, 0xd6, 0x11, 0xd4,
+	0xf4, 0x0e, 0x1e, 0x2c, 0x27, 0x99, 0x60, 0x96, 0x2d, 0xf4, 0x8e, 0xf4, 0x9a, 0x8c, 0xe3, 0xd5,
+	0x66, 0x5a, 0x42, 0xc8, 0x39, 0xeb, 0x1d, 0x91, 0xe3, 0x1e, 0xd3, 0x70, 0x1c, 0x46, 0xb6, 0x9f,
+	0x40, 0x5f, 0x70, 0x2e, 0xe1, 0x25, 0x2c, 0xbe, 0xad, 0x36, 0x20, 0x0e, 0x87, 0x58, 0x51, 0xa1,
+	0xc1, 0x84, 0xb2, 0x94, 0x10, 0x57, 0x68, 0x97, 0x71, 0xd2, 0xb6, 0x0e, 0xa1, 0x1e, 0x6b, 0xab,
+	0xb4, 0x71, 0x86, 0x5d, 0xf4, 0x2e, 0xcc, 0x6f, 0xdb, 0xbe, 0x1f, 0x32, 0xe5, 0xc6, 0xa5, 0x66,
+	0x5c, 0xc1, 0x90, 0x62, 0xac, 0xba, 0x2d, 0x13, 0x1a, 0x7c, 0x01, 0x3d, 0xe7, 0x84, 0xb8, 0x13,
+	0x9f, 0xb8, 0xfb, 0xe1, 0xf3, 0xfe, 0xa9, 0x2a, 0x12, 0x6c, 0x40, 0x7d, 0x9f, 0xb0, 0x3d, 0x51,
+	0xeb, 0x90, 0x0b, 0xab, 0x43, 0xa1, 0xbb, 0x1b, 0x53, 0xb1, 0xee, 0xae, 0xb5, 0x09, 0xcb, 0x7c,
+	0xb4, 0x54, 0xb9, 0x10, 0xca, 0x32, 0x37, 0x7b, 0x14, 0x0e, 0x7b, 0xe4, 0x8b, 0x09, 0x09, 0x9c,
+	0xeb, 0xa1, 0x01, 0xd6, 0x14, 0xaa, 0xda, 0x1c, 0xaf, 0x1c, 0x9b, 0x26, 0x94, 0x63, 0xdb, 0x2a,
+	0xc9, 0x48, 0xda, 0xd6, 0xbf, 0x0c, 0x71, 0xe5, 0xa8, 0xab, 0xb8, 0xe3, 0x30, 0xef, 0xb9, 0xc7,
+	0xae, 0x27, 0x05, 0xe5, 0xaf, 0xaf, 0xce, 0x76, 0x2e, 0xfb, 0xfa, 0x5e, 0x44, 0x79, 0xd6, 0x78,
+	0xf6, 0x1d, 0x39, 0x24, 0x70, 0xbd, 0x60, 0x28, 0xee, 0xf4, 0x32, 0xd6, 0x24, 0xd6, 0x57, 0x06,
+	0x2c, 0xe5, 0xf6, 0x8a, 0xb6, 0x00, 0xd4, 0x37, 0x27, 0x3a, 0x32, 0xf4, 0x50, 0x1a, 0x7a, 0xb1,
+	0x1e, 0xd6, 0xb4, 0x78, 0x62, 0x23, 0x78, 0xce, 0x95, 0x76, 0x22, 0xc9, 0xce, 0x6f, 0x4b, 0x50,
+	0x56, 0x96, 0xa7, 0xe7, 0x3e, 0xc7, 0xf7, 0xa0, 0xc4, 0xeb, 0x63, 0x2a, 0x2e, 0xcc, 0xa6, 0x2c,
+	0x1f, 0x36, 0xe3, 0xf2, 0x61, 0xb3, 0x1f, 0x97, 0x0f, 0xb7, 0xcb, 0x7c, 0x2d, 0x5f, 0xfd, 0x63,
+	0xdd, 0xc0, 0x62, 0x04, 0x3a, 0x84, 0xf9, 0xfe, 0xe9, 0xd5, 0xf3, 0x6a, 0x65, 0x04, 0x3d, 0xe0,
+	0xe6, 0xfa, 0xd3, 0xb1, 0x4c, 0x3c, 0x17, 0xb7, 0xb7, 0xfe, 0xf3, 0x72, 0xbd, 0x79, 0xb1, 0x29,
+	0x76, 0x1a, 0xb5, 0xe2, 0x38, 0xe6, 0x23, 0xb1, 0xb2, 0x80, 0xde, 0x83, 0x39, 0x1c, 0xfa, 0x24,
+	0x6a, 0xcc, 0x6d, 0x14, 0x37, 0xeb, 0xfa, 0x75, 0x97, 0xf8, 0x3c, 0xf4, 0x09, 0x96, 0x4a, 0xa8,
+	0xcf, 0x6b, 0x49, 0x93, 0x80, 0x11, 0x3a, 0xb6, 0x29, 0x9b, 0x36, 0xe6, 0x2f, 0xf9, 0xf0, 0x67,
+	0xac, 0x70, 0x87, 0x77, 0x46, 0x5c, 0x20, 0xea, 0x3f, 0x25, 0xac, 0x5a, 0xc9, 0x8b, 0x5f, 0x4e,
+	0x5f, 0x7c, 0xd4, 0x86, 0xf2, 0x31, 0xa1, 0xa3, 0x0e, 0x1d, 0x46, 0x8d, 0x8a, 0x38, 0x88, 0xd5,
+	0xa6, 0x56, 0x34, 0x8d, 0xfb, 0x70, 0xa2, 0x85, 0x5a, 0x50, 0xd9, 0x3b, 0x75, 0xc8, 0x58, 0xa4,
+	0x61, 0x20, 0x86, 0xdc, 0x68, 0xaa, 0xaa, 0x6a, 0xd2, 0x81, 0x53, 0x1d, 0x6b, 0x09, 0x16, 0x15,
+	0xa5, 0x54, 0xb7, 0x19, 0x81, 0x39, 0xd1, 0x42, 0x77, 0x60, 0x39, 0xe6, 0x4b, 0xbc, 0x4a, 0x9a,
+	0xa4, 0xd5, 0x25, 0x7c, 0x46, 0xce, 0x2b, 0xae, 0xba, 0x2c, 0x9c, 0xb0, 0x24, 0xf1, 0x2c, 0xe1,
+	0x59, 0x5d, 0xd6, 0xbb, 0x62, 0x5e, 0x51, 0x8b, 0x95, 0xb1, 0x7f, 0x0e, 0x10, 0xef, 0x3c, 0x84,
+	0x9a, 0x7e, 0x38, 0xa8, 0x02, 0x73, 0xdd, 0xa3, 0xe3, 0x27, 0xfd, 0xe5, 0xd7, 0x10, 0xc0, 0xfc,
+	0xa7, 0x4f, 0xfa, 0xfc, 0xdb, 0xe0, 0xdf, 0x3b, 0x9d, 0x47, 0x8f, 0xf6, 0xf6, 0x96, 0x0b, 0xa8,
+	0x0a, 0x0b, 0x3b, 0x78, 0xaf, 0xd3, 0xdf, 0xdb, 0x5d, 0x2e, 0xf2, 0xc6, 0xde, 0x61, 0xb7, 0xdf,
+	0xdf, 0xc3, 0xcb, 0xa5, 0xad, 0xef, 0x16, 0xd5, 0xad, 0x8b, 0xb6, 0x60, 0x5e, 0x16, 0x97, 0xd1,
+	0xeb, 0x3a, 0x7b, 0x4c, 0xca, 0xcd, 0xe6, 0x0d, 0x2e, 0x6e, 0xca, 0xb7, 0x43, 0x69, 0x3e, 0x80,
+	0xa5, 0x5c, 0x95, 0x18, 0xad, 0x65, 0x88, 0xf1, 0x99, 0x02, 0xb2, 0x79, 0x4b, 0xb3, 0x92, 0x19,
+	0x78, 0x17, 0x20, 0xbd, 0x05, 0x51, 0x96, 0x5f, 0xeb, 0xf5, 0x66, 0x33, 0xc3, 0x82, 0xd1, 0x0e,
+	0x54, 0xb5, 0xa2, 0x30, 0x32, 0x33, 0xe3, 0x32, 0xb5, 0x62, 0xb3, 0x91, 0xf6, 0xe5, 0x0a, 0xa8,
+	0xbf, 0x10, 0x73, 0xc7, 0xbc, 0xfd, 0x7c, 0x6e, 0x6f, 0x9e, 0x43, 0xac, 0x51, 0x57, 0x4f, 0xdb,
+	0x65, 0x46, 0xb7, 0x36, 0xcb, 0x4a, 0x9a, 0xc8, 0xce, 0x30, 0x25, 0xc7, 0xed, 0x24, 0xf8, 0x53,
+	0xf9, 0xc1, 0x5b, 0x39, 0x43, 0x99, 0x5c, 0xc7, 0x5c, 0xcd, 0x1e, 0x96, 0x1a, 0x73, 0x5f, 0xbc,
+	0xc0, 0x7a, 0xb1, 0xf1, 0x76, 0xc6, 0x4a, 0xbe, 0xd6, 0x69, 0xce, 0xae, 0xaf, 0xa0, 0x0f, 0x60,
+	0x41, 0x15, 0xb2, 0xd0, 0xcd, 0xec, 0xc1, 0xc6, 0xb5, 0x2d, 0xb3, 0x9e, 0xca, 0x85, 0xde, 0x27,
+	0x50, 0xd3, 0x33, 0x0b, 0xf4, 0x66, 0xda, 0x7f, 0x26, 0xe3, 0xc8, 0x9e, 0x65, 0xdb, 0x40, 0x2d,
+	0x31, 0x9f, 0x08, 0xf5, 0xec, 0x7c, 0x49, 0x22, 0x60, 0xd6, 0x9a, 0xf2, 0x77, 0x19, 0x99, 0x96,
+	0xde, 0x85, 0x4a, 0x92, 0x02, 0xa0, 0x46, 0x76, 0xaa, 0x34, 0x2f, 0xc8, 0x0e, 0x6a, 0x1b, 0x08,
+	0x8b, 0xbc, 0x31, 0x4f, 0x6d, 0xdf, 0xce, 0x4e, 0x39, 0x23, 0x07, 0x30, 0x35, 0x6c, 0xe4, 0x47,
+	0x4b, 0x0c, 0x64, 0xd8, 0x60, 0x16, 0x03, 0x67, 0x32, 0x07, 0xf3, 0x1c, 0x7a, 0x89, 0x7e, 0x0d,
+	0x37, 0x67, 0xf3, 0x77, 0xf4, 0xa3, 0x73, 0x2d, 0xea, 0x0c, 0xdf, 0xbc, 0x3d, 0xdb, 0x70, 0x6c,
+	0xa5, 0x23, 0x92, 0xab, 0x24, 0x97, 0xd0, 0xcf, 0xe8, 0x4c, 0x26, 0x63, 0xae, 0x36, 0xd3, 0xdf,
+	0xa9, 0xd2, 0x21, 0xfb, 0x32, 0xf7, 0x4a, 0x04, 0x91, 0x8e, 0xb0, 0x19, 0x99, 0xca, 0x6c, 0x33,
+	0x6d, 0x03, 0xfd, 0x4c, 0x04, 0x70, 0x4c, 0x4d, 0x73, 0x01, 0x9c, 0x21, 0xc2, 0x66, 0x9e, 0x8c,
+	0xa2, 0x2e, 0x2c, 0x66, 0x58, 0xb0, 0x1e, 0x2b, 0x67, 0xe9, 0xb1, 0x7e, 0x01, 0x64, 0xa9, 0x70,
+	0xdb, 0x40, 0x7d, 0x58, 0x99, 0xc1, 0x67, 0x91, 0x95, 0x35, 0x38, 0x8b, 0xee, 0x9a, 0xb7, 0x92,
+	0x65, 0x65, 0xbb, 0xdb, 0x06, 0x87, 0x67, 0xc2, 0x84, 0x75, 0x78, 0x66, 0xe9, 0xb1, 0x59, 0x6f,
+	0xaa, 0x1f, 0x06, 0x95, 0xe6, 0x27, 0x50, 0xd5, 0xe8, 0xb1, 0xee, 0x93, 0x3c, 0x6b, 0xce, 0x0f,
+	0x6d, 0x1b, 0xe8, 0x23, 0x28, 0xc7, 0x0f, 0x18, 0xba, 0x75, 0xe6, 0xee, 0x88, 0x62, 0x57, 0x66,
+	0xae, 0x8d, 0x48, 0xdd, 0x18, 0x3a, 0x07, 0xce, 0xde, 0x18, 0x79, 0x06, 0xae, 0xdf, 0x18, 0xfa,
+	0xa8, 0x7b, 0x50, 0x8f, 0x9f, 0xb1, 0x03, 0x62, 0xbb, 0x84, 0xe6, 0xd6, 0x90, 0x3e, 0x70, 0xe6,
+	0x62, 0x53, 0xfe, 0x1e, 0xab, 0xf4, 0x64, 0x4c, 0xe6, 0xa9, 0xe1, 0xdb, 0xb3, 0x1e, 0x82, 0x0c,
+	0x49, 0xd6, 0x63, 0x32, 0xd7, 0xbf, 0xfd, 0xf3, 0xbf, 0x7f, 0xbf, 0x66, 0xfc, 0xf3, 0xfb, 0x35,
+	0xe3, 0xeb, 0x1f, 0xd6, 0x8c, 0x6f, 0x7e, 0x58, 0x33, 0x7e, 0x79, 0xe7, 0x62, 0xa6, 0x42, 0xc7,
+	0x4e, 0x2b, 0xb6, 0x36, 0x98, 0x17, 0xe4, 0xee, 0xc3, 0xff, 0x0e, 0x00, 0x02, 0x51, 0xec, 0x9f,
+	0xad, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1078,9 +2451,19 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Status(ctx context.Context, in *StatusParam, opts ...grpc.CallOption) (*rpc.ResultStatus, error)
+	// GetCapabilities lists the features supported by this node
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesParam, opts ...grpc.CallOption) (*rpc.ResultCapabilities, error)
 	GetAccount(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*acm.Account, error)
 	GetMetadata(ctx context.Context, in *GetMetadataParam, opts ...grpc.CallOption) (*MetadataResult, error)
 	GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error)
+	// GetStorageRange returns a page of the storage of a contract in order of key
+	GetStorageRange(ctx context.Context, in *GetStorageRangeParam, opts ...grpc.CallOption) (*StorageRange, error)
+	// GetStateBatch returns many accounts and storage values in one call, all read from the state at the same height
+	GetStateBatch(ctx context.Context, in *GetStateBatchParam, opts ...grpc.CallOption) (*StateBatch, error)
+	// GetDisassembly returns the annotated assembly of the EVM code deployed at an address
+	GetDisassembly(ctx context.Context, in *GetDisassemblyParam, opts ...grpc.CallOption) (*Disassembly, error)
+	// GetCode returns the runtime code deployed at an address, optionally with its disassembly
+	GetCode(ctx context.Context, in *GetCodeParam, opts ...grpc.CallOption) (*Code, error)
 	ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error)
 	GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error)
 	ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error)
@@ -1088,10 +2471,27 @@ type QueryClient interface {
 	GetNetworkRegistry(ctx context.Context, in *GetNetworkRegistryParam, opts ...grpc.CallOption) (*NetworkRegistry, error)
 	GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error)
 	GetValidatorSetHistory(ctx context.Context, in *GetValidatorSetHistoryParam, opts ...grpc.CallOption) (*ValidatorSetHistory, error)
+	// GetHeartbeat returns the latest HeartbeatTx executed for a validator
+	GetHeartbeat(ctx context.Context, in *GetHeartbeatParam, opts ...grpc.CallOption) (*heartbeat.Heartbeat, error)
+	// ListHeartbeats returns the latest heartbeat of each validator that has sent one, optionally filtered by a query on
+	// their fields (e.g. "Height < 1000")
+	ListHeartbeats(ctx context.Context, in *ListHeartbeatsParam, opts ...grpc.CallOption) (Query_ListHeartbeatsClient, error)
 	GetProposal(ctx context.Context, in *GetProposalParam, opts ...grpc.CallOption) (*payload.Ballot, error)
 	ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error)
+	// ListScheduledGovTxs returns the GovTxs that are pending application at a future height
+	ListScheduledGovTxs(ctx context.Context, in *ListScheduledGovTxsParam, opts ...grpc.CallOption) (Query_ListScheduledGovTxsClient, error)
+	// GetEscrow returns an open escrow held by the Escrow native contract
+	GetEscrow(ctx context.Context, in *GetEscrowParam, opts ...grpc.CallOption) (*escrow.Escrow, error)
+	// ListEscrows returns the open escrows held by the Escrow native contract, optionally filtered by a query on their
+	// fields (e.g. "Payee = '...' AND Disputed = true")
+	ListEscrows(ctx context.Context, in *ListEscrowsParam, opts ...grpc.CallOption) (Query_ListEscrowsClient, error)
 	GetStats(ctx context.Context, in *GetStatsParam, opts ...grpc.CallOption) (*Stats, error)
+	// GetLogSequence returns the Sequence of the last LogEvent emitted by an address
+	GetLogSequence(ctx context.Context, in *GetLogSequenceParam, opts ...grpc.CallOption) (*LogSequence, error)
 	GetBlockHeader(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.Header, error)
+	// GetAccountActivity returns a page of the transactions in which an account was involved in order of execution,
+	// summarising each as the activity of the account with the height and time of its block
+	GetAccountActivity(ctx context.Context, in *GetAccountActivityParam, opts ...grpc.CallOption) (*AccountActivity, error)
 }
 
 type queryClient struct {
@@ -1111,6 +2511,15 @@ func (c *queryClient) Status(ctx context.Context, in *StatusParam, opts ...grpc.
 	return out, nil
 }
 
+func (c *queryClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesParam, opts ...grpc.CallOption) (*rpc.ResultCapabilities, error) {
+	out := new(rpc.ResultCapabilities)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetAccount(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*acm.Account, error) {
 	out := new(acm.Account)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetAccount", in, out, opts...)
@@ -1120,18 +2529,54 @@ func (c *queryClient) GetAccount(ctx context.Context, in *GetAccountParam, opts
 	return out, nil
 }
 
-func (c *queryClient) GetMetadata(ctx context.Context, in *GetMetadataParam, opts ...grpc.CallOption) (*MetadataResult, error) {
-	out := new(MetadataResult)
-	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetMetadata", in, out, opts...)
+func (c *queryClient) GetMetadata(ctx context.Context, in *GetMetadataParam, opts ...grpc.CallOption) (*MetadataResult, error) {
+	out := new(MetadataResult)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error) {
+	out := new(StorageValue)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStorageRange(ctx context.Context, in *GetStorageRangeParam, opts ...grpc.CallOption) (*StorageRange, error) {
+	out := new(StorageRange)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStorageRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStateBatch(ctx context.Context, in *GetStateBatchParam, opts ...grpc.CallOption) (*StateBatch, error) {
+	out := new(StateBatch)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDisassembly(ctx context.Context, in *GetDisassemblyParam, opts ...grpc.CallOption) (*Disassembly, error) {
+	out := new(Disassembly)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetDisassembly", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error) {
-	out := new(StorageValue)
-	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStorage", in, out, opts...)
+func (c *queryClient) GetCode(ctx context.Context, in *GetCodeParam, opts ...grpc.CallOption) (*Code, error) {
+	out := new(Code)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetCode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1238,6 +2683,47 @@ func (c *queryClient) GetValidatorSetHistory(ctx context.Context, in *GetValidat
 	return out, nil
 }
 
+func (c *queryClient) GetHeartbeat(ctx context.Context, in *GetHeartbeatParam, opts ...grpc.CallOption) (*heartbeat.Heartbeat, error) {
+	out := new(heartbeat.Heartbeat)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListHeartbeats(ctx context.Context, in *ListHeartbeatsParam, opts ...grpc.CallOption) (Query_ListHeartbeatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/rpcquery.Query/ListHeartbeats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListHeartbeatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListHeartbeatsClient interface {
+	Recv() (*heartbeat.Heartbeat, error)
+	grpc.ClientStream
+}
+
+type queryListHeartbeatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListHeartbeatsClient) Recv() (*heartbeat.Heartbeat, error) {
+	m := new(heartbeat.Heartbeat)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetProposal(ctx context.Context, in *GetProposalParam, opts ...grpc.CallOption) (*payload.Ballot, error) {
 	out := new(payload.Ballot)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetProposal", in, out, opts...)
@@ -1248,7 +2734,7 @@ func (c *queryClient) GetProposal(ctx context.Context, in *GetProposalParam, opt
 }
 
 func (c *queryClient) ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/rpcquery.Query/ListProposals", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[3], "/rpcquery.Query/ListProposals", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1279,6 +2765,79 @@ func (x *queryListProposalsClient) Recv() (*ProposalResult, error) {
 	return m, nil
 }
 
+func (c *queryClient) ListScheduledGovTxs(ctx context.Context, in *ListScheduledGovTxsParam, opts ...grpc.CallOption) (Query_ListScheduledGovTxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[4], "/rpcquery.Query/ListScheduledGovTxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListScheduledGovTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListScheduledGovTxsClient interface {
+	Recv() (*payload.ScheduledGovTx, error)
+	grpc.ClientStream
+}
+
+type queryListScheduledGovTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListScheduledGovTxsClient) Recv() (*payload.ScheduledGovTx, error) {
+	m := new(payload.ScheduledGovTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) GetEscrow(ctx context.Context, in *GetEscrowParam, opts ...grpc.CallOption) (*escrow.Escrow, error) {
+	out := new(escrow.Escrow)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListEscrows(ctx context.Context, in *ListEscrowsParam, opts ...grpc.CallOption) (Query_ListEscrowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[5], "/rpcquery.Query/ListEscrows", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListEscrowsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListEscrowsClient interface {
+	Recv() (*escrow.Escrow, error)
+	grpc.ClientStream
+}
+
+type queryListEscrowsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListEscrowsClient) Recv() (*escrow.Escrow, error) {
+	m := new(escrow.Escrow)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetStats(ctx context.Context, in *GetStatsParam, opts ...grpc.CallOption) (*Stats, error) {
 	out := new(Stats)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStats", in, out, opts...)
@@ -1288,6 +2847,15 @@ func (c *queryClient) GetStats(ctx context.Context, in *GetStatsParam, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) GetLogSequence(ctx context.Context, in *GetLogSequenceParam, opts ...grpc.CallOption) (*LogSequence, error) {
+	out := new(LogSequence)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetLogSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetBlockHeader(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.Header, error) {
 	out := new(types.Header)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetBlockHeader", in, out, opts...)
@@ -1297,12 +2865,31 @@ func (c *queryClient) GetBlockHeader(ctx context.Context, in *GetBlockParam, opt
 	return out, nil
 }
 
+func (c *queryClient) GetAccountActivity(ctx context.Context, in *GetAccountActivityParam, opts ...grpc.CallOption) (*AccountActivity, error) {
+	out := new(AccountActivity)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetAccountActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Status(context.Context, *StatusParam) (*rpc.ResultStatus, error)
+	// GetCapabilities lists the features supported by this node
+	GetCapabilities(context.Context, *GetCapabilitiesParam) (*rpc.ResultCapabilities, error)
 	GetAccount(context.Context, *GetAccountParam) (*acm.Account, error)
 	GetMetadata(context.Context, *GetMetadataParam) (*MetadataResult, error)
 	GetStorage(context.Context, *GetStorageParam) (*StorageValue, error)
+	// GetStorageRange returns a page of the storage of a contract in order of key
+	GetStorageRange(context.Context, *GetStorageRangeParam) (*StorageRange, error)
+	// GetStateBatch returns many accounts and storage values in one call, all read from the state at the same height
+	GetStateBatch(context.Context, *GetStateBatchParam) (*StateBatch, error)
+	// GetDisassembly returns the annotated assembly of the EVM code deployed at an address
+	GetDisassembly(context.Context, *GetDisassemblyParam) (*Disassembly, error)
+	// GetCode returns the runtime code deployed at an address, optionally with its disassembly
+	GetCode(context.Context, *GetCodeParam) (*Code, error)
 	ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error
 	GetName(context.Context, *GetNameParam) (*names.Entry, error)
 	ListNames(*ListNamesParam, Query_ListNamesServer) error
@@ -1310,10 +2897,27 @@ type QueryServer interface {
 	GetNetworkRegistry(context.Context, *GetNetworkRegistryParam) (*NetworkRegistry, error)
 	GetValidatorSet(context.Context, *GetValidatorSetParam) (*ValidatorSet, error)
 	GetValidatorSetHistory(context.Context, *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error)
+	// GetHeartbeat returns the latest HeartbeatTx executed for a validator
+	GetHeartbeat(context.Context, *GetHeartbeatParam) (*heartbeat.Heartbeat, error)
+	// ListHeartbeats returns the latest heartbeat of each validator that has sent one, optionally filtered by a query on
+	// their fields (e.g. "Height < 1000")
+	ListHeartbeats(*ListHeartbeatsParam, Query_ListHeartbeatsServer) error
 	GetProposal(context.Context, *GetProposalParam) (*payload.Ballot, error)
 	ListProposals(*ListProposalsParam, Query_ListProposalsServer) error
+	// ListScheduledGovTxs returns the GovTxs that are pending application at a future height
+	ListScheduledGovTxs(*ListScheduledGovTxsParam, Query_ListScheduledGovTxsServer) error
+	// GetEscrow returns an open escrow held by the Escrow native contract
+	GetEscrow(context.Context, *GetEscrowParam) (*escrow.Escrow, error)
+	// ListEscrows returns the open escrows held by the Escrow native contract, optionally filtered by a query on their
+	// fields (e.g. "Payee = '...' AND Disputed = true")
+	ListEscrows(*ListEscrowsParam, Query_ListEscrowsServer) error
 	GetStats(context.Context, *GetStatsParam) (*Stats, error)
+	// GetLogSequence returns the Sequence of the last LogEvent emitted by an address
+	GetLogSequence(context.Context, *GetLogSequenceParam) (*LogSequence, error)
 	GetBlockHeader(context.Context, *GetBlockParam) (*types.Header, error)
+	// GetAccountActivity returns a page of the transactions in which an account was involved in order of execution,
+	// summarising each as the activity of the account with the height and time of its block
+	GetAccountActivity(context.Context, *GetAccountActivityParam) (*AccountActivity, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1323,6 +2927,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Status(ctx context.Context, req *StatusParam) (*rpc.ResultStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedQueryServer) GetCapabilities(ctx context.Context, req *GetCapabilitiesParam) (*rpc.ResultCapabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (*UnimplementedQueryServer) GetAccount(ctx context.Context, req *GetAccountParam) (*acm.Account, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
@@ -1332,6 +2939,18 @@ func (*UnimplementedQueryServer) GetMetadata(ctx context.Context, req *GetMetada
 func (*UnimplementedQueryServer) GetStorage(ctx context.Context, req *GetStorageParam) (*StorageValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStorage not implemented")
 }
+func (*UnimplementedQueryServer) GetStorageRange(ctx context.Context, req *GetStorageRangeParam) (*StorageRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageRange not implemented")
+}
+func (*UnimplementedQueryServer) GetStateBatch(ctx context.Context, req *GetStateBatchParam) (*StateBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateBatch not implemented")
+}
+func (*UnimplementedQueryServer) GetDisassembly(ctx context.Context, req *GetDisassemblyParam) (*Disassembly, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDisassembly not implemented")
+}
+func (*UnimplementedQueryServer) GetCode(ctx context.Context, req *GetCodeParam) (*Code, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCode not implemented")
+}
 func (*UnimplementedQueryServer) ListAccounts(req *ListAccountsParam, srv Query_ListAccountsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
@@ -1350,18 +2969,39 @@ func (*UnimplementedQueryServer) GetValidatorSet(ctx context.Context, req *GetVa
 func (*UnimplementedQueryServer) GetValidatorSetHistory(ctx context.Context, req *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetHistory not implemented")
 }
+func (*UnimplementedQueryServer) GetHeartbeat(ctx context.Context, req *GetHeartbeatParam) (*heartbeat.Heartbeat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeartbeat not implemented")
+}
+func (*UnimplementedQueryServer) ListHeartbeats(req *ListHeartbeatsParam, srv Query_ListHeartbeatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHeartbeats not implemented")
+}
 func (*UnimplementedQueryServer) GetProposal(ctx context.Context, req *GetProposalParam) (*payload.Ballot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
 }
 func (*UnimplementedQueryServer) ListProposals(req *ListProposalsParam, srv Query_ListProposalsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
 }
+func (*UnimplementedQueryServer) ListScheduledGovTxs(req *ListScheduledGovTxsParam, srv Query_ListScheduledGovTxsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListScheduledGovTxs not implemented")
+}
+func (*UnimplementedQueryServer) GetEscrow(ctx context.Context, req *GetEscrowParam) (*escrow.Escrow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscrow not implemented")
+}
+func (*UnimplementedQueryServer) ListEscrows(req *ListEscrowsParam, srv Query_ListEscrowsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListEscrows not implemented")
+}
 func (*UnimplementedQueryServer) GetStats(ctx context.Context, req *GetStatsParam) (*Stats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
+func (*UnimplementedQueryServer) GetLogSequence(ctx context.Context, req *GetLogSequenceParam) (*LogSequence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogSequence not implemented")
+}
 func (*UnimplementedQueryServer) GetBlockHeader(ctx context.Context, req *GetBlockParam) (*types.Header, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeader not implemented")
 }
+func (*UnimplementedQueryServer) GetAccountActivity(ctx context.Context, req *GetAccountActivityParam) (*AccountActivity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountActivity not implemented")
+}
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1385,6 +3025,24 @@ func _Query_Status_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCapabilities(ctx, req.(*GetCapabilitiesParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccountParam)
 	if err := dec(in); err != nil {
@@ -1439,6 +3097,78 @@ func _Query_GetStorage_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetStorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageRangeParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetStorageRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStorageRange(ctx, req.(*GetStorageRangeParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateBatchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetStateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStateBatch(ctx, req.(*GetStateBatchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDisassembly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisassemblyParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDisassembly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetDisassembly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDisassembly(ctx, req.(*GetDisassemblyParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodeParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCode(ctx, req.(*GetCodeParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListAccountsParam)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1553,42 +3283,141 @@ func _Query_GetValidatorSetHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProposalParam)
+func _Query_GetHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeartbeatParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetHeartbeat(ctx, req.(*GetHeartbeatParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListHeartbeats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHeartbeatsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListHeartbeats(m, &queryListHeartbeatsServer{stream})
+}
+
+type Query_ListHeartbeatsServer interface {
+	Send(*heartbeat.Heartbeat) error
+	grpc.ServerStream
+}
+
+type queryListHeartbeatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListHeartbeatsServer) Send(m *heartbeat.Heartbeat) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProposal(ctx, req.(*GetProposalParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListProposals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProposalsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListProposals(m, &queryListProposalsServer{stream})
+}
+
+type Query_ListProposalsServer interface {
+	Send(*ProposalResult) error
+	grpc.ServerStream
+}
+
+type queryListProposalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListProposalsServer) Send(m *ProposalResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ListScheduledGovTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListScheduledGovTxsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListScheduledGovTxs(m, &queryListScheduledGovTxsServer{stream})
+}
+
+type Query_ListScheduledGovTxsServer interface {
+	Send(*payload.ScheduledGovTx) error
+	grpc.ServerStream
+}
+
+type queryListScheduledGovTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListScheduledGovTxsServer) Send(m *payload.ScheduledGovTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_GetEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscrowParam)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetProposal(ctx, in)
+		return srv.(QueryServer).GetEscrow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcquery.Query/GetProposal",
+		FullMethod: "/rpcquery.Query/GetEscrow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProposal(ctx, req.(*GetProposalParam))
+		return srv.(QueryServer).GetEscrow(ctx, req.(*GetEscrowParam))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ListProposals_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListProposalsParam)
+func _Query_ListEscrows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEscrowsParam)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(QueryServer).ListProposals(m, &queryListProposalsServer{stream})
+	return srv.(QueryServer).ListEscrows(m, &queryListEscrowsServer{stream})
 }
 
-type Query_ListProposalsServer interface {
-	Send(*ProposalResult) error
+type Query_ListEscrowsServer interface {
+	Send(*escrow.Escrow) error
 	grpc.ServerStream
 }
 
-type queryListProposalsServer struct {
+type queryListEscrowsServer struct {
 	grpc.ServerStream
 }
 
-func (x *queryListProposalsServer) Send(m *ProposalResult) error {
+func (x *queryListEscrowsServer) Send(m *escrow.Escrow) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -1610,6 +3439,24 @@ func _Query_GetStats_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetLogSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogSequenceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLogSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetLogSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLogSequence(ctx, req.(*GetLogSequenceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockParam)
 	if err := dec(in); err != nil {
@@ -1628,6 +3475,24 @@ func _Query_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetAccountActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountActivityParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetAccountActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountActivity(ctx, req.(*GetAccountActivityParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcquery.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1636,6 +3501,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Query_Status_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Query_GetCapabilities_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _Query_GetAccount_Handler,
@@ -1648,6 +3517,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStorage",
 			Handler:    _Query_GetStorage_Handler,
 		},
+		{
+			MethodName: "GetStorageRange",
+			Handler:    _Query_GetStorageRange_Handler,
+		},
+		{
+			MethodName: "GetStateBatch",
+			Handler:    _Query_GetStateBatch_Handler,
+		},
+		{
+			MethodName: "GetDisassembly",
+			Handler:    _Query_GetDisassembly_Handler,
+		},
+		{
+			MethodName: "GetCode",
+			Handler:    _Query_GetCode_Handler,
+		},
 		{
 			MethodName: "GetName",
 			Handler:    _Query_GetName_Handler,
@@ -1664,18 +3549,34 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorSetHistory",
 			Handler:    _Query_GetValidatorSetHistory_Handler,
 		},
+		{
+			MethodName: "GetHeartbeat",
+			Handler:    _Query_GetHeartbeat_Handler,
+		},
 		{
 			MethodName: "GetProposal",
 			Handler:    _Query_GetProposal_Handler,
 		},
+		{
+			MethodName: "GetEscrow",
+			Handler:    _Query_GetEscrow_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _Query_GetStats_Handler,
 		},
+		{
+			MethodName: "GetLogSequence",
+			Handler:    _Query_GetLogSequence_Handler,
+		},
 		{
 			MethodName: "GetBlockHeader",
 			Handler:    _Query_GetBlockHeader_Handler,
 		},
+		{
+			MethodName: "GetAccountActivity",
+			Handler:    _Query_GetAccountActivity_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1688,26 +3589,275 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_ListNames_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListHeartbeats",
+			Handler:       _Query_ListHeartbeats_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListProposals",
 			Handler:       _Query_ListProposals_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListScheduledGovTxs",
+			Handler:       _Query_ListScheduledGovTxs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListEscrows",
+			Handler:       _Query_ListEscrows_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpcquery.proto",
 }
 
-func (m *StatusParam) Size() (n int) {
+func (m *StatusParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockTimeWithin)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.BlockSeenTimeWithin)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCapabilitiesParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAccountParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMetadataParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.MetadataHash != nil {
+		l = m.MetadataHash.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.CodeHash != nil {
+		l = m.CodeHash.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.SourceFile)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Deployment != nil {
+		l = m.Deployment.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDisassemblyParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Disassembly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Instructions) > 0 {
+		for _, e := range m.Instructions {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if len(m.Selectors) > 0 {
+		for _, e := range m.Selectors {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FunctionSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Selector.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Destination != 0 {
+		n += 1 + sovRpcquery(uint64(m.Destination))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCodeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Disassemble {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Code) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Code.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.WASM {
+		n += 2
+	}
+	if m.Disassembly != nil {
+		l = m.Disassembly.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Instruction) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BlockTimeWithin)
+	if m.PC != 0 {
+		n += 1 + sovRpcquery(uint64(m.PC))
+	}
+	l = len(m.OpCode)
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
-	l = len(m.BlockSeenTimeWithin)
+	l = m.Immediate.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.Label)
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
@@ -1717,7 +3867,7 @@ func (m *StatusParam) Size() (n int) {
 	return n
 }
 
-func (m *GetAccountParam) Size() (n int) {
+func (m *GetStorageParam) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1725,40 +3875,69 @@ func (m *GetAccountParam) Size() (n int) {
 	_ = l
 	l = m.Address.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetMetadataParam) Size() (n int) {
+func (m *StorageValue) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Address != nil {
-		l = m.Address.Size()
-		n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.MetadataHash != nil {
-		l = m.MetadataHash.Size()
+	return n
+}
+
+func (m *GetStorageRangeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.After != nil {
+		l = m.After.Size()
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MetadataResult) Size() (n int) {
+func (m *StorageRange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Metadata)
-	if l > 0 {
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.Next != nil {
+		l = m.Next.Size()
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -1767,30 +3946,70 @@ func (m *MetadataResult) Size() (n int) {
 	return n
 }
 
-func (m *GetStorageParam) Size() (n int) {
+func (m *StorageEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
 	l = m.Key.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *StorageValue) Size() (n int) {
+func (m *GetStateBatchParam) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1807,6 +4026,19 @@ func (m *ListAccountsParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1839,6 +4071,13 @@ func (m *ListNamesParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1869,6 +4108,36 @@ func (m *GetValidatorSetParam) Size() (n int) {
 	return n
 }
 
+func (m *GetHeartbeatParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListHeartbeatsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetValidatorSetHistoryParam) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2010,6 +4279,170 @@ func (m *ProposalResult) Size() (n int) {
 	return n
 }
 
+func (m *ListScheduledGovTxsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEscrowParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcquery(uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListEscrowsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetLogSequenceParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovRpcquery(uint64(m.Sequence))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAccountActivityParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
+	if m.Descending {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activities) > 0 {
+		for _, e := range m.Activities {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	l = m.Next.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Activity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.TxType != 0 {
+		n += 1 + sovRpcquery(uint64(m.TxType))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovRpcquery(uint64(e))
+		}
+		n += 1 + sovRpcquery(uint64(l)) + l
+	}
+	if m.Counterparty != nil {
+		l = m.Counterparty.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRpcquery(uint64(m.Amount))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.PermArgs != nil {
+		l = m.PermArgs.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetStatsParam) Size() (n int) {
 	if m == nil {
 		return 0
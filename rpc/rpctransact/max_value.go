@@ -0,0 +1,67 @@
+package rpctransact
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/chainparams"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// MaxCallValue returns the largest value the input of tx can transfer given its balance in accounts once the fee has
+// been paid. The fee of tx is raised to the minimum fee in params unless tx is a fee exempt call. Gas is not charged
+// for so only the fee is deducted from the balance.
+func MaxCallValue(accounts acmstate.Reader, params chainparams.Reader, tx *payload.CallTx) (*MaxValue, error) {
+	if tx.Input == nil {
+		return nil, fmt.Errorf("CallTx must have an input from which to transfer value")
+	}
+	acc, err := accounts.GetAccount(tx.Input.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, errors.Errorf(errors.Codes.InvalidAddress, "cannot find input account %v", tx.Input.Address)
+	}
+	fee := tx.Fee
+	minFee, err := chainparams.MinFee(params)
+	if err != nil {
+		return nil, err
+	}
+	if fee < minFee {
+		feeExempt, err := chainparams.FeeExempt(params, tx)
+		if err != nil {
+			return nil, err
+		}
+		if !feeExempt {
+			fee = minFee
+		}
+	}
+	separateGas, err := chainparams.SeparateGasToken(params)
+	if err != nil {
+		return nil, err
+	}
+	if separateGas {
+		// The fee is paid in the gas token so the whole balance can be sent
+		if acc.GasBalance < fee {
+			return nil, errors.Errorf(errors.Codes.InsufficientFunds,
+				"input account %v (gas balance: %d) cannot cover fee of %d", acc.Address, acc.GasBalance, fee)
+		}
+		return &MaxValue{
+			Value:   acc.Balance,
+			Fee:     fee,
+			Amount:  acc.Balance,
+			Balance: acc.Balance,
+		}, nil
+	}
+	if acc.Balance < fee {
+		return nil, errors.Errorf(errors.Codes.InsufficientFunds,
+			"input account %v (balance: %d) cannot cover fee of %d", acc.Address, acc.Balance, fee)
+	}
+	return &MaxValue{
+		Value:   acc.Balance - fee,
+		Fee:     fee,
+		Amount:  acc.Balance,
+		Balance: acc.Balance,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package rpctransact
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type chainParams payload.ChainParams
+
+func (cp *chainParams) GetChainParams() (*payload.ChainParams, error) {
+	return (*payload.ChainParams)(cp), nil
+}
+
+func TestMaxCallValue(t *testing.T) {
+	input := crypto.Address{1, 2, 3}
+	callee := crypto.Address{4, 5, 6}
+	st := acmstate.NewMemoryState()
+	err := st.UpdateAccount(&acm.Account{Address: input, Balance: 1000})
+	require.NoError(t, err)
+	params := &chainParams{
+		MinFee:         10,
+		FeeExemptCalls: []*payload.FeeExemptCall{{Caller: input, Callee: callee}},
+	}
+
+	tx := &payload.CallTx{Input: &payload.TxInput{Address: input}, Fee: 20}
+	maxValue, err := MaxCallValue(st, params, tx)
+	require.NoError(t, err)
+	assert.Equal(t, &MaxValue{Value: 980, Fee: 20, Amount: 1000, Balance: 1000}, maxValue)
+
+	// The fee is raised to the minimum fee
+	tx.Fee = 1
+	maxValue, err = MaxCallValue(st, params, tx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(990), maxValue.Value)
+	assert.Equal(t, uint64(10), maxValue.Fee)
+
+	// Unless the call is exempt
+	tx.Address = &callee
+	maxValue, err = MaxCallValue(st, params, tx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(999), maxValue.Value)
+	assert.Equal(t, uint64(1), maxValue.Fee)
+
+	tx.Fee = 1001
+	_, err = MaxCallValue(st, params, tx)
+	assert.Equal(t, errors.Codes.InsufficientFunds, errors.GetCode(err))
+
+	// With a separate gas token the fee comes out of the gas balance
+	params.SeparateGasToken = true
+	err = st.UpdateAccount(&acm.Account{Address: input, Balance: 1000, GasBalance: 1001})
+	require.NoError(t, err)
+	maxValue, err = MaxCallValue(st, params, tx)
+	require.NoError(t, err)
+	assert.Equal(t, &MaxValue{Value: 1000, Fee: 1001, Amount: 1000, Balance: 1000}, maxValue)
+
+	tx.Input.Address = callee
+	_, err = MaxCallValue(st, params, tx)
+	assert.Equal(t, errors.Codes.InvalidAddress, errors.GetCode(err))
+}
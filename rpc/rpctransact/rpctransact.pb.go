@@ -99,6 +99,85 @@ func (*CallCodeParam) XXX_MessageName() string {
 	return "rpctransact.CallCodeParam"
 }
 
+type MaxValue struct {
+	// The largest value that can be transferred
+	Value uint64 `protobuf:"varint,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	// The fee the transaction must pay, which is raised to the minimum fee unless the call is fee exempt
+	Fee uint64 `protobuf:"varint,2,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	// The Input.Amount of a CallTx transferring Value and paying Fee (their sum)
+	Amount uint64 `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// The balance of the input account including the effect of transactions pending in the mempool
+	Balance              uint64   `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaxValue) Reset()         { *m = MaxValue{} }
+func (m *MaxValue) String() string { return proto.CompactTextString(m) }
+func (*MaxValue) ProtoMessage()    {}
+func (*MaxValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+}
+func (m *MaxValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxValue.Merge(m, src)
+}
+func (m *MaxValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxValue proto.InternalMessageInfo
+
+func (m *MaxValue) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *MaxValue) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *MaxValue) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MaxValue) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (*MaxValue) XXX_MessageName() string {
+	return "rpctransact.MaxValue"
+}
+
 type TxEnvelope struct {
 	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
@@ -110,7 +189,7 @@ func (m *TxEnvelope) Reset()         { *m = TxEnvelope{} }
 func (m *TxEnvelope) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelope) ProtoMessage()    {}
 func (*TxEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+	return fileDescriptor_039da6ebb58a8dc9, []int{2}
 }
 func (m *TxEnvelope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,6 +222,70 @@ func (*TxEnvelope) XXX_MessageName() string {
 	return "rpctransact.TxEnvelope"
 }
 
+// A payload.Any encoded as JSON in which any string value may be a placeholder:
+//   - '@name:<name>' resolves to the data registered under <name> in the name registry
+//   - '@seq' as the Sequence of an input resolves to the next sequence number for that input's account
+//   - a leading '@@' escapes a literal '@'
+type TxTemplateParam struct {
+	Template string `protobuf:"bytes,1,opt,name=Template,proto3" json:"Template,omitempty"`
+	// Sign the resolved transaction server-side with a key held by (or delegated to) the node
+	Sign                 bool     `protobuf:"varint,2,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxTemplateParam) Reset()         { *m = TxTemplateParam{} }
+func (m *TxTemplateParam) String() string { return proto.CompactTextString(m) }
+func (*TxTemplateParam) ProtoMessage()    {}
+func (*TxTemplateParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{3}
+}
+func (m *TxTemplateParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxTemplateParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxTemplateParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxTemplateParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxTemplateParam.Merge(m, src)
+}
+func (m *TxTemplateParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxTemplateParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxTemplateParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxTemplateParam proto.InternalMessageInfo
+
+func (m *TxTemplateParam) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *TxTemplateParam) GetSign() bool {
+	if m != nil {
+		return m.Sign
+	}
+	return false
+}
+
+func (*TxTemplateParam) XXX_MessageName() string {
+	return "rpctransact.TxTemplateParam"
+}
+
 type TxEnvelopeParam struct {
 	// An existing Envelope - either signed or unsigned - if the latter will be signed server-side
 	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
@@ -161,7 +304,7 @@ func (m *TxEnvelopeParam) Reset()         { *m = TxEnvelopeParam{} }
 func (m *TxEnvelopeParam) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelopeParam) ProtoMessage()    {}
 func (*TxEnvelopeParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_039da6ebb58a8dc9, []int{2}
+	return fileDescriptor_039da6ebb58a8dc9, []int{4}
 }
 func (m *TxEnvelopeParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,8 +353,12 @@ func (*TxEnvelopeParam) XXX_MessageName() string {
 func init() {
 	proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
 	golang_proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
+	proto.RegisterType((*MaxValue)(nil), "rpctransact.MaxValue")
+	golang_proto.RegisterType((*MaxValue)(nil), "rpctransact.MaxValue")
 	proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	golang_proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
+	proto.RegisterType((*TxTemplateParam)(nil), "rpctransact.TxTemplateParam")
+	golang_proto.RegisterType((*TxTemplateParam)(nil), "rpctransact.TxTemplateParam")
 	proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 	golang_proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 }
@@ -220,42 +367,49 @@ func init() { proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58
 func init() { golang_proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
 
 var fileDescriptor_039da6ebb58a8dc9 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x7c, 0xf4, 0x63, 0xdc, 0x2a, 0x74, 0x2f, 0x84, 0x08, 0x39, 0x28, 0x07, 0x84, 0x50,
-	0xbb, 0x8e, 0x42, 0x8f, 0x7c, 0x28, 0x4e, 0xdb, 0x23, 0xaa, 0x1c, 0x0b, 0x09, 0x6e, 0x1b, 0x7b,
-	0x71, 0x2d, 0xd9, 0x5e, 0x6b, 0xbd, 0x06, 0xe7, 0x57, 0x70, 0xe1, 0xc0, 0xcf, 0xe1, 0x98, 0x23,
-	0x12, 0xb7, 0x1e, 0x02, 0x4a, 0xff, 0x08, 0xf2, 0xae, 0xdd, 0xda, 0x49, 0xd3, 0x72, 0xe1, 0x36,
-	0xfb, 0x66, 0xdf, 0xdb, 0x79, 0x33, 0xb3, 0xb0, 0xc7, 0x13, 0x57, 0x70, 0x12, 0xa7, 0xc4, 0x15,
-	0x38, 0xe1, 0x4c, 0x30, 0xa4, 0xd7, 0xa0, 0xce, 0x81, 0x1f, 0x88, 0xb3, 0x6c, 0x82, 0x5d, 0x16,
-	0x99, 0x3e, 0xf3, 0x99, 0x29, 0xef, 0x4c, 0xb2, 0x4f, 0xf2, 0x24, 0x0f, 0x32, 0x52, 0xdc, 0x8e,
-	0xe1, 0x33, 0xe6, 0x87, 0xf4, 0xea, 0x96, 0x97, 0x71, 0x22, 0x02, 0x16, 0x97, 0x79, 0xa0, 0x39,
-	0x75, 0xcb, 0x78, 0x37, 0x21, 0xd3, 0x90, 0x11, 0xaf, 0x3c, 0x6e, 0x8b, 0x3c, 0x55, 0x61, 0xef,
-	0xab, 0x06, 0xbb, 0x23, 0x12, 0x86, 0x23, 0xe6, 0xd1, 0x53, 0xc2, 0x49, 0x84, 0xde, 0x83, 0x7e,
-	0xc2, 0x59, 0x34, 0xf4, 0x3c, 0x4e, 0xd3, 0xb4, 0xad, 0x3d, 0xd5, 0x9e, 0xef, 0x58, 0x87, 0xb3,
-	0x79, 0xf7, 0xce, 0xf9, 0xbc, 0xbb, 0x5f, 0xab, 0xf1, 0x6c, 0x9a, 0x50, 0x1e, 0x52, 0xcf, 0xa7,
-	0xdc, 0x9c, 0x64, 0x9c, 0xb3, 0x2f, 0xa6, 0xcb, 0xa7, 0x89, 0x60, 0xb8, 0xe4, 0xda, 0x75, 0x21,
-	0x84, 0xe0, 0x7e, 0xf1, 0x48, 0xfb, 0x6e, 0x21, 0x68, 0xcb, 0xb8, 0xc0, 0x8e, 0x88, 0x20, 0xed,
-	0x7b, 0x0a, 0x2b, 0xe2, 0x9e, 0x0f, 0xe0, 0xe4, 0xc7, 0xf1, 0x67, 0x1a, 0xb2, 0x84, 0xa2, 0x0f,
-	0xb0, 0x55, 0xc5, 0xb2, 0x14, 0x7d, 0xb0, 0x8b, 0x8b, 0xea, 0x2b, 0xd0, 0xc2, 0xe7, 0xf3, 0xee,
-	0x8b, 0x9b, 0xab, 0xaa, 0xdf, 0xb7, 0x2f, 0xe5, 0x7a, 0xbf, 0x34, 0x68, 0x5d, 0xbd, 0xa4, 0xcc,
-	0xff, 0xbf, 0xe7, 0xd0, 0x33, 0xd8, 0x3c, 0x55, 0x53, 0x90, 0x2d, 0xd0, 0x07, 0x3b, 0xb8, 0x9a,
-	0xca, 0x30, 0x9e, 0xda, 0x55, 0x12, 0xbd, 0x86, 0x4d, 0x27, 0x88, 0x28, 0xcb, 0x84, 0x6c, 0x8b,
-	0x3e, 0x78, 0x8c, 0xd5, 0xa4, 0x71, 0x35, 0x69, 0x7c, 0x54, 0x4e, 0xda, 0xda, 0x2a, 0xc6, 0xf2,
-	0xfd, 0x77, 0x57, 0xb3, 0x2b, 0xce, 0xe0, 0xdb, 0x03, 0xd8, 0x72, 0xca, 0x95, 0x42, 0x16, 0xb4,
-	0x2c, 0xce, 0x88, 0xe7, 0x92, 0x54, 0x38, 0xf9, 0x78, 0x1a, 0xbb, 0xe8, 0x09, 0xae, 0xaf, 0xe1,
-	0x92, 0xff, 0xce, 0x1e, 0x96, 0x5b, 0xe3, 0xe4, 0xc7, 0x39, 0x75, 0xb3, 0xe2, 0x0d, 0xf4, 0x06,
-	0x1e, 0xd6, 0x34, 0x86, 0xe9, 0xed, 0x22, 0x3b, 0xb2, 0x65, 0x36, 0x75, 0x69, 0x90, 0x08, 0xf4,
-	0x16, 0x36, 0xc6, 0x81, 0x1f, 0x3b, 0xf9, 0x2d, 0xac, 0x47, 0x6b, 0xb2, 0xe8, 0x10, 0xf4, 0x13,
-	0xc6, 0xa3, 0x2c, 0x24, 0x82, 0x3a, 0x39, 0x6a, 0xb4, 0x6d, 0x3d, 0xab, 0x0f, 0x50, 0xec, 0x75,
-	0xe9, 0xba, 0x75, 0x49, 0x52, 0xe0, 0x75, 0x46, 0xf7, 0x41, 0x57, 0x49, 0xe5, 0x71, 0x85, 0xd2,
-	0xb4, 0x65, 0xc2, 0x76, 0xa9, 0x1f, 0x44, 0xff, 0x24, 0xff, 0x4a, 0xc9, 0x17, 0x7b, 0x5f, 0x50,
-	0x3a, 0x8d, 0xc2, 0x1b, 0x5f, 0xf0, 0x3a, 0x76, 0x1f, 0x60, 0x4c, 0x63, 0x6f, 0xc5, 0x8e, 0x02,
-	0xd7, 0xd8, 0x51, 0xc9, 0x65, 0x3b, 0x25, 0xa5, 0x69, 0xa7, 0x0f, 0xf0, 0x8e, 0x44, 0x74, 0x45,
-	0x5f, 0x81, 0x6b, 0xf4, 0x55, 0x72, 0x59, 0xbf, 0xa4, 0x34, 0xf4, 0xad, 0xd1, 0x6c, 0x61, 0x68,
-	0x3f, 0x17, 0x86, 0xf6, 0x67, 0x61, 0x68, 0x3f, 0x2e, 0x0c, 0x6d, 0x76, 0x61, 0x68, 0x1f, 0x0f,
-	0x6e, 0xfe, 0x49, 0x3c, 0x71, 0xcd, 0x5a, 0x97, 0x26, 0x1b, 0xf2, 0x07, 0xbc, 0xfc, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x70, 0x12, 0x34, 0xbe, 0x4a, 0x05, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4b, 0x6f, 0xd3, 0x4c,
+	0x14, 0xfd, 0xfc, 0x35, 0xa4, 0xe9, 0x75, 0x4b, 0xdb, 0xe1, 0x15, 0x22, 0x94, 0xa0, 0x2c, 0x10,
+	0x42, 0xad, 0x53, 0x85, 0x2e, 0x58, 0xf0, 0x50, 0xdc, 0xc7, 0x0a, 0x50, 0xe5, 0x5a, 0x95, 0x60,
+	0x37, 0xb1, 0x07, 0x37, 0x92, 0xed, 0xb1, 0xc6, 0x63, 0x70, 0x7e, 0x05, 0x5b, 0x16, 0xfc, 0x18,
+	0x96, 0x5d, 0x22, 0xb1, 0xeb, 0xa2, 0xa0, 0xf6, 0x8f, 0xa0, 0x79, 0x38, 0xd8, 0x69, 0xd2, 0xb2,
+	0x61, 0x77, 0x1f, 0x73, 0xce, 0xdc, 0x39, 0x3e, 0xd7, 0xb0, 0xce, 0x12, 0x8f, 0x33, 0x1c, 0xa7,
+	0xd8, 0xe3, 0x56, 0xc2, 0x28, 0xa7, 0xc8, 0x2c, 0x95, 0x5a, 0x9b, 0xc1, 0x88, 0x1f, 0x67, 0x43,
+	0xcb, 0xa3, 0x51, 0x2f, 0xa0, 0x01, 0xed, 0xc9, 0x33, 0xc3, 0xec, 0x83, 0xcc, 0x64, 0x22, 0x23,
+	0x85, 0x6d, 0xb5, 0x03, 0x4a, 0x83, 0x90, 0xfc, 0x39, 0xe5, 0x67, 0x0c, 0xf3, 0x11, 0x8d, 0x75,
+	0x1f, 0x48, 0x4e, 0x3c, 0x1d, 0xaf, 0x24, 0x78, 0x1c, 0x52, 0xec, 0xeb, 0x74, 0x89, 0xe7, 0xa9,
+	0x0a, 0xbb, 0x9f, 0x0d, 0x58, 0xd9, 0xc1, 0x61, 0xb8, 0x43, 0x7d, 0x72, 0x80, 0x19, 0x8e, 0xd0,
+	0x11, 0x98, 0xfb, 0x8c, 0x46, 0x03, 0xdf, 0x67, 0x24, 0x4d, 0x9b, 0xc6, 0x43, 0xe3, 0xf1, 0xb2,
+	0xbd, 0x7d, 0x72, 0xd6, 0xf9, 0xef, 0xf4, 0xac, 0xb3, 0x51, 0x9a, 0xf1, 0x78, 0x9c, 0x10, 0x16,
+	0x12, 0x3f, 0x20, 0xac, 0x37, 0xcc, 0x18, 0xa3, 0x9f, 0x7a, 0x1e, 0x1b, 0x27, 0x9c, 0x5a, 0x1a,
+	0xeb, 0x94, 0x89, 0x10, 0x82, 0x9a, 0xb8, 0xa4, 0xf9, 0xbf, 0x20, 0x74, 0x64, 0x2c, 0x6a, 0xbb,
+	0x98, 0xe3, 0xe6, 0x82, 0xaa, 0x89, 0xb8, 0xeb, 0x43, 0xe3, 0x0d, 0xce, 0x8f, 0x70, 0x98, 0x11,
+	0x74, 0x1b, 0x6e, 0xc8, 0x40, 0x4e, 0x51, 0x73, 0x54, 0x82, 0xd6, 0x60, 0x61, 0x9f, 0x28, 0xa2,
+	0x9a, 0x23, 0x42, 0x74, 0x17, 0xea, 0x83, 0x88, 0x66, 0x31, 0x97, 0x4c, 0x35, 0x47, 0x67, 0xa8,
+	0x09, 0x8b, 0x36, 0x0e, 0x71, 0xec, 0x91, 0x66, 0x4d, 0x36, 0x8a, 0xb4, 0x1b, 0x00, 0xb8, 0xf9,
+	0x5e, 0xfc, 0x91, 0x84, 0x34, 0x21, 0xe8, 0x1d, 0x34, 0x8a, 0x58, 0x5e, 0x65, 0xf6, 0x57, 0x2c,
+	0xa1, 0x51, 0x51, 0xb4, 0xad, 0xd3, 0xb3, 0xce, 0x93, 0xab, 0xdf, 0x5e, 0x3e, 0xef, 0x4c, 0xe8,
+	0xba, 0x03, 0x58, 0x75, 0x73, 0x97, 0x44, 0x49, 0x88, 0xb9, 0x56, 0xb8, 0x05, 0x8d, 0xa2, 0x20,
+	0x6f, 0x5b, 0x72, 0x26, 0xb9, 0x50, 0xe4, 0x70, 0x14, 0xc4, 0xf2, 0x71, 0x0d, 0x47, 0xc6, 0xdd,
+	0x1f, 0x86, 0xe0, 0x28, 0x18, 0x15, 0xc7, 0xbf, 0x9b, 0x18, 0x3d, 0x82, 0xc5, 0x03, 0x65, 0x17,
+	0x39, 0x85, 0xd9, 0x5f, 0xb6, 0x0a, 0xfb, 0x0c, 0xe2, 0xb1, 0x53, 0x34, 0xd1, 0x0b, 0x58, 0x74,
+	0x47, 0x11, 0xa1, 0x99, 0x52, 0xdd, 0xec, 0xdf, 0xb7, 0x94, 0x25, 0xad, 0xc2, 0x92, 0xd6, 0xae,
+	0xb6, 0xa4, 0xdd, 0x10, 0xfe, 0xf9, 0xf2, 0xb3, 0x63, 0x38, 0x05, 0xa6, 0xff, 0xb5, 0x0e, 0x0d,
+	0x57, 0x7b, 0x1f, 0xd9, 0xb0, 0x6a, 0x33, 0x8a, 0x7d, 0x0f, 0xa7, 0xdc, 0xcd, 0x0f, 0xc7, 0xb1,
+	0x87, 0x1e, 0x58, 0xe5, 0x7d, 0x99, 0x7a, 0x7f, 0x6b, 0xdd, 0x92, 0xf6, 0x76, 0xf3, 0xbd, 0x9c,
+	0x78, 0x99, 0xb8, 0x03, 0xbd, 0x84, 0xb5, 0x12, 0xc7, 0x20, 0xbd, 0x9e, 0x64, 0x59, 0x4a, 0xe6,
+	0x10, 0x8f, 0x8c, 0x12, 0x8e, 0x5e, 0x41, 0x5d, 0xc8, 0xed, 0xe6, 0xd7, 0xa0, 0xee, 0xcd, 0xe9,
+	0xa2, 0x6d, 0x30, 0xf7, 0x29, 0x8b, 0x32, 0xf1, 0x21, 0xdd, 0x1c, 0x55, 0x64, 0x9b, 0x8f, 0x7a,
+	0x0d, 0xb7, 0x4a, 0xa8, 0x89, 0x11, 0xa6, 0x67, 0xa8, 0x58, 0x68, 0x3e, 0xdb, 0x16, 0x80, 0x58,
+	0x67, 0xad, 0xe1, 0xea, 0x64, 0x04, 0x55, 0x9c, 0x25, 0xdb, 0x06, 0x98, 0xaa, 0x39, 0x48, 0x67,
+	0x42, 0xaa, 0x22, 0xf5, 0x60, 0x49, 0xf3, 0x8f, 0xa2, 0xbf, 0xa2, 0x7f, 0xae, 0xe8, 0xc5, 0xba,
+	0x0b, 0x48, 0xab, 0x32, 0x78, 0xe5, 0xcf, 0x33, 0x0b, 0xfd, 0x0c, 0x6e, 0x2a, 0xea, 0xc9, 0x2f,
+	0xe1, 0xd2, 0x9d, 0x77, 0x2a, 0x8c, 0x93, 0x73, 0x5b, 0x00, 0x87, 0x24, 0xf6, 0x2f, 0x09, 0xa1,
+	0x8a, 0x73, 0x84, 0x50, 0xcd, 0x69, 0x21, 0x34, 0xa4, 0x2a, 0xc4, 0x16, 0xc0, 0x5b, 0x1c, 0x91,
+	0x4b, 0xfc, 0xaa, 0x38, 0x87, 0x5f, 0x35, 0xa7, 0xf9, 0x35, 0xa4, 0xc2, 0x6f, 0xef, 0x9c, 0x9c,
+	0xb7, 0x8d, 0xef, 0xe7, 0x6d, 0xe3, 0xd7, 0x79, 0xdb, 0xf8, 0x76, 0xd1, 0x36, 0x4e, 0x2e, 0xda,
+	0xc6, 0xfb, 0xcd, 0xab, 0x37, 0x9a, 0x25, 0x5e, 0xaf, 0xa4, 0xc6, 0xb0, 0x2e, 0x37, 0xf1, 0xe9,
+	0xef, 0x01, 0x00, 0x3a, 0x24, 0xf4, 0xe6, 0x7b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +433,9 @@ type TransactClient interface {
 	SignTx(ctx context.Context, in *TxEnvelopeParam, opts ...grpc.CallOption) (*TxEnvelope, error)
 	// Formulate a transaction from a Payload and retrun the envelop with the Tx bytes ready to sign
 	FormulateTx(ctx context.Context, in *payload.Any, opts ...grpc.CallOption) (*TxEnvelope, error)
+	// Formulate a transaction from a template by resolving its placeholders server-side and return the envelope with
+	// the Tx bytes ready to sign or, if requested, signed server-side
+	FormulateTxTemplate(ctx context.Context, in *TxTemplateParam, opts ...grpc.CallOption) (*TxEnvelope, error)
 	// Formulate and sign a CallTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	CallTxSync(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate and sign a CallTx transaction signed server-side
@@ -288,6 +445,9 @@ type TransactClient interface {
 	CallTxSim(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(ctx context.Context, in *CallCodeParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Compute the largest value the input of a CallTx can transfer such that its balance still covers the fee - the
+	// Input.Amount of the CallTx is ignored
+	CallTxMaxValue(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*MaxValue, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -342,6 +502,15 @@ func (c *transactClient) FormulateTx(ctx context.Context, in *payload.Any, opts
 	return out, nil
 }
 
+func (c *transactClient) FormulateTxTemplate(ctx context.Context, in *TxTemplateParam, opts ...grpc.CallOption) (*TxEnvelope, error) {
+	out := new(TxEnvelope)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/FormulateTxTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) CallTxSync(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
 	err := c.cc.Invoke(ctx, "/rpctransact.Transact/CallTxSync", in, out, opts...)
@@ -378,6 +547,15 @@ func (c *transactClient) CallCodeSim(ctx context.Context, in *CallCodeParam, opt
 	return out, nil
 }
 
+func (c *transactClient) CallTxMaxValue(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*MaxValue, error) {
+	out := new(MaxValue)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/CallTxMaxValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
 	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxSync", in, out, opts...)
@@ -425,6 +603,9 @@ type TransactServer interface {
 	SignTx(context.Context, *TxEnvelopeParam) (*TxEnvelope, error)
 	// Formulate a transaction from a Payload and retrun the envelop with the Tx bytes ready to sign
 	FormulateTx(context.Context, *payload.Any) (*TxEnvelope, error)
+	// Formulate a transaction from a template by resolving its placeholders server-side and return the envelope with
+	// the Tx bytes ready to sign or, if requested, signed server-side
+	FormulateTxTemplate(context.Context, *TxTemplateParam) (*TxEnvelope, error)
 	// Formulate and sign a CallTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	CallTxSync(context.Context, *payload.CallTx) (*exec.TxExecution, error)
 	// Formulate and sign a CallTx transaction signed server-side
@@ -434,6 +615,9 @@ type TransactServer interface {
 	CallTxSim(context.Context, *payload.CallTx) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(context.Context, *CallCodeParam) (*exec.TxExecution, error)
+	// Compute the largest value the input of a CallTx can transfer such that its balance still covers the fee - the
+	// Input.Amount of the CallTx is ignored
+	CallTxMaxValue(context.Context, *payload.CallTx) (*MaxValue, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(context.Context, *payload.SendTx) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -460,6 +644,9 @@ func (*UnimplementedTransactServer) SignTx(ctx context.Context, req *TxEnvelopeP
 func (*UnimplementedTransactServer) FormulateTx(ctx context.Context, req *payload.Any) (*TxEnvelope, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FormulateTx not implemented")
 }
+func (*UnimplementedTransactServer) FormulateTxTemplate(ctx context.Context, req *TxTemplateParam) (*TxEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormulateTxTemplate not implemented")
+}
 func (*UnimplementedTransactServer) CallTxSync(ctx context.Context, req *payload.CallTx) (*exec.TxExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallTxSync not implemented")
 }
@@ -472,6 +659,9 @@ func (*UnimplementedTransactServer) CallTxSim(ctx context.Context, req *payload.
 func (*UnimplementedTransactServer) CallCodeSim(ctx context.Context, req *CallCodeParam) (*exec.TxExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallCodeSim not implemented")
 }
+func (*UnimplementedTransactServer) CallTxMaxValue(ctx context.Context, req *payload.CallTx) (*MaxValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallTxMaxValue not implemented")
+}
 func (*UnimplementedTransactServer) SendTxSync(ctx context.Context, req *payload.SendTx) (*exec.TxExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTxSync not implemented")
 }
@@ -561,6 +751,24 @@ func _Transact_FormulateTx_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_FormulateTxTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxTemplateParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).FormulateTxTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/FormulateTxTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).FormulateTxTemplate(ctx, req.(*TxTemplateParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_CallTxSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.CallTx)
 	if err := dec(in); err != nil {
@@ -633,6 +841,24 @@ func _Transact_CallCodeSim_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_CallTxMaxValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.CallTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).CallTxMaxValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/CallTxMaxValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).CallTxMaxValue(ctx, req.(*payload.CallTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_SendTxSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.SendTx)
 	if err := dec(in); err != nil {
@@ -725,6 +951,10 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FormulateTx",
 			Handler:    _Transact_FormulateTx_Handler,
 		},
+		{
+			MethodName: "FormulateTxTemplate",
+			Handler:    _Transact_FormulateTxTemplate_Handler,
+		},
 		{
 			MethodName: "CallTxSync",
 			Handler:    _Transact_CallTxSync_Handler,
@@ -741,6 +971,10 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallCodeSim",
 			Handler:    _Transact_CallCodeSim_Handler,
 		},
+		{
+			MethodName: "CallTxMaxValue",
+			Handler:    _Transact_CallTxMaxValue_Handler,
+		},
 		{
 			MethodName: "SendTxSync",
 			Handler:    _Transact_SendTxSync_Handler,
@@ -813,6 +1047,53 @@ func (m *CallCodeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Balance != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Fee != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Value != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -852,6 +1133,50 @@ func (m *TxEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxTemplateParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxTemplateParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxTemplateParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Sign {
+		i--
+		if m.Sign {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -944,6 +1269,30 @@ func (m *CallCodeParam) Size() (n int) {
 	return n
 }
 
+func (m *MaxValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovRpctransact(uint64(m.Value))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovRpctransact(uint64(m.Fee))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRpctransact(uint64(m.Amount))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovRpctransact(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TxEnvelope) Size() (n int) {
 	if m == nil {
 		return 0
@@ -960,6 +1309,25 @@ func (m *TxEnvelope) Size() (n int) {
 	return n
 }
 
+func (m *TxTemplateParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Sign {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TxEnvelopeParam) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1143,6 +1511,136 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaxValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1233,6 +1731,112 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxTemplateParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxTemplateParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxTemplateParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sign", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sign = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,154 @@
+package rpctransact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+const (
+	placeholderPrefix   = "@"
+	escapedPrefix       = "@@"
+	namePlaceholder     = "@name:"
+	sequencePlaceholder = "@seq"
+	addressField        = "Address"
+	sequenceField       = "Sequence"
+)
+
+// Resolves the placeholders in a JSON-encoded payload.Any template (see TxTemplateParam) against the provided state
+func ResolveTemplate(template string, accounts acmstate.AccountGetter, nameReg names.Reader) (*payload.Any, error) {
+	decoder := json.NewDecoder(strings.NewReader(template))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode transaction template: %v", err)
+	}
+	resolver := &templateResolver{
+		accounts: accounts,
+		nameReg:  nameReg,
+	}
+	value, err = resolver.resolve(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve transaction template: %v", err)
+	}
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder = json.NewDecoder(bytes.NewReader(bs))
+	decoder.DisallowUnknownFields()
+	tx := new(payload.Any)
+	err = decoder.Decode(tx)
+	if err != nil {
+		return nil, fmt.Errorf("resolved transaction template is not a valid payload: %v", err)
+	}
+	if tx.GetValue() == nil {
+		return nil, fmt.Errorf("transaction template contains no payload")
+	}
+	return tx, nil
+}
+
+type templateResolver struct {
+	accounts acmstate.AccountGetter
+	nameReg  names.Reader
+}
+
+func (tr *templateResolver) resolve(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return tr.resolveObject(v)
+	case []interface{}:
+		for i, elem := range v {
+			resolved, err := tr.resolve(elem)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+		return v, nil
+	case string:
+		return tr.resolveString(v)
+	default:
+		return v, nil
+	}
+}
+
+func (tr *templateResolver) resolveObject(obj map[string]interface{}) (interface{}, error) {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == sequenceField && obj[key] == sequencePlaceholder {
+			// Resolved once the object's address is known
+			continue
+		}
+		resolved, err := tr.resolve(obj[key])
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = resolved
+	}
+	if obj[sequenceField] == sequencePlaceholder {
+		sequence, err := tr.nextSequence(obj[addressField])
+		if err != nil {
+			return nil, err
+		}
+		obj[sequenceField] = sequence
+	}
+	return obj, nil
+}
+
+func (tr *templateResolver) resolveString(str string) (interface{}, error) {
+	switch {
+	case !strings.HasPrefix(str, placeholderPrefix):
+		return str, nil
+	case strings.HasPrefix(str, escapedPrefix):
+		return str[len(placeholderPrefix):], nil
+	case strings.HasPrefix(str, namePlaceholder):
+		name := str[len(namePlaceholder):]
+		entry, err := tr.nameReg.GetName(name)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			return nil, fmt.Errorf("placeholder %s refers to name '%s' that is not registered", str, name)
+		}
+		return entry.Data, nil
+	case str == sequencePlaceholder:
+		return nil, fmt.Errorf("placeholder %s must be the %s of an object with an %s", str, sequenceField,
+			addressField)
+	default:
+		return nil, fmt.Errorf("unknown placeholder %s (use %s to escape a literal %s)", str, escapedPrefix,
+			placeholderPrefix)
+	}
+}
+
+func (tr *templateResolver) nextSequence(addressValue interface{}) (uint64, error) {
+	addressString, ok := addressValue.(string)
+	if !ok {
+		return 0, fmt.Errorf("placeholder %s requires a sibling %s but got: %v", sequencePlaceholder,
+			addressField, addressValue)
+	}
+	address, err := crypto.AddressFromHexString(addressString)
+	if err != nil {
+		return 0, err
+	}
+	acc, err := tr.accounts.GetAccount(address)
+	if err != nil {
+		return 0, err
+	}
+	if acc == nil {
+		return 0, fmt.Errorf("placeholder %s refers to account %v that does not exist", sequencePlaceholder, address)
+	}
+	return acc.Sequence + 1, nil
+}
@@ -0,0 +1,50 @@
+package rpctransact
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nameMap map[string]*names.Entry
+
+func (nm nameMap) GetName(name string) (*names.Entry, error) {
+	return nm[name], nil
+}
+
+func TestResolveTemplate(t *testing.T) {
+	input := crypto.Address{1, 2, 3}
+	contract := crypto.Address{4, 5, 6}
+	st := acmstate.NewMemoryState()
+	err := st.UpdateAccount(&acm.Account{Address: input, Sequence: 41})
+	require.NoError(t, err)
+	nameReg := nameMap{"contract": {Name: "contract", Data: contract.String()}}
+
+	tx, err := ResolveTemplate(fmt.Sprintf(`{"CallTx": {"Input": {"Address": "%v", "Amount": 1, "Sequence": "@seq"},
+		"Address": "@name:contract", "GasLimit": 100}}`, input), st, nameReg)
+	require.NoError(t, err)
+	require.NotNil(t, tx.CallTx)
+	assert.Equal(t, uint64(42), tx.CallTx.Input.Sequence)
+	assert.Equal(t, contract, *tx.CallTx.Address)
+	assert.Equal(t, uint64(100), tx.CallTx.GasLimit)
+
+	tx, err = ResolveTemplate(`{"NameTx": {"Input": {"Address": "@name:contract"}, "Name": "@@foo"}}`, st, nameReg)
+	require.NoError(t, err)
+	assert.Equal(t, "@foo", tx.NameTx.Name)
+	assert.Equal(t, contract, tx.NameTx.Input.Address)
+
+	_, err = ResolveTemplate(`{"CallTx": {"Address": "@name:missing"}}`, st, nameReg)
+	require.Error(t, err)
+
+	_, err = ResolveTemplate(`{"NameTx": {"Name": "@seq"}}`, st, nameReg)
+	require.Error(t, err)
+
+	_, err = ResolveTemplate(`{"NameTx": {"Name": "@unknown"}}`, st, nameReg)
+	require.Error(t, err)
+}
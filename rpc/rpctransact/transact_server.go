@@ -11,7 +11,12 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/chainparams"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/rpc/acl"
+	"github.com/hyperledger/burrow/rpc/auth"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"golang.org/x/net/context"
@@ -20,21 +25,33 @@ import (
 // This is probably silly
 const maxBroadcastSyncTimeout = time.Hour
 
+type TransactState interface {
+	acmstate.Reader
+	names.Reader
+	chainparams.Reader
+}
+
 type transactServer struct {
-	state      acmstate.Reader
+	state      TransactState
+	natives    *native.Natives
 	blockchain bcm.BlockchainInfo
 	transactor *execution.Transactor
+	acl        *acl.ACL
 	txCodec    txs.Codec
 	logger     *logging.Logger
 	lock       *sync.Mutex
 }
 
-func NewTransactServer(state acmstate.Reader, blockchain bcm.BlockchainInfo, transactor *execution.Transactor,
-	txCodec txs.Codec, logger *logging.Logger) TransactServer {
+// NewTransactServer returns a TransactServer that simulates calls against state with natives mounted (or the default
+// natives if nil)
+func NewTransactServer(state TransactState, natives *native.Natives, blockchain bcm.BlockchainInfo,
+	transactor *execution.Transactor, broadcastACL *acl.ACL, txCodec txs.Codec, logger *logging.Logger) TransactServer {
 	return &transactServer{
 		state:      state,
+		natives:    natives,
 		blockchain: blockchain,
 		transactor: transactor,
+		acl:        broadcastACL,
 		txCodec:    txCodec,
 		logger:     logger.WithScope("NewTransactServer()"),
 		lock:       &sync.Mutex{},
@@ -52,6 +69,10 @@ func (ts *transactServer) BroadcastTxSync(ctx context.Context, param *TxEnvelope
 	if txEnv == nil {
 		return nil, fmt.Errorf("%s no transaction envelope or payload provided", errHeader)
 	}
+	err := ts.acl.Authorize(auth.Identity(ctx), txEnv)
+	if err != nil {
+		return nil, err
+	}
 	return ts.transactor.BroadcastTxSync(ctx, txEnv)
 }
 
@@ -64,6 +85,10 @@ func (ts *transactServer) BroadcastTxAsync(ctx context.Context, param *TxEnvelop
 	if txEnv == nil {
 		return nil, fmt.Errorf("%s no transaction envelope or payload provided", errHeader)
 	}
+	err := ts.acl.Authorize(auth.Identity(ctx), txEnv)
+	if err != nil {
+		return nil, err
+	}
 	return ts.transactor.BroadcastTxAsync(ctx, txEnv)
 }
 
@@ -91,6 +116,18 @@ func (ts *transactServer) FormulateTx(ctx context.Context, param *payload.Any) (
 	}, nil
 }
 
+func (ts *transactServer) FormulateTxTemplate(ctx context.Context, param *TxTemplateParam) (*TxEnvelope, error) {
+	// Sequence numbers are taken from the mempool so that the transaction will follow any that are pending
+	tx, err := ResolveTemplate(param.Template, ts.transactor.MempoolAccounts, ts.state)
+	if err != nil {
+		return nil, err
+	}
+	if param.Sign {
+		return ts.SignTx(ctx, &TxEnvelopeParam{Payload: tx})
+	}
+	return ts.FormulateTx(ctx, tx)
+}
+
 func (ts *transactServer) CallTxSync(ctx context.Context, param *payload.CallTx) (*exec.TxExecution, error) {
 	return ts.BroadcastTxSync(ctx, &TxEnvelopeParam{Payload: param.Any()})
 }
@@ -105,16 +142,21 @@ func (ts *transactServer) CallTxSim(ctx context.Context, param *payload.CallTx)
 	}
 	ts.lock.Lock()
 	defer ts.lock.Unlock()
-	return execution.CallSim(ts.state, ts.blockchain, param.Input.Address, *param.Address, param.Data, ts.logger)
+	return execution.CallSim(ts.state, ts.state, ts.natives, ts.blockchain, param.Input.Address, *param.Address, param.Data, ts.logger)
 }
 
 func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam) (*exec.TxExecution, error) {
 	ts.lock.Lock()
 	defer ts.lock.Unlock()
-	return execution.CallCodeSim(ts.state, ts.blockchain, param.FromAddress, param.FromAddress, param.Code, param.Data,
+	return execution.CallCodeSim(ts.state, ts.state, ts.natives, ts.blockchain, param.FromAddress, param.FromAddress, param.Code, param.Data,
 		ts.logger)
 }
 
+func (ts *transactServer) CallTxMaxValue(ctx context.Context, param *payload.CallTx) (*MaxValue, error) {
+	// Balances are taken from the mempool so that the value leaves enough to pay for any transactions that are pending
+	return MaxCallValue(ts.transactor.MempoolAccounts, ts.state, param)
+}
+
 func (ts *transactServer) SendTxSync(ctx context.Context, param *payload.SendTx) (*exec.TxExecution, error) {
 	return ts.BroadcastTxSync(ctx, &TxEnvelopeParam{Payload: param.Any()})
 }
@@ -0,0 +1,566 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpcverify.proto
+
+package rpcverify
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type VerifyParam struct {
+	// The address of the deployed contract
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The name of the Solidity file, which must match that used when the contract was compiled for an exact match
+	SourceFile string `protobuf:"bytes,2,opt,name=SourceFile,proto3" json:"SourceFile,omitempty"`
+	// The Solidity source
+	Source string `protobuf:"bytes,3,opt,name=Source,proto3" json:"Source,omitempty"`
+	// The name of the contract within the source
+	ContractName string `protobuf:"bytes,4,opt,name=ContractName,proto3" json:"ContractName,omitempty"`
+	Optimize     bool   `protobuf:"varint,5,opt,name=Optimize,proto3" json:"Optimize,omitempty"`
+	// Addresses of linked libraries by library name
+	Libraries map[string]string `protobuf:"bytes,6,rep,name=Libraries,proto3" json:"Libraries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set the compiler available to the node must be of this version
+	CompilerVersion      string   `protobuf:"bytes,7,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyParam) Reset()         { *m = VerifyParam{} }
+func (m *VerifyParam) String() string { return proto.CompactTextString(m) }
+func (*VerifyParam) ProtoMessage()    {}
+func (*VerifyParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b2e6d32c5cc73a, []int{0}
+}
+func (m *VerifyParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyParam.Unmarshal(m, b)
+}
+func (m *VerifyParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyParam.Marshal(b, m, deterministic)
+}
+func (m *VerifyParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyParam.Merge(m, src)
+}
+func (m *VerifyParam) XXX_Size() int {
+	return xxx_messageInfo_VerifyParam.Size(m)
+}
+func (m *VerifyParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyParam proto.InternalMessageInfo
+
+func (m *VerifyParam) GetSourceFile() string {
+	if m != nil {
+		return m.SourceFile
+	}
+	return ""
+}
+
+func (m *VerifyParam) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *VerifyParam) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *VerifyParam) GetOptimize() bool {
+	if m != nil {
+		return m.Optimize
+	}
+	return false
+}
+
+func (m *VerifyParam) GetLibraries() map[string]string {
+	if m != nil {
+		return m.Libraries
+	}
+	return nil
+}
+
+func (m *VerifyParam) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (*VerifyParam) XXX_MessageName() string {
+	return "rpcverify.VerifyParam"
+}
+
+type GetVerificationParam struct {
+	Address              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	CodeHash             github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *GetVerificationParam) Reset()         { *m = GetVerificationParam{} }
+func (m *GetVerificationParam) String() string { return proto.CompactTextString(m) }
+func (*GetVerificationParam) ProtoMessage()    {}
+func (*GetVerificationParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b2e6d32c5cc73a, []int{1}
+}
+func (m *GetVerificationParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVerificationParam.Unmarshal(m, b)
+}
+func (m *GetVerificationParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVerificationParam.Marshal(b, m, deterministic)
+}
+func (m *GetVerificationParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVerificationParam.Merge(m, src)
+}
+func (m *GetVerificationParam) XXX_Size() int {
+	return xxx_messageInfo_GetVerificationParam.Size(m)
+}
+func (m *GetVerificationParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVerificationParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVerificationParam proto.InternalMessageInfo
+
+func (*GetVerificationParam) XXX_MessageName() string {
+	return "rpcverify.GetVerificationParam"
+}
+
+type Verification struct {
+	// The contract that was verified
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The hash of the verified code
+	CodeHash        github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	SourceFile      string                                        `protobuf:"bytes,3,opt,name=SourceFile,proto3" json:"SourceFile,omitempty"`
+	Source          string                                        `protobuf:"bytes,4,opt,name=Source,proto3" json:"Source,omitempty"`
+	ContractName    string                                        `protobuf:"bytes,5,opt,name=ContractName,proto3" json:"ContractName,omitempty"`
+	CompilerVersion string                                        `protobuf:"bytes,6,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+	Optimize        bool                                          `protobuf:"varint,7,opt,name=Optimize,proto3" json:"Optimize,omitempty"`
+	Libraries       map[string]string                             `protobuf:"bytes,8,rep,name=Libraries,proto3" json:"Libraries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The contract ABI as JSON
+	Abi string `protobuf:"bytes,9,opt,name=Abi,proto3" json:"Abi,omitempty"`
+	// Whether the compiled code matched including the trailing Solidity metadata hash, which commits to the exact
+	// source and settings, rather than only the executable code
+	ExactMatch bool `protobuf:"varint,10,opt,name=ExactMatch,proto3" json:"ExactMatch,omitempty"`
+	// The block height at which the contract was verified
+	Height               uint64   `protobuf:"varint,11,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Verification) Reset()         { *m = Verification{} }
+func (m *Verification) String() string { return proto.CompactTextString(m) }
+func (*Verification) ProtoMessage()    {}
+func (*Verification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b2e6d32c5cc73a, []int{2}
+}
+func (m *Verification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Verification.Unmarshal(m, b)
+}
+func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Verification.Marshal(b, m, deterministic)
+}
+func (m *Verification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Verification.Merge(m, src)
+}
+func (m *Verification) XXX_Size() int {
+	return xxx_messageInfo_Verification.Size(m)
+}
+func (m *Verification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Verification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Verification proto.InternalMessageInfo
+
+func (m *Verification) GetSourceFile() string {
+	if m != nil {
+		return m.SourceFile
+	}
+	return ""
+}
+
+func (m *Verification) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Verification) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *Verification) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *Verification) GetOptimize() bool {
+	if m != nil {
+		return m.Optimize
+	}
+	return false
+}
+
+func (m *Verification) GetLibraries() map[string]string {
+	if m != nil {
+		return m.Libraries
+	}
+	return nil
+}
+
+func (m *Verification) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *Verification) GetExactMatch() bool {
+	if m != nil {
+		return m.ExactMatch
+	}
+	return false
+}
+
+func (m *Verification) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*Verification) XXX_MessageName() string {
+	return "rpcverify.Verification"
+}
+func init() {
+	proto.RegisterType((*VerifyParam)(nil), "rpcverify.VerifyParam")
+	golang_proto.RegisterType((*VerifyParam)(nil), "rpcverify.VerifyParam")
+	proto.RegisterMapType((map[string]string)(nil), "rpcverify.VerifyParam.LibrariesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "rpcverify.VerifyParam.LibrariesEntry")
+	proto.RegisterType((*GetVerificationParam)(nil), "rpcverify.GetVerificationParam")
+	golang_proto.RegisterType((*GetVerificationParam)(nil), "rpcverify.GetVerificationParam")
+	proto.RegisterType((*Verification)(nil), "rpcverify.Verification")
+	golang_proto.RegisterType((*Verification)(nil), "rpcverify.Verification")
+	proto.RegisterMapType((map[string]string)(nil), "rpcverify.Verification.LibrariesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "rpcverify.Verification.LibrariesEntry")
+}
+
+func init() { proto.RegisterFile("rpcverify.proto", fileDescriptor_c4b2e6d32c5cc73a) }
+func init() { golang_proto.RegisterFile("rpcverify.proto", fileDescriptor_c4b2e6d32c5cc73a) }
+
+var fileDescriptor_c4b2e6d32c5cc73a = []byte{
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6f, 0x12, 0x41,
+	0x18, 0xc7, 0x9d, 0x2e, 0xe5, 0xe5, 0x81, 0x48, 0x33, 0x69, 0xea, 0x84, 0x03, 0x10, 0x12, 0xcd,
+	0x26, 0xda, 0xc5, 0x54, 0x4d, 0xd4, 0xe8, 0x01, 0xb0, 0x4a, 0x7c, 0xa9, 0xba, 0x26, 0x3d, 0x78,
+	0x9b, 0x5d, 0xa6, 0x30, 0x11, 0x98, 0xcd, 0xb3, 0x43, 0xed, 0x7a, 0xf4, 0x23, 0xf8, 0x0d, 0xfc,
+	0x10, 0xde, 0x3d, 0xf6, 0x23, 0x18, 0x0f, 0x8d, 0x69, 0xbf, 0x88, 0x61, 0x96, 0xd2, 0x65, 0x43,
+	0x39, 0x68, 0x6f, 0xf3, 0x7f, 0x76, 0x9e, 0x3f, 0xcf, 0xcb, 0x6f, 0x80, 0x32, 0x06, 0xfe, 0xa1,
+	0x40, 0x79, 0x10, 0x39, 0x01, 0x2a, 0xad, 0x68, 0x61, 0x1e, 0xa8, 0x6c, 0xf7, 0xa5, 0x1e, 0x4c,
+	0x3c, 0xc7, 0x57, 0xa3, 0x66, 0x5f, 0xf5, 0x55, 0xd3, 0xdc, 0xf0, 0x26, 0x07, 0x46, 0x19, 0x61,
+	0x4e, 0x71, 0x66, 0xe3, 0xab, 0x05, 0xc5, 0x7d, 0x93, 0xf9, 0x8e, 0x23, 0x1f, 0xd1, 0x3d, 0xc8,
+	0xb5, 0x7a, 0x3d, 0x14, 0x61, 0xc8, 0x48, 0x9d, 0xd8, 0xa5, 0xf6, 0xfd, 0xe3, 0x93, 0xda, 0xb5,
+	0xdf, 0x27, 0xb5, 0x3b, 0x09, 0xdf, 0x41, 0x14, 0x08, 0x1c, 0x8a, 0x5e, 0x5f, 0x60, 0xd3, 0x9b,
+	0x20, 0xaa, 0xcf, 0x4d, 0x1f, 0xa3, 0x40, 0x2b, 0x67, 0x96, 0xeb, 0x9e, 0x9b, 0xd0, 0x2a, 0xc0,
+	0x07, 0x35, 0x41, 0x5f, 0x3c, 0x97, 0x43, 0xc1, 0xd6, 0xea, 0xc4, 0x2e, 0xb8, 0x89, 0x08, 0xdd,
+	0x82, 0x6c, 0xac, 0x98, 0x65, 0xbe, 0xcd, 0x14, 0x6d, 0x40, 0xa9, 0xa3, 0xc6, 0x1a, 0xb9, 0xaf,
+	0xf7, 0xf8, 0x48, 0xb0, 0x8c, 0xf9, 0xba, 0x10, 0xa3, 0x15, 0xc8, 0xbf, 0x0d, 0xb4, 0x1c, 0xc9,
+	0x2f, 0x82, 0xad, 0xd7, 0x89, 0x9d, 0x77, 0xe7, 0x9a, 0x76, 0xa0, 0xf0, 0x5a, 0x7a, 0xc8, 0x51,
+	0x8a, 0x90, 0x65, 0xeb, 0x96, 0x5d, 0xdc, 0xb9, 0xe9, 0x5c, 0x8c, 0x2d, 0xd1, 0xb2, 0x33, 0xbf,
+	0xb7, 0x3b, 0xd6, 0x18, 0xb9, 0x17, 0x79, 0xd4, 0x86, 0x72, 0x47, 0x8d, 0x02, 0x39, 0x14, 0xb8,
+	0x2f, 0x30, 0x94, 0x6a, 0xcc, 0x72, 0xa6, 0x8e, 0x74, 0xb8, 0xf2, 0x04, 0xae, 0x2f, 0xda, 0xd0,
+	0x0d, 0xb0, 0x3e, 0x89, 0xc8, 0x0c, 0xb1, 0xe0, 0x4e, 0x8f, 0x74, 0x13, 0xd6, 0x0f, 0xf9, 0x70,
+	0x72, 0x3e, 0x85, 0x58, 0x3c, 0x5e, 0x7b, 0x48, 0x1a, 0x3f, 0x08, 0x6c, 0xbe, 0x10, 0xda, 0x14,
+	0x25, 0x7d, 0xae, 0xa5, 0x1a, 0xc7, 0xdb, 0x78, 0x99, 0xde, 0xc6, 0xdd, 0x7f, 0xdf, 0xc4, 0x7b,
+	0xc8, 0x77, 0x54, 0x4f, 0x74, 0x79, 0x38, 0x30, 0x15, 0x94, 0xda, 0x0f, 0x66, 0xab, 0xdd, 0x5e,
+	0x6d, 0xe8, 0xc9, 0x31, 0xc7, 0xc8, 0xe9, 0x8a, 0xa3, 0x76, 0xa4, 0x45, 0xe8, 0xce, 0x6d, 0x1a,
+	0xdf, 0x33, 0x50, 0x4a, 0x16, 0x7d, 0xe5, 0xf4, 0x5c, 0x7d, 0xcd, 0x29, 0x20, 0xad, 0x15, 0x40,
+	0x66, 0x56, 0x02, 0xb9, 0xbe, 0x04, 0xc8, 0x25, 0xbc, 0x64, 0x97, 0xf2, 0xb2, 0x80, 0x6e, 0x2e,
+	0x85, 0xee, 0xb3, 0x24, 0xba, 0x79, 0x83, 0xee, 0xad, 0x34, 0xba, 0xb3, 0x81, 0xaf, 0x60, 0x77,
+	0x03, 0xac, 0x96, 0x27, 0x59, 0x21, 0xe6, 0xaf, 0xe5, 0xc9, 0x69, 0xe7, 0xbb, 0x47, 0xdc, 0xd7,
+	0x6f, 0xb8, 0xf6, 0x07, 0x0c, 0xcc, 0xaf, 0x26, 0x22, 0xd3, 0xce, 0xbb, 0x42, 0xf6, 0x07, 0x9a,
+	0x15, 0xeb, 0xc4, 0xce, 0xb8, 0x33, 0xf5, 0x7f, 0x6c, 0xef, 0x7c, 0x23, 0x90, 0x8f, 0x4b, 0x16,
+	0x48, 0x1f, 0x41, 0xd6, 0x9c, 0x23, 0xba, 0xb5, 0xfc, 0x31, 0x56, 0x6e, 0x5c, 0xd2, 0x29, 0x7d,
+	0x05, 0xe5, 0xd4, 0x13, 0xa1, 0xb5, 0xc4, 0xdd, 0x65, 0xcf, 0xe7, 0x52, 0xb3, 0xf6, 0xd3, 0x5f,
+	0xa7, 0x55, 0xf2, 0xe7, 0xb4, 0x4a, 0x7e, 0x9e, 0x55, 0xc9, 0xf1, 0x59, 0x95, 0x7c, 0xbc, 0xbd,
+	0x9a, 0x27, 0x0c, 0xfc, 0xe6, 0xdc, 0xcb, 0xcb, 0x9a, 0xff, 0xce, 0x7b, 0x7f, 0x07, 0x00, 0xeb,
+	0x99, 0x59, 0xa2, 0x88, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// VerifierClient is the client API for Verifier service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VerifierClient interface {
+	// Recompile the source and compare the runtime bytecode of the named contract against the code deployed at the
+	// address, storing a verification record if they match
+	Verify(ctx context.Context, in *VerifyParam, opts ...grpc.CallOption) (*Verification, error)
+	// Get the verification record for a contract by address or by code hash
+	GetVerification(ctx context.Context, in *GetVerificationParam, opts ...grpc.CallOption) (*Verification, error)
+}
+
+type verifierClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVerifierClient(cc *grpc.ClientConn) VerifierClient {
+	return &verifierClient{cc}
+}
+
+func (c *verifierClient) Verify(ctx context.Context, in *VerifyParam, opts ...grpc.CallOption) (*Verification, error) {
+	out := new(Verification)
+	err := c.cc.Invoke(ctx, "/rpcverify.Verifier/Verify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *verifierClient) GetVerification(ctx context.Context, in *GetVerificationParam, opts ...grpc.CallOption) (*Verification, error) {
+	out := new(Verification)
+	err := c.cc.Invoke(ctx, "/rpcverify.Verifier/GetVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VerifierServer is the server API for Verifier service.
+type VerifierServer interface {
+	// Recompile the source and compare the runtime bytecode of the named contract against the code deployed at the
+	// address, storing a verification record if they match
+	Verify(context.Context, *VerifyParam) (*Verification, error)
+	// Get the verification record for a contract by address or by code hash
+	GetVerification(context.Context, *GetVerificationParam) (*Verification, error)
+}
+
+// UnimplementedVerifierServer can be embedded to have forward compatible implementations.
+type UnimplementedVerifierServer struct {
+}
+
+func (*UnimplementedVerifierServer) Verify(ctx context.Context, req *VerifyParam) (*Verification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (*UnimplementedVerifierServer) GetVerification(ctx context.Context, req *GetVerificationParam) (*Verification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerification not implemented")
+}
+
+func RegisterVerifierServer(s *grpc.Server, srv VerifierServer) {
+	s.RegisterService(&_Verifier_serviceDesc, srv)
+}
+
+func _Verifier_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifierServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcverify.Verifier/Verify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifierServer).Verify(ctx, req.(*VerifyParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Verifier_GetVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerificationParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifierServer).GetVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcverify.Verifier/GetVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifierServer).GetVerification(ctx, req.(*GetVerificationParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Verifier_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcverify.Verifier",
+	HandlerType: (*VerifierServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Verify",
+			Handler:    _Verifier_Verify_Handler,
+		},
+		{
+			MethodName: "GetVerification",
+			Handler:    _Verifier_GetVerification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcverify.proto",
+}
+
+func (m *VerifyParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcverify(uint64(l))
+	l = len(m.SourceFile)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	if m.Optimize {
+		n += 2
+	}
+	if len(m.Libraries) > 0 {
+		for k, v := range m.Libraries {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRpcverify(uint64(len(k))) + 1 + len(v) + sovRpcverify(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRpcverify(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetVerificationParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcverify(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Verification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcverify(uint64(l))
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcverify(uint64(l))
+	l = len(m.SourceFile)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	if m.Optimize {
+		n += 2
+	}
+	if len(m.Libraries) > 0 {
+		for k, v := range m.Libraries {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRpcverify(uint64(len(k))) + 1 + len(v) + sovRpcverify(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRpcverify(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovRpcverify(uint64(l))
+	}
+	if m.ExactMatch {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcverify(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcverify(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcverify(x uint64) (n int) {
+	return sovRpcverify(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
@@ -0,0 +1,224 @@
+package rpcverify
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const codeHashLength = 32
+
+var (
+	// Verification by address
+	verificationKey = storage.NewMustKeyFormat("a", crypto.AddressLength)
+	// The first address verified for each code hash
+	codeHashKey = storage.NewMustKeyFormat("c", codeHashLength)
+)
+
+// Compiles Solidity source named by sourceFile
+type Compiler func(sourceFile, source string, optimize bool, libraries map[string]string) (*compile.Response, error)
+
+// Compiles with the solc found on the PATH
+func SolidityCompiler(logger *logging.Logger) Compiler {
+	return func(sourceFile, source string, optimize bool, libraries map[string]string) (*compile.Response, error) {
+		return compile.EVMSource(sourceFile, source, optimize, libraries, logger)
+	}
+}
+
+type verifyServer struct {
+	state      acmstate.Reader
+	blockchain bcm.BlockchainInfo
+	db         dbm.DB
+	compiler   Compiler
+	logger     *logging.Logger
+}
+
+var _ VerifierServer = &verifyServer{}
+
+// NewVerifyServer verifies against state storing its verification records in db, which should be local to the node
+func NewVerifyServer(state acmstate.Reader, blockchain bcm.BlockchainInfo, db dbm.DB, compiler Compiler,
+	logger *logging.Logger) *verifyServer {
+	return &verifyServer{
+		state:      state,
+		blockchain: blockchain,
+		db:         db,
+		compiler:   compiler,
+		logger:     logger.WithScope("Verifier"),
+	}
+}
+
+func (vs *verifyServer) Verify(ctx context.Context, param *VerifyParam) (*Verification, error) {
+	acc, err := vs.state.GetAccount(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil || len(acc.EVMCode) == 0 {
+		return nil, fmt.Errorf("no EVM contract deployed at %v", param.Address)
+	}
+	resp, err := vs.compiler(param.SourceFile, param.Source, param.Optimize, param.Libraries)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile %s: %v", param.SourceFile, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("could not compile %s: %s", param.SourceFile, resp.Error)
+	}
+	var contract *compile.SolidityContract
+	for i, object := range resp.Objects {
+		if object.Filename == param.SourceFile && object.Objectname == param.ContractName {
+			contract = &resp.Objects[i].Contract
+			break
+		}
+	}
+	if contract == nil {
+		return nil, fmt.Errorf("contract %s not found in compiled output of %s", param.ContractName,
+			param.SourceFile)
+	}
+	var meta compile.SolidityMetadata
+	_ = json.Unmarshal([]byte(contract.Metadata), &meta)
+	if param.CompilerVersion != "" && param.CompilerVersion != meta.Compiler.Version {
+		return nil, fmt.Errorf("compiler version %s requested but the version available is %s",
+			param.CompilerVersion, meta.Compiler.Version)
+	}
+	if strings.Contains(contract.Evm.DeployedBytecode.Object, "_") {
+		return nil, fmt.Errorf("compiled code of %s has unlinked libraries, please provide their addresses",
+			param.ContractName)
+	}
+	compiled, err := hex.DecodeString(contract.Evm.DeployedBytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode compiled code of %s: %v", param.ContractName, err)
+	}
+
+	deployed := undoCallProtection(acc.EVMCode, param.Address)
+	exact := bytes.Equal(deployed, compiled)
+	if !exact && !bytes.Equal(stripMetadata(deployed), stripMetadata(compiled)) {
+		return nil, fmt.Errorf("compiled code of %s does not match the code deployed at %v", param.ContractName,
+			param.Address)
+	}
+
+	verification := &Verification{
+		Address:         param.Address,
+		CodeHash:        acc.CodeHash,
+		SourceFile:      param.SourceFile,
+		Source:          param.Source,
+		ContractName:    param.ContractName,
+		CompilerVersion: meta.Compiler.Version,
+		Optimize:        param.Optimize,
+		Libraries:       param.Libraries,
+		Abi:             string(contract.Abi),
+		ExactMatch:      exact,
+		Height:          vs.blockchain.LastBlockHeight(),
+	}
+	existing, err := vs.get(param.Address)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.ExactMatch && !exact {
+		// Do not replace a verification of the exact source with a weaker one
+		return existing, nil
+	}
+	err = vs.put(verification)
+	if err != nil {
+		return nil, err
+	}
+	vs.logger.InfoMsg("Verified contract", "address", param.Address, "contract_name", param.ContractName,
+		"exact_match", exact)
+	return verification, nil
+}
+
+func (vs *verifyServer) GetVerification(ctx context.Context, param *GetVerificationParam) (*Verification, error) {
+	codehash := param.CodeHash
+	if param.Address != nil {
+		verification, err := vs.get(*param.Address)
+		if err != nil || verification != nil {
+			return verification, err
+		}
+		// Any other contract with the same code verifies this one
+		acc, err := vs.state.GetAccount(*param.Address)
+		if err != nil {
+			return nil, err
+		}
+		if acc == nil {
+			return nil, fmt.Errorf("no account at %v", *param.Address)
+		}
+		codehash = acc.CodeHash
+	}
+	if len(codehash) == codeHashLength {
+		bs, err := vs.db.Get(codeHashKey.Key(codehash.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		if bs != nil {
+			verification, err := vs.get(crypto.MustAddressFromBytes(bs))
+			if err != nil || verification != nil {
+				return verification, err
+			}
+		}
+	}
+	return nil, fmt.Errorf("no verification found")
+}
+
+func (vs *verifyServer) get(address crypto.Address) (*Verification, error) {
+	bs, err := vs.db.Get(verificationKey.Key(address))
+	if err != nil || bs == nil {
+		return nil, err
+	}
+	verification := new(Verification)
+	err = encoding.Decode(bs, verification)
+	if err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+func (vs *verifyServer) put(verification *Verification) error {
+	bs, err := encoding.Encode(verification)
+	if err != nil {
+		return err
+	}
+	batch := vs.db.NewBatch()
+	defer batch.Close()
+	batch.Set(verificationKey.Key(verification.Address), bs)
+	if len(verification.CodeHash) == codeHashLength {
+		key := codeHashKey.Key(verification.CodeHash.Bytes())
+		has, err := vs.db.Has(key)
+		if err != nil {
+			return err
+		}
+		if !has {
+			batch.Set(key, verification.Address.Bytes())
+		}
+	}
+	return batch.WriteSync()
+}
+
+// Libraries deployed by Solidity embed their own address to protect against being called directly, which we replace
+// with the zero address the compiler emits (see compile.GetDeployCodeHash)
+func undoCallProtection(code []byte, address crypto.Address) []byte {
+	prefix := append([]byte{byte(asm.PUSH20)}, address.Bytes()...)
+	if !bytes.HasPrefix(code, prefix) {
+		return code
+	}
+	undone := make([]byte, len(code))
+	copy(undone, code)
+	copy(undone[1:len(prefix)], make([]byte, crypto.AddressLength))
+	return undone
+}
+
+// Solidity appends a hash of the contract's metadata (and so its exact source) to the runtime code. Stripping it
+// allows code compiled from functionally identical source (e.g. differing in comments) to be compared.
+func stripMetadata(code []byte) []byte {
+	return code[:asm.SolidityMetadataStart(code)]
+}
@@ -0,0 +1,118 @@
+package rpcverify
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Runtime code followed by a (truncated) CBOR metadata map and its length
+var code = []byte{0x60, 0x80, 0x60, 0x40, 0x52, 0x00, 0xa1, 0x65, 'b', 'z', 'z', 'r', '0', 0x00, 0x07}
+
+func TestVerify(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	address := crypto.Address{1, 2, 3}
+	copyAddress := crypto.Address{4, 5, 6}
+	codehash := bytes.Repeat([]byte{7}, 32)
+	for _, a := range []crypto.Address{address, copyAddress} {
+		require.NoError(t, st.UpdateAccount(&acm.Account{Address: a, EVMCode: code, CodeHash: codehash}))
+	}
+	genesisDoc, _, _ := genesis.NewDeterministicGenesis(3450976).GenesisDoc(1, 1)
+	blockchain := bcm.NewBlockchain(dbm.NewMemDB(), genesisDoc)
+
+	vs := NewVerifyServer(st, blockchain, dbm.NewMemDB(), compiler(code), logging.NewNoopLogger())
+	ctx := context.Background()
+	param := &VerifyParam{
+		Address:      address,
+		SourceFile:   "Foo.sol",
+		Source:       "contract Foo {}",
+		ContractName: "Foo",
+	}
+
+	t.Run("Mismatch", func(t *testing.T) {
+		vs.compiler = compiler([]byte{0x60, 0x80, 0x60, 0x40, 0x52, 0x01})
+		_, err := vs.Verify(ctx, param)
+		require.Error(t, err)
+		_, err = vs.GetVerification(ctx, &GetVerificationParam{Address: &address})
+		require.Error(t, err)
+	})
+
+	t.Run("MetadataMismatch", func(t *testing.T) {
+		differentMeta := append([]byte{}, code...)
+		differentMeta[9] = 'x'
+		vs.compiler = compiler(differentMeta)
+		verification, err := vs.Verify(ctx, param)
+		require.NoError(t, err)
+		assert.False(t, verification.ExactMatch)
+	})
+
+	t.Run("ExactMatch", func(t *testing.T) {
+		vs.compiler = compiler(code)
+		verification, err := vs.Verify(ctx, param)
+		require.NoError(t, err)
+		assert.True(t, verification.ExactMatch)
+		assert.Equal(t, "0.5.12", verification.CompilerVersion)
+		assert.Equal(t, "contract Foo {}", verification.Source)
+
+		// Not downgraded
+		param.CompilerVersion = "0.5.12"
+		vs.compiler = compiler(append([]byte{0x60, 0x80, 0x60, 0x40, 0x52, 0x00}, 0xa1, 0x00, 0x01))
+		verification, err = vs.Verify(ctx, param)
+		require.NoError(t, err)
+		assert.True(t, verification.ExactMatch)
+	})
+
+	t.Run("WrongCompiler", func(t *testing.T) {
+		param.CompilerVersion = "0.4.0"
+		_, err := vs.Verify(ctx, param)
+		require.Error(t, err)
+	})
+
+	t.Run("GetVerification", func(t *testing.T) {
+		verification, err := vs.GetVerification(ctx, &GetVerificationParam{Address: &address})
+		require.NoError(t, err)
+		assert.Equal(t, address, verification.Address)
+		assert.True(t, verification.ExactMatch)
+
+		verification, err = vs.GetVerification(ctx, &GetVerificationParam{Address: &copyAddress})
+		require.NoError(t, err)
+		assert.Equal(t, address, verification.Address, "should find verification of the same code")
+
+		verification, err = vs.GetVerification(ctx, &GetVerificationParam{CodeHash: codehash})
+		require.NoError(t, err)
+		assert.Equal(t, address, verification.Address)
+	})
+}
+
+func TestUndoCallProtection(t *testing.T) {
+	address := crypto.Address{1, 2, 3}
+	library := append(append([]byte{0x73}, address.Bytes()...), 0x30, 0x14)
+	undone := undoCallProtection(library, address)
+	assert.Equal(t, append(append([]byte{0x73}, make([]byte, crypto.AddressLength)...), 0x30, 0x14), undone)
+	assert.Equal(t, address.Bytes(), library[1:21], "should not modify code")
+}
+
+func compiler(runtime []byte) Compiler {
+	return func(sourceFile, source string, optimize bool, libraries map[string]string) (*compile.Response, error) {
+		contract := compile.SolidityContract{
+			Abi:      []byte(`[]`),
+			Metadata: `{"compiler":{"version":"0.5.12"}}`,
+		}
+		contract.Evm.DeployedBytecode.Object = hex.EncodeToString(runtime)
+		return &compile.Response{
+			Objects: []compile.ResponseItem{{Filename: sourceFile, Objectname: "Foo", Contract: contract}},
+		}, nil
+	}
+}
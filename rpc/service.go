@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
@@ -16,17 +17,23 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/registry"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/project"
 	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/p2p"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
 )
 
 // Magic! Should probably be configurable, but not shouldn't be so huge we
@@ -41,13 +48,14 @@ type Service struct {
 	blockchain bcm.BlockchainInfo
 	validators validator.History
 	nodeView   *tendermint.NodeView
+	boot       *process.BootReport
 	logger     *logging.Logger
 }
 
 // Service provides an internal query and information service with serialisable return types on which can accomodate
 // a number of transport front ends
 func NewService(state acmstate.IterableStatsReader, nameReg names.IterableReader, nodeReg registry.IterableReader, blockchain bcm.BlockchainInfo,
-	validators validator.History, nodeView *tendermint.NodeView, logger *logging.Logger) *Service {
+	validators validator.History, nodeView *tendermint.NodeView, boot *process.BootReport, logger *logging.Logger) *Service {
 
 	return &Service{
 		state:      state,
@@ -56,6 +64,7 @@ func NewService(state acmstate.IterableStatsReader, nameReg names.IterableReader
 		blockchain: blockchain,
 		validators: validators,
 		nodeView:   nodeView,
+		boot:       boot,
 		logger:     logger.With(structure.ComponentKey, "Service"),
 	}
 }
@@ -97,6 +106,20 @@ func (s *Service) StatusWithin(blockTimeWithin, blockSeenTimeWithin string) (*Re
 	return Status(s.BlockchainInfo(), s.validators, s.nodeView, blockTimeWithin, blockSeenTimeWithin)
 }
 
+// BootReport returns the stages of booting this node and how long each took
+func (s *Service) BootReport() (*ResultBootReport, error) {
+	if s.boot == nil {
+		return nil, fmt.Errorf("cannot report on boot because no BootReport mounted")
+	}
+	report := s.boot.Copy()
+	return &ResultBootReport{
+		Started:  report.Started,
+		Finished: report.Finished,
+		Duration: report.Duration().String(),
+		Steps:    report.Steps,
+	}, nil
+}
+
 func (s *Service) ChainIdentifiers() (*ResultChainId, error) {
 	return &ResultChainId{
 		ChainName:   s.blockchain.GenesisDoc().ChainName,
@@ -446,6 +469,29 @@ func statusJSON(res *ResultStatus) string {
 	return string(bs)
 }
 
+// Capabilities describes the features supported by this node, with services providing the GRPC methods being served and
+// payloadTypes the transactions its executor can execute
+func Capabilities(services map[string]grpc.ServiceInfo, payloadTypes []payload.Type) *ResultCapabilities {
+	res := &ResultCapabilities{
+		BurrowVersion:      project.FullVersion(),
+		EventSchemaVersion: exec.EventSchemaVersion,
+		HardForks:          evm.HardForks,
+	}
+	for _, ty := range payloadTypes {
+		res.PayloadTypes = append(res.PayloadTypes, ty.String())
+	}
+	for name, info := range services {
+		for _, method := range info.Methods {
+			res.RPCMethods = append(res.RPCMethods, fmt.Sprintf("/%s/%s", name, method.Name))
+		}
+	}
+	sort.Strings(res.RPCMethods)
+	for _, op := range asm.OpCodes() {
+		res.Opcodes = append(res.Opcodes, op.Name())
+	}
+	return res
+}
+
 func timeWithin(now time.Time, testTime time.Time, within string) error {
 	duration, err := time.ParseDuration(within)
 	if err != nil {
@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+type TLSConfig struct {
+	Enabled bool
+	// PEM file containing the server's certificate chain
+	CertFile string
+	// PEM file containing the private key of the server's certificate
+	KeyFile string
+	// PEM file containing the CA certificates that clients' certificates must be signed by - when set clients must
+	// present a certificate (mutual TLS)
+	ClientCAFile string `json:",omitempty" toml:",omitempty"`
+	// How often to check the files for a renewed certificate or CA (e.g. "1m"), if empty they are only read at startup
+	ReloadInterval string `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultGRPCTLSConfig() *TLSConfig {
+	return &TLSConfig{
+		Enabled:        false,
+		ReloadInterval: "1m",
+	}
+}
+
+// ServerTLSConfig returns a tls.Config for a server that presents the configured certificate and, if a client CA is
+// configured, requires clients to present a certificate signed by it. New connections pick up certificates rotated
+// on disk once ReloadInterval has passed.
+func (conf *TLSConfig) ServerTLSConfig() (*tls.Config, error) {
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, fmt.Errorf("TLS requires both CertFile and KeyFile")
+	}
+	var interval time.Duration
+	if conf.ReloadInterval != "" {
+		var err error
+		interval, err = time.ParseDuration(conf.ReloadInterval)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse ReloadInterval '%s': %v", conf.ReloadInterval, err)
+		}
+	}
+	loader := &tlsLoader{conf: conf, interval: interval}
+	_, err := loader.load()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return loader.maybeReload()
+		},
+	}, nil
+}
+
+// Holds the tls.Config built from the files, rebuilding it when they change
+type tlsLoader struct {
+	sync.Mutex
+	conf      *TLSConfig
+	interval  time.Duration
+	tlsConfig *tls.Config
+	checked   time.Time
+	modTimes  []time.Time
+}
+
+func (tl *tlsLoader) maybeReload() (*tls.Config, error) {
+	tl.Lock()
+	defer tl.Unlock()
+	if tl.interval == 0 || time.Since(tl.checked) < tl.interval {
+		return tl.tlsConfig, nil
+	}
+	tl.checked = time.Now()
+	modTimes, err := tl.statFiles()
+	if err != nil {
+		// Keep serving the certificate we have rather than refuse connections
+		return tl.tlsConfig, nil
+	}
+	for i := range modTimes {
+		if !modTimes[i].Equal(tl.modTimes[i]) {
+			// A half-written renewal fails to load so is retried at the next check
+			_, _ = tl.loadLocked()
+			break
+		}
+	}
+	return tl.tlsConfig, nil
+}
+
+func (tl *tlsLoader) load() (*tls.Config, error) {
+	tl.Lock()
+	defer tl.Unlock()
+	tl.checked = time.Now()
+	return tl.loadLocked()
+}
+
+func (tl *tlsLoader) loadLocked() (*tls.Config, error) {
+	// Read the modification times first so a file replaced while we load is reloaded next time
+	modTimes, err := tl.statFiles()
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.LoadX509KeyPair(tl.conf.CertFile, tl.conf.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load TLS certificate: %v", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		// gRPC-web clients may share the listener over HTTP/1
+		NextProtos: []string{"h2", "http/1.1"},
+		MinVersion: tls.VersionTLS12,
+	}
+	if tl.conf.ClientCAFile != "" {
+		bs, err := ioutil.ReadFile(tl.conf.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client CA file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no PEM certificates found in client CA file %s", tl.conf.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	tl.tlsConfig = tlsConfig
+	tl.modTimes = modTimes
+	return tlsConfig, nil
+}
+
+func (tl *tlsLoader) statFiles() ([]time.Time, error) {
+	files := []string{tl.conf.CertFile, tl.conf.KeyFile}
+	if tl.conf.ClientCAFile != "" {
+		files = append(files, tl.conf.ClientCAFile)
+	}
+	modTimes := make([]time.Time, len(files))
+	for i, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
@@ -0,0 +1,148 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSConfig_ServerTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCertificate(t, "ca", nil)
+	otherCA := newTestCertificate(t, "other-ca", nil)
+	conf := &TLSConfig{
+		Enabled:        true,
+		CertFile:       filepath.Join(dir, "server.crt"),
+		KeyFile:        filepath.Join(dir, "server.key"),
+		ClientCAFile:   filepath.Join(dir, "ca.crt"),
+		ReloadInterval: "1ns",
+	}
+	newTestCertificate(t, "server", ca).write(t, conf.CertFile, conf.KeyFile)
+	ca.write(t, conf.ClientCAFile, "")
+
+	serverTLS, err := conf.ServerTLSConfig()
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listener = tls.NewListener(listener, serverTLS)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				conn.(*tls.Conn).Handshake()
+				conn.Close()
+			}()
+		}
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	dial := func(client *testCertificate) (*x509.Certificate, error) {
+		clientTLS := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if client != nil {
+			clientTLS.Certificates = []tls.Certificate{client.tlsCertificate()}
+		}
+		conn, err := tls.Dial("tcp", listener.Addr().String(), clientTLS)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		// Client certificates are verified after the client's side of the handshake completes
+		_, err = conn.Read(make([]byte, 1))
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return conn.ConnectionState().PeerCertificates[0], nil
+	}
+
+	// Mutual TLS
+	served, err := dial(newTestCertificate(t, "client", ca))
+	require.NoError(t, err)
+	assert.Equal(t, "server", served.Subject.CommonName)
+	_, err = dial(nil)
+	assert.Error(t, err)
+	_, err = dial(newTestCertificate(t, "client", otherCA))
+	assert.Error(t, err)
+
+	// Rotation
+	later := time.Now().Add(time.Minute)
+	newTestCertificate(t, "renewed", ca).write(t, conf.CertFile, conf.KeyFile)
+	require.NoError(t, os.Chtimes(conf.CertFile, later, later))
+	served, err = dial(newTestCertificate(t, "client", ca))
+	require.NoError(t, err)
+	assert.Equal(t, "renewed", served.Subject.CommonName)
+
+	_, err = (&TLSConfig{Enabled: true, CertFile: conf.CertFile}).ServerTLSConfig()
+	assert.Error(t, err)
+}
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// Makes a certificate for localhost signed by issuer, or a self-signed CA if issuer is nil
+func newTestCertificate(t *testing.T, name string, issuer *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	parent, signer := template, key
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCertificate{cert: cert, key: key}
+}
+
+func (tc *testCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{tc.cert.Raw}, PrivateKey: tc.key}
+}
+
+func (tc *testCertificate) write(t *testing.T, certFile, keyFile string) {
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tc.cert.Raw})
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+	if keyFile != "" {
+		der, err := x509.MarshalECPrivateKey(tc.key)
+		require.NoError(t, err)
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+		require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+	}
+}
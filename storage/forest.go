@@ -251,6 +251,72 @@ func (imf *ImmutableForest) Dump() string {
 	return dump.String()
 }
 
+// Hash returns the state hash of the forest, which is the root hash of its commits tree
+func (imf *ImmutableForest) Hash() []byte {
+	if commits, ok := imf.commitsTree.(interface{ Hash() []byte }); ok {
+		return commits.Hash()
+	}
+	return nil
+}
+
+// Prove obtains a StateProof that key is set in the tree at prefix against the hash of the forest, returns nil if the
+// key is not set
+func (imf *ImmutableForest) Prove(prefix, key []byte) (*StateProof, error) {
+	commits, ok := imf.commitsTree.(provableTree)
+	if !ok {
+		return nil, fmt.Errorf("ImmutableForest.Prove(): commits tree %T cannot provide proofs", imf.commitsTree)
+	}
+	tree, err := imf.tree(prefix)
+	if err != nil {
+		return nil, err
+	}
+	return imf.prove(commits, prefix, tree, key)
+}
+
+// Export passes a StateProof for every key of every tree in the forest to fn, in prefix then key order. Each proof can
+// be verified against the hash of the forest independently of the others.
+func (imf *ImmutableForest) Export(fn func(proof *StateProof) error) error {
+	commits, ok := imf.commitsTree.(provableTree)
+	if !ok {
+		return fmt.Errorf("ImmutableForest.Export(): commits tree %T cannot provide proofs", imf.commitsTree)
+	}
+	return imf.commitsTree.Iterate(nil, nil, true, func(prefix []byte, _ []byte) error {
+		tree, err := imf.tree(prefix)
+		if err != nil {
+			return err
+		}
+		return tree.Iterate(nil, nil, true, func(key []byte, _ []byte) error {
+			proof, err := imf.prove(commits, prefix, tree, key)
+			if err != nil {
+				return err
+			}
+			if proof == nil {
+				return fmt.Errorf("ImmutableForest.Export(): key %X of tree %X has no proof", key, prefix)
+			}
+			return fn(proof)
+		})
+	})
+}
+
+func (imf *ImmutableForest) prove(commits provableTree, prefix []byte, tree *RWTree, key []byte) (*StateProof, error) {
+	treeProof, err := existenceProof(tree, key)
+	if err != nil || treeProof == nil {
+		return nil, err
+	}
+	forestProof, err := existenceProof(commits, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if forestProof == nil {
+		return nil, fmt.Errorf("tree %X is not committed to the forest", prefix)
+	}
+	return &StateProof{
+		Prefix:      prefix,
+		TreeProof:   treeProof,
+		ForestProof: forestProof,
+	}, nil
+}
+
 // Shared implementation - these methods
 
 // Lazy load tree
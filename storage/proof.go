@@ -0,0 +1,279 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	"github.com/tendermint/iavl"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+)
+
+// IAVLSpec is the ICS-23 ProofSpec of the IAVL trees that make up a forest (both the commits tree and the trees it
+// commits to) - it matches the IavlSpec of the ICS-23 reference implementation
+var IAVLSpec = &ProofSpec{
+	LeafSpec: &LeafOp{
+		Hash:         HashOp_SHA256,
+		PrehashKey:   HashOp_NO_HASH,
+		PrehashValue: HashOp_SHA256,
+		Length:       LengthOp_VAR_PROTO,
+		Prefix:       []byte{0},
+	},
+	InnerSpec: &InnerSpec{
+		ChildOrder:      []int32{0, 1},
+		ChildSize:       33,
+		MinPrefixLength: 4,
+		MaxPrefixLength: 12,
+		Hash:            HashOp_SHA256,
+	},
+}
+
+// The subset of an IAVL tree needed to make proofs of its keys
+type provableTree interface {
+	GetWithProof(key []byte) (value []byte, proof *iavl.RangeProof, err error)
+}
+
+// Obtain an ExistenceProof that key maps to value in tree, returns nil if the key does not exist
+func existenceProof(tree provableTree, key []byte) (*ExistenceProof, error) {
+	value, proof, err := tree.GetWithProof(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof of key %X: %v", key, err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	if len(proof.Leaves) != 1 || !bytes.Equal(proof.Leaves[0].Key, key) {
+		return nil, fmt.Errorf("proof of key %X does not prove exactly that key", key)
+	}
+	leaf := proof.Leaves[0]
+	ep := &ExistenceProof{
+		Key:   key,
+		Value: value,
+		Leaf: &LeafOp{
+			Hash:         HashOp_SHA256,
+			PrehashKey:   HashOp_NO_HASH,
+			PrehashValue: HashOp_SHA256,
+			Length:       LengthOp_VAR_PROTO,
+			Prefix:       iavlNodePrefix(0, 1, leaf.Version),
+		},
+		Path: make([]*InnerOp, len(proof.LeftPath)),
+	}
+	// IAVL paths run from the root to the leaf whereas ICS-23 paths run from the leaf to the root
+	for i, node := range proof.LeftPath {
+		op := &InnerOp{
+			Hash:   HashOp_SHA256,
+			Prefix: iavlNodePrefix(node.Height, node.Size, node.Version),
+		}
+		if len(node.Left) == 0 {
+			// Our child is on the left
+			op.Prefix = appendUvarint(op.Prefix, sha256.Size)
+			op.Suffix = appendUvarint(nil, uint64(len(node.Right)))
+			op.Suffix = append(op.Suffix, node.Right...)
+		} else {
+			op.Prefix = appendUvarint(op.Prefix, uint64(len(node.Left)))
+			op.Prefix = append(op.Prefix, node.Left...)
+			op.Prefix = appendUvarint(op.Prefix, sha256.Size)
+		}
+		ep.Path[len(proof.LeftPath)-1-i] = op
+	}
+	return ep, nil
+}
+
+// Calculate returns the root hash committed to by the proof
+func (ep *ExistenceProof) Calculate() ([]byte, error) {
+	if ep.Leaf == nil {
+		return nil, fmt.Errorf("existence proof has no leaf")
+	}
+	root, err := ep.Leaf.apply(ep.Key, ep.Value)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range ep.Path {
+		root, err = op.apply(root)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// Verify checks that the proof matches spec and proves that key maps to value in the tree with root
+func (ep *ExistenceProof) Verify(spec *ProofSpec, root, key, value []byte) error {
+	if !bytes.Equal(ep.Key, key) {
+		return fmt.Errorf("proof is of key %X not %X", ep.Key, key)
+	}
+	if !bytes.Equal(ep.Value, value) {
+		return fmt.Errorf("proof is of value %X not %X", ep.Value, value)
+	}
+	err := spec.check(ep)
+	if err != nil {
+		return err
+	}
+	calculated, err := ep.Calculate()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(calculated, root) {
+		return fmt.Errorf("proof calculates root %X not %X", calculated, root)
+	}
+	return nil
+}
+
+// Verify checks that the proof proves its key and value against the state hash root
+func (sp *StateProof) Verify(root []byte) error {
+	if sp.TreeProof == nil || sp.ForestProof == nil {
+		return fmt.Errorf("state proof is incomplete")
+	}
+	if !bytes.Equal(sp.ForestProof.Key, sp.Prefix) {
+		return fmt.Errorf("forest proof is of prefix %X not %X", sp.ForestProof.Key, sp.Prefix)
+	}
+	commitID, err := unmarshalCommitID(sp.ForestProof.Value)
+	if err != nil {
+		return err
+	}
+	err = sp.TreeProof.Verify(IAVLSpec, commitID.Hash, sp.TreeProof.Key, sp.TreeProof.Value)
+	if err != nil {
+		return fmt.Errorf("could not verify key %X in tree %X: %v", sp.TreeProof.Key, sp.Prefix, err)
+	}
+	err = sp.ForestProof.Verify(IAVLSpec, root, sp.Prefix, sp.ForestProof.Value)
+	if err != nil {
+		return fmt.Errorf("could not verify tree %X in forest: %v", sp.Prefix, err)
+	}
+	return nil
+}
+
+func (spec *ProofSpec) check(ep *ExistenceProof) error {
+	leaf := spec.LeafSpec
+	if leaf != nil {
+		if ep.Leaf.Hash != leaf.Hash || ep.Leaf.PrehashKey != leaf.PrehashKey ||
+			ep.Leaf.PrehashValue != leaf.PrehashValue || ep.Leaf.Length != leaf.Length {
+			return fmt.Errorf("leaf op %v does not match spec %v", ep.Leaf, leaf)
+		}
+		if !bytes.HasPrefix(ep.Leaf.Prefix, leaf.Prefix) {
+			return fmt.Errorf("leaf prefix %X does not start with %X", ep.Leaf.Prefix, leaf.Prefix)
+		}
+	}
+	if spec.MaxDepth > 0 && len(ep.Path) > int(spec.MaxDepth) {
+		return fmt.Errorf("proof has depth %d greater than the maximum %d", len(ep.Path), spec.MaxDepth)
+	}
+	if spec.MinDepth > 0 && len(ep.Path) < int(spec.MinDepth) {
+		return fmt.Errorf("proof has depth %d less than the minimum %d", len(ep.Path), spec.MinDepth)
+	}
+	inner := spec.InnerSpec
+	if inner == nil {
+		return nil
+	}
+	for i, op := range ep.Path {
+		if op.Hash != inner.Hash {
+			return fmt.Errorf("inner op %d uses hash %v not %v", i, op.Hash, inner.Hash)
+		}
+		if leaf != nil && bytes.HasPrefix(op.Prefix, leaf.Prefix) {
+			return fmt.Errorf("inner op %d has the prefix of a leaf", i)
+		}
+		if len(op.Prefix) < int(inner.MinPrefixLength) {
+			return fmt.Errorf("inner op %d prefix is shorter than %d", i, inner.MinPrefixLength)
+		}
+		maxPrefixLength := int(inner.MaxPrefixLength) + (len(inner.ChildOrder)-1)*int(inner.ChildSize)
+		if len(op.Prefix) > maxPrefixLength {
+			return fmt.Errorf("inner op %d prefix is longer than %d", i, maxPrefixLength)
+		}
+	}
+	return nil
+}
+
+func (op *LeafOp) apply(key, value []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("leaf op requires a key")
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("leaf op requires a value")
+	}
+	pkey, err := prepareLeafData(op.PrehashKey, op.Length, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare leaf key: %v", err)
+	}
+	pvalue, err := prepareLeafData(op.PrehashValue, op.Length, value)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare leaf value: %v", err)
+	}
+	data := append(append(append([]byte{}, op.Prefix...), pkey...), pvalue...)
+	return doHash(op.Hash, data)
+}
+
+func (op *InnerOp) apply(child []byte) ([]byte, error) {
+	if len(child) == 0 {
+		return nil, fmt.Errorf("inner op requires a child hash")
+	}
+	data := append(append(append([]byte{}, op.Prefix...), child...), op.Suffix...)
+	return doHash(op.Hash, data)
+}
+
+func prepareLeafData(hashOp HashOp, lengthOp LengthOp, data []byte) ([]byte, error) {
+	hashed, err := doHash(hashOp, data)
+	if err != nil {
+		return nil, err
+	}
+	switch lengthOp {
+	case LengthOp_NO_PREFIX:
+		return hashed, nil
+	case LengthOp_VAR_PROTO:
+		return append(appendUvarint(nil, uint64(len(hashed))), hashed...), nil
+	case LengthOp_REQUIRE_32_BYTES:
+		if len(hashed) != 32 {
+			return nil, fmt.Errorf("data is %d bytes rather than 32", len(hashed))
+		}
+		return hashed, nil
+	case LengthOp_REQUIRE_64_BYTES:
+		if len(hashed) != 64 {
+			return nil, fmt.Errorf("data is %d bytes rather than 64", len(hashed))
+		}
+		return hashed, nil
+	default:
+		return nil, fmt.Errorf("unsupported length op %v", lengthOp)
+	}
+}
+
+func doHash(hashOp HashOp, data []byte) ([]byte, error) {
+	var hasher hash.Hash
+	switch hashOp {
+	case HashOp_NO_HASH:
+		return data, nil
+	case HashOp_SHA256:
+		hasher = sha256.New()
+	case HashOp_SHA512:
+		hasher = sha512.New()
+	case HashOp_KECCAK:
+		hasher = sha3.NewLegacyKeccak256()
+	case HashOp_RIPEMD160:
+		hasher = ripemd160.New()
+	case HashOp_BITCOIN:
+		sum := sha256.Sum256(data)
+		hasher = ripemd160.New()
+		data = sum[:]
+	default:
+		return nil, fmt.Errorf("unsupported hash op %v", hashOp)
+	}
+	hasher.Write(data)
+	return hasher.Sum(nil), nil
+}
+
+// The amino encoding of the height, size, and version that start every IAVL node preimage
+func iavlNodePrefix(height int8, size, version int64) []byte {
+	prefix := appendVarint(nil, int64(height))
+	prefix = appendVarint(prefix, size)
+	return appendVarint(prefix, version)
+}
+
+func appendVarint(bs []byte, i int64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(bs, buf[:binary.PutVarint(buf, i)]...)
+}
+
+func appendUvarint(bs []byte, i uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(bs, buf[:binary.PutUvarint(buf, i)]...)
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestImmutableForest_Prove(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	for _, prefix := range []string{"balances", "names", "other"} {
+		tree, err := forest.Writer([]byte(prefix))
+		require.NoError(t, err)
+		for i := 0; i < 50; i++ {
+			tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%s%d", prefix, i)))
+		}
+	}
+	hash1, version1, err := forest.Save()
+	require.NoError(t, err)
+
+	tree, err := forest.Writer([]byte("names"))
+	require.NoError(t, err)
+	tree.Set([]byte("key3"), []byte("changed"))
+	hash2, _, err := forest.Save()
+	require.NoError(t, err)
+
+	proof, err := forest.Prove([]byte("names"), []byte("key3"))
+	require.NoError(t, err)
+	require.NotNil(t, proof)
+	assert.Equal(t, []byte("changed"), proof.TreeProof.Value)
+	require.NoError(t, proof.Verify(hash2))
+	require.Error(t, proof.Verify(hash1))
+
+	// Tampering with the value invalidates the proof
+	proof.TreeProof.Value = []byte("forged")
+	require.Error(t, proof.Verify(hash2))
+
+	proof, err = forest.Prove([]byte("names"), []byte("missing"))
+	require.NoError(t, err)
+	assert.Nil(t, proof)
+
+	// Proofs of an earlier version verify against the hash of that version
+	previous, err := forest.GetImmutable(version1)
+	require.NoError(t, err)
+	count := 0
+	err = previous.Export(func(proof *StateProof) error {
+		count++
+		return proof.Verify(hash1)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 150, count)
+}
+
+func TestExistenceProof_Calculate(t *testing.T) {
+	tree, err := NewMutableTree(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	root, _, err := tree.SaveVersion()
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		proof, err := existenceProof(tree, key)
+		require.NoError(t, err)
+		require.NoError(t, proof.Verify(IAVLSpec, root, key, []byte(fmt.Sprintf("value%d", i))))
+	}
+}
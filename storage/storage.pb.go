@@ -26,6 +26,89 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type HashOp int32
+
+const (
+	HashOp_NO_HASH   HashOp = 0
+	HashOp_SHA256    HashOp = 1
+	HashOp_SHA512    HashOp = 2
+	HashOp_KECCAK    HashOp = 3
+	HashOp_RIPEMD160 HashOp = 4
+	HashOp_BITCOIN   HashOp = 5
+)
+
+var HashOp_name = map[int32]string{
+	0: "NO_HASH",
+	1: "SHA256",
+	2: "SHA512",
+	3: "KECCAK",
+	4: "RIPEMD160",
+	5: "BITCOIN",
+}
+
+var HashOp_value = map[string]int32{
+	"NO_HASH":   0,
+	"SHA256":    1,
+	"SHA512":    2,
+	"KECCAK":    3,
+	"RIPEMD160": 4,
+	"BITCOIN":   5,
+}
+
+func (x HashOp) String() string {
+	return proto.EnumName(HashOp_name, int32(x))
+}
+
+func (HashOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{0}
+}
+
+type LengthOp int32
+
+const (
+	LengthOp_NO_PREFIX        LengthOp = 0
+	LengthOp_VAR_PROTO        LengthOp = 1
+	LengthOp_VAR_RLP          LengthOp = 2
+	LengthOp_FIXED32_BIG      LengthOp = 3
+	LengthOp_FIXED32_LITTLE   LengthOp = 4
+	LengthOp_FIXED64_BIG      LengthOp = 5
+	LengthOp_FIXED64_LITTLE   LengthOp = 6
+	LengthOp_REQUIRE_32_BYTES LengthOp = 7
+	LengthOp_REQUIRE_64_BYTES LengthOp = 8
+)
+
+var LengthOp_name = map[int32]string{
+	0: "NO_PREFIX",
+	1: "VAR_PROTO",
+	2: "VAR_RLP",
+	3: "FIXED32_BIG",
+	4: "FIXED32_LITTLE",
+	5: "FIXED64_BIG",
+	6: "FIXED64_LITTLE",
+	7: "REQUIRE_32_BYTES",
+	8: "REQUIRE_64_BYTES",
+}
+
+var LengthOp_value = map[string]int32{
+	"NO_PREFIX":        0,
+	"VAR_PROTO":        1,
+	"VAR_RLP":          2,
+	"FIXED32_BIG":      3,
+	"FIXED32_LITTLE":   4,
+	"FIXED64_BIG":      5,
+	"FIXED64_LITTLE":   6,
+	"REQUIRE_32_BYTES": 7,
+	"REQUIRE_64_BYTES": 8,
+}
+
+func (x LengthOp) String() string {
+	return proto.EnumName(LengthOp_name, int32(x))
+}
+
+func (LengthOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{1}
+}
+
 // This is the object that is stored in the leaves of the commitsTree - it captures the sub-tree hashes so that the
 // commitsTree's hash becomes a mixture of the hashes of all the sub-trees.
 type CommitID struct {
@@ -81,185 +164,2264 @@ func (m *CommitID) GetHash() []byte {
 func (*CommitID) XXX_MessageName() string {
 	return "storage.CommitID"
 }
-func init() {
-	proto.RegisterType((*CommitID)(nil), "storage.CommitID")
-	golang_proto.RegisterType((*CommitID)(nil), "storage.CommitID")
+
+// ExistenceProof proves that Key maps to Value in a tree whose root is obtained by applying Leaf and then each of Path
+// in turn
+type ExistenceProof struct {
+	Key                  []byte     `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value                []byte     `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Leaf                 *LeafOp    `protobuf:"bytes,3,opt,name=Leaf,proto3" json:"Leaf,omitempty"`
+	Path                 []*InnerOp `protobuf:"bytes,4,rep,name=Path,proto3" json:"Path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
-func init() { golang_proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
+func (m *ExistenceProof) Reset()         { *m = ExistenceProof{} }
+func (m *ExistenceProof) String() string { return proto.CompactTextString(m) }
+func (*ExistenceProof) ProtoMessage()    {}
+func (*ExistenceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{1}
+}
+func (m *ExistenceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExistenceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExistenceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExistenceProof.Merge(m, src)
+}
+func (m *ExistenceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExistenceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExistenceProof.DiscardUnknown(m)
+}
 
-var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 186 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x2e, 0xc9, 0x2f,
-	0x4a, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0xa5, 0x74, 0xd3,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xd3, 0xf3, 0xd3, 0xf3, 0xf5, 0xc1,
-	0xf2, 0x49, 0xa5, 0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0xf4, 0x29, 0xd9, 0x71, 0x71, 0x38,
-	0xe7, 0xe7, 0xe6, 0x66, 0x96, 0x78, 0xba, 0x08, 0x49, 0x70, 0xb1, 0x87, 0xa5, 0x16, 0x15, 0x67,
-	0xe6, 0xe7, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0x1e,
-	0x89, 0xc5, 0x19, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x60, 0xb6, 0x15, 0xcb, 0x8c, 0x05,
-	0xf2, 0x0c, 0x4e, 0xf6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x78, 0xe3, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x07, 0x1e, 0xcb, 0x31, 0x9e, 0x78, 0x2c, 0xc7, 0x18, 0xa5, 0x8a,
-	0xe4, 0x90, 0x8c, 0xca, 0x82, 0xd4, 0xa2, 0x9c, 0xd4, 0x94, 0xf4, 0xd4, 0x22, 0xfd, 0xa4, 0xd2,
-	0xa2, 0xa2, 0xfc, 0x72, 0x7d, 0xa8, 0x7b, 0x93, 0xd8, 0xc0, 0xee, 0x30, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xc6, 0xa6, 0xc1, 0x7f, 0xd0, 0x00, 0x00, 0x00,
+var xxx_messageInfo_ExistenceProof proto.InternalMessageInfo
+
+func (m *ExistenceProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *CommitID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExistenceProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ExistenceProof) GetLeaf() *LeafOp {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+func (m *ExistenceProof) GetPath() []*InnerOp {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (*ExistenceProof) XXX_MessageName() string {
+	return "storage.ExistenceProof"
+}
+
+// LeafOp hashes Prefix followed by the (length-prefixed, optionally pre-hashed) key and value
+type LeafOp struct {
+	Hash                 HashOp   `protobuf:"varint,1,opt,name=Hash,proto3,enum=storage.HashOp" json:"Hash,omitempty"`
+	PrehashKey           HashOp   `protobuf:"varint,2,opt,name=PrehashKey,proto3,enum=storage.HashOp" json:"PrehashKey,omitempty"`
+	PrehashValue         HashOp   `protobuf:"varint,3,opt,name=PrehashValue,proto3,enum=storage.HashOp" json:"PrehashValue,omitempty"`
+	Length               LengthOp `protobuf:"varint,4,opt,name=Length,proto3,enum=storage.LengthOp" json:"Length,omitempty"`
+	Prefix               []byte   `protobuf:"bytes,5,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeafOp) Reset()         { *m = LeafOp{} }
+func (m *LeafOp) String() string { return proto.CompactTextString(m) }
+func (*LeafOp) ProtoMessage()    {}
+func (*LeafOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{2}
+}
+func (m *LeafOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeafOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *LeafOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeafOp.Merge(m, src)
+}
+func (m *LeafOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeafOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeafOp.DiscardUnknown(m)
 }
 
-func (m *CommitID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LeafOp proto.InternalMessageInfo
+
+func (m *LeafOp) GetHash() HashOp {
+	if m != nil {
+		return m.Hash
+	}
+	return HashOp_NO_HASH
 }
 
-func (m *CommitID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *LeafOp) GetPrehashKey() HashOp {
+	if m != nil {
+		return m.PrehashKey
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
+	return HashOp_NO_HASH
+}
+
+func (m *LeafOp) GetPrehashValue() HashOp {
+	if m != nil {
+		return m.PrehashValue
 	}
-	if m.Version != 0 {
-		i = encodeVarintStorage(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+	return HashOp_NO_HASH
+}
+
+func (m *LeafOp) GetLength() LengthOp {
+	if m != nil {
+		return m.Length
 	}
-	return len(dAtA) - i, nil
+	return LengthOp_NO_PREFIX
 }
 
-func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LeafOp) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *CommitID) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (*LeafOp) XXX_MessageName() string {
+	return "storage.LeafOp"
+}
+
+// InnerOp hashes Prefix followed by the child hash followed by Suffix
+type InnerOp struct {
+	Hash                 HashOp   `protobuf:"varint,1,opt,name=Hash,proto3,enum=storage.HashOp" json:"Hash,omitempty"`
+	Prefix               []byte   `protobuf:"bytes,2,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Suffix               []byte   `protobuf:"bytes,3,opt,name=Suffix,proto3" json:"Suffix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InnerOp) Reset()         { *m = InnerOp{} }
+func (m *InnerOp) String() string { return proto.CompactTextString(m) }
+func (*InnerOp) ProtoMessage()    {}
+func (*InnerOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{3}
+}
+func (m *InnerOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InnerOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovStorage(uint64(m.Version))
+	return b[:n], nil
+}
+func (m *InnerOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InnerOp.Merge(m, src)
+}
+func (m *InnerOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *InnerOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_InnerOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InnerOp proto.InternalMessageInfo
+
+func (m *InnerOp) GetHash() HashOp {
+	if m != nil {
+		return m.Hash
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	return HashOp_NO_HASH
+}
+
+func (m *InnerOp) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *InnerOp) GetSuffix() []byte {
+	if m != nil {
+		return m.Suffix
 	}
-	return n
+	return nil
 }
 
-func sovStorage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (*InnerOp) XXX_MessageName() string {
+	return "storage.InnerOp"
 }
-func sozStorage(x uint64) (n int) {
-	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// ProofSpec describes the shape of the proofs of a tree so that a verifier can reject proofs of some other structure
+type ProofSpec struct {
+	LeafSpec             *LeafOp    `protobuf:"bytes,1,opt,name=LeafSpec,proto3" json:"LeafSpec,omitempty"`
+	InnerSpec            *InnerSpec `protobuf:"bytes,2,opt,name=InnerSpec,proto3" json:"InnerSpec,omitempty"`
+	MaxDepth             int32      `protobuf:"varint,3,opt,name=MaxDepth,proto3" json:"MaxDepth,omitempty"`
+	MinDepth             int32      `protobuf:"varint,4,opt,name=MinDepth,proto3" json:"MinDepth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (m *CommitID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStorage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+
+func (m *ProofSpec) Reset()         { *m = ProofSpec{} }
+func (m *ProofSpec) String() string { return proto.CompactTextString(m) }
+func (*ProofSpec) ProtoMessage()    {}
+func (*ProofSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{4}
+}
+func (m *ProofSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofSpec.Merge(m, src)
+}
+func (m *ProofSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofSpec proto.InternalMessageInfo
+
+func (m *ProofSpec) GetLeafSpec() *LeafOp {
+	if m != nil {
+		return m.LeafSpec
+	}
+	return nil
+}
+
+func (m *ProofSpec) GetInnerSpec() *InnerSpec {
+	if m != nil {
+		return m.InnerSpec
+	}
+	return nil
+}
+
+func (m *ProofSpec) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *ProofSpec) GetMinDepth() int32 {
+	if m != nil {
+		return m.MinDepth
+	}
+	return 0
+}
+
+func (*ProofSpec) XXX_MessageName() string {
+	return "storage.ProofSpec"
+}
+
+type InnerSpec struct {
+	ChildOrder           []int32  `protobuf:"varint,1,rep,packed,name=ChildOrder,proto3" json:"ChildOrder,omitempty"`
+	ChildSize            int32    `protobuf:"varint,2,opt,name=ChildSize,proto3" json:"ChildSize,omitempty"`
+	MinPrefixLength      int32    `protobuf:"varint,3,opt,name=MinPrefixLength,proto3" json:"MinPrefixLength,omitempty"`
+	MaxPrefixLength      int32    `protobuf:"varint,4,opt,name=MaxPrefixLength,proto3" json:"MaxPrefixLength,omitempty"`
+	EmptyChild           []byte   `protobuf:"bytes,5,opt,name=EmptyChild,proto3" json:"EmptyChild,omitempty"`
+	Hash                 HashOp   `protobuf:"varint,6,opt,name=Hash,proto3,enum=storage.HashOp" json:"Hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InnerSpec) Reset()         { *m = InnerSpec{} }
+func (m *InnerSpec) String() string { return proto.CompactTextString(m) }
+func (*InnerSpec) ProtoMessage()    {}
+func (*InnerSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{5}
+}
+func (m *InnerSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InnerSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InnerSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InnerSpec.Merge(m, src)
+}
+func (m *InnerSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *InnerSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_InnerSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InnerSpec proto.InternalMessageInfo
+
+func (m *InnerSpec) GetChildOrder() []int32 {
+	if m != nil {
+		return m.ChildOrder
+	}
+	return nil
+}
+
+func (m *InnerSpec) GetChildSize() int32 {
+	if m != nil {
+		return m.ChildSize
+	}
+	return 0
+}
+
+func (m *InnerSpec) GetMinPrefixLength() int32 {
+	if m != nil {
+		return m.MinPrefixLength
+	}
+	return 0
+}
+
+func (m *InnerSpec) GetMaxPrefixLength() int32 {
+	if m != nil {
+		return m.MaxPrefixLength
+	}
+	return 0
+}
+
+func (m *InnerSpec) GetEmptyChild() []byte {
+	if m != nil {
+		return m.EmptyChild
+	}
+	return nil
+}
+
+func (m *InnerSpec) GetHash() HashOp {
+	if m != nil {
+		return m.Hash
+	}
+	return HashOp_NO_HASH
+}
+
+func (*InnerSpec) XXX_MessageName() string {
+	return "storage.InnerSpec"
+}
+
+// StateProof proves that Key maps to Value in the tree at Prefix of a forest. TreeProof is the proof of Key in the tree
+// and ForestProof is the proof of Prefix in the commits tree, whose value is the CommitID holding the root of TreeProof
+// and whose root is the state hash of the forest.
+type StateProof struct {
+	Prefix               []byte          `protobuf:"bytes,1,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	TreeProof            *ExistenceProof `protobuf:"bytes,2,opt,name=TreeProof,proto3" json:"TreeProof,omitempty"`
+	ForestProof          *ExistenceProof `protobuf:"bytes,3,opt,name=ForestProof,proto3" json:"ForestProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{6}
+}
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *StateProof) GetTreeProof() *ExistenceProof {
+	if m != nil {
+		return m.TreeProof
+	}
+	return nil
+}
+
+func (m *StateProof) GetForestProof() *ExistenceProof {
+	if m != nil {
+		return m.ForestProof
+	}
+	return nil
+}
+
+func (*StateProof) XXX_MessageName() string {
+	return "storage.StateProof"
+}
+func init() {
+	proto.RegisterEnum("storage.HashOp", HashOp_name, HashOp_value)
+	golang_proto.RegisterEnum("storage.HashOp", HashOp_name, HashOp_value)
+	proto.RegisterEnum("storage.LengthOp", LengthOp_name, LengthOp_value)
+	golang_proto.RegisterEnum("storage.LengthOp", LengthOp_name, LengthOp_value)
+	proto.RegisterType((*CommitID)(nil), "storage.CommitID")
+	golang_proto.RegisterType((*CommitID)(nil), "storage.CommitID")
+	proto.RegisterType((*ExistenceProof)(nil), "storage.ExistenceProof")
+	golang_proto.RegisterType((*ExistenceProof)(nil), "storage.ExistenceProof")
+	proto.RegisterType((*LeafOp)(nil), "storage.LeafOp")
+	golang_proto.RegisterType((*LeafOp)(nil), "storage.LeafOp")
+	proto.RegisterType((*InnerOp)(nil), "storage.InnerOp")
+	golang_proto.RegisterType((*InnerOp)(nil), "storage.InnerOp")
+	proto.RegisterType((*ProofSpec)(nil), "storage.ProofSpec")
+	golang_proto.RegisterType((*ProofSpec)(nil), "storage.ProofSpec")
+	proto.RegisterType((*InnerSpec)(nil), "storage.InnerSpec")
+	golang_proto.RegisterType((*InnerSpec)(nil), "storage.InnerSpec")
+	proto.RegisterType((*StateProof)(nil), "storage.StateProof")
+	golang_proto.RegisterType((*StateProof)(nil), "storage.StateProof")
+}
+
+func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
+func init() { golang_proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
+
+var fileDescriptor_0d2c4ccf1453ffdb = []byte{
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6f, 0xda, 0x48,
+	0x14, 0xc7, 0x33, 0xd8, 0xfc, 0x7a, 0xe4, 0x87, 0x77, 0x14, 0xed, 0x5a, 0xd1, 0x8a, 0x45, 0xec,
+	0xae, 0x44, 0x53, 0x35, 0x24, 0x24, 0x41, 0x6a, 0x0f, 0xad, 0x08, 0x38, 0xc5, 0x0a, 0x89, 0xdd,
+	0x81, 0x44, 0x69, 0x0f, 0x45, 0x26, 0x19, 0xb0, 0xa5, 0x80, 0x2d, 0x63, 0x54, 0xd2, 0x6b, 0xff,
+	0x87, 0xaa, 0xc7, 0xaa, 0xd7, 0xfe, 0x13, 0x3d, 0xe6, 0xd8, 0x53, 0xd5, 0x63, 0x45, 0xfe, 0x91,
+	0x6a, 0xc6, 0x03, 0x98, 0x2a, 0x8a, 0x7a, 0x62, 0xbe, 0xdf, 0xf7, 0x79, 0xcf, 0x6f, 0xde, 0xc3,
+	0x86, 0x95, 0x61, 0xe0, 0xfa, 0x56, 0x8f, 0x6e, 0x79, 0xbe, 0x1b, 0xb8, 0x38, 0x29, 0xe4, 0xc6,
+	0xa3, 0x9e, 0x13, 0xd8, 0xa3, 0xce, 0xd6, 0x85, 0xdb, 0x2f, 0xf6, 0xdc, 0x9e, 0x5b, 0xe4, 0xf1,
+	0xce, 0xa8, 0xcb, 0x15, 0x17, 0xfc, 0x14, 0xe6, 0xe5, 0x9f, 0x42, 0xaa, 0xea, 0xf6, 0xfb, 0x4e,
+	0xa0, 0xd7, 0xb0, 0x0a, 0xc9, 0x33, 0xea, 0x0f, 0x1d, 0x77, 0xa0, 0xa2, 0x1c, 0x2a, 0x48, 0x64,
+	0x2a, 0x31, 0x06, 0xb9, 0x6e, 0x0d, 0x6d, 0x35, 0x96, 0x43, 0x85, 0x65, 0xc2, 0xcf, 0x4f, 0xe4,
+	0x0f, 0x1f, 0xff, 0x59, 0xca, 0xbf, 0x43, 0xb0, 0xaa, 0x8d, 0x9d, 0x61, 0x40, 0x07, 0x17, 0xd4,
+	0xf4, 0x5d, 0xb7, 0x8b, 0x15, 0x90, 0x8e, 0xe8, 0x35, 0x2f, 0xb1, 0x4c, 0xd8, 0x11, 0xaf, 0x43,
+	0xfc, 0xcc, 0xba, 0x1a, 0x51, 0x91, 0x1f, 0x0a, 0xfc, 0x2f, 0xc8, 0x0d, 0x6a, 0x75, 0x55, 0x29,
+	0x87, 0x0a, 0x99, 0xd2, 0xda, 0xd6, 0xf4, 0x42, 0xcc, 0x34, 0x3c, 0xc2, 0x83, 0xf8, 0x3f, 0x90,
+	0x4d, 0x2b, 0xb0, 0x55, 0x39, 0x27, 0x15, 0x32, 0x25, 0x65, 0x06, 0xe9, 0x83, 0x01, 0xf5, 0x19,
+	0xc5, 0xa2, 0xf9, 0x6f, 0x08, 0x12, 0x61, 0x1a, 0xab, 0xca, 0x5b, 0x65, 0x8f, 0x5f, 0x8d, 0x54,
+	0x65, 0x26, 0xe3, 0xd9, 0x2f, 0x2e, 0x02, 0x98, 0x3e, 0xb5, 0xad, 0xa1, 0xcd, 0x3a, 0x8d, 0xdd,
+	0x8d, 0x46, 0x10, 0xbc, 0x0b, 0xcb, 0x42, 0x85, 0x17, 0x91, 0xee, 0x4e, 0x59, 0x80, 0xf0, 0x03,
+	0xd6, 0xd4, 0xa0, 0xc7, 0xbb, 0x67, 0xf8, 0x1f, 0x91, 0x2b, 0x32, 0xdb, 0xf0, 0x88, 0x00, 0xf0,
+	0x9f, 0x90, 0x30, 0x7d, 0xda, 0x75, 0xc6, 0x6a, 0x9c, 0x8f, 0x48, 0xa8, 0xfc, 0x6b, 0x48, 0x8a,
+	0x9b, 0xfe, 0xde, 0xc5, 0xe6, 0x75, 0x62, 0xd1, 0x3a, 0xcc, 0x6f, 0x8e, 0xba, 0xcc, 0x97, 0x42,
+	0x3f, 0x54, 0xf9, 0x4f, 0x08, 0xd2, 0x7c, 0x6b, 0x4d, 0x8f, 0x5e, 0xe0, 0x87, 0x90, 0x62, 0x53,
+	0x64, 0x67, 0x15, 0xdd, 0xbd, 0x95, 0x19, 0x80, 0xb7, 0x21, 0xcd, 0x5b, 0xe3, 0x74, 0x8c, 0xd3,
+	0x78, 0x71, 0x3d, 0x2c, 0x42, 0xe6, 0x10, 0xde, 0x80, 0xd4, 0xb1, 0x35, 0xae, 0x51, 0x2f, 0xb0,
+	0x79, 0x1b, 0x71, 0x32, 0xd3, 0x3c, 0xe6, 0x0c, 0xc2, 0x98, 0x2c, 0x62, 0x42, 0xe7, 0x27, 0x28,
+	0xf2, 0x28, 0x9c, 0x05, 0xa8, 0xda, 0xce, 0xd5, 0xa5, 0xe1, 0x5f, 0x52, 0x5f, 0x45, 0x39, 0xa9,
+	0x10, 0x27, 0x11, 0x07, 0xff, 0x0d, 0x69, 0xae, 0x9a, 0xce, 0xdb, 0xf0, 0x0f, 0x17, 0x27, 0x73,
+	0x03, 0x17, 0x60, 0xed, 0xd8, 0x19, 0x84, 0x53, 0x11, 0xcb, 0x09, 0x5b, 0xf9, 0xd5, 0xe6, 0xa4,
+	0x35, 0x5e, 0x20, 0x65, 0x41, 0x2e, 0xda, 0xac, 0x23, 0xad, 0xef, 0x05, 0xd7, 0xfc, 0x29, 0x62,
+	0x81, 0x11, 0x67, 0xb6, 0xb9, 0xc4, 0x3d, 0x9b, 0xcb, 0xbf, 0x47, 0x00, 0xcd, 0xc0, 0x0a, 0xc4,
+	0x4b, 0x34, 0x5f, 0x24, 0x5a, 0x58, 0xe4, 0x3e, 0xa4, 0x5b, 0x3e, 0x0d, 0x21, 0x31, 0xf5, 0xbf,
+	0x66, 0x05, 0x17, 0x5f, 0x44, 0x32, 0x27, 0xf1, 0x63, 0xc8, 0x1c, 0xba, 0x3e, 0x1d, 0x06, 0x61,
+	0xa2, 0x74, 0x7f, 0x62, 0x94, 0xdd, 0x3c, 0x85, 0x44, 0xd8, 0x28, 0xce, 0x40, 0xf2, 0xc4, 0x68,
+	0xd7, 0x2b, 0xcd, 0xba, 0xb2, 0x84, 0x01, 0x12, 0xcd, 0x7a, 0xa5, 0xb4, 0x5f, 0x56, 0x90, 0x38,
+	0xef, 0xef, 0x94, 0x94, 0x18, 0x3b, 0x1f, 0x69, 0xd5, 0x6a, 0xe5, 0x48, 0x91, 0xf0, 0x0a, 0xa4,
+	0x89, 0x6e, 0x6a, 0xc7, 0xb5, 0x9d, 0xf2, 0xb6, 0x22, 0xb3, 0xfc, 0x03, 0xbd, 0x55, 0x35, 0xf4,
+	0x13, 0x25, 0xbe, 0xf9, 0x19, 0x41, 0x2a, 0x9c, 0x9f, 0xe1, 0x31, 0xf0, 0xc4, 0x68, 0x9b, 0x44,
+	0x3b, 0xd4, 0xcf, 0x95, 0x25, 0x26, 0xcf, 0x2a, 0xa4, 0x6d, 0x12, 0xa3, 0x65, 0x28, 0x88, 0xe5,
+	0x31, 0x49, 0x1a, 0xa6, 0x12, 0xc3, 0x6b, 0x90, 0x39, 0xd4, 0xcf, 0xb5, 0xda, 0x6e, 0xa9, 0x7d,
+	0xa0, 0x3f, 0x57, 0x24, 0x8c, 0x61, 0x75, 0x6a, 0x34, 0xf4, 0x56, 0xab, 0xa1, 0x29, 0xf2, 0x0c,
+	0x2a, 0xef, 0x71, 0x28, 0x3e, 0x83, 0xca, 0x7b, 0x53, 0x28, 0x81, 0xd7, 0x41, 0x21, 0xda, 0x8b,
+	0x53, 0x9d, 0x68, 0x6d, 0x56, 0xec, 0x65, 0x4b, 0x6b, 0x2a, 0xc9, 0xa8, 0x5b, 0xde, 0x13, 0x6e,
+	0xea, 0xe0, 0xd9, 0xcd, 0x24, 0x8b, 0xbe, 0x4e, 0xb2, 0xe8, 0xfb, 0x24, 0x8b, 0x7e, 0x4c, 0xb2,
+	0xe8, 0xcb, 0x6d, 0x16, 0xdd, 0xdc, 0x66, 0xd1, 0xab, 0xff, 0x23, 0xdf, 0x5b, 0xfb, 0xda, 0xa3,
+	0xfe, 0x15, 0xbd, 0xec, 0x51, 0xbf, 0xd8, 0x19, 0xf9, 0xbe, 0xfb, 0xa6, 0x28, 0x26, 0xdc, 0x49,
+	0xf0, 0xcf, 0xed, 0xee, 0xcf, 0x01, 0x00, 0x14, 0xf0, 0x59, 0x51, 0xb7, 0x05, 0x00, 0x00,
+}
+
+func (m *CommitID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExistenceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExistenceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExistenceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Path[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Leaf != nil {
+		{
+			size, err := m.Leaf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeafOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeafOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeafOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Length != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PrehashValue != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.PrehashValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrehashKey != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.PrehashKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Hash != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InnerOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InnerOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InnerOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Suffix) > 0 {
+		i -= len(m.Suffix)
+		copy(dAtA[i:], m.Suffix)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Suffix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hash != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MinDepth != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.MinDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxDepth != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InnerSpec != nil {
+		{
+			size, err := m.InnerSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LeafSpec != nil {
+		{
+			size, err := m.LeafSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InnerSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InnerSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InnerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hash != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Hash))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EmptyChild) > 0 {
+		i -= len(m.EmptyChild)
+		copy(dAtA[i:], m.EmptyChild)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.EmptyChild)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxPrefixLength != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.MaxPrefixLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinPrefixLength != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.MinPrefixLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChildSize != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.ChildSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChildOrder) > 0 {
+		dAtA5 := make([]byte, len(m.ChildOrder)*10)
+		var j4 int
+		for _, num1 := range m.ChildOrder {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStorage(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ForestProof != nil {
+		{
+			size, err := m.ForestProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TreeProof != nil {
+		{
+			size, err := m.TreeProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovStorage(uint64(m.Version))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExistenceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Leaf != nil {
+		l = m.Leaf.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if len(m.Path) > 0 {
+		for _, e := range m.Path {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeafOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != 0 {
+		n += 1 + sovStorage(uint64(m.Hash))
+	}
+	if m.PrehashKey != 0 {
+		n += 1 + sovStorage(uint64(m.PrehashKey))
+	}
+	if m.PrehashValue != 0 {
+		n += 1 + sovStorage(uint64(m.PrehashValue))
+	}
+	if m.Length != 0 {
+		n += 1 + sovStorage(uint64(m.Length))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InnerOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != 0 {
+		n += 1 + sovStorage(uint64(m.Hash))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProofSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeafSpec != nil {
+		l = m.LeafSpec.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.InnerSpec != nil {
+		l = m.InnerSpec.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovStorage(uint64(m.MaxDepth))
+	}
+	if m.MinDepth != 0 {
+		n += 1 + sovStorage(uint64(m.MinDepth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InnerSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChildOrder) > 0 {
+		l = 0
+		for _, e := range m.ChildOrder {
+			l += sovStorage(uint64(e))
+		}
+		n += 1 + sovStorage(uint64(l)) + l
+	}
+	if m.ChildSize != 0 {
+		n += 1 + sovStorage(uint64(m.ChildSize))
+	}
+	if m.MinPrefixLength != 0 {
+		n += 1 + sovStorage(uint64(m.MinPrefixLength))
+	}
+	if m.MaxPrefixLength != 0 {
+		n += 1 + sovStorage(uint64(m.MaxPrefixLength))
+	}
+	l = len(m.EmptyChild)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Hash != 0 {
+		n += 1 + sovStorage(uint64(m.Hash))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.TreeProof != nil {
+		l = m.TreeProof.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.ForestProof != nil {
+		l = m.ForestProof.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovStorage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorage(x uint64) (n int) {
+	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommitID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExistenceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExistenceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExistenceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leaf == nil {
+				m.Leaf = &LeafOp{}
+			}
+			if err := m.Leaf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, &InnerOp{})
+			if err := m.Path[len(m.Path)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeafOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeafOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeafOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= HashOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrehashKey", wireType)
+			}
+			m.PrehashKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrehashKey |= HashOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrehashValue", wireType)
+			}
+			m.PrehashValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrehashValue |= HashOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= LengthOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InnerOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InnerOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InnerOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= HashOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = append(m.Suffix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Suffix == nil {
+				m.Suffix = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeafSpec == nil {
+				m.LeafSpec = &LeafOp{}
+			}
+			if err := m.LeafSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InnerSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InnerSpec == nil {
+				m.InnerSpec = &InnerSpec{}
+			}
+			if err := m.InnerSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepth", wireType)
+			}
+			m.MinDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InnerSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InnerSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InnerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChildOrder = append(m.ChildOrder, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStorage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChildOrder) == 0 {
+					m.ChildOrder = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChildOrder = append(m.ChildOrder, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildOrder", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildSize", wireType)
+			}
+			m.ChildSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrefixLength", wireType)
+			}
+			m.MinPrefixLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPrefixLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrefixLength", wireType)
+			}
+			m.MaxPrefixLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrefixLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyChild", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmptyChild = append(m.EmptyChild[:0], dAtA[iNdEx:postIndex]...)
+			if m.EmptyChild == nil {
+				m.EmptyChild = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= HashOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TreeProof == nil {
+				m.TreeProof = &ExistenceProof{}
+			}
+			if err := m.TreeProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForestProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForestProof == nil {
+				m.ForestProof = &ExistenceProof{}
+			}
+			if err := m.ForestProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -65,7 +65,9 @@ func (s *Signatory) RealisePublicKey(getter acmstate.AccountGetter) error {
 	return nil
 }
 
-// Returns an error if Envelope has a nil transaction or zero signatures (and therefore could not possibly be valid)
+// Returns an error if Envelope has a nil transaction or zero signatures (and therefore could not possibly be valid).
+// A signatory without a PublicKey is allowed so long as it has a Signature since it may be verified by the authorizer
+// contract of its account, which can only be determined against state by VerifyWith.
 func (txEnv *Envelope) Validate() error {
 	if txEnv.Tx == nil {
 		return fmt.Errorf("transaction envelope contains no (successfully unmarshalled) transaction")
@@ -74,6 +76,9 @@ func (txEnv *Envelope) Validate() error {
 		return fmt.Errorf("transaction envelope contains no (successfully unmarshalled) signatories")
 	}
 	for i, sig := range txEnv.Signatories {
+		if sig.PublicKey == nil && sig.Address != nil && sig.Signature != nil {
+			continue
+		}
 		err := sig.Validate()
 		if err != nil {
 			return fmt.Errorf("Signatory %v is invalid: %v", i, err)
@@ -92,12 +97,24 @@ func (sig *Signatory) Validate() error {
 	return nil
 }
 
+// SignatoryVerifier may take over verification of the signatory for an input, for example by delegating to an
+// authorizer contract. It returns false if the signatory should be verified against its public key as usual.
+type SignatoryVerifier func(input *payload.TxInput, sig *Signatory, signBytes []byte) (bool, error)
+
 // Verifies the validity of the Signatories' Signatures in the Envelope. The Signatories must
 // appear in the same order as the inputs as returned by Tx.GetInputs().
 func (txEnv *Envelope) Verify(chainID string) error {
-	err := txEnv.Validate()
-	if err != nil {
-		return err
+	return txEnv.VerifyWith(chainID, nil)
+}
+
+// Verifies the Envelope as Verify, but first offering each signatory to verifier (if not nil). Signatories handled by
+// verifier need only provide an Address and Signature.
+func (txEnv *Envelope) VerifyWith(chainID string, verifier SignatoryVerifier) error {
+	if txEnv.Tx == nil {
+		return fmt.Errorf("transaction envelope contains no (successfully unmarshalled) transaction")
+	}
+	if len(txEnv.Signatories) == 0 {
+		return fmt.Errorf("transaction envelope contains no (successfully unmarshalled) signatories")
 	}
 	errPrefix := fmt.Sprintf("could not verify transaction %X", txEnv.Tx.Hash())
 	if txEnv.Tx.ChainID != chainID {
@@ -115,6 +132,23 @@ func (txEnv *Envelope) Verify(chainID string) error {
 	}
 	// Expect order to match (we could build lookup but we want Verify to be quicker than Sign which does order sigs)
 	for i, s := range txEnv.Signatories {
+		if verifier != nil && s.Address != nil && s.Signature != nil {
+			if inputs[i].Address != *s.Address {
+				return fmt.Errorf("signatory %v has address %v but input %v has address %v",
+					i, *s.Address, i, inputs[i].Address)
+			}
+			handled, err := verifier(inputs[i], &txEnv.Signatories[i], signBytes)
+			if err != nil {
+				return fmt.Errorf("could not authorize signatory %v: %v", *s.Address, err)
+			}
+			if handled {
+				continue
+			}
+		}
+		err = s.Validate()
+		if err != nil {
+			return fmt.Errorf("Signatory %v is invalid: %v", i, err)
+		}
 		if inputs[i].Address != *s.Address {
 			return fmt.Errorf("signatory %v has address %v but input %v has address %v",
 				i, *s.Address, i, inputs[i].Address)
@@ -124,6 +158,24 @@ func (txEnv *Envelope) Verify(chainID string) error {
 			return fmt.Errorf("invalid signature in signatory %v: %v", *s.Address, err)
 		}
 	}
+	if txEnv.FeePayer != nil {
+		s := txEnv.FeePayer
+		err = s.Validate()
+		if err != nil {
+			return fmt.Errorf("%s: FeePayer is invalid: %v", errPrefix, err)
+		}
+		if s.PublicKey.GetAddress() != *s.Address {
+			return fmt.Errorf("%s: FeePayer has address %v but public key with address %v",
+				errPrefix, *s.Address, s.PublicKey.GetAddress())
+		}
+		if s.Signature == nil {
+			return fmt.Errorf("%s: FeePayer %v has nil Signature", errPrefix, *s.Address)
+		}
+		err = s.PublicKey.Verify(signBytes, s.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature from FeePayer %v: %v", *s.Address, err)
+		}
+	}
 	return nil
 }
 
@@ -161,6 +213,27 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	return nil
 }
 
+// Sign the Tx Envelope as its FeePayer so that the transaction fee is paid by feePayer rather than the first input.
+// This does not affect the Signatories so may be done before or after the inputs sign.
+func (txEnv *Envelope) SignFeePayer(feePayer acm.AddressableSigner) error {
+	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	if err != nil {
+		return err
+	}
+	sig, err := feePayer.Sign(signBytes)
+	if err != nil {
+		return err
+	}
+	address := feePayer.GetAddress()
+	publicKey := feePayer.GetPublicKey()
+	txEnv.FeePayer = &Signatory{
+		Address:   &address,
+		PublicKey: &publicKey,
+		Signature: sig,
+	}
+	return nil
+}
+
 func (txEnv *Envelope) Get(key string) (interface{}, bool) {
 	if txEnv == nil {
 		return nil, false
@@ -0,0 +1,62 @@
+package payload
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Merge returns a copy of params (which may be nil) with the fields named in mask set to their values in update, or
+// with the non-zero fields of update set if mask is empty
+func (params *ChainParams) Merge(update *ChainParams, mask []string) (*ChainParams, error) {
+	merged := new(ChainParams)
+	if params != nil {
+		*merged = *params
+	}
+	dst := reflect.ValueOf(merged).Elem()
+	src := reflect.ValueOf(update).Elem()
+	if len(mask) == 0 {
+		for i := 0; i < src.NumField(); i++ {
+			if isChainParam(src.Type().Field(i).Name) && !src.Field(i).IsZero() {
+				dst.Field(i).Set(src.Field(i))
+			}
+		}
+		return merged, nil
+	}
+	for _, name := range mask {
+		if !isChainParam(name) || !src.FieldByName(name).IsValid() {
+			return nil, fmt.Errorf("no chain parameter named %s", name)
+		}
+		dst.FieldByName(name).Set(src.FieldByName(name))
+	}
+	return merged, nil
+}
+
+// IsZero returns true if no chain parameter is set
+func (params *ChainParams) IsZero() bool {
+	if params == nil {
+		return true
+	}
+	value := reflect.ValueOf(params).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if !isChainParam(value.Type().Field(i).Name) {
+			continue
+		}
+		field := value.Field(i)
+		switch field.Kind() {
+		case reflect.Slice, reflect.Map:
+			if field.Len() > 0 {
+				return false
+			}
+		default:
+			if !field.IsZero() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isChainParam(name string) bool {
+	return !strings.HasPrefix(name, "XXX_")
+}
@@ -65,6 +65,22 @@ func AlterPermissionsTx(inputAddress crypto.Address, id crypto.Addressable, perm
 	})
 }
 
+// Creates a GovTx that updates the chain parameters named in mask to their values in chainParams, or the non-zero
+// chain parameters if mask is empty
+func UpdateChainParamsTx(inputAddress crypto.Address, chainParams *ChainParams, mask ...string) *GovTx {
+	tx := UpdateAccountTx(inputAddress)
+	tx.Params = chainParams
+	tx.ParamsMask = mask
+	return tx
+}
+
+// Creates a GovTx that cancels the scheduled GovTxs identified by vetoes
+func VetoTx(inputAddress crypto.Address, vetoes ...*ScheduledGovTxID) *GovTx {
+	tx := UpdateAccountTx(inputAddress)
+	tx.Vetoes = vetoes
+	return tx
+}
+
 func UpdateAccountTx(inputAddress crypto.Address, updates ...*spec.TemplateAccount) *GovTx {
 	return &GovTx{
 		Inputs: []*TxInput{{
@@ -0,0 +1,36 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewHeartbeatTx(address crypto.Address, height uint64, blockHash binary.HexBytes) *HeartbeatTx {
+	return &HeartbeatTx{
+		Input: &TxInput{
+			Address: address,
+		},
+		Height:    height,
+		BlockHash: blockHash,
+	}
+}
+
+func (tx *HeartbeatTx) Type() Type {
+	return TypeHeartbeat
+}
+
+func (tx *HeartbeatTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *HeartbeatTx) String() string {
+	return fmt.Sprintf("HeartbeatTx{%v -> %d:%v}", tx.Input, tx.Height, tx.BlockHash)
+}
+
+func (tx *HeartbeatTx) Any() *Any {
+	return &Any{
+		HeartbeatTx: tx,
+	}
+}
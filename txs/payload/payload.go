@@ -27,14 +27,16 @@ type Type uint32
 const (
 	TypeUnknown = Type(0x00)
 	// Account transactions
-	TypeSend  = Type(0x01)
-	TypeCall  = Type(0x02)
-	TypeName  = Type(0x03)
-	TypeBatch = Type(0x04)
+	TypeSend    = Type(0x01)
+	TypeCall    = Type(0x02)
+	TypeName    = Type(0x03)
+	TypeBatch   = Type(0x04)
+	TypePrivate = Type(0x05)
 
 	// Validation transactions
-	TypeBond   = Type(0x11)
-	TypeUnbond = Type(0x12)
+	TypeBond      = Type(0x11)
+	TypeUnbond    = Type(0x12)
+	TypeHeartbeat = Type(0x13)
 
 	// Admin transactions
 	TypePermissions = Type(0x21)
@@ -58,11 +60,13 @@ var nameFromType = map[Type]string{
 	TypeCall:        "CallTx",
 	TypeName:        "NameTx",
 	TypeBatch:       "BatchTx",
+	TypePrivate:     "PrivateTx",
 	TypePermissions: "PermsTx",
 	TypeGovernance:  "GovTx",
 	TypeProposal:    "ProposalTx",
 	TypeBond:        "BondTx",
 	TypeUnbond:      "UnbondTx",
+	TypeHeartbeat:   "HeartbeatTx",
 	TypeIdentify:    "IdentifyTx",
 }
 
@@ -122,6 +126,8 @@ func New(txType Type) (Payload, error) {
 		return &NameTx{}, nil
 	case TypeBatch:
 		return &BatchTx{}, nil
+	case TypePrivate:
+		return &PrivateTx{}, nil
 	case TypePermissions:
 		return &PermsTx{}, nil
 	case TypeGovernance:
@@ -134,6 +140,8 @@ func New(txType Type) (Payload, error) {
 		return &ProposalTx{}, nil
 	case TypeIdentify:
 		return &IdentifyTx{}, nil
+	case TypeHeartbeat:
+		return &HeartbeatTx{}, nil
 	}
 	return nil, fmt.Errorf("unknown payload type: %d", txType)
 }
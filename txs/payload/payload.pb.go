@@ -16,6 +16,7 @@ import (
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 	registry "github.com/hyperledger/burrow/execution/registry"
 	spec "github.com/hyperledger/burrow/genesis/spec"
+	github_com_hyperledger_burrow_permission "github.com/hyperledger/burrow/permission"
 	permission "github.com/hyperledger/burrow/permission"
 )
 
@@ -57,24 +58,26 @@ func (x Ballot_ProposalState) String() string {
 }
 
 func (Ballot_ProposalState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{16, 0}
+	return fileDescriptor_678c914f1bee6d56, []int{22, 0}
 }
 
 // Any encodes a sum type for which only one should be set
 type Any struct {
-	CallTx               *CallTx     `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
-	SendTx               *SendTx     `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
-	NameTx               *NameTx     `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
-	PermsTx              *PermsTx    `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
-	GovTx                *GovTx      `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
-	BondTx               *BondTx     `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
-	UnbondTx             *UnbondTx   `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
-	BatchTx              *BatchTx    `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
-	ProposalTx           *ProposalTx `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
-	IdentifyTx           *IdentifyTx `protobuf:"bytes,10,opt,name=IdentifyTx,proto3" json:"IdentifyTx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	CallTx               *CallTx      `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
+	SendTx               *SendTx      `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
+	NameTx               *NameTx      `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
+	PermsTx              *PermsTx     `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
+	GovTx                *GovTx       `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
+	BondTx               *BondTx      `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
+	UnbondTx             *UnbondTx    `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
+	BatchTx              *BatchTx     `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	ProposalTx           *ProposalTx  `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
+	IdentifyTx           *IdentifyTx  `protobuf:"bytes,10,opt,name=IdentifyTx,proto3" json:"IdentifyTx,omitempty"`
+	PrivateTx            *PrivateTx   `protobuf:"bytes,11,opt,name=PrivateTx,proto3" json:"PrivateTx,omitempty"`
+	HeartbeatTx          *HeartbeatTx `protobuf:"bytes,12,opt,name=HeartbeatTx,proto3" json:"HeartbeatTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Any) Reset()         { *m = Any{} }
@@ -180,6 +183,20 @@ func (m *Any) GetIdentifyTx() *IdentifyTx {
 	return nil
 }
 
+func (m *Any) GetPrivateTx() *PrivateTx {
+	if m != nil {
+		return m.PrivateTx
+	}
+	return nil
+}
+
+func (m *Any) GetHeartbeatTx() *HeartbeatTx {
+	if m != nil {
+		return m.HeartbeatTx
+	}
+	return nil
+}
+
 func (*Any) XXX_MessageName() string {
 	return "payload.Any"
 }
@@ -302,6 +319,61 @@ func (*TxOutput) XXX_MessageName() string {
 	return "payload.TxOutput"
 }
 
+// Commits to an encrypted private payload distributed off-chain to a participant group, each of whose nodes executes
+// the payload against its own private state when the transaction is delivered
+type PrivateTx struct {
+	// The caller's input, the caller of the private call
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The hash of the EncryptedPayload returned when it was sent
+	PayloadHash          github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=PayloadHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"PayloadHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *PrivateTx) Reset()      { *m = PrivateTx{} }
+func (*PrivateTx) ProtoMessage() {}
+func (*PrivateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{3}
+}
+func (m *PrivateTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivateTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivateTx.Merge(m, src)
+}
+func (m *PrivateTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivateTx proto.InternalMessageInfo
+
+func (m *PrivateTx) GetInput() *TxInput {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (*PrivateTx) XXX_MessageName() string {
+	return "payload.PrivateTx"
+}
+
 // A instruction to run smart contract code in the EVM
 type CallTx struct {
 	// The caller's input
@@ -328,7 +400,7 @@ type CallTx struct {
 func (m *CallTx) Reset()      { *m = CallTx{} }
 func (*CallTx) ProtoMessage() {}
 func (*CallTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{3}
+	return fileDescriptor_678c914f1bee6d56, []int{4}
 }
 func (m *CallTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +480,7 @@ func (m *ContractMeta) Reset()         { *m = ContractMeta{} }
 func (m *ContractMeta) String() string { return proto.CompactTextString(m) }
 func (*ContractMeta) ProtoMessage()    {}
 func (*ContractMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{4}
+	return fileDescriptor_678c914f1bee6d56, []int{5}
 }
 func (m *ContractMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +534,7 @@ type SendTx struct {
 func (m *SendTx) Reset()      { *m = SendTx{} }
 func (*SendTx) ProtoMessage() {}
 func (*SendTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{5}
+	return fileDescriptor_678c914f1bee6d56, []int{6}
 }
 func (m *SendTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +581,7 @@ type PermsTx struct {
 func (m *PermsTx) Reset()      { *m = PermsTx{} }
 func (*PermsTx) ProtoMessage() {}
 func (*PermsTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{6}
+	return fileDescriptor_678c914f1bee6d56, []int{7}
 }
 func (m *PermsTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +646,7 @@ type NameTx struct {
 func (m *NameTx) Reset()      { *m = NameTx{} }
 func (*NameTx) ProtoMessage() {}
 func (*NameTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{7}
+	return fileDescriptor_678c914f1bee6d56, []int{8}
 }
 func (m *NameTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,7 +718,7 @@ type BondTx struct {
 func (m *BondTx) Reset()      { *m = BondTx{} }
 func (*BondTx) ProtoMessage() {}
 func (*BondTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{8}
+	return fileDescriptor_678c914f1bee6d56, []int{9}
 }
 func (m *BondTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +763,7 @@ type UnbondTx struct {
 func (m *UnbondTx) Reset()      { *m = UnbondTx{} }
 func (*UnbondTx) ProtoMessage() {}
 func (*UnbondTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{9}
+	return fileDescriptor_678c914f1bee6d56, []int{10}
 }
 func (m *UnbondTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,17 +797,27 @@ func (*UnbondTx) XXX_MessageName() string {
 }
 
 type GovTx struct {
-	Inputs               []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
-	AccountUpdates       []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Inputs []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	// Account updates are applied atomically - either all succeed or none are applied
+	AccountUpdates []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
+	// If non-zero the account updates are scheduled for application at the end of the block at this height
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=ActivationHeight,proto3" json:"ActivationHeight,omitempty"`
+	// If set updates the chain parameters (alongside any account updates) as selected by ParamsMask
+	Params *ChainParams `protobuf:"bytes,4,opt,name=Params,proto3" json:"Params,omitempty"`
+	// Scheduled GovTxs to cancel before they are applied, each must be pending
+	Vetoes []*ScheduledGovTxID `protobuf:"bytes,5,rep,name=Vetoes,proto3" json:"Vetoes,omitempty"`
+	// The names of the fields of Params to update, the rest are left unchanged. If empty only the non-zero fields of
+	// Params are updated so a field can only be reset to zero by naming it here.
+	ParamsMask           []string `protobuf:"bytes,6,rep,name=ParamsMask,proto3" json:"ParamsMask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GovTx) Reset()      { *m = GovTx{} }
 func (*GovTx) ProtoMessage() {}
 func (*GovTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{10}
+	return fileDescriptor_678c914f1bee6d56, []int{11}
 }
 func (m *GovTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -768,27 +850,27 @@ func (*GovTx) XXX_MessageName() string {
 	return "payload.GovTx"
 }
 
-type ProposalTx struct {
-	Input                *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
-	VotingWeight         int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
-	ProposalHash         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
-	Proposal             *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+// Identifies a scheduled GovTx by its activation height and the hash of the transaction that scheduled it
+type ScheduledGovTxID struct {
+	Height               uint64                                        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	TxHash               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *ProposalTx) Reset()      { *m = ProposalTx{} }
-func (*ProposalTx) ProtoMessage() {}
-func (*ProposalTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{11}
+func (m *ScheduledGovTxID) Reset()         { *m = ScheduledGovTxID{} }
+func (m *ScheduledGovTxID) String() string { return proto.CompactTextString(m) }
+func (*ScheduledGovTxID) ProtoMessage()    {}
+func (*ScheduledGovTxID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{12}
 }
-func (m *ProposalTx) XXX_Unmarshal(b []byte) error {
+func (m *ScheduledGovTxID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProposalTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduledGovTxID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProposalTx.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduledGovTxID.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -798,43 +880,87 @@ func (m *ProposalTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ProposalTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposalTx.Merge(m, src)
+func (m *ScheduledGovTxID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledGovTxID.Merge(m, src)
 }
-func (m *ProposalTx) XXX_Size() int {
+func (m *ScheduledGovTxID) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProposalTx) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProposalTx.DiscardUnknown(m)
+func (m *ScheduledGovTxID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledGovTxID.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProposalTx proto.InternalMessageInfo
+var xxx_messageInfo_ScheduledGovTxID proto.InternalMessageInfo
 
-func (*ProposalTx) XXX_MessageName() string {
-	return "payload.ProposalTx"
+func (m *ScheduledGovTxID) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type IdentifyTx struct {
-	// Senders
-	Inputs []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
-	// Node to register
-	Node                 *registry.NodeIdentity `protobuf:"bytes,2,opt,name=Node,proto3" json:"Node,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (*ScheduledGovTxID) XXX_MessageName() string {
+	return "payload.ScheduledGovTxID"
+}
+
+// Chain parameters that are set at genesis and may be adjusted by governance
+type ChainParams struct {
+	// The maximum total gas that may be used by the transactions of a block (zero means unlimited). A CallTx is only
+	// admitted to a block if its gas limit fits within the gas remaining.
+	BlockGasLimit uint64 `protobuf:"varint,1,opt,name=BlockGasLimit,proto3" json:"BlockGasLimit,omitempty"`
+	// The maximum number of EVM instructions a single transaction may execute across all of its calls (zero means
+	// unlimited). This acts as a deterministic proxy for a wall-clock execution ceiling so that code that is cheap in
+	// gas but slow to execute cannot lengthen block times unboundedly.
+	MaxTxInstructions uint64 `protobuf:"varint,2,opt,name=MaxTxInstructions,proto3" json:"MaxTxInstructions,omitempty"`
+	// The maximum number of bytes of data a single EVM log event may carry (zero means unlimited) unless the emitting
+	// contract has the Emit permission
+	MaxLogDataSize uint64 `protobuf:"varint,3,opt,name=MaxLogDataSize,proto3" json:"MaxLogDataSize,omitempty"`
+	// The maximum number of EVM log events a contract may emit within a single transaction (zero means unlimited)
+	// unless it has the Emit permission
+	MaxTxLogs uint64 `protobuf:"varint,4,opt,name=MaxTxLogs,proto3" json:"MaxTxLogs,omitempty"`
+	// The largest change to validator power, as a percentage of total power, that a GovTx may make within a block
+	// without delay (zero means unlimited). Larger changes are time-locked.
+	MaxValidatorPowerChange uint64 `protobuf:"varint,5,opt,name=MaxValidatorPowerChange,proto3" json:"MaxValidatorPowerChange,omitempty"`
+	// The number of blocks a time-locked validator power change waits before it is applied, during which it may be
+	// vetoed by another GovTx
+	ValidatorPowerChangeDelay uint64 `protobuf:"varint,6,opt,name=ValidatorPowerChangeDelay,proto3" json:"ValidatorPowerChangeDelay,omitempty"`
+	// The minimum fee of a CallTx or NameTx (zero means no minimum), which does not apply to calls in FeeExemptCalls
+	MinFee uint64 `protobuf:"varint,7,opt,name=MinFee,proto3" json:"MinFee,omitempty"`
+	// Calls that are exempt from MinFee (and from any minimum fee imposed by a node's circuit breaker) so that
+	// consortium system operations can remain free while general traffic pays
+	FeeExemptCalls []*FeeExemptCall `protobuf:"bytes,8,rep,name=FeeExemptCalls,proto3" json:"FeeExemptCalls,omitempty"`
+	// Whether transaction fees are paid from accounts' GasBalance in the gas token rather than from the native token
+	// sent as their input amount, so that the cost of using the chain is accounted separately from application value
+	SeparateGasToken bool `protobuf:"varint,9,opt,name=SeparateGasToken,proto3" json:"SeparateGasToken,omitempty"`
+	// Whether a contract's CALL, CALLCODE, DELEGATECALL, STATICCALL, CREATE, and CREATE2 forward at most all but one
+	// 64th of its remaining gas (the EIP-150 rule) so that it always retains gas to continue after the call, as on
+	// Ethereum. Otherwise a call is given the gas it requests if it is available.
+	CapCallGas bool `protobuf:"varint,10,opt,name=CapCallGas,proto3" json:"CapCallGas,omitempty"`
+	// The permissions granted to an account when it is created by a SendTx, by a CallTx deploying a contract, or by a
+	// contract's CALL, CREATE, or CREATE2. A new account otherwise falls back to the global permissions.
+	NewAccountPermissions github_com_hyperledger_burrow_permission.PermFlag `protobuf:"varint,11,opt,name=NewAccountPermissions,proto3,casttype=github.com/hyperledger/burrow/permission.PermFlag" json:"NewAccountPermissions,omitempty"`
+	// The most permissions such an account may have when it is created (zero means unlimited). Every other permission
+	// is explicitly unset on the new account so that it cannot fall back to the global permissions.
+	MaxNewAccountPermissions github_com_hyperledger_burrow_permission.PermFlag `protobuf:"varint,12,opt,name=MaxNewAccountPermissions,proto3,casttype=github.com/hyperledger/burrow/permission.PermFlag" json:"MaxNewAccountPermissions,omitempty"`
+	// The EVM gas schedule, either 'burrow' (the default when empty) or 'ethereum'
+	GasSchedule          string   `protobuf:"bytes,13,opt,name=GasSchedule,proto3" json:"GasSchedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IdentifyTx) Reset()      { *m = IdentifyTx{} }
-func (*IdentifyTx) ProtoMessage() {}
-func (*IdentifyTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{12}
+func (m *ChainParams) Reset()         { *m = ChainParams{} }
+func (m *ChainParams) String() string { return proto.CompactTextString(m) }
+func (*ChainParams) ProtoMessage()    {}
+func (*ChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{13}
 }
-func (m *IdentifyTx) XXX_Unmarshal(b []byte) error {
+func (m *ChainParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IdentifyTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IdentifyTx.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChainParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -844,85 +970,136 @@ func (m *IdentifyTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *IdentifyTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IdentifyTx.Merge(m, src)
+func (m *ChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainParams.Merge(m, src)
 }
-func (m *IdentifyTx) XXX_Size() int {
+func (m *ChainParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *IdentifyTx) XXX_DiscardUnknown() {
-	xxx_messageInfo_IdentifyTx.DiscardUnknown(m)
+func (m *ChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IdentifyTx proto.InternalMessageInfo
+var xxx_messageInfo_ChainParams proto.InternalMessageInfo
 
-func (*IdentifyTx) XXX_MessageName() string {
-	return "payload.IdentifyTx"
+func (m *ChainParams) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
 }
 
-type BatchTx struct {
-	Inputs               []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
-	Txs                  []*Any     `protobuf:"bytes,2,rep,name=Txs,proto3" json:"Txs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *ChainParams) GetMaxTxInstructions() uint64 {
+	if m != nil {
+		return m.MaxTxInstructions
+	}
+	return 0
 }
 
-func (m *BatchTx) Reset()      { *m = BatchTx{} }
-func (*BatchTx) ProtoMessage() {}
-func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{13}
+func (m *ChainParams) GetMaxLogDataSize() uint64 {
+	if m != nil {
+		return m.MaxLogDataSize
+	}
+	return 0
 }
-func (m *BatchTx) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *ChainParams) GetMaxTxLogs() uint64 {
+	if m != nil {
+		return m.MaxTxLogs
+	}
+	return 0
 }
-func (m *BatchTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_BatchTx.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *ChainParams) GetMaxValidatorPowerChange() uint64 {
+	if m != nil {
+		return m.MaxValidatorPowerChange
 	}
+	return 0
 }
-func (m *BatchTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTx.Merge(m, src)
+
+func (m *ChainParams) GetValidatorPowerChangeDelay() uint64 {
+	if m != nil {
+		return m.ValidatorPowerChangeDelay
+	}
+	return 0
 }
-func (m *BatchTx) XXX_Size() int {
-	return m.Size()
+
+func (m *ChainParams) GetMinFee() uint64 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
 }
-func (m *BatchTx) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTx.DiscardUnknown(m)
+
+func (m *ChainParams) GetFeeExemptCalls() []*FeeExemptCall {
+	if m != nil {
+		return m.FeeExemptCalls
+	}
+	return nil
 }
 
-var xxx_messageInfo_BatchTx proto.InternalMessageInfo
+func (m *ChainParams) GetSeparateGasToken() bool {
+	if m != nil {
+		return m.SeparateGasToken
+	}
+	return false
+}
 
-func (*BatchTx) XXX_MessageName() string {
-	return "payload.BatchTx"
+func (m *ChainParams) GetCapCallGas() bool {
+	if m != nil {
+		return m.CapCallGas
+	}
+	return false
 }
 
-type Vote struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	VotingWeight         int64                                        `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+func (m *ChainParams) GetNewAccountPermissions() github_com_hyperledger_burrow_permission.PermFlag {
+	if m != nil {
+		return m.NewAccountPermissions
+	}
+	return 0
 }
 
-func (m *Vote) Reset()      { *m = Vote{} }
-func (*Vote) ProtoMessage() {}
-func (*Vote) Descriptor() ([]byte, []int) {
+func (m *ChainParams) GetMaxNewAccountPermissions() github_com_hyperledger_burrow_permission.PermFlag {
+	if m != nil {
+		return m.MaxNewAccountPermissions
+	}
+	return 0
+}
+
+func (m *ChainParams) GetGasSchedule() string {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return ""
+}
+
+func (*ChainParams) XXX_MessageName() string {
+	return "payload.ChainParams"
+}
+
+// A CallTx from Caller to Callee that is exempt from minimum fees
+type FeeExemptCall struct {
+	Caller github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Caller,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Caller"`
+	Callee github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Callee,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Callee"`
+	// The 4-byte function selector that the call data must start with, any call from Caller to Callee is exempt if empty
+	Selector             github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Selector,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Selector"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *FeeExemptCall) Reset()         { *m = FeeExemptCall{} }
+func (m *FeeExemptCall) String() string { return proto.CompactTextString(m) }
+func (*FeeExemptCall) ProtoMessage()    {}
+func (*FeeExemptCall) Descriptor() ([]byte, []int) {
 	return fileDescriptor_678c914f1bee6d56, []int{14}
 }
-func (m *Vote) XXX_Unmarshal(b []byte) error {
+func (m *FeeExemptCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeExemptCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeExemptCall.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -932,42 +1109,48 @@ func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(m, src)
+func (m *FeeExemptCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExemptCall.Merge(m, src)
 }
-func (m *Vote) XXX_Size() int {
+func (m *FeeExemptCall) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vote) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vote.DiscardUnknown(m)
+func (m *FeeExemptCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExemptCall.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vote proto.InternalMessageInfo
+var xxx_messageInfo_FeeExemptCall proto.InternalMessageInfo
 
-func (*Vote) XXX_MessageName() string {
-	return "payload.Vote"
+func (*FeeExemptCall) XXX_MessageName() string {
+	return "payload.FeeExemptCall"
 }
 
-type Proposal struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	BatchTx              *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+// A GovTx awaiting activation
+type ScheduledGovTx struct {
+	// The height at which the GovTx will be applied
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The hash of the transaction that scheduled the GovTx
+	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	GovTx  *GovTx                                        `protobuf:"bytes,3,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
+	// Whether the GovTx was scheduled because its validator power change exceeded MaxValidatorPowerChange
+	TimeLocked           bool     `protobuf:"varint,4,opt,name=TimeLocked,proto3" json:"TimeLocked,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Proposal) Reset()      { *m = Proposal{} }
-func (*Proposal) ProtoMessage() {}
-func (*Proposal) Descriptor() ([]byte, []int) {
+func (m *ScheduledGovTx) Reset()         { *m = ScheduledGovTx{} }
+func (m *ScheduledGovTx) String() string { return proto.CompactTextString(m) }
+func (*ScheduledGovTx) ProtoMessage()    {}
+func (*ScheduledGovTx) Descriptor() ([]byte, []int) {
 	return fileDescriptor_678c914f1bee6d56, []int{15}
 }
-func (m *Proposal) XXX_Unmarshal(b []byte) error {
+func (m *ScheduledGovTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduledGovTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduledGovTx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -977,44 +1160,64 @@ func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Proposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Proposal.Merge(m, src)
+func (m *ScheduledGovTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledGovTx.Merge(m, src)
 }
-func (m *Proposal) XXX_Size() int {
+func (m *ScheduledGovTx) XXX_Size() int {
 	return m.Size()
 }
-func (m *Proposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_Proposal.DiscardUnknown(m)
+func (m *ScheduledGovTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledGovTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Proposal proto.InternalMessageInfo
+var xxx_messageInfo_ScheduledGovTx proto.InternalMessageInfo
 
-func (*Proposal) XXX_MessageName() string {
-	return "payload.Proposal"
+func (m *ScheduledGovTx) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type Ballot struct {
-	Proposal             *Proposal                                      `protobuf:"bytes,1,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
-	FinalizingTx         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=FinalizingTx,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"FinalizingTx,omitempty"`
-	ProposalState        Ballot_ProposalState                           `protobuf:"varint,4,opt,name=proposalState,proto3,enum=payload.Ballot_ProposalState" json:"proposalState,omitempty"`
-	Votes                []*Vote                                        `protobuf:"bytes,5,rep,name=Votes,proto3" json:"Votes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
+func (m *ScheduledGovTx) GetGovTx() *GovTx {
+	if m != nil {
+		return m.GovTx
+	}
+	return nil
+}
+
+func (m *ScheduledGovTx) GetTimeLocked() bool {
+	if m != nil {
+		return m.TimeLocked
+	}
+	return false
+}
+
+func (*ScheduledGovTx) XXX_MessageName() string {
+	return "payload.ScheduledGovTx"
+}
+
+type ProposalTx struct {
+	Input                *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	VotingWeight         int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
+	ProposalHash         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
+	Proposal             *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
 	XXX_sizecache        int32                                          `json:"-"`
 }
 
-func (m *Ballot) Reset()         { *m = Ballot{} }
-func (m *Ballot) String() string { return proto.CompactTextString(m) }
-func (*Ballot) ProtoMessage()    {}
-func (*Ballot) Descriptor() ([]byte, []int) {
+func (m *ProposalTx) Reset()      { *m = ProposalTx{} }
+func (*ProposalTx) ProtoMessage() {}
+func (*ProposalTx) Descriptor() ([]byte, []int) {
 	return fileDescriptor_678c914f1bee6d56, []int{16}
 }
-func (m *Ballot) XXX_Unmarshal(b []byte) error {
+func (m *ProposalTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Ballot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Ballot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalTx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1024,189 +1227,547 @@ func (m *Ballot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Ballot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Ballot.Merge(m, src)
+func (m *ProposalTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTx.Merge(m, src)
 }
-func (m *Ballot) XXX_Size() int {
+func (m *ProposalTx) XXX_Size() int {
 	return m.Size()
 }
-func (m *Ballot) XXX_DiscardUnknown() {
-	xxx_messageInfo_Ballot.DiscardUnknown(m)
+func (m *ProposalTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Ballot proto.InternalMessageInfo
+var xxx_messageInfo_ProposalTx proto.InternalMessageInfo
 
-func (m *Ballot) GetProposal() *Proposal {
-	if m != nil {
-		return m.Proposal
+func (*ProposalTx) XXX_MessageName() string {
+	return "payload.ProposalTx"
+}
+
+type IdentifyTx struct {
+	// Senders
+	Inputs []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	// Node to register
+	Node                 *registry.NodeIdentity `protobuf:"bytes,2,opt,name=Node,proto3" json:"Node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *IdentifyTx) Reset()      { *m = IdentifyTx{} }
+func (*IdentifyTx) ProtoMessage() {}
+func (*IdentifyTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{17}
+}
+func (m *IdentifyTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifyTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifyTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *IdentifyTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifyTx.Merge(m, src)
+}
+func (m *IdentifyTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifyTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifyTx.DiscardUnknown(m)
 }
 
-func (m *Ballot) GetProposalState() Ballot_ProposalState {
-	if m != nil {
-		return m.ProposalState
+var xxx_messageInfo_IdentifyTx proto.InternalMessageInfo
+
+func (*IdentifyTx) XXX_MessageName() string {
+	return "payload.IdentifyTx"
+}
+
+// Attests that a validator is live and following the chain, independently of its participation in consensus
+type HeartbeatTx struct {
+	// The validator's input
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The height of the latest block the validator has seen
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The hash of the block at Height
+	BlockHash            github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=BlockHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"BlockHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *HeartbeatTx) Reset()      { *m = HeartbeatTx{} }
+func (*HeartbeatTx) ProtoMessage() {}
+func (*HeartbeatTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{18}
+}
+func (m *HeartbeatTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Ballot_PROPOSED
+}
+func (m *HeartbeatTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatTx.Merge(m, src)
+}
+func (m *HeartbeatTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatTx.DiscardUnknown(m)
 }
 
-func (m *Ballot) GetVotes() []*Vote {
+var xxx_messageInfo_HeartbeatTx proto.InternalMessageInfo
+
+func (m *HeartbeatTx) GetInput() *TxInput {
 	if m != nil {
-		return m.Votes
+		return m.Input
 	}
 	return nil
 }
 
-func (*Ballot) XXX_MessageName() string {
-	return "payload.Ballot"
-}
-func init() {
-	proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
-	golang_proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
-	proto.RegisterType((*Any)(nil), "payload.Any")
-	golang_proto.RegisterType((*Any)(nil), "payload.Any")
-	proto.RegisterType((*TxInput)(nil), "payload.TxInput")
-	golang_proto.RegisterType((*TxInput)(nil), "payload.TxInput")
-	proto.RegisterType((*TxOutput)(nil), "payload.TxOutput")
-	golang_proto.RegisterType((*TxOutput)(nil), "payload.TxOutput")
-	proto.RegisterType((*CallTx)(nil), "payload.CallTx")
-	golang_proto.RegisterType((*CallTx)(nil), "payload.CallTx")
-	proto.RegisterType((*ContractMeta)(nil), "payload.ContractMeta")
-	golang_proto.RegisterType((*ContractMeta)(nil), "payload.ContractMeta")
-	proto.RegisterType((*SendTx)(nil), "payload.SendTx")
-	golang_proto.RegisterType((*SendTx)(nil), "payload.SendTx")
-	proto.RegisterType((*PermsTx)(nil), "payload.PermsTx")
-	golang_proto.RegisterType((*PermsTx)(nil), "payload.PermsTx")
-	proto.RegisterType((*NameTx)(nil), "payload.NameTx")
-	golang_proto.RegisterType((*NameTx)(nil), "payload.NameTx")
-	proto.RegisterType((*BondTx)(nil), "payload.BondTx")
-	golang_proto.RegisterType((*BondTx)(nil), "payload.BondTx")
-	proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
-	golang_proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
-	proto.RegisterType((*GovTx)(nil), "payload.GovTx")
-	golang_proto.RegisterType((*GovTx)(nil), "payload.GovTx")
-	proto.RegisterType((*ProposalTx)(nil), "payload.ProposalTx")
-	golang_proto.RegisterType((*ProposalTx)(nil), "payload.ProposalTx")
-	proto.RegisterType((*IdentifyTx)(nil), "payload.IdentifyTx")
-	golang_proto.RegisterType((*IdentifyTx)(nil), "payload.IdentifyTx")
-	proto.RegisterType((*BatchTx)(nil), "payload.BatchTx")
-	golang_proto.RegisterType((*BatchTx)(nil), "payload.BatchTx")
-	proto.RegisterType((*Vote)(nil), "payload.Vote")
-	golang_proto.RegisterType((*Vote)(nil), "payload.Vote")
-	proto.RegisterType((*Proposal)(nil), "payload.Proposal")
-	golang_proto.RegisterType((*Proposal)(nil), "payload.Proposal")
-	proto.RegisterType((*Ballot)(nil), "payload.Ballot")
-	golang_proto.RegisterType((*Ballot)(nil), "payload.Ballot")
+func (m *HeartbeatTx) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
-func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
+func (*HeartbeatTx) XXX_MessageName() string {
+	return "payload.HeartbeatTx"
+}
 
-var fileDescriptor_678c914f1bee6d56 = []byte{
-	// 1099 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x66, 0x37, 0xb6, 0xfb, 0xe2, 0x04, 0x77, 0xa0, 0x95, 0x15, 0x09, 0x3b, 0x32, 0x08,
-	0xd2, 0xd2, 0x38, 0x90, 0xf2, 0x47, 0xe4, 0x82, 0xfc, 0x2f, 0x69, 0x50, 0x9b, 0x98, 0xf1, 0xa6,
-	0x45, 0x20, 0x0e, 0xeb, 0xf5, 0x74, 0xbd, 0x92, 0xbd, 0xb3, 0xec, 0x8e, 0xcb, 0x2e, 0x27, 0x0e,
-	0x1c, 0xb8, 0x73, 0xe1, 0x98, 0x6f, 0x80, 0xf8, 0x06, 0x9c, 0x50, 0x8e, 0x9c, 0x39, 0x44, 0x28,
-	0xbd, 0x20, 0x3e, 0x05, 0x9a, 0xd9, 0xd9, 0xf5, 0xd8, 0xad, 0x5a, 0x27, 0x42, 0xbd, 0xcd, 0x7b,
-	0xef, 0x37, 0xef, 0xbd, 0x79, 0xef, 0x37, 0x6f, 0x06, 0xd6, 0x7c, 0x2b, 0x1e, 0x51, 0x6b, 0x50,
-	0xf7, 0x03, 0xca, 0x28, 0xca, 0x4b, 0x71, 0x63, 0xdb, 0x71, 0xd9, 0x70, 0xd2, 0xaf, 0xdb, 0x74,
-	0xbc, 0xe3, 0x50, 0x87, 0xee, 0x08, 0x7b, 0x7f, 0xf2, 0x58, 0x48, 0x42, 0x10, 0xab, 0x64, 0xdf,
-	0x46, 0xc9, 0x27, 0xc1, 0xd8, 0x0d, 0x43, 0x97, 0x7a, 0x52, 0xb3, 0x1e, 0x10, 0xc7, 0x0d, 0x59,
-	0x10, 0x4b, 0x19, 0x42, 0x9f, 0xd8, 0xc9, 0xba, 0xf6, 0x87, 0x0e, 0x7a, 0xc3, 0x8b, 0xd1, 0xbb,
-	0x90, 0x6b, 0x59, 0xa3, 0x91, 0x19, 0x95, 0xb5, 0x4d, 0x6d, 0x6b, 0x75, 0xf7, 0xb5, 0x7a, 0x9a,
-	0x4d, 0xa2, 0xc6, 0xd2, 0xcc, 0x81, 0x3d, 0xe2, 0x0d, 0xcc, 0xa8, 0xbc, 0x3c, 0x07, 0x4c, 0xd4,
-	0x58, 0x9a, 0x39, 0xf0, 0xc8, 0x1a, 0x13, 0x33, 0x2a, 0xeb, 0x73, 0xc0, 0x44, 0x8d, 0xa5, 0x19,
-	0xdd, 0x86, 0x7c, 0x97, 0x04, 0xe3, 0xd0, 0x8c, 0xca, 0x86, 0x40, 0x96, 0x32, 0xa4, 0xd4, 0xe3,
-	0x14, 0x80, 0xde, 0x86, 0x95, 0x03, 0xfa, 0xc4, 0x8c, 0xca, 0x2b, 0x02, 0xb9, 0x9e, 0x21, 0x85,
-	0x16, 0x27, 0x46, 0x1e, 0xba, 0x49, 0x45, 0x8e, 0xb9, 0xb9, 0xd0, 0x89, 0x1a, 0x4b, 0x33, 0xda,
-	0x86, 0xc2, 0x89, 0xd7, 0x4f, 0xa0, 0x79, 0x01, 0xbd, 0x9e, 0x41, 0x53, 0x03, 0xce, 0x20, 0x3c,
-	0xd3, 0xa6, 0xc5, 0xec, 0xa1, 0x19, 0x95, 0x0b, 0x73, 0x99, 0x4a, 0x3d, 0x4e, 0x01, 0xe8, 0x2e,
-	0x40, 0x37, 0xa0, 0x3e, 0x0d, 0x2d, 0x5e, 0xd4, 0x6b, 0x02, 0xfe, 0xfa, 0xf4, 0x60, 0x99, 0x09,
-	0x2b, 0x30, 0xbe, 0xe9, 0x70, 0x40, 0x3c, 0xe6, 0x3e, 0x8e, 0xcd, 0xa8, 0x0c, 0x73, 0x9b, 0xa6,
-	0x26, 0xac, 0xc0, 0xf6, 0x8c, 0xb3, 0xd3, 0xaa, 0x56, 0xfb, 0x59, 0x83, 0xbc, 0x19, 0x1d, 0x7a,
-	0xfe, 0x84, 0xa1, 0x23, 0xc8, 0x37, 0x06, 0x83, 0x80, 0x84, 0xa1, 0xe8, 0x66, 0xb1, 0xf9, 0xe1,
-	0xd9, 0x79, 0x75, 0xe9, 0xaf, 0xf3, 0xea, 0x1d, 0x85, 0x4a, 0xc3, 0xd8, 0x27, 0xc1, 0x88, 0x0c,
-	0x1c, 0x12, 0xec, 0xf4, 0x27, 0x41, 0x40, 0xbf, 0xdb, 0xb1, 0x83, 0xd8, 0x67, 0xb4, 0x2e, 0xf7,
-	0xe2, 0xd4, 0x09, 0xba, 0x09, 0xb9, 0xc6, 0x98, 0x4e, 0x3c, 0x26, 0x7a, 0x6e, 0x60, 0x29, 0xa1,
-	0x0d, 0x28, 0xf4, 0xc8, 0xb7, 0x13, 0xe2, 0xd9, 0x44, 0x34, 0xd9, 0xc0, 0x99, 0xbc, 0x67, 0xfc,
-	0x72, 0x5a, 0x5d, 0xaa, 0x45, 0x50, 0x30, 0xa3, 0xe3, 0x09, 0x7b, 0x85, 0x59, 0xc9, 0xc8, 0xbf,
-	0xea, 0x29, 0xa3, 0xd1, 0x3b, 0xb0, 0x22, 0xea, 0x22, 0xa9, 0x3d, 0x6d, 0x9a, 0xac, 0x17, 0x4e,
-	0xcc, 0xe8, 0xf3, 0x69, 0x82, 0xcb, 0x22, 0xc1, 0xf7, 0xaf, 0x9e, 0xdc, 0x06, 0x14, 0x0e, 0xac,
-	0xf0, 0xbe, 0x3b, 0x76, 0x59, 0x5a, 0x9a, 0x54, 0x46, 0x25, 0xd0, 0xf7, 0x09, 0x11, 0x64, 0x37,
-	0x30, 0x5f, 0xa2, 0x43, 0x30, 0xda, 0x16, 0xb3, 0x04, 0xab, 0x8b, 0xcd, 0x8f, 0x64, 0x5d, 0xb6,
-	0x5f, 0x1c, 0xba, 0xef, 0x7a, 0x56, 0x10, 0xd7, 0xef, 0x91, 0xa8, 0x19, 0x33, 0x12, 0x62, 0xe1,
-	0x02, 0x7d, 0x0d, 0xc6, 0xa3, 0x46, 0xef, 0x81, 0x60, 0x7e, 0xb1, 0x79, 0x70, 0x25, 0x57, 0xff,
-	0x9e, 0x57, 0xd7, 0x99, 0xe5, 0x84, 0x77, 0xe8, 0xd8, 0x65, 0x64, 0xec, 0xb3, 0x18, 0x0b, 0xa7,
-	0xe8, 0x53, 0x28, 0xb6, 0xa8, 0xc7, 0x02, 0xcb, 0x66, 0x0f, 0x08, 0xb3, 0xca, 0xf9, 0x4d, 0x7d,
-	0x6b, 0x75, 0xf7, 0xc6, 0x74, 0x56, 0x28, 0x46, 0x3c, 0x03, 0x95, 0x05, 0xe9, 0x06, 0xae, 0x4d,
-	0xc4, 0xe5, 0x49, 0x0a, 0x22, 0x64, 0xd9, 0xb1, 0xc9, 0xac, 0x73, 0xf4, 0x05, 0x14, 0x5a, 0x74,
-	0x40, 0xee, 0x59, 0xe1, 0x50, 0x12, 0xe6, 0x8a, 0x85, 0xc9, 0xdc, 0x20, 0x04, 0x86, 0xc8, 0x9b,
-	0xb7, 0xf7, 0x1a, 0x16, 0xeb, 0x9a, 0x9b, 0x0e, 0x34, 0xb4, 0x05, 0x39, 0x41, 0x04, 0xce, 0x4f,
-	0xfd, 0xb9, 0x44, 0x91, 0x76, 0xf4, 0x1e, 0xe4, 0x13, 0x52, 0x73, 0xa6, 0xe8, 0x33, 0x63, 0x23,
-	0xa5, 0x3b, 0x4e, 0x11, 0x7b, 0x85, 0x9f, 0x4e, 0xab, 0x4b, 0xe2, 0x84, 0x34, 0x9b, 0x74, 0x0b,
-	0x73, 0xf2, 0x63, 0x28, 0xf0, 0x2d, 0x8d, 0xc0, 0x09, 0xe5, 0xc0, 0x7d, 0xa3, 0xae, 0x0c, 0xf8,
-	0xd4, 0xd6, 0x34, 0x78, 0x69, 0x70, 0x86, 0x95, 0x25, 0xf5, 0xd3, 0x19, 0xbc, 0x70, 0x3c, 0x04,
-	0x06, 0xdf, 0x91, 0x56, 0x88, 0xaf, 0xb9, 0x4e, 0xb0, 0x53, 0x4f, 0x74, 0x82, 0x66, 0xcf, 0x70,
-	0x58, 0x46, 0xdc, 0x4b, 0x47, 0xef, 0xa2, 0x11, 0x95, 0xf2, 0x38, 0xd3, 0x69, 0xbc, 0x70, 0xbe,
-	0xb7, 0x20, 0x97, 0xd4, 0x59, 0x56, 0xe7, 0x39, 0x8d, 0x90, 0x00, 0x25, 0xd0, 0x0f, 0x9a, 0x7c,
-	0x46, 0x2e, 0xd1, 0xf2, 0x16, 0xac, 0x37, 0x6c, 0x9b, 0x0f, 0x98, 0x13, 0x7f, 0x60, 0x31, 0x92,
-	0x76, 0xfe, 0x46, 0x5d, 0xbc, 0xa6, 0x26, 0x19, 0xfb, 0x23, 0x8b, 0x11, 0x89, 0x11, 0xfd, 0xd0,
-	0xf0, 0xdc, 0x16, 0x25, 0x85, 0x7f, 0x34, 0xf5, 0x7d, 0x58, 0xf8, 0xb8, 0x35, 0x28, 0x3e, 0xa4,
-	0xcc, 0xf5, 0x9c, 0x47, 0xc4, 0x75, 0x86, 0xc9, 0xa1, 0x75, 0x3c, 0xa3, 0x43, 0x27, 0x50, 0x4c,
-	0x3d, 0x8b, 0xbb, 0xa3, 0x8b, 0xbb, 0xf3, 0xc1, 0xe5, 0xef, 0xcd, 0x8c, 0x1b, 0xfe, 0x56, 0xa6,
-	0xb2, 0x7c, 0xa7, 0xaf, 0x3f, 0xf3, 0x9c, 0xe1, 0x0c, 0xa2, 0x1c, 0x75, 0xa4, 0x3e, 0x6a, 0x97,
-	0xa8, 0xf8, 0x6d, 0x30, 0x8e, 0xe8, 0x80, 0xc8, 0xc6, 0xde, 0xac, 0x67, 0xbf, 0x18, 0xae, 0x4d,
-	0x3c, 0xf2, 0xc1, 0xc4, 0x25, 0x25, 0xda, 0x37, 0xd9, 0x1b, 0x7d, 0x89, 0x50, 0x15, 0xd0, 0xcd,
-	0x28, 0xed, 0x68, 0x31, 0x83, 0x35, 0xbc, 0x18, 0x73, 0x83, 0xe2, 0xfe, 0x47, 0x0d, 0x8c, 0x87,
-	0x94, 0x91, 0xff, 0xfd, 0x35, 0x5b, 0xa0, 0xb3, 0x4a, 0x1a, 0x4f, 0xa6, 0xcd, 0xc8, 0xae, 0xac,
-	0xa6, 0x5c, 0xd9, 0x4d, 0x58, 0x6d, 0x93, 0xd0, 0x0e, 0x5c, 0x9f, 0xb9, 0xd4, 0x93, 0xb7, 0x59,
-	0x55, 0xa9, 0x7f, 0x19, 0xfd, 0x25, 0x7f, 0x19, 0x25, 0xee, 0x6f, 0xcb, 0x90, 0x6b, 0x5a, 0xa3,
-	0x11, 0x65, 0x33, 0x7c, 0xd0, 0x5e, 0xca, 0x07, 0xce, 0xca, 0x7d, 0xd7, 0xb3, 0x46, 0xee, 0xf7,
-	0xae, 0xe7, 0xc8, 0xdf, 0xe3, 0xd5, 0x58, 0xa9, 0xba, 0x41, 0x2d, 0x58, 0xf3, 0x65, 0x88, 0x1e,
-	0xb3, 0x58, 0x32, 0x91, 0xd6, 0x77, 0xdf, 0x54, 0x0e, 0xc3, 0xb3, 0xcd, 0x32, 0x12, 0x20, 0x3c,
-	0xbb, 0x07, 0xbd, 0x05, 0x2b, 0xbc, 0xa7, 0x61, 0x79, 0x45, 0x10, 0x60, 0x2d, 0xdb, 0xcc, 0xb5,
-	0x38, 0xb1, 0xd5, 0x3e, 0x81, 0xb5, 0x19, 0x27, 0xa8, 0x08, 0x85, 0x2e, 0x3e, 0xee, 0x1e, 0xf7,
-	0x3a, 0xed, 0xd2, 0x12, 0x97, 0x3a, 0x5f, 0x76, 0x5a, 0x27, 0x66, 0xa7, 0x5d, 0xd2, 0x10, 0x40,
-	0x6e, 0xbf, 0x71, 0x78, 0xbf, 0xd3, 0x2e, 0x2d, 0x37, 0x3f, 0x3b, 0xbb, 0xa8, 0x68, 0x7f, 0x5e,
-	0x54, 0xb4, 0xbf, 0x2f, 0x2a, 0xda, 0xef, 0x4f, 0x2b, 0xda, 0xd9, 0xd3, 0x8a, 0xf6, 0xd5, 0xad,
-	0x17, 0x9f, 0x9a, 0x45, 0xe1, 0x8e, 0xcc, 0xa2, 0x9f, 0x13, 0x5f, 0xf5, 0xbb, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x5c, 0xab, 0x20, 0xfa, 0x21, 0x0c, 0x00, 0x00,
+type BatchTx struct {
+	Inputs               []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	Txs                  []*Any     `protobuf:"bytes,2,rep,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *Any) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchTx) Reset()      { *m = BatchTx{} }
+func (*BatchTx) ProtoMessage() {}
+func (*BatchTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{19}
+}
+func (m *BatchTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BatchTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTx.Merge(m, src)
+}
+func (m *BatchTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTx.DiscardUnknown(m)
 }
 
-func (m *Any) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BatchTx proto.InternalMessageInfo
+
+func (*BatchTx) XXX_MessageName() string {
+	return "payload.BatchTx"
 }
 
-func (m *Any) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IdentifyTx != nil {
-		{
-			size, err := m.IdentifyTx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPayload(dAtA, i, uint64(size))
+type Vote struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	VotingWeight         int64                                        `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *Vote) Reset()      { *m = Vote{} }
+func (*Vote) ProtoMessage() {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{20}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (*Vote) XXX_MessageName() string {
+	return "payload.Vote"
+}
+
+type Proposal struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	BatchTx              *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Proposal) Reset()      { *m = Proposal{} }
+func (*Proposal) ProtoMessage() {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{21}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (*Proposal) XXX_MessageName() string {
+	return "payload.Proposal"
+}
+
+type Ballot struct {
+	Proposal             *Proposal                                      `protobuf:"bytes,1,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
+	FinalizingTx         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=FinalizingTx,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"FinalizingTx,omitempty"`
+	ProposalState        Ballot_ProposalState                           `protobuf:"varint,4,opt,name=proposalState,proto3,enum=payload.Ballot_ProposalState" json:"proposalState,omitempty"`
+	Votes                []*Vote                                        `protobuf:"bytes,5,rep,name=Votes,proto3" json:"Votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *Ballot) Reset()         { *m = Ballot{} }
+func (m *Ballot) String() string { return proto.CompactTextString(m) }
+func (*Ballot) ProtoMessage()    {}
+func (*Ballot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{22}
+}
+func (m *Ballot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ballot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ballot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ballot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ballot.Merge(m, src)
+}
+func (m *Ballot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ballot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ballot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ballot proto.InternalMessageInfo
+
+func (m *Ballot) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *Ballot) GetProposalState() Ballot_ProposalState {
+	if m != nil {
+		return m.ProposalState
+	}
+	return Ballot_PROPOSED
+}
+
+func (m *Ballot) GetVotes() []*Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (*Ballot) XXX_MessageName() string {
+	return "payload.Ballot"
+}
+func init() {
+	proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
+	golang_proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
+	proto.RegisterType((*Any)(nil), "payload.Any")
+	golang_proto.RegisterType((*Any)(nil), "payload.Any")
+	proto.RegisterType((*TxInput)(nil), "payload.TxInput")
+	golang_proto.RegisterType((*TxInput)(nil), "payload.TxInput")
+	proto.RegisterType((*TxOutput)(nil), "payload.TxOutput")
+	golang_proto.RegisterType((*TxOutput)(nil), "payload.TxOutput")
+	proto.RegisterType((*PrivateTx)(nil), "payload.PrivateTx")
+	golang_proto.RegisterType((*PrivateTx)(nil), "payload.PrivateTx")
+	proto.RegisterType((*CallTx)(nil), "payload.CallTx")
+	golang_proto.RegisterType((*CallTx)(nil), "payload.CallTx")
+	proto.RegisterType((*ContractMeta)(nil), "payload.ContractMeta")
+	golang_proto.RegisterType((*ContractMeta)(nil), "payload.ContractMeta")
+	proto.RegisterType((*SendTx)(nil), "payload.SendTx")
+	golang_proto.RegisterType((*SendTx)(nil), "payload.SendTx")
+	proto.RegisterType((*PermsTx)(nil), "payload.PermsTx")
+	golang_proto.RegisterType((*PermsTx)(nil), "payload.PermsTx")
+	proto.RegisterType((*NameTx)(nil), "payload.NameTx")
+	golang_proto.RegisterType((*NameTx)(nil), "payload.NameTx")
+	proto.RegisterType((*BondTx)(nil), "payload.BondTx")
+	golang_proto.RegisterType((*BondTx)(nil), "payload.BondTx")
+	proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
+	golang_proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
+	proto.RegisterType((*GovTx)(nil), "payload.GovTx")
+	golang_proto.RegisterType((*GovTx)(nil), "payload.GovTx")
+	proto.RegisterType((*ScheduledGovTxID)(nil), "payload.ScheduledGovTxID")
+	golang_proto.RegisterType((*ScheduledGovTxID)(nil), "payload.ScheduledGovTxID")
+	proto.RegisterType((*ChainParams)(nil), "payload.ChainParams")
+	golang_proto.RegisterType((*ChainParams)(nil), "payload.ChainParams")
+	proto.RegisterType((*FeeExemptCall)(nil), "payload.FeeExemptCall")
+	golang_proto.RegisterType((*FeeExemptCall)(nil), "payload.FeeExemptCall")
+	proto.RegisterType((*ScheduledGovTx)(nil), "payload.ScheduledGovTx")
+	golang_proto.RegisterType((*ScheduledGovTx)(nil), "payload.ScheduledGovTx")
+	proto.RegisterType((*ProposalTx)(nil), "payload.ProposalTx")
+	golang_proto.RegisterType((*ProposalTx)(nil), "payload.ProposalTx")
+	proto.RegisterType((*IdentifyTx)(nil), "payload.IdentifyTx")
+	golang_proto.RegisterType((*IdentifyTx)(nil), "payload.IdentifyTx")
+	proto.RegisterType((*HeartbeatTx)(nil), "payload.HeartbeatTx")
+	golang_proto.RegisterType((*HeartbeatTx)(nil), "payload.HeartbeatTx")
+	proto.RegisterType((*BatchTx)(nil), "payload.BatchTx")
+	golang_proto.RegisterType((*BatchTx)(nil), "payload.BatchTx")
+	proto.RegisterType((*Vote)(nil), "payload.Vote")
+	golang_proto.RegisterType((*Vote)(nil), "payload.Vote")
+	proto.RegisterType((*Proposal)(nil), "payload.Proposal")
+	golang_proto.RegisterType((*Proposal)(nil), "payload.Proposal")
+	proto.RegisterType((*Ballot)(nil), "payload.Ballot")
+	golang_proto.RegisterType((*Ballot)(nil), "payload.Ballot")
+}
+
+func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
+func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
+
+var fileDescriptor_678c914f1bee6d56 = []byte{
+	// 1617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xc7, 0x1d, 0xdb, 0x79, 0x76, 0x8c, 0xb7, 0xd8, 0x19, 0x7a, 0x23, 0x70, 0x22, 0xb3,
+	0x5a, 0xb2, 0x43, 0xc6, 0xd9, 0x99, 0x65, 0x17, 0x88, 0x10, 0xc8, 0x76, 0xfe, 0xa2, 0x38, 0x63,
+	0xca, 0x9d, 0x0c, 0x02, 0x71, 0xa8, 0xb4, 0x6b, 0xed, 0x56, 0xec, 0xae, 0xde, 0xee, 0xf2, 0x4c,
+	0x7b, 0xcf, 0x1c, 0x38, 0xc3, 0x85, 0xe3, 0x1c, 0xb9, 0x20, 0xe0, 0x03, 0x20, 0x71, 0x41, 0xca,
+	0x91, 0x33, 0x87, 0x08, 0xcd, 0x5e, 0x10, 0x1f, 0x81, 0x03, 0x42, 0x55, 0x5d, 0xdd, 0x2e, 0x3b,
+	0x99, 0xac, 0x93, 0xac, 0xf6, 0xd6, 0xf5, 0xde, 0xef, 0xfd, 0xa9, 0x57, 0xaf, 0xde, 0x7b, 0xd5,
+	0xb0, 0xe2, 0x93, 0xf1, 0x80, 0x91, 0x6e, 0xcd, 0x0f, 0x18, 0x67, 0x28, 0xa7, 0x96, 0xab, 0x8f,
+	0x7b, 0x2e, 0xef, 0x8f, 0xce, 0x6a, 0x0e, 0x1b, 0x6e, 0xf5, 0x58, 0x8f, 0x6d, 0x49, 0xfe, 0xd9,
+	0xe8, 0x13, 0xb9, 0x92, 0x0b, 0xf9, 0x15, 0xcb, 0xad, 0x96, 0x7d, 0x1a, 0x0c, 0xdd, 0x30, 0x74,
+	0x99, 0xa7, 0x28, 0xa5, 0x80, 0xf6, 0xdc, 0x90, 0x07, 0x63, 0xb5, 0x86, 0xd0, 0xa7, 0x4e, 0xfc,
+	0x5d, 0xfd, 0xb3, 0x09, 0x99, 0xba, 0x37, 0x46, 0xdf, 0x81, 0x6c, 0x93, 0x0c, 0x06, 0x76, 0x64,
+	0x19, 0xeb, 0xc6, 0x46, 0xe1, 0xe9, 0xd7, 0x6a, 0x89, 0x37, 0x31, 0x19, 0x2b, 0xb6, 0x00, 0x76,
+	0xa8, 0xd7, 0xb5, 0x23, 0x6b, 0x71, 0x06, 0x18, 0x93, 0xb1, 0x62, 0x0b, 0xe0, 0x31, 0x19, 0x52,
+	0x3b, 0xb2, 0x32, 0x33, 0xc0, 0x98, 0x8c, 0x15, 0x1b, 0x3d, 0x82, 0x5c, 0x9b, 0x06, 0xc3, 0xd0,
+	0x8e, 0x2c, 0x53, 0x22, 0xcb, 0x29, 0x52, 0xd1, 0x71, 0x02, 0x40, 0xef, 0xc2, 0xd2, 0x3e, 0x7b,
+	0x61, 0x47, 0xd6, 0x92, 0x44, 0x96, 0x52, 0xa4, 0xa4, 0xe2, 0x98, 0x29, 0x4c, 0x37, 0x98, 0xf4,
+	0x31, 0x3b, 0x63, 0x3a, 0x26, 0x63, 0xc5, 0x46, 0x8f, 0x21, 0x7f, 0xe2, 0x9d, 0xc5, 0xd0, 0x9c,
+	0x84, 0xbe, 0x95, 0x42, 0x13, 0x06, 0x4e, 0x21, 0xc2, 0xd3, 0x06, 0xe1, 0x4e, 0xdf, 0x8e, 0xac,
+	0xfc, 0x8c, 0xa7, 0x8a, 0x8e, 0x13, 0x00, 0xfa, 0x10, 0xa0, 0x1d, 0x30, 0x9f, 0x85, 0x44, 0x04,
+	0x75, 0x59, 0xc2, 0xbf, 0x3e, 0xd9, 0x58, 0xca, 0xc2, 0x1a, 0x4c, 0x08, 0x1d, 0x76, 0xa9, 0xc7,
+	0xdd, 0x4f, 0xc6, 0x76, 0x64, 0xc1, 0x8c, 0xd0, 0x84, 0x85, 0x35, 0x18, 0xfa, 0x00, 0x96, 0xdb,
+	0x81, 0xfb, 0x82, 0x70, 0x11, 0xeb, 0x82, 0x94, 0x41, 0x9a, 0x21, 0xc5, 0xc1, 0x13, 0x10, 0xfa,
+	0x18, 0x0a, 0x07, 0x94, 0x04, 0xfc, 0x8c, 0x12, 0x6e, 0x47, 0x56, 0x51, 0xca, 0xbc, 0x9d, 0xca,
+	0x68, 0x3c, 0xac, 0x03, 0xb7, 0xcd, 0x8b, 0x57, 0x6b, 0x46, 0xf5, 0x77, 0x06, 0xe4, 0xec, 0xe8,
+	0xd0, 0xf3, 0x47, 0x1c, 0x1d, 0x43, 0xae, 0xde, 0xed, 0x06, 0x34, 0x0c, 0x65, 0xde, 0x14, 0x1b,
+	0xdf, 0xbb, 0xb8, 0x5c, 0x5b, 0xf8, 0xe7, 0xe5, 0xda, 0xa6, 0x96, 0xb4, 0xfd, 0xb1, 0x4f, 0x83,
+	0x01, 0xed, 0xf6, 0x68, 0xb0, 0x75, 0x36, 0x0a, 0x02, 0xf6, 0x72, 0xcb, 0x09, 0xc6, 0x3e, 0x67,
+	0x35, 0x25, 0x8b, 0x13, 0x25, 0xe8, 0x21, 0x64, 0xeb, 0x43, 0x36, 0xf2, 0xb8, 0xcc, 0x2e, 0x13,
+	0xab, 0x15, 0x5a, 0x85, 0x7c, 0x87, 0x7e, 0x3a, 0xa2, 0x9e, 0x43, 0x65, 0x3a, 0x99, 0x38, 0x5d,
+	0x6f, 0x9b, 0xbf, 0x7f, 0xb5, 0xb6, 0x50, 0x8d, 0x20, 0x6f, 0x47, 0xcf, 0x46, 0xfc, 0x2b, 0xf4,
+	0x4a, 0x59, 0xfe, 0xad, 0xa1, 0x1d, 0x00, 0x7a, 0x0f, 0x96, 0x64, 0x68, 0x2c, 0x63, 0x26, 0x43,
+	0x54, 0xc8, 0x70, 0xcc, 0x46, 0xcf, 0xa1, 0xd0, 0x8e, 0x39, 0x07, 0x24, 0xec, 0x4b, 0xc5, 0xc5,
+	0xc6, 0x47, 0xca, 0xcf, 0xc7, 0x37, 0xfb, 0x79, 0xe6, 0x7a, 0x24, 0x18, 0xd7, 0x0e, 0x68, 0xd4,
+	0x18, 0x73, 0x1a, 0x62, 0x5d, 0x93, 0x72, 0xea, 0x4f, 0x99, 0xe4, 0x42, 0xcf, 0xed, 0xd1, 0x4f,
+	0x27, 0x51, 0x8b, 0xbd, 0xf9, 0xe0, 0xee, 0x11, 0x5b, 0x85, 0xfc, 0x3e, 0x09, 0x8f, 0xdc, 0xa1,
+	0xcb, 0x93, 0xf3, 0x4a, 0xd6, 0xa8, 0x0c, 0x99, 0x3d, 0x4a, 0xe5, 0x5d, 0x37, 0xb1, 0xf8, 0x44,
+	0x87, 0x60, 0xee, 0x10, 0x4e, 0xac, 0xa5, 0xfb, 0x04, 0x41, 0xaa, 0x40, 0xbf, 0x04, 0xf3, 0x79,
+	0xbd, 0xd3, 0x92, 0x17, 0xbf, 0xd8, 0xd8, 0xbf, 0x93, 0xaa, 0xff, 0x5c, 0xae, 0x95, 0x38, 0xe9,
+	0x85, 0x9b, 0x6c, 0xe8, 0x72, 0x3a, 0xf4, 0xf9, 0x18, 0x4b, 0xa5, 0xe8, 0x87, 0x50, 0x6c, 0x32,
+	0x8f, 0x07, 0xc4, 0xe1, 0x2d, 0xca, 0x89, 0x95, 0x5b, 0xcf, 0x6c, 0x14, 0x9e, 0x3e, 0x98, 0x94,
+	0x4a, 0x8d, 0x89, 0xa7, 0xa0, 0x2a, 0x20, 0xed, 0xc0, 0x75, 0xa8, 0x95, 0x4f, 0x03, 0x22, 0xd7,
+	0xea, 0xc4, 0x46, 0xd3, 0xca, 0xd1, 0xcf, 0x20, 0xdf, 0x64, 0x5d, 0x2a, 0xb3, 0xc3, 0xb8, 0x4f,
+	0x60, 0x52, 0x35, 0x08, 0x81, 0x29, 0xfd, 0x16, 0xc7, 0xbb, 0x8c, 0xe5, 0x77, 0xd5, 0x4d, 0xea,
+	0x39, 0xda, 0x80, 0xac, 0x4c, 0x04, 0x71, 0x69, 0x32, 0xd7, 0x26, 0x8a, 0xe2, 0xa3, 0xef, 0x42,
+	0x2e, 0xbe, 0x69, 0x22, 0x53, 0x32, 0x53, 0x55, 0x33, 0xb9, 0x83, 0x38, 0x41, 0x6c, 0xe7, 0x7f,
+	0xf3, 0x6a, 0x6d, 0x41, 0xee, 0x90, 0xa5, 0x85, 0x7e, 0xee, 0x9c, 0xfc, 0x18, 0xf2, 0x42, 0xa4,
+	0x1e, 0xf4, 0x42, 0xd5, 0x6f, 0xde, 0xae, 0x69, 0xfd, 0x2d, 0xe1, 0x35, 0x4c, 0x11, 0x1a, 0x9c,
+	0x62, 0x55, 0x48, 0xfd, 0xa4, 0x05, 0xcd, 0x6d, 0x0f, 0x81, 0x29, 0x24, 0x92, 0x08, 0x89, 0x6f,
+	0x41, 0x93, 0xd9, 0x99, 0x89, 0x69, 0xe2, 0xfb, 0x6a, 0x0e, 0x2b, 0x8b, 0xdb, 0x49, 0xe7, 0x99,
+	0xd7, 0xa2, 0x16, 0x9e, 0xde, 0xa4, 0x19, 0xcd, 0xed, 0xef, 0xfb, 0x90, 0x8d, 0xe3, 0xac, 0xa2,
+	0x73, 0xcd, 0x41, 0x28, 0x80, 0x66, 0xe8, 0x8f, 0x8b, 0xaa, 0x8b, 0xde, 0xe2, 0xc8, 0x9b, 0x50,
+	0xaa, 0x3b, 0x8e, 0xa8, 0x7a, 0x27, 0x7e, 0x97, 0x70, 0x9a, 0x9c, 0xfc, 0x83, 0x9a, 0x1c, 0x26,
+	0x6c, 0x3a, 0xf4, 0x07, 0x84, 0x53, 0x85, 0x91, 0xe7, 0x61, 0xe0, 0x19, 0x11, 0xf4, 0x08, 0xca,
+	0x75, 0x87, 0x8b, 0x4a, 0xe9, 0x32, 0xef, 0x80, 0xba, 0xbd, 0x7e, 0x52, 0x1d, 0xae, 0xd0, 0xd1,
+	0x26, 0x64, 0xdb, 0x24, 0x20, 0xc3, 0xd0, 0x32, 0x67, 0xda, 0x53, 0xb3, 0x4f, 0x5c, 0x2f, 0xe6,
+	0x61, 0x85, 0x41, 0x4f, 0x20, 0x7b, 0x4a, 0x39, 0xa3, 0xa1, 0xb5, 0x24, 0xdd, 0x7a, 0x67, 0x32,
+	0x95, 0x38, 0x7d, 0xda, 0x1d, 0x0d, 0x68, 0x57, 0xee, 0xf8, 0x70, 0x07, 0x2b, 0x20, 0xaa, 0x00,
+	0xc4, 0xc2, 0x2d, 0x12, 0x9e, 0x5b, 0xd9, 0xf5, 0xcc, 0xc6, 0x32, 0xd6, 0x28, 0x5a, 0xbc, 0xc6,
+	0x50, 0x9e, 0xd5, 0x22, 0x5a, 0x82, 0xda, 0x80, 0x11, 0xb7, 0x04, 0xe5, 0x76, 0x0b, 0xb2, 0x76,
+	0x74, 0xff, 0x8a, 0xae, 0x94, 0x54, 0xff, 0xbe, 0x04, 0x05, 0x6d, 0xbf, 0xe8, 0x5d, 0x58, 0x69,
+	0x0c, 0x98, 0x73, 0x9e, 0x16, 0xd7, 0xd8, 0xfa, 0x34, 0x11, 0x6d, 0xc2, 0x5b, 0x2d, 0x12, 0x89,
+	0x23, 0x0c, 0x79, 0x30, 0x72, 0x44, 0x54, 0x43, 0xd5, 0xba, 0xae, 0x32, 0xd0, 0x7b, 0x50, 0x6a,
+	0x91, 0xe8, 0x88, 0xf5, 0x44, 0x66, 0x77, 0xdc, 0xcf, 0x92, 0x0e, 0x3b, 0x43, 0x45, 0xdf, 0x84,
+	0x65, 0x29, 0x7c, 0xc4, 0x7a, 0xa1, 0xca, 0xfc, 0x09, 0x01, 0xfd, 0x00, 0xbe, 0xd1, 0x22, 0xd1,
+	0x29, 0x19, 0xb8, 0x5d, 0xc2, 0x59, 0xd0, 0x66, 0x2f, 0x69, 0xd0, 0xec, 0x13, 0xaf, 0x47, 0x65,
+	0x59, 0x37, 0xf1, 0x9b, 0xd8, 0xe8, 0x47, 0xf0, 0xce, 0x75, 0xf4, 0x1d, 0x3a, 0x20, 0x63, 0x59,
+	0xc7, 0x4d, 0xfc, 0x66, 0x80, 0x38, 0x88, 0x96, 0xeb, 0x89, 0xcb, 0x98, 0x8b, 0x0f, 0x22, 0x5e,
+	0xa1, 0x1f, 0x43, 0x69, 0x8f, 0xd2, 0xdd, 0x48, 0xd4, 0x6f, 0xd1, 0x08, 0x43, 0x2b, 0x2f, 0x33,
+	0xe3, 0x61, 0x9a, 0x19, 0x53, 0x6c, 0x3c, 0x83, 0x16, 0xb9, 0xda, 0xa1, 0x3e, 0x09, 0x08, 0xa7,
+	0xfb, 0x24, 0xb4, 0xd9, 0x39, 0xf5, 0xe4, 0x14, 0x97, 0xc7, 0x57, 0xe8, 0x22, 0x95, 0x9a, 0xc4,
+	0x17, 0x72, 0xfb, 0x24, 0x94, 0x63, 0x5b, 0x1e, 0x6b, 0x14, 0x74, 0x0e, 0x0f, 0x8e, 0xe9, 0x4b,
+	0x75, 0x19, 0xda, 0x69, 0xf9, 0x0a, 0xe5, 0xb4, 0x66, 0x36, 0x3e, 0xfa, 0xef, 0xe5, 0xda, 0x93,
+	0x9b, 0xf3, 0x63, 0xa6, 0xe6, 0xed, 0x0d, 0x48, 0x0f, 0x5f, 0xaf, 0x13, 0x7d, 0x0a, 0x56, 0x8b,
+	0x44, 0xd7, 0xdb, 0x2b, 0xde, 0xc7, 0xde, 0x1b, 0xd5, 0xa2, 0x75, 0x28, 0xec, 0x93, 0x30, 0xb9,
+	0x23, 0xd6, 0x8a, 0x2c, 0x94, 0x3a, 0xa9, 0xfa, 0x3f, 0x03, 0x56, 0xa6, 0x02, 0x8c, 0x8e, 0xe2,
+	0xf9, 0x84, 0x06, 0xf7, 0x1a, 0xd1, 0x94, 0x8e, 0x54, 0x1b, 0xb5, 0x16, 0xef, 0xad, 0x8d, 0x8a,
+	0xd6, 0xdb, 0xa1, 0x03, 0xea, 0x70, 0x16, 0x58, 0x99, 0xfb, 0x5c, 0xe3, 0x54, 0x4d, 0xf5, 0xaf,
+	0x06, 0x94, 0xa6, 0x8b, 0xc8, 0x57, 0x54, 0x42, 0x26, 0x4f, 0xa6, 0xcc, 0x4d, 0x4f, 0xa6, 0x0a,
+	0x80, 0xed, 0x0e, 0xe9, 0x11, 0x73, 0xce, 0x69, 0x57, 0xde, 0xee, 0x3c, 0xd6, 0x28, 0xd5, 0x7f,
+	0x1b, 0xfa, 0x7b, 0x66, 0xee, 0xfe, 0x54, 0x85, 0xe2, 0x29, 0xe3, 0xae, 0xd7, 0x7b, 0x1e, 0xef,
+	0x54, 0xec, 0x28, 0x83, 0xa7, 0x68, 0xe8, 0x04, 0x8a, 0x89, 0x66, 0xb9, 0xeb, 0x38, 0xe2, 0x4f,
+	0x6e, 0xbf, 0xe3, 0x29, 0x35, 0xe2, 0x6d, 0x97, 0xac, 0x2d, 0x73, 0xa6, 0x39, 0x26, 0x0c, 0x9c,
+	0x42, 0xb4, 0x72, 0x3f, 0xd0, 0x1f, 0x61, 0xb7, 0x68, 0x91, 0x8f, 0xc0, 0x3c, 0x66, 0x5d, 0xaa,
+	0x3a, 0xf1, 0xc3, 0x5a, 0xfa, 0xea, 0x16, 0xd4, 0x58, 0xa3, 0x98, 0x24, 0xc5, 0x4a, 0xb3, 0xf6,
+	0x07, 0x63, 0xea, 0x31, 0x36, 0x77, 0x64, 0x27, 0xd9, 0xb3, 0x38, 0x95, 0x3d, 0x1d, 0x58, 0x96,
+	0xcd, 0x40, 0x0b, 0xe5, 0x1d, 0x13, 0x68, 0xa2, 0x47, 0x0d, 0x37, 0xbf, 0x4a, 0x9f, 0xbf, 0xb7,
+	0x88, 0x4a, 0x05, 0x32, 0x76, 0x94, 0x4c, 0x0b, 0xc5, 0x14, 0x56, 0xf7, 0xc6, 0x58, 0x30, 0xb4,
+	0x48, 0xfc, 0xda, 0x00, 0xf3, 0x94, 0x71, 0xfa, 0xa5, 0x3f, 0xdf, 0xe6, 0x48, 0x42, 0xcd, 0x8d,
+	0x17, 0x93, 0xbc, 0x49, 0xc7, 0x41, 0x43, 0x1b, 0x07, 0xd7, 0xa1, 0xb0, 0x43, 0x43, 0x27, 0x70,
+	0x7d, 0xd1, 0x3e, 0xd5, 0xa4, 0xa8, 0x93, 0xf4, 0xdf, 0x04, 0x99, 0x2f, 0xf8, 0x4d, 0xa0, 0xd9,
+	0xfd, 0xcb, 0x22, 0x64, 0x1b, 0x64, 0x30, 0x60, 0x7c, 0x2a, 0x75, 0x8d, 0x2f, 0x4c, 0x5d, 0x71,
+	0x81, 0xf6, 0x5c, 0x8f, 0x0c, 0xdc, 0xcf, 0x5c, 0xaf, 0xa7, 0x7e, 0xcc, 0xdc, 0xed, 0x02, 0xe9,
+	0x6a, 0x50, 0x13, 0x56, 0x7c, 0x65, 0xa2, 0xc3, 0x09, 0x8f, 0xa7, 0xdd, 0xd2, 0xd3, 0x6f, 0x69,
+	0x9b, 0x11, 0xde, 0xd6, 0xda, 0x3a, 0x08, 0x4f, 0xcb, 0xa0, 0x6f, 0xc3, 0x92, 0x38, 0xd3, 0x64,
+	0x2e, 0x5b, 0x49, 0x85, 0x05, 0x15, 0xc7, 0xbc, 0xea, 0xf7, 0x61, 0x65, 0x4a, 0x09, 0x2a, 0x42,
+	0xbe, 0x8d, 0x9f, 0xb5, 0x9f, 0x75, 0x76, 0x77, 0xca, 0x0b, 0x62, 0xb5, 0xfb, 0xf3, 0xdd, 0xe6,
+	0x89, 0xbd, 0xbb, 0x53, 0x36, 0x10, 0x40, 0x76, 0xaf, 0x7e, 0x78, 0xb4, 0xbb, 0x53, 0x5e, 0x6c,
+	0xfc, 0xe4, 0xe2, 0x75, 0xc5, 0xf8, 0xc7, 0xeb, 0x8a, 0xf1, 0xaf, 0xd7, 0x15, 0xe3, 0x6f, 0x9f,
+	0x57, 0x8c, 0x8b, 0xcf, 0x2b, 0xc6, 0x2f, 0xde, 0xbf, 0x79, 0xd7, 0x3c, 0x0a, 0xb7, 0x94, 0x17,
+	0x67, 0x59, 0xf9, 0x17, 0xec, 0xc3, 0xff, 0x0f, 0x00, 0xdc, 0x7a, 0x1c, 0x18, 0x7c, 0x13, 0x00,
+	0x00,
+}
+
+func (m *Any) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Any) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Any) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeartbeatTx != nil {
+		{
+			size, err := m.HeartbeatTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.PrivateTx != nil {
+		{
+			size, err := m.PrivateTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.IdentifyTx != nil {
+		{
+			size, err := m.IdentifyTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x52
@@ -1411,7 +1972,7 @@ func (m *TxOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CallTx) Marshal() (dAtA []byte, err error) {
+func (m *PrivateTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1421,12 +1982,12 @@ func (m *CallTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrivateTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrivateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1435,41 +1996,90 @@ func (m *CallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GasPrice != 0 {
-		i = encodeVarintPayload(dAtA, i, uint64(m.GasPrice))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.ContractMeta) > 0 {
-		for iNdEx := len(m.ContractMeta) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractMeta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPayload(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
 	{
-		size := m.WASM.Size()
+		size := m.PayloadHash.Size()
 		i -= size
-		if _, err := m.WASM.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PayloadHash.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintPayload(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Data.Size()
-		i -= size
-		if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
+	dAtA[i] = 0x12
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ContractMeta) > 0 {
+		for iNdEx := len(m.ContractMeta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractMeta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.WASM.Size()
+		i -= size
+		if _, err := m.WASM.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Data.Size()
+		i -= size
+		if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintPayload(dAtA, i, uint64(size))
 	}
 	i--
@@ -1831,6 +2441,46 @@ func (m *GovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ParamsMask) > 0 {
+		for iNdEx := len(m.ParamsMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ParamsMask[iNdEx])
+			copy(dAtA[i:], m.ParamsMask[iNdEx])
+			i = encodeVarintPayload(dAtA, i, uint64(len(m.ParamsMask[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Vetoes) > 0 {
+		for iNdEx := len(m.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vetoes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AccountUpdates) > 0 {
 		for iNdEx := len(m.AccountUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1862,7 +2512,7 @@ func (m *GovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProposalTx) Marshal() (dAtA []byte, err error) {
+func (m *ScheduledGovTxID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1872,12 +2522,12 @@ func (m *ProposalTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduledGovTxID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduledGovTxID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1886,51 +2536,25 @@ func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPayload(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ProposalHash != nil {
-		{
-			size := m.ProposalHash.Size()
-			i -= size
-			if _, err := m.ProposalHash.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintPayload(dAtA, i, uint64(size))
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.VotingWeight != 0 {
-		i = encodeVarintPayload(dAtA, i, uint64(m.VotingWeight))
-		i--
-		dAtA[i] = 0x10
+		i = encodeVarintPayload(dAtA, i, uint64(size))
 	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPayload(dAtA, i, uint64(size))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IdentifyTx) Marshal() (dAtA []byte, err error) {
+func (m *ChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1940,12 +2564,12 @@ func (m *IdentifyTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IdentifyTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IdentifyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1954,22 +2578,47 @@ func (m *IdentifyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Node != nil {
-		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPayload(dAtA, i, uint64(size))
+	if len(m.GasSchedule) > 0 {
+		i -= len(m.GasSchedule)
+		copy(dAtA[i:], m.GasSchedule)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.GasSchedule)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxNewAccountPermissions != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.MaxNewAccountPermissions))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NewAccountPermissions != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.NewAccountPermissions))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CapCallGas {
+		i--
+		if m.CapCallGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x50
 	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+	if m.SeparateGasToken {
+		i--
+		if m.SeparateGasToken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.FeeExemptCalls) > 0 {
+		for iNdEx := len(m.FeeExemptCalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FeeExemptCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1977,13 +2626,48 @@ func (m *IdentifyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPayload(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x42
 		}
 	}
+	if m.MinFee != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.MinFee))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ValidatorPowerChangeDelay != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.ValidatorPowerChangeDelay))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxValidatorPowerChange != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.MaxValidatorPowerChange))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTxLogs != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.MaxTxLogs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxLogDataSize != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.MaxLogDataSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxInstructions != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.MaxTxInstructions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+func (m *FeeExemptCall) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1993,12 +2677,12 @@ func (m *BatchTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeExemptCall) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeExemptCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2007,38 +2691,40 @@ func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPayload(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.Selector.Size()
+		i -= size
+		if _, err := m.Selector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
 	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPayload(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Callee.Size()
+		i -= size
+		if _, err := m.Callee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Caller.Size()
+		i -= size
+		if _, err := m.Caller.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
+func (m *ScheduledGovTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2048,12 +2734,12 @@ func (m *Vote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduledGovTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduledGovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2062,25 +2748,47 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.VotingWeight != 0 {
-		i = encodeVarintPayload(dAtA, i, uint64(m.VotingWeight))
+	if m.TimeLocked {
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.Address.Size()
-		i -= size
-		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+		if m.TimeLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintPayload(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if m.GovTx != nil {
+		{
+			size, err := m.GovTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *ProposalTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2090,12 +2798,12 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2104,9 +2812,9 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.BatchTx != nil {
+	if m.Proposal != nil {
 		{
-			size, err := m.BatchTx.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2114,26 +2822,41 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPayload(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProposalHash != nil {
+		{
+			size := m.ProposalHash.Size()
+			i -= size
+			if _, err := m.ProposalHash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Description)))
+	if m.VotingWeight != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.VotingWeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Name)))
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Ballot) Marshal() (dAtA []byte, err error) {
+func (m *IdentifyTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2143,12 +2866,12 @@ func (m *Ballot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Ballot) MarshalTo(dAtA []byte) (int, error) {
+func (m *IdentifyTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IdentifyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2157,10 +2880,22 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2168,29 +2903,54 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPayload(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ProposalState != 0 {
-		i = encodeVarintPayload(dAtA, i, uint64(m.ProposalState))
-		i--
-		dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+
+func (m *HeartbeatTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.FinalizingTx != nil {
-		{
-			size := m.FinalizingTx.Size()
-			i -= size
-			if _, err := m.FinalizingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintPayload(dAtA, i, uint64(size))
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.BlockHash.Size()
+		i -= size
+		if _, err := m.BlockHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Proposal != nil {
+	if m.Input != nil {
 		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2203,138 +2963,290 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPayload(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Any) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CallTx != nil {
-		l = m.CallTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.SendTx != nil {
-		l = m.SendTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.NameTx != nil {
-		l = m.NameTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.PermsTx != nil {
-		l = m.PermsTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.GovTx != nil {
-		l = m.GovTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.BondTx != nil {
-		l = m.BondTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.UnbondTx != nil {
-		l = m.UnbondTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.BatchTx != nil {
-		l = m.BatchTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.ProposalTx != nil {
-		l = m.ProposalTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.IdentifyTx != nil {
-		l = m.IdentifyTx.Size()
-		n += 1 + l + sovPayload(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovPayload(uint64(l))
-	if m.Amount != 0 {
-		n += 1 + sovPayload(uint64(m.Amount))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovPayload(uint64(m.Sequence))
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TxOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovPayload(uint64(l))
-	if m.Amount != 0 {
-		n += 1 + sovPayload(uint64(m.Amount))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.VotingWeight != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.VotingWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPayload(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.BatchTx != nil {
+		{
+			size, err := m.BatchTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CallTx) Size() (n int) {
+func (m *Ballot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ballot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ProposalState != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.ProposalState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FinalizingTx != nil {
+		{
+			size := m.FinalizingTx.Size()
+			i -= size
+			if _, err := m.FinalizingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPayload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Any) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Input != nil {
-		l = m.Input.Size()
+	if m.CallTx != nil {
+		l = m.CallTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
-	if m.Address != nil {
-		l = m.Address.Size()
+	if m.SendTx != nil {
+		l = m.SendTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovPayload(uint64(m.GasLimit))
+	if m.NameTx != nil {
+		l = m.NameTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
 	}
-	if m.Fee != 0 {
-		n += 1 + sovPayload(uint64(m.Fee))
+	if m.PermsTx != nil {
+		l = m.PermsTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
 	}
-	l = m.Data.Size()
-	n += 1 + l + sovPayload(uint64(l))
-	l = m.WASM.Size()
-	n += 1 + l + sovPayload(uint64(l))
-	if len(m.ContractMeta) > 0 {
-		for _, e := range m.ContractMeta {
-			l = e.Size()
-			n += 1 + l + sovPayload(uint64(l))
-		}
+	if m.GovTx != nil {
+		l = m.GovTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
 	}
-	if m.GasPrice != 0 {
-		n += 1 + sovPayload(uint64(m.GasPrice))
+	if m.BondTx != nil {
+		l = m.BondTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.UnbondTx != nil {
+		l = m.UnbondTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.BatchTx != nil {
+		l = m.BatchTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.ProposalTx != nil {
+		l = m.ProposalTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.IdentifyTx != nil {
+		l = m.IdentifyTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.PrivateTx != nil {
+		l = m.PrivateTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.HeartbeatTx != nil {
+		l = m.HeartbeatTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -2342,17 +3254,19 @@ func (m *CallTx) Size() (n int) {
 	return n
 }
 
-func (m *ContractMeta) Size() (n int) {
+func (m *TxInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CodeHash.Size()
+	l = m.Address.Size()
 	n += 1 + l + sovPayload(uint64(l))
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovPayload(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovPayload(uint64(m.Amount))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPayload(uint64(m.Sequence))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -2360,17 +3274,109 @@ func (m *ContractMeta) Size() (n int) {
 	return n
 }
 
-func (m *SendTx) Size() (n int) {
+func (m *TxOutput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovPayload(uint64(l))
-		}
+	l = m.Address.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovPayload(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrivateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	l = m.PayloadHash.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovPayload(uint64(m.GasLimit))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovPayload(uint64(m.Fee))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	l = m.WASM.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if len(m.ContractMeta) > 0 {
+		for _, e := range m.ContractMeta {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovPayload(uint64(m.GasPrice))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContractMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeHash.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SendTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
 	}
 	if len(m.Outputs) > 0 {
 		for _, e := range m.Outputs {
@@ -2483,6 +3489,139 @@ func (m *GovTx) Size() (n int) {
 			n += 1 + l + sovPayload(uint64(l))
 		}
 	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovPayload(uint64(m.ActivationHeight))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if len(m.Vetoes) > 0 {
+		for _, e := range m.Vetoes {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.ParamsMask) > 0 {
+		for _, s := range m.ParamsMask {
+			l = len(s)
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduledGovTxID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPayload(uint64(m.Height))
+	}
+	l = m.TxHash.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovPayload(uint64(m.BlockGasLimit))
+	}
+	if m.MaxTxInstructions != 0 {
+		n += 1 + sovPayload(uint64(m.MaxTxInstructions))
+	}
+	if m.MaxLogDataSize != 0 {
+		n += 1 + sovPayload(uint64(m.MaxLogDataSize))
+	}
+	if m.MaxTxLogs != 0 {
+		n += 1 + sovPayload(uint64(m.MaxTxLogs))
+	}
+	if m.MaxValidatorPowerChange != 0 {
+		n += 1 + sovPayload(uint64(m.MaxValidatorPowerChange))
+	}
+	if m.ValidatorPowerChangeDelay != 0 {
+		n += 1 + sovPayload(uint64(m.ValidatorPowerChangeDelay))
+	}
+	if m.MinFee != 0 {
+		n += 1 + sovPayload(uint64(m.MinFee))
+	}
+	if len(m.FeeExemptCalls) > 0 {
+		for _, e := range m.FeeExemptCalls {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
+	if m.SeparateGasToken {
+		n += 2
+	}
+	if m.CapCallGas {
+		n += 2
+	}
+	if m.NewAccountPermissions != 0 {
+		n += 1 + sovPayload(uint64(m.NewAccountPermissions))
+	}
+	if m.MaxNewAccountPermissions != 0 {
+		n += 1 + sovPayload(uint64(m.MaxNewAccountPermissions))
+	}
+	l = len(m.GasSchedule)
+	if l > 0 {
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FeeExemptCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Caller.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	l = m.Callee.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	l = m.Selector.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduledGovTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPayload(uint64(m.Height))
+	}
+	l = m.TxHash.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.GovTx != nil {
+		l = m.GovTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.TimeLocked {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2538,6 +3677,27 @@ func (m *IdentifyTx) Size() (n int) {
 	return n
 }
 
+func (m *HeartbeatTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPayload(uint64(m.Height))
+	}
+	l = m.BlockHash.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *BatchTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2669,37 +3829,764 @@ func (this *Any) GetValue() interface{} {
 	if this.IdentifyTx != nil {
 		return this.IdentifyTx
 	}
-	return nil
-}
+	if this.PrivateTx != nil {
+		return this.PrivateTx
+	}
+	if this.HeartbeatTx != nil {
+		return this.HeartbeatTx
+	}
+	return nil
+}
+
+func (this *Any) SetValue(value interface{}) bool {
+	switch vt := value.(type) {
+	case *CallTx:
+		this.CallTx = vt
+	case *SendTx:
+		this.SendTx = vt
+	case *NameTx:
+		this.NameTx = vt
+	case *PermsTx:
+		this.PermsTx = vt
+	case *GovTx:
+		this.GovTx = vt
+	case *BondTx:
+		this.BondTx = vt
+	case *UnbondTx:
+		this.UnbondTx = vt
+	case *BatchTx:
+		this.BatchTx = vt
+	case *ProposalTx:
+		this.ProposalTx = vt
+	case *IdentifyTx:
+		this.IdentifyTx = vt
+	case *PrivateTx:
+		this.PrivateTx = vt
+	case *HeartbeatTx:
+		this.HeartbeatTx = vt
+	default:
+		return false
+	}
+	return true
+}
+func (m *Any) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Any: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Any: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallTx == nil {
+				m.CallTx = &CallTx{}
+			}
+			if err := m.CallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SendTx == nil {
+				m.SendTx = &SendTx{}
+			}
+			if err := m.SendTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NameTx == nil {
+				m.NameTx = &NameTx{}
+			}
+			if err := m.NameTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermsTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PermsTx == nil {
+				m.PermsTx = &PermsTx{}
+			}
+			if err := m.PermsTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GovTx == nil {
+				m.GovTx = &GovTx{}
+			}
+			if err := m.GovTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BondTx == nil {
+				m.BondTx = &BondTx{}
+			}
+			if err := m.BondTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnbondTx == nil {
+				m.UnbondTx = &UnbondTx{}
+			}
+			if err := m.UnbondTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchTx == nil {
+				m.BatchTx = &BatchTx{}
+			}
+			if err := m.BatchTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalTx == nil {
+				m.ProposalTx = &ProposalTx{}
+			}
+			if err := m.ProposalTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentifyTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdentifyTx == nil {
+				m.IdentifyTx = &IdentifyTx{}
+			}
+			if err := m.IdentifyTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrivateTx == nil {
+				m.PrivateTx = &PrivateTx{}
+			}
+			if err := m.PrivateTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTx == nil {
+				m.HeartbeatTx = &HeartbeatTx{}
+			}
+			if err := m.HeartbeatTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (this *Any) SetValue(value interface{}) bool {
-	switch vt := value.(type) {
-	case *CallTx:
-		this.CallTx = vt
-	case *SendTx:
-		this.SendTx = vt
-	case *NameTx:
-		this.NameTx = vt
-	case *PermsTx:
-		this.PermsTx = vt
-	case *GovTx:
-		this.GovTx = vt
-	case *BondTx:
-		this.BondTx = vt
-	case *UnbondTx:
-		this.UnbondTx = vt
-	case *BatchTx:
-		this.BatchTx = vt
-	case *ProposalTx:
-		this.ProposalTx = vt
-	case *IdentifyTx:
-		this.IdentifyTx = vt
-	default:
-		return false
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return true
+	return nil
 }
-func (m *Any) Unmarshal(dAtA []byte) error {
+func (m *PrivateTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2722,15 +4609,15 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Any: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrivateTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Any: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrivateTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2757,18 +4644,18 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CallTx == nil {
-				m.CallTx = &CallTx{}
+			if m.Input == nil {
+				m.Input = &TxInput{}
 			}
-			if err := m.CallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -2778,67 +4665,82 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SendTx == nil {
-				m.SendTx = &SendTx{}
-			}
-			if err := m.SendTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PayloadHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameTx", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NameTx == nil {
-				m.NameTx = &NameTx{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
 			}
-			if err := m.NameTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermsTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2865,18 +4767,18 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PermsTx == nil {
-				m.PermsTx = &PermsTx{}
+			if m.Input == nil {
+				m.Input = &TxInput{}
 			}
-			if err := m.PermsTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -2886,33 +4788,32 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GovTx == nil {
-				m.GovTx = &GovTx{}
-			}
-			if err := m.GovTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondTx", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var msglen int
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -2922,33 +4823,16 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BondTx == nil {
-				m.BondTx = &BondTx{}
-			}
-			if err := m.BondTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondTx", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var msglen int
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -2958,33 +4842,16 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UnbondTx == nil {
-				m.UnbondTx = &UnbondTx{}
-			}
-			if err := m.UnbondTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -2994,33 +4861,30 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BatchTx == nil {
-				m.BatchTx = &BatchTx{}
-			}
-			if err := m.BatchTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WASM", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3030,31 +4894,28 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProposalTx == nil {
-				m.ProposalTx = &ProposalTx{}
-			}
-			if err := m.ProposalTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WASM.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentifyTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3081,13 +4942,30 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IdentifyTx == nil {
-				m.IdentifyTx = &IdentifyTx{}
-			}
-			if err := m.IdentifyTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractMeta = append(m.ContractMeta, &ContractMeta{})
+			if err := m.ContractMeta[len(m.ContractMeta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3113,7 +4991,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxInput) Unmarshal(dAtA []byte) error {
+func (m *ContractMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,15 +5014,15 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3171,15 +5049,15 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CodeHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3189,30 +5067,24 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3238,7 +5110,7 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxOutput) Unmarshal(dAtA []byte) error {
+func (m *SendTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3261,17 +5133,17 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3281,30 +5153,31 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, &TxInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3314,11 +5187,26 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, &TxOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3344,7 +5232,7 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallTx) Unmarshal(dAtA []byte) error {
+func (m *PermsTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3367,10 +5255,10 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermsTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermsTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3411,9 +5299,9 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3423,32 +5311,84 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_hyperledger_burrow_crypto.Address
-			m.Address = &v
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GasLimit = 0
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3458,16 +5398,33 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
 			}
-			m.Fee = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3477,16 +5434,29 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Fee |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3496,30 +5466,29 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASM", wireType)
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var byteLen int
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3529,28 +5498,68 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WASM.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3577,30 +5586,13 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractMeta = append(m.ContractMeta, &ContractMeta{})
-			if err := m.ContractMeta[len(m.ContractMeta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
-			}
-			m.GasPrice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasPrice |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3626,7 +5618,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractMeta) Unmarshal(dAtA []byte) error {
+func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3649,17 +5641,17 @@ func (m *ContractMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3669,30 +5661,33 @@ func (m *ContractMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3702,23 +5697,27 @@ func (m *ContractMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			if m.Output == nil {
+				m.Output = &TxOutput{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3745,7 +5744,7 @@ func (m *ContractMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendTx) Unmarshal(dAtA []byte) error {
+func (m *GovTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3768,10 +5767,10 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3810,7 +5809,7 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3837,11 +5836,132 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, &TxOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountUpdates = append(m.AccountUpdates, &spec.TemplateAccount{})
+			if err := m.AccountUpdates[len(m.AccountUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &ChainParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoes = append(m.Vetoes, &ScheduledGovTxID{})
+			if err := m.Vetoes[len(m.Vetoes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsMask = append(m.ParamsMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3867,7 +5987,7 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermsTx) Unmarshal(dAtA []byte) error {
+func (m *ScheduledGovTxID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3890,17 +6010,17 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermsTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledGovTxID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermsTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledGovTxID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3910,33 +6030,16 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &TxInput{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -3946,22 +6049,22 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3990,7 +6093,7 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameTx) Unmarshal(dAtA []byte) error {
+func (m *ChainParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4013,17 +6116,17 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
 			}
-			var msglen int
+			m.BlockGasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4033,33 +6136,73 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxInstructions", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
+			m.MaxTxInstructions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxInstructions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLogDataSize", wireType)
 			}
-			if m.Input == nil {
-				m.Input = &TxInput{}
+			m.MaxLogDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLogDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxLogs", wireType)
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.MaxTxLogs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxLogs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerChange", wireType)
+			}
+			m.MaxValidatorPowerChange = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4069,29 +6212,54 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxValidatorPowerChange |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPayload
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPowerChangeDelay", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
+			m.ValidatorPowerChangeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPowerChangeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.MinFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptCalls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4101,29 +6269,31 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.FeeExemptCalls = append(m.FeeExemptCalls, &FeeExemptCall{})
+			if err := m.FeeExemptCalls[len(m.FeeExemptCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeparateGasToken", wireType)
 			}
-			m.Fee = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4133,70 +6303,75 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Fee |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
+			m.SeparateGasToken = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapCallGas", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.CapCallGas = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAccountPermissions", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BondTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
+			m.NewAccountPermissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewAccountPermissions |= github_com_hyperledger_burrow_permission.PermFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNewAccountPermissions", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxNewAccountPermissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNewAccountPermissions |= github_com_hyperledger_burrow_permission.PermFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BondTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BondTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4206,27 +6381,23 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &TxInput{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GasSchedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4253,7 +6424,7 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondTx) Unmarshal(dAtA []byte) error {
+func (m *FeeExemptCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4276,17 +6447,17 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeExemptCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeExemptCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4296,33 +6467,30 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &TxInput{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4332,25 +6500,55 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &TxOutput{}
+			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4379,7 +6577,7 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovTx) Unmarshal(dAtA []byte) error {
+func (m *ScheduledGovTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4402,17 +6600,36 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledGovTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledGovTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -4422,29 +6639,28 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, &TxInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4471,11 +6687,33 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountUpdates = append(m.AccountUpdates, &spec.TemplateAccount{})
-			if err := m.AccountUpdates[len(m.AccountUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GovTx == nil {
+				m.GovTx = &GovTx{}
+			}
+			if err := m.GovTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -4805,6 +7043,148 @@ func (m *IdentifyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeartbeatTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BatchTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
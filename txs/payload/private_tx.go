@@ -0,0 +1,35 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewPrivateTx(address crypto.Address, payloadHash binary.HexBytes) *PrivateTx {
+	return &PrivateTx{
+		Input: &TxInput{
+			Address: address,
+		},
+		PayloadHash: payloadHash,
+	}
+}
+
+func (tx *PrivateTx) Type() Type {
+	return TypePrivate
+}
+
+func (tx *PrivateTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *PrivateTx) String() string {
+	return fmt.Sprintf("PrivateTx{%v -> %v}", tx.Input, tx.PayloadHash)
+}
+
+func (tx *PrivateTx) Any() *Any {
+	return &Any{
+		PrivateTx: tx,
+	}
+}
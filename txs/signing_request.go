@@ -0,0 +1,114 @@
+package txs
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Creates a SigningRequest for txEnv (which may already carry some signatures) that expires at expiry
+func NewSigningRequest(txEnv *Envelope, expiry time.Time) (*SigningRequest, error) {
+	if txEnv == nil || txEnv.Tx == nil {
+		return nil, fmt.Errorf("cannot create SigningRequest from envelope with no transaction")
+	}
+	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	if err != nil {
+		return nil, err
+	}
+	return &SigningRequest{
+		Summary:   Summarise(txEnv.Tx),
+		SignBytes: signBytes,
+		TxHash:    txEnv.Tx.Hash(),
+		Expiry:    expiry.UTC(),
+		Envelope:  txEnv,
+	}, nil
+}
+
+// Returns a human-readable, single line, summary of tx for approvers
+func Summarise(tx *Tx) string {
+	inputs := make([]string, len(tx.GetInputs()))
+	for i, in := range tx.GetInputs() {
+		inputs[i] = fmt.Sprintf("%v (amount %d, sequence %d)", in.Address, in.Amount, in.Sequence)
+	}
+	return fmt.Sprintf("%v on chain %s from %s: %v", tx.Type(), tx.ChainID, strings.Join(inputs, ", "),
+		tx.Payload)
+}
+
+// Checks that the request has not expired as of now and that its SignBytes and TxHash match its Envelope
+func (sr *SigningRequest) Validate(now time.Time) error {
+	if sr.Envelope == nil || sr.Envelope.Tx == nil {
+		return fmt.Errorf("SigningRequest has no transaction")
+	}
+	if !sr.Expiry.IsZero() && now.After(sr.Expiry) {
+		return fmt.Errorf("SigningRequest for transaction %v expired at %v", sr.TxHash, sr.Expiry)
+	}
+	signBytes, err := sr.Envelope.Tx.SignBytes(sr.Envelope.GetEncoding())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signBytes, sr.SignBytes) {
+		return fmt.Errorf("SignBytes of SigningRequest do not match its transaction")
+	}
+	if !bytes.Equal(sr.Envelope.Tx.Hash(), sr.TxHash) {
+		return fmt.Errorf("TxHash %v of SigningRequest does not match hash of its transaction %v", sr.TxHash,
+			sr.Envelope.Tx.Hash())
+	}
+	return nil
+}
+
+// Whether every input of the transaction has been signed
+func (sr *SigningRequest) Complete() bool {
+	return len(sr.Envelope.Signatories) == len(sr.Envelope.Tx.GetInputs())
+}
+
+// Verifies sig against the Envelope's SignBytes and adds it to the Signatories in the position of the input it signs
+// (replacing any existing signature for that input) so that signatures may be returned by approvers in any order.
+func (txEnv *Envelope) AttachSignatory(sig Signatory) error {
+	err := sig.Validate()
+	if err != nil {
+		return fmt.Errorf("Signatory is invalid: %v", err)
+	}
+	if sig.PublicKey.GetAddress() != *sig.Address {
+		return fmt.Errorf("Signatory has address %v but public key with address %v", *sig.Address,
+			sig.PublicKey.GetAddress())
+	}
+	if sig.Signature == nil {
+		return fmt.Errorf("Signatory %v has nil Signature", *sig.Address)
+	}
+	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	if err != nil {
+		return err
+	}
+	err = sig.PublicKey.Verify(signBytes, sig.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature from %v: %v", *sig.Address, err)
+	}
+	inputs := txEnv.Tx.GetInputs()
+	signatories := make(map[crypto.Address]Signatory, len(inputs))
+	for _, s := range txEnv.Signatories {
+		if s.Address != nil {
+			signatories[*s.Address] = s
+		}
+	}
+	found := false
+	for _, in := range inputs {
+		if in.Address == *sig.Address {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("Signatory %v does not correspond to any input of transaction %v", *sig.Address,
+			txEnv.Tx.Hash())
+	}
+	signatories[*sig.Address] = sig
+	txEnv.Signatories = txEnv.Signatories[:0]
+	for _, in := range inputs {
+		if s, ok := signatories[in.Address]; ok {
+			txEnv.Signatories = append(txEnv.Signatories, s)
+		}
+	}
+	return nil
+}
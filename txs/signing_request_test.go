@@ -0,0 +1,58 @@
+package txs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningRequest(t *testing.T) {
+	alice := makePrivateAccount("alice")
+	bob := makePrivateAccount("bob")
+	sendTx := payload.NewSendTx()
+	require.NoError(t, sendTx.AddInputWithSequence(alice.GetPublicKey(), 10, 1))
+	require.NoError(t, sendTx.AddInputWithSequence(bob.GetPublicKey(), 20, 3))
+	sendTx.AddOutput(makePrivateAccount("carol").GetAddress(), 30)
+
+	expiry := time.Now().Add(time.Hour)
+	request, err := NewSigningRequest(Enclose(chainID, sendTx), expiry)
+	require.NoError(t, err)
+	assert.Contains(t, request.Summary, alice.GetAddress().String())
+	assert.Contains(t, request.Summary, chainID)
+
+	// Survives the trip to and from an approver
+	bs, err := json.Marshal(request)
+	require.NoError(t, err)
+	request = new(SigningRequest)
+	require.NoError(t, json.Unmarshal(bs, request))
+	require.NoError(t, request.Validate(time.Now()))
+	require.Error(t, request.Validate(expiry.Add(time.Second)))
+
+	sign := func(signer *acm.PrivateAccount) Signatory {
+		sig, err := signer.Sign(request.SignBytes)
+		require.NoError(t, err)
+		address := signer.GetAddress()
+		publicKey := signer.GetPublicKey()
+		return Signatory{Address: &address, PublicKey: &publicKey, Signature: sig}
+	}
+
+	// Signatures may be attached in any order
+	require.NoError(t, request.Envelope.AttachSignatory(sign(bob)))
+	assert.False(t, request.Complete())
+	require.Error(t, request.Envelope.Verify(chainID))
+	require.NoError(t, request.Envelope.AttachSignatory(sign(alice)))
+	assert.True(t, request.Complete())
+	require.NoError(t, request.Envelope.Verify(chainID))
+
+	// Only signatures from inputs over the SignBytes are accepted
+	require.Error(t, request.Envelope.AttachSignatory(sign(makePrivateAccount("mallory"))))
+	bad := sign(alice)
+	bad.Signature.Signature[0] ^= 0xff
+	require.Error(t, request.Envelope.AttachSignatory(bad))
+	require.NoError(t, request.Envelope.Verify(chainID))
+}
@@ -275,5 +275,11 @@ func EnvelopeFromAny(chainID string, p *payload.Any) *Envelope {
 	if p.IdentifyTx != nil {
 		return Enclose(chainID, p.IdentifyTx)
 	}
+	if p.PrivateTx != nil {
+		return Enclose(chainID, p.PrivateTx)
+	}
+	if p.HeartbeatTx != nil {
+		return Enclose(chainID, p.HeartbeatTx)
+	}
 	return nil
 }
@@ -105,6 +105,38 @@ func TestCallTxSignable(t *testing.T) {
 	testTxSignVerify(t, callTx)
 }
 
+func TestEnvelope_SignFeePayer(t *testing.T) {
+	input := makePrivateAccount("input1")
+	feePayer := makePrivateAccount("relayer")
+	toAddress := makePrivateAccount("contract1").GetAddress()
+	callTx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  input.GetAddress(),
+			Amount:   222,
+			Sequence: 1,
+		},
+		Address:  &toAddress,
+		GasLimit: 111,
+		Fee:      222,
+	}
+	txEnv := Enclose(chainID, callTx)
+	require.NoError(t, txEnv.Sign(input))
+	require.NoError(t, txEnv.SignFeePayer(feePayer))
+	require.NoError(t, txEnv.Verify(chainID))
+	assert.Equal(t, feePayer.GetAddress(), *txEnv.FeePayer.Address)
+
+	// FeePayer signature must be over the same Tx
+	otherEnv := Enclose(chainID, &payload.CallTx{Input: callTx.Input, Address: &toAddress, GasLimit: 111, Fee: 1})
+	require.NoError(t, otherEnv.Sign(input))
+	otherEnv.FeePayer = txEnv.FeePayer
+	require.Error(t, otherEnv.Verify(chainID))
+
+	// FeePayer public key must match its address
+	address := input.GetAddress()
+	txEnv.FeePayer.Address = &address
+	require.Error(t, txEnv.Verify(chainID))
+}
+
 func TestNameTxSignable(t *testing.T) {
 	nameTx := &payload.NameTx{
 		Input: &payload.TxInput{
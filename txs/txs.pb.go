@@ -8,10 +8,13 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	crypto "github.com/hyperledger/burrow/crypto"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
@@ -23,6 +26,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,11 +63,15 @@ func (Envelope_EncodingType) EnumDescriptor() ([]byte, []int) {
 type Envelope struct {
 	Signatories []Signatory `protobuf:"bytes,1,rep,name=Signatories,proto3" json:"Signatories"`
 	// Canonical bytes of the Tx ready to be signed
-	Tx                   *Tx                   `protobuf:"bytes,2,opt,name=Tx,proto3,customtype=Tx" json:"Tx,omitempty"`
-	Encoding             Envelope_EncodingType `protobuf:"varint,3,opt,name=Encoding,proto3,enum=txs.Envelope_EncodingType" json:"Encoding,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Tx       *Tx                   `protobuf:"bytes,2,opt,name=Tx,proto3,customtype=Tx" json:"Tx,omitempty"`
+	Encoding Envelope_EncodingType `protobuf:"varint,3,opt,name=Encoding,proto3,enum=txs.Envelope_EncodingType" json:"Encoding,omitempty"`
+	// An optional signatory, distinct from the inputs, that pays the transaction fee on behalf of the first input (for
+	// example a relayer offering gasless transactions). The FeePayer signs the same SignBytes as the inputs. The first
+	// input's Amount must still include the fee but the input account need not hold it.
+	FeePayer             *Signatory `protobuf:"bytes,4,opt,name=FeePayer,proto3" json:"FeePayer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Envelope) Reset()      { *m = Envelope{} }
@@ -112,6 +120,13 @@ func (m *Envelope) GetEncoding() Envelope_EncodingType {
 	return Envelope_JSON
 }
 
+func (m *Envelope) GetFeePayer() *Signatory {
+	if m != nil {
+		return m.FeePayer
+	}
+	return nil
+}
+
 func (*Envelope) XXX_MessageName() string {
 	return "txs.Envelope"
 }
@@ -177,6 +192,83 @@ func (*Signatory) XXX_MessageName() string {
 	return "txs.Signatory"
 }
 
+// A portable request for external approvers to sign a transaction. Approvers should check the Summary against the
+// SignBytes, check that the TxHash is the SHA256 of the SignBytes (truncated to the TxHash length), and return a
+// Signatory over the SignBytes to be attached to the Envelope.
+type SigningRequest struct {
+	// Human-readable summary of the transaction
+	Summary string `protobuf:"bytes,1,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	// The canonical bytes to be signed
+	SignBytes github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=SignBytes,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"SignBytes"`
+	// The hash identifying the transaction
+	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	// The time after which the request should no longer be signed or its transaction submitted
+	Expiry time.Time `protobuf:"bytes,4,opt,name=Expiry,proto3,stdtime" json:"Expiry"`
+	// The Envelope to which returned signatures are attached
+	Envelope             *Envelope `protobuf:"bytes,5,opt,name=Envelope,proto3" json:"Envelope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SigningRequest) Reset()         { *m = SigningRequest{} }
+func (m *SigningRequest) String() string { return proto.CompactTextString(m) }
+func (*SigningRequest) ProtoMessage()    {}
+func (*SigningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_372ebcf753025bdc, []int{2}
+}
+func (m *SigningRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningRequest.Merge(m, src)
+}
+func (m *SigningRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningRequest proto.InternalMessageInfo
+
+func (m *SigningRequest) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *SigningRequest) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *SigningRequest) GetEnvelope() *Envelope {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (*SigningRequest) XXX_MessageName() string {
+	return "txs.SigningRequest"
+}
+
 // BroadcastTx or Transaction receipt
 type Receipt struct {
 	// Transaction type
@@ -196,7 +288,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_372ebcf753025bdc, []int{2}
+	return fileDescriptor_372ebcf753025bdc, []int{3}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,6 +341,8 @@ func init() {
 	golang_proto.RegisterType((*Envelope)(nil), "txs.Envelope")
 	proto.RegisterType((*Signatory)(nil), "txs.Signatory")
 	golang_proto.RegisterType((*Signatory)(nil), "txs.Signatory")
+	proto.RegisterType((*SigningRequest)(nil), "txs.SigningRequest")
+	golang_proto.RegisterType((*SigningRequest)(nil), "txs.SigningRequest")
 	proto.RegisterType((*Receipt)(nil), "txs.Receipt")
 	golang_proto.RegisterType((*Receipt)(nil), "txs.Receipt")
 }
@@ -257,37 +351,44 @@ func init() { proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
 func init() { golang_proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
 
 var fileDescriptor_372ebcf753025bdc = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbb, 0x8e, 0xd3, 0x40,
-	0x14, 0xcd, 0x38, 0x56, 0x1e, 0x93, 0xb0, 0xbb, 0x4c, 0x81, 0xa2, 0x14, 0x76, 0x36, 0x55, 0x0a,
-	0xd6, 0x46, 0x01, 0x16, 0x89, 0x0e, 0xaf, 0x90, 0x56, 0xe1, 0xb5, 0x9a, 0x75, 0x45, 0x81, 0xe4,
-	0xc7, 0xc5, 0xb1, 0x14, 0x3c, 0xd6, 0x78, 0x0c, 0xf6, 0x9f, 0x50, 0xf2, 0x05, 0xf4, 0x88, 0x86,
-	0x32, 0x25, 0x75, 0x0a, 0x0b, 0x65, 0xff, 0x82, 0x0a, 0x79, 0xd6, 0xf6, 0x86, 0x2d, 0x82, 0xe8,
-	0xe6, 0xce, 0x39, 0xf7, 0xcc, 0xb9, 0xf7, 0x0c, 0xee, 0x8b, 0x2c, 0x31, 0x62, 0xce, 0x04, 0x23,
-	0x6d, 0x91, 0x25, 0xe3, 0x93, 0x20, 0x14, 0xcb, 0xd4, 0x35, 0x3c, 0xf6, 0xc1, 0x0c, 0x58, 0xc0,
-	0x4c, 0x89, 0xb9, 0xe9, 0x7b, 0x59, 0xc9, 0x42, 0x9e, 0xae, 0x7b, 0xc6, 0x43, 0x8f, 0xe7, 0xb1,
-	0xa8, 0xaa, 0xe9, 0x77, 0x84, 0x7b, 0xcf, 0xa3, 0x8f, 0xb0, 0x62, 0x31, 0x90, 0x53, 0x3c, 0xb8,
-	0x0c, 0x83, 0xc8, 0x11, 0x8c, 0x87, 0x90, 0x8c, 0xd0, 0xa4, 0x3d, 0x1b, 0xcc, 0x0f, 0x8c, 0xf2,
-	0xbd, 0xfa, 0x3e, 0xb7, 0xd4, 0x75, 0xa1, 0xb7, 0xe8, 0x2e, 0x91, 0xdc, 0xc3, 0x8a, 0x9d, 0x8d,
-	0x94, 0x09, 0x9a, 0x0d, 0xad, 0xce, 0xa6, 0xd0, 0x15, 0x3b, 0xa3, 0x8a, 0x9d, 0x91, 0xd3, 0x52,
-	0xdb, 0x63, 0x7e, 0x18, 0x05, 0xa3, 0xf6, 0x04, 0xcd, 0x0e, 0xe6, 0x63, 0x29, 0x56, 0x3f, 0x68,
-	0xd4, 0xa8, 0x9d, 0xc7, 0x40, 0x1b, 0xee, 0xf4, 0x18, 0x0f, 0x77, 0x11, 0xd2, 0xc3, 0xea, 0xe2,
-	0xf2, 0xcd, 0xeb, 0xa3, 0x16, 0xe9, 0xe2, 0x36, 0x7d, 0x79, 0x71, 0x84, 0x9e, 0xaa, 0x9f, 0xbf,
-	0xe8, 0xad, 0xe9, 0x37, 0x84, 0xfb, 0x8d, 0x33, 0xb2, 0xc0, 0xdd, 0x67, 0xbe, 0xcf, 0x21, 0x29,
-	0xad, 0x97, 0x5e, 0x1e, 0x6c, 0x0a, 0xfd, 0xfe, 0xce, 0x76, 0x96, 0x79, 0x0c, 0x7c, 0x05, 0x7e,
-	0x00, 0xdc, 0x74, 0x53, 0xce, 0xd9, 0x27, 0xb3, 0x5a, 0x46, 0xd5, 0x47, 0x6b, 0x01, 0x62, 0xe2,
-	0xfe, 0x45, 0xea, 0xae, 0x42, 0xef, 0x05, 0xe4, 0x72, 0xb2, 0xc1, 0xfc, 0xae, 0x51, 0x91, 0x1b,
-	0x80, 0xde, 0x70, 0xca, 0x86, 0x6b, 0x27, 0x29, 0x87, 0x91, 0xfa, 0x77, 0x43, 0x03, 0xd0, 0x1b,
-	0xce, 0xf4, 0xab, 0x82, 0xbb, 0x14, 0x3c, 0x08, 0x63, 0x41, 0x16, 0xb8, 0x63, 0x67, 0xe5, 0xa8,
-	0xd2, 0xf8, 0x1d, 0x6b, 0xfe, 0xbb, 0xd0, 0x8d, 0xfd, 0xc6, 0x45, 0x96, 0x98, 0xb1, 0x93, 0xaf,
-	0x98, 0xe3, 0x1b, 0x72, 0x7d, 0x95, 0x02, 0x79, 0x55, 0x6a, 0x9d, 0x3b, 0xc9, 0xb2, 0x0a, 0xe4,
-	0x71, 0x99, 0xd7, 0xa6, 0xd0, 0x4f, 0xf6, 0xeb, 0xb9, 0x61, 0xe4, 0xf0, 0xdc, 0x38, 0x87, 0xcc,
-	0xca, 0x05, 0x24, 0xb4, 0x12, 0x21, 0x33, 0x7c, 0x78, 0xc6, 0xc1, 0x11, 0x90, 0x9c, 0xb1, 0x48,
-	0x70, 0xc7, 0x13, 0x32, 0xca, 0x1e, 0xbd, 0x7d, 0x4d, 0xde, 0xe1, 0xc3, 0xfa, 0x5c, 0xc7, 0xa0,
-	0x4a, 0x07, 0x8f, 0x2a, 0x07, 0xff, 0x17, 0xc5, 0x6d, 0x31, 0xeb, 0xc9, 0x7a, 0xab, 0xa1, 0x9f,
-	0x5b, 0x0d, 0xfd, 0xda, 0x6a, 0xe8, 0xc7, 0x95, 0x86, 0xd6, 0x57, 0x1a, 0x7a, 0x7b, 0xfc, 0xcf,
-	0x35, 0xb9, 0x1d, 0xf9, 0xd5, 0x1f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x03, 0x82, 0x5c, 0xd8,
-	0x39, 0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xed, 0x39, 0x26, 0x3f, 0x2e, 0x69, 0x5a, 0x6e, 0x40, 0x56, 0x86, 0x38, 0xcd, 0x14, 0x10,
+	0xb5, 0x51, 0x80, 0x22, 0x21, 0x16, 0x5c, 0x15, 0x55, 0xe1, 0x57, 0x74, 0xf1, 0xc4, 0x80, 0x64,
+	0x3b, 0x87, 0x63, 0x29, 0xf1, 0x99, 0xf3, 0x19, 0xec, 0xff, 0x82, 0x91, 0x91, 0xbf, 0x80, 0x15,
+	0xb1, 0x31, 0x66, 0x64, 0xce, 0x10, 0x50, 0x3a, 0xf0, 0x3f, 0x30, 0x21, 0x5f, 0x6c, 0x27, 0x2d,
+	0x52, 0x11, 0x82, 0xed, 0xbe, 0xfb, 0xde, 0xf7, 0xee, 0x7d, 0xef, 0x1e, 0xac, 0xf1, 0x38, 0xd4,
+	0x02, 0x46, 0x39, 0x45, 0x25, 0x1e, 0x87, 0xad, 0x43, 0xd7, 0xe3, 0x93, 0xc8, 0xd6, 0x1c, 0x3a,
+	0xd3, 0x5d, 0xea, 0x52, 0x5d, 0xf4, 0xec, 0xe8, 0x95, 0xa8, 0x44, 0x21, 0x4e, 0xeb, 0x99, 0x96,
+	0xea, 0x52, 0xea, 0x4e, 0xc9, 0x06, 0xc5, 0xbd, 0x19, 0x09, 0xb9, 0x35, 0x0b, 0x32, 0x40, 0xc3,
+	0x61, 0x49, 0xc0, 0x33, 0x78, 0xf7, 0x07, 0x80, 0xd5, 0x13, 0xff, 0x0d, 0x99, 0xd2, 0x80, 0xa0,
+	0x23, 0x58, 0x1f, 0x79, 0xae, 0x6f, 0x71, 0xca, 0x3c, 0x12, 0x2a, 0xa0, 0x53, 0xea, 0xd5, 0xfb,
+	0x4d, 0x2d, 0x15, 0x94, 0xdf, 0x27, 0x86, 0x3c, 0x5f, 0xaa, 0x3b, 0x78, 0x1b, 0x88, 0xae, 0x41,
+	0xc9, 0x8c, 0x15, 0xa9, 0x03, 0x7a, 0x0d, 0xa3, 0xbc, 0x58, 0xaa, 0x92, 0x19, 0x63, 0xc9, 0x8c,
+	0xd1, 0x51, 0xca, 0xed, 0xd0, 0xb1, 0xe7, 0xbb, 0x4a, 0xa9, 0x03, 0x7a, 0xcd, 0x7e, 0x4b, 0x90,
+	0xe5, 0x0f, 0x6a, 0x79, 0xd7, 0x4c, 0x02, 0x82, 0x0b, 0x2c, 0xba, 0x01, 0xab, 0x8f, 0x08, 0x19,
+	0x5a, 0x09, 0x61, 0x8a, 0xdc, 0x01, 0xbf, 0x8b, 0xc0, 0x45, 0xbf, 0x7b, 0x00, 0x1b, 0xdb, 0x2c,
+	0xa8, 0x0a, 0xe5, 0xc1, 0xe8, 0xf9, 0xb3, 0xfd, 0x1d, 0x54, 0x81, 0x25, 0xfc, 0x64, 0xb8, 0x0f,
+	0xee, 0xcb, 0xef, 0x3f, 0xa8, 0x3b, 0xdd, 0xcf, 0x00, 0xd6, 0x0a, 0x02, 0x34, 0x80, 0x95, 0x87,
+	0xe3, 0x31, 0x23, 0x61, 0xba, 0x66, 0xaa, 0xfb, 0xd6, 0x62, 0xa9, 0xde, 0xdc, 0xb2, 0x7a, 0x92,
+	0x04, 0x84, 0x4d, 0xc9, 0xd8, 0x25, 0x4c, 0xb7, 0x23, 0xc6, 0xe8, 0x5b, 0x3d, 0x33, 0x2e, 0x9b,
+	0xc3, 0x39, 0x01, 0xd2, 0x61, 0x6d, 0x18, 0xd9, 0x53, 0xcf, 0x79, 0x4c, 0x12, 0xe1, 0x42, 0xbd,
+	0x7f, 0x55, 0xcb, 0xc0, 0x45, 0x03, 0x6f, 0x30, 0x48, 0xcf, 0x95, 0x44, 0x8c, 0x28, 0xf2, 0xf9,
+	0x81, 0xa2, 0x81, 0x37, 0x98, 0xee, 0x27, 0x09, 0x36, 0xd3, 0xca, 0xf3, 0x5d, 0x4c, 0x5e, 0x47,
+	0x24, 0xe4, 0x48, 0x81, 0x95, 0x51, 0x34, 0x9b, 0x59, 0x2c, 0x11, 0x0b, 0xd4, 0x70, 0x5e, 0xa2,
+	0xd1, 0x9a, 0xdd, 0x48, 0x38, 0x09, 0xb3, 0x4f, 0xb9, 0x9b, 0xfe, 0xd9, 0x62, 0xa9, 0x1e, 0x5e,
+	0xbe, 0xa0, 0xed, 0xf9, 0x16, 0x4b, 0xb4, 0x53, 0x12, 0x8b, 0x61, 0xbc, 0xe1, 0x41, 0x4f, 0x61,
+	0xd9, 0x8c, 0x4f, 0xad, 0x70, 0xa2, 0x94, 0xfe, 0x85, 0x31, 0x23, 0x41, 0x0f, 0x60, 0xf9, 0x24,
+	0x0e, 0x3c, 0x96, 0x64, 0xeb, 0xb7, 0xb4, 0x75, 0x6c, 0xb5, 0x3c, 0xb6, 0x9a, 0x99, 0xc7, 0xd6,
+	0xa8, 0xa6, 0x4f, 0xbd, 0xfb, 0xa6, 0x02, 0x9c, 0xcd, 0xa0, 0xeb, 0x9b, 0xcc, 0x2a, 0x57, 0xc4,
+	0xfc, 0xee, 0xb9, 0x5c, 0xe1, 0xa2, 0xdd, 0xfd, 0x28, 0xc1, 0x0a, 0x26, 0x0e, 0xf1, 0x02, 0x8e,
+	0x06, 0xe9, 0x0e, 0x69, 0x48, 0x84, 0x63, 0xbb, 0x46, 0xff, 0xe7, 0x52, 0xd5, 0x2e, 0xd7, 0xcf,
+	0xe3, 0x50, 0x0f, 0xac, 0x64, 0x4a, 0xad, 0xb1, 0x26, 0x42, 0x9a, 0x31, 0x6c, 0xf9, 0x21, 0xfd,
+	0x0f, 0x3f, 0x7a, 0x70, 0xef, 0x98, 0x11, 0x8b, 0x93, 0xf0, 0x98, 0xfa, 0x9c, 0x59, 0x0e, 0x17,
+	0x3e, 0x57, 0xf1, 0xc5, 0x6b, 0xf4, 0x12, 0xee, 0xe5, 0xe7, 0x3c, 0xc0, 0xb2, 0x50, 0x70, 0x27,
+	0x53, 0xf0, 0x77, 0x21, 0xbe, 0x48, 0x66, 0xdc, 0x9b, 0xaf, 0xda, 0xe0, 0xeb, 0xaa, 0x0d, 0xbe,
+	0xaf, 0xda, 0xe0, 0xcb, 0x59, 0x1b, 0xcc, 0xcf, 0xda, 0xe0, 0xc5, 0xc1, 0x1f, 0x6d, 0xb2, 0xcb,
+	0xe2, 0xeb, 0x6e, 0xff, 0x1a, 0x00, 0x9b, 0x68, 0x8a, 0x43, 0xc0, 0x04, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -314,6 +415,18 @@ func (m *Envelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FeePayer != nil {
+		{
+			size, err := m.FeePayer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Encoding != 0 {
 		i = encodeVarintTxs(dAtA, i, uint64(m.Encoding))
 		i--
@@ -411,6 +524,80 @@ func (m *Signatory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Envelope != nil {
+		{
+			size, err := m.Envelope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTxs(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SignBytes.Size()
+		i -= size
+		if _, err := m.SignBytes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTxs(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Receipt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -503,6 +690,10 @@ func (m *Envelope) Size() (n int) {
 	if m.Encoding != 0 {
 		n += 1 + sovTxs(uint64(m.Encoding))
 	}
+	if m.FeePayer != nil {
+		l = m.FeePayer.Size()
+		n += 1 + l + sovTxs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -533,6 +724,32 @@ func (m *Signatory) Size() (n int) {
 	return n
 }
 
+func (m *SigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTxs(uint64(l))
+	}
+	l = m.SignBytes.Size()
+	n += 1 + l + sovTxs(uint64(l))
+	l = m.TxHash.Size()
+	n += 1 + l + sovTxs(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTxs(uint64(l))
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovTxs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Receipt) Size() (n int) {
 	if m == nil {
 		return 0
@@ -678,6 +895,42 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeePayer == nil {
+				m.FeePayer = &Signatory{}
+			}
+			if err := m.FeePayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxs(dAtA[iNdEx:])
@@ -864,6 +1117,227 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Receipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
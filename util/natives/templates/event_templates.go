@@ -0,0 +1,239 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/native"
+)
+
+const goEventsTemplateText = `// Code generated by burrow natives. DO NOT EDIT.
+
+package [[.Package]]
+
+import (
+[[range .Imports]]	"[[.]]"
+[[end]])
+
+// [[.Name]]Address is the address of the [[.Name]] native contract
+var [[.Name]]Address = crypto.MustAddressFromHexString("[[.Address]]")
+[[range .Events]]
+[[.GoComment]]
+type [[.Name]] struct {
+[[range .Fields]]	[[.Name]] [[.GoType]][[.GoTag]]
+[[end]]}
+
+// Query[[.Name]] returns a query matching [[.Name]] events emitted by the [[$.Name]] contract
+func Query[[.Name]]() *query.Builder {
+	return query.NewBuilder().
+		AndEquals(exec.LogNKey(0), "[[.ID]]").
+		AndEquals(event.AddressKey, [[$.Name]]Address)
+}
+
+// Decode[[.Name]] decodes a [[.Name]] event from a log emitted by the [[$.Name]] contract
+func Decode[[.Name]](log *exec.LogEvent) (*[[.Name]], error) {
+	ev := new([[.Name]])
+	return ev, native.DecodeEvent(log, ev)
+}
+[[end]]`
+
+const protoEventsTemplateText = `syntax = 'proto3';
+
+package [[.Package]];
+
+// Events emitted by the [[.Name]] native contract at [[.Address]]
+[[range .Events]]
+[[.ProtoComment]]
+message [[.Name]] {
+[[range $i, $f := .Fields]]    [[$f.ProtoType]] [[$f.Name]] = [[inc $i]];
+[[end]]}
+[[end]]`
+
+var goEventsTemplate *template.Template
+var protoEventsTemplate *template.Template
+
+func init() {
+	var err error
+	goEventsTemplate, err = template.New("GoEventsTemplate").
+		Delims("[[", "]]").
+		Parse(goEventsTemplateText)
+	if err != nil {
+		panic(fmt.Errorf("couldn't parse native events Go template: %s", err))
+	}
+	protoEventsTemplate, err = template.New("ProtoEventsTemplate").
+		Delims("[[", "]]").
+		Funcs(template.FuncMap{"inc": func(i int) int { return i + 1 }}).
+		Parse(protoEventsTemplateText)
+	if err != nil {
+		panic(fmt.Errorf("couldn't parse native events proto template: %s", err))
+	}
+}
+
+type eventsContract struct {
+	Package string
+	*native.Contract
+}
+
+type eventDefinition struct {
+	*native.Event
+}
+
+type eventField struct {
+	Name    string
+	Indexed bool
+	Type    reflect.Type
+}
+
+// Create a templated set of typed event definitions for the events of a native contract, so that consumers of those
+// events need not decode logs by hand
+func NewEventsContract(pkg string, contract *native.Contract) *eventsContract {
+	return &eventsContract{
+		Package:  pkg,
+		Contract: contract,
+	}
+}
+
+func (contract *eventsContract) Address() string {
+	return contract.Contract.Address().String()
+}
+
+func (contract *eventsContract) Events() []*eventDefinition {
+	events := contract.Contract.Events()
+	definitions := make([]*eventDefinition, len(events))
+	for i, ev := range events {
+		definitions[i] = &eventDefinition{ev}
+	}
+	return definitions
+}
+
+func (contract *eventsContract) Imports() []string {
+	imports := map[string]struct{}{
+		"github.com/hyperledger/burrow/crypto":           {},
+		"github.com/hyperledger/burrow/event":            {},
+		"github.com/hyperledger/burrow/event/query":      {},
+		"github.com/hyperledger/burrow/execution/exec":   {},
+		"github.com/hyperledger/burrow/execution/native": {},
+	}
+	for _, ev := range contract.Events() {
+		for _, field := range ev.Fields() {
+			if pkgPath := elemType(field.Type).PkgPath(); pkgPath != "" {
+				imports[pkgPath] = struct{}{}
+			}
+		}
+	}
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Generate Go type definitions, query helpers, and decoders for the events of this native contract
+func (contract *eventsContract) Go() (string, error) {
+	buf := new(bytes.Buffer)
+	err := goEventsTemplate.Execute(buf, contract)
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("could not format generated Go: %v", err)
+	}
+	return string(src), nil
+}
+
+// Generate protobuf message definitions for the events of this native contract
+func (contract *eventsContract) Proto() (string, error) {
+	buf := new(bytes.Buffer)
+	err := protoEventsTemplate.Execute(buf, contract)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (ev *eventDefinition) ID() string {
+	return ev.Abi().ID.String()
+}
+
+func (ev *eventDefinition) Fields() []*eventField {
+	rt := reflect.TypeOf(ev.Value)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	fields := make([]*eventField, rt.NumField())
+	for i := range fields {
+		f := rt.Field(i)
+		fields[i] = &eventField{
+			Name:    f.Name,
+			Indexed: ev.Abi().Inputs[i].Indexed,
+			Type:    f.Type,
+		}
+	}
+	return fields
+}
+
+func (ev *eventDefinition) GoComment() string {
+	return prefixLines("// ", comment(ev.Comment))
+}
+
+func (ev *eventDefinition) ProtoComment() string {
+	return prefixLines("// ", comment(ev.Comment))
+}
+
+func (field *eventField) GoType() string {
+	return field.Type.String()
+}
+
+func (field *eventField) GoTag() string {
+	if field.Indexed {
+		return " `abi:\"indexed\"`"
+	}
+	return ""
+}
+
+func (field *eventField) ProtoType() string {
+	rt := field.Type
+	repeated := ""
+	if (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) && rt.Elem().Kind() != reflect.Uint8 {
+		repeated = "repeated "
+		rt = rt.Elem()
+	}
+	switch {
+	case rt == reflect.TypeOf(crypto.Address{}):
+		return repeated + "bytes"
+	case rt.Kind() == reflect.Bool:
+		return repeated + "bool"
+	case rt.Kind() == reflect.String:
+		return repeated + "string"
+	case rt.Kind() == reflect.Uint64:
+		return repeated + "uint64"
+	case rt.Kind() == reflect.Int64:
+		return repeated + "int64"
+	default:
+		// Big ints and byte arrays
+		return repeated + "bytes"
+	}
+}
+
+func elemType(rt reflect.Type) reflect.Type {
+	for rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt
+}
+
+func prefixLines(prefix, text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,47 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type noArgs struct{}
+
+type Transferred struct {
+	From   crypto.Address `abi:"indexed"`
+	To     crypto.Address `abi:"indexed"`
+	Amount uint64
+	Memo   string
+}
+
+func transfer(ctx native.Context, args noArgs) (noArgs, error) {
+	return noArgs{}, native.EmitEvent(ctx, Transferred{})
+}
+
+func TestEventsTemplates(t *testing.T) {
+	contract := native.New().
+		MustContract("Ledger", "A ledger", native.Function{F: transfer}).
+		MustContractEvents("Ledger", native.Event{Comment: "Emitted on transfer", Value: Transferred{}}).
+		GetContract("Ledger")
+
+	solidity, err := NewSolidityContract(contract).Solidity()
+	require.NoError(t, err)
+	assert.Contains(t, solidity, "event Transferred(address indexed _from, address indexed _to, uint64 _amount, string _memo);")
+
+	events := NewEventsContract("ledger", contract)
+	goSrc, err := events.Go()
+	require.NoError(t, err)
+	assert.Contains(t, goSrc, "package ledger")
+	assert.Contains(t, goSrc, "From   crypto.Address `abi:\"indexed\"`")
+	assert.Contains(t, goSrc, "func QueryTransferred() *query.Builder")
+	assert.Contains(t, goSrc, contract.EventByName("Transferred").Abi().ID.String())
+	assert.Contains(t, goSrc, "func DecodeTransferred(log *exec.LogEvent) (*Transferred, error)")
+
+	proto, err := events.Proto()
+	require.NoError(t, err)
+	assert.Contains(t, proto, "message Transferred {\n    bytes From = 1;\n    bytes To = 2;\n    uint64 Amount = 3;\n    string Memo = 4;\n}")
+}
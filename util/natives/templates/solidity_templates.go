@@ -9,6 +9,7 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/iancoleman/strcase"
 )
@@ -22,7 +23,9 @@ const contractTemplateText = `pragma solidity [[.SolidityPragmaVersion]];
 * @dev To instantiate the contract use:
 * @dev [[.Name]] [[.InstanceName]] = [[.Name]](address(uint256(keccak256("[[.Name]]"))));
 */
-interface [[.Name]] {[[range .Functions]]
+interface [[.Name]] {[[range .Events]]
+[[.SolidityIndent 1]]
+[[end]][[range .Functions]]
 [[.SolidityIndent 1]]
 [[end]]}
 `
@@ -30,6 +33,10 @@ const functionTemplateText = `/**
 [[.Comment]]
 */
 function [[.Name]]([[.ArgList]]) external returns ([[.RetList]]);`
+const eventTemplateText = `/**
+[[.Comment]]
+*/
+event [[.Name]]([[.ArgList]]);`
 
 // Solidity style guide recommends 4 spaces per indentation level
 // (see: http://solidity.readthedocs.io/en/develop/style-guide.html)
@@ -37,6 +44,7 @@ const indentString = "    "
 
 var contractTemplate *template.Template
 var functionTemplate *template.Template
+var eventTemplate *template.Template
 
 func init() {
 	var err error
@@ -46,6 +54,12 @@ func init() {
 	if err != nil {
 		panic(fmt.Errorf("couldn't parse native function template: %s", err))
 	}
+	eventTemplate, err = template.New("SolidityEventTemplate").
+		Delims("[[", "]]").
+		Parse(eventTemplateText)
+	if err != nil {
+		panic(fmt.Errorf("couldn't parse native event template: %s", err))
+	}
 	contractTemplate, err = template.New("SolidityContractTemplate").
 		Delims("[[", "]]").
 		Parse(contractTemplateText)
@@ -63,6 +77,10 @@ type solidityFunction struct {
 	*native.Function
 }
 
+type solidityEvent struct {
+	*native.Event
+}
+
 //
 // Contract
 //
@@ -114,6 +132,15 @@ func (contract *solidityContract) Functions() []*solidityFunction {
 	return solidityFunctions
 }
 
+func (contract *solidityContract) Events() []*solidityEvent {
+	events := contract.Contract.Events()
+	solidityEvents := make([]*solidityEvent, len(events))
+	for i, ev := range events {
+		solidityEvents[i] = NewSolidityEvent(ev)
+	}
+	return solidityEvents
+}
+
 //
 // Function
 //
@@ -128,10 +155,10 @@ func (function *solidityFunction) ArgList() string {
 	argList := make([]string, len(abi.Inputs))
 	for i, arg := range abi.Inputs {
 		storage := ""
-		if arg.EVM.Dynamic() {
+		if arg.EVM.Dynamic() || arg.IsArray {
 			storage = " calldata"
 		}
-		argList[i] = fmt.Sprintf("%s%s %s", arg.EVM.GetSignature(), storage, param(arg.Name))
+		argList[i] = fmt.Sprintf("%s%s %s", solidityType(arg), storage, param(arg.Name))
 	}
 	return strings.Join(argList, ", ")
 }
@@ -140,7 +167,11 @@ func (function *solidityFunction) RetList() string {
 	abi := function.Abi()
 	argList := make([]string, len(abi.Outputs))
 	for i, arg := range abi.Outputs {
-		argList[i] = fmt.Sprintf("%s %s", arg.EVM.GetSignature(), param(arg.Name))
+		storage := ""
+		if arg.EVM.Dynamic() || arg.IsArray {
+			storage = " memory"
+		}
+		argList[i] = fmt.Sprintf("%s%s %s", solidityType(arg), storage, param(arg.Name))
 	}
 	return strings.Join(argList, ", ")
 }
@@ -167,6 +198,42 @@ func (function *solidityFunction) solidity(indentLevel uint) (string, error) {
 	return buf.String(), nil
 }
 
+//
+// Event
+//
+
+// Create a templated solidityEvent from a native event description
+func NewSolidityEvent(ev *native.Event) *solidityEvent {
+	return &solidityEvent{ev}
+}
+
+func (ev *solidityEvent) ArgList() string {
+	abi := ev.Abi()
+	argList := make([]string, len(abi.Inputs))
+	for i, arg := range abi.Inputs {
+		indexed := ""
+		if arg.Indexed {
+			indexed = " indexed"
+		}
+		argList[i] = fmt.Sprintf("%s%s %s", solidityType(arg), indexed, param(arg.Name))
+	}
+	return strings.Join(argList, ", ")
+}
+
+func (ev *solidityEvent) Comment() string {
+	return comment(ev.Event.Comment)
+}
+
+func (ev *solidityEvent) SolidityIndent(indentLevel uint) (string, error) {
+	buf := new(bytes.Buffer)
+	iw := NewIndentWriter(indentLevel, indentString, buf)
+	err := eventTemplate.Execute(iw, ev)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 //
 // Utility
 //
@@ -185,3 +252,13 @@ func comment(comment string) string {
 func param(name string) string {
 	return "_" + strcase.ToSnake(name)
 }
+
+func solidityType(arg abi.Argument) string {
+	if !arg.IsArray {
+		return arg.EVM.GetSignature()
+	}
+	if arg.ArrayLength > 0 {
+		return fmt.Sprintf("%s[%d]", arg.EVM.GetSignature(), arg.ArrayLength)
+	}
+	return arg.EVM.GetSignature() + "[]"
+}
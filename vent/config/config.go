@@ -22,6 +22,8 @@ type VentConfig struct {
 	SpecOpt        sqlsol.SpecOpt
 	// Announce status every AnnouncePeriod
 	AnnounceEvery time.Duration
+	// The /healthz endpoint fails when the projection is more than this many blocks behind the chain, zero disables
+	MaxHealthyLag uint64
 }
 
 // DefaultFlags returns a configuration with default values
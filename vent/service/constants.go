@@ -1,6 +1,13 @@
 package service
 
-import "github.com/hyperledger/burrow/vent/types"
+import (
+	"time"
+
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// How often to refresh the chain status when status announcements are disabled
+const DefaultStatusRefresh = 5 * time.Second
 
 var tables = types.DefaultSQLTableNames
 var columns = types.DefaultSQLColumnNames
@@ -29,6 +29,7 @@ type Consumer struct {
 	GRPCConnection *grpc.ClientConn
 	// external events channel used for when vent is leveraged as a library
 	EventsChannel chan types.EventData
+	Metrics       *Metrics
 	Done          chan struct{}
 	shutdownOnce  sync.Once
 	Status
@@ -48,6 +49,7 @@ func NewConsumer(cfg *config.VentConfig, log *logging.Logger, eventChannel chan
 		Config:        cfg,
 		Logger:        log,
 		EventsChannel: eventChannel,
+		Metrics:       NewMetrics(),
 		Done:          make(chan struct{}),
 	}
 }
@@ -73,6 +75,7 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 	if err != nil {
 		return errors.Wrapf(err, "Error getting chain status")
 	}
+	c.Metrics.Sample(c.Burrow.SyncInfo.LatestBlockHeight)
 
 	abiProvider, err := NewAbiProvider(c.Config.AbiFileOrDirs, rpcquery.NewQueryClient(c.GRPCConnection), c.Logger)
 	if err != nil {
@@ -120,7 +123,7 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		defer func() {
 			c.Shutdown()
 		}()
-		go c.announceEvery(c.Done)
+		go c.updateStatusEvery(c.Done)
 
 		c.Logger.InfoMsg("Getting last processed block number from SQL log table")
 
@@ -139,6 +142,7 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 			errCh <- errors.Wrapf(err, "Error trying to get last processed block number")
 			return
 		}
+		c.Metrics.Resume(fromBlock)
 
 		startingBlock := fromBlock
 		// Start the block after the last one successfully committed - apart from if this is the first block
@@ -220,8 +224,10 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.EventData) error {
 	// upsert rows in specific SQL event tables and update block number
 	if err := c.DB.SetBlock(c.Burrow.ChainID, projection.Tables, blockEvents); err != nil {
+		c.Metrics.Failed()
 		return fmt.Errorf("error upserting rows in database: %v", err)
 	}
+	c.Metrics.Committed(blockEvents)
 
 	// send to the external events channel in a non-blocking manner
 	select {
@@ -274,6 +280,7 @@ func (c *Consumer) updateStatus(qcli rpcquery.QueryClient) {
 		return
 	}
 	c.Status.Burrow = stat
+	c.Metrics.Sample(stat.SyncInfo.LatestBlockHeight)
 }
 
 func (c *Consumer) statusMessage() []interface{} {
@@ -296,19 +303,25 @@ func (c *Consumer) statusMessage() []interface{} {
 	}
 }
 
-func (c *Consumer) announceEvery(doneCh <-chan struct{}) {
-	if c.Config.AnnounceEvery != 0 {
-		qcli := rpcquery.NewQueryClient(c.GRPCConnection)
-		ticker := time.NewTicker(c.Config.AnnounceEvery)
-		for {
-			select {
-			case <-ticker.C:
-				c.updateStatus(qcli)
+// Refreshes the chain status (on which lag metrics depend) every AnnounceEvery (or DefaultStatusRefresh if announcements
+// are disabled) announcing the status if enabled
+func (c *Consumer) updateStatusEvery(doneCh <-chan struct{}) {
+	period := c.Config.AnnounceEvery
+	if period == 0 {
+		period = DefaultStatusRefresh
+	}
+	qcli := rpcquery.NewQueryClient(c.GRPCConnection)
+	ticker := time.NewTicker(period)
+	for {
+		select {
+		case <-ticker.C:
+			c.updateStatus(qcli)
+			if c.Config.AnnounceEvery != 0 {
 				c.Logger.InfoMsg("Announcement", c.statusMessage()...)
-			case <-doneCh:
-				ticker.Stop()
-				return
 			}
+		case <-doneCh:
+			ticker.Stop()
+			return
 		}
 	}
 }
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "vent"
+
+// Metrics tracks how far the projection lags behind the chain and the rate at which it is being written so that
+// indexing can be monitored with Prometheus
+type Metrics struct {
+	sync.Mutex
+	Registry            *prometheus.Registry
+	chainHeight         uint64
+	lastProcessedHeight uint64
+	rows                uint64
+	rowsAtLastSample    uint64
+	lastSample          time.Time
+	headLag             prometheus.Gauge
+	chainHeightGauge    prometheus.Gauge
+	processedHeight     prometheus.Gauge
+	rowsTotal           prometheus.Counter
+	rowsPerSecond       prometheus.Gauge
+	failedInserts       prometheus.Counter
+	tableHeight         *prometheus.GaugeVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		Registry:   prometheus.NewRegistry(),
+		lastSample: time.Now(),
+		headLag: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "head_lag_blocks",
+			Help:      "Number of blocks the projection is behind the latest block of the chain",
+		}),
+		chainHeightGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "chain_height",
+			Help:      "Latest block height reported by the chain",
+		}),
+		processedHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_processed_height",
+			Help:      "Height of the last block committed to the projection",
+		}),
+		rowsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rows_total",
+			Help:      "Number of rows upserted or deleted in projection tables",
+		}),
+		rowsPerSecond: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rows_per_second",
+			Help:      "Rate of rows written to projection tables between the two most recent status samples",
+		}),
+		failedInserts: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "failed_inserts_total",
+			Help:      "Number of blocks that could not be written to the projection",
+		}),
+		tableHeight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "table_last_updated_height",
+			Help:      "Height of the last block that wrote rows to each projection table",
+		}, []string{"table"}),
+	}
+	m.Registry.MustRegister(m.headLag, m.chainHeightGauge, m.processedHeight, m.rowsTotal, m.rowsPerSecond,
+		m.failedInserts, m.tableHeight)
+	return m
+}
+
+// Record a block of rows successfully committed to the projection
+func (m *Metrics) Committed(blockEvents types.EventData) {
+	m.Lock()
+	defer m.Unlock()
+	var rows uint64
+	for table, tableRows := range blockEvents.Tables {
+		if len(tableRows) > 0 {
+			m.tableHeight.WithLabelValues(table).Set(float64(blockEvents.BlockHeight))
+			rows += uint64(len(tableRows))
+		}
+	}
+	m.rows += rows
+	m.rowsTotal.Add(float64(rows))
+	m.lastProcessedHeight = blockEvents.BlockHeight
+	m.processedHeight.Set(float64(blockEvents.BlockHeight))
+	m.updateLag()
+}
+
+// Record the height from which the projection resumes
+func (m *Metrics) Resume(lastProcessedHeight uint64) {
+	m.Lock()
+	defer m.Unlock()
+	m.lastProcessedHeight = lastProcessedHeight
+	m.processedHeight.Set(float64(lastProcessedHeight))
+	m.updateLag()
+}
+
+// Record a block that could not be committed to the projection
+func (m *Metrics) Failed() {
+	m.failedInserts.Inc()
+}
+
+// Sample the latest height of the chain and the rate of rows written since the last sample
+func (m *Metrics) Sample(chainHeight uint64) {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	if elapsed := now.Sub(m.lastSample).Seconds(); elapsed > 0 {
+		m.rowsPerSecond.Set(float64(m.rows-m.rowsAtLastSample) / elapsed)
+	}
+	m.rowsAtLastSample = m.rows
+	m.lastSample = now
+	m.chainHeight = chainHeight
+	m.chainHeightGauge.Set(float64(chainHeight))
+	m.updateLag()
+}
+
+// The number of blocks the projection is behind the chain
+func (m *Metrics) Lag() uint64 {
+	m.Lock()
+	defer m.Unlock()
+	return m.lag()
+}
+
+// Returns an error if the projection is more than maxLag blocks behind the chain, zero maxLag disables the check
+func (m *Metrics) CheckLag(maxLag uint64) error {
+	if maxLag == 0 {
+		return nil
+	}
+	lag := m.Lag()
+	if lag > maxLag {
+		return fmt.Errorf("projection is %d blocks behind the chain, more than the maximum of %d", lag, maxLag)
+	}
+	return nil
+}
+
+func (m *Metrics) updateLag() {
+	m.headLag.Set(float64(m.lag()))
+}
+
+func (m *Metrics) lag() uint64 {
+	if m.chainHeight <= m.lastProcessedHeight {
+		return 0
+	}
+	return m.chainHeight - m.lastProcessedHeight
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+	m.Sample(10)
+	assert.Equal(t, uint64(10), m.Lag())
+	m.Resume(4)
+	assert.Equal(t, uint64(6), m.Lag())
+	require.Error(t, m.CheckLag(5))
+
+	m.Committed(types.EventData{
+		BlockHeight: 8,
+		Tables: map[string]types.EventDataTable{
+			"Events": {{}, {}},
+			"Other":  {},
+		},
+	})
+	assert.Equal(t, uint64(2), m.Lag())
+	require.NoError(t, m.CheckLag(5))
+	require.NoError(t, m.CheckLag(0))
+
+	// Processing may get ahead of the last sample of the chain height
+	m.Committed(types.EventData{BlockHeight: 12})
+	assert.Equal(t, uint64(0), m.Lag())
+	m.Failed()
+
+	server := NewServer(config.DefaultVentConfig(), logging.NewNoopLogger(), &Consumer{Metrics: m})
+	resp := httptest.NewRecorder()
+	server.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	body := resp.Body.String()
+	for _, line := range []string{
+		"vent_head_lag_blocks 0",
+		"vent_last_processed_height 12",
+		"vent_rows_total 2",
+		"vent_failed_inserts_total 1",
+		`vent_table_last_updated_height{table="Events"} 8`,
+	} {
+		assert.True(t, strings.Contains(body, line), "expected metrics to contain %s", line)
+	}
+	assert.False(t, strings.Contains(body, `table="Other"`))
+}
+
+func TestHealthz(t *testing.T) {
+	consumer := NewConsumer(config.DefaultVentConfig(), logging.NewNoopLogger(), nil)
+	server := NewServer(consumer.Config, consumer.Logger, consumer)
+	resp := httptest.NewRecorder()
+	server.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	// Not connected to a database
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Contains(t, resp.Body.String(), "database disconnected")
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/config"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // Server exposes HTTP endpoints for the service
@@ -23,6 +25,8 @@ func NewServer(cfg *config.VentConfig, log *logging.Logger, consumer *Consumer)
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/health", healthHandler(consumer))
+	mux.HandleFunc("/healthz", healthzHandler(consumer, cfg.MaxHealthyLag))
+	mux.Handle("/metrics", promhttp.HandlerFor(consumer.Metrics.Registry, promhttp.HandlerOpts{}))
 
 	return &Server{
 		Config:   cfg,
@@ -73,3 +77,20 @@ func healthHandler(consumer *Consumer) func(resp http.ResponseWriter, req *http.
 		}
 	}
 }
+
+// Like /health but also fails when the projection lags more than maxLag blocks behind the chain, reporting the reason
+func healthzHandler(consumer *Consumer, maxLag uint64) func(resp http.ResponseWriter, req *http.Request) {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		err := consumer.Health()
+		if err == nil {
+			err = consumer.Metrics.CheckLag(maxLag)
+		}
+		if err != nil {
+			resp.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(resp, err)
+			return
+		}
+		resp.WriteHeader(http.StatusOK)
+		fmt.Fprintf(resp, "ok, %d blocks behind\n", consumer.Metrics.Lag())
+	}
+}